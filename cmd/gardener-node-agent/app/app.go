@@ -16,12 +16,11 @@ package app
 
 import (
 	"context"
+	"crypto/tls"
 	"fmt"
-	"io/fs"
 	"net"
 	"net/http"
 	"os"
-	"path/filepath"
 	goruntime "runtime"
 	"strconv"
 	"time"
@@ -32,8 +31,10 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	"k8s.io/client-go/rest"
 	"k8s.io/component-base/version/verflag"
+	"k8s.io/utils/clock"
 	"k8s.io/utils/pointer"
 	"sigs.k8s.io/controller-runtime/pkg/cache"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -53,6 +54,10 @@ import (
 	"github.com/gardener/gardener/pkg/nodeagent/bootstrap"
 	"github.com/gardener/gardener/pkg/nodeagent/controller"
 	"github.com/gardener/gardener/pkg/nodeagent/dbus"
+	"github.com/gardener/gardener/pkg/nodeagent/diagnostics"
+	"github.com/gardener/gardener/pkg/nodeagent/journal"
+	nodeagentmetrics "github.com/gardener/gardener/pkg/nodeagent/metrics"
+	"github.com/gardener/gardener/pkg/nodeagent/watchdog"
 )
 
 // Name is a const for the name of this component.
@@ -108,10 +113,21 @@ func getBootstrapCommand(opts *options) *cobra.Command {
 func run(ctx context.Context, cancel context.CancelFunc, log logr.Logger, cfg *config.NodeAgentConfiguration) error {
 	log.Info("Feature Gates", "featureGates", features.DefaultFeatureGate)
 
+	if cfg.Diagnostics != nil && cfg.Diagnostics.PersistCrashDumps {
+		log.Info("Persisting crash dumps to the state directory", "directory", diagnostics.Dir)
+		diagnostics.AddCrashDumpPanicHandler(log, afero.Afero{Fs: afero.NewOsFs()}, clock.RealClock{})
+		defer utilruntime.HandleCrash()
+	}
+
 	if kubeconfig := os.Getenv("KUBECONFIG"); kubeconfig != "" {
 		cfg.ClientConnection.Kubeconfig = kubeconfig
 	}
 
+	log.Info("Recovering interrupted writes of the state directory", "journal", journal.Dir)
+	if err := journal.Recover(afero.Afero{Fs: afero.NewOsFs()}); err != nil {
+		return fmt.Errorf("failed recovering state directory: %w", err)
+	}
+
 	log.Info("Getting rest config")
 	var (
 		restConfig *rest.Config
@@ -146,6 +162,17 @@ func run(ctx context.Context, cancel context.CancelFunc, log logr.Logger, cfg *c
 		}
 	}
 
+	metricsOptions := metricsserver.Options{
+		BindAddress:   net.JoinHostPort(cfg.Server.Metrics.BindAddress, strconv.Itoa(cfg.Server.Metrics.Port)),
+		ExtraHandlers: extraHandlers,
+	}
+	if cfg.Server.MetricsClientCAFile != nil {
+		metricsOptions.SecureServing = true
+		metricsOptions.TLSOpts = []func(*tls.Config){
+			nodeagentmetrics.ClientCertificateTLSOption(afero.Afero{Fs: afero.NewOsFs()}, *cfg.Server.MetricsClientCAFile),
+		}
+	}
+
 	log.Info("Fetching hostname")
 	hostName, err := os.Hostname()
 	if err != nil {
@@ -159,10 +186,7 @@ func run(ctx context.Context, cancel context.CancelFunc, log logr.Logger, cfg *c
 		GracefulShutdownTimeout: pointer.Duration(5 * time.Second),
 
 		HealthProbeBindAddress: net.JoinHostPort(cfg.Server.HealthProbes.BindAddress, strconv.Itoa(cfg.Server.HealthProbes.Port)),
-		Metrics: metricsserver.Options{
-			BindAddress:   net.JoinHostPort(cfg.Server.Metrics.BindAddress, strconv.Itoa(cfg.Server.Metrics.Port)),
-			ExtraHandlers: extraHandlers,
-		},
+		Metrics:                metricsOptions,
 
 		Cache: cache.Options{ByObject: map[client.Object]cache.ByObject{
 			&corev1.Secret{}: {Namespaces: map[string]cache.Config{metav1.NamespaceSystem: {}}},
@@ -185,8 +209,34 @@ func run(ctx context.Context, cancel context.CancelFunc, log logr.Logger, cfg *c
 		return err
 	}
 
+	var reconcileState *diagnostics.ReconcileState
+	if cfg.Diagnostics != nil && cfg.Diagnostics.SocketPath != nil {
+		log.Info("Adding diagnostics server to manager")
+		reconcileState = diagnostics.NewReconcileState(clock.RealClock{})
+		if err := mgr.Add(&diagnostics.Server{
+			Log:        log.WithName("diagnostics"),
+			FS:         afero.Afero{Fs: afero.NewOsFs()},
+			Clock:      clock.RealClock{},
+			State:      reconcileState,
+			SocketPath: *cfg.Diagnostics.SocketPath,
+		}); err != nil {
+			return fmt.Errorf("failed adding diagnostics server to manager: %w", err)
+		}
+	}
+
+	nodeAgentWatchdog, err := watchdog.New(log.WithName("watchdog"), clock.RealClock{}, watchdog.SdNotify)
+	if err != nil {
+		return err
+	}
+	if nodeAgentWatchdog != nil {
+		log.Info("Adding systemd watchdog to manager")
+		if err := mgr.Add(nodeAgentWatchdog); err != nil {
+			return fmt.Errorf("failed adding systemd watchdog to manager: %w", err)
+		}
+	}
+
 	log.Info("Adding controllers to manager")
-	if err := controller.AddToManager(cancel, mgr, cfg, hostName); err != nil {
+	if err := controller.AddToManager(cancel, mgr, cfg, hostName, reconcileState, nodeAgentWatchdog); err != nil {
 		return fmt.Errorf("failed adding controllers to manager: %w", err)
 	}
 
@@ -243,10 +293,7 @@ func fetchAccessToken(ctx context.Context, log logr.Logger, restConfig *rest.Con
 	}
 
 	log.Info("Writing downloaded access token to disk", "path", nodeagentv1alpha1.TokenFilePath)
-	if err := os.MkdirAll(filepath.Dir(nodeagentv1alpha1.TokenFilePath), fs.ModeDir); err != nil {
-		return fmt.Errorf("unable to create directory %q: %w", filepath.Dir(nodeagentv1alpha1.TokenFilePath), err)
-	}
-	if err := os.WriteFile(nodeagentv1alpha1.TokenFilePath, token, 0600); err != nil {
+	if err := journal.WriteFile(afero.Afero{Fs: afero.NewOsFs()}, nodeagentv1alpha1.TokenFilePath, token, 0600); err != nil {
 		return fmt.Errorf("unable to write access token to %s: %w", nodeagentv1alpha1.TokenFilePath, err)
 	}
 
@@ -89,9 +89,14 @@ func run(ctx context.Context, log logr.Logger, cfg *config.SchedulerConfiguratio
 		return err
 	}
 
-	var extraHandlers map[string]http.Handler
-	if cfg.Debugging != nil && cfg.Debugging.EnableProfiling {
-		extraHandlers = routes.ProfilingHandlers
+	var (
+		profilingEnabled = cfg.Debugging != nil && cfg.Debugging.EnableProfiling
+		extraHandlers    map[string]http.Handler
+	)
+	if profilingEnabled {
+		if cfg.Server.Profiling == nil {
+			extraHandlers = routes.ProfilingHandlers
+		}
 		if cfg.Debugging.EnableContentionProfiling {
 			goruntime.SetBlockProfileRate(1)
 		}
@@ -132,6 +137,16 @@ func run(ctx context.Context, log logr.Logger, cfg *config.SchedulerConfiguratio
 		return err
 	}
 
+	if profilingEnabled && cfg.Server.Profiling != nil {
+		log.Info("Adding profiling server to manager")
+		if err := mgr.Add(&routes.ProfilingServer{
+			Log:     log.WithName("profiling-server"),
+			Address: net.JoinHostPort(cfg.Server.Profiling.BindAddress, strconv.Itoa(cfg.Server.Profiling.Port)),
+		}); err != nil {
+			return err
+		}
+	}
+
 	log.Info("Adding health check endpoints to manager")
 	if err := mgr.AddReadyzCheck("informer-sync", gardenerhealthz.NewCacheSyncHealthz(mgr.GetCache())); err != nil {
 		return err
@@ -34,6 +34,13 @@ func FilesToDiskScript(ctx context.Context, reader client.Reader, namespace stri
 	var out string
 
 	for _, file := range files {
+		if symlink := file.Content.Symlink; symlink != nil {
+			out += `
+mkdir -p "` + path.Dir(file.Path) + `"
+ln -sfn "` + symlink.Target + `" "` + file.Path + `"`
+			continue
+		}
+
 		data, err := dataForFileContent(ctx, reader, namespace, &file.Content)
 		if err != nil {
 			return "", err
@@ -47,6 +54,11 @@ mkdir -p "` + path.Dir(file.Path) + `"
 			out += `
 ` + fmt.Sprintf(`chmod "%04o" "%s"`, *file.Permissions, file.Path)
 		}
+
+		if file.Owner != nil {
+			out += `
+` + fmt.Sprintf(`chown "%s:%s" "%s"`, ownerOrRoot(file.Owner.User), ownerOrRoot(file.Owner.Group), file.Path)
+		}
 	}
 
 	return out, nil
@@ -93,6 +105,13 @@ func dataForFileContent(ctx context.Context, c client.Reader, namespace string,
 	return secret.Data[content.SecretRef.DataKey], nil
 }
 
+func ownerOrRoot(name string) string {
+	if name == "" {
+		return "root"
+	}
+	return name
+}
+
 func catDataIntoFile(path string, data []byte, transmitUnencoded bool) string {
 	if transmitUnencoded {
 		return `
@@ -165,6 +165,41 @@ EOF`))
 				tempDir+file4,
 			)
 		})
+
+		It("should generate the expected output for symbolic links and owners", func() {
+			var (
+				folder2 = "/bar"
+				file2   = folder2 + "/baz"
+			)
+
+			files := []extensionsv1alpha1.File{
+				{
+					Path: file1,
+					Content: extensionsv1alpha1.FileContent{
+						Inline: &extensionsv1alpha1.FileContentInline{Data: "plain-text"},
+					},
+					Owner: &extensionsv1alpha1.FileOwner{Group: "systemd-network"},
+				},
+				{
+					Path: file2,
+					Content: extensionsv1alpha1.FileContent{
+						Symlink: &extensionsv1alpha1.FileContentSymlink{Target: file1},
+					},
+				},
+			}
+
+			script, err := FilesToDiskScript(ctx, fakeClient, namespace, files)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(script).To(Equal(`
+mkdir -p "` + folder1 + `"
+
+cat << EOF | base64 -d > "` + file1 + `"
+cGxhaW4tdGV4dA==
+EOF
+chown "root:systemd-network" "` + file1 + `"
+mkdir -p "` + folder2 + `"
+ln -sfn "` + file1 + `" "` + file2 + `"`))
+		})
 	})
 
 	Describe("#UnitsToDiskScript", func() {
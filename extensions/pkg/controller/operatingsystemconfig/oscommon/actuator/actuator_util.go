@@ -16,6 +16,7 @@ package actuator
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/go-logr/logr"
 	corev1 "k8s.io/api/core/v1"
@@ -42,10 +43,20 @@ func CloudConfigFromOperatingSystemConfig(
 ) {
 	files := make([]*commonosgenerator.File, 0, len(config.Spec.Files))
 	for _, file := range config.Spec.Files {
+		// Files from images are only applied by gardener-node-agent.
 		if file.Content.ImageRef != nil {
 			continue
 		}
 
+		// The cloud-config rendered by the generators cannot express symbolic links and owners, hence they are rejected
+		// instead of being dropped silently.
+		if file.Content.Symlink != nil {
+			return nil, nil, fmt.Errorf("file %q declares a symbolic link which is only supported by gardener-node-agent", file.Path)
+		}
+		if file.Owner != nil {
+			return nil, nil, fmt.Errorf("file %q declares an owner which is only supported by gardener-node-agent", file.Path)
+		}
+
 		data, err := DataForFileContent(ctx, c, config.Namespace, &file.Content)
 		if err != nil {
 			return nil, nil, err
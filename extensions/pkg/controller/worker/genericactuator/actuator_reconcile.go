@@ -18,6 +18,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strconv"
 	"strings"
 	"time"
 
@@ -257,6 +258,13 @@ func deployMachineDeployments(
 		}
 
 		if _, err := controllerutils.GetAndCreateOrMergePatch(ctx, cl, machineDeployment, func() error {
+			for _, key := range clusterAutoscalerAnnotationKeys {
+				delete(machineDeployment.Annotations, key)
+			}
+			for key, value := range deployment.ClusterAutoscalerAnnotations {
+				metav1.SetMetaDataAnnotation(&machineDeployment.ObjectMeta, key, value)
+			}
+
 			machineDeployment.Spec = machinev1alpha1.MachineDeploymentSpec{
 				Replicas:        replicas,
 				MinReadySeconds: 500,
@@ -514,3 +522,34 @@ func ReadMachineConfiguration(pool extensionsv1alpha1.WorkerPool) *machinev1alph
 	}
 	return machineConfiguration
 }
+
+var clusterAutoscalerAnnotationKeys = []string{
+	extensionsv1alpha1.ScaleDownUtilizationThresholdAnnotation,
+	extensionsv1alpha1.ScaleDownUnneededTimeAnnotation,
+	extensionsv1alpha1.MaxNodeProvisionTimeAnnotation,
+	extensionsv1alpha1.MaxGracefulTerminationSecondsAnnotation,
+}
+
+// ReadClusterAutoscalerAnnotations reads the cluster-autoscaler options from the worker-pool and returns the
+// corresponding annotations of the machine-deployment. They are evaluated by the cluster-autoscaler for the node group.
+func ReadClusterAutoscalerAnnotations(pool extensionsv1alpha1.WorkerPool) map[string]string {
+	caOptions := pool.ClusterAutoscaler
+	if caOptions == nil {
+		return nil
+	}
+
+	annotations := map[string]string{}
+	if caOptions.ScaleDownUtilizationThreshold != nil {
+		annotations[extensionsv1alpha1.ScaleDownUtilizationThresholdAnnotation] = *caOptions.ScaleDownUtilizationThreshold
+	}
+	if caOptions.ScaleDownUnneededTime != nil {
+		annotations[extensionsv1alpha1.ScaleDownUnneededTimeAnnotation] = caOptions.ScaleDownUnneededTime.Duration.String()
+	}
+	if caOptions.MaxNodeProvisionTime != nil {
+		annotations[extensionsv1alpha1.MaxNodeProvisionTimeAnnotation] = caOptions.MaxNodeProvisionTime.Duration.String()
+	}
+	if caOptions.MaxGracefulTerminationSeconds != nil {
+		annotations[extensionsv1alpha1.MaxGracefulTerminationSecondsAnnotation] = strconv.Itoa(int(*caOptions.MaxGracefulTerminationSeconds))
+	}
+	return annotations
+}
@@ -16,6 +16,7 @@ package genericactuator
 
 import (
 	"context"
+	"time"
 
 	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
 	. "github.com/onsi/ginkgo/v2"
@@ -26,10 +27,16 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/utils/pointer"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 
+	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
 	"github.com/gardener/gardener/extensions/pkg/controller/worker"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
 	mockclient "github.com/gardener/gardener/pkg/mock/controller-runtime/client"
 	"github.com/gardener/gardener/pkg/utils/gardener/shootstate"
 )
@@ -125,6 +132,66 @@ var _ = Describe("Actuator", func() {
 		})
 	})
 
+	Describe("#ReadClusterAutoscalerAnnotations", func() {
+		It("should return nil if the worker pool has no cluster autoscaler options", func() {
+			Expect(ReadClusterAutoscalerAnnotations(extensionsv1alpha1.WorkerPool{})).To(BeNil())
+		})
+
+		It("should return the annotations for the configured options", func() {
+			Expect(ReadClusterAutoscalerAnnotations(extensionsv1alpha1.WorkerPool{
+				ClusterAutoscaler: &extensionsv1alpha1.ClusterAutoscalerOptions{
+					ScaleDownUtilizationThreshold: pointer.String("0.25"),
+					MaxNodeProvisionTime:          &metav1.Duration{Duration: 15 * time.Minute},
+					MaxGracefulTerminationSeconds: pointer.Int32(3600),
+				},
+			})).To(Equal(map[string]string{
+				"autoscaler.gardener.cloud/scale-down-utilization-threshold": "0.25",
+				"autoscaler.gardener.cloud/max-node-provision-time":          "15m0s",
+				"autoscaler.gardener.cloud/max-graceful-termination-sec":     "3600",
+			}))
+		})
+	})
+
+	Describe("#deployMachineDeployments", func() {
+		var (
+			ctx        = context.TODO()
+			fakeClient client.Client
+			cluster    *extensionscontroller.Cluster
+			w          *extensionsv1alpha1.Worker
+		)
+
+		BeforeEach(func() {
+			fakeClient = fake.NewClientBuilder().WithScheme(kubernetes.SeedScheme).Build()
+			cluster = &extensionscontroller.Cluster{Shoot: &gardencorev1beta1.Shoot{}}
+			w = &extensionsv1alpha1.Worker{ObjectMeta: metav1.ObjectMeta{Namespace: "shoot--foo--bar"}}
+		})
+
+		It("should add and remove the cluster autoscaler annotations", func() {
+			machineDeployment := &machinev1alpha1.MachineDeployment{ObjectMeta: metav1.ObjectMeta{
+				Name:      "pool-z1",
+				Namespace: w.Namespace,
+				Annotations: map[string]string{
+					"autoscaler.gardener.cloud/scale-down-unneeded-time": "1m0s",
+					"foo": "bar",
+				},
+			}}
+			Expect(fakeClient.Create(ctx, machineDeployment)).To(Succeed())
+
+			Expect(deployMachineDeployments(ctx, log.Log, fakeClient, cluster, w, &machinev1alpha1.MachineDeploymentList{}, worker.MachineDeployments{{
+				Name:                         "pool-z1",
+				Minimum:                      1,
+				Maximum:                      2,
+				ClusterAutoscalerAnnotations: map[string]string{"autoscaler.gardener.cloud/max-node-provision-time": "15m0s"},
+			}}, true)).To(Succeed())
+
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(machineDeployment), machineDeployment)).To(Succeed())
+			Expect(machineDeployment.Annotations).To(Equal(map[string]string{
+				"autoscaler.gardener.cloud/max-node-provision-time": "15m0s",
+				"foo": "bar",
+			}))
+		})
+	})
+
 	Describe("#isMachineControllerStuck", func() {
 		var (
 			machineDeploymentName           = "machine-deployment-1"
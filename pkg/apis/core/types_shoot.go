@@ -317,6 +317,9 @@ type KubernetesDashboard struct {
 const (
 	// KubernetesDashboardAuthModeToken uses token-based mode for auth.
 	KubernetesDashboardAuthModeToken = "token"
+	// KubernetesDashboardAuthModeOIDC uses an OIDC auth proxy in front of the dashboard which authenticates users with
+	// the OIDC provider configured for kube-apiserver (see `.spec.kubernetes.kubeAPIServer.oidcConfig`).
+	KubernetesDashboardAuthModeOIDC = "oidc"
 )
 
 // NginxIngress describes configuration values for the nginx-ingress addon.
@@ -483,6 +486,39 @@ type ClusterAutoscaler struct {
 	NewPodScaleUpDelay *metav1.Duration
 	// MaxEmptyBulkDelete specifies the maximum number of empty nodes that can be deleted at the same time (default: 10).
 	MaxEmptyBulkDelete *int32
+	// SkipNodesWithCustomControllerPods specifies whether CA should never delete nodes with pods owned by custom
+	// controllers, i.e., controllers other than ReplicaSets, Jobs, StatefulSets, and ReplicationControllers (default:
+	// true). Setting it to false allows scaling down nodes whose pods are only blocked by such custom controllers.
+	// This field is only available for Kubernetes versions >= 1.27.
+	SkipNodesWithCustomControllerPods *bool
+	// MaxPodEvictionTime defines how long CA tries to evict a pod when draining a node before it gives up and marks the
+	// scale-down as failed (default: 2m).
+	MaxPodEvictionTime *metav1.Duration
+	// PriorityClassScaleUpDelays specifies how long CA should ignore newly created pods of the given priority classes
+	// before they have to be considered for scale-up. It overrides NewPodScaleUpDelay for pods with a matching
+	// priority class name.
+	PriorityClassScaleUpDelays []PriorityClassScaleUpDelay
+	// CordonNodeBeforeTerminating specifies whether CA should cordon nodes before it starts draining them during
+	// scale-down, i.e., whether no new pods are scheduled to nodes which are about to be removed (default: false).
+	CordonNodeBeforeTerminating *bool
+	// ScaleDownCandidatesPoolRatio defines the ratio of nodes that are considered as additional non-empty candidates
+	// for scale-down when some candidates from the previous iteration are no longer valid (default: 0.1). Lower values
+	// reduce the CPU consumption and the loop latency of CA in large clusters but slow down the scale-down.
+	ScaleDownCandidatesPoolRatio *float64
+	// ScaleDownCandidatesPoolMinCount defines the minimum number of nodes that are considered as additional non-empty
+	// candidates for scale-down when some candidates from the previous iteration are no longer valid (default: 50).
+	// The effective pool size is the maximum of this value and the number of nodes multiplied by
+	// ScaleDownCandidatesPoolRatio.
+	ScaleDownCandidatesPoolMinCount *int32
+}
+
+// PriorityClassScaleUpDelay contains the new pod scale-up delay for pods of a certain priority class.
+type PriorityClassScaleUpDelay struct {
+	// PriorityClassName is the name of the priority class.
+	PriorityClassName string
+	// NewPodScaleUpDelay specifies how long CA should ignore newly created pods of the priority class before they have
+	// to be considered for scale-up.
+	NewPodScaleUpDelay metav1.Duration
 }
 
 // ExpanderMode is type used for Expander values
@@ -1100,8 +1136,48 @@ type Worker struct {
 	MachineControllerManagerSettings *MachineControllerManagerSettings
 	// Sysctls is a map of kernel settings to apply on all machines in this worker pool.
 	Sysctls map[string]string
+	// ClusterAutoscaler contains the cluster autoscaler configurations for the worker pool. They override the global
+	// settings in `.spec.kubernetes.clusterAutoscaler` for this worker pool.
+	ClusterAutoscaler *ClusterAutoscalerOptions
 }
 
+// ClusterAutoscalerOptions contains the cluster autoscaler configurations for a worker pool.
+type ClusterAutoscalerOptions struct {
+	// ScaleDownUtilizationThreshold defines the threshold in fraction (0.0 - 1.0) under which a node is being removed.
+	ScaleDownUtilizationThreshold *float64
+	// ScaleDownUnneededTime defines how long a node should be unneeded before it is eligible for scale down.
+	ScaleDownUnneededTime *metav1.Duration
+	// MaxNodeProvisionTime defines how long CA waits for node to be provisioned.
+	MaxNodeProvisionTime *metav1.Duration
+	// MaxGracefulTerminationSeconds is the number of seconds CA waits for pod termination when trying to scale
+	// down a node of this worker pool.
+	MaxGracefulTerminationSeconds *int32
+	// ZoneSplitStrategy is the strategy used by Gardener for splitting the minimum and maximum of this worker pool over
+	// the machine deployments of its zones. If not set, the split is left to the provider extension.
+	ZoneSplitStrategy *ZoneSplitStrategy
+	// ZoneWeights are the weights of the zones of this worker pool which are considered by the `Weighted` zone split
+	// strategy. Zones without weight have a weight of 1.
+	ZoneWeights map[string]int32
+	// ZoneCaps are the maximum numbers of nodes in the zones of this worker pool which are considered by the `Capped`
+	// zone split strategy.
+	ZoneCaps map[string]int32
+}
+
+// ZoneSplitStrategy is the strategy used for splitting the minimum and maximum of a worker pool over the machine
+// deployments of its zones.
+type ZoneSplitStrategy string
+
+const (
+	// ZoneSplitStrategyEven distributes the minimum and maximum evenly over all zones. Remainders are assigned to the
+	// first zones.
+	ZoneSplitStrategyEven ZoneSplitStrategy = "Even"
+	// ZoneSplitStrategyWeighted distributes the minimum and maximum proportionally to the weights of the zones.
+	ZoneSplitStrategyWeighted ZoneSplitStrategy = "Weighted"
+	// ZoneSplitStrategyCapped distributes the minimum and maximum evenly over all zones, however, the maximum of a zone
+	// never exceeds its cap.
+	ZoneSplitStrategyCapped ZoneSplitStrategy = "Capped"
+)
+
 // MachineControllerManagerSettings contains configurations for different worker-pools. Eg. MachineDrainTimeout, MachineHealthTimeout.
 type MachineControllerManagerSettings struct {
 	// MachineDrainTimeout is the period after which machine is forcefully deleted.
@@ -1280,6 +1356,46 @@ type NodeLocalDNS struct {
 	// Default, if unspecified, is to forward requests for external domains to upstream DNS
 	// +optional
 	DisableForwardToUpstreamDNS *bool `json:"disableForwardToUpstreamDNS,omitempty" protobuf:"varint,4,opt,name=disableForwardToUpstreamDNS"`
+	// Resources are the resource requirements of the node-cache container of node local DNS. Only CPU and memory
+	// resources are supported. Defaults to requests of 25m CPU and 25Mi memory, and a memory limit of 100Mi.
+	Resources *corev1.ResourceRequirements
+	// PriorityClassName is the name of the priority class of the node local DNS pods. The priority class must exist in
+	// the shoot cluster. Defaults to `system-node-critical`.
+	PriorityClassName *string
+	// Tolerations are the tolerations of the node local DNS pods. If set, they replace the default tolerations which
+	// tolerate all taints with the `NoSchedule` and `NoExecute` effects. This allows keeping node local DNS off
+	// specialized worker pools (e.g., Windows or confidential nodes) by not tolerating their taints.
+	Tolerations []corev1.Toleration
+	// EnableDaemonSetEviction specifies whether cluster-autoscaler evicts the node local DNS pods when scaling down a
+	// node. If set, the pods are annotated with `cluster-autoscaler.kubernetes.io/enable-ds-eviction` accordingly.
+	// Otherwise, the default behaviour of cluster-autoscaler applies.
+	EnableDaemonSetEviction *bool
+	// UpstreamServers are the DNS servers (IP addresses, optionally with port) to which node local DNS forwards queries
+	// for non-cluster domains. If set, they replace the resolvers of the node. This is not allowed if forwarding to
+	// upstream DNS is disabled.
+	UpstreamServers []string
+	// CustomZones are additional server blocks which are appended to the Corefile of node local DNS, e.g., to forward
+	// queries for corporate domains to dedicated DNS servers.
+	CustomZones []string
+	// ClusterDNSForward configures how node local DNS forwards queries for the cluster domain and the reverse zones to
+	// the cluster DNS.
+	ClusterDNSForward *NodeLocalDNSForward
+	// UpstreamDNSForward configures how node local DNS forwards queries for non-cluster domains to the upstream DNS.
+	UpstreamDNSForward *NodeLocalDNSForward
+}
+
+// NodeLocalDNSForward contains the settings of the forward plugin of a zone of node local DNS.
+type NodeLocalDNSForward struct {
+	// HealthCheckInterval is the interval in which the health of the DNS servers the queries are forwarded to is
+	// checked. Unhealthy servers are not used until they are healthy again, hence a shorter interval speeds up the
+	// failover for flaky servers. Defaults to `0.5s`.
+	HealthCheckInterval *metav1.Duration
+	// MaxConcurrent is the maximum number of concurrent queries which are forwarded. Queries exceeding it are answered
+	// with `REFUSED`. Defaults to no limit.
+	MaxConcurrent *int32
+	// Policy is the policy for selecting the DNS server a query is forwarded to. Supported values are `random`,
+	// `round_robin`, and `sequential`. Defaults to `random`.
+	Policy *string
 }
 
 const (
@@ -545,6 +545,9 @@ const (
 	IstioSystemNamespace = "istio-system"
 	// KubernetesDashboardNamespace is the kubernetes-dashboard namespace.
 	KubernetesDashboardNamespace = "kubernetes-dashboard"
+	// ClusterAutoscalerRBACNamespace is the namespace in the shoot which is created and owned by Gardener if the
+	// cluster-autoscaler shall store its status ConfigMap and leader election leases outside of kube-system.
+	ClusterAutoscalerRBACNamespace = "gardener-cluster-autoscaler"
 
 	// DefaultSNIIngressNamespace is the default sni ingress namespace.
 	DefaultSNIIngressNamespace = "istio-ingress"
@@ -612,6 +615,35 @@ const (
 	AnnotationShootCloudConfigExecutionMaxDelaySeconds = "shoot.gardener.cloud/cloud-config-execution-max-delay-seconds"
 	// AnnotationCoreDNSRewritingDisabled disables core dns query rewriting even if the corresponding feature gate is enabled.
 	AnnotationCoreDNSRewritingDisabled = "alpha.featuregates.shoot.gardener.cloud/core-dns-rewriting-disabled"
+	// AnnotationNodeLocalDNSHostNetwork enables node-local-dns also for pods running in the host network if set to
+	// "true". It only has an effect if node-local-dns is enabled for the shoot.
+	AnnotationNodeLocalDNSHostNetwork = "alpha.featuregates.shoot.gardener.cloud/node-local-dns-host-network"
+	// AnnotationNodeLocalDNSStaticPod makes node-local-dns run as a static pod managed by the kubelet instead of a
+	// DaemonSet if set to "true". It only has an effect if node-local-dns is enabled for the shoot.
+	AnnotationNodeLocalDNSStaticPod = "alpha.featuregates.shoot.gardener.cloud/node-local-dns-static-pod"
+	// AnnotationNodeLocalDNSForwardToNodeResolvers makes node-local-dns forward queries for non-cluster domains to the
+	// resolvers of the node (e.g., handed out via DHCP) if set to "true". It only has an effect if node-local-dns is
+	// enabled for the shoot.
+	AnnotationNodeLocalDNSForwardToNodeResolvers = "alpha.featuregates.shoot.gardener.cloud/node-local-dns-forward-to-node-resolvers"
+	// AnnotationClusterAutoscalerRBACNamespace is the key for an annotation on a Shoot resource whose value is the name
+	// of a dedicated namespace in the shoot to which the permissions of the cluster-autoscaler for its status ConfigMap
+	// and its leader election leases are bound instead of granting them for kube-system and cluster-wide. The value must
+	// be either `kube-system` or ClusterAutoscalerRBACNamespace.
+	AnnotationClusterAutoscalerRBACNamespace = "alpha.featuregates.shoot.gardener.cloud/cluster-autoscaler-rbac-namespace"
+	// AnnotationEncryptedDataRewriteNamespaceByNamespace is the key for an annotation on a Shoot resource which makes
+	// the ETCD encryption key rotation rewrite the encrypted data one namespace at a time if set to "true".
+	AnnotationEncryptedDataRewriteNamespaceByNamespace = "alpha.featuregates.shoot.gardener.cloud/encrypted-data-rewrite-namespace-by-namespace"
+	// AnnotationEncryptedDataRewritePageSize is the key for an annotation on a Shoot resource whose value is the number
+	// of objects listed at once by the ETCD encryption key rotation. If set, the encrypted data is rewritten page by page
+	// and an interrupted rewrite resumes with the next page.
+	AnnotationEncryptedDataRewritePageSize = "alpha.featuregates.shoot.gardener.cloud/encrypted-data-rewrite-page-size"
+	// AnnotationEncryptedDataRewriteQPS is the key for an annotation on a Shoot resource whose value is the maximum
+	// number of patch requests per second sent to the shoot's API server by the ETCD encryption key rotation.
+	AnnotationEncryptedDataRewriteQPS = "alpha.featuregates.shoot.gardener.cloud/encrypted-data-rewrite-qps"
+	// AnnotationEncryptedDataVerifyAtRest is the key for an annotation on a Shoot resource which makes the ETCD
+	// encryption key rotation verify that the rewritten data is encrypted with the new key by sampling the ciphertext
+	// stored in ETCD if set to "true".
+	AnnotationEncryptedDataVerifyAtRest = "alpha.featuregates.shoot.gardener.cloud/encrypted-data-verify-at-rest"
 
 	// AnnotationSeccompDefaultProfile is the key for an annotation applied to a PodSecurityPolicy which specifies
 	// which is the default seccomp profile to apply to containers.
@@ -634,6 +666,8 @@ const (
 	OperatingSystemConfigFilePathKernelSettings = "/etc/sysctl.d/99-k8s-general.conf"
 	// OperatingSystemConfigFilePathKubeletConfig is a constant for a path to a file in the operating system config that contains the kubelet configuration.
 	OperatingSystemConfigFilePathKubeletConfig = "/var/lib/kubelet/config/kubelet"
+	// OperatingSystemConfigFilePathStaticPodManifests is a constant for a path to a directory in the operating system config that contains the static pod manifests.
+	OperatingSystemConfigFilePathStaticPodManifests = "/etc/kubernetes/manifests"
 	// OperatingSystemConfigUnitNameValitailService is a constant for a unit in the operating system config that contains the valitail service.
 	OperatingSystemConfigUnitNameValitailService = "valitail.service"
 	// OperatingSystemConfigFilePathValitailConfig is a constant for a path to a file in the operating system config that contains the kubelet configuration.
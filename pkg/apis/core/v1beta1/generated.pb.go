@@ -725,10 +725,38 @@ func (m *ClusterAutoscaler) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ClusterAutoscaler proto.InternalMessageInfo
 
+func (m *ClusterAutoscalerOptions) Reset()      { *m = ClusterAutoscalerOptions{} }
+func (*ClusterAutoscalerOptions) ProtoMessage() {}
+func (*ClusterAutoscalerOptions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca37af0df9a5bbd2, []int{24}
+}
+func (m *ClusterAutoscalerOptions) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ClusterAutoscalerOptions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ClusterAutoscalerOptions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClusterAutoscalerOptions.Merge(m, src)
+}
+func (m *ClusterAutoscalerOptions) XXX_Size() int {
+	return m.Size()
+}
+func (m *ClusterAutoscalerOptions) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClusterAutoscalerOptions.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClusterAutoscalerOptions proto.InternalMessageInfo
+
 func (m *Condition) Reset()      { *m = Condition{} }
 func (*Condition) ProtoMessage() {}
 func (*Condition) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{24}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{25}
 }
 func (m *Condition) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -756,7 +784,7 @@ var xxx_messageInfo_Condition proto.InternalMessageInfo
 func (m *ContainerRuntime) Reset()      { *m = ContainerRuntime{} }
 func (*ContainerRuntime) ProtoMessage() {}
 func (*ContainerRuntime) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{25}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{26}
 }
 func (m *ContainerRuntime) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -784,7 +812,7 @@ var xxx_messageInfo_ContainerRuntime proto.InternalMessageInfo
 func (m *ControlPlane) Reset()      { *m = ControlPlane{} }
 func (*ControlPlane) ProtoMessage() {}
 func (*ControlPlane) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{26}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{27}
 }
 func (m *ControlPlane) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -812,7 +840,7 @@ var xxx_messageInfo_ControlPlane proto.InternalMessageInfo
 func (m *ControllerDeployment) Reset()      { *m = ControllerDeployment{} }
 func (*ControllerDeployment) ProtoMessage() {}
 func (*ControllerDeployment) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{27}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{28}
 }
 func (m *ControllerDeployment) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -840,7 +868,7 @@ var xxx_messageInfo_ControllerDeployment proto.InternalMessageInfo
 func (m *ControllerDeploymentList) Reset()      { *m = ControllerDeploymentList{} }
 func (*ControllerDeploymentList) ProtoMessage() {}
 func (*ControllerDeploymentList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{28}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{29}
 }
 func (m *ControllerDeploymentList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -868,7 +896,7 @@ var xxx_messageInfo_ControllerDeploymentList proto.InternalMessageInfo
 func (m *ControllerInstallation) Reset()      { *m = ControllerInstallation{} }
 func (*ControllerInstallation) ProtoMessage() {}
 func (*ControllerInstallation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{29}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{30}
 }
 func (m *ControllerInstallation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -896,7 +924,7 @@ var xxx_messageInfo_ControllerInstallation proto.InternalMessageInfo
 func (m *ControllerInstallationList) Reset()      { *m = ControllerInstallationList{} }
 func (*ControllerInstallationList) ProtoMessage() {}
 func (*ControllerInstallationList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{30}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{31}
 }
 func (m *ControllerInstallationList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -924,7 +952,7 @@ var xxx_messageInfo_ControllerInstallationList proto.InternalMessageInfo
 func (m *ControllerInstallationSpec) Reset()      { *m = ControllerInstallationSpec{} }
 func (*ControllerInstallationSpec) ProtoMessage() {}
 func (*ControllerInstallationSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{31}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{32}
 }
 func (m *ControllerInstallationSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -952,7 +980,7 @@ var xxx_messageInfo_ControllerInstallationSpec proto.InternalMessageInfo
 func (m *ControllerInstallationStatus) Reset()      { *m = ControllerInstallationStatus{} }
 func (*ControllerInstallationStatus) ProtoMessage() {}
 func (*ControllerInstallationStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{32}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{33}
 }
 func (m *ControllerInstallationStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -980,7 +1008,7 @@ var xxx_messageInfo_ControllerInstallationStatus proto.InternalMessageInfo
 func (m *ControllerRegistration) Reset()      { *m = ControllerRegistration{} }
 func (*ControllerRegistration) ProtoMessage() {}
 func (*ControllerRegistration) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{33}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{34}
 }
 func (m *ControllerRegistration) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1008,7 +1036,7 @@ var xxx_messageInfo_ControllerRegistration proto.InternalMessageInfo
 func (m *ControllerRegistrationDeployment) Reset()      { *m = ControllerRegistrationDeployment{} }
 func (*ControllerRegistrationDeployment) ProtoMessage() {}
 func (*ControllerRegistrationDeployment) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{34}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{35}
 }
 func (m *ControllerRegistrationDeployment) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1036,7 +1064,7 @@ var xxx_messageInfo_ControllerRegistrationDeployment proto.InternalMessageInfo
 func (m *ControllerRegistrationList) Reset()      { *m = ControllerRegistrationList{} }
 func (*ControllerRegistrationList) ProtoMessage() {}
 func (*ControllerRegistrationList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{35}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{36}
 }
 func (m *ControllerRegistrationList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1064,7 +1092,7 @@ var xxx_messageInfo_ControllerRegistrationList proto.InternalMessageInfo
 func (m *ControllerRegistrationSpec) Reset()      { *m = ControllerRegistrationSpec{} }
 func (*ControllerRegistrationSpec) ProtoMessage() {}
 func (*ControllerRegistrationSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{36}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{37}
 }
 func (m *ControllerRegistrationSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1092,7 +1120,7 @@ var xxx_messageInfo_ControllerRegistrationSpec proto.InternalMessageInfo
 func (m *ControllerResource) Reset()      { *m = ControllerResource{} }
 func (*ControllerResource) ProtoMessage() {}
 func (*ControllerResource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{37}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{38}
 }
 func (m *ControllerResource) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1120,7 +1148,7 @@ var xxx_messageInfo_ControllerResource proto.InternalMessageInfo
 func (m *ControllerResourceLifecycle) Reset()      { *m = ControllerResourceLifecycle{} }
 func (*ControllerResourceLifecycle) ProtoMessage() {}
 func (*ControllerResourceLifecycle) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{38}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{39}
 }
 func (m *ControllerResourceLifecycle) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1148,7 +1176,7 @@ var xxx_messageInfo_ControllerResourceLifecycle proto.InternalMessageInfo
 func (m *CoreDNS) Reset()      { *m = CoreDNS{} }
 func (*CoreDNS) ProtoMessage() {}
 func (*CoreDNS) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{39}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{40}
 }
 func (m *CoreDNS) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1176,7 +1204,7 @@ var xxx_messageInfo_CoreDNS proto.InternalMessageInfo
 func (m *CoreDNSAutoscaling) Reset()      { *m = CoreDNSAutoscaling{} }
 func (*CoreDNSAutoscaling) ProtoMessage() {}
 func (*CoreDNSAutoscaling) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{40}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{41}
 }
 func (m *CoreDNSAutoscaling) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1204,7 +1232,7 @@ var xxx_messageInfo_CoreDNSAutoscaling proto.InternalMessageInfo
 func (m *CoreDNSRewriting) Reset()      { *m = CoreDNSRewriting{} }
 func (*CoreDNSRewriting) ProtoMessage() {}
 func (*CoreDNSRewriting) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{41}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{42}
 }
 func (m *CoreDNSRewriting) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1232,7 +1260,7 @@ var xxx_messageInfo_CoreDNSRewriting proto.InternalMessageInfo
 func (m *DNS) Reset()      { *m = DNS{} }
 func (*DNS) ProtoMessage() {}
 func (*DNS) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{42}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{43}
 }
 func (m *DNS) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1260,7 +1288,7 @@ var xxx_messageInfo_DNS proto.InternalMessageInfo
 func (m *DNSIncludeExclude) Reset()      { *m = DNSIncludeExclude{} }
 func (*DNSIncludeExclude) ProtoMessage() {}
 func (*DNSIncludeExclude) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{43}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{44}
 }
 func (m *DNSIncludeExclude) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1288,7 +1316,7 @@ var xxx_messageInfo_DNSIncludeExclude proto.InternalMessageInfo
 func (m *DNSProvider) Reset()      { *m = DNSProvider{} }
 func (*DNSProvider) ProtoMessage() {}
 func (*DNSProvider) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{44}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{45}
 }
 func (m *DNSProvider) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1316,7 +1344,7 @@ var xxx_messageInfo_DNSProvider proto.InternalMessageInfo
 func (m *DataVolume) Reset()      { *m = DataVolume{} }
 func (*DataVolume) ProtoMessage() {}
 func (*DataVolume) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{45}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{46}
 }
 func (m *DataVolume) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1344,7 +1372,7 @@ var xxx_messageInfo_DataVolume proto.InternalMessageInfo
 func (m *DeploymentRef) Reset()      { *m = DeploymentRef{} }
 func (*DeploymentRef) ProtoMessage() {}
 func (*DeploymentRef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{46}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{47}
 }
 func (m *DeploymentRef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1372,7 +1400,7 @@ var xxx_messageInfo_DeploymentRef proto.InternalMessageInfo
 func (m *ETCDEncryptionKeyRotation) Reset()      { *m = ETCDEncryptionKeyRotation{} }
 func (*ETCDEncryptionKeyRotation) ProtoMessage() {}
 func (*ETCDEncryptionKeyRotation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{47}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{48}
 }
 func (m *ETCDEncryptionKeyRotation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1400,7 +1428,7 @@ var xxx_messageInfo_ETCDEncryptionKeyRotation proto.InternalMessageInfo
 func (m *ExpirableVersion) Reset()      { *m = ExpirableVersion{} }
 func (*ExpirableVersion) ProtoMessage() {}
 func (*ExpirableVersion) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{48}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{49}
 }
 func (m *ExpirableVersion) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1428,7 +1456,7 @@ var xxx_messageInfo_ExpirableVersion proto.InternalMessageInfo
 func (m *ExposureClass) Reset()      { *m = ExposureClass{} }
 func (*ExposureClass) ProtoMessage() {}
 func (*ExposureClass) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{49}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{50}
 }
 func (m *ExposureClass) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1456,7 +1484,7 @@ var xxx_messageInfo_ExposureClass proto.InternalMessageInfo
 func (m *ExposureClassList) Reset()      { *m = ExposureClassList{} }
 func (*ExposureClassList) ProtoMessage() {}
 func (*ExposureClassList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{50}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{51}
 }
 func (m *ExposureClassList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1484,7 +1512,7 @@ var xxx_messageInfo_ExposureClassList proto.InternalMessageInfo
 func (m *ExposureClassScheduling) Reset()      { *m = ExposureClassScheduling{} }
 func (*ExposureClassScheduling) ProtoMessage() {}
 func (*ExposureClassScheduling) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{51}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{52}
 }
 func (m *ExposureClassScheduling) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1512,7 +1540,7 @@ var xxx_messageInfo_ExposureClassScheduling proto.InternalMessageInfo
 func (m *Extension) Reset()      { *m = Extension{} }
 func (*Extension) ProtoMessage() {}
 func (*Extension) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{52}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{53}
 }
 func (m *Extension) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1540,7 +1568,7 @@ var xxx_messageInfo_Extension proto.InternalMessageInfo
 func (m *ExtensionResourceState) Reset()      { *m = ExtensionResourceState{} }
 func (*ExtensionResourceState) ProtoMessage() {}
 func (*ExtensionResourceState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{53}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{54}
 }
 func (m *ExtensionResourceState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1568,7 +1596,7 @@ var xxx_messageInfo_ExtensionResourceState proto.InternalMessageInfo
 func (m *FailureTolerance) Reset()      { *m = FailureTolerance{} }
 func (*FailureTolerance) ProtoMessage() {}
 func (*FailureTolerance) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{54}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{55}
 }
 func (m *FailureTolerance) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1596,7 +1624,7 @@ var xxx_messageInfo_FailureTolerance proto.InternalMessageInfo
 func (m *Gardener) Reset()      { *m = Gardener{} }
 func (*Gardener) ProtoMessage() {}
 func (*Gardener) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{55}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{56}
 }
 func (m *Gardener) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1624,7 +1652,7 @@ var xxx_messageInfo_Gardener proto.InternalMessageInfo
 func (m *GardenerResourceData) Reset()      { *m = GardenerResourceData{} }
 func (*GardenerResourceData) ProtoMessage() {}
 func (*GardenerResourceData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{56}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{57}
 }
 func (m *GardenerResourceData) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1652,7 +1680,7 @@ var xxx_messageInfo_GardenerResourceData proto.InternalMessageInfo
 func (m *Hibernation) Reset()      { *m = Hibernation{} }
 func (*Hibernation) ProtoMessage() {}
 func (*Hibernation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{57}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{58}
 }
 func (m *Hibernation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1680,7 +1708,7 @@ var xxx_messageInfo_Hibernation proto.InternalMessageInfo
 func (m *HibernationSchedule) Reset()      { *m = HibernationSchedule{} }
 func (*HibernationSchedule) ProtoMessage() {}
 func (*HibernationSchedule) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{58}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{59}
 }
 func (m *HibernationSchedule) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1708,7 +1736,7 @@ var xxx_messageInfo_HibernationSchedule proto.InternalMessageInfo
 func (m *HighAvailability) Reset()      { *m = HighAvailability{} }
 func (*HighAvailability) ProtoMessage() {}
 func (*HighAvailability) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{59}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{60}
 }
 func (m *HighAvailability) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1736,7 +1764,7 @@ var xxx_messageInfo_HighAvailability proto.InternalMessageInfo
 func (m *HorizontalPodAutoscalerConfig) Reset()      { *m = HorizontalPodAutoscalerConfig{} }
 func (*HorizontalPodAutoscalerConfig) ProtoMessage() {}
 func (*HorizontalPodAutoscalerConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{60}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{61}
 }
 func (m *HorizontalPodAutoscalerConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1764,7 +1792,7 @@ var xxx_messageInfo_HorizontalPodAutoscalerConfig proto.InternalMessageInfo
 func (m *Ingress) Reset()      { *m = Ingress{} }
 func (*Ingress) ProtoMessage() {}
 func (*Ingress) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{61}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{62}
 }
 func (m *Ingress) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1792,7 +1820,7 @@ var xxx_messageInfo_Ingress proto.InternalMessageInfo
 func (m *IngressController) Reset()      { *m = IngressController{} }
 func (*IngressController) ProtoMessage() {}
 func (*IngressController) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{62}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{63}
 }
 func (m *IngressController) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1820,7 +1848,7 @@ var xxx_messageInfo_IngressController proto.InternalMessageInfo
 func (m *InternalSecret) Reset()      { *m = InternalSecret{} }
 func (*InternalSecret) ProtoMessage() {}
 func (*InternalSecret) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{63}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{64}
 }
 func (m *InternalSecret) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1848,7 +1876,7 @@ var xxx_messageInfo_InternalSecret proto.InternalMessageInfo
 func (m *InternalSecretList) Reset()      { *m = InternalSecretList{} }
 func (*InternalSecretList) ProtoMessage() {}
 func (*InternalSecretList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{64}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{65}
 }
 func (m *InternalSecretList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1876,7 +1904,7 @@ var xxx_messageInfo_InternalSecretList proto.InternalMessageInfo
 func (m *KubeAPIServerConfig) Reset()      { *m = KubeAPIServerConfig{} }
 func (*KubeAPIServerConfig) ProtoMessage() {}
 func (*KubeAPIServerConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{65}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{66}
 }
 func (m *KubeAPIServerConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1904,7 +1932,7 @@ var xxx_messageInfo_KubeAPIServerConfig proto.InternalMessageInfo
 func (m *KubeControllerManagerConfig) Reset()      { *m = KubeControllerManagerConfig{} }
 func (*KubeControllerManagerConfig) ProtoMessage() {}
 func (*KubeControllerManagerConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{66}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{67}
 }
 func (m *KubeControllerManagerConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1932,7 +1960,7 @@ var xxx_messageInfo_KubeControllerManagerConfig proto.InternalMessageInfo
 func (m *KubeProxyConfig) Reset()      { *m = KubeProxyConfig{} }
 func (*KubeProxyConfig) ProtoMessage() {}
 func (*KubeProxyConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{67}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{68}
 }
 func (m *KubeProxyConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1960,7 +1988,7 @@ var xxx_messageInfo_KubeProxyConfig proto.InternalMessageInfo
 func (m *KubeSchedulerConfig) Reset()      { *m = KubeSchedulerConfig{} }
 func (*KubeSchedulerConfig) ProtoMessage() {}
 func (*KubeSchedulerConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{68}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{69}
 }
 func (m *KubeSchedulerConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1988,7 +2016,7 @@ var xxx_messageInfo_KubeSchedulerConfig proto.InternalMessageInfo
 func (m *KubeletConfig) Reset()      { *m = KubeletConfig{} }
 func (*KubeletConfig) ProtoMessage() {}
 func (*KubeletConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{69}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{70}
 }
 func (m *KubeletConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2016,7 +2044,7 @@ var xxx_messageInfo_KubeletConfig proto.InternalMessageInfo
 func (m *KubeletConfigEviction) Reset()      { *m = KubeletConfigEviction{} }
 func (*KubeletConfigEviction) ProtoMessage() {}
 func (*KubeletConfigEviction) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{70}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{71}
 }
 func (m *KubeletConfigEviction) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2044,7 +2072,7 @@ var xxx_messageInfo_KubeletConfigEviction proto.InternalMessageInfo
 func (m *KubeletConfigEvictionMinimumReclaim) Reset()      { *m = KubeletConfigEvictionMinimumReclaim{} }
 func (*KubeletConfigEvictionMinimumReclaim) ProtoMessage() {}
 func (*KubeletConfigEvictionMinimumReclaim) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{71}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{72}
 }
 func (m *KubeletConfigEvictionMinimumReclaim) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2072,7 +2100,7 @@ var xxx_messageInfo_KubeletConfigEvictionMinimumReclaim proto.InternalMessageInf
 func (m *KubeletConfigEvictionSoftGracePeriod) Reset()      { *m = KubeletConfigEvictionSoftGracePeriod{} }
 func (*KubeletConfigEvictionSoftGracePeriod) ProtoMessage() {}
 func (*KubeletConfigEvictionSoftGracePeriod) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{72}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{73}
 }
 func (m *KubeletConfigEvictionSoftGracePeriod) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2100,7 +2128,7 @@ var xxx_messageInfo_KubeletConfigEvictionSoftGracePeriod proto.InternalMessageIn
 func (m *KubeletConfigReserved) Reset()      { *m = KubeletConfigReserved{} }
 func (*KubeletConfigReserved) ProtoMessage() {}
 func (*KubeletConfigReserved) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{73}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{74}
 }
 func (m *KubeletConfigReserved) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2128,7 +2156,7 @@ var xxx_messageInfo_KubeletConfigReserved proto.InternalMessageInfo
 func (m *Kubernetes) Reset()      { *m = Kubernetes{} }
 func (*Kubernetes) ProtoMessage() {}
 func (*Kubernetes) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{74}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{75}
 }
 func (m *Kubernetes) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2156,7 +2184,7 @@ var xxx_messageInfo_Kubernetes proto.InternalMessageInfo
 func (m *KubernetesConfig) Reset()      { *m = KubernetesConfig{} }
 func (*KubernetesConfig) ProtoMessage() {}
 func (*KubernetesConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{75}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{76}
 }
 func (m *KubernetesConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2184,7 +2212,7 @@ var xxx_messageInfo_KubernetesConfig proto.InternalMessageInfo
 func (m *KubernetesDashboard) Reset()      { *m = KubernetesDashboard{} }
 func (*KubernetesDashboard) ProtoMessage() {}
 func (*KubernetesDashboard) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{76}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{77}
 }
 func (m *KubernetesDashboard) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2212,7 +2240,7 @@ var xxx_messageInfo_KubernetesDashboard proto.InternalMessageInfo
 func (m *KubernetesSettings) Reset()      { *m = KubernetesSettings{} }
 func (*KubernetesSettings) ProtoMessage() {}
 func (*KubernetesSettings) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{77}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{78}
 }
 func (m *KubernetesSettings) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2240,7 +2268,7 @@ var xxx_messageInfo_KubernetesSettings proto.InternalMessageInfo
 func (m *LastError) Reset()      { *m = LastError{} }
 func (*LastError) ProtoMessage() {}
 func (*LastError) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{78}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{79}
 }
 func (m *LastError) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2268,7 +2296,7 @@ var xxx_messageInfo_LastError proto.InternalMessageInfo
 func (m *LastMaintenance) Reset()      { *m = LastMaintenance{} }
 func (*LastMaintenance) ProtoMessage() {}
 func (*LastMaintenance) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{79}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{80}
 }
 func (m *LastMaintenance) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2296,7 +2324,7 @@ var xxx_messageInfo_LastMaintenance proto.InternalMessageInfo
 func (m *LastOperation) Reset()      { *m = LastOperation{} }
 func (*LastOperation) ProtoMessage() {}
 func (*LastOperation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{80}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{81}
 }
 func (m *LastOperation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2324,7 +2352,7 @@ var xxx_messageInfo_LastOperation proto.InternalMessageInfo
 func (m *Machine) Reset()      { *m = Machine{} }
 func (*Machine) ProtoMessage() {}
 func (*Machine) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{81}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{82}
 }
 func (m *Machine) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2352,7 +2380,7 @@ var xxx_messageInfo_Machine proto.InternalMessageInfo
 func (m *MachineControllerManagerSettings) Reset()      { *m = MachineControllerManagerSettings{} }
 func (*MachineControllerManagerSettings) ProtoMessage() {}
 func (*MachineControllerManagerSettings) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{82}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{83}
 }
 func (m *MachineControllerManagerSettings) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2380,7 +2408,7 @@ var xxx_messageInfo_MachineControllerManagerSettings proto.InternalMessageInfo
 func (m *MachineImage) Reset()      { *m = MachineImage{} }
 func (*MachineImage) ProtoMessage() {}
 func (*MachineImage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{83}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{84}
 }
 func (m *MachineImage) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2408,7 +2436,7 @@ var xxx_messageInfo_MachineImage proto.InternalMessageInfo
 func (m *MachineImageVersion) Reset()      { *m = MachineImageVersion{} }
 func (*MachineImageVersion) ProtoMessage() {}
 func (*MachineImageVersion) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{84}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{85}
 }
 func (m *MachineImageVersion) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2436,7 +2464,7 @@ var xxx_messageInfo_MachineImageVersion proto.InternalMessageInfo
 func (m *MachineType) Reset()      { *m = MachineType{} }
 func (*MachineType) ProtoMessage() {}
 func (*MachineType) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{85}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{86}
 }
 func (m *MachineType) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2464,7 +2492,7 @@ var xxx_messageInfo_MachineType proto.InternalMessageInfo
 func (m *MachineTypeStorage) Reset()      { *m = MachineTypeStorage{} }
 func (*MachineTypeStorage) ProtoMessage() {}
 func (*MachineTypeStorage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{86}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{87}
 }
 func (m *MachineTypeStorage) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2492,7 +2520,7 @@ var xxx_messageInfo_MachineTypeStorage proto.InternalMessageInfo
 func (m *Maintenance) Reset()      { *m = Maintenance{} }
 func (*Maintenance) ProtoMessage() {}
 func (*Maintenance) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{87}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{88}
 }
 func (m *Maintenance) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2520,7 +2548,7 @@ var xxx_messageInfo_Maintenance proto.InternalMessageInfo
 func (m *MaintenanceAutoUpdate) Reset()      { *m = MaintenanceAutoUpdate{} }
 func (*MaintenanceAutoUpdate) ProtoMessage() {}
 func (*MaintenanceAutoUpdate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{88}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{89}
 }
 func (m *MaintenanceAutoUpdate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2548,7 +2576,7 @@ var xxx_messageInfo_MaintenanceAutoUpdate proto.InternalMessageInfo
 func (m *MaintenanceTimeWindow) Reset()      { *m = MaintenanceTimeWindow{} }
 func (*MaintenanceTimeWindow) ProtoMessage() {}
 func (*MaintenanceTimeWindow) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{89}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{90}
 }
 func (m *MaintenanceTimeWindow) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2576,7 +2604,7 @@ var xxx_messageInfo_MaintenanceTimeWindow proto.InternalMessageInfo
 func (m *MemorySwapConfiguration) Reset()      { *m = MemorySwapConfiguration{} }
 func (*MemorySwapConfiguration) ProtoMessage() {}
 func (*MemorySwapConfiguration) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{90}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{91}
 }
 func (m *MemorySwapConfiguration) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2604,7 +2632,7 @@ var xxx_messageInfo_MemorySwapConfiguration proto.InternalMessageInfo
 func (m *Monitoring) Reset()      { *m = Monitoring{} }
 func (*Monitoring) ProtoMessage() {}
 func (*Monitoring) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{91}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{92}
 }
 func (m *Monitoring) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2632,7 +2660,7 @@ var xxx_messageInfo_Monitoring proto.InternalMessageInfo
 func (m *NamedResourceReference) Reset()      { *m = NamedResourceReference{} }
 func (*NamedResourceReference) ProtoMessage() {}
 func (*NamedResourceReference) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{92}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{93}
 }
 func (m *NamedResourceReference) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2660,7 +2688,7 @@ var xxx_messageInfo_NamedResourceReference proto.InternalMessageInfo
 func (m *Networking) Reset()      { *m = Networking{} }
 func (*Networking) ProtoMessage() {}
 func (*Networking) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{93}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{94}
 }
 func (m *Networking) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2688,7 +2716,7 @@ var xxx_messageInfo_Networking proto.InternalMessageInfo
 func (m *NginxIngress) Reset()      { *m = NginxIngress{} }
 func (*NginxIngress) ProtoMessage() {}
 func (*NginxIngress) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{94}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{95}
 }
 func (m *NginxIngress) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2716,7 +2744,7 @@ var xxx_messageInfo_NginxIngress proto.InternalMessageInfo
 func (m *NodeLocalDNS) Reset()      { *m = NodeLocalDNS{} }
 func (*NodeLocalDNS) ProtoMessage() {}
 func (*NodeLocalDNS) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{95}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{96}
 }
 func (m *NodeLocalDNS) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2741,10 +2769,38 @@ func (m *NodeLocalDNS) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_NodeLocalDNS proto.InternalMessageInfo
 
+func (m *NodeLocalDNSForward) Reset()      { *m = NodeLocalDNSForward{} }
+func (*NodeLocalDNSForward) ProtoMessage() {}
+func (*NodeLocalDNSForward) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca37af0df9a5bbd2, []int{97}
+}
+func (m *NodeLocalDNSForward) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NodeLocalDNSForward) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *NodeLocalDNSForward) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NodeLocalDNSForward.Merge(m, src)
+}
+func (m *NodeLocalDNSForward) XXX_Size() int {
+	return m.Size()
+}
+func (m *NodeLocalDNSForward) XXX_DiscardUnknown() {
+	xxx_messageInfo_NodeLocalDNSForward.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NodeLocalDNSForward proto.InternalMessageInfo
+
 func (m *OIDCConfig) Reset()      { *m = OIDCConfig{} }
 func (*OIDCConfig) ProtoMessage() {}
 func (*OIDCConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{96}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{98}
 }
 func (m *OIDCConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2772,7 +2828,7 @@ var xxx_messageInfo_OIDCConfig proto.InternalMessageInfo
 func (m *ObservabilityRotation) Reset()      { *m = ObservabilityRotation{} }
 func (*ObservabilityRotation) ProtoMessage() {}
 func (*ObservabilityRotation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{97}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{99}
 }
 func (m *ObservabilityRotation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2800,7 +2856,7 @@ var xxx_messageInfo_ObservabilityRotation proto.InternalMessageInfo
 func (m *OpenIDConnectClientAuthentication) Reset()      { *m = OpenIDConnectClientAuthentication{} }
 func (*OpenIDConnectClientAuthentication) ProtoMessage() {}
 func (*OpenIDConnectClientAuthentication) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{98}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{100}
 }
 func (m *OpenIDConnectClientAuthentication) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2825,10 +2881,38 @@ func (m *OpenIDConnectClientAuthentication) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_OpenIDConnectClientAuthentication proto.InternalMessageInfo
 
+func (m *PriorityClassScaleUpDelay) Reset()      { *m = PriorityClassScaleUpDelay{} }
+func (*PriorityClassScaleUpDelay) ProtoMessage() {}
+func (*PriorityClassScaleUpDelay) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca37af0df9a5bbd2, []int{101}
+}
+func (m *PriorityClassScaleUpDelay) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PriorityClassScaleUpDelay) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *PriorityClassScaleUpDelay) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PriorityClassScaleUpDelay.Merge(m, src)
+}
+func (m *PriorityClassScaleUpDelay) XXX_Size() int {
+	return m.Size()
+}
+func (m *PriorityClassScaleUpDelay) XXX_DiscardUnknown() {
+	xxx_messageInfo_PriorityClassScaleUpDelay.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PriorityClassScaleUpDelay proto.InternalMessageInfo
+
 func (m *Project) Reset()      { *m = Project{} }
 func (*Project) ProtoMessage() {}
 func (*Project) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{99}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{102}
 }
 func (m *Project) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2856,7 +2940,7 @@ var xxx_messageInfo_Project proto.InternalMessageInfo
 func (m *ProjectList) Reset()      { *m = ProjectList{} }
 func (*ProjectList) ProtoMessage() {}
 func (*ProjectList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{100}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{103}
 }
 func (m *ProjectList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2884,7 +2968,7 @@ var xxx_messageInfo_ProjectList proto.InternalMessageInfo
 func (m *ProjectMember) Reset()      { *m = ProjectMember{} }
 func (*ProjectMember) ProtoMessage() {}
 func (*ProjectMember) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{101}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{104}
 }
 func (m *ProjectMember) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2912,7 +2996,7 @@ var xxx_messageInfo_ProjectMember proto.InternalMessageInfo
 func (m *ProjectSpec) Reset()      { *m = ProjectSpec{} }
 func (*ProjectSpec) ProtoMessage() {}
 func (*ProjectSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{102}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{105}
 }
 func (m *ProjectSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2940,7 +3024,7 @@ var xxx_messageInfo_ProjectSpec proto.InternalMessageInfo
 func (m *ProjectStatus) Reset()      { *m = ProjectStatus{} }
 func (*ProjectStatus) ProtoMessage() {}
 func (*ProjectStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{103}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{106}
 }
 func (m *ProjectStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2968,7 +3052,7 @@ var xxx_messageInfo_ProjectStatus proto.InternalMessageInfo
 func (m *ProjectTolerations) Reset()      { *m = ProjectTolerations{} }
 func (*ProjectTolerations) ProtoMessage() {}
 func (*ProjectTolerations) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{104}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{107}
 }
 func (m *ProjectTolerations) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2996,7 +3080,7 @@ var xxx_messageInfo_ProjectTolerations proto.InternalMessageInfo
 func (m *Provider) Reset()      { *m = Provider{} }
 func (*Provider) ProtoMessage() {}
 func (*Provider) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{105}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{108}
 }
 func (m *Provider) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3024,7 +3108,7 @@ var xxx_messageInfo_Provider proto.InternalMessageInfo
 func (m *Quota) Reset()      { *m = Quota{} }
 func (*Quota) ProtoMessage() {}
 func (*Quota) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{106}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{109}
 }
 func (m *Quota) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3052,7 +3136,7 @@ var xxx_messageInfo_Quota proto.InternalMessageInfo
 func (m *QuotaList) Reset()      { *m = QuotaList{} }
 func (*QuotaList) ProtoMessage() {}
 func (*QuotaList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{107}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{110}
 }
 func (m *QuotaList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3080,7 +3164,7 @@ var xxx_messageInfo_QuotaList proto.InternalMessageInfo
 func (m *QuotaSpec) Reset()      { *m = QuotaSpec{} }
 func (*QuotaSpec) ProtoMessage() {}
 func (*QuotaSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{108}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{111}
 }
 func (m *QuotaSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3108,7 +3192,7 @@ var xxx_messageInfo_QuotaSpec proto.InternalMessageInfo
 func (m *Region) Reset()      { *m = Region{} }
 func (*Region) ProtoMessage() {}
 func (*Region) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{109}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{112}
 }
 func (m *Region) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3136,7 +3220,7 @@ var xxx_messageInfo_Region proto.InternalMessageInfo
 func (m *ResourceData) Reset()      { *m = ResourceData{} }
 func (*ResourceData) ProtoMessage() {}
 func (*ResourceData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{110}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{113}
 }
 func (m *ResourceData) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3164,7 +3248,7 @@ var xxx_messageInfo_ResourceData proto.InternalMessageInfo
 func (m *ResourceWatchCacheSize) Reset()      { *m = ResourceWatchCacheSize{} }
 func (*ResourceWatchCacheSize) ProtoMessage() {}
 func (*ResourceWatchCacheSize) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{111}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{114}
 }
 func (m *ResourceWatchCacheSize) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3192,7 +3276,7 @@ var xxx_messageInfo_ResourceWatchCacheSize proto.InternalMessageInfo
 func (m *SSHAccess) Reset()      { *m = SSHAccess{} }
 func (*SSHAccess) ProtoMessage() {}
 func (*SSHAccess) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{112}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{115}
 }
 func (m *SSHAccess) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3220,7 +3304,7 @@ var xxx_messageInfo_SSHAccess proto.InternalMessageInfo
 func (m *SecretBinding) Reset()      { *m = SecretBinding{} }
 func (*SecretBinding) ProtoMessage() {}
 func (*SecretBinding) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{113}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{116}
 }
 func (m *SecretBinding) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3248,7 +3332,7 @@ var xxx_messageInfo_SecretBinding proto.InternalMessageInfo
 func (m *SecretBindingList) Reset()      { *m = SecretBindingList{} }
 func (*SecretBindingList) ProtoMessage() {}
 func (*SecretBindingList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{114}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{117}
 }
 func (m *SecretBindingList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3276,7 +3360,7 @@ var xxx_messageInfo_SecretBindingList proto.InternalMessageInfo
 func (m *SecretBindingProvider) Reset()      { *m = SecretBindingProvider{} }
 func (*SecretBindingProvider) ProtoMessage() {}
 func (*SecretBindingProvider) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{115}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{118}
 }
 func (m *SecretBindingProvider) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3304,7 +3388,7 @@ var xxx_messageInfo_SecretBindingProvider proto.InternalMessageInfo
 func (m *Seed) Reset()      { *m = Seed{} }
 func (*Seed) ProtoMessage() {}
 func (*Seed) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{116}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{119}
 }
 func (m *Seed) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3332,7 +3416,7 @@ var xxx_messageInfo_Seed proto.InternalMessageInfo
 func (m *SeedBackup) Reset()      { *m = SeedBackup{} }
 func (*SeedBackup) ProtoMessage() {}
 func (*SeedBackup) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{117}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{120}
 }
 func (m *SeedBackup) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3360,7 +3444,7 @@ var xxx_messageInfo_SeedBackup proto.InternalMessageInfo
 func (m *SeedDNS) Reset()      { *m = SeedDNS{} }
 func (*SeedDNS) ProtoMessage() {}
 func (*SeedDNS) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{118}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{121}
 }
 func (m *SeedDNS) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3388,7 +3472,7 @@ var xxx_messageInfo_SeedDNS proto.InternalMessageInfo
 func (m *SeedDNSProvider) Reset()      { *m = SeedDNSProvider{} }
 func (*SeedDNSProvider) ProtoMessage() {}
 func (*SeedDNSProvider) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{119}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{122}
 }
 func (m *SeedDNSProvider) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3416,7 +3500,7 @@ var xxx_messageInfo_SeedDNSProvider proto.InternalMessageInfo
 func (m *SeedList) Reset()      { *m = SeedList{} }
 func (*SeedList) ProtoMessage() {}
 func (*SeedList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{120}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{123}
 }
 func (m *SeedList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3444,7 +3528,7 @@ var xxx_messageInfo_SeedList proto.InternalMessageInfo
 func (m *SeedNetworks) Reset()      { *m = SeedNetworks{} }
 func (*SeedNetworks) ProtoMessage() {}
 func (*SeedNetworks) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{121}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{124}
 }
 func (m *SeedNetworks) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3472,7 +3556,7 @@ var xxx_messageInfo_SeedNetworks proto.InternalMessageInfo
 func (m *SeedProvider) Reset()      { *m = SeedProvider{} }
 func (*SeedProvider) ProtoMessage() {}
 func (*SeedProvider) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{122}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{125}
 }
 func (m *SeedProvider) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3500,7 +3584,7 @@ var xxx_messageInfo_SeedProvider proto.InternalMessageInfo
 func (m *SeedSelector) Reset()      { *m = SeedSelector{} }
 func (*SeedSelector) ProtoMessage() {}
 func (*SeedSelector) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{123}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{126}
 }
 func (m *SeedSelector) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3528,7 +3612,7 @@ var xxx_messageInfo_SeedSelector proto.InternalMessageInfo
 func (m *SeedSettingDependencyWatchdog) Reset()      { *m = SeedSettingDependencyWatchdog{} }
 func (*SeedSettingDependencyWatchdog) ProtoMessage() {}
 func (*SeedSettingDependencyWatchdog) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{124}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{127}
 }
 func (m *SeedSettingDependencyWatchdog) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3556,7 +3640,7 @@ var xxx_messageInfo_SeedSettingDependencyWatchdog proto.InternalMessageInfo
 func (m *SeedSettingDependencyWatchdogProber) Reset()      { *m = SeedSettingDependencyWatchdogProber{} }
 func (*SeedSettingDependencyWatchdogProber) ProtoMessage() {}
 func (*SeedSettingDependencyWatchdogProber) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{125}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{128}
 }
 func (m *SeedSettingDependencyWatchdogProber) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3584,7 +3668,7 @@ var xxx_messageInfo_SeedSettingDependencyWatchdogProber proto.InternalMessageInf
 func (m *SeedSettingDependencyWatchdogWeeder) Reset()      { *m = SeedSettingDependencyWatchdogWeeder{} }
 func (*SeedSettingDependencyWatchdogWeeder) ProtoMessage() {}
 func (*SeedSettingDependencyWatchdogWeeder) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{126}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{129}
 }
 func (m *SeedSettingDependencyWatchdogWeeder) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3612,7 +3696,7 @@ var xxx_messageInfo_SeedSettingDependencyWatchdogWeeder proto.InternalMessageInf
 func (m *SeedSettingExcessCapacityReservation) Reset()      { *m = SeedSettingExcessCapacityReservation{} }
 func (*SeedSettingExcessCapacityReservation) ProtoMessage() {}
 func (*SeedSettingExcessCapacityReservation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{127}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{130}
 }
 func (m *SeedSettingExcessCapacityReservation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3642,7 +3726,7 @@ func (m *SeedSettingExcessCapacityReservationConfig) Reset() {
 }
 func (*SeedSettingExcessCapacityReservationConfig) ProtoMessage() {}
 func (*SeedSettingExcessCapacityReservationConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{128}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{131}
 }
 func (m *SeedSettingExcessCapacityReservationConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3670,7 +3754,7 @@ var xxx_messageInfo_SeedSettingExcessCapacityReservationConfig proto.InternalMes
 func (m *SeedSettingLoadBalancerServices) Reset()      { *m = SeedSettingLoadBalancerServices{} }
 func (*SeedSettingLoadBalancerServices) ProtoMessage() {}
 func (*SeedSettingLoadBalancerServices) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{129}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{132}
 }
 func (m *SeedSettingLoadBalancerServices) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3698,7 +3782,7 @@ var xxx_messageInfo_SeedSettingLoadBalancerServices proto.InternalMessageInfo
 func (m *SeedSettingLoadBalancerServicesZones) Reset()      { *m = SeedSettingLoadBalancerServicesZones{} }
 func (*SeedSettingLoadBalancerServicesZones) ProtoMessage() {}
 func (*SeedSettingLoadBalancerServicesZones) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{130}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{133}
 }
 func (m *SeedSettingLoadBalancerServicesZones) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3726,7 +3810,7 @@ var xxx_messageInfo_SeedSettingLoadBalancerServicesZones proto.InternalMessageIn
 func (m *SeedSettingScheduling) Reset()      { *m = SeedSettingScheduling{} }
 func (*SeedSettingScheduling) ProtoMessage() {}
 func (*SeedSettingScheduling) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{131}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{134}
 }
 func (m *SeedSettingScheduling) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3754,7 +3838,7 @@ var xxx_messageInfo_SeedSettingScheduling proto.InternalMessageInfo
 func (m *SeedSettingTopologyAwareRouting) Reset()      { *m = SeedSettingTopologyAwareRouting{} }
 func (*SeedSettingTopologyAwareRouting) ProtoMessage() {}
 func (*SeedSettingTopologyAwareRouting) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{132}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{135}
 }
 func (m *SeedSettingTopologyAwareRouting) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3782,7 +3866,7 @@ var xxx_messageInfo_SeedSettingTopologyAwareRouting proto.InternalMessageInfo
 func (m *SeedSettingVerticalPodAutoscaler) Reset()      { *m = SeedSettingVerticalPodAutoscaler{} }
 func (*SeedSettingVerticalPodAutoscaler) ProtoMessage() {}
 func (*SeedSettingVerticalPodAutoscaler) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{133}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{136}
 }
 func (m *SeedSettingVerticalPodAutoscaler) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3810,7 +3894,7 @@ var xxx_messageInfo_SeedSettingVerticalPodAutoscaler proto.InternalMessageInfo
 func (m *SeedSettings) Reset()      { *m = SeedSettings{} }
 func (*SeedSettings) ProtoMessage() {}
 func (*SeedSettings) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{134}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{137}
 }
 func (m *SeedSettings) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3838,7 +3922,7 @@ var xxx_messageInfo_SeedSettings proto.InternalMessageInfo
 func (m *SeedSpec) Reset()      { *m = SeedSpec{} }
 func (*SeedSpec) ProtoMessage() {}
 func (*SeedSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{135}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{138}
 }
 func (m *SeedSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3866,7 +3950,7 @@ var xxx_messageInfo_SeedSpec proto.InternalMessageInfo
 func (m *SeedStatus) Reset()      { *m = SeedStatus{} }
 func (*SeedStatus) ProtoMessage() {}
 func (*SeedStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{136}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{139}
 }
 func (m *SeedStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3894,7 +3978,7 @@ var xxx_messageInfo_SeedStatus proto.InternalMessageInfo
 func (m *SeedTaint) Reset()      { *m = SeedTaint{} }
 func (*SeedTaint) ProtoMessage() {}
 func (*SeedTaint) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{137}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{140}
 }
 func (m *SeedTaint) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3922,7 +4006,7 @@ var xxx_messageInfo_SeedTaint proto.InternalMessageInfo
 func (m *SeedTemplate) Reset()      { *m = SeedTemplate{} }
 func (*SeedTemplate) ProtoMessage() {}
 func (*SeedTemplate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{138}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{141}
 }
 func (m *SeedTemplate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3950,7 +4034,7 @@ var xxx_messageInfo_SeedTemplate proto.InternalMessageInfo
 func (m *SeedVolume) Reset()      { *m = SeedVolume{} }
 func (*SeedVolume) ProtoMessage() {}
 func (*SeedVolume) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{139}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{142}
 }
 func (m *SeedVolume) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3978,7 +4062,7 @@ var xxx_messageInfo_SeedVolume proto.InternalMessageInfo
 func (m *SeedVolumeProvider) Reset()      { *m = SeedVolumeProvider{} }
 func (*SeedVolumeProvider) ProtoMessage() {}
 func (*SeedVolumeProvider) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{140}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{143}
 }
 func (m *SeedVolumeProvider) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4006,7 +4090,7 @@ var xxx_messageInfo_SeedVolumeProvider proto.InternalMessageInfo
 func (m *ServiceAccountConfig) Reset()      { *m = ServiceAccountConfig{} }
 func (*ServiceAccountConfig) ProtoMessage() {}
 func (*ServiceAccountConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{141}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{144}
 }
 func (m *ServiceAccountConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4034,7 +4118,7 @@ var xxx_messageInfo_ServiceAccountConfig proto.InternalMessageInfo
 func (m *ServiceAccountKeyRotation) Reset()      { *m = ServiceAccountKeyRotation{} }
 func (*ServiceAccountKeyRotation) ProtoMessage() {}
 func (*ServiceAccountKeyRotation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{142}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{145}
 }
 func (m *ServiceAccountKeyRotation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4062,7 +4146,7 @@ var xxx_messageInfo_ServiceAccountKeyRotation proto.InternalMessageInfo
 func (m *Shoot) Reset()      { *m = Shoot{} }
 func (*Shoot) ProtoMessage() {}
 func (*Shoot) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{143}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{146}
 }
 func (m *Shoot) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4090,7 +4174,7 @@ var xxx_messageInfo_Shoot proto.InternalMessageInfo
 func (m *ShootAdvertisedAddress) Reset()      { *m = ShootAdvertisedAddress{} }
 func (*ShootAdvertisedAddress) ProtoMessage() {}
 func (*ShootAdvertisedAddress) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{144}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{147}
 }
 func (m *ShootAdvertisedAddress) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4118,7 +4202,7 @@ var xxx_messageInfo_ShootAdvertisedAddress proto.InternalMessageInfo
 func (m *ShootCredentials) Reset()      { *m = ShootCredentials{} }
 func (*ShootCredentials) ProtoMessage() {}
 func (*ShootCredentials) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{145}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{148}
 }
 func (m *ShootCredentials) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4146,7 +4230,7 @@ var xxx_messageInfo_ShootCredentials proto.InternalMessageInfo
 func (m *ShootCredentialsRotation) Reset()      { *m = ShootCredentialsRotation{} }
 func (*ShootCredentialsRotation) ProtoMessage() {}
 func (*ShootCredentialsRotation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{146}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{149}
 }
 func (m *ShootCredentialsRotation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4174,7 +4258,7 @@ var xxx_messageInfo_ShootCredentialsRotation proto.InternalMessageInfo
 func (m *ShootKubeconfigRotation) Reset()      { *m = ShootKubeconfigRotation{} }
 func (*ShootKubeconfigRotation) ProtoMessage() {}
 func (*ShootKubeconfigRotation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{147}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{150}
 }
 func (m *ShootKubeconfigRotation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4202,7 +4286,7 @@ var xxx_messageInfo_ShootKubeconfigRotation proto.InternalMessageInfo
 func (m *ShootList) Reset()      { *m = ShootList{} }
 func (*ShootList) ProtoMessage() {}
 func (*ShootList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{148}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{151}
 }
 func (m *ShootList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4230,7 +4314,7 @@ var xxx_messageInfo_ShootList proto.InternalMessageInfo
 func (m *ShootMachineImage) Reset()      { *m = ShootMachineImage{} }
 func (*ShootMachineImage) ProtoMessage() {}
 func (*ShootMachineImage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{149}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{152}
 }
 func (m *ShootMachineImage) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4258,7 +4342,7 @@ var xxx_messageInfo_ShootMachineImage proto.InternalMessageInfo
 func (m *ShootNetworks) Reset()      { *m = ShootNetworks{} }
 func (*ShootNetworks) ProtoMessage() {}
 func (*ShootNetworks) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{150}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{153}
 }
 func (m *ShootNetworks) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4286,7 +4370,7 @@ var xxx_messageInfo_ShootNetworks proto.InternalMessageInfo
 func (m *ShootSSHKeypairRotation) Reset()      { *m = ShootSSHKeypairRotation{} }
 func (*ShootSSHKeypairRotation) ProtoMessage() {}
 func (*ShootSSHKeypairRotation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{151}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{154}
 }
 func (m *ShootSSHKeypairRotation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4314,7 +4398,7 @@ var xxx_messageInfo_ShootSSHKeypairRotation proto.InternalMessageInfo
 func (m *ShootSpec) Reset()      { *m = ShootSpec{} }
 func (*ShootSpec) ProtoMessage() {}
 func (*ShootSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{152}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{155}
 }
 func (m *ShootSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4342,7 +4426,7 @@ var xxx_messageInfo_ShootSpec proto.InternalMessageInfo
 func (m *ShootState) Reset()      { *m = ShootState{} }
 func (*ShootState) ProtoMessage() {}
 func (*ShootState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{153}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{156}
 }
 func (m *ShootState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4370,7 +4454,7 @@ var xxx_messageInfo_ShootState proto.InternalMessageInfo
 func (m *ShootStateList) Reset()      { *m = ShootStateList{} }
 func (*ShootStateList) ProtoMessage() {}
 func (*ShootStateList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{154}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{157}
 }
 func (m *ShootStateList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4398,7 +4482,7 @@ var xxx_messageInfo_ShootStateList proto.InternalMessageInfo
 func (m *ShootStateSpec) Reset()      { *m = ShootStateSpec{} }
 func (*ShootStateSpec) ProtoMessage() {}
 func (*ShootStateSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{155}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{158}
 }
 func (m *ShootStateSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4426,7 +4510,7 @@ var xxx_messageInfo_ShootStateSpec proto.InternalMessageInfo
 func (m *ShootStatus) Reset()      { *m = ShootStatus{} }
 func (*ShootStatus) ProtoMessage() {}
 func (*ShootStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{156}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{159}
 }
 func (m *ShootStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4454,7 +4538,7 @@ var xxx_messageInfo_ShootStatus proto.InternalMessageInfo
 func (m *ShootTemplate) Reset()      { *m = ShootTemplate{} }
 func (*ShootTemplate) ProtoMessage() {}
 func (*ShootTemplate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{157}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{160}
 }
 func (m *ShootTemplate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4482,7 +4566,7 @@ var xxx_messageInfo_ShootTemplate proto.InternalMessageInfo
 func (m *SystemComponents) Reset()      { *m = SystemComponents{} }
 func (*SystemComponents) ProtoMessage() {}
 func (*SystemComponents) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{158}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{161}
 }
 func (m *SystemComponents) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4510,7 +4594,7 @@ var xxx_messageInfo_SystemComponents proto.InternalMessageInfo
 func (m *Toleration) Reset()      { *m = Toleration{} }
 func (*Toleration) ProtoMessage() {}
 func (*Toleration) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{159}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{162}
 }
 func (m *Toleration) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4538,7 +4622,7 @@ var xxx_messageInfo_Toleration proto.InternalMessageInfo
 func (m *VerticalPodAutoscaler) Reset()      { *m = VerticalPodAutoscaler{} }
 func (*VerticalPodAutoscaler) ProtoMessage() {}
 func (*VerticalPodAutoscaler) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{160}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{163}
 }
 func (m *VerticalPodAutoscaler) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4566,7 +4650,7 @@ var xxx_messageInfo_VerticalPodAutoscaler proto.InternalMessageInfo
 func (m *Volume) Reset()      { *m = Volume{} }
 func (*Volume) ProtoMessage() {}
 func (*Volume) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{161}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{164}
 }
 func (m *Volume) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4594,7 +4678,7 @@ var xxx_messageInfo_Volume proto.InternalMessageInfo
 func (m *VolumeType) Reset()      { *m = VolumeType{} }
 func (*VolumeType) ProtoMessage() {}
 func (*VolumeType) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{162}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{165}
 }
 func (m *VolumeType) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4622,7 +4706,7 @@ var xxx_messageInfo_VolumeType proto.InternalMessageInfo
 func (m *WatchCacheSizes) Reset()      { *m = WatchCacheSizes{} }
 func (*WatchCacheSizes) ProtoMessage() {}
 func (*WatchCacheSizes) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{163}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{166}
 }
 func (m *WatchCacheSizes) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4650,7 +4734,7 @@ var xxx_messageInfo_WatchCacheSizes proto.InternalMessageInfo
 func (m *Worker) Reset()      { *m = Worker{} }
 func (*Worker) ProtoMessage() {}
 func (*Worker) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{164}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{167}
 }
 func (m *Worker) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4678,7 +4762,7 @@ var xxx_messageInfo_Worker proto.InternalMessageInfo
 func (m *WorkerKubernetes) Reset()      { *m = WorkerKubernetes{} }
 func (*WorkerKubernetes) ProtoMessage() {}
 func (*WorkerKubernetes) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{165}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{168}
 }
 func (m *WorkerKubernetes) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4706,7 +4790,7 @@ var xxx_messageInfo_WorkerKubernetes proto.InternalMessageInfo
 func (m *WorkerSystemComponents) Reset()      { *m = WorkerSystemComponents{} }
 func (*WorkerSystemComponents) ProtoMessage() {}
 func (*WorkerSystemComponents) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{166}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{169}
 }
 func (m *WorkerSystemComponents) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4734,7 +4818,7 @@ var xxx_messageInfo_WorkerSystemComponents proto.InternalMessageInfo
 func (m *WorkersSettings) Reset()      { *m = WorkersSettings{} }
 func (*WorkersSettings) ProtoMessage() {}
 func (*WorkersSettings) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{167}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{170}
 }
 func (m *WorkersSettings) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4784,6 +4868,9 @@ func init() {
 	proto.RegisterType((*CloudProfileList)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.CloudProfileList")
 	proto.RegisterType((*CloudProfileSpec)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.CloudProfileSpec")
 	proto.RegisterType((*ClusterAutoscaler)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.ClusterAutoscaler")
+	proto.RegisterType((*ClusterAutoscalerOptions)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.ClusterAutoscalerOptions")
+	proto.RegisterMapType((map[string]int32)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.ClusterAutoscalerOptions.ZoneCapsEntry")
+	proto.RegisterMapType((map[string]int32)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.ClusterAutoscalerOptions.ZoneWeightsEntry")
 	proto.RegisterType((*Condition)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.Condition")
 	proto.RegisterType((*ContainerRuntime)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.ContainerRuntime")
 	proto.RegisterType((*ControlPlane)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.ControlPlane")
@@ -4862,11 +4949,13 @@ func init() {
 	proto.RegisterType((*NginxIngress)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.NginxIngress")
 	proto.RegisterMapType((map[string]string)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.NginxIngress.ConfigEntry")
 	proto.RegisterType((*NodeLocalDNS)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.NodeLocalDNS")
+	proto.RegisterType((*NodeLocalDNSForward)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.NodeLocalDNSForward")
 	proto.RegisterType((*OIDCConfig)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.OIDCConfig")
 	proto.RegisterMapType((map[string]string)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.OIDCConfig.RequiredClaimsEntry")
 	proto.RegisterType((*ObservabilityRotation)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.ObservabilityRotation")
 	proto.RegisterType((*OpenIDConnectClientAuthentication)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.OpenIDConnectClientAuthentication")
 	proto.RegisterMapType((map[string]string)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.OpenIDConnectClientAuthentication.ExtraConfigEntry")
+	proto.RegisterType((*PriorityClassScaleUpDelay)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.PriorityClassScaleUpDelay")
 	proto.RegisterType((*Project)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.Project")
 	proto.RegisterType((*ProjectList)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.ProjectList")
 	proto.RegisterType((*ProjectMember)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.ProjectMember")
@@ -4954,755 +5043,788 @@ func init() {
 }
 
 var fileDescriptor_ca37af0df9a5bbd2 = []byte{
-	// 11965 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0xbd, 0x7d, 0x6c, 0x25, 0x59,
-	0x56, 0x18, 0xbe, 0xf5, 0xfc, 0x7d, 0xfc, 0xd1, 0xee, 0xdb, 0x1f, 0xe3, 0x76, 0xcf, 0xf4, 0xeb,
-	0xad, 0x99, 0xdd, 0xdf, 0x0c, 0xbb, 0xb8, 0x99, 0x61, 0x97, 0xdd, 0xe9, 0x65, 0x76, 0xd6, 0x7e,
-	0xcf, 0xdd, 0xfd, 0x68, 0xdb, 0xed, 0xb9, 0xcf, 0x9e, 0x19, 0x06, 0x7e, 0x03, 0xe5, 0xaa, 0xeb,
-	0xe7, 0x1a, 0xd7, 0xab, 0x7a, 0x53, 0x55, 0xcf, 0xed, 0x37, 0x03, 0x81, 0xdd, 0xb0, 0x84, 0x1d,
-	0xd8, 0x08, 0x21, 0x91, 0xd5, 0x2e, 0x24, 0x2c, 0x42, 0xe4, 0x8b, 0x88, 0x20, 0x22, 0x22, 0x01,
-	0x8a, 0x84, 0x90, 0x12, 0x76, 0x11, 0xa0, 0x15, 0x24, 0xca, 0xa2, 0x04, 0x93, 0x75, 0x08, 0x44,
-	0x4a, 0x84, 0x22, 0xa1, 0x28, 0x4a, 0x07, 0x91, 0xe8, 0x7e, 0x55, 0xdd, 0xfa, 0x7a, 0xb6, 0xeb,
-	0xd9, 0xde, 0x1d, 0xc1, 0x5f, 0xf6, 0xbb, 0xe7, 0xde, 0x73, 0xee, 0xbd, 0x75, 0xef, 0xb9, 0xe7,
-	0x9e, 0x7b, 0x3e, 0x60, 0xa9, 0x65, 0x87, 0x3b, 0xdd, 0xad, 0x05, 0xd3, 0x6b, 0xdf, 0x6a, 0x19,
-	0xbe, 0x45, 0x5c, 0xe2, 0xc7, 0xff, 0x74, 0x76, 0x5b, 0xb7, 0x8c, 0x8e, 0x1d, 0xdc, 0x32, 0x3d,
-	0x9f, 0xdc, 0xda, 0x7b, 0x76, 0x8b, 0x84, 0xc6, 0xb3, 0xb7, 0x5a, 0x14, 0x66, 0x84, 0xc4, 0x5a,
-	0xe8, 0xf8, 0x5e, 0xe8, 0xa1, 0xe7, 0x62, 0x1c, 0x0b, 0xb2, 0x69, 0xfc, 0x4f, 0x67, 0xb7, 0xb5,
-	0x40, 0x71, 0x2c, 0x50, 0x1c, 0x0b, 0x02, 0xc7, 0xfc, 0x37, 0xab, 0x74, 0xbd, 0x96, 0x77, 0x8b,
-	0xa1, 0xda, 0xea, 0x6e, 0xb3, 0x5f, 0xec, 0x07, 0xfb, 0x8f, 0x93, 0x98, 0x7f, 0x66, 0xf7, 0xa3,
-	0xc1, 0x82, 0xed, 0xd1, 0xce, 0xdc, 0x32, 0xba, 0xa1, 0x17, 0x98, 0x86, 0x63, 0xbb, 0xad, 0x5b,
-	0x7b, 0x99, 0xde, 0xcc, 0xeb, 0x4a, 0x55, 0xd1, 0xed, 0xbe, 0x75, 0xfc, 0x2d, 0xc3, 0xcc, 0xab,
-	0xf3, 0xa1, 0xb8, 0x4e, 0xdb, 0x30, 0x77, 0x6c, 0x97, 0xf8, 0x3d, 0x39, 0x21, 0xb7, 0x7c, 0x12,
-	0x78, 0x5d, 0xdf, 0x24, 0x27, 0x6a, 0x15, 0xdc, 0x6a, 0x93, 0xd0, 0xc8, 0xa3, 0x75, 0xab, 0xa8,
-	0x95, 0xdf, 0x75, 0x43, 0xbb, 0x9d, 0x25, 0xf3, 0x6d, 0x47, 0x35, 0x08, 0xcc, 0x1d, 0xd2, 0x36,
-	0x32, 0xed, 0xbe, 0xb5, 0xa8, 0x5d, 0x37, 0xb4, 0x9d, 0x5b, 0xb6, 0x1b, 0x06, 0xa1, 0x9f, 0x6e,
-	0xa4, 0xbf, 0xa3, 0xc1, 0xec, 0xe2, 0x7a, 0xa3, 0x49, 0xfc, 0x3d, 0xe2, 0xaf, 0x78, 0xad, 0x96,
-	0xed, 0xb6, 0xd0, 0x07, 0x60, 0x62, 0x8f, 0xf8, 0x5b, 0x5e, 0x60, 0x87, 0xbd, 0x39, 0xed, 0xa6,
-	0xf6, 0xf4, 0xc8, 0xd2, 0xf4, 0xe1, 0x41, 0x75, 0xe2, 0x65, 0x59, 0x88, 0x63, 0x38, 0x6a, 0xc0,
-	0xa5, 0x9d, 0x30, 0xec, 0x2c, 0x9a, 0x26, 0x09, 0x82, 0xa8, 0xc6, 0x5c, 0x85, 0x35, 0x7b, 0xec,
-	0xf0, 0xa0, 0x7a, 0xe9, 0xde, 0xc6, 0xc6, 0x7a, 0x0a, 0x8c, 0xf3, 0xda, 0xe8, 0xbf, 0xac, 0xc1,
-	0xc5, 0xa8, 0x33, 0x98, 0xbc, 0xd9, 0x25, 0x41, 0x18, 0x20, 0x0c, 0x57, 0xdb, 0xc6, 0xfe, 0x9a,
-	0xe7, 0xae, 0x76, 0x43, 0x23, 0xb4, 0xdd, 0x56, 0xc3, 0xdd, 0x76, 0xec, 0xd6, 0x4e, 0x28, 0xba,
-	0x36, 0x7f, 0x78, 0x50, 0xbd, 0xba, 0x9a, 0x5b, 0x03, 0x17, 0xb4, 0xa4, 0x9d, 0x6e, 0x1b, 0xfb,
-	0x19, 0x84, 0x4a, 0xa7, 0x57, 0xb3, 0x60, 0x9c, 0xd7, 0x46, 0x7f, 0x0e, 0x46, 0x16, 0x2d, 0xcb,
-	0x73, 0xd1, 0x33, 0x30, 0x46, 0x5c, 0x63, 0xcb, 0x21, 0x16, 0xeb, 0xd8, 0xf8, 0xd2, 0x85, 0x2f,
-	0x1d, 0x54, 0xdf, 0x73, 0x78, 0x50, 0x1d, 0x5b, 0xe6, 0xc5, 0x58, 0xc2, 0xf5, 0x9f, 0xac, 0xc0,
-	0x28, 0x6b, 0x14, 0xa0, 0x9f, 0xd0, 0xe0, 0xd2, 0x6e, 0x77, 0x8b, 0xf8, 0x2e, 0x09, 0x49, 0x50,
-	0x37, 0x82, 0x9d, 0x2d, 0xcf, 0xf0, 0x39, 0x8a, 0xc9, 0xe7, 0xee, 0x2e, 0x9c, 0x7c, 0xff, 0x2d,
-	0xdc, 0xcf, 0xa2, 0xe3, 0x63, 0xca, 0x01, 0xe0, 0x3c, 0xe2, 0x68, 0x0f, 0xa6, 0xdc, 0x96, 0xed,
-	0xee, 0x37, 0xdc, 0x96, 0x4f, 0x82, 0x80, 0xcd, 0xcb, 0xe4, 0x73, 0x9f, 0x28, 0xd3, 0x99, 0x35,
-	0x05, 0xcf, 0xd2, 0xec, 0xe1, 0x41, 0x75, 0x4a, 0x2d, 0xc1, 0x09, 0x3a, 0xfa, 0x5f, 0x69, 0x70,
-	0x61, 0xd1, 0x6a, 0xdb, 0x41, 0x60, 0x7b, 0xee, 0xba, 0xd3, 0x6d, 0xd9, 0x2e, 0xba, 0x09, 0xc3,
-	0xae, 0xd1, 0x26, 0x6c, 0x42, 0x26, 0x96, 0xa6, 0xc4, 0x9c, 0x0e, 0xaf, 0x19, 0x6d, 0x82, 0x19,
-	0x04, 0xbd, 0x04, 0xa3, 0xa6, 0xe7, 0x6e, 0xdb, 0x2d, 0xd1, 0xcf, 0x6f, 0x5e, 0xe0, 0x3b, 0x61,
-	0x41, 0xdd, 0x09, 0xac, 0x7b, 0x62, 0x07, 0x2d, 0x60, 0xe3, 0xe1, 0xf2, 0x7e, 0x48, 0x5c, 0x4a,
-	0x66, 0x09, 0x0e, 0x0f, 0xaa, 0xa3, 0x35, 0x86, 0x00, 0x0b, 0x44, 0xe8, 0x69, 0x18, 0xb7, 0xec,
-	0x80, 0x7f, 0xcc, 0x21, 0xf6, 0x31, 0xa7, 0x0e, 0x0f, 0xaa, 0xe3, 0x75, 0x51, 0x86, 0x23, 0x28,
-	0x5a, 0x81, 0xcb, 0x74, 0x06, 0x79, 0xbb, 0x26, 0x31, 0x7d, 0x12, 0xd2, 0xae, 0xcd, 0x0d, 0xb3,
-	0xee, 0xce, 0x1d, 0x1e, 0x54, 0x2f, 0xdf, 0xcf, 0x81, 0xe3, 0xdc, 0x56, 0xfa, 0x1d, 0x18, 0x5f,
-	0x74, 0x88, 0x4f, 0x17, 0x18, 0xba, 0x0d, 0x33, 0xa4, 0x6d, 0xd8, 0x0e, 0x26, 0x26, 0xb1, 0xf7,
-	0x88, 0x1f, 0xcc, 0x69, 0x37, 0x87, 0x9e, 0x9e, 0x58, 0x42, 0x87, 0x07, 0xd5, 0x99, 0xe5, 0x04,
-	0x04, 0xa7, 0x6a, 0xea, 0x9f, 0xd4, 0x60, 0x72, 0xb1, 0x6b, 0xd9, 0x21, 0x1f, 0x17, 0xf2, 0x61,
-	0xd2, 0xa0, 0x3f, 0xd7, 0x3d, 0xc7, 0x36, 0x7b, 0x62, 0x71, 0xbd, 0x58, 0xe6, 0x7b, 0x2e, 0xc6,
-	0x68, 0x96, 0x2e, 0x1c, 0x1e, 0x54, 0x27, 0x95, 0x02, 0xac, 0x12, 0xd1, 0x77, 0x40, 0x85, 0xa1,
-	0xef, 0x84, 0x29, 0x3e, 0xdc, 0x55, 0xa3, 0x83, 0xc9, 0xb6, 0xe8, 0xc3, 0x93, 0xca, 0xb7, 0x92,
-	0x84, 0x16, 0x1e, 0x6c, 0xbd, 0x41, 0xcc, 0x10, 0x93, 0x6d, 0xe2, 0x13, 0xd7, 0x24, 0x7c, 0xd9,
-	0xd4, 0x94, 0xc6, 0x38, 0x81, 0x4a, 0xff, 0x63, 0xca, 0xc4, 0xf6, 0x0c, 0xdb, 0x31, 0xb6, 0x6c,
-	0xc7, 0x0e, 0x7b, 0xaf, 0x79, 0x2e, 0x39, 0xc6, 0xba, 0xd9, 0x84, 0xc7, 0xba, 0xae, 0xc1, 0xdb,
-	0x39, 0x64, 0x95, 0xaf, 0x94, 0x8d, 0x5e, 0x87, 0xd0, 0x05, 0x4f, 0x67, 0xfa, 0xfa, 0xe1, 0x41,
-	0xf5, 0xb1, 0xcd, 0xfc, 0x2a, 0xb8, 0xa8, 0x2d, 0xe5, 0x57, 0x0a, 0xe8, 0x65, 0xcf, 0xe9, 0xb6,
-	0x05, 0xd6, 0x21, 0x86, 0x95, 0xf1, 0xab, 0xcd, 0xdc, 0x1a, 0xb8, 0xa0, 0xa5, 0xfe, 0xa5, 0x0a,
-	0x4c, 0x2d, 0x19, 0xe6, 0x6e, 0xb7, 0xb3, 0xd4, 0x35, 0x77, 0x49, 0x88, 0xbe, 0x17, 0xc6, 0xe9,
-	0x81, 0x63, 0x19, 0xa1, 0x21, 0x66, 0xf2, 0x5b, 0x0a, 0x57, 0x3d, 0xfb, 0x88, 0xb4, 0x76, 0x3c,
-	0xb7, 0xab, 0x24, 0x34, 0x96, 0x90, 0x98, 0x13, 0x88, 0xcb, 0x70, 0x84, 0x15, 0x6d, 0xc3, 0x70,
-	0xd0, 0x21, 0xa6, 0xd8, 0x53, 0xf5, 0x32, 0x6b, 0x45, 0xed, 0x71, 0xb3, 0x43, 0xcc, 0xf8, 0x2b,
-	0xd0, 0x5f, 0x98, 0xe1, 0x47, 0x2e, 0x8c, 0x06, 0xa1, 0x11, 0x76, 0x03, 0xb6, 0xd1, 0x26, 0x9f,
-	0xbb, 0x33, 0x30, 0x25, 0x86, 0x6d, 0x69, 0x46, 0xd0, 0x1a, 0xe5, 0xbf, 0xb1, 0xa0, 0xa2, 0xff,
-	0x7b, 0x0d, 0x66, 0xd5, 0xea, 0x2b, 0x76, 0x10, 0xa2, 0xef, 0xce, 0x4c, 0xe7, 0xc2, 0xf1, 0xa6,
-	0x93, 0xb6, 0x66, 0x93, 0x39, 0x2b, 0xc8, 0x8d, 0xcb, 0x12, 0x65, 0x2a, 0x09, 0x8c, 0xd8, 0x21,
-	0x69, 0xf3, 0x65, 0x55, 0x92, 0x8f, 0xaa, 0x5d, 0x5e, 0x9a, 0x16, 0xc4, 0x46, 0x1a, 0x14, 0x2d,
-	0xe6, 0xd8, 0xf5, 0xef, 0x85, 0xcb, 0x6a, 0xad, 0x75, 0xdf, 0xdb, 0xb3, 0x2d, 0xe2, 0xd3, 0x9d,
-	0x10, 0xf6, 0x3a, 0x99, 0x9d, 0x40, 0x57, 0x16, 0x66, 0x10, 0xf4, 0x7e, 0x18, 0xf5, 0x49, 0xcb,
-	0xf6, 0x5c, 0xf6, 0xb5, 0x27, 0xe2, 0xb9, 0xc3, 0xac, 0x14, 0x0b, 0xa8, 0xfe, 0x3f, 0x2b, 0xc9,
-	0xb9, 0xa3, 0x9f, 0x11, 0xed, 0xc1, 0x78, 0x47, 0x90, 0x12, 0x73, 0x77, 0x6f, 0xd0, 0x01, 0xca,
-	0xae, 0xc7, 0xb3, 0x2a, 0x4b, 0x70, 0x44, 0x0b, 0xd9, 0x30, 0x23, 0xff, 0xaf, 0x0d, 0xc0, 0xfe,
-	0x19, 0x3b, 0x5d, 0x4f, 0x20, 0xc2, 0x29, 0xc4, 0x68, 0x03, 0x26, 0x02, 0xc6, 0xa4, 0x29, 0xe3,
-	0x1a, 0x2a, 0x66, 0x5c, 0x4d, 0x59, 0x49, 0x30, 0xae, 0x8b, 0xa2, 0xfb, 0x13, 0x11, 0x00, 0xc7,
-	0x88, 0xe8, 0x21, 0x13, 0x10, 0x62, 0x29, 0xc7, 0x05, 0x3b, 0x64, 0x9a, 0xa2, 0x0c, 0x47, 0x50,
-	0xfd, 0x8b, 0xc3, 0x80, 0xb2, 0x4b, 0x5c, 0x9d, 0x01, 0x5e, 0x22, 0xe6, 0x7f, 0x90, 0x19, 0x10,
-	0xbb, 0x25, 0x85, 0x18, 0xbd, 0x05, 0xd3, 0x8e, 0x11, 0x84, 0x0f, 0x3a, 0x54, 0x7a, 0x94, 0x0b,
-	0x65, 0xf2, 0xb9, 0xc5, 0x32, 0x5f, 0x7a, 0x45, 0x45, 0xb4, 0x74, 0xf1, 0xf0, 0xa0, 0x3a, 0x9d,
-	0x28, 0xc2, 0x49, 0x52, 0xe8, 0x0d, 0x98, 0xa0, 0x05, 0xcb, 0xbe, 0xef, 0xf9, 0x62, 0xf6, 0x5f,
-	0x28, 0x4b, 0x97, 0x21, 0xe1, 0xd2, 0x6c, 0xf4, 0x13, 0xc7, 0xe8, 0xd1, 0x77, 0x00, 0xf2, 0xb6,
-	0x02, 0x2a, 0x80, 0x5a, 0x77, 0xb9, 0xa8, 0x4c, 0x07, 0x4b, 0xbf, 0xce, 0xd0, 0xd2, 0xbc, 0xf8,
-	0x9a, 0xe8, 0x41, 0xa6, 0x06, 0xce, 0x69, 0x85, 0x76, 0x01, 0x45, 0xe2, 0x76, 0xb4, 0x00, 0xe6,
-	0x46, 0x8e, 0xbf, 0x7c, 0xae, 0x52, 0x62, 0x77, 0x33, 0x28, 0x70, 0x0e, 0x5a, 0xfd, 0x5f, 0x57,
-	0x60, 0x92, 0x2f, 0x91, 0x65, 0x37, 0xf4, 0x7b, 0xe7, 0x70, 0x40, 0x90, 0xc4, 0x01, 0x51, 0x2b,
-	0xbf, 0xe7, 0x59, 0x87, 0x0b, 0xcf, 0x87, 0x76, 0xea, 0x7c, 0x58, 0x1e, 0x94, 0x50, 0xff, 0xe3,
-	0xe1, 0xdf, 0x69, 0x70, 0x41, 0xa9, 0x7d, 0x0e, 0xa7, 0x83, 0x95, 0x3c, 0x1d, 0x5e, 0x1c, 0x70,
-	0x7c, 0x05, 0x87, 0x83, 0x97, 0x18, 0x16, 0x63, 0xdc, 0xcf, 0x01, 0x6c, 0x31, 0x76, 0xb2, 0x16,
-	0xcb, 0x49, 0xd1, 0x27, 0x5f, 0x8a, 0x20, 0x58, 0xa9, 0x95, 0xe0, 0x59, 0x95, 0xbe, 0x3c, 0xeb,
-	0xbf, 0x0c, 0xc1, 0xc5, 0xcc, 0xb4, 0x67, 0xf9, 0x88, 0xf6, 0x75, 0xe2, 0x23, 0x95, 0xaf, 0x07,
-	0x1f, 0x19, 0x2a, 0xc5, 0x47, 0x8e, 0x7d, 0x4e, 0x20, 0x1f, 0x50, 0xdb, 0x6e, 0xf1, 0x66, 0xcd,
-	0xd0, 0xf0, 0xc3, 0x0d, 0xbb, 0x4d, 0x04, 0xc7, 0xf9, 0xa6, 0xe3, 0x2d, 0x59, 0xda, 0x82, 0x33,
-	0x9e, 0xd5, 0x0c, 0x26, 0x9c, 0x83, 0x5d, 0xff, 0xfd, 0x61, 0x80, 0xda, 0x22, 0xf6, 0x42, 0xde,
-	0xd9, 0x17, 0x61, 0xa4, 0xb3, 0x63, 0x04, 0x72, 0x3d, 0x3d, 0x23, 0x17, 0xe3, 0x3a, 0x2d, 0x7c,
-	0x74, 0x50, 0x9d, 0xab, 0xf9, 0xc4, 0x22, 0x6e, 0x68, 0x1b, 0x4e, 0x20, 0x1b, 0x31, 0x18, 0xe6,
-	0xed, 0xe8, 0x18, 0xe8, 0x34, 0xd6, 0xbc, 0x76, 0xc7, 0x21, 0x14, 0xca, 0xc6, 0x50, 0x29, 0x37,
-	0x86, 0x95, 0x0c, 0x26, 0x9c, 0x83, 0x5d, 0xd2, 0x6c, 0xb8, 0x76, 0x68, 0x1b, 0x11, 0xcd, 0xa1,
-	0xf2, 0x34, 0x93, 0x98, 0x70, 0x0e, 0x76, 0xf4, 0x8e, 0x06, 0xf3, 0xc9, 0xe2, 0x3b, 0xb6, 0x6b,
-	0x07, 0x3b, 0xc4, 0x62, 0xc4, 0x87, 0x4f, 0x4c, 0xfc, 0xc6, 0xe1, 0x41, 0x75, 0x7e, 0xa5, 0x10,
-	0x23, 0xee, 0x43, 0x0d, 0x7d, 0x56, 0x83, 0xeb, 0xa9, 0x79, 0xf1, 0xed, 0x56, 0x8b, 0xf8, 0xa2,
-	0x37, 0x27, 0x5f, 0x42, 0xd5, 0xc3, 0x83, 0xea, 0xf5, 0x95, 0x62, 0x94, 0xb8, 0x1f, 0x3d, 0xfd,
-	0x37, 0x35, 0x18, 0xaa, 0xe1, 0x06, 0xfa, 0x40, 0xe2, 0x12, 0xf7, 0x98, 0x7a, 0x89, 0x7b, 0x74,
-	0x50, 0x1d, 0xab, 0xe1, 0x86, 0x72, 0x9f, 0xfb, 0xac, 0x06, 0x17, 0x4d, 0xcf, 0x0d, 0x0d, 0xda,
-	0x2f, 0xcc, 0x25, 0x1d, 0xc9, 0x55, 0x4b, 0xdd, 0x5f, 0x6a, 0x29, 0x64, 0x4b, 0xd7, 0x44, 0x07,
-	0x2e, 0xa6, 0x21, 0x01, 0xce, 0x52, 0xd6, 0xbf, 0xaa, 0xc1, 0x54, 0xcd, 0xf1, 0xba, 0xd6, 0xba,
-	0xef, 0x6d, 0xdb, 0x0e, 0x79, 0x77, 0x5c, 0xda, 0xd4, 0x1e, 0x17, 0x1d, 0xca, 0xec, 0x12, 0xa5,
-	0x56, 0x7c, 0x97, 0x5c, 0xa2, 0xd4, 0x2e, 0x17, 0x9c, 0x93, 0x3f, 0x39, 0x96, 0x1c, 0x19, 0x3b,
-	0x29, 0x9f, 0x86, 0x71, 0xd3, 0x58, 0xea, 0xba, 0x96, 0x13, 0xdd, 0xa2, 0x68, 0x2f, 0x6b, 0x8b,
-	0xbc, 0x0c, 0x47, 0x50, 0xf4, 0x16, 0x40, 0xac, 0x50, 0x13, 0x9f, 0xe1, 0xce, 0x60, 0x4a, 0xbc,
-	0x26, 0x09, 0x43, 0xdb, 0x6d, 0x05, 0xf1, 0xa7, 0x8f, 0x61, 0x58, 0xa1, 0x86, 0xbe, 0x1f, 0xa6,
-	0xc5, 0x24, 0x37, 0xda, 0x46, 0x4b, 0xe8, 0x1b, 0x4a, 0xce, 0xd4, 0xaa, 0x82, 0x68, 0xe9, 0x8a,
-	0x20, 0x3c, 0xad, 0x96, 0x06, 0x38, 0x49, 0x0d, 0xf5, 0x60, 0xaa, 0xad, 0xea, 0x50, 0x86, 0xcb,
-	0x8b, 0x33, 0x8a, 0x3e, 0x65, 0xe9, 0xb2, 0x20, 0x3e, 0x95, 0xd0, 0xbe, 0x24, 0x48, 0xe5, 0x5c,
-	0x05, 0x47, 0xce, 0xea, 0x2a, 0x48, 0x60, 0x8c, 0x5f, 0x86, 0x83, 0xb9, 0x51, 0x36, 0xc0, 0xdb,
-	0x65, 0x06, 0xc8, 0xef, 0xd5, 0xb1, 0x86, 0x98, 0xff, 0x0e, 0xb0, 0xc4, 0x8d, 0xf6, 0x60, 0x8a,
-	0x9e, 0xea, 0x4d, 0xe2, 0x10, 0x33, 0xf4, 0xfc, 0xb9, 0xb1, 0xf2, 0x1a, 0xd8, 0xa6, 0x82, 0x87,
-	0xab, 0xd2, 0xd4, 0x12, 0x9c, 0xa0, 0x13, 0xe9, 0x0a, 0xc6, 0x0b, 0x75, 0x05, 0x5d, 0x98, 0xdc,
-	0x53, 0x74, 0x5a, 0x13, 0x6c, 0x12, 0x3e, 0x5e, 0xa6, 0x63, 0xb1, 0x82, 0x6b, 0xe9, 0x92, 0x20,
-	0x34, 0xa9, 0x2a, 0xc3, 0x54, 0x3a, 0xfa, 0x3f, 0x00, 0xb8, 0x58, 0x73, 0xba, 0x41, 0x48, 0xfc,
-	0x45, 0xf1, 0x48, 0x44, 0x7c, 0xf4, 0x29, 0x0d, 0xae, 0xb2, 0x7f, 0xeb, 0xde, 0x43, 0xb7, 0x4e,
-	0x1c, 0xa3, 0xb7, 0xb8, 0x4d, 0x6b, 0x58, 0xd6, 0xc9, 0x38, 0x50, 0xbd, 0x2b, 0xa4, 0x48, 0xa6,
-	0x9c, 0x6b, 0xe6, 0x62, 0xc4, 0x05, 0x94, 0xd0, 0x8f, 0x6a, 0x70, 0x2d, 0x07, 0x54, 0x27, 0x0e,
-	0x09, 0xa5, 0xe4, 0x72, 0xd2, 0x7e, 0x3c, 0x71, 0x78, 0x50, 0xbd, 0xd6, 0x2c, 0x42, 0x8a, 0x8b,
-	0xe9, 0xa1, 0xbf, 0xab, 0xc1, 0x7c, 0x0e, 0xf4, 0x8e, 0x61, 0x3b, 0x5d, 0x5f, 0x0a, 0x35, 0x27,
-	0xed, 0x0e, 0x93, 0x2d, 0x9a, 0x85, 0x58, 0x71, 0x1f, 0x8a, 0xe8, 0x07, 0xe0, 0x4a, 0x04, 0xdd,
-	0x74, 0x5d, 0x42, 0xac, 0x84, 0x88, 0x73, 0xd2, 0xae, 0x5c, 0x3b, 0x3c, 0xa8, 0x5e, 0x69, 0xe6,
-	0x21, 0xc4, 0xf9, 0x74, 0x50, 0x0b, 0x9e, 0x88, 0x01, 0xa1, 0xed, 0xd8, 0x6f, 0x71, 0x29, 0x6c,
-	0xc7, 0x27, 0xc1, 0x8e, 0xe7, 0x58, 0x8c, 0x59, 0x68, 0x4b, 0xef, 0x3d, 0x3c, 0xa8, 0x3e, 0xd1,
-	0xec, 0x57, 0x11, 0xf7, 0xc7, 0x83, 0x2c, 0x98, 0x0a, 0x4c, 0xc3, 0x6d, 0xb8, 0x21, 0xf1, 0xf7,
-	0x0c, 0x67, 0x6e, 0xb4, 0xd4, 0x00, 0xf9, 0x16, 0x55, 0xf0, 0xe0, 0x04, 0x56, 0xf4, 0x51, 0x18,
-	0x27, 0xfb, 0x1d, 0xc3, 0xb5, 0x08, 0x67, 0x0b, 0x13, 0x4b, 0x8f, 0xd3, 0xc3, 0x68, 0x59, 0x94,
-	0x3d, 0x3a, 0xa8, 0x4e, 0xc9, 0xff, 0x57, 0x3d, 0x8b, 0xe0, 0xa8, 0x36, 0xfa, 0x3e, 0xb8, 0xcc,
-	0xde, 0xc3, 0x2c, 0xc2, 0x98, 0x5c, 0x20, 0x05, 0xdd, 0xf1, 0x52, 0xfd, 0x64, 0x6f, 0x1b, 0xab,
-	0x39, 0xf8, 0x70, 0x2e, 0x15, 0xfa, 0x19, 0xda, 0xc6, 0xfe, 0x5d, 0xdf, 0x30, 0xc9, 0x76, 0xd7,
-	0xd9, 0x20, 0x7e, 0xdb, 0x76, 0xf9, 0x5d, 0x82, 0x98, 0x9e, 0x6b, 0x51, 0x56, 0xa2, 0x3d, 0x3d,
-	0xc2, 0x3f, 0xc3, 0x6a, 0xbf, 0x8a, 0xb8, 0x3f, 0x1e, 0xf4, 0x21, 0x98, 0xb2, 0x5b, 0xae, 0xe7,
-	0x93, 0x0d, 0xc3, 0x76, 0xc3, 0x60, 0x0e, 0x98, 0xda, 0x9d, 0x4d, 0x6b, 0x43, 0x29, 0xc7, 0x89,
-	0x5a, 0x68, 0x0f, 0x90, 0x4b, 0x1e, 0xae, 0x7b, 0x16, 0x5b, 0x02, 0x9b, 0x1d, 0xb6, 0x90, 0xe7,
-	0x26, 0x4b, 0x4d, 0x0d, 0xbb, 0x07, 0xac, 0x65, 0xb0, 0xe1, 0x1c, 0x0a, 0xe8, 0x0e, 0xa0, 0xb6,
-	0xb1, 0xbf, 0xdc, 0xee, 0x84, 0xbd, 0xa5, 0xae, 0xb3, 0x2b, 0xb8, 0xc6, 0x14, 0x9b, 0x0b, 0x7e,
-	0x0f, 0xcb, 0x40, 0x71, 0x4e, 0x0b, 0xfd, 0x60, 0x08, 0x26, 0x6a, 0x9e, 0x6b, 0xd9, 0xec, 0x1a,
-	0xf6, 0x6c, 0x42, 0xe7, 0xfb, 0x84, 0xca, 0xc7, 0x1f, 0x1d, 0x54, 0xa7, 0xa3, 0x8a, 0x0a, 0x63,
-	0x7f, 0x3e, 0x52, 0xb4, 0xf0, 0x8b, 0xfd, 0x7b, 0x93, 0x1a, 0x92, 0x47, 0x07, 0xd5, 0x0b, 0x51,
-	0xb3, 0xa4, 0xd2, 0x84, 0xce, 0x1d, 0x95, 0xe6, 0x37, 0x7c, 0xc3, 0x0d, 0xec, 0x01, 0xee, 0x4f,
-	0xd1, 0xcd, 0x78, 0x25, 0x83, 0x0d, 0xe7, 0x50, 0x40, 0x6f, 0xc0, 0x0c, 0x2d, 0xdd, 0xec, 0x58,
-	0x46, 0x48, 0x4a, 0x5e, 0x9b, 0xae, 0x0a, 0x9a, 0x33, 0x2b, 0x09, 0x4c, 0x38, 0x85, 0x99, 0xeb,
-	0xc8, 0x8d, 0xc0, 0x73, 0x19, 0xbb, 0x48, 0xe8, 0xc8, 0x69, 0x29, 0x16, 0x50, 0xf4, 0x0c, 0x8c,
-	0xb5, 0x49, 0x10, 0x18, 0x2d, 0xc2, 0xf6, 0xff, 0x44, 0x7c, 0xc8, 0xaf, 0xf2, 0x62, 0x2c, 0xe1,
-	0xe8, 0x83, 0x30, 0x62, 0x7a, 0x16, 0x09, 0xe6, 0xc6, 0xd8, 0x0a, 0xa5, 0x5f, 0x7b, 0xa4, 0x46,
-	0x0b, 0x1e, 0x1d, 0x54, 0x27, 0x98, 0x1e, 0x81, 0xfe, 0xc2, 0xbc, 0x92, 0xfe, 0x33, 0x54, 0xe6,
-	0x4e, 0x5d, 0x32, 0x8e, 0xa1, 0xdb, 0x3f, 0x3f, 0x35, 0xb9, 0xfe, 0x39, 0x7a, 0xe1, 0xf1, 0xdc,
-	0xd0, 0xf7, 0x9c, 0x75, 0xc7, 0x70, 0x09, 0xfa, 0x61, 0x0d, 0x66, 0x77, 0xec, 0xd6, 0x8e, 0xfa,
-	0x38, 0x27, 0x0e, 0xe6, 0x52, 0x77, 0x93, 0x7b, 0x29, 0x5c, 0x4b, 0x97, 0x0f, 0x0f, 0xaa, 0xb3,
-	0xe9, 0x52, 0x9c, 0xa1, 0xa9, 0x7f, 0xa6, 0x02, 0x97, 0x45, 0xcf, 0x1c, 0x7a, 0x52, 0x76, 0x1c,
-	0xaf, 0xd7, 0x26, 0xee, 0x79, 0xbc, 0xa3, 0xc9, 0x2f, 0x54, 0x29, 0xfc, 0x42, 0xed, 0xcc, 0x17,
-	0x1a, 0x2a, 0xf3, 0x85, 0xa2, 0x85, 0x7c, 0xc4, 0x57, 0xfa, 0x33, 0x0d, 0xe6, 0xf2, 0xe6, 0xe2,
-	0x1c, 0xee, 0x70, 0xed, 0xe4, 0x1d, 0xee, 0x5e, 0xd9, 0x4b, 0x79, 0xba, 0xeb, 0x05, 0x77, 0xb9,
-	0x3f, 0xad, 0xc0, 0xd5, 0xb8, 0x7a, 0xc3, 0x0d, 0x42, 0xc3, 0x71, 0xb8, 0x9a, 0xea, 0xec, 0xbf,
-	0x7b, 0x27, 0x71, 0x15, 0x5f, 0x1b, 0x6c, 0xa8, 0x6a, 0xdf, 0x0b, 0x35, 0xe5, 0xfb, 0x29, 0x4d,
-	0xf9, 0xfa, 0x29, 0xd2, 0xec, 0xaf, 0x34, 0xff, 0x6f, 0x1a, 0xcc, 0xe7, 0x37, 0x3c, 0x87, 0x45,
-	0xe5, 0x25, 0x17, 0xd5, 0x77, 0x9c, 0xde, 0xa8, 0x0b, 0x96, 0xd5, 0x2f, 0x57, 0x8a, 0x46, 0xcb,
-	0x94, 0x05, 0xdb, 0x70, 0x81, 0xde, 0xe2, 0x82, 0x50, 0xa8, 0x74, 0x4f, 0x66, 0xeb, 0x20, 0x75,
-	0x5c, 0x17, 0x70, 0x12, 0x07, 0x4e, 0x23, 0x45, 0x6b, 0x30, 0x46, 0xaf, 0x6e, 0x14, 0x7f, 0xe5,
-	0xf8, 0xf8, 0xa3, 0xd3, 0xa8, 0xc9, 0xdb, 0x62, 0x89, 0x04, 0x7d, 0x37, 0x4c, 0x5b, 0xd1, 0x8e,
-	0x3a, 0xe2, 0xa1, 0x33, 0x8d, 0x95, 0x29, 0xdf, 0xeb, 0x6a, 0x6b, 0x9c, 0x44, 0xa6, 0xff, 0xa5,
-	0x06, 0x8f, 0xf7, 0x5b, 0x5b, 0xe8, 0x4d, 0x00, 0x53, 0x8a, 0x17, 0xdc, 0xd4, 0xa5, 0xa4, 0x7a,
-	0x3e, 0x12, 0x52, 0xe2, 0x0d, 0x1a, 0x15, 0x05, 0x58, 0x21, 0x92, 0xf3, 0x7e, 0x5a, 0x39, 0xa3,
-	0xf7, 0x53, 0xfd, 0xbf, 0x6b, 0x2a, 0x2b, 0x52, 0xbf, 0xed, 0xbb, 0x8d, 0x15, 0xa9, 0x7d, 0x2f,
-	0xd4, 0x0f, 0xfe, 0x41, 0x05, 0x6e, 0xe6, 0x37, 0x51, 0xce, 0xde, 0x4f, 0xc0, 0x68, 0x87, 0xdb,
-	0x23, 0x0d, 0xb1, 0xb3, 0xf1, 0x69, 0xca, 0x59, 0xb8, 0xb5, 0xd0, 0xa3, 0x83, 0xea, 0x7c, 0x1e,
-	0xa3, 0x17, 0x76, 0x46, 0xa2, 0x1d, 0xb2, 0x53, 0x5a, 0x12, 0x2e, 0xfd, 0x7d, 0xeb, 0x31, 0x99,
-	0x8b, 0xb1, 0x45, 0x9c, 0x63, 0x2b, 0x46, 0x3e, 0xa9, 0xc1, 0x4c, 0x62, 0x45, 0x07, 0x73, 0x23,
-	0x6c, 0x8d, 0x96, 0x7a, 0xba, 0x4a, 0x6c, 0x95, 0xf8, 0xe4, 0x4e, 0x14, 0x07, 0x38, 0x45, 0x30,
-	0xc5, 0x66, 0xd5, 0x59, 0x7d, 0xd7, 0xb1, 0x59, 0xb5, 0xf3, 0x05, 0x6c, 0xf6, 0xa7, 0x2b, 0x45,
-	0xa3, 0x65, 0x6c, 0xf6, 0x21, 0x4c, 0x48, 0x4b, 0x5d, 0xc9, 0x2e, 0xee, 0x0c, 0xda, 0x27, 0x8e,
-	0x2e, 0x36, 0xdb, 0x90, 0x25, 0x01, 0x8e, 0x69, 0xa1, 0x1f, 0xd2, 0x00, 0xe2, 0x0f, 0x23, 0x36,
-	0xd5, 0xc6, 0xe9, 0x4d, 0x87, 0x22, 0xd6, 0xcc, 0xd0, 0x2d, 0xad, 0x2c, 0x0a, 0x85, 0xae, 0xfe,
-	0xbf, 0x87, 0x00, 0x65, 0xfb, 0x4e, 0xc5, 0xcd, 0x5d, 0xdb, 0xb5, 0xd2, 0x17, 0x82, 0xfb, 0xb6,
-	0x6b, 0x61, 0x06, 0x39, 0x86, 0x40, 0xfa, 0x02, 0x5c, 0x68, 0x39, 0xde, 0x96, 0xe1, 0x38, 0x3d,
-	0x61, 0xba, 0x2a, 0x8c, 0x20, 0x2f, 0xd1, 0x83, 0xe9, 0x6e, 0x12, 0x84, 0xd3, 0x75, 0x51, 0x07,
-	0x66, 0x7d, 0x7a, 0x15, 0x37, 0x6d, 0x87, 0x5d, 0x9d, 0xbc, 0x6e, 0x58, 0x52, 0xd7, 0xc3, 0xc4,
-	0x7b, 0x9c, 0xc2, 0x85, 0x33, 0xd8, 0xd1, 0xfb, 0x60, 0xac, 0xe3, 0xdb, 0x6d, 0xc3, 0xef, 0xb1,
-	0xcb, 0xd9, 0xf8, 0xd2, 0x24, 0x3d, 0xe1, 0xd6, 0x79, 0x11, 0x96, 0x30, 0xf4, 0x7d, 0x30, 0xe1,
-	0xd8, 0xdb, 0xc4, 0xec, 0x99, 0x0e, 0x11, 0xca, 0x99, 0x07, 0xa7, 0xb3, 0x64, 0x56, 0x24, 0x5a,
-	0xf1, 0x24, 0x2c, 0x7f, 0xe2, 0x98, 0x20, 0x6a, 0xc0, 0xa5, 0x87, 0x9e, 0xbf, 0x4b, 0x7c, 0x87,
-	0x04, 0x41, 0xb3, 0xdb, 0xe9, 0x78, 0x7e, 0x48, 0x2c, 0xa6, 0xc2, 0x19, 0xe7, 0xf6, 0xb9, 0xaf,
-	0x64, 0xc1, 0x38, 0xaf, 0x8d, 0xfe, 0x4e, 0x05, 0xae, 0xf7, 0xe9, 0x04, 0xc2, 0x74, 0x6f, 0x88,
-	0x39, 0x12, 0x2b, 0xe1, 0x43, 0x7c, 0x3d, 0x8b, 0xc2, 0x47, 0x07, 0xd5, 0x27, 0xfb, 0x20, 0x68,
-	0xd2, 0xa5, 0x48, 0x5a, 0x3d, 0x1c, 0xa3, 0x41, 0x0d, 0x18, 0xb5, 0x62, 0x8d, 0xe6, 0xc4, 0xd2,
-	0xb3, 0x94, 0x5b, 0x73, 0xdd, 0xc3, 0x71, 0xb1, 0x09, 0x04, 0x68, 0x05, 0xc6, 0xf8, 0x43, 0x32,
-	0x11, 0x9c, 0xff, 0x39, 0x76, 0x3d, 0xe6, 0x45, 0xc7, 0x45, 0x26, 0x51, 0xe8, 0xff, 0x4b, 0x83,
-	0xb1, 0x9a, 0xe7, 0x93, 0xfa, 0x5a, 0x13, 0xf5, 0x60, 0x52, 0x71, 0x21, 0x10, 0x5c, 0xb0, 0x24,
-	0x5b, 0x60, 0x18, 0x17, 0x63, 0x6c, 0xd2, 0xdc, 0x35, 0x2a, 0xc0, 0x2a, 0x2d, 0xf4, 0x26, 0x9d,
-	0xf3, 0x87, 0xbe, 0x1d, 0x52, 0xc2, 0x83, 0xbc, 0xbf, 0x71, 0xc2, 0x58, 0xe2, 0xe2, 0x2b, 0x2a,
-	0xfa, 0x89, 0x63, 0x2a, 0xfa, 0x3a, 0xe5, 0x00, 0xe9, 0x6e, 0xa2, 0xdb, 0x30, 0xdc, 0xf6, 0x2c,
-	0xf9, 0xdd, 0xdf, 0x2f, 0xf7, 0xf7, 0xaa, 0x67, 0xd1, 0xb9, 0xbd, 0x9a, 0x6d, 0xc1, 0xb4, 0x84,
-	0xac, 0x8d, 0xbe, 0x06, 0xb3, 0x69, 0xfa, 0xe8, 0x36, 0xcc, 0x98, 0x5e, 0xbb, 0xed, 0xb9, 0xcd,
-	0xee, 0xf6, 0xb6, 0xbd, 0x4f, 0x12, 0x76, 0xc8, 0xb5, 0x04, 0x04, 0xa7, 0x6a, 0xea, 0x3f, 0xa5,
-	0xc1, 0x10, 0xfd, 0x2e, 0x3a, 0x8c, 0x5a, 0x5e, 0xdb, 0xb0, 0x5d, 0xd1, 0x2b, 0x66, 0x73, 0x5d,
-	0x67, 0x25, 0x58, 0x40, 0x50, 0x07, 0x26, 0xa4, 0xd0, 0x34, 0x90, 0x2d, 0x4c, 0x7d, 0xad, 0x19,
-	0xd9, 0x0f, 0x46, 0x9c, 0x5c, 0x96, 0x04, 0x38, 0x26, 0xa2, 0x1b, 0x70, 0xb1, 0xbe, 0xd6, 0x6c,
-	0xb8, 0xa6, 0xd3, 0xb5, 0xc8, 0xf2, 0x3e, 0xfb, 0x43, 0x79, 0x89, 0xcd, 0x4b, 0xc4, 0x38, 0x19,
-	0x2f, 0x11, 0x95, 0xb0, 0x84, 0xd1, 0x6a, 0x84, 0xb7, 0x10, 0xc6, 0xc2, 0xac, 0x9a, 0x40, 0x82,
-	0x25, 0x4c, 0xff, 0x6a, 0x05, 0x26, 0x95, 0x0e, 0x21, 0x07, 0xc6, 0xf8, 0x70, 0xa5, 0xad, 0xde,
-	0x72, 0xc9, 0x21, 0x26, 0x7b, 0xcd, 0xa9, 0xf3, 0x09, 0x0d, 0xb0, 0x24, 0xa1, 0xf2, 0xc5, 0x4a,
-	0x1f, 0xbe, 0xb8, 0x00, 0x10, 0xc4, 0x96, 0xeb, 0x7c, 0x4b, 0xb2, 0xa3, 0x47, 0xb1, 0x57, 0x57,
-	0x6a, 0xa0, 0xc7, 0xc5, 0x09, 0xc2, 0x8d, 0x51, 0xc6, 0x53, 0xa7, 0xc7, 0x36, 0x8c, 0xbc, 0xe5,
-	0xb9, 0x24, 0x10, 0x6f, 0x70, 0xa7, 0x34, 0xc0, 0x09, 0x2a, 0x1f, 0xbc, 0x46, 0xf1, 0x62, 0x8e,
-	0x5e, 0xff, 0x59, 0x0d, 0xa0, 0x6e, 0x84, 0x06, 0x7f, 0x32, 0x3a, 0x86, 0xbd, 0xf7, 0xe3, 0x89,
-	0x83, 0x6f, 0x3c, 0x63, 0x03, 0x3b, 0x1c, 0xd8, 0x6f, 0xc9, 0xe1, 0x47, 0x02, 0x35, 0xc7, 0xde,
-	0xb4, 0xdf, 0x22, 0x98, 0xc1, 0xd1, 0x07, 0x60, 0x82, 0xb8, 0xa6, 0xdf, 0xeb, 0x50, 0xe6, 0x3d,
-	0xcc, 0x66, 0x95, 0xed, 0xd0, 0x65, 0x59, 0x88, 0x63, 0xb8, 0xfe, 0x2c, 0x24, 0x6f, 0x45, 0x47,
-	0xf7, 0x52, 0xff, 0xda, 0x30, 0x5c, 0x5b, 0xde, 0xa8, 0xd5, 0x05, 0x3e, 0xdb, 0x73, 0xef, 0x93,
-	0xde, 0xdf, 0x98, 0xd7, 0xfc, 0x8d, 0x79, 0xcd, 0x29, 0x9a, 0xd7, 0x3c, 0xd2, 0x60, 0x76, 0x79,
-	0xbf, 0x63, 0xfb, 0xcc, 0xcf, 0x80, 0xf8, 0xf4, 0x1a, 0x8b, 0x9e, 0x81, 0xb1, 0x3d, 0xfe, 0xaf,
-	0x58, 0x5c, 0x91, 0xaa, 0x40, 0xd4, 0xc0, 0x12, 0x8e, 0xb6, 0x61, 0x86, 0xb0, 0xe6, 0x4c, 0x5e,
-	0x35, 0xc2, 0x32, 0x0b, 0x88, 0xbb, 0xb1, 0x24, 0xb0, 0xe0, 0x14, 0x56, 0xd4, 0x84, 0x19, 0xd3,
-	0x31, 0x82, 0xc0, 0xde, 0xb6, 0xcd, 0xd8, 0x82, 0x6e, 0x62, 0xe9, 0x03, 0xec, 0xe8, 0x49, 0x40,
-	0x1e, 0x1d, 0x54, 0xaf, 0x88, 0x7e, 0x26, 0x01, 0x38, 0x85, 0x42, 0xff, 0x7c, 0x05, 0xa6, 0x97,
-	0xf7, 0x3b, 0x5e, 0xd0, 0xf5, 0x09, 0xab, 0x7a, 0x0e, 0x37, 0xf0, 0x67, 0x60, 0x6c, 0xc7, 0x70,
-	0x2d, 0x87, 0xf8, 0x82, 0xfb, 0x44, 0x73, 0x7b, 0x8f, 0x17, 0x63, 0x09, 0x47, 0x6f, 0x03, 0x04,
-	0xe6, 0x0e, 0xb1, 0xba, 0x4c, 0x82, 0xe1, 0x9b, 0xe4, 0x7e, 0x19, 0x1e, 0x9a, 0x18, 0x63, 0x33,
-	0x42, 0x29, 0x38, 0x7b, 0xf4, 0x1b, 0x2b, 0xe4, 0xf4, 0x3f, 0xd4, 0xe0, 0x62, 0xa2, 0xdd, 0x39,
-	0x5c, 0x2c, 0xb7, 0x93, 0x17, 0xcb, 0xc5, 0x81, 0xc7, 0x5a, 0x70, 0x9f, 0xfc, 0x91, 0x0a, 0x3c,
-	0x56, 0x30, 0x27, 0x19, 0x73, 0x0b, 0xed, 0x9c, 0xcc, 0x2d, 0xba, 0x30, 0x19, 0x7a, 0x8e, 0x30,
-	0xf4, 0x94, 0x33, 0x50, 0xca, 0x98, 0x62, 0x23, 0x42, 0x13, 0x1b, 0x53, 0xc4, 0x65, 0x01, 0x56,
-	0xe9, 0xe8, 0xbf, 0xa9, 0xc1, 0x44, 0xa4, 0xbf, 0xfa, 0x86, 0x7a, 0x43, 0x3a, 0xbe, 0xe7, 0x9d,
-	0xfe, 0x3b, 0x15, 0xb8, 0x1a, 0xe1, 0x96, 0xf7, 0x84, 0x66, 0x48, 0xf9, 0xc6, 0xd1, 0x97, 0xe0,
-	0xc7, 0xc5, 0x39, 0xac, 0xc8, 0x02, 0x8a, 0xa4, 0x40, 0xe5, 0xa6, 0xae, 0xdf, 0xf1, 0x02, 0x29,
-	0x0e, 0x70, 0xb9, 0x89, 0x17, 0x61, 0x09, 0x43, 0x6b, 0x30, 0x12, 0x50, 0x7a, 0xe2, 0x34, 0x39,
-	0xe1, 0x6c, 0x30, 0x89, 0x86, 0xf5, 0x17, 0x73, 0x34, 0xe8, 0x6d, 0x55, 0xa5, 0x31, 0x52, 0x5e,
-	0xcd, 0x42, 0x47, 0x62, 0xc9, 0x19, 0xc9, 0xf1, 0x46, 0xc9, 0x53, 0x6b, 0xe8, 0x2b, 0x30, 0x2b,
-	0x2c, 0x36, 0xf8, 0xb2, 0x71, 0x4d, 0x82, 0x3e, 0x9a, 0x58, 0x19, 0x4f, 0xa5, 0x5e, 0x91, 0x2f,
-	0xa7, 0xeb, 0xc7, 0x2b, 0x46, 0x0f, 0x60, 0xfc, 0xae, 0xe8, 0x24, 0x9a, 0x87, 0x8a, 0x2d, 0xbf,
-	0x05, 0x08, 0x1c, 0x95, 0x46, 0x1d, 0x57, 0x6c, 0x2b, 0x92, 0x87, 0x2a, 0x85, 0x52, 0x9b, 0x72,
-	0x2c, 0x0d, 0xf5, 0x3f, 0x96, 0xf4, 0x3f, 0xa9, 0xc0, 0x65, 0x49, 0x55, 0x8e, 0xb1, 0x2e, 0xde,
-	0xe0, 0x8e, 0x90, 0x0d, 0x8f, 0x56, 0x8a, 0x3c, 0x80, 0x61, 0xc6, 0x00, 0x4b, 0xbd, 0xcd, 0x45,
-	0x08, 0x69, 0x77, 0x30, 0x43, 0x84, 0xbe, 0x0f, 0x46, 0x1d, 0x63, 0x8b, 0x38, 0xd2, 0x52, 0xae,
-	0x94, 0x0a, 0x29, 0x6f, 0xb8, 0x5c, 0xb3, 0x19, 0x70, 0x6f, 0x80, 0xe8, 0xc9, 0x86, 0x17, 0x62,
-	0x41, 0x73, 0xfe, 0x79, 0x98, 0x54, 0xaa, 0xa1, 0x59, 0x18, 0xda, 0x25, 0xfc, 0x6d, 0x76, 0x02,
-	0xd3, 0x7f, 0xd1, 0x65, 0x18, 0xd9, 0x33, 0x9c, 0xae, 0x98, 0x12, 0xcc, 0x7f, 0xdc, 0xae, 0x7c,
-	0x54, 0xd3, 0x7f, 0x51, 0x83, 0xc9, 0x7b, 0xf6, 0x16, 0xf1, 0xb9, 0xd9, 0x05, 0xbb, 0x0a, 0x25,
-	0x1c, 0x9f, 0x27, 0xf3, 0x9c, 0x9e, 0xd1, 0x3e, 0x4c, 0x88, 0x93, 0x26, 0xb2, 0xca, 0xbd, 0x5b,
-	0xee, 0x11, 0x38, 0x22, 0x2d, 0x38, 0xb8, 0xea, 0x68, 0x25, 0x29, 0xe0, 0x98, 0x98, 0xfe, 0x36,
-	0x5c, 0xca, 0x69, 0x84, 0xaa, 0x6c, 0xfb, 0xfa, 0xa1, 0x58, 0x16, 0x72, 0x3f, 0xfa, 0x21, 0xe6,
-	0xe5, 0xe8, 0x1a, 0x0c, 0x11, 0xd7, 0x12, 0x6b, 0x62, 0xec, 0xf0, 0xa0, 0x3a, 0xb4, 0xec, 0x5a,
-	0x98, 0x96, 0x51, 0x36, 0xe5, 0x78, 0x09, 0x99, 0x84, 0xb1, 0xa9, 0x15, 0x51, 0x86, 0x23, 0x28,
-	0x7b, 0xb6, 0x4f, 0xbf, 0x50, 0x53, 0xe9, 0x74, 0x76, 0x3b, 0xb5, 0x7b, 0x06, 0x79, 0x18, 0x4f,
-	0xef, 0xc4, 0xa5, 0x39, 0x31, 0x21, 0x99, 0x3d, 0x8d, 0x33, 0x74, 0xf5, 0x5f, 0x1b, 0x86, 0x27,
-	0xee, 0x79, 0xbe, 0xfd, 0x96, 0xe7, 0x86, 0x86, 0xb3, 0xee, 0x59, 0xb1, 0x81, 0x9d, 0x60, 0xca,
-	0x9f, 0xd6, 0xe0, 0x31, 0xb3, 0xd3, 0xe5, 0xd2, 0xad, 0xb4, 0x7b, 0x5a, 0x27, 0xbe, 0xed, 0x95,
-	0xb5, 0xb3, 0x63, 0xae, 0xb5, 0xb5, 0xf5, 0xcd, 0x3c, 0x94, 0xb8, 0x88, 0x16, 0x33, 0xf7, 0xb3,
-	0xbc, 0x87, 0x2e, 0xeb, 0x5c, 0x33, 0x64, 0xb3, 0xf9, 0x56, 0xfc, 0x11, 0x4a, 0x9a, 0xfb, 0xd5,
-	0x73, 0x31, 0xe2, 0x02, 0x4a, 0xe8, 0x07, 0xe0, 0x8a, 0xcd, 0x3b, 0x87, 0x89, 0x61, 0xd9, 0x2e,
-	0x09, 0x02, 0x6e, 0x2b, 0x34, 0x80, 0x3d, 0x5b, 0x23, 0x0f, 0x21, 0xce, 0xa7, 0x83, 0x5e, 0x07,
-	0x08, 0x7a, 0xae, 0x29, 0xe6, 0x7f, 0xa4, 0x14, 0x55, 0x2e, 0x04, 0x46, 0x58, 0xb0, 0x82, 0x91,
-	0xde, 0x70, 0xc3, 0x68, 0x51, 0x8e, 0x32, 0xdb, 0x38, 0x76, 0xc3, 0x8d, 0xd7, 0x50, 0x0c, 0xd7,
-	0xff, 0x99, 0x06, 0x63, 0xc2, 0x7d, 0x1f, 0xbd, 0x3f, 0xa5, 0xe5, 0x89, 0x78, 0x4f, 0x4a, 0xd3,
-	0xd3, 0x63, 0x4f, 0x7d, 0x42, 0xc3, 0x27, 0x44, 0x89, 0x52, 0x6a, 0x02, 0x41, 0x38, 0x56, 0x17,
-	0x26, 0x9e, 0xfc, 0xa4, 0x0a, 0x51, 0x21, 0xa6, 0x7f, 0x51, 0x83, 0x8b, 0x99, 0x56, 0xc7, 0x90,
-	0x17, 0xce, 0xd1, 0x8a, 0xe6, 0x0f, 0x86, 0x61, 0x86, 0x19, 0xfb, 0xb9, 0x86, 0xc3, 0x15, 0x30,
-	0xe7, 0x70, 0x41, 0xf9, 0x00, 0x4c, 0xd8, 0xed, 0x76, 0x37, 0xa4, 0xac, 0x5a, 0xe8, 0xd0, 0xd9,
-	0x37, 0x6f, 0xc8, 0x42, 0x1c, 0xc3, 0x91, 0x2b, 0x8e, 0x42, 0xce, 0xc4, 0x57, 0xca, 0x7d, 0x39,
-	0x75, 0x80, 0x0b, 0xf4, 0xd8, 0xe2, 0xe7, 0x55, 0xde, 0x49, 0xf9, 0xc3, 0x1a, 0x40, 0x10, 0xfa,
-	0xb6, 0xdb, 0xa2, 0x85, 0xe2, 0xb8, 0xc4, 0xa7, 0x40, 0xb6, 0x19, 0x21, 0xe5, 0xc4, 0xa3, 0x39,
-	0x8a, 0x01, 0x58, 0xa1, 0x8c, 0x16, 0x85, 0x94, 0xc0, 0x39, 0xfe, 0x37, 0xa7, 0xe4, 0xa1, 0x27,
-	0xb2, 0xd1, 0x69, 0x84, 0x4b, 0x67, 0x2c, 0x46, 0xcc, 0x7f, 0x04, 0x26, 0x22, 0x7a, 0x47, 0x9d,
-	0xba, 0x53, 0xca, 0xa9, 0x3b, 0xff, 0x02, 0x5c, 0x48, 0x75, 0xf7, 0x44, 0x87, 0xf6, 0x7f, 0xd0,
-	0x00, 0x25, 0x47, 0x7f, 0x0e, 0x57, 0xbb, 0x56, 0xf2, 0x6a, 0xb7, 0x34, 0xf8, 0x27, 0x2b, 0xb8,
-	0xdb, 0x7d, 0x65, 0x1a, 0x58, 0x74, 0x93, 0x28, 0x7a, 0x8c, 0x38, 0xb8, 0xe8, 0x39, 0x1b, 0x7b,
-	0x48, 0x88, 0x9d, 0x3b, 0xc0, 0x39, 0x7b, 0x3f, 0x85, 0x2b, 0x3e, 0x67, 0xd3, 0x10, 0x9c, 0xa1,
-	0x8b, 0x3e, 0xa3, 0xc1, 0xac, 0x91, 0x8c, 0x6e, 0x22, 0x67, 0xa6, 0x94, 0xf7, 0x6c, 0x2a, 0x52,
-	0x4a, 0xdc, 0x97, 0x14, 0x20, 0xc0, 0x19, 0xb2, 0xe8, 0x43, 0x30, 0x65, 0x74, 0xec, 0xc5, 0xae,
-	0x65, 0xd3, 0xab, 0x81, 0x0c, 0x4d, 0xc1, 0xae, 0xab, 0x8b, 0xeb, 0x8d, 0xa8, 0x1c, 0x27, 0x6a,
-	0x45, 0x61, 0x44, 0xc4, 0x44, 0x0e, 0x0f, 0x18, 0x46, 0x44, 0xcc, 0x61, 0x1c, 0x46, 0x44, 0x4c,
-	0x9d, 0x4a, 0x04, 0xb9, 0x00, 0x9e, 0x6d, 0x99, 0x82, 0x24, 0x7f, 0xb5, 0x2b, 0x75, 0x43, 0x7e,
-	0xd0, 0xa8, 0xd7, 0x04, 0x45, 0x76, 0xfa, 0xc5, 0xbf, 0xb1, 0x42, 0x01, 0x7d, 0x4e, 0x83, 0x69,
-	0xc1, 0xbb, 0x05, 0xcd, 0x31, 0xf6, 0x89, 0x5e, 0x2b, 0xbb, 0x5e, 0x52, 0x6b, 0x72, 0x01, 0xab,
-	0xc8, 0x39, 0xdf, 0x89, 0x1c, 0x6c, 0x12, 0x30, 0x9c, 0xec, 0x07, 0xfa, 0x7b, 0x1a, 0x5c, 0x0e,
-	0x88, 0xbf, 0x67, 0x9b, 0x64, 0xd1, 0x34, 0xbd, 0xae, 0x2b, 0xbf, 0xc3, 0x78, 0xf9, 0xa8, 0x0b,
-	0xcd, 0x1c, 0x7c, 0xdc, 0xb2, 0x3b, 0x0f, 0x82, 0x73, 0xe9, 0x53, 0xb1, 0xec, 0xc2, 0x43, 0x23,
-	0x34, 0x77, 0x6a, 0x86, 0xb9, 0xc3, 0x74, 0xe5, 0xdc, 0x98, 0xbb, 0xe4, 0xba, 0x7e, 0x25, 0x89,
-	0x8a, 0xbf, 0x3a, 0xa7, 0x0a, 0x71, 0x9a, 0x20, 0xf2, 0x60, 0xdc, 0x17, 0x21, 0xa3, 0xe6, 0xa0,
-	0xbc, 0x48, 0x91, 0x89, 0x3f, 0xc5, 0x05, 0x7b, 0xf9, 0x0b, 0x47, 0x44, 0x50, 0x0b, 0x9e, 0xe0,
-	0x57, 0x9b, 0x45, 0xd7, 0x73, 0x7b, 0x6d, 0xaf, 0x1b, 0x2c, 0x76, 0xc3, 0x1d, 0xe2, 0x86, 0x52,
-	0x57, 0x39, 0xc9, 0x8e, 0x51, 0x66, 0xcf, 0xbe, 0xdc, 0xaf, 0x22, 0xee, 0x8f, 0x07, 0xbd, 0x0a,
-	0xe3, 0x64, 0x8f, 0xb8, 0xe1, 0xc6, 0xc6, 0x0a, 0xb3, 0x0b, 0x3f, 0xb9, 0xb4, 0xc7, 0x86, 0xb0,
-	0x2c, 0x70, 0xe0, 0x08, 0x1b, 0xda, 0x85, 0x31, 0x87, 0xc7, 0xfc, 0x9a, 0x9b, 0x2e, 0xcf, 0x14,
-	0xd3, 0xf1, 0xc3, 0xf8, 0xfd, 0x4f, 0xfc, 0xc0, 0x92, 0x02, 0xea, 0xc0, 0x4d, 0x8b, 0x6c, 0x1b,
-	0x5d, 0x27, 0x5c, 0xf3, 0x42, 0x2a, 0xd2, 0xf6, 0x62, 0xfd, 0x94, 0x74, 0x01, 0x98, 0x61, 0x0e,
-	0xd2, 0x4f, 0x1d, 0x1e, 0x54, 0x6f, 0xd6, 0x8f, 0xa8, 0x8b, 0x8f, 0xc4, 0x86, 0x7a, 0xf0, 0xa4,
-	0xa8, 0xb3, 0xe9, 0xfa, 0xc4, 0x30, 0x77, 0xe8, 0x2c, 0x67, 0x89, 0x5e, 0x60, 0x44, 0xff, 0xbf,
-	0xc3, 0x83, 0xea, 0x93, 0xf5, 0xa3, 0xab, 0xe3, 0xe3, 0xe0, 0x9c, 0xff, 0x04, 0xa0, 0xec, 0x3e,
-	0x3f, 0xea, 0xc0, 0x1e, 0x57, 0x0f, 0xec, 0x2f, 0x8c, 0xc0, 0x75, 0xca, 0x3e, 0x62, 0x31, 0x75,
-	0xd5, 0x70, 0x8d, 0xd6, 0x37, 0xe6, 0xd1, 0xf6, 0x8b, 0x1a, 0x3c, 0xb6, 0x93, 0x7f, 0x85, 0x14,
-	0x82, 0xf2, 0x4b, 0xa5, 0xae, 0xfa, 0xfd, 0x6e, 0xa5, 0x7c, 0x67, 0xf5, 0xad, 0x82, 0x8b, 0x3a,
-	0x85, 0x3e, 0x01, 0xb3, 0xae, 0x67, 0x91, 0x5a, 0xa3, 0x8e, 0x57, 0x8d, 0x60, 0xb7, 0x29, 0x5f,
-	0xfe, 0x46, 0xb8, 0xcd, 0xc9, 0x5a, 0x0a, 0x86, 0x33, 0xb5, 0xd1, 0x1e, 0xa0, 0x8e, 0x67, 0x2d,
-	0xef, 0xd9, 0xa6, 0x7c, 0x73, 0x2a, 0x6f, 0xe7, 0xc2, 0x1e, 0xb6, 0xd6, 0x33, 0xd8, 0x70, 0x0e,
-	0x05, 0x76, 0x07, 0xa6, 0x9d, 0x59, 0xf5, 0x5c, 0x3b, 0xf4, 0x7c, 0xe6, 0x07, 0x33, 0xd0, 0x55,
-	0x90, 0xdd, 0x81, 0xd7, 0x72, 0x31, 0xe2, 0x02, 0x4a, 0xfa, 0xff, 0xd0, 0xe0, 0x02, 0x5d, 0x16,
-	0xeb, 0xbe, 0xb7, 0xdf, 0xfb, 0x46, 0x5c, 0x90, 0xcf, 0x08, 0x23, 0x08, 0xae, 0xbb, 0xb9, 0xa2,
-	0x18, 0x40, 0x4c, 0xb0, 0x3e, 0xc7, 0x36, 0x0f, 0xaa, 0xfa, 0x6a, 0xa8, 0x58, 0x7d, 0xa5, 0x7f,
-	0xae, 0xc2, 0x45, 0x4c, 0xa9, 0x3e, 0xfa, 0x86, 0xdc, 0x87, 0x1f, 0x81, 0x69, 0x5a, 0xb6, 0x6a,
-	0xec, 0xaf, 0xd7, 0x5f, 0xf6, 0x1c, 0xe9, 0xca, 0xc3, 0xcc, 0x73, 0xef, 0xab, 0x00, 0x9c, 0xac,
-	0x87, 0x6e, 0xc3, 0x58, 0x87, 0x3b, 0x3c, 0x8b, 0xcb, 0xcd, 0x4d, 0x6e, 0x29, 0xc0, 0x8a, 0x1e,
-	0x1d, 0x54, 0x2f, 0xc6, 0x8f, 0x25, 0xa2, 0x10, 0xcb, 0x06, 0xfa, 0x67, 0xaf, 0x00, 0x43, 0xee,
-	0x90, 0xf0, 0x1b, 0x71, 0x4e, 0x9e, 0x85, 0x49, 0xb3, 0xd3, 0xad, 0xdd, 0x69, 0xbe, 0xd4, 0xf5,
-	0xd8, 0xa5, 0x95, 0xc5, 0x66, 0xa4, 0x32, 0x67, 0x6d, 0x7d, 0x53, 0x16, 0x63, 0xb5, 0x0e, 0xe5,
-	0x0e, 0x66, 0xa7, 0x2b, 0xf8, 0xed, 0xba, 0x6a, 0xa3, 0xca, 0xb8, 0x43, 0x6d, 0x7d, 0x33, 0x01,
-	0xc3, 0x99, 0xda, 0xe8, 0x07, 0x60, 0x8a, 0x88, 0x8d, 0x7b, 0xcf, 0xf0, 0x2d, 0xc1, 0x17, 0x1a,
-	0x65, 0x07, 0x1f, 0x4d, 0xad, 0xe4, 0x06, 0x5c, 0x54, 0x5f, 0x56, 0x48, 0xe0, 0x04, 0x41, 0xf4,
-	0x5d, 0x70, 0x4d, 0xfe, 0xa6, 0x5f, 0xd9, 0xb3, 0xd2, 0x8c, 0x62, 0x84, 0xfb, 0x98, 0x2e, 0x17,
-	0x55, 0xc2, 0xc5, 0xed, 0xd1, 0x2f, 0x68, 0x70, 0x35, 0x82, 0xda, 0xae, 0xdd, 0xee, 0xb6, 0x31,
-	0x31, 0x1d, 0xc3, 0x6e, 0x0b, 0x01, 0xfd, 0x95, 0x53, 0x1b, 0x68, 0x12, 0x3d, 0x67, 0x56, 0xf9,
-	0x30, 0x5c, 0xd0, 0x25, 0xf4, 0x45, 0x0d, 0x6e, 0x4a, 0xd0, 0xba, 0x4f, 0x82, 0xa0, 0xeb, 0x93,
-	0xd8, 0x91, 0x4c, 0x4c, 0xc9, 0x58, 0x29, 0xde, 0xc9, 0x24, 0x95, 0xe5, 0x23, 0x70, 0xe3, 0x23,
-	0xa9, 0xab, 0xcb, 0xa5, 0xe9, 0x6d, 0x87, 0x42, 0xa2, 0x3f, 0xab, 0xe5, 0x42, 0x49, 0xe0, 0x04,
-	0x41, 0xf4, 0xcf, 0x35, 0x78, 0x4c, 0x2d, 0x50, 0x57, 0x0b, 0x17, 0xe5, 0x5f, 0x3d, 0xb5, 0xce,
-	0xa4, 0xf0, 0x73, 0x5d, 0x70, 0x01, 0x10, 0x17, 0xf5, 0x8a, 0xb2, 0xed, 0x36, 0x5b, 0x98, 0x5c,
-	0xdc, 0x1f, 0xe1, 0x6c, 0x9b, 0xaf, 0xd5, 0x00, 0x4b, 0x18, 0xbd, 0xe8, 0x76, 0x3c, 0x6b, 0xdd,
-	0xb6, 0x82, 0x15, 0xbb, 0x6d, 0x87, 0x4c, 0x28, 0x1f, 0xe2, 0xd3, 0xb1, 0xee, 0x59, 0xeb, 0x8d,
-	0x3a, 0x2f, 0xc7, 0x89, 0x5a, 0xcc, 0xa5, 0xdb, 0x6e, 0x1b, 0x2d, 0xb2, 0xde, 0x75, 0x9c, 0x75,
-	0xdf, 0x63, 0x0a, 0xc3, 0x3a, 0x31, 0x2c, 0xc7, 0x76, 0x49, 0x49, 0x21, 0x9c, 0x6d, 0xb7, 0x46,
-	0x11, 0x52, 0x5c, 0x4c, 0x0f, 0x2d, 0x00, 0x6c, 0x1b, 0xb6, 0xd3, 0x7c, 0x68, 0x74, 0x1e, 0xb8,
-	0x4c, 0x52, 0x1f, 0xe7, 0x57, 0xd8, 0x3b, 0x51, 0x29, 0x56, 0x6a, 0xd0, 0xd5, 0x44, 0xb9, 0x20,
-	0x26, 0x3c, 0x94, 0x10, 0x93, 0xaa, 0x4f, 0x63, 0x35, 0x49, 0x84, 0x7c, 0xfa, 0xee, 0x2b, 0x24,
-	0x70, 0x82, 0x20, 0xfa, 0xb4, 0x06, 0x33, 0x41, 0x2f, 0x08, 0x49, 0x3b, 0xea, 0xc3, 0x85, 0xd3,
-	0xee, 0x03, 0x53, 0xa5, 0x36, 0x13, 0x44, 0x70, 0x8a, 0x28, 0x32, 0xe0, 0x3a, 0x9b, 0xd5, 0xbb,
-	0xb5, 0x7b, 0x76, 0x6b, 0x27, 0x72, 0xd4, 0x5e, 0x27, 0xbe, 0x49, 0xdc, 0x70, 0x6e, 0x96, 0xad,
-	0x1b, 0x66, 0x4a, 0xd3, 0x28, 0xae, 0x86, 0xfb, 0xe1, 0x40, 0xaf, 0xc3, 0xbc, 0x00, 0xaf, 0x78,
-	0x0f, 0x33, 0x14, 0x2e, 0x32, 0x0a, 0xcc, 0x74, 0xa8, 0x51, 0x58, 0x0b, 0xf7, 0xc1, 0x80, 0x1a,
-	0x70, 0x29, 0x20, 0x3e, 0x7b, 0x09, 0x21, 0xd1, 0xe2, 0x09, 0xe6, 0x50, 0x6c, 0x35, 0xdc, 0xcc,
-	0x82, 0x71, 0x5e, 0x1b, 0xf4, 0x42, 0xe4, 0x98, 0xd4, 0xa3, 0x05, 0x2f, 0xad, 0x37, 0xe7, 0x2e,
-	0xb1, 0xfe, 0x5d, 0x52, 0xfc, 0x8d, 0x24, 0x08, 0xa7, 0xeb, 0x52, 0xd9, 0x42, 0x16, 0x2d, 0x75,
-	0xfd, 0x20, 0x9c, 0xbb, 0xcc, 0x1a, 0x33, 0xd9, 0x02, 0xab, 0x00, 0x9c, 0xac, 0x87, 0x6e, 0xc3,
-	0x4c, 0x40, 0x4c, 0xd3, 0x6b, 0x77, 0xc4, 0xf5, 0x6a, 0xee, 0x0a, 0xeb, 0x3d, 0xff, 0x82, 0x09,
-	0x08, 0x4e, 0xd5, 0x44, 0x3d, 0xb8, 0x14, 0x05, 0xd6, 0x59, 0xf1, 0x5a, 0xab, 0xc6, 0x3e, 0x13,
-	0xd5, 0xaf, 0x1e, 0xbd, 0x03, 0x17, 0xe4, 0xd3, 0xf6, 0xc2, 0x4b, 0x5d, 0xc3, 0x0d, 0xed, 0xb0,
-	0xc7, 0xa7, 0xab, 0x96, 0x45, 0x87, 0xf3, 0x68, 0xa0, 0x15, 0xb8, 0x9c, 0x2a, 0xbe, 0x63, 0x3b,
-	0x24, 0x98, 0x7b, 0x8c, 0x0d, 0x9b, 0xe9, 0x48, 0x6a, 0x39, 0x70, 0x9c, 0xdb, 0x0a, 0x3d, 0x80,
-	0x2b, 0x1d, 0xdf, 0x0b, 0x89, 0x19, 0xde, 0xa7, 0xe2, 0x89, 0x23, 0x06, 0x18, 0xcc, 0xcd, 0xb1,
-	0xb9, 0x60, 0xaf, 0x40, 0xeb, 0x79, 0x15, 0x70, 0x7e, 0x3b, 0xf4, 0x05, 0x0d, 0x6e, 0x04, 0xa1,
-	0x4f, 0x8c, 0xb6, 0xed, 0xb6, 0x6a, 0x9e, 0xeb, 0x12, 0xc6, 0x26, 0x1b, 0x56, 0x6c, 0x74, 0x7f,
-	0xad, 0x14, 0x9f, 0xd2, 0x0f, 0x0f, 0xaa, 0x37, 0x9a, 0x7d, 0x31, 0xe3, 0x23, 0x28, 0xa3, 0xb7,
-	0x01, 0xda, 0xa4, 0xed, 0xf9, 0x3d, 0xca, 0x91, 0xe6, 0xe6, 0xcb, 0x1b, 0x31, 0xad, 0x46, 0x58,
-	0xf8, 0xf6, 0x4f, 0xbc, 0x5f, 0xc5, 0x40, 0xac, 0x90, 0xd3, 0x0f, 0x2a, 0x70, 0x25, 0xf7, 0xe0,
-	0xa1, 0x3b, 0x80, 0xd7, 0x5b, 0x94, 0x41, 0x76, 0xc5, 0x93, 0x0f, 0xdb, 0x01, 0xab, 0x49, 0x10,
-	0x4e, 0xd7, 0xa5, 0x62, 0x21, 0xdb, 0xa9, 0x77, 0x9a, 0x71, 0xfb, 0x4a, 0x2c, 0x16, 0x36, 0x52,
-	0x30, 0x9c, 0xa9, 0x8d, 0x6a, 0x70, 0x51, 0x94, 0x35, 0xe8, 0xcd, 0x2a, 0xb8, 0xe3, 0x13, 0x29,
-	0x70, 0xd3, 0x3b, 0xca, 0xc5, 0x46, 0x1a, 0x88, 0xb3, 0xf5, 0xe9, 0x28, 0xe8, 0x0f, 0xb5, 0x17,
-	0xc3, 0xf1, 0x28, 0xd6, 0x92, 0x20, 0x9c, 0xae, 0x2b, 0xaf, 0xbe, 0x89, 0x2e, 0x8c, 0xc4, 0xa3,
-	0x58, 0x4b, 0xc1, 0x70, 0xa6, 0xb6, 0xfe, 0x1f, 0x87, 0xe1, 0xc9, 0x63, 0x08, 0x6b, 0xa8, 0x9d,
-	0x3f, 0xdd, 0x27, 0xdf, 0xb8, 0xc7, 0xfb, 0x3c, 0x9d, 0x82, 0xcf, 0x73, 0x72, 0x7a, 0xc7, 0xfd,
-	0x9c, 0x41, 0xd1, 0xe7, 0x3c, 0x39, 0xc9, 0xe3, 0x7f, 0xfe, 0x76, 0xfe, 0xe7, 0x2f, 0x39, 0xab,
-	0x47, 0x2e, 0x97, 0x4e, 0xc1, 0x72, 0x29, 0x39, 0xab, 0xc7, 0x58, 0x5e, 0x7f, 0x34, 0x0c, 0x4f,
-	0x1d, 0x47, 0x70, 0x2c, 0xb9, 0xbe, 0x72, 0x58, 0xde, 0x99, 0xae, 0xaf, 0x22, 0xbf, 0xa6, 0x33,
-	0x5c, 0x5f, 0x39, 0x24, 0xcf, 0x7a, 0x7d, 0x15, 0xcd, 0xea, 0x59, 0xad, 0xaf, 0xa2, 0x59, 0x3d,
-	0xc6, 0xfa, 0xfa, 0x8b, 0xf4, 0xf9, 0x10, 0xc9, 0x8b, 0x0d, 0x18, 0x32, 0x3b, 0xdd, 0x92, 0x4c,
-	0x8a, 0x19, 0x08, 0xd5, 0xd6, 0x37, 0x31, 0xc5, 0x81, 0x30, 0x8c, 0xf2, 0xf5, 0x53, 0x92, 0x05,
-	0x31, 0x0f, 0x19, 0xbe, 0x24, 0xb1, 0xc0, 0x44, 0xa7, 0x8a, 0x74, 0x76, 0x48, 0x9b, 0xf8, 0x86,
-	0xd3, 0x0c, 0x3d, 0xdf, 0x68, 0x95, 0xe5, 0x36, 0x6c, 0xaa, 0x96, 0x53, 0xb8, 0x70, 0x06, 0x3b,
-	0x9d, 0x90, 0x8e, 0x6d, 0x95, 0xe4, 0x2f, 0x6c, 0x42, 0xd6, 0x1b, 0x75, 0x4c, 0x71, 0xe8, 0xff,
-	0x70, 0x02, 0x94, 0xc0, 0x75, 0xe8, 0xbb, 0xe0, 0x9a, 0xe1, 0x38, 0xde, 0xc3, 0x75, 0xdf, 0xde,
-	0xb3, 0x1d, 0xd2, 0x22, 0x56, 0x24, 0x4c, 0x05, 0xc2, 0x8c, 0x8c, 0x5d, 0x98, 0x16, 0x8b, 0x2a,
-	0xe1, 0xe2, 0xf6, 0xe8, 0x1d, 0x0d, 0x2e, 0x9a, 0xe9, 0x60, 0x61, 0x83, 0x18, 0x9a, 0x64, 0x22,
-	0x8f, 0xf1, 0xfd, 0x94, 0x29, 0xc6, 0x59, 0xb2, 0xe8, 0x07, 0x35, 0xae, 0x94, 0x8b, 0x9e, 0x49,
-	0xc4, 0x37, 0xbb, 0x7b, 0x4a, 0x0f, 0x8a, 0xb1, 0x76, 0x2f, 0x7e, 0xbb, 0x4a, 0x12, 0x44, 0x5f,
-	0xd4, 0xe0, 0xca, 0x6e, 0xde, 0x5b, 0x82, 0xf8, 0xb2, 0x0f, 0xca, 0x76, 0xa5, 0xe0, 0x71, 0x82,
-	0x8b, 0xb3, 0xb9, 0x15, 0x70, 0x7e, 0x47, 0xa2, 0x59, 0x8a, 0xd4, 0xab, 0x82, 0x09, 0x94, 0x9e,
-	0xa5, 0x94, 0x9e, 0x36, 0x9e, 0xa5, 0x08, 0x80, 0x93, 0x04, 0x51, 0x07, 0x26, 0x76, 0xa5, 0x4e,
-	0x5b, 0xe8, 0xb1, 0x6a, 0x65, 0xa9, 0x2b, 0x8a, 0x71, 0x6e, 0x48, 0x13, 0x15, 0xe2, 0x98, 0x08,
-	0xda, 0x81, 0xb1, 0x5d, 0xce, 0x88, 0x84, 0xfe, 0x69, 0x71, 0xe0, 0xfb, 0x31, 0x57, 0x83, 0x88,
-	0x22, 0x2c, 0xd1, 0xab, 0x56, 0xb4, 0xe3, 0x47, 0x38, 0x77, 0x7c, 0x41, 0x83, 0x2b, 0x7b, 0xc4,
-	0x0f, 0x6d, 0x33, 0xfd, 0x92, 0x33, 0x51, 0xfe, 0x0e, 0xff, 0x72, 0x1e, 0x42, 0xbe, 0x4c, 0x72,
-	0x41, 0x38, 0xbf, 0x0b, 0xf4, 0x46, 0xcf, 0x15, 0xf2, 0xcd, 0xd0, 0x08, 0x6d, 0x73, 0xc3, 0xdb,
-	0x25, 0x6e, 0x9c, 0x5f, 0x85, 0x69, 0x82, 0xc6, 0xf9, 0x8d, 0x7e, 0xb9, 0xb8, 0x1a, 0xee, 0x87,
-	0x43, 0xff, 0x53, 0x0d, 0x32, 0x6a, 0x65, 0xf4, 0xe3, 0x1a, 0x4c, 0x6d, 0x13, 0x23, 0xec, 0xfa,
-	0xe4, 0xae, 0x11, 0x46, 0x1e, 0xe7, 0x2f, 0x9f, 0x86, 0x36, 0x7b, 0xe1, 0x8e, 0x82, 0x98, 0x1b,
-	0x04, 0x44, 0x41, 0x2f, 0x55, 0x10, 0x4e, 0xf4, 0x60, 0xfe, 0x45, 0xb8, 0x98, 0x69, 0x78, 0xa2,
-	0x17, 0xc6, 0x7f, 0xa5, 0x41, 0x5e, 0x4a, 0x20, 0xf4, 0x3a, 0x8c, 0x18, 0x96, 0x15, 0xc5, 0xf8,
-	0x7f, 0xbe, 0x9c, 0x6d, 0x8a, 0xa5, 0x3a, 0xf6, 0xb3, 0x9f, 0x98, 0xa3, 0x45, 0x77, 0x00, 0x19,
-	0x89, 0x17, 0xee, 0xd5, 0xd8, 0x5d, 0x95, 0xbd, 0x84, 0x2d, 0x66, 0xa0, 0x38, 0xa7, 0x85, 0xfe,
-	0x23, 0x1a, 0xa0, 0x6c, 0x98, 0x54, 0xe4, 0xc3, 0xb8, 0x58, 0xca, 0xf2, 0x2b, 0xd5, 0x4b, 0xba,
-	0x94, 0x24, 0xfc, 0xa3, 0x62, 0x43, 0x27, 0x51, 0x10, 0xe0, 0x88, 0x8e, 0xfe, 0x97, 0x1a, 0xc4,
-	0x71, 0xc0, 0xd1, 0x87, 0x61, 0xd2, 0x22, 0x81, 0xe9, 0xdb, 0x9d, 0x30, 0xf6, 0xa6, 0x8a, 0xbc,
-	0x32, 0xea, 0x31, 0x08, 0xab, 0xf5, 0x90, 0x0e, 0xa3, 0xa1, 0x11, 0xec, 0x36, 0xea, 0xe2, 0x52,
-	0xc9, 0x44, 0x80, 0x0d, 0x56, 0x82, 0x05, 0x24, 0x0e, 0x19, 0x36, 0x74, 0x8c, 0x90, 0x61, 0x68,
-	0xfb, 0x14, 0xe2, 0xa3, 0xa1, 0xa3, 0x63, 0xa3, 0xe9, 0x3f, 0x5f, 0x81, 0x0b, 0xb4, 0xca, 0xaa,
-	0x61, 0xbb, 0x21, 0x71, 0x99, 0xef, 0x40, 0xc9, 0x49, 0x68, 0xc1, 0x74, 0x98, 0xf0, 0x8d, 0x3b,
-	0xb9, 0x67, 0x59, 0x64, 0x4d, 0x93, 0xf4, 0x88, 0x4b, 0xe2, 0x45, 0xcf, 0x4b, 0xe7, 0x0d, 0x7e,
-	0xfd, 0x7e, 0x52, 0x2e, 0x55, 0xe6, 0x91, 0xf1, 0x48, 0x38, 0x1a, 0x46, 0xc1, 0xe3, 0x13, 0x7e,
-	0x1a, 0x1f, 0x81, 0x69, 0x61, 0x44, 0xcd, 0x63, 0xbf, 0x89, 0xeb, 0x37, 0x3b, 0x61, 0xee, 0xa8,
-	0x00, 0x9c, 0xac, 0xa7, 0xff, 0x7e, 0x05, 0x92, 0x21, 0xea, 0xcb, 0xce, 0x52, 0x36, 0xf0, 0x5d,
-	0xe5, 0xcc, 0x02, 0xdf, 0x7d, 0x90, 0xe5, 0x77, 0xe1, 0x89, 0xc0, 0xf8, 0x13, 0xb9, 0x9a, 0x95,
-	0x85, 0xa7, 0xf1, 0x8a, 0x6a, 0xc4, 0xd3, 0x3a, 0x7c, 0xe2, 0x69, 0xfd, 0xb0, 0xb0, 0xae, 0x1c,
-	0x49, 0x84, 0x1f, 0x94, 0xd6, 0x95, 0x17, 0x13, 0x0d, 0x15, 0x57, 0x93, 0x2f, 0x6b, 0x30, 0x26,
-	0x62, 0x03, 0x1f, 0xc3, 0x95, 0x69, 0x1b, 0x46, 0xd8, 0x95, 0x67, 0x10, 0x69, 0xb0, 0xb9, 0xe3,
-	0x79, 0x61, 0x22, 0x42, 0x32, 0xf3, 0x1d, 0x60, 0xff, 0x62, 0x8e, 0x9e, 0x19, 0xd8, 0xf9, 0xe6,
-	0x8e, 0x1d, 0x12, 0x33, 0x94, 0x71, 0x57, 0xa5, 0x81, 0x9d, 0x52, 0x8e, 0x13, 0xb5, 0xf4, 0x9f,
-	0x1a, 0x86, 0x9b, 0x02, 0x71, 0x46, 0x44, 0x8a, 0x18, 0x5c, 0x0f, 0x2e, 0x89, 0x6f, 0x5b, 0xf7,
-	0x0d, 0x3b, 0x32, 0x3d, 0x28, 0x77, 0xf5, 0x15, 0xc9, 0xee, 0x32, 0xe8, 0x70, 0x1e, 0x0d, 0x1e,
-	0x41, 0x94, 0x15, 0xdf, 0x23, 0x86, 0x13, 0xee, 0x48, 0xda, 0x95, 0x41, 0x22, 0x88, 0x66, 0xf1,
-	0xe1, 0x5c, 0x2a, 0xcc, 0xf4, 0x41, 0x00, 0x6a, 0x3e, 0x31, 0x54, 0xbb, 0x8b, 0x01, 0xcc, 0xff,
-	0x57, 0x73, 0x31, 0xe2, 0x02, 0x4a, 0x4c, 0x87, 0x68, 0xec, 0x33, 0x95, 0x04, 0x26, 0xa1, 0x6f,
-	0xb3, 0x48, 0xd7, 0x91, 0x16, 0x7d, 0x35, 0x09, 0xc2, 0xe9, 0xba, 0xe8, 0x36, 0xcc, 0x30, 0x53,
-	0x92, 0x38, 0xd4, 0xd5, 0x48, 0x1c, 0x4d, 0x61, 0x2d, 0x01, 0xc1, 0xa9, 0x9a, 0xfa, 0x27, 0x2b,
-	0x30, 0xa5, 0x2e, 0xbb, 0x63, 0xf8, 0x35, 0x75, 0x95, 0xc3, 0x70, 0x00, 0x9f, 0x1b, 0x95, 0xea,
-	0x31, 0xce, 0x43, 0xf4, 0x2a, 0xcc, 0x74, 0x19, 0x07, 0x91, 0xe1, 0x3a, 0xc4, 0xfa, 0xff, 0x16,
-	0x3a, 0xca, 0xcd, 0x04, 0xe4, 0xd1, 0x41, 0x75, 0x5e, 0x45, 0x9f, 0x84, 0xe2, 0x14, 0x1e, 0xfd,
-	0xb3, 0x43, 0x70, 0x29, 0xa7, 0x37, 0xcc, 0xe4, 0x80, 0xa4, 0x8e, 0xec, 0x41, 0x4c, 0x0e, 0x32,
-	0xc7, 0x7f, 0x64, 0x72, 0x90, 0x86, 0xe0, 0x0c, 0x5d, 0xf4, 0x32, 0x0c, 0x99, 0xbe, 0x2d, 0x26,
-	0xfc, 0x23, 0xa5, 0x2e, 0x9c, 0xb8, 0xb1, 0x34, 0x29, 0x28, 0x0e, 0xd5, 0x70, 0x03, 0x53, 0x84,
-	0xf4, 0xe0, 0x51, 0xd9, 0x85, 0x94, 0x02, 0xd8, 0xc1, 0xa3, 0x72, 0x95, 0x00, 0x27, 0xeb, 0xa1,
-	0x57, 0x61, 0x4e, 0xdc, 0x04, 0xa4, 0x8f, 0xb4, 0xe7, 0x06, 0x21, 0xdd, 0xd9, 0xa1, 0x60, 0xd4,
-	0x8f, 0x1f, 0x1e, 0x54, 0xe7, 0xee, 0x17, 0xd4, 0xc1, 0x85, 0xad, 0xf5, 0x3f, 0x1f, 0x82, 0x49,
-	0x25, 0x32, 0x3b, 0x5a, 0x1d, 0x44, 0x85, 0x12, 0x8f, 0x58, 0xaa, 0x51, 0x56, 0x61, 0xa8, 0xd5,
-	0xe9, 0x96, 0xd4, 0xa1, 0x44, 0xe8, 0xee, 0x52, 0x74, 0xad, 0x4e, 0x17, 0xbd, 0x1c, 0x69, 0x65,
-	0xca, 0xe9, 0x4d, 0x22, 0x8f, 0x96, 0x94, 0x66, 0x46, 0x6e, 0xc4, 0xe1, 0xc2, 0x8d, 0xd8, 0x86,
-	0xb1, 0x40, 0xa8, 0x6c, 0x46, 0xca, 0x47, 0xa5, 0x51, 0x66, 0x5a, 0xa8, 0x68, 0xf8, 0x7d, 0x4f,
-	0x6a, 0x70, 0x24, 0x0d, 0x2a, 0x4b, 0x76, 0x99, 0x9f, 0x2c, 0xbb, 0xc8, 0x8e, 0x73, 0x59, 0x72,
-	0x93, 0x95, 0x60, 0x01, 0xc9, 0x1c, 0x51, 0x63, 0xc7, 0x3a, 0xa2, 0xfe, 0x4e, 0x05, 0x50, 0xb6,
-	0x1b, 0xe8, 0x49, 0x18, 0x61, 0x7e, 0xf6, 0x82, 0x17, 0x45, 0x92, 0x3f, 0xf3, 0xb4, 0xc6, 0x1c,
-	0x86, 0x9a, 0x22, 0xc6, 0x46, 0xb9, 0xcf, 0xc9, 0x6c, 0x76, 0x04, 0x3d, 0x25, 0x20, 0xc7, 0xcd,
-	0x84, 0x53, 0x46, 0xde, 0x99, 0xbf, 0x09, 0x63, 0x6d, 0xdb, 0x65, 0x0f, 0x87, 0xe5, 0x34, 0x59,
-	0xdc, 0xb4, 0x80, 0xa3, 0xc0, 0x12, 0x97, 0xfe, 0x47, 0x15, 0xba, 0xf4, 0x63, 0x89, 0xb7, 0x07,
-	0x60, 0x74, 0x43, 0x8f, 0x33, 0x30, 0xb1, 0x03, 0x1a, 0xe5, 0xbe, 0x72, 0x84, 0x74, 0x31, 0x42,
-	0xc8, 0x9f, 0xbc, 0xe2, 0xdf, 0x58, 0x21, 0x46, 0x49, 0x87, 0x76, 0x9b, 0xbc, 0x62, 0xbb, 0x96,
-	0xf7, 0x50, 0x4c, 0xef, 0xa0, 0xa4, 0x37, 0x22, 0x84, 0x9c, 0x74, 0xfc, 0x1b, 0x2b, 0xc4, 0x28,
-	0x6b, 0x61, 0x17, 0x67, 0x97, 0xa5, 0xca, 0x10, 0x7d, 0xf3, 0x1c, 0x47, 0x9e, 0xca, 0xe3, 0x9c,
-	0xb5, 0xd4, 0x0a, 0xea, 0xe0, 0xc2, 0xd6, 0xfa, 0x2f, 0x68, 0x70, 0x25, 0x77, 0x2a, 0xd0, 0x5d,
-	0xb8, 0x18, 0x9b, 0x79, 0xa9, 0xcc, 0x7e, 0x3c, 0x4e, 0xd1, 0x72, 0x3f, 0x5d, 0x01, 0x67, 0xdb,
-	0xf0, 0x3c, 0xc0, 0x99, 0xc3, 0x44, 0xd8, 0x88, 0xa9, 0xa2, 0x91, 0x0a, 0xc6, 0x79, 0x6d, 0xf4,
-	0xef, 0x4a, 0x74, 0x36, 0x9e, 0x2c, 0xba, 0x33, 0xb6, 0x48, 0x2b, 0x72, 0x8a, 0x8b, 0x76, 0xc6,
-	0x12, 0x2d, 0xc4, 0x1c, 0x86, 0x9e, 0x50, 0x5d, 0x4d, 0x23, 0xbe, 0x25, 0xdd, 0x4d, 0xf5, 0xef,
-	0x81, 0xc7, 0x0a, 0x5e, 0x42, 0x51, 0x1d, 0xa6, 0x82, 0x87, 0x46, 0x67, 0x89, 0xec, 0x18, 0x7b,
-	0xb6, 0x08, 0x5d, 0xc0, 0xcd, 0xf7, 0xa6, 0x9a, 0x4a, 0xf9, 0xa3, 0xd4, 0x6f, 0x9c, 0x68, 0xa5,
-	0x87, 0x00, 0xc2, 0xcc, 0xd3, 0x76, 0x5b, 0x68, 0x1b, 0xc6, 0x0d, 0x91, 0x86, 0x56, 0xac, 0xe3,
-	0x6f, 0x2f, 0xa5, 0x04, 0x10, 0x38, 0xb8, 0xfd, 0xb9, 0xfc, 0x85, 0x23, 0xdc, 0xfa, 0x3f, 0xd1,
-	0xe0, 0x6a, 0xbe, 0xb3, 0xfa, 0x31, 0x44, 0x9b, 0x36, 0x4c, 0xfa, 0x71, 0x33, 0xb1, 0xe8, 0xbf,
-	0x4d, 0x8d, 0x56, 0xaa, 0x84, 0xe7, 0xa2, 0x62, 0x5f, 0xcd, 0xf7, 0x02, 0xf9, 0xe5, 0xd3, 0x01,
-	0x4c, 0xa3, 0x2b, 0x97, 0xd2, 0x13, 0xac, 0xe2, 0xd7, 0x7f, 0xad, 0x02, 0xb0, 0x46, 0xc2, 0x87,
-	0x9e, 0xbf, 0x4b, 0xa7, 0xe8, 0xf1, 0xc4, 0x4d, 0x63, 0xfc, 0xeb, 0x17, 0x30, 0xe1, 0x71, 0x18,
-	0xee, 0x78, 0x56, 0x20, 0xd8, 0x1f, 0xeb, 0x08, 0xb3, 0x80, 0x62, 0xa5, 0xa8, 0x0a, 0x23, 0xec,
-	0xe1, 0x43, 0x9c, 0x4c, 0xec, 0x9e, 0x42, 0xa5, 0xcc, 0x00, 0xf3, 0x72, 0x9e, 0x5c, 0x8c, 0xf9,
-	0x74, 0x04, 0xe2, 0xe2, 0x25, 0x92, 0x8b, 0xf1, 0x32, 0x1c, 0x41, 0xd1, 0x6d, 0x00, 0xbb, 0x73,
-	0xc7, 0x68, 0xdb, 0x0e, 0x95, 0x79, 0x47, 0xa3, 0x5c, 0xb6, 0xd0, 0x58, 0x97, 0xa5, 0x8f, 0x0e,
-	0xaa, 0xe3, 0xe2, 0x57, 0x0f, 0x2b, 0xb5, 0xf5, 0xbf, 0x1a, 0x82, 0x44, 0xde, 0xe7, 0x58, 0xc7,
-	0xa4, 0x9d, 0x8d, 0x8e, 0xe9, 0x55, 0x98, 0x73, 0x3c, 0xc3, 0x5a, 0x32, 0x1c, 0xba, 0x1b, 0xfd,
-	0x26, 0xff, 0x8c, 0x86, 0xdb, 0x8a, 0x92, 0xfb, 0x32, 0xae, 0xb4, 0x52, 0x50, 0x07, 0x17, 0xb6,
-	0x46, 0x61, 0x94, 0x6d, 0x7a, 0xa8, 0xbc, 0xfb, 0xa3, 0x3a, 0x17, 0x0b, 0xaa, 0x27, 0x50, 0x24,
-	0x60, 0xa4, 0x12, 0x52, 0x7f, 0x4a, 0x83, 0x2b, 0x64, 0x9f, 0x7b, 0xc2, 0x6d, 0xf8, 0xc6, 0xf6,
-	0xb6, 0x6d, 0x0a, 0xbb, 0x54, 0xfe, 0x61, 0x57, 0x0e, 0x0f, 0xaa, 0x57, 0x96, 0xf3, 0x2a, 0x3c,
-	0x3a, 0xa8, 0xde, 0xca, 0x75, 0x4c, 0x64, 0x9f, 0x35, 0xb7, 0x09, 0xce, 0x27, 0x35, 0xff, 0x3c,
-	0x4c, 0x9e, 0xc0, 0x9b, 0x21, 0xe1, 0x7e, 0xf8, 0xeb, 0x15, 0x98, 0xa2, 0xeb, 0x6e, 0xc5, 0x33,
-	0x0d, 0xa7, 0xbe, 0xd6, 0x3c, 0x41, 0xb6, 0x74, 0xb4, 0x02, 0x97, 0xb7, 0x3d, 0xdf, 0x24, 0x1b,
-	0xb5, 0xf5, 0x0d, 0x4f, 0x3c, 0xb9, 0xd4, 0xd7, 0x9a, 0x82, 0x4b, 0xb3, 0x4b, 0xe4, 0x9d, 0x1c,
-	0x38, 0xce, 0x6d, 0x85, 0x1e, 0xc0, 0x95, 0xb8, 0x7c, 0xb3, 0xc3, 0x0d, 0x59, 0x28, 0xba, 0xa1,
-	0xd8, 0x10, 0xe7, 0x4e, 0x5e, 0x05, 0x9c, 0xdf, 0x0e, 0x19, 0x70, 0x5d, 0xc4, 0x24, 0xb9, 0xe3,
-	0xf9, 0x0f, 0x0d, 0xdf, 0x4a, 0xa2, 0x1d, 0x8e, 0x55, 0xd2, 0xf5, 0xe2, 0x6a, 0xb8, 0x1f, 0x0e,
-	0xfd, 0xa7, 0x47, 0x41, 0x71, 0x57, 0x3b, 0x41, 0x3a, 0xaa, 0x9f, 0xd3, 0xe0, 0xb2, 0xe9, 0xd8,
-	0xc4, 0x0d, 0x53, 0xbe, 0x49, 0x9c, 0x1d, 0x6d, 0x96, 0xf2, 0xa3, 0xeb, 0x10, 0xb7, 0x51, 0x17,
-	0x76, 0x3f, 0xb5, 0x1c, 0xe4, 0xc2, 0x36, 0x2a, 0x07, 0x82, 0x73, 0x3b, 0xc3, 0xc6, 0xc3, 0xca,
-	0x1b, 0x75, 0x35, 0x98, 0x42, 0x4d, 0x94, 0xe1, 0x08, 0x8a, 0x9e, 0x85, 0xc9, 0x96, 0xef, 0x75,
-	0x3b, 0x41, 0x8d, 0x19, 0x1b, 0xf3, 0xb5, 0xcf, 0xe4, 0xc2, 0xbb, 0x71, 0x31, 0x56, 0xeb, 0x50,
-	0x29, 0x97, 0xff, 0x5c, 0xf7, 0xc9, 0xb6, 0xbd, 0x2f, 0x98, 0x1c, 0x93, 0x72, 0xef, 0x2a, 0xe5,
-	0x38, 0x51, 0x8b, 0xf9, 0x43, 0x07, 0x41, 0x97, 0xf8, 0x9b, 0x78, 0x45, 0xe4, 0x71, 0xe0, 0xfe,
-	0xd0, 0xb2, 0x10, 0xc7, 0x70, 0xf4, 0x13, 0x1a, 0xcc, 0xf8, 0xe4, 0xcd, 0xae, 0xed, 0x13, 0x8b,
-	0x11, 0x0d, 0x84, 0xcf, 0x20, 0x1e, 0xcc, 0x4f, 0x71, 0x01, 0x27, 0x90, 0x72, 0x0e, 0x11, 0xa9,
-	0xed, 0x92, 0x40, 0x9c, 0xea, 0x01, 0x9d, 0xaa, 0xc0, 0x6e, 0xb9, 0xb6, 0xdb, 0x5a, 0x74, 0x5a,
-	0xc1, 0xdc, 0x38, 0x63, 0x7a, 0x5c, 0x84, 0x8e, 0x8b, 0xb1, 0x5a, 0x87, 0x5e, 0x2f, 0xbb, 0x01,
-	0xdd, 0xf7, 0x6d, 0xc2, 0xe7, 0x77, 0x22, 0xd6, 0x6b, 0x6e, 0xaa, 0x00, 0x9c, 0xac, 0x87, 0x6e,
-	0xc3, 0x8c, 0x2c, 0x10, 0xb3, 0x0c, 0x3c, 0x8a, 0x1e, 0xbb, 0xee, 0x27, 0x20, 0x38, 0x55, 0x73,
-	0x7e, 0x11, 0x2e, 0xe5, 0x0c, 0xf3, 0x44, 0xcc, 0xe5, 0xff, 0x6a, 0x70, 0x85, 0xe7, 0xd2, 0x94,
-	0x19, 0x20, 0x64, 0xb8, 0xbc, 0xfc, 0xc8, 0x73, 0xda, 0x99, 0x46, 0x9e, 0xfb, 0x3a, 0x44, 0xd8,
-	0xd3, 0xff, 0x51, 0x05, 0xde, 0x7b, 0xe4, 0xbe, 0x44, 0x7f, 0x5f, 0x83, 0x49, 0xb2, 0x1f, 0xfa,
-	0x46, 0xe4, 0x91, 0x41, 0x17, 0xe9, 0xf6, 0x99, 0x30, 0x81, 0x85, 0xe5, 0x98, 0x10, 0x5f, 0xb8,
-	0x91, 0x88, 0xa5, 0x40, 0xb0, 0xda, 0x1f, 0x7a, 0x69, 0xe5, 0x51, 0x26, 0xd5, 0x07, 0x10, 0x91,
-	0xe2, 0x58, 0x40, 0xe6, 0x3f, 0x0e, 0xb3, 0x69, 0xcc, 0x27, 0x5a, 0x2b, 0xbf, 0x5a, 0x81, 0xb1,
-	0x75, 0xdf, 0xa3, 0xd2, 0xdf, 0x39, 0x84, 0x55, 0x30, 0x12, 0x91, 0xd7, 0x4b, 0x79, 0x4a, 0x8b,
-	0xce, 0x16, 0x66, 0x7d, 0xb0, 0x53, 0x59, 0x1f, 0x16, 0x07, 0x21, 0xd2, 0x3f, 0xcd, 0xc3, 0xef,
-	0x6a, 0x30, 0x29, 0x6a, 0x9e, 0x43, 0xf0, 0x80, 0xef, 0x4d, 0x06, 0x0f, 0xf8, 0xd8, 0x00, 0xe3,
-	0x2a, 0x88, 0x1a, 0xf0, 0x05, 0x0d, 0xa6, 0x45, 0x8d, 0x55, 0xd2, 0xde, 0x22, 0x3e, 0xba, 0x03,
-	0x63, 0x41, 0x97, 0x7d, 0x48, 0x31, 0xa0, 0xeb, 0xea, 0x7d, 0xc2, 0xdf, 0x32, 0x4c, 0x96, 0xa7,
-	0x9b, 0x57, 0x51, 0x72, 0x29, 0xf0, 0x02, 0x2c, 0x1b, 0xd3, 0xdb, 0x8b, 0xef, 0x39, 0x99, 0x70,
-	0x52, 0xd8, 0x73, 0x08, 0x66, 0x10, 0x2a, 0x98, 0xd3, 0xbf, 0x52, 0x85, 0xc7, 0x04, 0x73, 0x0a,
-	0x0e, 0x30, 0x2f, 0xd7, 0x3f, 0x3d, 0x1c, 0x4d, 0x36, 0x8b, 0x77, 0x7e, 0x0f, 0x26, 0x4c, 0x9f,
-	0x18, 0x21, 0xb1, 0x96, 0x7a, 0xc7, 0xe9, 0x1c, 0x3b, 0xae, 0x6a, 0xb2, 0x05, 0x8e, 0x1b, 0xd3,
-	0x93, 0x41, 0x7d, 0x73, 0xaa, 0xc4, 0x87, 0x68, 0xe1, 0x7b, 0xd3, 0xb7, 0xc3, 0x88, 0xf7, 0xd0,
-	0x8d, 0x4c, 0x57, 0xfa, 0x12, 0x66, 0x43, 0x79, 0x40, 0x6b, 0x63, 0xde, 0x48, 0x0d, 0xa7, 0x36,
-	0xdc, 0x27, 0x9c, 0x9a, 0x03, 0x63, 0x6d, 0xf6, 0x19, 0x06, 0x0a, 0xad, 0x9f, 0xf8, 0xa0, 0x6a,
-	0xf2, 0x25, 0x86, 0x19, 0x4b, 0x12, 0xf4, 0x84, 0xa7, 0xa7, 0x50, 0xd0, 0x31, 0x4c, 0xa2, 0x9e,
-	0xf0, 0x6b, 0xb2, 0x10, 0xc7, 0x70, 0xd4, 0x4b, 0xc6, 0xe9, 0x1b, 0x2b, 0xaf, 0xc1, 0x13, 0xdd,
-	0x53, 0x42, 0xf3, 0xf1, 0xa9, 0x2f, 0x8c, 0xd5, 0xf7, 0xa3, 0xc3, 0xd1, 0x22, 0x15, 0x99, 0x32,
-	0xf2, 0x73, 0x4b, 0x6b, 0xa5, 0x72, 0x4b, 0x7f, 0xab, 0x8c, 0x27, 0x5b, 0x49, 0x24, 0x0a, 0x8b,
-	0xe2, 0xc9, 0x4e, 0x09, 0xd2, 0x89, 0x18, 0xb2, 0x5d, 0xb8, 0x14, 0x84, 0x86, 0x43, 0x9a, 0xb6,
-	0xd0, 0x74, 0x04, 0xa1, 0xd1, 0xee, 0x94, 0x08, 0xe8, 0xca, 0xfd, 0x17, 0xb2, 0xa8, 0x70, 0x1e,
-	0x7e, 0xf4, 0x43, 0x1a, 0xcc, 0xb1, 0xf2, 0xc5, 0x6e, 0xe8, 0xf1, 0xc8, 0xe3, 0x31, 0xf1, 0x93,
-	0x3f, 0x6c, 0xb3, 0x0b, 0x60, 0xb3, 0x00, 0x1f, 0x2e, 0xa4, 0x84, 0xde, 0x86, 0x2b, 0xf4, 0x04,
-	0x5e, 0x34, 0x43, 0x7b, 0xcf, 0x0e, 0x7b, 0x71, 0x17, 0x4e, 0x1e, 0xc5, 0x95, 0x5d, 0x36, 0x56,
-	0xf2, 0x90, 0xe1, 0x7c, 0x1a, 0xfa, 0x5f, 0x68, 0x80, 0xb2, 0x4b, 0x08, 0x39, 0x30, 0x6e, 0x49,
-	0x87, 0x02, 0xed, 0x54, 0x82, 0x48, 0x46, 0x9c, 0x39, 0xf2, 0x43, 0x88, 0x28, 0x20, 0x0f, 0x26,
-	0x1e, 0xee, 0xd8, 0x21, 0x71, 0xec, 0x20, 0x3c, 0xa5, 0x98, 0x95, 0x51, 0x00, 0xb7, 0x57, 0x24,
-	0x62, 0x1c, 0xd3, 0xd0, 0x7f, 0x6c, 0x18, 0xc6, 0xa3, 0x10, 0xda, 0x47, 0xbf, 0xf1, 0x76, 0x01,
-	0x99, 0x4a, 0x1a, 0xb2, 0x41, 0x34, 0x30, 0x4c, 0x08, 0xab, 0x65, 0x90, 0xe1, 0x1c, 0x02, 0xe8,
-	0x6d, 0xb8, 0x6c, 0xbb, 0xdb, 0xbe, 0x11, 0x84, 0x7e, 0x97, 0xe9, 0xca, 0x07, 0xc9, 0xe6, 0xc5,
-	0xee, 0x50, 0x8d, 0x1c, 0x74, 0x38, 0x97, 0x08, 0x22, 0x30, 0xc6, 0x33, 0x05, 0xc8, 0x70, 0x82,
-	0xa5, 0xf2, 0xd2, 0xf2, 0x0c, 0x04, 0x31, 0xd7, 0xe4, 0xbf, 0x03, 0x2c, 0x71, 0xf3, 0x50, 0x1f,
-	0xfc, 0x7f, 0xf9, 0x1e, 0x2d, 0xd6, 0x7d, 0xad, 0x3c, 0xbd, 0x38, 0xc5, 0x31, 0x0f, 0xf5, 0x91,
-	0x2c, 0xc4, 0x69, 0x82, 0xfa, 0x6f, 0x6b, 0x30, 0xc2, 0x1d, 0x75, 0xcf, 0x5e, 0x82, 0xfb, 0x9e,
-	0x84, 0x04, 0x57, 0x2a, 0x21, 0x11, 0xeb, 0x6a, 0x61, 0xaa, 0x9c, 0x2f, 0x6b, 0x30, 0xc1, 0x6a,
-	0x9c, 0x83, 0x48, 0xf5, 0x7a, 0x52, 0xa4, 0x7a, 0xbe, 0xf4, 0x68, 0x0a, 0x04, 0xaa, 0xdf, 0x1e,
-	0x12, 0x63, 0x61, 0x12, 0x4b, 0x03, 0x2e, 0x09, 0x6b, 0xd8, 0x15, 0x7b, 0x9b, 0xd0, 0x25, 0x5e,
-	0x37, 0x7a, 0xfc, 0x81, 0x68, 0x44, 0xf8, 0x62, 0x65, 0xc1, 0x38, 0xaf, 0x0d, 0xfa, 0x75, 0x8d,
-	0xca, 0x06, 0xa1, 0x6f, 0x9b, 0x03, 0xe5, 0x9f, 0x89, 0xfa, 0xb6, 0xb0, 0xca, 0x91, 0xf1, 0x9b,
-	0xc9, 0x66, 0x2c, 0x24, 0xb0, 0xd2, 0x47, 0x07, 0xd5, 0x6a, 0x8e, 0xca, 0x2c, 0xce, 0x45, 0x11,
-	0x84, 0x9f, 0xfa, 0xe3, 0xbe, 0x55, 0x98, 0x9a, 0x5a, 0xf6, 0x18, 0xdd, 0x83, 0x91, 0xc0, 0xf4,
-	0x3a, 0xe4, 0x24, 0x19, 0xb5, 0xa2, 0x09, 0x6e, 0xd2, 0x96, 0x98, 0x23, 0x98, 0x7f, 0x03, 0xa6,
-	0xd4, 0x9e, 0xe7, 0xdc, 0x7c, 0xea, 0xea, 0xcd, 0xe7, 0xc4, 0x2f, 0x5d, 0xea, 0x4d, 0xe9, 0x37,
-	0x2a, 0x30, 0xca, 0xf3, 0x52, 0x1f, 0x43, 0x19, 0x6f, 0xcb, 0xa0, 0xff, 0x03, 0xa4, 0xdb, 0x57,
-	0x23, 0x64, 0xbe, 0xe6, 0xb9, 0xca, 0x1c, 0xa8, 0x71, 0xff, 0x91, 0x1b, 0xc5, 0x4d, 0x1d, 0x2a,
-	0x9f, 0xf5, 0x87, 0x0f, 0xec, 0xac, 0x23, 0xa5, 0xfe, 0x9e, 0x06, 0x53, 0x89, 0x40, 0xb4, 0x6d,
-	0x18, 0xf2, 0xa3, 0x7c, 0x70, 0x65, 0xdf, 0x2a, 0xa4, 0x4d, 0xd5, 0xf5, 0x3e, 0x95, 0x30, 0xa5,
-	0x13, 0xc5, 0xac, 0xad, 0x9c, 0x52, 0xcc, 0x5a, 0xfd, 0x73, 0x1a, 0x5c, 0x95, 0x03, 0x4a, 0x46,
-	0x64, 0x42, 0x4f, 0xc3, 0xb8, 0xd1, 0xb1, 0x99, 0x4a, 0x4d, 0x55, 0x4a, 0x2e, 0xae, 0x37, 0x58,
-	0x19, 0x8e, 0xa0, 0xe8, 0x83, 0x30, 0x2e, 0x17, 0x9e, 0x10, 0x3b, 0x23, 0x9e, 0x15, 0xbd, 0xbe,
-	0x44, 0x35, 0xd0, 0xfb, 0x94, 0xbc, 0x0c, 0x23, 0xb1, 0x9c, 0x10, 0x11, 0xe6, 0xaf, 0xc0, 0xfa,
-	0xb7, 0xc1, 0x44, 0xb3, 0x79, 0x6f, 0xd1, 0x34, 0x49, 0x10, 0x9c, 0x40, 0xb9, 0xac, 0x7f, 0x66,
-	0x08, 0xa6, 0x45, 0x68, 0x39, 0xdb, 0xb5, 0x6c, 0xb7, 0x75, 0x0e, 0x67, 0xca, 0x06, 0x4c, 0x70,
-	0x6d, 0xc6, 0x11, 0xb9, 0xfb, 0x9a, 0xb2, 0x52, 0x3a, 0x80, 0x73, 0x04, 0xc0, 0x31, 0x22, 0x74,
-	0x1f, 0x46, 0xdf, 0xa4, 0xfc, 0x4d, 0xee, 0x8b, 0x63, 0xb1, 0x99, 0x68, 0xd1, 0x33, 0xd6, 0x18,
-	0x60, 0x81, 0x02, 0x05, 0xcc, 0xe8, 0x8f, 0x09, 0x5c, 0x83, 0xc4, 0xae, 0x48, 0xcc, 0x6c, 0x94,
-	0x95, 0x65, 0x4a, 0xd8, 0x0e, 0xb2, 0x5f, 0x38, 0x22, 0xc4, 0xa2, 0xcf, 0x27, 0x5a, 0xbc, 0x4b,
-	0xa2, 0xcf, 0x27, 0xfa, 0x5c, 0x70, 0x34, 0x3e, 0x0f, 0x57, 0x72, 0x27, 0xe3, 0x68, 0x71, 0x56,
-	0xff, 0xa5, 0x0a, 0x0c, 0x37, 0x09, 0xb1, 0xce, 0x61, 0x65, 0xbe, 0x9e, 0x90, 0x76, 0xbe, 0xbd,
-	0x74, 0xfc, 0xfb, 0x22, 0x65, 0xd5, 0x76, 0x4a, 0x59, 0xf5, 0xf1, 0xd2, 0x14, 0xfa, 0x6b, 0xaa,
-	0x7e, 0xa6, 0x02, 0x40, 0xab, 0x2d, 0x19, 0xe6, 0x2e, 0xe7, 0x38, 0xd1, 0x6a, 0xd6, 0x92, 0x1c,
-	0x27, 0xbb, 0x0c, 0xcf, 0xf3, 0xf1, 0x56, 0x87, 0x51, 0x9f, 0x9d, 0x44, 0xe2, 0xdd, 0x03, 0x78,
-	0x42, 0x69, 0x5a, 0x82, 0x05, 0x24, 0xc9, 0x2d, 0x86, 0x4f, 0x89, 0x5b, 0xe8, 0xfb, 0xc0, 0x32,
-	0x80, 0xd6, 0xd7, 0x9a, 0xa8, 0xad, 0xcc, 0x4e, 0xa5, 0xbc, 0x2c, 0x2f, 0xd0, 0x1d, 0xb9, 0xcb,
-	0x3f, 0xa3, 0xc1, 0x85, 0x54, 0xdd, 0x63, 0xdc, 0xe9, 0xce, 0x84, 0x67, 0xea, 0xbf, 0xa5, 0xc1,
-	0x38, 0xed, 0xcb, 0x39, 0x30, 0x9a, 0xff, 0x3f, 0xc9, 0x68, 0x3e, 0x5a, 0x76, 0x8a, 0x0b, 0xf8,
-	0xcb, 0x9f, 0x55, 0x80, 0x25, 0x9a, 0x10, 0x26, 0x0a, 0xca, 0xcb, 0xbf, 0x56, 0xf0, 0xf2, 0x7f,
-	0x53, 0x18, 0x0e, 0xa4, 0x74, 0x94, 0x8a, 0xf1, 0xc0, 0x07, 0x15, 0xdb, 0x80, 0xa1, 0xe4, 0xb6,
-	0xc9, 0xb1, 0x0f, 0x78, 0x0b, 0xa6, 0x83, 0x1d, 0xcf, 0x0b, 0xa3, 0xc8, 0x06, 0xc3, 0xe5, 0xf5,
-	0xd1, 0xcc, 0xc2, 0x5a, 0x0e, 0x85, 0x3f, 0x40, 0x35, 0x55, 0xdc, 0x38, 0x49, 0x0a, 0x2d, 0x00,
-	0x6c, 0x39, 0x9e, 0xb9, 0x5b, 0x6b, 0xd4, 0xb1, 0xb4, 0xa8, 0x65, 0x46, 0x4b, 0x4b, 0x51, 0x29,
-	0x56, 0x6a, 0x0c, 0x64, 0xcb, 0xf0, 0x27, 0x1a, 0x9f, 0xe9, 0x13, 0x2c, 0xde, 0x73, 0xe4, 0x28,
-	0xef, 0x4f, 0x71, 0x14, 0x25, 0x4d, 0x7d, 0x82, 0xab, 0x54, 0xa5, 0xc0, 0x3e, 0x1c, 0xeb, 0x9f,
-	0x13, 0xe9, 0xb5, 0x7e, 0x55, 0x0c, 0x33, 0xca, 0x55, 0xd2, 0x81, 0x69, 0x47, 0x4d, 0x99, 0x2a,
-	0xf6, 0x48, 0xa9, 0x6c, 0xab, 0x91, 0x8b, 0x46, 0xa2, 0x18, 0x27, 0x09, 0xa0, 0x8f, 0xc0, 0xb4,
-	0x1c, 0x1d, 0x9d, 0x4c, 0x69, 0xb9, 0xc1, 0x96, 0xc3, 0xba, 0x0a, 0xc0, 0xc9, 0x7a, 0xfa, 0xe7,
-	0x2b, 0xf0, 0x04, 0xef, 0x3b, 0xd3, 0x18, 0xd4, 0x49, 0x87, 0xb8, 0x16, 0x71, 0xcd, 0x1e, 0x93,
-	0x59, 0x2d, 0xaf, 0x85, 0xde, 0x86, 0xd1, 0x87, 0x84, 0x58, 0x91, 0x46, 0xfb, 0x95, 0xf2, 0xa9,
-	0x5e, 0x0a, 0x48, 0xbc, 0xc2, 0xd0, 0x73, 0x8e, 0xce, 0xff, 0xc7, 0x82, 0x24, 0x25, 0xde, 0xf1,
-	0xbd, 0xad, 0x48, 0xb4, 0x3a, 0x7d, 0xe2, 0xeb, 0x0c, 0x3d, 0x27, 0xce, 0xff, 0xc7, 0x82, 0xa4,
-	0xbe, 0x0e, 0x4f, 0x1e, 0xa3, 0xe9, 0x49, 0x44, 0xe8, 0xa3, 0x30, 0xf2, 0xd1, 0x9f, 0x04, 0xe3,
-	0x1f, 0x6a, 0xf0, 0x94, 0x82, 0x72, 0x79, 0x9f, 0x4a, 0xf5, 0x35, 0xa3, 0x63, 0x98, 0xf4, 0x8e,
-	0xca, 0xbc, 0xb5, 0x4f, 0x94, 0x7a, 0xe2, 0x33, 0x1a, 0x8c, 0x71, 0x43, 0x1a, 0xc9, 0x7e, 0x5f,
-	0x1f, 0x70, 0xca, 0x0b, 0xbb, 0x24, 0x63, 0x1a, 0xcb, 0xb1, 0xf1, 0xdf, 0x01, 0x96, 0xf4, 0xf5,
-	0x7f, 0x33, 0x02, 0xdf, 0x74, 0x7c, 0x44, 0xe8, 0x4f, 0xb4, 0x6c, 0x9e, 0xdb, 0xf6, 0xd9, 0x76,
-	0x3e, 0xd2, 0x62, 0x88, 0x8b, 0xf1, 0x2b, 0x99, 0xbc, 0x31, 0xa7, 0xa4, 0x20, 0x51, 0x92, 0xea,
-	0xfe, 0x53, 0x0d, 0xa6, 0xe8, 0xb1, 0x14, 0x31, 0x17, 0xfe, 0x99, 0x3a, 0x67, 0x3c, 0xd2, 0x35,
-	0x85, 0x64, 0xca, 0xf3, 0x52, 0x05, 0xe1, 0x44, 0xdf, 0xd0, 0x66, 0xf2, 0x35, 0x88, 0x5f, 0xb7,
-	0x6e, 0xe4, 0x49, 0x23, 0x27, 0xc9, 0xca, 0x34, 0xef, 0xc0, 0x4c, 0x72, 0xe6, 0xcf, 0x52, 0xbd,
-	0x33, 0xff, 0x22, 0x5c, 0xcc, 0x8c, 0xfe, 0x44, 0xca, 0x8d, 0xbf, 0x3d, 0x0c, 0x55, 0x65, 0xaa,
-	0x13, 0xa6, 0x74, 0x52, 0x26, 0xf8, 0x29, 0x0d, 0x26, 0x0d, 0xd7, 0x15, 0xe6, 0x18, 0x72, 0xfd,
-	0x5a, 0x03, 0x7e, 0xd5, 0x3c, 0x52, 0x0b, 0x8b, 0x31, 0x99, 0x94, 0xbd, 0x81, 0x02, 0xc1, 0x6a,
-	0x6f, 0xfa, 0x18, 0xd5, 0x55, 0xce, 0xcd, 0xa8, 0x0e, 0x7d, 0xbf, 0x3c, 0x88, 0xf9, 0x32, 0x7a,
-	0xf5, 0x0c, 0xe6, 0x86, 0x9d, 0xeb, 0xf9, 0xda, 0xb4, 0xf9, 0x8f, 0xc3, 0x6c, 0x7a, 0xe6, 0x4e,
-	0xb4, 0x0a, 0x7e, 0x69, 0x28, 0xc1, 0xaa, 0x0b, 0xc9, 0x1f, 0x43, 0x87, 0xf8, 0xc5, 0xd4, 0x62,
-	0xe1, 0x2c, 0xc0, 0x3e, 0xab, 0x09, 0x39, 0xdd, 0x15, 0x33, 0x74, 0x7e, 0x66, 0x98, 0x83, 0x7e,
-	0xb2, 0x25, 0xb8, 0xa2, 0xcc, 0x8f, 0x92, 0x05, 0xef, 0x19, 0x18, 0xdb, 0xb3, 0x03, 0x5b, 0xc6,
-	0xd1, 0x51, 0x4e, 0xe8, 0x97, 0x79, 0x31, 0x96, 0x70, 0x7d, 0x25, 0xb1, 0xf7, 0x37, 0xbc, 0x8e,
-	0xe7, 0x78, 0xad, 0xde, 0xe2, 0x43, 0xc3, 0x27, 0xd8, 0xeb, 0x86, 0x02, 0xdb, 0x71, 0xcf, 0xfb,
-	0x55, 0xb8, 0xa9, 0x60, 0xcb, 0x0d, 0x08, 0x70, 0x12, 0x74, 0xbf, 0x3b, 0x26, 0x45, 0x57, 0xe1,
-	0x31, 0xf9, 0x2b, 0x1a, 0x5c, 0x23, 0x45, 0x47, 0x81, 0x90, 0x63, 0x5f, 0x3d, 0xab, 0xa3, 0x46,
-	0xc4, 0x59, 0x2d, 0x02, 0xe3, 0xe2, 0x9e, 0xa1, 0x5e, 0x22, 0x17, 0x64, 0x65, 0x10, 0x3d, 0x5c,
-	0xce, 0xf7, 0xee, 0x97, 0x09, 0x12, 0xfd, 0xac, 0x06, 0x97, 0x9d, 0x9c, 0xad, 0x23, 0x44, 0xd6,
-	0xe6, 0x19, 0xec, 0x4a, 0xfe, 0xe6, 0x99, 0x07, 0xc1, 0xb9, 0x5d, 0x41, 0x3f, 0x5f, 0x18, 0xa9,
-	0x62, 0xa4, 0x7c, 0x52, 0xfe, 0xa3, 0x16, 0x62, 0x89, 0xa0, 0x15, 0x9f, 0xd7, 0x00, 0x59, 0x19,
-	0xb1, 0x58, 0x58, 0x91, 0xbc, 0x74, 0xea, 0xc2, 0x3f, 0x7f, 0xb4, 0xce, 0x96, 0xe3, 0x9c, 0x4e,
-	0xb0, 0xef, 0x1c, 0xe6, 0x6c, 0x5f, 0x11, 0x82, 0x76, 0xd0, 0xef, 0x9c, 0xc7, 0x19, 0xf8, 0x77,
-	0xce, 0x83, 0xe0, 0xdc, 0xae, 0xe8, 0x9f, 0x1b, 0xe3, 0x5a, 0x1a, 0xf6, 0xaa, 0xb8, 0x05, 0xa3,
-	0x5b, 0x4c, 0xab, 0x27, 0xf6, 0x6d, 0x69, 0x15, 0x22, 0xd7, 0x0d, 0xf2, 0x3b, 0x12, 0xff, 0x1f,
-	0x0b, 0xcc, 0xe8, 0x35, 0x18, 0xb2, 0xdc, 0x40, 0x6c, 0xb8, 0x8f, 0x0d, 0xa0, 0x0c, 0x8b, 0x5d,
-	0x79, 0xea, 0x6b, 0x4d, 0x4c, 0x91, 0x22, 0x17, 0xc6, 0x5d, 0xa1, 0xd8, 0x10, 0x77, 0xcf, 0xd2,
-	0x69, 0x46, 0x23, 0x05, 0x49, 0xa4, 0x96, 0x91, 0x25, 0x38, 0xa2, 0x41, 0xe9, 0xa5, 0x34, 0xf9,
-	0xa5, 0xe9, 0x45, 0xaa, 0xbd, 0x7e, 0xda, 0xd3, 0x75, 0x55, 0x51, 0x37, 0x72, 0x7c, 0x45, 0xdd,
-	0x74, 0xe1, 0xc3, 0x06, 0x81, 0xd1, 0xd0, 0xb0, 0xdd, 0x90, 0x2b, 0x6a, 0x4a, 0x3e, 0xc2, 0xd3,
-	0xfe, 0x6f, 0x50, 0x2c, 0xb1, 0x46, 0x84, 0xfd, 0x0c, 0xb0, 0x40, 0x4e, 0x17, 0xd6, 0x1e, 0x4b,
-	0xf6, 0x2d, 0x36, 0x66, 0xe9, 0x85, 0xc5, 0x53, 0x86, 0xf3, 0x85, 0xc5, 0xff, 0xc7, 0x02, 0x33,
-	0x7a, 0x03, 0xc6, 0x03, 0x69, 0x36, 0x31, 0x3e, 0x68, 0x8e, 0x59, 0x61, 0x33, 0x21, 0xfc, 0x75,
-	0x84, 0xb1, 0x44, 0x84, 0x1f, 0x6d, 0xc1, 0x98, 0xcd, 0x3d, 0x4c, 0x44, 0xe0, 0x9e, 0x8f, 0x0d,
-	0x90, 0x62, 0x4d, 0x66, 0xc1, 0xe7, 0xe1, 0x1e, 0x24, 0x62, 0xfd, 0x77, 0x81, 0xeb, 0xd9, 0x85,
-	0x65, 0xda, 0x36, 0x8c, 0x4b, 0x74, 0x83, 0xf8, 0x8d, 0xc9, 0xa4, 0x96, 0x7c, 0x68, 0x51, 0x8a,
-	0xcb, 0x08, 0x37, 0xaa, 0xe5, 0xf9, 0xff, 0xc5, 0xa1, 0xfe, 0x8f, 0xe7, 0xfb, 0xf7, 0x26, 0xcb,
-	0x42, 0x27, 0xbd, 0xf0, 0x87, 0xca, 0x2f, 0xad, 0xc8, 0x43, 0x3f, 0x91, 0x7d, 0x4e, 0x3a, 0xf1,
-	0x2b, 0x44, 0x0a, 0x2c, 0xf7, 0x86, 0x4b, 0x59, 0xee, 0xbd, 0x00, 0x17, 0x84, 0xa5, 0x44, 0x83,
-	0x25, 0x7c, 0x0f, 0x7b, 0xc2, 0xb5, 0x81, 0xd9, 0xd0, 0xd4, 0x92, 0x20, 0x9c, 0xae, 0x8b, 0x7e,
-	0x43, 0x83, 0x71, 0x53, 0x88, 0x1c, 0x62, 0x5f, 0xad, 0x0c, 0xf6, 0x18, 0xb3, 0x20, 0x25, 0x18,
-	0x2e, 0x4c, 0xbf, 0x2c, 0x79, 0x84, 0x2c, 0x3e, 0x25, 0xa5, 0x41, 0xd4, 0x6b, 0xf4, 0x3b, 0xf4,
-	0xbe, 0xe0, 0xb0, 0x44, 0x9b, 0xcc, 0xd3, 0x99, 0xfb, 0x5c, 0x3c, 0x18, 0x70, 0x14, 0x8b, 0x31,
-	0x46, 0x3e, 0x90, 0xef, 0x8c, 0x6e, 0x05, 0x31, 0xe4, 0x94, 0xc6, 0xa2, 0x76, 0x1f, 0xfd, 0x63,
-	0x0d, 0x9e, 0xe2, 0x8e, 0x2e, 0x35, 0x2a, 0x45, 0xb0, 0x7c, 0xe5, 0x24, 0x4e, 0x90, 0x1e, 0xdb,
-	0x19, 0x8e, 0x9f, 0xd8, 0xce, 0xf0, 0xe9, 0xc3, 0x83, 0xea, 0x53, 0xb5, 0x63, 0xe0, 0xc6, 0xc7,
-	0xea, 0x01, 0x7a, 0x0b, 0xa6, 0x1d, 0x35, 0x1a, 0x8b, 0x60, 0x30, 0xa5, 0x54, 0xfd, 0x89, 0xb0,
-	0x2e, 0x5c, 0xb7, 0x9b, 0x28, 0xc2, 0x49, 0x52, 0xf3, 0xbb, 0x30, 0x9d, 0x58, 0x68, 0x67, 0xaa,
-	0x24, 0x71, 0x61, 0x36, 0xbd, 0x1e, 0xce, 0xd4, 0xe6, 0xe6, 0x3e, 0x4c, 0x44, 0x07, 0x15, 0x7a,
-	0x42, 0x21, 0x14, 0x0b, 0x12, 0xf7, 0x49, 0x8f, 0x53, 0xad, 0x26, 0x2e, 0x78, 0x5c, 0x83, 0xff,
-	0x32, 0x2d, 0x10, 0x08, 0xf5, 0xaf, 0x08, 0x0d, 0xfe, 0x06, 0x69, 0x77, 0x1c, 0x23, 0x24, 0xef,
-	0xfe, 0xf7, 0x63, 0xfd, 0xbf, 0x6a, 0xfc, 0xbc, 0xe1, 0xc7, 0x2a, 0x32, 0x60, 0xb2, 0xcd, 0x43,
-	0x0e, 0x33, 0xe7, 0x7e, 0xad, 0x7c, 0x58, 0x81, 0xd5, 0x18, 0x0d, 0x56, 0x71, 0xa2, 0x87, 0x30,
-	0x21, 0x45, 0x1b, 0xa9, 0x91, 0xb8, 0x33, 0x98, 0x60, 0x10, 0x49, 0x51, 0xd1, 0xd3, 0xa4, 0x2c,
-	0x09, 0x70, 0x4c, 0x4b, 0x37, 0x00, 0x65, 0xdb, 0xd0, 0x5b, 0xb0, 0x34, 0xa5, 0xd7, 0x92, 0x71,
-	0xfc, 0x32, 0xe6, 0xf4, 0x47, 0xa6, 0xd6, 0xd6, 0x7f, 0xb3, 0x02, 0xb9, 0x69, 0xde, 0x90, 0x0e,
-	0xa3, 0xdc, 0xbb, 0x4d, 0x66, 0xed, 0xa6, 0xa2, 0x0c, 0x77, 0x7d, 0xc3, 0x02, 0x82, 0x1e, 0x70,
-	0x4d, 0x88, 0x6b, 0xb1, 0xf8, 0x79, 0x31, 0x97, 0x50, 0xfd, 0x28, 0x97, 0xf3, 0x2a, 0xe0, 0xfc,
-	0x76, 0x68, 0x0f, 0x50, 0xdb, 0xd8, 0x4f, 0x63, 0x1b, 0x20, 0xa1, 0xd2, 0x6a, 0x06, 0x1b, 0xce,
-	0xa1, 0x40, 0x0f, 0x52, 0xc3, 0x34, 0x49, 0x27, 0x24, 0x16, 0x1f, 0xa2, 0x7c, 0x40, 0x64, 0x07,
-	0xe9, 0x62, 0x12, 0x84, 0xd3, 0x75, 0xf5, 0xaf, 0x0d, 0xc3, 0xb5, 0xe4, 0x24, 0xd2, 0x1d, 0x2a,
-	0x1d, 0xd0, 0x5e, 0x94, 0xf6, 0xf5, 0x7c, 0x22, 0x9f, 0x49, 0xdb, 0xd7, 0xcf, 0xd5, 0x7c, 0xc2,
-	0x8e, 0x64, 0xc3, 0x09, 0x64, 0xa3, 0x84, 0xad, 0xfd, 0xd7, 0xc1, 0x9b, 0xac, 0xc0, 0x6b, 0x6e,
-	0xe8, 0x4c, 0xbd, 0xe6, 0xde, 0xd1, 0x60, 0x3e, 0x59, 0x7c, 0xc7, 0x76, 0xed, 0x60, 0x47, 0x44,
-	0x81, 0x3b, 0xb9, 0x79, 0x3f, 0x4b, 0xba, 0xb0, 0x52, 0x88, 0x11, 0xf7, 0xa1, 0x86, 0x3e, 0xab,
-	0xc1, 0xf5, 0xd4, 0xbc, 0x24, 0x62, 0xd2, 0x9d, 0xdc, 0xd2, 0x9f, 0xf9, 0xff, 0xae, 0x14, 0xa3,
-	0xc4, 0xfd, 0xe8, 0xe9, 0xff, 0xa2, 0x02, 0x23, 0xec, 0xfd, 0xfb, 0xdd, 0x61, 0xf0, 0xcc, 0xba,
-	0x5a, 0x68, 0x03, 0xd4, 0x4a, 0xd9, 0x00, 0xbd, 0x58, 0x9e, 0x44, 0x7f, 0x23, 0xa0, 0xef, 0x84,
-	0xab, 0xac, 0xda, 0xa2, 0xc5, 0xd4, 0x32, 0x01, 0xb1, 0x16, 0x2d, 0x8b, 0x45, 0x1f, 0x38, 0x5a,
-	0x17, 0xfd, 0x04, 0x0c, 0x75, 0x7d, 0x27, 0x1d, 0x8f, 0x63, 0x13, 0xaf, 0x60, 0x5a, 0xae, 0xbf,
-	0xa3, 0xc1, 0x2c, 0xc3, 0xad, 0x6c, 0x5f, 0xb4, 0x07, 0xe3, 0xbe, 0xd8, 0xc2, 0xe2, 0xdb, 0xac,
-	0x94, 0x1e, 0x5a, 0x0e, 0x5b, 0x10, 0x89, 0x28, 0xc5, 0x2f, 0x1c, 0xd1, 0xd2, 0xbf, 0x3a, 0x0a,
-	0x73, 0x45, 0x8d, 0xd0, 0x4f, 0x68, 0x70, 0xd5, 0x8c, 0xa5, 0xb9, 0xc5, 0x6e, 0xb8, 0xe3, 0xf9,
-	0x76, 0x68, 0x0b, 0xc3, 0x90, 0x92, 0xd7, 0xdc, 0xda, 0x62, 0xd4, 0x2b, 0x16, 0x43, 0xad, 0x96,
-	0x4b, 0x01, 0x17, 0x50, 0x46, 0x6f, 0x03, 0xec, 0xc6, 0x41, 0x5b, 0x2b, 0xe5, 0xd3, 0x43, 0xb0,
-	0x61, 0x2b, 0x81, 0x5d, 0x65, 0xa7, 0x98, 0x66, 0x53, 0x29, 0x57, 0xc8, 0x51, 0xe2, 0x41, 0xb0,
-	0x73, 0x9f, 0xf4, 0x3a, 0x86, 0x2d, 0x9f, 0xff, 0xcb, 0x13, 0x6f, 0x36, 0xef, 0x09, 0x54, 0x49,
-	0xe2, 0x4a, 0xb9, 0x42, 0x0e, 0x7d, 0x4a, 0x83, 0x69, 0x4f, 0x75, 0x55, 0x1e, 0xc4, 0xba, 0x32,
-	0xd7, 0xe7, 0x99, 0x8b, 0xd0, 0x49, 0x50, 0x92, 0x24, 0x5d, 0x13, 0x17, 0x83, 0xf4, 0x91, 0x25,
-	0x98, 0xda, 0xea, 0xe0, 0x59, 0x64, 0x95, 0xf3, 0x8f, 0x5f, 0xc7, 0xb3, 0xe0, 0x2c, 0x79, 0xd6,
-	0x29, 0x12, 0x9a, 0xd6, 0xb2, 0x6b, 0xfa, 0x3d, 0xe6, 0x75, 0x48, 0x3b, 0x35, 0x5a, 0xbe, 0x53,
-	0xcb, 0x1b, 0xb5, 0x7a, 0x02, 0x59, 0xb2, 0x53, 0x59, 0x70, 0x96, 0xbc, 0xfe, 0xc9, 0x0a, 0x3c,
-	0x56, 0xb0, 0xc6, 0xfe, 0xda, 0xf8, 0x96, 0x7f, 0x59, 0x83, 0x09, 0x36, 0x07, 0xef, 0x12, 0x07,
-	0x15, 0xd6, 0xd7, 0x02, 0x2b, 0xb9, 0xdf, 0xd2, 0xe0, 0x62, 0x26, 0x7a, 0xe7, 0xb1, 0xdc, 0x1b,
-	0xce, 0xcd, 0x80, 0xeb, 0x7d, 0x71, 0xa4, 0xee, 0xa1, 0xd8, 0x59, 0x36, 0x1d, 0xa5, 0x5b, 0x7f,
-	0x05, 0xa6, 0x13, 0x46, 0x72, 0x51, 0x1c, 0x20, 0x2d, 0x37, 0x0e, 0x90, 0x1a, 0xe6, 0xa7, 0xd2,
-	0x2f, 0xcc, 0x4f, 0xbc, 0xe4, 0xb3, 0x9c, 0xed, 0xaf, 0xcd, 0x92, 0xff, 0xc3, 0x0b, 0x62, 0xc9,
-	0xb3, 0x17, 0x87, 0xd7, 0x61, 0x94, 0x05, 0x15, 0x92, 0x27, 0xe6, 0xed, 0xd2, 0xc1, 0x8a, 0x02,
-	0x7e, 0x93, 0xe2, 0xff, 0x63, 0x81, 0x15, 0xd5, 0x61, 0xd6, 0x74, 0xbc, 0xae, 0x25, 0x12, 0x6b,
-	0xae, 0xc5, 0x97, 0xb6, 0x28, 0xe6, 0x64, 0x2d, 0x05, 0xc7, 0x99, 0x16, 0x08, 0xf3, 0x37, 0x0b,
-	0x7e, 0x9e, 0x95, 0x8a, 0x39, 0x59, 0x5f, 0x6b, 0xf2, 0x9c, 0x0d, 0xd1, 0x5b, 0xc5, 0x9b, 0x00,
-	0x44, 0x2e, 0x5e, 0xe9, 0x57, 0xf8, 0x42, 0xb9, 0x68, 0x9a, 0xd1, 0x16, 0x90, 0xc2, 0x67, 0x54,
-	0x14, 0x60, 0x85, 0x08, 0xf2, 0x61, 0x72, 0xc7, 0xde, 0x22, 0xbe, 0xcb, 0xe5, 0xa8, 0x91, 0xf2,
-	0x22, 0xe2, 0xbd, 0x18, 0x0d, 0xbf, 0xe3, 0x2b, 0x05, 0x58, 0x25, 0x82, 0x7c, 0x2e, 0x8e, 0x70,
-	0xf5, 0xf0, 0x20, 0x29, 0xe6, 0x63, 0xbd, 0x73, 0x3c, 0xce, 0xb8, 0x0c, 0x2b, 0x54, 0x90, 0x0b,
-	0xe0, 0x46, 0xd1, 0xc4, 0x06, 0x79, 0x71, 0x88, 0x63, 0x92, 0x71, 0xc1, 0x23, 0xfe, 0x8d, 0x15,
-	0x0a, 0x74, 0x5e, 0xdb, 0x71, 0x78, 0x3a, 0xa1, 0x43, 0x7c, 0x71, 0xc0, 0x10, 0x81, 0x42, 0x77,
-	0x12, 0x17, 0x60, 0x95, 0x08, 0x1d, 0x63, 0x3b, 0x0a, 0x2a, 0x27, 0x74, 0x84, 0xa5, 0xc6, 0x18,
-	0x87, 0xa6, 0x13, 0x89, 0xbf, 0xa2, 0xdf, 0x58, 0xa1, 0x80, 0xde, 0x50, 0x9e, 0xba, 0xa0, 0xbc,
-	0x06, 0xea, 0x58, 0xcf, 0x5c, 0x1f, 0x8e, 0x15, 0x31, 0x93, 0x6c, 0xaf, 0x5e, 0x57, 0x94, 0x30,
-	0x2c, 0xd8, 0x1e, 0xe5, 0x1f, 0x19, 0xa5, 0x4c, 0x6c, 0x9e, 0x3b, 0xd5, 0xd7, 0x3c, 0xb7, 0x46,
-	0x25, 0x34, 0xc5, 0x5d, 0x84, 0x31, 0x85, 0xe9, 0xf8, 0x85, 0xa3, 0x99, 0x06, 0xe2, 0x6c, 0x7d,
-	0xce, 0xf4, 0x89, 0xc5, 0xda, 0xce, 0xa8, 0x4c, 0x9f, 0x97, 0xe1, 0x08, 0x8a, 0xf6, 0x60, 0x2a,
-	0x50, 0x6c, 0x7d, 0x45, 0xb6, 0xc6, 0x01, 0xde, 0xa6, 0x84, 0x9d, 0x2f, 0x0b, 0xb3, 0xa4, 0x96,
-	0xe0, 0x04, 0x1d, 0xf4, 0xb6, 0x6a, 0xdc, 0x38, 0x5b, 0xde, 0xb1, 0x33, 0x3f, 0x88, 0x60, 0xac,
-	0x61, 0x8b, 0xec, 0xea, 0x54, 0x9b, 0xc3, 0x6e, 0xd2, 0x8c, 0xef, 0xe2, 0xa9, 0x38, 0xb2, 0x1f,
-	0x69, 0xe6, 0x47, 0x3f, 0x2d, 0xd9, 0xef, 0x78, 0x41, 0xd7, 0x27, 0x2c, 0x38, 0x2a, 0xfb, 0x3c,
-	0x28, 0xfe, 0xb4, 0xcb, 0x69, 0x20, 0xce, 0xd6, 0x47, 0x3f, 0xac, 0xc1, 0x2c, 0x4f, 0x76, 0x49,
-	0x8f, 0x2e, 0xcf, 0x25, 0x6e, 0x18, 0xb0, 0x6c, 0x8e, 0x25, 0x7d, 0x2f, 0x9b, 0x29, 0x5c, 0x3c,
-	0x43, 0x50, 0xba, 0x14, 0x67, 0x68, 0xd2, 0x95, 0xa3, 0xba, 0xc2, 0xb3, 0xa4, 0x90, 0x25, 0x57,
-	0x8e, 0xea, 0x66, 0xcf, 0x57, 0x8e, 0x5a, 0x82, 0x13, 0x74, 0xd0, 0x47, 0x60, 0x3a, 0x90, 0x99,
-	0x5b, 0xd8, 0x0c, 0x5e, 0x89, 0x63, 0x55, 0x35, 0x55, 0x00, 0x4e, 0xd6, 0xd3, 0xff, 0xad, 0x06,
-	0x10, 0x69, 0x0f, 0xce, 0x43, 0x27, 0x6e, 0x25, 0x14, 0x2a, 0x4b, 0x03, 0x69, 0x3b, 0x48, 0xa1,
-	0x66, 0xfc, 0x0f, 0x34, 0x98, 0x89, 0xab, 0x9d, 0x83, 0xa8, 0x6e, 0x26, 0x45, 0xf5, 0x8f, 0x0f,
-	0x36, 0xae, 0x02, 0x79, 0xfd, 0xff, 0x54, 0xd4, 0x51, 0x31, 0x69, 0x6c, 0x2f, 0xf1, 0xc6, 0x4c,
-	0x49, 0xdf, 0x1b, 0xe4, 0x8d, 0x59, 0x75, 0xcf, 0x8d, 0xc7, 0x9b, 0xf3, 0xe6, 0xfc, 0xb7, 0x12,
-	0xb2, 0xd0, 0x00, 0x4e, 0xe8, 0x91, 0xe0, 0x23, 0x49, 0xf3, 0x09, 0x38, 0x4a, 0x30, 0x7a, 0x53,
-	0x65, 0x95, 0xfc, 0xb5, 0xfa, 0x13, 0xe5, 0x3c, 0x9f, 0x95, 0x01, 0xf7, 0x65, 0x90, 0xfa, 0x97,
-	0xa7, 0x61, 0x52, 0x51, 0xb4, 0xa5, 0x5e, 0xcc, 0xb5, 0xf3, 0x78, 0x31, 0x0f, 0x61, 0xd2, 0x8c,
-	0x82, 0x8d, 0xcb, 0x69, 0x1f, 0x90, 0x66, 0xc4, 0xa2, 0xe3, 0x30, 0xe6, 0x01, 0x56, 0xc9, 0x50,
-	0x41, 0x22, 0x5a, 0x63, 0x43, 0xa7, 0x60, 0xc7, 0xd0, 0x6f, 0x5d, 0x7d, 0x08, 0x40, 0xca, 0xa2,
-	0xc4, 0x12, 0xd1, 0x22, 0x23, 0x23, 0xf4, 0x46, 0x70, 0x2f, 0x82, 0x61, 0xa5, 0x5e, 0xf6, 0x05,
-	0x76, 0xe4, 0xdc, 0x5e, 0x60, 0xe9, 0x32, 0x70, 0x64, 0xae, 0x9b, 0x81, 0x6c, 0x72, 0xa2, 0x8c,
-	0x39, 0xf1, 0x32, 0x88, 0x8a, 0x02, 0xac, 0x10, 0x29, 0x30, 0x9c, 0x18, 0x2b, 0x65, 0x38, 0xd1,
-	0x85, 0x4b, 0x3e, 0x09, 0xfd, 0x5e, 0xad, 0x67, 0xb2, 0x14, 0x50, 0x7e, 0xc8, 0x6e, 0x94, 0xe3,
-	0xe5, 0xa2, 0x17, 0xe1, 0x2c, 0x2a, 0x9c, 0x87, 0x3f, 0x21, 0x8c, 0x4d, 0xf4, 0x15, 0xc6, 0x3e,
-	0x0c, 0x93, 0x21, 0x31, 0x77, 0x5c, 0xdb, 0x34, 0x9c, 0x46, 0x5d, 0x84, 0x52, 0x8c, 0xe5, 0x8a,
-	0x18, 0x84, 0xd5, 0x7a, 0x68, 0x09, 0x86, 0xba, 0xb6, 0x25, 0xa4, 0xd1, 0x6f, 0x89, 0x54, 0xd6,
-	0x8d, 0xfa, 0xa3, 0x83, 0xea, 0x7b, 0x63, 0x4b, 0x84, 0x68, 0x54, 0xb7, 0x3a, 0xbb, 0xad, 0x5b,
-	0x61, 0xaf, 0x43, 0x82, 0x85, 0xcd, 0x46, 0x1d, 0xd3, 0xc6, 0x79, 0x46, 0x25, 0x53, 0x27, 0x30,
-	0x2a, 0xf9, 0xbc, 0x06, 0x97, 0x8c, 0xb4, 0xb6, 0x9d, 0x04, 0x73, 0xd3, 0xe5, 0xb9, 0x65, 0xbe,
-	0x06, 0x7f, 0xe9, 0xba, 0x18, 0xdf, 0xa5, 0xc5, 0x2c, 0x39, 0x9c, 0xd7, 0x07, 0xe4, 0x03, 0x6a,
-	0xdb, 0xad, 0x28, 0xed, 0x8c, 0xf8, 0xea, 0x33, 0xe5, 0xf4, 0x08, 0xab, 0x19, 0x4c, 0x38, 0x07,
-	0x3b, 0x7a, 0x08, 0x93, 0x66, 0xac, 0x93, 0x17, 0x52, 0x75, 0xfd, 0x34, 0x1e, 0x05, 0xf8, 0xcd,
-	0x4b, 0x55, 0xf8, 0xab, 0x94, 0xa2, 0xd7, 0x34, 0xe5, 0xca, 0x2b, 0x5e, 0x94, 0xd8, 0xa8, 0x67,
-	0xcb, 0xbf, 0xa6, 0xe5, 0x63, 0xc4, 0x7d, 0xa8, 0xb1, 0x98, 0x41, 0x4e, 0x32, 0x3b, 0x14, 0x4b,
-	0x8c, 0x5e, 0xd2, 0xcf, 0x38, 0x95, 0x68, 0x8a, 0x2f, 0xcd, 0x54, 0x21, 0x4e, 0x13, 0xd4, 0x7f,
-	0x5f, 0x13, 0x0a, 0xb3, 0x73, 0xb4, 0x86, 0x38, 0xeb, 0xa7, 0x34, 0xfd, 0xcf, 0x35, 0xc8, 0xc8,
-	0xe8, 0x68, 0x0b, 0xc6, 0x28, 0x8a, 0xfa, 0x5a, 0x53, 0x0c, 0xeb, 0x63, 0xe5, 0x8e, 0x4b, 0x86,
-	0x82, 0x6b, 0x1f, 0xc5, 0x0f, 0x2c, 0x11, 0x53, 0xa9, 0xdf, 0x55, 0xa2, 0x39, 0x8b, 0x11, 0x96,
-	0x92, 0x47, 0xd4, 0xa8, 0xd0, 0x5c, 0xea, 0x57, 0x4b, 0x70, 0x82, 0x8e, 0xbe, 0x02, 0x10, 0xdf,
-	0xab, 0x06, 0x36, 0x90, 0xf9, 0xd3, 0x11, 0xb8, 0x32, 0xa8, 0xb3, 0x01, 0x4b, 0x4a, 0x44, 0xf6,
-	0x6c, 0x33, 0x5c, 0xdc, 0x0e, 0x89, 0xff, 0xe0, 0xc1, 0x6a, 0x94, 0xc2, 0xbf, 0x64, 0x56, 0x24,
-	0xf6, 0xa0, 0xb6, 0x9c, 0x8b, 0x11, 0x17, 0x50, 0x62, 0x77, 0x4a, 0x91, 0x24, 0x19, 0x53, 0x61,
-	0x92, 0xe5, 0xe7, 0xe7, 0x11, 0x53, 0xf8, 0x9d, 0x32, 0x0d, 0xc4, 0xd9, 0xfa, 0x69, 0x24, 0x2b,
-	0x76, 0xdb, 0xe6, 0xd9, 0x61, 0xb4, 0x2c, 0x12, 0x06, 0xc4, 0xd9, 0xfa, 0x2a, 0x12, 0xfe, 0xa5,
-	0xe8, 0x6e, 0x1f, 0xc9, 0x22, 0x89, 0x80, 0x38, 0x5b, 0x1f, 0x59, 0xf0, 0xb8, 0x4f, 0x4c, 0xaf,
-	0xdd, 0x26, 0xae, 0xc5, 0xf3, 0xfd, 0x19, 0x7e, 0xcb, 0x76, 0xef, 0xf8, 0x06, 0xab, 0xc8, 0x54,
-	0x74, 0x1a, 0xcb, 0x71, 0xf0, 0x38, 0xee, 0x53, 0x0f, 0xf7, 0xc5, 0x82, 0xda, 0x70, 0x81, 0x27,
-	0x17, 0xf2, 0x1b, 0x6e, 0x48, 0xfc, 0x3d, 0xc3, 0x11, 0x7a, 0xb8, 0x52, 0x89, 0x8e, 0x37, 0x93,
-	0xa8, 0x70, 0x1a, 0x37, 0xea, 0x51, 0xb9, 0x43, 0x74, 0x47, 0x21, 0x39, 0x5e, 0x3e, 0x6d, 0x17,
-	0xce, 0xa2, 0xc3, 0x79, 0x34, 0xf4, 0xcf, 0x6b, 0x20, 0x2c, 0x91, 0xd1, 0xe3, 0x89, 0xb7, 0x8e,
-	0xf1, 0xd4, 0x3b, 0x87, 0xcc, 0x6a, 0x50, 0xc9, 0xcd, 0x6a, 0xf0, 0x7e, 0x25, 0x14, 0xcf, 0x44,
-	0xcc, 0xfb, 0x38, 0x66, 0x25, 0x23, 0xcb, 0x07, 0x60, 0x82, 0xf0, 0x67, 0xb4, 0x48, 0xa2, 0x65,
-	0xd6, 0xdd, 0xcb, 0xb2, 0x10, 0xc7, 0x70, 0xfd, 0xf7, 0x34, 0x10, 0x18, 0x58, 0xfe, 0xa0, 0x63,
-	0xe5, 0x91, 0x39, 0xd2, 0xb4, 0x49, 0xc9, 0x7f, 0x33, 0x54, 0x98, 0xff, 0xe6, 0x8c, 0xd2, 0xc2,
-	0xfc, 0x8a, 0x06, 0x17, 0x92, 0xb1, 0x91, 0x02, 0xf4, 0x3e, 0x18, 0x13, 0xd1, 0x13, 0x45, 0xf8,
-	0x33, 0xd6, 0x54, 0x84, 0x2f, 0xc0, 0x12, 0x96, 0x54, 0x87, 0x0d, 0x70, 0xc5, 0xcc, 0x0f, 0xd1,
-	0x74, 0xc4, 0x6d, 0xef, 0xd3, 0xb3, 0x30, 0xca, 0x43, 0xef, 0x51, 0x9e, 0x96, 0xe3, 0xb6, 0x79,
-	0xbf, 0x7c, 0x84, 0xbf, 0x32, 0xbe, 0x76, 0x6a, 0x94, 0xfb, 0x4a, 0xdf, 0x28, 0xf7, 0x98, 0xa7,
-	0xdb, 0x1a, 0xe0, 0xe9, 0xa3, 0x86, 0x1b, 0x22, 0x7f, 0xb7, 0x4c, 0xb5, 0x15, 0x26, 0xde, 0x04,
-	0x86, 0xcb, 0x4b, 0x6e, 0x7c, 0x02, 0x94, 0x97, 0x81, 0x99, 0xbe, 0xaf, 0x02, 0x32, 0xb6, 0xd9,
-	0x48, 0x79, 0x53, 0x43, 0x31, 0xe5, 0xc7, 0x88, 0x6d, 0x16, 0x6d, 0xa4, 0xd1, 0xc2, 0x8d, 0xb4,
-	0x0d, 0x63, 0x62, 0x2b, 0x08, 0xe6, 0xf8, 0xb1, 0x01, 0xf2, 0x56, 0x29, 0xe1, 0x78, 0x79, 0x01,
-	0x96, 0xc8, 0xe9, 0x89, 0xdb, 0x36, 0xf6, 0xed, 0x76, 0xb7, 0xcd, 0x38, 0xe2, 0x88, 0x5a, 0x95,
-	0x15, 0x63, 0x09, 0x67, 0x55, 0xb9, 0x85, 0x26, 0xbb, 0x48, 0xa9, 0x55, 0x79, 0x31, 0x96, 0x70,
-	0xf4, 0x1a, 0x8c, 0xb7, 0x8d, 0xfd, 0x66, 0xd7, 0x6f, 0x11, 0xf1, 0x22, 0x50, 0x2c, 0xe3, 0x75,
-	0x43, 0xdb, 0x59, 0xa0, 0xd7, 0xff, 0xd0, 0x5f, 0x68, 0xb8, 0xe1, 0x03, 0xbf, 0x19, 0xfa, 0x51,
-	0xf2, 0x9a, 0x55, 0x81, 0x05, 0x47, 0xf8, 0x90, 0x03, 0x33, 0x6d, 0x63, 0x7f, 0xd3, 0x35, 0xa2,
-	0x34, 0xf9, 0x93, 0x25, 0x29, 0xb0, 0x67, 0xe1, 0xd5, 0x04, 0x2e, 0x9c, 0xc2, 0x9d, 0xf3, 0x02,
-	0x3d, 0x75, 0x56, 0x2f, 0xd0, 0x8b, 0x91, 0xbf, 0x0d, 0xbf, 0xb7, 0x5d, 0xcb, 0xf5, 0x6c, 0xef,
-	0xeb, 0x4b, 0xf3, 0x7a, 0xe4, 0x4b, 0x33, 0x53, 0xfe, 0xc9, 0xb4, 0x8f, 0x1f, 0x4d, 0x17, 0x26,
-	0xa9, 0x84, 0xcd, 0x4b, 0xe9, 0xc5, 0xaa, 0xb4, 0x0a, 0xb2, 0x1e, 0xa1, 0x51, 0xd2, 0xae, 0xc6,
-	0xa8, 0xb1, 0x4a, 0x07, 0x3d, 0xe0, 0x69, 0xd4, 0x1d, 0x12, 0xc6, 0x55, 0xd8, 0x85, 0x7e, 0x96,
-	0xed, 0x9f, 0x28, 0xeb, 0x79, 0xa6, 0x02, 0xce, 0x6f, 0x17, 0x47, 0x61, 0xb9, 0x98, 0x1f, 0x85,
-	0x05, 0xfd, 0x58, 0x9e, 0x9e, 0x1f, 0xb1, 0x39, 0xfd, 0x8e, 0xf2, 0xbc, 0xa1, 0xb4, 0xb6, 0xff,
-	0x5f, 0x6a, 0x30, 0xd7, 0x2e, 0xc8, 0x4f, 0x2a, 0x9e, 0x1f, 0x36, 0x06, 0xe0, 0x0f, 0x85, 0x39,
-	0x4f, 0x97, 0x9e, 0x3a, 0x3c, 0xa8, 0x1e, 0x99, 0x19, 0x15, 0x17, 0xf6, 0x0d, 0xf9, 0x30, 0x16,
-	0xf4, 0x02, 0x33, 0x74, 0x82, 0xb9, 0xcb, 0xe5, 0xd3, 0x60, 0x0a, 0xce, 0xda, 0xe4, 0x98, 0x38,
-	0x6b, 0x8d, 0x83, 0xc0, 0xf3, 0x52, 0x2c, 0x09, 0x0d, 0xea, 0xa7, 0x3d, 0x40, 0xe0, 0xc9, 0xf9,
-	0xdb, 0x30, 0xa5, 0x76, 0xf2, 0x44, 0xee, 0xe1, 0x3f, 0xa7, 0xc1, 0x6c, 0xfa, 0xd0, 0x52, 0x33,
-	0xd5, 0x6b, 0x67, 0x9b, 0xa9, 0x5e, 0xb1, 0x7f, 0xa9, 0xf4, 0xb1, 0x7f, 0x79, 0x01, 0xae, 0xe6,
-	0xaf, 0x65, 0x2a, 0x41, 0x1a, 0x8e, 0xe3, 0x3d, 0x14, 0x37, 0xb7, 0x38, 0x3f, 0x14, 0x2d, 0xc4,
-	0x1c, 0xa6, 0x7f, 0x3f, 0xa4, 0xc3, 0x0c, 0xa3, 0x37, 0x60, 0x22, 0x08, 0x76, 0x78, 0x04, 0x49,
-	0x31, 0xc8, 0x72, 0x57, 0x76, 0x19, 0x86, 0x52, 0xb8, 0x34, 0xca, 0x9f, 0x38, 0x46, 0xbf, 0xf4,
-	0xea, 0x97, 0xbe, 0x76, 0xe3, 0x3d, 0x5f, 0xf9, 0xda, 0x8d, 0xf7, 0x7c, 0xf5, 0x6b, 0x37, 0xde,
-	0xf3, 0x83, 0x87, 0x37, 0xb4, 0x2f, 0x1d, 0xde, 0xd0, 0xbe, 0x72, 0x78, 0x43, 0xfb, 0xea, 0xe1,
-	0x0d, 0xed, 0x3f, 0x1d, 0xde, 0xd0, 0x7e, 0xfc, 0x3f, 0xdf, 0x78, 0xcf, 0x6b, 0xcf, 0xc5, 0xd4,
-	0x6f, 0x49, 0xa2, 0xf1, 0x3f, 0x9d, 0xdd, 0xd6, 0x2d, 0x4a, 0x5d, 0xba, 0x16, 0x31, 0xea, 0xff,
-	0x2f, 0x00, 0x00, 0xff, 0xff, 0x46, 0x8c, 0x06, 0x22, 0xf5, 0xe9, 0x00, 0x00,
+	// 12492 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0xbd, 0x7b, 0x6c, 0x24, 0xc9,
+	0x79, 0x18, 0xbe, 0x3d, 0xc3, 0xe7, 0xc7, 0xc7, 0x2e, 0x6b, 0x5f, 0xb3, 0xdc, 0xbb, 0xe5, 0xaa,
+	0xef, 0xac, 0xdf, 0x9d, 0x64, 0x73, 0x7d, 0x67, 0xc9, 0xd2, 0x9d, 0x7d, 0xba, 0xe3, 0x0c, 0xb9,
+	0xbb, 0xd4, 0x92, 0xdc, 0x51, 0x0d, 0x79, 0x7b, 0x3e, 0xdb, 0x67, 0x35, 0x7b, 0x8a, 0xc3, 0x3e,
+	0xf6, 0x74, 0xcf, 0x75, 0xf7, 0x70, 0xc9, 0x3b, 0xf9, 0xe7, 0x57, 0xec, 0x48, 0xb2, 0x95, 0x18,
+	0x46, 0x1c, 0x41, 0x92, 0x03, 0xcb, 0x30, 0x9c, 0x97, 0x13, 0xc7, 0x70, 0xe0, 0x20, 0xb6, 0x11,
+	0xc0, 0x30, 0x90, 0x58, 0x32, 0xac, 0x40, 0x90, 0x12, 0x44, 0x42, 0x62, 0x3a, 0x62, 0x14, 0x39,
+	0x40, 0x02, 0x23, 0x81, 0x11, 0x04, 0xde, 0x04, 0x4e, 0x50, 0xaf, 0xee, 0xea, 0xd7, 0x70, 0xd8,
+	0x43, 0x52, 0x3a, 0xd8, 0x7f, 0x91, 0x53, 0x5f, 0xd5, 0xf7, 0x55, 0x55, 0x57, 0x7d, 0xf5, 0xd5,
+	0x57, 0xdf, 0x03, 0xaa, 0x2d, 0x2b, 0xd8, 0xee, 0x6e, 0xce, 0x9b, 0x6e, 0xfb, 0x56, 0xcb, 0xf0,
+	0x9a, 0xc4, 0x21, 0x5e, 0xf4, 0x4f, 0x67, 0xa7, 0x75, 0xcb, 0xe8, 0x58, 0xfe, 0x2d, 0xd3, 0xf5,
+	0xc8, 0xad, 0xdd, 0x67, 0x36, 0x49, 0x60, 0x3c, 0x73, 0xab, 0x45, 0x61, 0x46, 0x40, 0x9a, 0xf3,
+	0x1d, 0xcf, 0x0d, 0x5c, 0xf4, 0x6c, 0x84, 0x63, 0x5e, 0x36, 0x8d, 0xfe, 0xe9, 0xec, 0xb4, 0xe6,
+	0x29, 0x8e, 0x79, 0x8a, 0x63, 0x5e, 0xe0, 0x98, 0xfd, 0x0e, 0x95, 0xae, 0xdb, 0x72, 0x6f, 0x31,
+	0x54, 0x9b, 0xdd, 0x2d, 0xf6, 0x8b, 0xfd, 0x60, 0xff, 0x71, 0x12, 0xb3, 0x4f, 0xef, 0xbc, 0xdf,
+	0x9f, 0xb7, 0x5c, 0xda, 0x99, 0x5b, 0x46, 0x37, 0x70, 0x7d, 0xd3, 0xb0, 0x2d, 0xa7, 0x75, 0x6b,
+	0x37, 0xd5, 0x9b, 0x59, 0x5d, 0xa9, 0x2a, 0xba, 0xdd, 0xb3, 0x8e, 0xb7, 0x69, 0x98, 0x59, 0x75,
+	0xde, 0x13, 0xd5, 0x69, 0x1b, 0xe6, 0xb6, 0xe5, 0x10, 0x6f, 0x5f, 0x4e, 0xc8, 0x2d, 0x8f, 0xf8,
+	0x6e, 0xd7, 0x33, 0xc9, 0xb1, 0x5a, 0xf9, 0xb7, 0xda, 0x24, 0x30, 0xb2, 0x68, 0xdd, 0xca, 0x6b,
+	0xe5, 0x75, 0x9d, 0xc0, 0x6a, 0xa7, 0xc9, 0x7c, 0xf7, 0x51, 0x0d, 0x7c, 0x73, 0x9b, 0xb4, 0x8d,
+	0x54, 0xbb, 0xef, 0xca, 0x6b, 0xd7, 0x0d, 0x2c, 0xfb, 0x96, 0xe5, 0x04, 0x7e, 0xe0, 0x25, 0x1b,
+	0xe9, 0x1f, 0xd7, 0xe0, 0xc2, 0x42, 0x7d, 0xb9, 0x41, 0xbc, 0x5d, 0xe2, 0xad, 0xb8, 0xad, 0x96,
+	0xe5, 0xb4, 0xd0, 0xbb, 0x61, 0x7c, 0x97, 0x78, 0x9b, 0xae, 0x6f, 0x05, 0xfb, 0x15, 0xed, 0xa6,
+	0xf6, 0xd4, 0x70, 0x75, 0xea, 0xf0, 0x60, 0x6e, 0xfc, 0x65, 0x59, 0x88, 0x23, 0x38, 0x5a, 0x86,
+	0x8b, 0xdb, 0x41, 0xd0, 0x59, 0x30, 0x4d, 0xe2, 0xfb, 0x61, 0x8d, 0x4a, 0x89, 0x35, 0xbb, 0x7a,
+	0x78, 0x30, 0x77, 0xf1, 0xee, 0xfa, 0x7a, 0x3d, 0x01, 0xc6, 0x59, 0x6d, 0xf4, 0xdf, 0xd0, 0x60,
+	0x26, 0xec, 0x0c, 0x26, 0x6f, 0x74, 0x89, 0x1f, 0xf8, 0x08, 0xc3, 0x95, 0xb6, 0xb1, 0xb7, 0xe6,
+	0x3a, 0xab, 0xdd, 0xc0, 0x08, 0x2c, 0xa7, 0xb5, 0xec, 0x6c, 0xd9, 0x56, 0x6b, 0x3b, 0x10, 0x5d,
+	0x9b, 0x3d, 0x3c, 0x98, 0xbb, 0xb2, 0x9a, 0x59, 0x03, 0xe7, 0xb4, 0xa4, 0x9d, 0x6e, 0x1b, 0x7b,
+	0x29, 0x84, 0x4a, 0xa7, 0x57, 0xd3, 0x60, 0x9c, 0xd5, 0x46, 0x7f, 0x16, 0x86, 0x17, 0x9a, 0x4d,
+	0xd7, 0x41, 0x4f, 0xc3, 0x28, 0x71, 0x8c, 0x4d, 0x9b, 0x34, 0x59, 0xc7, 0xc6, 0xaa, 0xe7, 0x3f,
+	0x77, 0x30, 0x77, 0xee, 0xf0, 0x60, 0x6e, 0x74, 0x89, 0x17, 0x63, 0x09, 0xd7, 0x7f, 0xbe, 0x04,
+	0x23, 0xac, 0x91, 0x8f, 0x7e, 0x4e, 0x83, 0x8b, 0x3b, 0xdd, 0x4d, 0xe2, 0x39, 0x24, 0x20, 0xfe,
+	0xa2, 0xe1, 0x6f, 0x6f, 0xba, 0x86, 0xc7, 0x51, 0x4c, 0x3c, 0x7b, 0x67, 0xfe, 0xf8, 0xfb, 0x6f,
+	0xfe, 0x5e, 0x1a, 0x1d, 0x1f, 0x53, 0x06, 0x00, 0x67, 0x11, 0x47, 0xbb, 0x30, 0xe9, 0xb4, 0x2c,
+	0x67, 0x6f, 0xd9, 0x69, 0x79, 0xc4, 0xf7, 0xd9, 0xbc, 0x4c, 0x3c, 0xfb, 0x52, 0x91, 0xce, 0xac,
+	0x29, 0x78, 0xaa, 0x17, 0x0e, 0x0f, 0xe6, 0x26, 0xd5, 0x12, 0x1c, 0xa3, 0xa3, 0xff, 0x85, 0x06,
+	0xe7, 0x17, 0x9a, 0x6d, 0xcb, 0xf7, 0x2d, 0xd7, 0xa9, 0xdb, 0xdd, 0x96, 0xe5, 0xa0, 0x9b, 0x30,
+	0xe4, 0x18, 0x6d, 0xc2, 0x26, 0x64, 0xbc, 0x3a, 0x29, 0xe6, 0x74, 0x68, 0xcd, 0x68, 0x13, 0xcc,
+	0x20, 0xe8, 0x43, 0x30, 0x62, 0xba, 0xce, 0x96, 0xd5, 0x12, 0xfd, 0xfc, 0x8e, 0x79, 0xbe, 0x13,
+	0xe6, 0xd5, 0x9d, 0xc0, 0xba, 0x27, 0x76, 0xd0, 0x3c, 0x36, 0x1e, 0x2e, 0xed, 0x05, 0xc4, 0xa1,
+	0x64, 0xaa, 0x70, 0x78, 0x30, 0x37, 0x52, 0x63, 0x08, 0xb0, 0x40, 0x84, 0x9e, 0x82, 0xb1, 0xa6,
+	0xe5, 0xf3, 0x8f, 0x59, 0x66, 0x1f, 0x73, 0xf2, 0xf0, 0x60, 0x6e, 0x6c, 0x51, 0x94, 0xe1, 0x10,
+	0x8a, 0x56, 0xe0, 0x12, 0x9d, 0x41, 0xde, 0xae, 0x41, 0x4c, 0x8f, 0x04, 0xb4, 0x6b, 0x95, 0x21,
+	0xd6, 0xdd, 0xca, 0xe1, 0xc1, 0xdc, 0xa5, 0x7b, 0x19, 0x70, 0x9c, 0xd9, 0x4a, 0xbf, 0x0d, 0x63,
+	0x0b, 0x36, 0xf1, 0xe8, 0x02, 0x43, 0xcf, 0xc3, 0x34, 0x69, 0x1b, 0x96, 0x8d, 0x89, 0x49, 0xac,
+	0x5d, 0xe2, 0xf9, 0x15, 0xed, 0x66, 0xf9, 0xa9, 0xf1, 0x2a, 0x3a, 0x3c, 0x98, 0x9b, 0x5e, 0x8a,
+	0x41, 0x70, 0xa2, 0xa6, 0xfe, 0x63, 0x1a, 0x4c, 0x2c, 0x74, 0x9b, 0x56, 0xc0, 0xc7, 0x85, 0x3c,
+	0x98, 0x30, 0xe8, 0xcf, 0xba, 0x6b, 0x5b, 0xe6, 0xbe, 0x58, 0x5c, 0x2f, 0x16, 0xf9, 0x9e, 0x0b,
+	0x11, 0x9a, 0xea, 0xf9, 0xc3, 0x83, 0xb9, 0x09, 0xa5, 0x00, 0xab, 0x44, 0xf4, 0x6d, 0x50, 0x61,
+	0xe8, 0xfb, 0x60, 0x92, 0x0f, 0x77, 0xd5, 0xe8, 0x60, 0xb2, 0x25, 0xfa, 0xf0, 0x84, 0xf2, 0xad,
+	0x24, 0xa1, 0xf9, 0xfb, 0x9b, 0xaf, 0x13, 0x33, 0xc0, 0x64, 0x8b, 0x78, 0xc4, 0x31, 0x09, 0x5f,
+	0x36, 0x35, 0xa5, 0x31, 0x8e, 0xa1, 0xd2, 0xff, 0x98, 0x32, 0xb1, 0x5d, 0xc3, 0xb2, 0x8d, 0x4d,
+	0xcb, 0xb6, 0x82, 0xfd, 0x57, 0x5d, 0x87, 0xf4, 0xb1, 0x6e, 0x36, 0xe0, 0x6a, 0xd7, 0x31, 0x78,
+	0x3b, 0x9b, 0xac, 0xf2, 0x95, 0xb2, 0xbe, 0xdf, 0x21, 0x74, 0xc1, 0xd3, 0x99, 0xbe, 0x7e, 0x78,
+	0x30, 0x77, 0x75, 0x23, 0xbb, 0x0a, 0xce, 0x6b, 0x4b, 0xf9, 0x95, 0x02, 0x7a, 0xd9, 0xb5, 0xbb,
+	0x6d, 0x81, 0xb5, 0xcc, 0xb0, 0x32, 0x7e, 0xb5, 0x91, 0x59, 0x03, 0xe7, 0xb4, 0xd4, 0x3f, 0x57,
+	0x82, 0xc9, 0xaa, 0x61, 0xee, 0x74, 0x3b, 0xd5, 0xae, 0xb9, 0x43, 0x02, 0xf4, 0x61, 0x18, 0xa3,
+	0x07, 0x4e, 0xd3, 0x08, 0x0c, 0x31, 0x93, 0xdf, 0x99, 0xbb, 0xea, 0xd9, 0x47, 0xa4, 0xb5, 0xa3,
+	0xb9, 0x5d, 0x25, 0x81, 0x51, 0x45, 0x62, 0x4e, 0x20, 0x2a, 0xc3, 0x21, 0x56, 0xb4, 0x05, 0x43,
+	0x7e, 0x87, 0x98, 0x62, 0x4f, 0x2d, 0x16, 0x59, 0x2b, 0x6a, 0x8f, 0x1b, 0x1d, 0x62, 0x46, 0x5f,
+	0x81, 0xfe, 0xc2, 0x0c, 0x3f, 0x72, 0x60, 0xc4, 0x0f, 0x8c, 0xa0, 0xeb, 0xb3, 0x8d, 0x36, 0xf1,
+	0xec, 0xed, 0x81, 0x29, 0x31, 0x6c, 0xd5, 0x69, 0x41, 0x6b, 0x84, 0xff, 0xc6, 0x82, 0x8a, 0xfe,
+	0xef, 0x34, 0xb8, 0xa0, 0x56, 0x5f, 0xb1, 0xfc, 0x00, 0xfd, 0x40, 0x6a, 0x3a, 0xe7, 0xfb, 0x9b,
+	0x4e, 0xda, 0x9a, 0x4d, 0xe6, 0x05, 0x41, 0x6e, 0x4c, 0x96, 0x28, 0x53, 0x49, 0x60, 0xd8, 0x0a,
+	0x48, 0x9b, 0x2f, 0xab, 0x82, 0x7c, 0x54, 0xed, 0x72, 0x75, 0x4a, 0x10, 0x1b, 0x5e, 0xa6, 0x68,
+	0x31, 0xc7, 0xae, 0x7f, 0x18, 0x2e, 0xa9, 0xb5, 0xea, 0x9e, 0xbb, 0x6b, 0x35, 0x89, 0x47, 0x77,
+	0x42, 0xb0, 0xdf, 0x49, 0xed, 0x04, 0xba, 0xb2, 0x30, 0x83, 0xa0, 0x77, 0xc2, 0x88, 0x47, 0x5a,
+	0x96, 0xeb, 0xb0, 0xaf, 0x3d, 0x1e, 0xcd, 0x1d, 0x66, 0xa5, 0x58, 0x40, 0xf5, 0xff, 0x59, 0x8a,
+	0xcf, 0x1d, 0xfd, 0x8c, 0x68, 0x17, 0xc6, 0x3a, 0x82, 0x94, 0x98, 0xbb, 0xbb, 0x83, 0x0e, 0x50,
+	0x76, 0x3d, 0x9a, 0x55, 0x59, 0x82, 0x43, 0x5a, 0xc8, 0x82, 0x69, 0xf9, 0x7f, 0x6d, 0x00, 0xf6,
+	0xcf, 0xd8, 0x69, 0x3d, 0x86, 0x08, 0x27, 0x10, 0xa3, 0x75, 0x18, 0xf7, 0x19, 0x93, 0xa6, 0x8c,
+	0xab, 0x9c, 0xcf, 0xb8, 0x1a, 0xb2, 0x92, 0x60, 0x5c, 0x33, 0xa2, 0xfb, 0xe3, 0x21, 0x00, 0x47,
+	0x88, 0xe8, 0x21, 0xe3, 0x13, 0xd2, 0x54, 0x8e, 0x0b, 0x76, 0xc8, 0x34, 0x44, 0x19, 0x0e, 0xa1,
+	0xfa, 0x67, 0x87, 0x00, 0xa5, 0x97, 0xb8, 0x3a, 0x03, 0xbc, 0xa4, 0xa2, 0x0d, 0x3c, 0x03, 0x62,
+	0xb7, 0x24, 0x10, 0xa3, 0x37, 0x61, 0xca, 0x36, 0xfc, 0xe0, 0x7e, 0x87, 0x78, 0x46, 0x20, 0x17,
+	0xca, 0xc4, 0xb3, 0x0b, 0x45, 0xbe, 0xf4, 0x8a, 0x8a, 0xa8, 0x3a, 0x73, 0x78, 0x30, 0x37, 0x15,
+	0x2b, 0xc2, 0x71, 0x52, 0xe8, 0x75, 0x18, 0xa7, 0x05, 0x4b, 0x9e, 0xe7, 0x7a, 0x62, 0xf6, 0x5f,
+	0x28, 0x4a, 0x97, 0x21, 0xe1, 0xd2, 0x6c, 0xf8, 0x13, 0x47, 0xe8, 0xd1, 0x07, 0x01, 0xb9, 0x9b,
+	0x3e, 0x15, 0x40, 0x9b, 0x77, 0x88, 0x23, 0x07, 0x4b, 0xbf, 0x4e, 0xb9, 0x3a, 0x2b, 0xbe, 0x26,
+	0xba, 0x9f, 0xaa, 0x81, 0x33, 0x5a, 0xa1, 0x1d, 0x40, 0xa1, 0xb8, 0x1d, 0x2e, 0x80, 0xca, 0x70,
+	0xff, 0xcb, 0xe7, 0x0a, 0x25, 0x76, 0x27, 0x85, 0x02, 0x67, 0xa0, 0xd5, 0xff, 0x65, 0x09, 0x26,
+	0xf8, 0x12, 0x59, 0x72, 0x02, 0x6f, 0xff, 0x0c, 0x0e, 0x08, 0x12, 0x3b, 0x20, 0x6a, 0xc5, 0xf7,
+	0x3c, 0xeb, 0x70, 0xee, 0xf9, 0xd0, 0x4e, 0x9c, 0x0f, 0x4b, 0x83, 0x12, 0xea, 0x7d, 0x3c, 0xfc,
+	0x5b, 0x0d, 0xce, 0x2b, 0xb5, 0xcf, 0xe0, 0x74, 0x68, 0xc6, 0x4f, 0x87, 0x17, 0x07, 0x1c, 0x5f,
+	0xce, 0xe1, 0xe0, 0xc6, 0x86, 0xc5, 0x18, 0xf7, 0xb3, 0x00, 0x9b, 0x8c, 0x9d, 0xac, 0x45, 0x72,
+	0x52, 0xf8, 0xc9, 0xab, 0x21, 0x04, 0x2b, 0xb5, 0x62, 0x3c, 0xab, 0xd4, 0x93, 0x67, 0xfd, 0xe7,
+	0x32, 0xcc, 0xa4, 0xa6, 0x3d, 0xcd, 0x47, 0xb4, 0x6f, 0x12, 0x1f, 0x29, 0x7d, 0x33, 0xf8, 0x48,
+	0xb9, 0x10, 0x1f, 0xe9, 0xfb, 0x9c, 0x40, 0x1e, 0xa0, 0xb6, 0xd5, 0xe2, 0xcd, 0x1a, 0x81, 0xe1,
+	0x05, 0xeb, 0x56, 0x9b, 0x08, 0x8e, 0xf3, 0xae, 0xfe, 0x96, 0x2c, 0x6d, 0xc1, 0x19, 0xcf, 0x6a,
+	0x0a, 0x13, 0xce, 0xc0, 0xae, 0x7f, 0x69, 0x08, 0xa0, 0xb6, 0x80, 0xdd, 0x80, 0x77, 0xf6, 0x45,
+	0x18, 0xee, 0x6c, 0x1b, 0xbe, 0x5c, 0x4f, 0x4f, 0xcb, 0xc5, 0x58, 0xa7, 0x85, 0x8f, 0x0e, 0xe6,
+	0x2a, 0x35, 0x8f, 0x34, 0x89, 0x13, 0x58, 0x86, 0xed, 0xcb, 0x46, 0x0c, 0x86, 0x79, 0x3b, 0x3a,
+	0x06, 0x3a, 0x8d, 0x35, 0xb7, 0xdd, 0xb1, 0x09, 0x85, 0xb2, 0x31, 0x94, 0x8a, 0x8d, 0x61, 0x25,
+	0x85, 0x09, 0x67, 0x60, 0x97, 0x34, 0x97, 0x1d, 0x2b, 0xb0, 0x8c, 0x90, 0x66, 0xb9, 0x38, 0xcd,
+	0x38, 0x26, 0x9c, 0x81, 0x1d, 0x7d, 0x5c, 0x83, 0xd9, 0x78, 0xf1, 0x6d, 0xcb, 0xb1, 0xfc, 0x6d,
+	0xd2, 0x5c, 0xb7, 0xc4, 0x87, 0x3e, 0x1e, 0xf1, 0x1b, 0x87, 0x07, 0x73, 0xb3, 0x2b, 0xb9, 0x18,
+	0x71, 0x0f, 0x6a, 0xe8, 0x13, 0x1a, 0x5c, 0x4f, 0xcc, 0x8b, 0x67, 0xb5, 0x5a, 0xc4, 0x23, 0xcd,
+	0x82, 0x4b, 0x68, 0xee, 0xf0, 0x60, 0xee, 0xfa, 0x4a, 0x3e, 0x4a, 0xdc, 0x8b, 0x9e, 0xfe, 0x7b,
+	0x1a, 0x94, 0x6b, 0x78, 0x19, 0xbd, 0x3b, 0x76, 0x89, 0xbb, 0xaa, 0x5e, 0xe2, 0x1e, 0x1d, 0xcc,
+	0x8d, 0xd6, 0xf0, 0xb2, 0x72, 0x9f, 0xfb, 0x84, 0x06, 0x33, 0xa6, 0xeb, 0x04, 0x06, 0xed, 0x17,
+	0xe6, 0x92, 0x8e, 0xe4, 0xaa, 0x85, 0xee, 0x2f, 0xb5, 0x04, 0xb2, 0xea, 0x35, 0xd1, 0x81, 0x99,
+	0x24, 0xc4, 0xc7, 0x69, 0xca, 0xfa, 0x57, 0x34, 0x98, 0xac, 0xd9, 0x6e, 0xb7, 0x59, 0xf7, 0xdc,
+	0x2d, 0xcb, 0x26, 0x6f, 0x8f, 0x4b, 0x9b, 0xda, 0xe3, 0xbc, 0x43, 0x99, 0x5d, 0xa2, 0xd4, 0x8a,
+	0x6f, 0x93, 0x4b, 0x94, 0xda, 0xe5, 0x9c, 0x73, 0xf2, 0xe7, 0x47, 0xe3, 0x23, 0x63, 0x27, 0xe5,
+	0x53, 0x30, 0x66, 0x1a, 0xd5, 0xae, 0xd3, 0xb4, 0xc3, 0x5b, 0x14, 0xed, 0x65, 0x6d, 0x81, 0x97,
+	0xe1, 0x10, 0x8a, 0xde, 0x04, 0x88, 0x14, 0x6a, 0x95, 0x52, 0xf1, 0x1b, 0x6d, 0xa4, 0xab, 0x6b,
+	0x90, 0x20, 0xb0, 0x9c, 0x96, 0x1f, 0x7d, 0xfa, 0x08, 0x86, 0x15, 0x6a, 0xe8, 0x87, 0x61, 0x4a,
+	0x4c, 0xf2, 0x72, 0xdb, 0x68, 0x09, 0x7d, 0x43, 0xc1, 0x99, 0x5a, 0x55, 0x10, 0x55, 0x2f, 0x0b,
+	0xc2, 0x53, 0x6a, 0xa9, 0x8f, 0xe3, 0xd4, 0xd0, 0x3e, 0x4c, 0xb6, 0x55, 0x1d, 0xca, 0x50, 0x71,
+	0x71, 0x46, 0xd1, 0xa7, 0x54, 0x2f, 0x09, 0xe2, 0x93, 0x31, 0xed, 0x4b, 0x8c, 0x54, 0xc6, 0x55,
+	0x70, 0xf8, 0xb4, 0xae, 0x82, 0x04, 0x46, 0xf9, 0x65, 0xd8, 0xaf, 0x8c, 0xb0, 0x01, 0x3e, 0x5f,
+	0x64, 0x80, 0xfc, 0x5e, 0x1d, 0x69, 0x88, 0xf9, 0x6f, 0x1f, 0x4b, 0xdc, 0x54, 0x03, 0x4b, 0x4f,
+	0xf5, 0x06, 0xb1, 0x89, 0x19, 0xb8, 0x5e, 0x65, 0xb4, 0xb8, 0x06, 0xb6, 0xa1, 0xe0, 0xe1, 0xaa,
+	0x34, 0xb5, 0x04, 0xc7, 0xe8, 0x84, 0xba, 0x82, 0xb1, 0x5c, 0x5d, 0x41, 0x17, 0x26, 0x76, 0x15,
+	0x9d, 0xd6, 0x38, 0x9b, 0x84, 0x0f, 0x14, 0xe9, 0x58, 0xa4, 0xe0, 0xaa, 0x5e, 0x14, 0x84, 0x26,
+	0x54, 0x65, 0x98, 0x4a, 0x47, 0xff, 0xe7, 0xd3, 0x30, 0x53, 0xb3, 0xbb, 0x7e, 0x40, 0xbc, 0x05,
+	0xf1, 0x48, 0x44, 0x3c, 0xf4, 0xe3, 0x1a, 0x5c, 0x61, 0xff, 0x2e, 0xba, 0x0f, 0x9d, 0x45, 0x62,
+	0x1b, 0xfb, 0x0b, 0x5b, 0xb4, 0x46, 0xb3, 0x79, 0x3c, 0x0e, 0xb4, 0xd8, 0x15, 0x52, 0x24, 0x53,
+	0xce, 0x35, 0x32, 0x31, 0xe2, 0x1c, 0x4a, 0xe8, 0xa7, 0x35, 0xb8, 0x96, 0x01, 0x5a, 0x24, 0x36,
+	0x09, 0xa4, 0xe4, 0x72, 0xdc, 0x7e, 0x3c, 0x7e, 0x78, 0x30, 0x77, 0xad, 0x91, 0x87, 0x14, 0xe7,
+	0xd3, 0x43, 0x7f, 0x43, 0x83, 0xd9, 0x0c, 0xe8, 0x6d, 0xc3, 0xb2, 0xbb, 0x9e, 0x14, 0x6a, 0x8e,
+	0xdb, 0x1d, 0x26, 0x5b, 0x34, 0x72, 0xb1, 0xe2, 0x1e, 0x14, 0xd1, 0x8f, 0xc0, 0xe5, 0x10, 0xba,
+	0xe1, 0x38, 0x84, 0x34, 0x63, 0x22, 0xce, 0x71, 0xbb, 0x72, 0xed, 0xf0, 0x60, 0xee, 0x72, 0x23,
+	0x0b, 0x21, 0xce, 0xa6, 0x83, 0x5a, 0xf0, 0x78, 0x04, 0x08, 0x2c, 0xdb, 0x7a, 0x93, 0x4b, 0x61,
+	0xdb, 0x1e, 0xf1, 0xb7, 0x5d, 0xbb, 0xc9, 0x98, 0x85, 0x56, 0x7d, 0xc7, 0xe1, 0xc1, 0xdc, 0xe3,
+	0x8d, 0x5e, 0x15, 0x71, 0x6f, 0x3c, 0xa8, 0x09, 0x93, 0xbe, 0x69, 0x38, 0xcb, 0x4e, 0x40, 0xbc,
+	0x5d, 0xc3, 0xae, 0x8c, 0x14, 0x1a, 0x20, 0xdf, 0xa2, 0x0a, 0x1e, 0x1c, 0xc3, 0x8a, 0xde, 0x0f,
+	0x63, 0x64, 0xaf, 0x63, 0x38, 0x4d, 0xc2, 0xd9, 0xc2, 0x78, 0xf5, 0x31, 0x7a, 0x18, 0x2d, 0x89,
+	0xb2, 0x47, 0x07, 0x73, 0x93, 0xf2, 0xff, 0x55, 0xb7, 0x49, 0x70, 0x58, 0x1b, 0x7d, 0x04, 0x2e,
+	0xb1, 0xf7, 0xb0, 0x26, 0x61, 0x4c, 0xce, 0x97, 0x82, 0xee, 0x58, 0xa1, 0x7e, 0xb2, 0xb7, 0x8d,
+	0xd5, 0x0c, 0x7c, 0x38, 0x93, 0x0a, 0xfd, 0x0c, 0x6d, 0x63, 0xef, 0x8e, 0x67, 0x98, 0x64, 0xab,
+	0x6b, 0xaf, 0x13, 0xaf, 0x6d, 0x39, 0xfc, 0x2e, 0x41, 0xdf, 0x41, 0x9a, 0x94, 0x95, 0xd0, 0xd7,
+	0x37, 0xf6, 0x19, 0x56, 0x7b, 0x55, 0xc4, 0xbd, 0xf1, 0xa0, 0xf7, 0xc0, 0xa4, 0xd5, 0x72, 0x5c,
+	0x8f, 0xac, 0x1b, 0x96, 0x13, 0xf8, 0x15, 0x60, 0x6a, 0x77, 0x36, 0xad, 0xcb, 0x4a, 0x39, 0x8e,
+	0xd5, 0x42, 0xbb, 0x80, 0x1c, 0xf2, 0xb0, 0xee, 0x36, 0xd9, 0x12, 0xd8, 0xe8, 0xb0, 0x85, 0x5c,
+	0x99, 0x28, 0x34, 0x35, 0xec, 0x1e, 0xb0, 0x96, 0xc2, 0x86, 0x33, 0x28, 0xa0, 0xdb, 0x80, 0xda,
+	0xc6, 0xde, 0x52, 0xbb, 0x13, 0xec, 0x57, 0xbb, 0xf6, 0x8e, 0xe0, 0x1a, 0x93, 0x6c, 0x2e, 0xf8,
+	0x3d, 0x2c, 0x05, 0xc5, 0x19, 0x2d, 0xd0, 0x1a, 0xbc, 0xc3, 0xdf, 0xb1, 0x3a, 0x74, 0xde, 0xfd,
+	0x07, 0x56, 0xb0, 0x5d, 0xeb, 0xfa, 0x81, 0xdb, 0xa6, 0x82, 0xaa, 0xe7, 0xda, 0x36, 0xf1, 0xea,
+	0x6e, 0xd3, 0xaf, 0x4c, 0xb1, 0xb7, 0xac, 0x73, 0xf8, 0xe8, 0xaa, 0xe8, 0xc3, 0xac, 0x5f, 0x75,
+	0xb7, 0xb9, 0xb4, 0x6b, 0x99, 0xe1, 0x9d, 0x68, 0xba, 0xd0, 0x7c, 0x9c, 0xc3, 0x19, 0xb8, 0xd0,
+	0xdf, 0xd4, 0x60, 0xb6, 0xe3, 0x59, 0xae, 0x67, 0x05, 0xfb, 0x35, 0xdb, 0xf0, 0x7d, 0x75, 0x5e,
+	0xfc, 0xca, 0x79, 0x76, 0xb2, 0xac, 0x16, 0x39, 0x59, 0xea, 0x79, 0x58, 0xab, 0xe7, 0x70, 0x0f,
+	0x92, 0xa8, 0x0a, 0xd7, 0x4d, 0xd7, 0x6b, 0xba, 0x0e, 0x9d, 0x9a, 0x2a, 0xd9, 0xa2, 0xab, 0x43,
+	0xae, 0x2f, 0xa7, 0x55, 0xb9, 0x20, 0x66, 0xaf, 0x57, 0x25, 0xb4, 0x08, 0x8f, 0x85, 0x5c, 0xa2,
+	0x66, 0x38, 0x4d, 0xab, 0x69, 0x04, 0xc4, 0xaf, 0xbb, 0xae, 0x8d, 0xe9, 0x64, 0x54, 0x66, 0x18,
+	0xb3, 0x39, 0x87, 0x7b, 0xd6, 0x42, 0x1f, 0x84, 0xb9, 0x1c, 0xf8, 0xaa, 0xe5, 0xd4, 0xdc, 0xae,
+	0x13, 0x54, 0x10, 0x5b, 0x22, 0xe7, 0xf0, 0x51, 0x15, 0xf5, 0xff, 0x31, 0x02, 0x95, 0xd4, 0xd1,
+	0x79, 0xbf, 0x13, 0x30, 0x41, 0xe3, 0xf6, 0x51, 0xcc, 0x51, 0x13, 0xfd, 0x3d, 0x82, 0xf7, 0x6d,
+	0xe5, 0x71, 0xf9, 0x52, 0xc1, 0x15, 0x93, 0xc3, 0xcc, 0x9b, 0x39, 0x3c, 0xac, 0x5c, 0x90, 0x4c,
+	0x36, 0xaf, 0xba, 0x7d, 0x14, 0xaf, 0x1a, 0x12, 0x93, 0x7f, 0x04, 0x2b, 0x7a, 0x05, 0x66, 0xde,
+	0x74, 0x1d, 0xd2, 0xe8, 0xd8, 0x56, 0xd0, 0x08, 0x3c, 0x23, 0x20, 0xad, 0x7d, 0x76, 0xdc, 0x8c,
+	0x57, 0xdf, 0x45, 0xef, 0x91, 0xaf, 0x26, 0x81, 0x8f, 0xb2, 0x0a, 0x71, 0x1a, 0x09, 0xfa, 0x5b,
+	0x1a, 0x4c, 0xd0, 0xd2, 0x07, 0x84, 0x5a, 0x21, 0x48, 0x61, 0xf4, 0x07, 0x8b, 0xdd, 0x8a, 0xb2,
+	0xd7, 0xc6, 0xfc, 0xab, 0x11, 0x7e, 0xae, 0x5a, 0x0c, 0xc5, 0x34, 0x05, 0x82, 0xd5, 0x6e, 0xa0,
+	0x8f, 0x6a, 0x30, 0x46, 0x7f, 0xd7, 0x8c, 0x8e, 0x5f, 0x19, 0x65, 0x7d, 0x7a, 0xf5, 0xc4, 0xfb,
+	0x44, 0x91, 0xf3, 0x0e, 0x85, 0x17, 0x46, 0x59, 0x8c, 0x43, 0xea, 0xb3, 0x1f, 0x80, 0x0b, 0xc9,
+	0x01, 0xa0, 0x0b, 0x50, 0xde, 0x21, 0xfc, 0xfd, 0x7b, 0x1c, 0xd3, 0x7f, 0xd1, 0x25, 0x18, 0xde,
+	0x35, 0xec, 0x2e, 0x5f, 0xa7, 0xc3, 0x98, 0xff, 0x78, 0xbe, 0xf4, 0x7e, 0x6d, 0xf6, 0x7b, 0x60,
+	0x2a, 0x46, 0xec, 0x38, 0x8d, 0xf5, 0x83, 0x32, 0x8c, 0xd7, 0x5c, 0xa7, 0x69, 0x31, 0xa5, 0xd8,
+	0x33, 0xb1, 0x17, 0xb8, 0xc7, 0x55, 0xa9, 0xfa, 0xd1, 0xc1, 0xdc, 0x54, 0x58, 0x51, 0x11, 0xb3,
+	0x9f, 0x0b, 0xd5, 0xde, 0x5c, 0xcd, 0xfa, 0x8e, 0xb8, 0xbe, 0xfa, 0xd1, 0xc1, 0xdc, 0xf9, 0xb0,
+	0x59, 0x5c, 0x85, 0x4d, 0x4f, 0x32, 0xaa, 0x5b, 0x59, 0xf7, 0x0c, 0xc7, 0xb7, 0x06, 0xd0, 0x66,
+	0x85, 0x7a, 0xca, 0x95, 0x14, 0x36, 0x9c, 0x41, 0x01, 0xbd, 0x0e, 0xd3, 0xb4, 0x74, 0xa3, 0x43,
+	0x59, 0x50, 0x41, 0x25, 0xd6, 0x15, 0x41, 0x73, 0x7a, 0x25, 0x86, 0x09, 0x27, 0x30, 0xf3, 0x17,
+	0x4b, 0xc3, 0x77, 0x1d, 0xb1, 0x9b, 0x94, 0x17, 0x4b, 0xc3, 0xe7, 0x2f, 0x96, 0x86, 0xcf, 0x8d,
+	0x72, 0xda, 0xc4, 0xf7, 0x8d, 0x16, 0x61, 0xd2, 0xd8, 0x78, 0x74, 0xe5, 0x5a, 0xe5, 0xc5, 0x58,
+	0xc2, 0xd1, 0xb7, 0xc3, 0xb0, 0x49, 0x4f, 0x44, 0xb6, 0x6c, 0xc7, 0xd9, 0xd9, 0x3b, 0x5c, 0xa3,
+	0x05, 0x8f, 0x0e, 0xe6, 0xc6, 0x99, 0x56, 0x97, 0xfe, 0xc2, 0xbc, 0x92, 0xfe, 0x8b, 0x54, 0x03,
+	0x92, 0x50, 0xf9, 0xf4, 0xf1, 0xd2, 0x7a, 0x76, 0x8f, 0x96, 0xfa, 0x27, 0xa9, 0xfa, 0x89, 0x9f,
+	0xe9, 0x75, 0xdb, 0x70, 0x08, 0xfa, 0x29, 0x0d, 0x2e, 0x6c, 0x5b, 0xad, 0x6d, 0xd5, 0x54, 0x42,
+	0x5c, 0x93, 0x0a, 0x69, 0x8a, 0xee, 0x26, 0x70, 0x55, 0x2f, 0x1d, 0x1e, 0xcc, 0x5d, 0x48, 0x96,
+	0xe2, 0x14, 0x4d, 0xfd, 0x63, 0x25, 0xb8, 0x14, 0x49, 0x1b, 0x8b, 0xa4, 0x63, 0xbb, 0xfb, 0x6d,
+	0xe2, 0x9c, 0x85, 0x55, 0x83, 0xfc, 0x42, 0xa5, 0xdc, 0x2f, 0xd4, 0x4e, 0x7d, 0xa1, 0x72, 0x91,
+	0x2f, 0x14, 0x2e, 0xe4, 0x23, 0xbe, 0xd2, 0x9f, 0x68, 0x50, 0xc9, 0x9a, 0x8b, 0x33, 0xd0, 0xa8,
+	0xb5, 0xe3, 0x1a, 0xb5, 0xbb, 0x45, 0x55, 0xa4, 0xc9, 0xae, 0xe7, 0x68, 0xd6, 0xbe, 0x51, 0x82,
+	0x2b, 0x51, 0xf5, 0x65, 0xc7, 0x0f, 0x0c, 0xdb, 0xe6, 0x8f, 0x06, 0xa7, 0xff, 0xdd, 0x3b, 0x31,
+	0xc5, 0xe8, 0xda, 0x60, 0x43, 0x55, 0xfb, 0x9e, 0xfb, 0x6e, 0xb9, 0x97, 0x78, 0xb7, 0xac, 0x9f,
+	0x20, 0xcd, 0xde, 0x4f, 0x98, 0xff, 0x55, 0x83, 0xd9, 0xec, 0x86, 0x67, 0xb0, 0xa8, 0xdc, 0xf8,
+	0xa2, 0xfa, 0xe0, 0xc9, 0x8d, 0x3a, 0x67, 0x59, 0xfd, 0x46, 0x29, 0x6f, 0xb4, 0x4c, 0x75, 0xbb,
+	0x05, 0xe7, 0x3d, 0xd2, 0xb2, 0xfc, 0x40, 0x3c, 0xb0, 0x1d, 0xcf, 0xf2, 0x4c, 0xbe, 0x38, 0x9c,
+	0xc7, 0x71, 0x1c, 0x38, 0x89, 0x14, 0xad, 0xc1, 0x28, 0x55, 0xa4, 0x51, 0xfc, 0xa5, 0xfe, 0xf1,
+	0x87, 0xa7, 0x51, 0x83, 0xb7, 0xc5, 0x12, 0x09, 0xfa, 0x01, 0x98, 0x6a, 0x86, 0x3b, 0xea, 0x08,
+	0xb3, 0x93, 0x24, 0x56, 0xf6, 0x14, 0xba, 0xa8, 0xb6, 0xc6, 0x71, 0x64, 0xfa, 0xff, 0xd1, 0xe0,
+	0xb1, 0x5e, 0x6b, 0x0b, 0xbd, 0x01, 0x60, 0x4a, 0xf1, 0x82, 0x1b, 0x1e, 0x16, 0x7c, 0x2c, 0x0d,
+	0x85, 0x94, 0x68, 0x83, 0x86, 0x45, 0x3e, 0x56, 0x88, 0x64, 0x58, 0xb3, 0x94, 0x4e, 0xc9, 0x9a,
+	0x45, 0xff, 0x6f, 0x9a, 0xca, 0x8a, 0xd4, 0x6f, 0xfb, 0x76, 0x63, 0x45, 0x6a, 0xdf, 0x73, 0x5f,
+	0x6b, 0xbe, 0x5c, 0x82, 0x9b, 0xd9, 0x4d, 0x94, 0xb3, 0xf7, 0x25, 0x18, 0xe9, 0x70, 0xeb, 0xd0,
+	0x32, 0x3b, 0x1b, 0x9f, 0xa2, 0x9c, 0x85, 0xdb, 0x6e, 0x3e, 0x3a, 0x98, 0x9b, 0xcd, 0x62, 0xf4,
+	0x1c, 0x8a, 0x45, 0x3b, 0x64, 0x25, 0x74, 0xd6, 0x5c, 0xfa, 0xfb, 0xae, 0x3e, 0x99, 0x8b, 0xb1,
+	0x49, 0xec, 0xbe, 0xd5, 0xd4, 0x3f, 0xa6, 0xc1, 0x74, 0x6c, 0x45, 0xfb, 0x95, 0xe1, 0x9b, 0xe5,
+	0xa2, 0x86, 0x04, 0xb1, 0xad, 0x12, 0x9d, 0xdc, 0xb1, 0x62, 0x1f, 0x27, 0x08, 0x26, 0xd8, 0xac,
+	0x3a, 0xab, 0x6f, 0x3b, 0x36, 0xab, 0x76, 0x3e, 0x87, 0xcd, 0xfe, 0x42, 0x29, 0x6f, 0xb4, 0x8c,
+	0xcd, 0x3e, 0x84, 0x71, 0xe9, 0x37, 0x21, 0xd9, 0xc5, 0xed, 0x41, 0xfb, 0xc4, 0xd1, 0x45, 0x46,
+	0x74, 0xb2, 0xc4, 0xc7, 0x11, 0x2d, 0xf4, 0xd7, 0x34, 0x80, 0xe8, 0xc3, 0x88, 0x4d, 0xb5, 0x7e,
+	0x72, 0xd3, 0xa1, 0x88, 0x35, 0xd3, 0x74, 0x4b, 0x47, 0xbf, 0xb1, 0x42, 0x57, 0xff, 0xf3, 0x32,
+	0xa0, 0x74, 0xdf, 0xa9, 0xb8, 0xb9, 0x63, 0x39, 0xcd, 0xe4, 0x85, 0xe0, 0x9e, 0xe5, 0x34, 0x31,
+	0x83, 0xf4, 0x21, 0x90, 0xbe, 0x00, 0xe7, 0x5b, 0xb6, 0xbb, 0x69, 0xd8, 0xf6, 0xbe, 0x70, 0x24,
+	0x10, 0x26, 0xe9, 0x17, 0xe9, 0xc1, 0x74, 0x27, 0x0e, 0xc2, 0xc9, 0xba, 0xa8, 0x03, 0x17, 0x3c,
+	0xaa, 0x8d, 0x30, 0x2d, 0x9b, 0x5d, 0x9d, 0xdc, 0x6e, 0x50, 0x50, 0xf3, 0xce, 0xc4, 0x7b, 0x9c,
+	0xc0, 0x85, 0x53, 0xd8, 0xd1, 0xb7, 0xc1, 0x68, 0xc7, 0xb3, 0xda, 0x86, 0xc7, 0x55, 0x1d, 0x63,
+	0xd5, 0x09, 0x7a, 0xc2, 0xd5, 0x79, 0x11, 0x96, 0x30, 0xf4, 0x11, 0x18, 0xb7, 0xad, 0x2d, 0x62,
+	0xee, 0x9b, 0x36, 0x11, 0xaa, 0xf2, 0xfb, 0x27, 0xb3, 0x64, 0x56, 0x24, 0x5a, 0x61, 0xa0, 0x23,
+	0x7f, 0xe2, 0x88, 0x20, 0xf5, 0x00, 0x79, 0xe8, 0x7a, 0x3b, 0xc4, 0xb3, 0x89, 0xef, 0x37, 0xba,
+	0x9d, 0x8e, 0xeb, 0x05, 0xa4, 0xc9, 0x14, 0xea, 0x63, 0xdc, 0x5b, 0xe2, 0x41, 0x1a, 0x8c, 0xb3,
+	0xda, 0xe8, 0x1f, 0x2f, 0xc1, 0xf5, 0x1e, 0x9d, 0x40, 0x18, 0xc6, 0xc3, 0x39, 0x12, 0x2b, 0xe1,
+	0x3d, 0x7c, 0x3d, 0x8b, 0xc2, 0x47, 0x07, 0x73, 0x4f, 0xf4, 0x40, 0x10, 0xaa, 0x81, 0x22, 0x34,
+	0x68, 0x19, 0x46, 0x9a, 0xd1, 0xfb, 0xd2, 0x78, 0xf5, 0x19, 0xca, 0xad, 0xb9, 0x26, 0xb8, 0x5f,
+	0x6c, 0x02, 0x01, 0x5a, 0x81, 0x51, 0x6e, 0xd6, 0x43, 0x04, 0xe7, 0x7f, 0x96, 0x5d, 0x8f, 0x79,
+	0x51, 0xbf, 0xc8, 0x24, 0x0a, 0xfd, 0x7f, 0x69, 0x30, 0x5a, 0x73, 0x3d, 0xb2, 0xb8, 0xd6, 0x40,
+	0xfb, 0xd4, 0xeb, 0x20, 0x74, 0xe8, 0x12, 0x5c, 0xb0, 0x20, 0x5b, 0x60, 0x18, 0x17, 0x22, 0x6c,
+	0xd2, 0xf9, 0x20, 0x2c, 0xc0, 0x2a, 0x2d, 0xf4, 0x06, 0x9d, 0xf3, 0x87, 0x9e, 0xc5, 0xf4, 0xb6,
+	0x83, 0x58, 0x43, 0x70, 0xc2, 0x58, 0xe2, 0xe2, 0x2b, 0x2a, 0xfc, 0x89, 0x23, 0x2a, 0x7a, 0x1d,
+	0x90, 0xa8, 0xad, 0xf4, 0x0a, 0x3d, 0x0f, 0x43, 0x6d, 0xb7, 0x29, 0xbf, 0xfb, 0x3b, 0xe5, 0xfe,
+	0xa6, 0x2f, 0x33, 0x8f, 0x0e, 0xe6, 0xae, 0xa4, 0x5b, 0x50, 0x08, 0x66, 0x6d, 0xf4, 0x35, 0xb8,
+	0x20, 0xe0, 0x21, 0x41, 0xea, 0x15, 0x62, 0xba, 0xed, 0xb6, 0xeb, 0x34, 0xba, 0x5b, 0x5b, 0xd6,
+	0x1e, 0x89, 0x79, 0x85, 0xd4, 0x62, 0x10, 0x9c, 0xa8, 0xa9, 0x7f, 0x46, 0x83, 0x32, 0xfd, 0x2e,
+	0x3a, 0x8c, 0x34, 0xdd, 0xb6, 0x61, 0x39, 0xa2, 0x57, 0xcc, 0x03, 0x66, 0x91, 0x95, 0x60, 0x01,
+	0x41, 0x1d, 0x18, 0x97, 0x42, 0xd3, 0x40, 0x96, 0x89, 0x8b, 0x6b, 0x8d, 0xd0, 0x9a, 0x3b, 0xe4,
+	0xe4, 0xb2, 0xc4, 0xc7, 0x11, 0x11, 0xdd, 0x80, 0x99, 0xc5, 0xb5, 0xc6, 0xb2, 0x63, 0xda, 0xdd,
+	0x26, 0x59, 0xda, 0x63, 0x7f, 0x28, 0x2f, 0xb1, 0x78, 0x89, 0x18, 0x27, 0xe3, 0x25, 0xa2, 0x12,
+	0x96, 0x30, 0x5a, 0x8d, 0xf0, 0x16, 0x95, 0x52, 0x54, 0x4d, 0x20, 0xc1, 0x12, 0xa6, 0x7f, 0xa5,
+	0x04, 0x13, 0x4a, 0x87, 0x90, 0x0d, 0xa3, 0x7c, 0xb8, 0xd2, 0x72, 0x7a, 0xa9, 0xe0, 0x10, 0xe3,
+	0xbd, 0xe6, 0xd4, 0xf9, 0x84, 0xfa, 0x58, 0x92, 0x50, 0xf9, 0x62, 0xa9, 0x07, 0x5f, 0x9c, 0x07,
+	0xf0, 0x23, 0x3f, 0x22, 0xbe, 0x25, 0xd9, 0xd1, 0xa3, 0x78, 0x0f, 0x29, 0x35, 0xd0, 0x63, 0xe2,
+	0x04, 0xe1, 0xa6, 0x81, 0x63, 0x89, 0xd3, 0x63, 0x0b, 0x86, 0xa9, 0x46, 0xd4, 0xaf, 0x0c, 0x9f,
+	0xe4, 0x00, 0xc7, 0xa9, 0x7c, 0x40, 0xb5, 0xa3, 0x3e, 0xe6, 0xe8, 0xf5, 0x5f, 0xd2, 0x00, 0x16,
+	0x8d, 0xc0, 0xe0, 0x0f, 0xf8, 0x7d, 0x78, 0xdf, 0x3c, 0x16, 0x3b, 0xf8, 0xc6, 0x52, 0x1e, 0x09,
+	0x43, 0xbe, 0xf5, 0xa6, 0x1c, 0x7e, 0x28, 0x50, 0x73, 0xec, 0x0d, 0xeb, 0x4d, 0x82, 0x19, 0x9c,
+	0xba, 0x2a, 0x12, 0xc7, 0xf4, 0xf6, 0x3b, 0x94, 0x79, 0x0f, 0xb1, 0x59, 0x65, 0x3b, 0x74, 0x49,
+	0x16, 0xe2, 0x08, 0xae, 0x3f, 0x03, 0xf1, 0x5b, 0xd1, 0xd1, 0xbd, 0xd4, 0xbf, 0x36, 0x04, 0xd7,
+	0x96, 0xd6, 0x6b, 0x8b, 0x02, 0x9f, 0xe5, 0x3a, 0xf7, 0xc8, 0xfe, 0x5f, 0x19, 0x3b, 0xfe, 0x95,
+	0xb1, 0xe3, 0x09, 0x1a, 0x3b, 0x3e, 0xd2, 0xe0, 0xc2, 0xd2, 0x5e, 0xc7, 0xf2, 0x98, 0xd7, 0x17,
+	0xf1, 0x7c, 0x8b, 0x2b, 0xae, 0x77, 0xf9, 0xbf, 0x62, 0x71, 0x85, 0xaa, 0x02, 0x51, 0x03, 0x4b,
+	0x38, 0xda, 0x82, 0x69, 0xc2, 0x9a, 0x33, 0x79, 0xd5, 0x08, 0x8a, 0x2c, 0x20, 0xee, 0x54, 0x18,
+	0xc3, 0x82, 0x13, 0x58, 0x51, 0x03, 0xa6, 0x4d, 0xfa, 0x66, 0x6a, 0x6d, 0x59, 0x66, 0x64, 0xcf,
+	0x3c, 0x5e, 0x7d, 0x37, 0x3b, 0x7a, 0x62, 0x90, 0x47, 0x07, 0x73, 0x97, 0x45, 0x3f, 0xe3, 0x00,
+	0x9c, 0x40, 0xa1, 0x7f, 0xaa, 0x04, 0x53, 0x4b, 0x7b, 0x1d, 0xd7, 0xef, 0x7a, 0x84, 0x55, 0x3d,
+	0x83, 0x1b, 0xf8, 0xd3, 0x30, 0xba, 0x6d, 0x50, 0x73, 0x3d, 0xaf, 0x52, 0x8a, 0xcf, 0xed, 0x5d,
+	0x5e, 0x8c, 0x25, 0x1c, 0xbd, 0x05, 0x40, 0xdd, 0xad, 0x9b, 0x5d, 0x26, 0xc1, 0xf0, 0x4d, 0x72,
+	0xaf, 0x08, 0x0f, 0x8d, 0x8d, 0xb1, 0x11, 0xa2, 0x14, 0x9c, 0x3d, 0xfc, 0x8d, 0x15, 0x72, 0xfa,
+	0x57, 0x35, 0x98, 0x89, 0xb5, 0x3b, 0x83, 0x8b, 0xe5, 0x56, 0xfc, 0x62, 0xb9, 0x30, 0xf0, 0x58,
+	0x73, 0xee, 0x93, 0x1f, 0x2d, 0xc1, 0xd5, 0x9c, 0x39, 0x49, 0x19, 0xbf, 0x69, 0x67, 0x64, 0xfc,
+	0xd6, 0x85, 0x89, 0xc0, 0xb5, 0x85, 0xd9, 0xbd, 0x9c, 0x81, 0x42, 0xa6, 0x6d, 0xeb, 0x21, 0x9a,
+	0xe8, 0xcd, 0x34, 0x2a, 0xf3, 0xb1, 0x4a, 0x87, 0x1a, 0x3b, 0x8f, 0x87, 0xfa, 0xab, 0x6f, 0xa9,
+	0x37, 0xa4, 0xfe, 0xfd, 0xa0, 0xf5, 0x3f, 0x2c, 0xc1, 0x95, 0x10, 0xb7, 0xbc, 0x27, 0x50, 0x75,
+	0x5b, 0x3f, 0x97, 0xe0, 0xc7, 0xc4, 0x39, 0xac, 0xc8, 0x02, 0x8a, 0xa4, 0x40, 0xe5, 0xa6, 0xae,
+	0xd7, 0x71, 0x7d, 0x29, 0x0e, 0x70, 0xb9, 0x89, 0x17, 0x61, 0x09, 0x43, 0x6b, 0x30, 0xec, 0x53,
+	0x7a, 0x95, 0xa1, 0x22, 0xb3, 0xc1, 0x24, 0x1a, 0xd6, 0x5f, 0xcc, 0xd1, 0xa0, 0xb7, 0x54, 0x95,
+	0xc6, 0x70, 0x71, 0x35, 0x0b, 0x1d, 0x49, 0x53, 0xce, 0x48, 0x86, 0x6f, 0x60, 0x96, 0x5a, 0x43,
+	0x5f, 0x81, 0x0b, 0xc2, 0x7e, 0x8e, 0x2f, 0x1b, 0xc7, 0x24, 0xe8, 0xfd, 0xb1, 0x95, 0xf1, 0x64,
+	0xe2, 0x15, 0xf9, 0x52, 0xb2, 0x7e, 0xb4, 0x62, 0x74, 0x1f, 0xc6, 0xee, 0x88, 0x4e, 0xa2, 0x59,
+	0x28, 0x59, 0xf2, 0x5b, 0x80, 0xc0, 0x51, 0x5a, 0x5e, 0xc4, 0x25, 0xab, 0x89, 0x6e, 0xc6, 0xbe,
+	0x43, 0x96, 0xd4, 0xa6, 0x1c, 0x4b, 0xe5, 0xde, 0xc7, 0x92, 0xfe, 0xf5, 0x12, 0x5c, 0x92, 0x54,
+	0xe5, 0x18, 0x17, 0xc5, 0x1b, 0xdc, 0x11, 0xb2, 0xe1, 0xd1, 0x4a, 0x91, 0xfb, 0x30, 0xc4, 0x18,
+	0x60, 0xa1, 0xb7, 0xb9, 0x10, 0x21, 0xed, 0x0e, 0x66, 0x88, 0xd0, 0x47, 0x60, 0xc4, 0xa6, 0x2a,
+	0x48, 0x69, 0xb7, 0x5c, 0x48, 0x85, 0x94, 0x35, 0x5c, 0xae, 0xd9, 0x14, 0xf6, 0x0a, 0xe1, 0x93,
+	0x0d, 0x2f, 0xc4, 0x82, 0xe6, 0xec, 0x73, 0x30, 0xa1, 0x54, 0x3b, 0xca, 0xd2, 0x60, 0x5c, 0xb5,
+	0x34, 0xf8, 0x35, 0x0d, 0x26, 0xee, 0x5a, 0x9b, 0xc4, 0xe3, 0x96, 0x27, 0xec, 0x2a, 0x14, 0x0b,
+	0x43, 0x31, 0x91, 0x15, 0x82, 0x02, 0xed, 0xc1, 0xb8, 0x38, 0x69, 0x42, 0x1f, 0x89, 0x3b, 0xc5,
+	0x1e, 0x81, 0x43, 0xd2, 0x82, 0x83, 0xab, 0x6e, 0xaf, 0x92, 0x02, 0x8e, 0x88, 0xe9, 0x6f, 0xc1,
+	0xc5, 0x8c, 0x46, 0x68, 0x8e, 0x6d, 0x5f, 0x2f, 0x10, 0xcb, 0x42, 0xee, 0x47, 0x2f, 0xc0, 0xbc,
+	0x1c, 0x5d, 0x83, 0x32, 0x71, 0x9a, 0x62, 0x4d, 0x8c, 0x1e, 0x1e, 0xcc, 0x95, 0x97, 0x9c, 0x26,
+	0xa6, 0x65, 0x94, 0x4d, 0xd9, 0x6e, 0x4c, 0x26, 0x61, 0x6c, 0x6a, 0x45, 0x94, 0xe1, 0x10, 0xca,
+	0x9e, 0xed, 0x93, 0x2f, 0xd4, 0x54, 0x3a, 0xbd, 0xb0, 0x95, 0xd8, 0x3d, 0x83, 0x3c, 0x8c, 0x27,
+	0x77, 0x62, 0xb5, 0x22, 0x26, 0x24, 0xb5, 0xa7, 0x71, 0x8a, 0xae, 0xfe, 0xdb, 0x43, 0xf0, 0xf8,
+	0x5d, 0xd7, 0xb3, 0xde, 0x74, 0x9d, 0xc0, 0xb0, 0xeb, 0x6e, 0x33, 0xb2, 0x81, 0x11, 0x4c, 0xf9,
+	0x27, 0x35, 0xb8, 0x6a, 0x76, 0xba, 0x5c, 0xba, 0x95, 0x96, 0x58, 0x75, 0xe2, 0x59, 0x6e, 0x51,
+	0xab, 0x67, 0x16, 0xe8, 0xa0, 0x56, 0xdf, 0xc8, 0x42, 0x89, 0xf3, 0x68, 0x31, 0xe3, 0xeb, 0xa6,
+	0xfb, 0xd0, 0x61, 0x9d, 0x6b, 0x04, 0x6c, 0x36, 0xdf, 0x8c, 0x3e, 0x42, 0x41, 0xe3, 0xeb, 0xc5,
+	0x4c, 0x8c, 0x38, 0x87, 0x12, 0xb5, 0x2e, 0xb6, 0x78, 0xe7, 0x30, 0x31, 0x9a, 0x96, 0x43, 0x7c,
+	0x9f, 0x5b, 0x6e, 0x0e, 0x60, 0x5d, 0xbc, 0x9c, 0x85, 0x10, 0x67, 0xd3, 0x41, 0xaf, 0x01, 0xf8,
+	0xfb, 0x8e, 0x29, 0xe6, 0x7f, 0xb8, 0x10, 0x55, 0x2e, 0x04, 0x86, 0x58, 0xb0, 0x82, 0x91, 0xde,
+	0x70, 0x83, 0x70, 0x51, 0x8e, 0x30, 0x63, 0x3c, 0x76, 0xc3, 0x8d, 0xd6, 0x50, 0x04, 0xd7, 0xff,
+	0x91, 0x06, 0xa3, 0x22, 0x98, 0x0a, 0x35, 0x91, 0x89, 0x69, 0x79, 0x42, 0xde, 0x93, 0xd0, 0xf4,
+	0xec, 0xb3, 0xa7, 0x3e, 0xa1, 0xe1, 0x13, 0xa2, 0x44, 0x21, 0x35, 0x81, 0x20, 0x1c, 0xa9, 0x0b,
+	0x63, 0x4f, 0x7e, 0xa2, 0x0c, 0x2b, 0xc4, 0xf4, 0xcf, 0x6a, 0x30, 0x93, 0x6a, 0xd5, 0x87, 0xbc,
+	0x70, 0x86, 0x56, 0x34, 0x5f, 0x1e, 0x82, 0x69, 0x66, 0x7a, 0xed, 0x18, 0x36, 0x57, 0xc0, 0x9c,
+	0xc1, 0x05, 0xe5, 0xdd, 0x30, 0x6e, 0xb5, 0xdb, 0xdd, 0x80, 0xb2, 0x6a, 0xa1, 0x43, 0x67, 0xdf,
+	0x7c, 0x59, 0x16, 0xe2, 0x08, 0x8e, 0x1c, 0x71, 0x14, 0x72, 0x26, 0xbe, 0x52, 0xec, 0xcb, 0xa9,
+	0x03, 0x9c, 0xa7, 0xc7, 0x16, 0x3f, 0xaf, 0xb2, 0x4e, 0xca, 0x9f, 0xd2, 0x00, 0xfc, 0xc0, 0xb3,
+	0x9c, 0x16, 0x2d, 0x14, 0xc7, 0x25, 0x3e, 0x01, 0xb2, 0x8d, 0x10, 0x29, 0x27, 0x1e, 0xce, 0x51,
+	0x04, 0xc0, 0x0a, 0x65, 0xb4, 0x20, 0xa4, 0x04, 0xce, 0xf1, 0xbf, 0x23, 0x21, 0x0f, 0x3d, 0x9e,
+	0x8e, 0x15, 0x26, 0x1c, 0xec, 0x23, 0x31, 0x62, 0xf6, 0x7d, 0x30, 0x1e, 0xd2, 0x3b, 0xea, 0xd4,
+	0x9d, 0x54, 0x8d, 0x03, 0x5f, 0x80, 0xf3, 0x89, 0xee, 0x1e, 0xeb, 0xd0, 0xfe, 0xf7, 0x1a, 0xa0,
+	0xf8, 0xe8, 0xcf, 0xe0, 0x6a, 0xd7, 0x8a, 0x5f, 0xed, 0xaa, 0x83, 0x7f, 0xb2, 0x9c, 0xbb, 0xdd,
+	0x17, 0xa7, 0x80, 0xc5, 0x9a, 0x0a, 0x63, 0x79, 0x89, 0x83, 0x8b, 0x9e, 0xb3, 0x91, 0xbf, 0x9a,
+	0xd8, 0xb9, 0x03, 0x9c, 0xb3, 0xf7, 0x12, 0xb8, 0xa2, 0x73, 0x36, 0x09, 0xc1, 0x29, 0xba, 0xe8,
+	0x63, 0x1a, 0x5c, 0x30, 0xe2, 0xb1, 0xa6, 0xe4, 0xcc, 0x14, 0x8a, 0x65, 0x90, 0x88, 0x5b, 0x15,
+	0xf5, 0x25, 0x01, 0xf0, 0x71, 0x8a, 0x2c, 0xf5, 0x58, 0x30, 0x3a, 0x16, 0x8d, 0x96, 0x44, 0xaf,
+	0x06, 0x32, 0x50, 0x10, 0xbb, 0xae, 0x2e, 0xd4, 0x97, 0xc3, 0x72, 0x1c, 0xab, 0x15, 0x06, 0x75,
+	0x12, 0x13, 0x39, 0x34, 0x60, 0x50, 0x27, 0x31, 0x87, 0x51, 0x50, 0x27, 0x31, 0x75, 0x2a, 0x11,
+	0xe4, 0x00, 0xb8, 0x56, 0xd3, 0x14, 0x24, 0xf9, 0xab, 0x5d, 0xa1, 0x1b, 0xf2, 0xfd, 0xe5, 0xc5,
+	0x9a, 0xa0, 0xc8, 0x4e, 0xbf, 0xe8, 0x37, 0x56, 0x28, 0xa0, 0x4f, 0x6a, 0x30, 0x25, 0x78, 0xb7,
+	0xa0, 0x39, 0x80, 0x51, 0x71, 0xc6, 0x9a, 0x9c, 0xc7, 0x2a, 0x72, 0xce, 0x77, 0x42, 0x77, 0xc7,
+	0x18, 0x0c, 0xc7, 0xfb, 0x81, 0xfe, 0xb6, 0x06, 0x97, 0xa8, 0xab, 0xbe, 0x65, 0x92, 0x05, 0xd3,
+	0xa4, 0x86, 0xf6, 0xa2, 0x83, 0x63, 0xc5, 0x63, 0xe0, 0x34, 0x32, 0xf0, 0x71, 0x3f, 0x9b, 0x2c,
+	0x08, 0xce, 0xa4, 0x4f, 0xc5, 0xb2, 0xf3, 0x0f, 0x8d, 0xc0, 0xdc, 0xae, 0x19, 0xe6, 0x36, 0xd3,
+	0x95, 0x73, 0xd7, 0x9a, 0x82, 0xeb, 0xfa, 0x41, 0x1c, 0x15, 0x7f, 0x75, 0x4e, 0x14, 0xe2, 0x24,
+	0x41, 0xe4, 0xc2, 0x98, 0x27, 0x02, 0xf8, 0x55, 0xa0, 0xb8, 0x48, 0x91, 0x8a, 0x06, 0xc8, 0x05,
+	0x7b, 0xf9, 0x0b, 0x87, 0x44, 0xa8, 0x77, 0x11, 0xbf, 0xda, 0x2c, 0x38, 0xae, 0xb3, 0xdf, 0x76,
+	0xbb, 0xfe, 0x42, 0x37, 0xd8, 0x26, 0x4e, 0x20, 0x75, 0x95, 0x13, 0xec, 0x18, 0x65, 0xde, 0x45,
+	0x4b, 0xbd, 0x2a, 0xe2, 0xde, 0x78, 0xd0, 0x2b, 0x30, 0x46, 0x76, 0x89, 0x13, 0xac, 0xaf, 0xaf,
+	0x54, 0x26, 0x8f, 0xc3, 0xa3, 0x43, 0x69, 0x8f, 0x0d, 0x61, 0x49, 0xe0, 0xc0, 0x21, 0x36, 0xb4,
+	0x03, 0xa3, 0x36, 0x8f, 0xc0, 0x58, 0x99, 0x2a, 0xce, 0x14, 0x93, 0xd1, 0x1c, 0xf9, 0xfd, 0x4f,
+	0xfc, 0xc0, 0x92, 0x02, 0xea, 0xc0, 0xcd, 0x26, 0xd9, 0x32, 0xba, 0x76, 0xb0, 0xe6, 0x06, 0x54,
+	0xa4, 0xdd, 0x8f, 0xf4, 0x53, 0xd2, 0xc9, 0x61, 0x9a, 0x85, 0xab, 0x78, 0xf2, 0xf0, 0x60, 0xee,
+	0xe6, 0xe2, 0x11, 0x75, 0xf1, 0x91, 0xd8, 0xd0, 0x3e, 0x3c, 0x21, 0xea, 0x6c, 0x38, 0x1e, 0x31,
+	0xcc, 0x6d, 0x3a, 0xcb, 0x69, 0xa2, 0xe7, 0x19, 0xd1, 0xff, 0xef, 0xf0, 0x60, 0xee, 0x89, 0xc5,
+	0xa3, 0xab, 0xe3, 0x7e, 0x70, 0xce, 0xbe, 0x04, 0x28, 0xbd, 0xcf, 0x8f, 0x3a, 0xb0, 0xc7, 0xd4,
+	0x03, 0xfb, 0xd3, 0xc3, 0x70, 0x9d, 0xb2, 0x8f, 0x48, 0x4c, 0x5d, 0x35, 0x1c, 0xa3, 0xf5, 0xad,
+	0x79, 0xb4, 0xfd, 0x9a, 0x06, 0x57, 0xb7, 0xb3, 0xaf, 0x90, 0x42, 0x50, 0xfe, 0x50, 0xa1, 0xab,
+	0x7e, 0xaf, 0x5b, 0x29, 0xdf, 0x59, 0x3d, 0xab, 0xe0, 0xbc, 0x4e, 0xa1, 0x97, 0xe0, 0x82, 0xe3,
+	0x36, 0x49, 0x6d, 0x79, 0x11, 0xaf, 0x1a, 0xfe, 0x4e, 0x43, 0xbe, 0xfc, 0x0d, 0x73, 0x9b, 0x93,
+	0xb5, 0x04, 0x0c, 0xa7, 0x6a, 0x53, 0x9f, 0x87, 0x4e, 0xdc, 0xbd, 0xac, 0xb8, 0x9d, 0x0b, 0x7b,
+	0xd8, 0xaa, 0xa7, 0xb0, 0xe1, 0x0c, 0x0a, 0xec, 0x0e, 0x4c, 0x3b, 0xb3, 0xea, 0x3a, 0x56, 0xe0,
+	0x7a, 0xcc, 0x15, 0x68, 0xa0, 0xab, 0x20, 0xbb, 0x03, 0xaf, 0x65, 0x62, 0xc4, 0x39, 0x94, 0xf4,
+	0xff, 0xae, 0xc1, 0x79, 0xba, 0x2c, 0xea, 0x9e, 0xbb, 0xb7, 0xff, 0xad, 0xb8, 0x20, 0x9f, 0x16,
+	0x46, 0x10, 0x5c, 0x77, 0x73, 0x59, 0x31, 0x80, 0x18, 0x67, 0x7d, 0x8e, 0x6c, 0x1e, 0x54, 0xf5,
+	0x55, 0x39, 0x5f, 0x7d, 0xa5, 0x7f, 0xb2, 0xc4, 0x45, 0x4c, 0xa9, 0x3e, 0xfa, 0x96, 0xdc, 0x87,
+	0xef, 0x83, 0x29, 0x5a, 0xb6, 0x6a, 0xec, 0xd5, 0x17, 0x5f, 0x76, 0x6d, 0xe9, 0xca, 0xc3, 0xcc,
+	0x73, 0xef, 0xa9, 0x00, 0x1c, 0xaf, 0x87, 0x9e, 0xa7, 0x96, 0x02, 0x2c, 0xfc, 0x84, 0xb8, 0xdc,
+	0xdc, 0xe4, 0x96, 0x02, 0xac, 0x88, 0xba, 0x88, 0x45, 0x8f, 0x25, 0xa2, 0x10, 0xcb, 0x06, 0xfa,
+	0x27, 0x2e, 0x03, 0x43, 0x6e, 0x93, 0xe0, 0x5b, 0x71, 0x4e, 0x9e, 0x81, 0x09, 0xb3, 0xd3, 0xad,
+	0xdd, 0x6e, 0x7c, 0xa8, 0xeb, 0xb2, 0x4b, 0x2b, 0x8b, 0x94, 0x4b, 0x65, 0xce, 0x5a, 0x7d, 0x43,
+	0x16, 0x63, 0xb5, 0x0e, 0xe5, 0x0e, 0x66, 0xa7, 0x2b, 0xf8, 0x6d, 0x5d, 0xb5, 0x51, 0x65, 0xdc,
+	0xa1, 0x56, 0xdf, 0x88, 0xc1, 0x70, 0xaa, 0x36, 0xfa, 0x11, 0x98, 0x24, 0x62, 0xe3, 0xde, 0xa5,
+	0xc1, 0x75, 0x39, 0x5f, 0x58, 0x2e, 0x3a, 0xf8, 0x70, 0x6a, 0x25, 0x37, 0xe0, 0xa2, 0xfa, 0x92,
+	0x42, 0x02, 0xc7, 0x08, 0xa2, 0xef, 0x87, 0x6b, 0xf2, 0xf7, 0x2a, 0x73, 0x84, 0x4d, 0x32, 0x8a,
+	0x61, 0xee, 0xf1, 0xbf, 0x94, 0x57, 0x09, 0xe7, 0xb7, 0x47, 0xbf, 0xaa, 0xc1, 0x95, 0x10, 0x6a,
+	0x39, 0x56, 0xbb, 0xdb, 0xc6, 0xc4, 0xb4, 0x0d, 0xab, 0x2d, 0x04, 0xf4, 0x07, 0x27, 0x36, 0xd0,
+	0x38, 0x7a, 0xce, 0xac, 0xb2, 0x61, 0x38, 0xa7, 0x4b, 0xe8, 0xb3, 0x1a, 0xdc, 0x94, 0xa0, 0xba,
+	0x47, 0x7c, 0xfa, 0x00, 0x18, 0x39, 0x92, 0x89, 0x29, 0x19, 0x2d, 0xc4, 0x3b, 0x99, 0xa4, 0xb2,
+	0x74, 0x04, 0x6e, 0x7c, 0x24, 0x75, 0x75, 0xb9, 0x34, 0xdc, 0xad, 0xa0, 0x32, 0x76, 0xaa, 0xcb,
+	0x85, 0x92, 0xc0, 0x31, 0x82, 0xe8, 0x9f, 0x68, 0x70, 0x55, 0x2d, 0x50, 0x57, 0x0b, 0x17, 0xe5,
+	0x5f, 0x39, 0xb1, 0xce, 0x24, 0xf0, 0x73, 0x5d, 0x70, 0x0e, 0x10, 0xe7, 0xf5, 0x8a, 0xb2, 0x6d,
+	0xee, 0xe1, 0xcd, 0xc5, 0xfd, 0x61, 0xce, 0xb6, 0xf9, 0x5a, 0xf5, 0xb1, 0x84, 0xd1, 0x8b, 0x6e,
+	0xc7, 0x6d, 0xd6, 0xad, 0xa6, 0xbf, 0x62, 0xb5, 0xad, 0x80, 0x09, 0xe5, 0x65, 0x3e, 0x1d, 0x75,
+	0xb7, 0x59, 0x5f, 0x5e, 0xe4, 0xe5, 0x38, 0x56, 0x8b, 0x05, 0xd8, 0xb0, 0xda, 0x46, 0x8b, 0xd4,
+	0xbb, 0xb6, 0x5d, 0xf7, 0x5c, 0xa6, 0x30, 0x5c, 0x24, 0x46, 0xd3, 0xb6, 0x1c, 0x52, 0x50, 0x08,
+	0x67, 0xdb, 0x6d, 0x39, 0x0f, 0x29, 0xce, 0xa7, 0x47, 0xed, 0xb3, 0xa8, 0xd2, 0xbe, 0xf1, 0xd0,
+	0xe8, 0xdc, 0x77, 0x84, 0x47, 0x3d, 0xbb, 0xc2, 0xde, 0x0e, 0x4b, 0xb1, 0x52, 0x83, 0xae, 0x26,
+	0xca, 0x05, 0x31, 0xe1, 0x81, 0xdd, 0x2a, 0xd3, 0x27, 0xb4, 0x9a, 0x24, 0x42, 0x3e, 0x7d, 0xf7,
+	0x14, 0x12, 0x38, 0x46, 0x90, 0xbe, 0x17, 0x4c, 0xfb, 0xfb, 0x7e, 0x40, 0xda, 0x61, 0x1f, 0xce,
+	0x9f, 0x74, 0x1f, 0x98, 0x2a, 0xb5, 0x11, 0x23, 0x82, 0x13, 0x44, 0x91, 0x01, 0xd7, 0xd9, 0xac,
+	0xde, 0xa9, 0xd1, 0x17, 0x98, 0xd0, 0x75, 0xbc, 0x4e, 0x3c, 0x93, 0x9a, 0x6e, 0x5f, 0x60, 0xeb,
+	0x86, 0x99, 0xd2, 0x2c, 0xe7, 0x57, 0xc3, 0xbd, 0x70, 0xa0, 0xd7, 0x60, 0x56, 0x80, 0x57, 0xdc,
+	0x87, 0x29, 0x0a, 0x33, 0x8c, 0x02, 0x33, 0x1d, 0x5a, 0xce, 0xad, 0x85, 0x7b, 0x60, 0xa0, 0x56,
+	0xc3, 0x3e, 0xf1, 0xd8, 0x4b, 0x08, 0x09, 0x17, 0x8f, 0x5f, 0x41, 0x91, 0xd5, 0x70, 0x23, 0x0d,
+	0xc6, 0x59, 0x6d, 0xa8, 0x59, 0xb7, 0xf0, 0x21, 0xda, 0xa7, 0x05, 0x1f, 0xaa, 0x37, 0x2a, 0x17,
+	0x59, 0xff, 0x2e, 0x2a, 0xfe, 0x46, 0x12, 0x84, 0x93, 0x75, 0xa9, 0x6c, 0x21, 0x8b, 0xaa, 0x5d,
+	0xcf, 0x0f, 0x2a, 0x97, 0x58, 0x63, 0x26, 0x5b, 0x60, 0x15, 0x80, 0xe3, 0xf5, 0xa8, 0x01, 0xa9,
+	0x4f, 0x4c, 0xd3, 0x6d, 0x77, 0xc4, 0xf5, 0xaa, 0x72, 0x99, 0xf5, 0x9e, 0x7f, 0xc1, 0x18, 0x04,
+	0x27, 0x6a, 0xa2, 0x7d, 0xb8, 0x18, 0x86, 0x39, 0x5b, 0x71, 0x5b, 0xab, 0xc6, 0x1e, 0x13, 0xd5,
+	0xaf, 0x1c, 0xbd, 0x03, 0xe7, 0xe5, 0xd3, 0xf6, 0xfc, 0x87, 0xba, 0x86, 0x13, 0x50, 0x6f, 0x51,
+	0x36, 0x5d, 0xb5, 0x34, 0x3a, 0x9c, 0x45, 0x83, 0xc6, 0x59, 0x4f, 0x14, 0xdf, 0xb6, 0xe8, 0xd3,
+	0xe5, 0x55, 0x36, 0x6c, 0xa6, 0x23, 0xa9, 0x65, 0xc0, 0x71, 0x66, 0x2b, 0x74, 0x1f, 0x2e, 0x77,
+	0x3c, 0x37, 0x20, 0x66, 0x70, 0x8f, 0x78, 0x0e, 0xb1, 0xc5, 0x00, 0xfd, 0x4a, 0x85, 0xcd, 0x05,
+	0x7b, 0x05, 0xaa, 0x67, 0x55, 0xc0, 0xd9, 0xed, 0xd0, 0xa7, 0x35, 0xb8, 0xe1, 0x07, 0x1e, 0x31,
+	0xda, 0x96, 0xd3, 0xaa, 0xb9, 0x8e, 0x43, 0x18, 0x9b, 0x5c, 0x6e, 0x46, 0x46, 0xf7, 0xd7, 0x0a,
+	0xf1, 0x29, 0xfd, 0xf0, 0x60, 0xee, 0x46, 0xa3, 0x27, 0x66, 0x7c, 0x04, 0x65, 0x6a, 0xc4, 0xd4,
+	0x26, 0x6d, 0xd7, 0xdb, 0xa7, 0x1c, 0xa9, 0x32, 0x5b, 0xdc, 0x88, 0x69, 0x35, 0xc4, 0xc2, 0xb7,
+	0x7f, 0xec, 0xfd, 0x2a, 0x02, 0x62, 0x85, 0x9c, 0x7e, 0x50, 0x82, 0xcb, 0x99, 0x07, 0x0f, 0xdd,
+	0x01, 0xbc, 0xde, 0x82, 0x0c, 0x79, 0x2e, 0x9e, 0x7c, 0xd8, 0x0e, 0x58, 0x8d, 0x83, 0x70, 0xb2,
+	0x2e, 0x15, 0x0b, 0xd9, 0x4e, 0xbd, 0xdd, 0x88, 0xda, 0x97, 0x22, 0xb1, 0x70, 0x39, 0x01, 0xc3,
+	0xa9, 0xda, 0xa8, 0x06, 0x33, 0xa2, 0x6c, 0x99, 0xde, 0xac, 0xfc, 0xdb, 0x1e, 0x91, 0x02, 0x37,
+	0xbd, 0xa3, 0xcc, 0x2c, 0x27, 0x81, 0x38, 0x5d, 0x9f, 0x8e, 0x82, 0xfe, 0x50, 0x7b, 0x31, 0x14,
+	0x8d, 0x62, 0x2d, 0x0e, 0xc2, 0xc9, 0xba, 0xf2, 0xea, 0x1b, 0xeb, 0xc2, 0x70, 0x34, 0x8a, 0xb5,
+	0x04, 0x0c, 0xa7, 0x6a, 0xeb, 0xff, 0x61, 0x08, 0x9e, 0xe8, 0x43, 0x58, 0x43, 0xed, 0xec, 0xe9,
+	0x3e, 0xfe, 0xc6, 0xed, 0xef, 0xf3, 0x74, 0x72, 0x3e, 0xcf, 0xf1, 0xe9, 0xf5, 0xfb, 0x39, 0xfd,
+	0xbc, 0xcf, 0x79, 0x7c, 0x92, 0xfd, 0x7f, 0xfe, 0x76, 0xf6, 0xe7, 0x2f, 0x38, 0xab, 0x47, 0x2e,
+	0x97, 0x4e, 0xce, 0x72, 0x29, 0x38, 0xab, 0x7d, 0x2c, 0xaf, 0x3f, 0x1a, 0x82, 0x27, 0xfb, 0x11,
+	0x1c, 0x0b, 0xae, 0xaf, 0x0c, 0x96, 0x77, 0xaa, 0xeb, 0x2b, 0xcf, 0xaf, 0xe9, 0x14, 0xd7, 0x57,
+	0x06, 0xc9, 0xd3, 0x5e, 0x5f, 0x79, 0xb3, 0x7a, 0x5a, 0xeb, 0x2b, 0x6f, 0x56, 0xfb, 0x58, 0x5f,
+	0x7f, 0x96, 0x3c, 0x1f, 0x42, 0x79, 0x71, 0x19, 0xca, 0x66, 0xa7, 0x5b, 0x90, 0x49, 0x31, 0x03,
+	0xa1, 0x5a, 0x7d, 0x03, 0x53, 0x1c, 0x08, 0xc3, 0x08, 0x5f, 0x3f, 0x05, 0x59, 0x10, 0xf3, 0x90,
+	0xe1, 0x4b, 0x12, 0x0b, 0x4c, 0x74, 0xaa, 0x48, 0x67, 0x9b, 0xb4, 0x89, 0x67, 0xd8, 0x8d, 0xc0,
+	0xf5, 0x8c, 0x56, 0x51, 0x6e, 0xc3, 0xa6, 0x6a, 0x29, 0x81, 0x0b, 0xa7, 0xb0, 0xd3, 0x09, 0xe9,
+	0x58, 0xcd, 0xca, 0x50, 0xf1, 0x09, 0xa9, 0x2f, 0x2f, 0x62, 0x8a, 0x43, 0xff, 0xbb, 0xe3, 0xa0,
+	0x84, 0x11, 0xa5, 0xfa, 0x09, 0xc3, 0xb6, 0xdd, 0x87, 0x75, 0xcf, 0xda, 0xb5, 0x6c, 0xd2, 0x22,
+	0xcd, 0x50, 0x98, 0xf2, 0x85, 0x19, 0x19, 0xbb, 0x30, 0x2d, 0xe4, 0x55, 0xc2, 0xf9, 0xed, 0xa9,
+	0xfe, 0x69, 0xc6, 0x4c, 0xc6, 0xf3, 0x19, 0xc4, 0xd0, 0x24, 0x15, 0x1c, 0x88, 0xef, 0xa7, 0x54,
+	0x31, 0x4e, 0x93, 0x45, 0x3f, 0xaa, 0x71, 0xa5, 0x5c, 0xf8, 0x4c, 0x22, 0xbe, 0xd9, 0x9d, 0x13,
+	0x7a, 0x50, 0x8c, 0xb4, 0x7b, 0x21, 0x00, 0xc7, 0x09, 0x52, 0x0d, 0xc8, 0xe5, 0x9d, 0xac, 0xb7,
+	0x84, 0xca, 0x50, 0x71, 0x2f, 0xc8, 0x1e, 0x8f, 0x13, 0x5c, 0x9c, 0xcd, 0xac, 0x80, 0xb3, 0x3b,
+	0x12, 0xce, 0x52, 0xa8, 0x5e, 0xad, 0x0c, 0x0f, 0x36, 0x4b, 0x09, 0x3d, 0x6d, 0x34, 0x4b, 0x21,
+	0x00, 0xc7, 0x09, 0x52, 0x07, 0xb4, 0x1d, 0xa9, 0xd3, 0xae, 0x8c, 0x14, 0x7f, 0xbf, 0x4c, 0x28,
+	0xc6, 0xb9, 0x21, 0x4d, 0x58, 0x88, 0x23, 0x22, 0x68, 0x1b, 0x46, 0x77, 0x38, 0x23, 0x12, 0xfa,
+	0xa7, 0x85, 0x81, 0xef, 0xc7, 0x5c, 0x0d, 0x22, 0x8a, 0xb0, 0x44, 0xaf, 0x5a, 0xd1, 0x8e, 0x1d,
+	0xe1, 0xdc, 0xf1, 0x69, 0x0d, 0x2e, 0xef, 0x12, 0x2f, 0xb0, 0xcc, 0xe4, 0x4b, 0xce, 0x78, 0xf1,
+	0x3b, 0xfc, 0xcb, 0x59, 0x08, 0xf9, 0x32, 0xc9, 0x04, 0xe1, 0xec, 0x2e, 0xd0, 0x1b, 0x3d, 0x57,
+	0xc8, 0x37, 0x02, 0x23, 0xb0, 0xcc, 0x75, 0x77, 0x87, 0x38, 0x51, 0xb6, 0x2b, 0xa6, 0x09, 0x1a,
+	0xe3, 0x37, 0xfa, 0xa5, 0xfc, 0x6a, 0xb8, 0x17, 0x0e, 0xfd, 0x1b, 0x1a, 0xa4, 0xd4, 0xca, 0xe8,
+	0x67, 0x35, 0x98, 0xdc, 0x22, 0x46, 0xd0, 0xf5, 0xc8, 0x1d, 0x23, 0x08, 0x3d, 0xce, 0x5f, 0x3e,
+	0x09, 0x6d, 0xf6, 0xfc, 0x6d, 0x05, 0x31, 0x37, 0x08, 0x08, 0x43, 0x10, 0xab, 0x20, 0x1c, 0xeb,
+	0xc1, 0xec, 0x8b, 0x30, 0x93, 0x6a, 0x78, 0xac, 0x17, 0xc6, 0x7f, 0xa1, 0x41, 0x56, 0x82, 0x36,
+	0xf4, 0x1a, 0x0c, 0x1b, 0x34, 0x55, 0x9c, 0x60, 0x98, 0xcf, 0x15, 0xb3, 0x4d, 0x69, 0xaa, 0x8e,
+	0xfd, 0xec, 0x27, 0xe6, 0x68, 0x69, 0xfc, 0x49, 0x23, 0xf6, 0xc2, 0xbd, 0x1a, 0xb9, 0xab, 0xb2,
+	0x97, 0xb0, 0x85, 0x14, 0x14, 0x67, 0xb4, 0xd0, 0x3f, 0xaa, 0x01, 0x4a, 0x07, 0xad, 0x46, 0x1e,
+	0x8c, 0x89, 0xa5, 0x2c, 0xbf, 0xd2, 0x62, 0x41, 0x97, 0x92, 0x98, 0x7f, 0x54, 0x64, 0xe8, 0x24,
+	0x0a, 0x7c, 0x1c, 0xd2, 0xa1, 0xd1, 0x4d, 0xa2, 0xac, 0x0c, 0xe8, 0xbd, 0x30, 0xd1, 0x24, 0xbe,
+	0xe9, 0x59, 0x9d, 0x20, 0xf2, 0xa6, 0x0a, 0xbd, 0x32, 0x16, 0x23, 0x10, 0x56, 0xeb, 0x51, 0x27,
+	0xd9, 0xc0, 0xf0, 0x77, 0x96, 0x17, 0xc5, 0xa5, 0x92, 0x89, 0x00, 0xeb, 0xac, 0x04, 0x0b, 0x48,
+	0x14, 0x32, 0xac, 0xdc, 0x47, 0xc8, 0x30, 0xea, 0xa7, 0x35, 0x70, 0x7c, 0x34, 0x74, 0x74, 0x6c,
+	0x34, 0xfd, 0x57, 0x4a, 0x70, 0x9e, 0x56, 0x59, 0x35, 0x2c, 0x27, 0x20, 0x0e, 0xf3, 0x1d, 0x28,
+	0x38, 0x09, 0x2d, 0x98, 0x0a, 0x62, 0xbe, 0x71, 0xc7, 0xf7, 0x2c, 0x0b, 0xad, 0x69, 0xe2, 0x1e,
+	0x71, 0x71, 0xbc, 0xe8, 0x39, 0xe9, 0xbc, 0xc1, 0xaf, 0xdf, 0x4f, 0xc8, 0xa5, 0xca, 0x3c, 0x32,
+	0x1e, 0x09, 0x47, 0xc3, 0x30, 0x95, 0x47, 0xcc, 0x4f, 0xe3, 0x7d, 0x30, 0x25, 0x8c, 0xa8, 0x79,
+	0xec, 0x37, 0x71, 0xfd, 0x66, 0x27, 0xcc, 0x6d, 0x15, 0x80, 0xe3, 0xf5, 0xf4, 0x2f, 0x95, 0x20,
+	0x9e, 0x30, 0xa4, 0xe8, 0x2c, 0xa5, 0x03, 0xdf, 0x95, 0x4e, 0x2d, 0xf0, 0xdd, 0xb7, 0xb3, 0x6c,
+	0x5b, 0x3c, 0x2d, 0x23, 0x7f, 0x22, 0x57, 0x73, 0x64, 0xb1, 0x72, 0x1c, 0xd6, 0x88, 0xa6, 0x75,
+	0xe8, 0xd8, 0xd3, 0xfa, 0x5e, 0x61, 0x5d, 0x39, 0x1c, 0x0b, 0x3f, 0x28, 0xad, 0x2b, 0x67, 0x62,
+	0x0d, 0x15, 0x57, 0x93, 0xcf, 0x6b, 0x30, 0x2a, 0x22, 0xb5, 0xf7, 0xe1, 0xca, 0x44, 0xbd, 0xcd,
+	0xe8, 0x95, 0x67, 0x10, 0x69, 0xb0, 0xb1, 0xed, 0xba, 0x41, 0x2c, 0x5e, 0x3d, 0xf3, 0x1d, 0x60,
+	0xff, 0x62, 0x8e, 0x9e, 0x19, 0xd8, 0x79, 0xe6, 0xb6, 0x15, 0x10, 0x33, 0x90, 0x51, 0xb0, 0xa5,
+	0x81, 0x9d, 0x52, 0x8e, 0x63, 0xb5, 0xf4, 0xcf, 0x0c, 0xc1, 0x4d, 0x81, 0x38, 0x25, 0x22, 0x85,
+	0x0c, 0x6e, 0x9f, 0xa6, 0x12, 0x65, 0x75, 0x16, 0x3d, 0xc3, 0x0a, 0x4d, 0x0f, 0x8a, 0x5d, 0x7d,
+	0x45, 0xea, 0xd1, 0x14, 0x3a, 0x9c, 0x45, 0x83, 0xc7, 0x73, 0x66, 0xc5, 0x77, 0x89, 0x61, 0x07,
+	0xdb, 0x92, 0x76, 0x69, 0x90, 0x78, 0xce, 0x69, 0x7c, 0x38, 0x93, 0x0a, 0x33, 0x7d, 0x10, 0x80,
+	0x9a, 0x47, 0x0c, 0xd5, 0xee, 0x62, 0x00, 0xf3, 0xff, 0xd5, 0x4c, 0x8c, 0x38, 0x87, 0x12, 0xd3,
+	0x21, 0x1a, 0x7b, 0x4c, 0x25, 0x81, 0x49, 0xe0, 0x59, 0x44, 0x86, 0x66, 0xe5, 0x4a, 0x84, 0x38,
+	0x08, 0x27, 0xeb, 0x52, 0x65, 0x38, 0x33, 0x25, 0x89, 0x42, 0x5d, 0x0d, 0x47, 0xd1, 0x14, 0xd6,
+	0x62, 0x10, 0x9c, 0xa8, 0xa9, 0xff, 0x58, 0x09, 0x26, 0xd5, 0x65, 0xd7, 0x87, 0x5f, 0x53, 0x57,
+	0x39, 0x0c, 0x07, 0xf0, 0xb9, 0x51, 0xa9, 0xf6, 0x71, 0x1e, 0xa2, 0x57, 0x60, 0xba, 0xcb, 0x38,
+	0x48, 0x18, 0x82, 0x96, 0xaf, 0xff, 0xef, 0xa4, 0xa3, 0xdc, 0x88, 0x41, 0x68, 0xa8, 0x27, 0x15,
+	0x7d, 0x1c, 0x8a, 0x13, 0x78, 0xf4, 0x4f, 0x94, 0xe1, 0x62, 0x46, 0x6f, 0x98, 0xc9, 0x01, 0x49,
+	0x1c, 0xd9, 0x83, 0x98, 0x1c, 0xa4, 0x8e, 0xff, 0xd0, 0xe4, 0x20, 0x09, 0xc1, 0x29, 0xba, 0xe8,
+	0x65, 0x28, 0x9b, 0x9e, 0x25, 0x26, 0xfc, 0x7d, 0x85, 0x2e, 0x9c, 0x78, 0xb9, 0x3a, 0x21, 0x28,
+	0xd2, 0xbc, 0x34, 0x98, 0x22, 0xa4, 0x07, 0x8f, 0xca, 0x2e, 0xa4, 0x14, 0xc0, 0x0e, 0x1e, 0x95,
+	0xab, 0xf8, 0x38, 0x5e, 0x0f, 0xbd, 0x02, 0x15, 0x71, 0x13, 0x90, 0x3e, 0xd2, 0xae, 0xe3, 0x07,
+	0x74, 0x67, 0x07, 0x95, 0xa1, 0x30, 0xa2, 0x7b, 0xe5, 0x5e, 0x4e, 0x1d, 0x9c, 0xdb, 0x5a, 0xff,
+	0xd3, 0x32, 0x4c, 0x28, 0x79, 0x32, 0xd0, 0xea, 0x20, 0x2a, 0x94, 0x68, 0xc4, 0x52, 0x8d, 0xb2,
+	0x0a, 0xe5, 0x56, 0xa7, 0x5b, 0x29, 0x0d, 0x86, 0xee, 0x0e, 0x45, 0xd7, 0xea, 0x74, 0xd1, 0xcb,
+	0xa1, 0x56, 0xa6, 0x98, 0xde, 0x24, 0xf4, 0x68, 0x49, 0x68, 0x66, 0xe4, 0x46, 0x1c, 0xca, 0xdd,
+	0x88, 0x6d, 0x18, 0xf5, 0x85, 0xca, 0x66, 0xb8, 0x78, 0x54, 0x1a, 0x65, 0xa6, 0x85, 0x8a, 0x86,
+	0xdf, 0xf7, 0xc4, 0x0f, 0x2c, 0x69, 0x50, 0x59, 0xb2, 0xcb, 0xfc, 0x64, 0xd9, 0x45, 0x76, 0x8c,
+	0xcb, 0x92, 0x1b, 0xac, 0x04, 0x0b, 0x48, 0xea, 0x88, 0x1a, 0xed, 0xeb, 0x88, 0xfa, 0xeb, 0x25,
+	0x40, 0xe9, 0x6e, 0xa0, 0x27, 0x60, 0x98, 0xf9, 0xd9, 0x0b, 0x5e, 0x14, 0x4a, 0xfe, 0xcc, 0xd3,
+	0x1a, 0x73, 0x18, 0x6a, 0x88, 0x18, 0x1b, 0xc5, 0x3e, 0x27, 0xb3, 0xd9, 0x11, 0xf4, 0x94, 0x80,
+	0x1c, 0x37, 0x63, 0x4e, 0x19, 0x59, 0x67, 0xfe, 0x06, 0x8d, 0x37, 0xe4, 0xd0, 0x26, 0x05, 0x35,
+	0x59, 0xdc, 0xb4, 0x80, 0xa3, 0xc0, 0x12, 0x97, 0xfe, 0x47, 0x25, 0x98, 0x50, 0x25, 0xde, 0x7d,
+	0x00, 0xa3, 0x1b, 0xb8, 0x9c, 0x81, 0x55, 0xb4, 0xe2, 0x97, 0x65, 0x05, 0xe9, 0x42, 0x88, 0x90,
+	0x3f, 0x79, 0x45, 0xbf, 0xb1, 0x42, 0x8c, 0x92, 0x0e, 0xac, 0x36, 0x79, 0x60, 0x39, 0x4d, 0xf7,
+	0x61, 0xa5, 0x74, 0x22, 0xa4, 0xd7, 0x43, 0x84, 0x9c, 0x74, 0xf4, 0x1b, 0x2b, 0xc4, 0x28, 0x6b,
+	0x61, 0x17, 0x67, 0x87, 0x25, 0x2e, 0x12, 0x7d, 0x73, 0x6d, 0x5b, 0x9e, 0xca, 0x63, 0x9c, 0xb5,
+	0xd4, 0x72, 0xea, 0xe0, 0xdc, 0xd6, 0xfa, 0xaf, 0x6a, 0x70, 0x39, 0x73, 0x2a, 0xd0, 0x1d, 0x98,
+	0x89, 0xcc, 0xbc, 0x54, 0x66, 0x3f, 0x16, 0x25, 0xcc, 0xba, 0x97, 0xac, 0x80, 0xd3, 0x6d, 0x78,
+	0x56, 0xf6, 0xd4, 0x61, 0x22, 0x6c, 0xc4, 0x54, 0xd1, 0x48, 0x05, 0xe3, 0xac, 0x36, 0xfa, 0xf7,
+	0xc7, 0x3a, 0x1b, 0x4d, 0x16, 0xdd, 0x19, 0x9b, 0xa4, 0x65, 0x39, 0xc9, 0x9d, 0x51, 0xa5, 0x85,
+	0x98, 0xc3, 0xd0, 0xe3, 0xaa, 0xab, 0x69, 0xc8, 0xb7, 0xa4, 0xbb, 0xa9, 0xfe, 0x43, 0x70, 0x35,
+	0xe7, 0x25, 0x14, 0x2d, 0xc2, 0xa4, 0xff, 0xd0, 0xe8, 0x54, 0xc9, 0xb6, 0xb1, 0x6b, 0x89, 0xd0,
+	0x05, 0xdc, 0x7c, 0x6f, 0xb2, 0xa1, 0x94, 0x3f, 0x4a, 0xfc, 0xc6, 0xb1, 0x56, 0x7a, 0x00, 0x20,
+	0xcc, 0x3c, 0xa9, 0xa9, 0xf6, 0x16, 0x8c, 0x19, 0x22, 0x29, 0xb8, 0x58, 0xc7, 0xdf, 0x5b, 0x48,
+	0x09, 0x20, 0x70, 0x70, 0xfb, 0x73, 0xf9, 0x0b, 0x87, 0xb8, 0xf5, 0x7f, 0xa0, 0xc1, 0x95, 0x6c,
+	0x67, 0xf5, 0x3e, 0x44, 0x9b, 0x36, 0x4c, 0x78, 0x51, 0x33, 0xb1, 0xe8, 0xbf, 0x5b, 0xd9, 0xd9,
+	0xf3, 0x4a, 0x78, 0x2e, 0x2a, 0xf6, 0xd5, 0x3c, 0xd7, 0x97, 0x5f, 0x3e, 0x19, 0xc0, 0x34, 0xbc,
+	0x72, 0x29, 0x3d, 0xc1, 0x2a, 0x7e, 0xfd, 0xb7, 0x4b, 0x00, 0x6b, 0x24, 0xa0, 0xe1, 0xd8, 0xe8,
+	0x14, 0x3d, 0x16, 0xbb, 0x69, 0x8c, 0x7d, 0xf3, 0x02, 0x26, 0x3c, 0x06, 0x43, 0x1d, 0x6a, 0x04,
+	0x55, 0x8e, 0x3a, 0xc2, 0x2c, 0xa0, 0x58, 0x29, 0xf5, 0x71, 0x66, 0x0f, 0x1f, 0xe2, 0x64, 0x62,
+	0xf7, 0x14, 0x96, 0x85, 0x03, 0xf3, 0x72, 0x9e, 0xea, 0x91, 0xf9, 0x74, 0xf8, 0xe2, 0xe2, 0x25,
+	0x52, 0x3d, 0xf2, 0x32, 0x1c, 0x42, 0xd1, 0xf3, 0x00, 0x56, 0xe7, 0xb6, 0xd1, 0xb6, 0x6c, 0x8b,
+	0xf0, 0xe8, 0xff, 0x3c, 0xb3, 0x38, 0x2c, 0xd7, 0x65, 0xe9, 0xa3, 0x83, 0xb9, 0x31, 0xf1, 0x6b,
+	0x1f, 0x2b, 0xb5, 0xf5, 0xbf, 0x28, 0x43, 0x2c, 0x0b, 0x7f, 0xa4, 0x63, 0xd2, 0x4e, 0x47, 0xc7,
+	0xf4, 0x0a, 0x54, 0x6c, 0xd7, 0x68, 0x56, 0x0d, 0x9b, 0xee, 0x46, 0xaf, 0xc1, 0x3f, 0xa3, 0xe1,
+	0xb4, 0xc2, 0x54, 0xeb, 0x8c, 0x2b, 0xad, 0xe4, 0xd4, 0xc1, 0xb9, 0xad, 0x51, 0x10, 0xe6, 0xfe,
+	0x2f, 0x17, 0x77, 0x7f, 0x54, 0xe7, 0x62, 0x5e, 0xf5, 0x04, 0x0a, 0x05, 0x0c, 0xf1, 0xb5, 0x05,
+	0x2d, 0x7a, 0xf5, 0xb9, 0x4c, 0xf6, 0xb8, 0x27, 0xdc, 0xba, 0x67, 0x6c, 0x6d, 0x59, 0xa6, 0xb0,
+	0x4b, 0xe5, 0x1f, 0x76, 0x85, 0x6a, 0x52, 0x97, 0xb2, 0x2a, 0x3c, 0x3a, 0x98, 0xbb, 0x95, 0xe9,
+	0x98, 0xc8, 0x3e, 0x6b, 0x66, 0x13, 0x9c, 0x4d, 0x8a, 0xc6, 0x0c, 0x38, 0x86, 0x37, 0x43, 0xcc,
+	0xfd, 0xf0, 0xcf, 0x47, 0x60, 0x92, 0xae, 0x3b, 0xea, 0x20, 0x6f, 0xd3, 0x88, 0x70, 0x4f, 0x27,
+	0x83, 0x06, 0x84, 0x0a, 0xe9, 0x54, 0xe0, 0x80, 0x15, 0xb8, 0xb4, 0xe5, 0x7a, 0x26, 0x59, 0xaf,
+	0xd5, 0xd7, 0x5d, 0xf1, 0xe4, 0xb2, 0xb8, 0xd6, 0x10, 0x5c, 0x9a, 0x5d, 0x22, 0x6f, 0x67, 0xc0,
+	0x71, 0x66, 0x2b, 0x6a, 0x88, 0x13, 0x95, 0x6f, 0x74, 0xb8, 0x21, 0x0b, 0x45, 0x57, 0x8e, 0x0c,
+	0x71, 0x6e, 0x67, 0x55, 0xc0, 0xd9, 0xed, 0xa8, 0x4a, 0x5a, 0xc4, 0x24, 0xb9, 0xed, 0x7a, 0x0f,
+	0x0d, 0xaf, 0x19, 0x47, 0x3b, 0x14, 0xa9, 0xa4, 0x17, 0xf3, 0xab, 0xe1, 0x5e, 0x38, 0xd0, 0xdd,
+	0x78, 0x60, 0x10, 0xba, 0x63, 0x9e, 0xca, 0x0a, 0xcb, 0x1c, 0x31, 0xaf, 0x37, 0xba, 0x96, 0x47,
+	0xda, 0xc4, 0x09, 0xfc, 0xea, 0x39, 0x35, 0x78, 0xe9, 0x3c, 0xcc, 0xc4, 0xb2, 0xd1, 0xb0, 0x88,
+	0x6f, 0x3c, 0x4f, 0xc1, 0x39, 0x9c, 0x06, 0xa1, 0x6a, 0x3c, 0x40, 0x0d, 0x77, 0x85, 0xbb, 0x91,
+	0x45, 0x5b, 0x09, 0x40, 0x73, 0x2e, 0x16, 0x6d, 0x06, 0x3d, 0x0f, 0x57, 0xf9, 0xa7, 0x5c, 0x34,
+	0x08, 0x0d, 0x0e, 0x48, 0x02, 0xf9, 0xa0, 0x5f, 0x19, 0x13, 0xf9, 0x6d, 0xf2, 0x2a, 0xa0, 0x77,
+	0xc1, 0xf9, 0xae, 0x98, 0x08, 0xfe, 0x96, 0xc5, 0xf3, 0xbf, 0xd1, 0xde, 0x26, 0x01, 0x48, 0x87,
+	0x09, 0x93, 0xe5, 0x15, 0x62, 0x61, 0xe2, 0x44, 0x12, 0xa6, 0x73, 0x58, 0x2d, 0x44, 0x0f, 0xc3,
+	0x87, 0xc1, 0xc5, 0xb5, 0x86, 0x98, 0x6b, 0x91, 0x72, 0xa9, 0xd0, 0xc5, 0x58, 0x5d, 0xd3, 0x02,
+	0x1d, 0x9d, 0xc8, 0x14, 0x0d, 0xb4, 0x0f, 0xa8, 0x1b, 0x7d, 0x51, 0x49, 0x79, 0xf2, 0xa4, 0x29,
+	0x67, 0x10, 0xd1, 0x7f, 0x47, 0x83, 0x8b, 0x19, 0xb5, 0xd1, 0x26, 0x5c, 0xdc, 0x66, 0xfa, 0x95,
+	0xda, 0x36, 0x31, 0x77, 0xc2, 0x1c, 0x62, 0x5a, 0xc1, 0xbc, 0x36, 0x59, 0xc8, 0xd0, 0x3b, 0x69,
+	0x86, 0xc8, 0xbd, 0x9a, 0xeb, 0x98, 0x5d, 0xcf, 0x93, 0xe1, 0x72, 0x69, 0x1a, 0x9b, 0x78, 0x31,
+	0xaa, 0x24, 0x62, 0x41, 0x9f, 0x93, 0x31, 0x9e, 0xf5, 0x5f, 0x18, 0x01, 0xc5, 0x55, 0xf3, 0x18,
+	0x89, 0x31, 0x7f, 0x59, 0x83, 0x4b, 0xa6, 0x6d, 0x11, 0x27, 0x48, 0xf8, 0xe5, 0xf1, 0xa3, 0x78,
+	0xa3, 0x90, 0x0f, 0x69, 0x87, 0x38, 0xcb, 0x8b, 0xc2, 0xe6, 0xad, 0x96, 0x81, 0x5c, 0xd8, 0x05,
+	0x66, 0x40, 0x70, 0x66, 0x67, 0xd8, 0x78, 0x58, 0xf9, 0xf2, 0xa2, 0x1a, 0x48, 0xa4, 0x26, 0xca,
+	0x70, 0x08, 0xa5, 0x7e, 0x0c, 0x2d, 0xcf, 0xed, 0x76, 0xfc, 0x1a, 0x33, 0xb4, 0xe7, 0x7c, 0x9f,
+	0xdd, 0x89, 0xee, 0x44, 0xc5, 0x58, 0xad, 0x43, 0x6f, 0x78, 0xfc, 0x67, 0xdd, 0x23, 0x5b, 0xd6,
+	0x5e, 0x65, 0x38, 0xba, 0xe1, 0xdd, 0x51, 0xca, 0x71, 0xac, 0x16, 0x8b, 0x05, 0xe0, 0xfb, 0x5d,
+	0xe2, 0x6d, 0xe0, 0x15, 0xc1, 0x1b, 0x78, 0x2c, 0x00, 0x59, 0x88, 0x23, 0x38, 0xfa, 0x39, 0x0d,
+	0xa6, 0x3d, 0xce, 0x6e, 0x9a, 0x8c, 0xa8, 0x64, 0x12, 0x78, 0x30, 0x1f, 0xdd, 0x79, 0x1c, 0x43,
+	0xca, 0x4f, 0xc7, 0x50, 0x65, 0x1d, 0x07, 0xe2, 0x44, 0x0f, 0xe8, 0x54, 0xf9, 0x56, 0xcb, 0xb1,
+	0x9c, 0xd6, 0x82, 0xdd, 0xf2, 0x2b, 0x63, 0x37, 0xcb, 0x72, 0xaa, 0x1a, 0x51, 0x31, 0x56, 0xeb,
+	0x50, 0xd5, 0x4a, 0xd7, 0xa7, 0x67, 0x5e, 0x9b, 0xf0, 0xf9, 0x1d, 0x8f, 0x74, 0xfa, 0x1b, 0x2a,
+	0x00, 0xc7, 0xeb, 0x51, 0x85, 0x9e, 0x2c, 0x10, 0xb3, 0x0c, 0xac, 0x25, 0x93, 0xdd, 0x36, 0x62,
+	0x10, 0x9c, 0xa8, 0x39, 0xbb, 0x00, 0x17, 0x33, 0x86, 0x79, 0xac, 0x83, 0xf5, 0xff, 0x6a, 0x70,
+	0x99, 0x67, 0xf5, 0x96, 0xd9, 0x4f, 0x64, 0xa8, 0xc8, 0xec, 0xa8, 0x8b, 0xda, 0xa9, 0x46, 0x5d,
+	0xfc, 0x26, 0x44, 0x97, 0xd4, 0xff, 0x5e, 0x09, 0xde, 0x71, 0xe4, 0xbe, 0x44, 0x7f, 0x47, 0x83,
+	0x09, 0xb2, 0x17, 0x78, 0x46, 0xe8, 0x8d, 0x44, 0x17, 0xe9, 0xd6, 0xa9, 0x30, 0x81, 0xf9, 0xa5,
+	0x88, 0x50, 0x22, 0x8d, 0x95, 0x02, 0xc1, 0x6a, 0x7f, 0xa8, 0xc2, 0x86, 0x47, 0x58, 0x55, 0x1f,
+	0xff, 0x78, 0xcc, 0x03, 0x2c, 0x20, 0x34, 0xbf, 0x54, 0x12, 0xf3, 0xb1, 0xd6, 0xca, 0x3f, 0xd6,
+	0xe0, 0x5a, 0x6e, 0xa2, 0xba, 0x6c, 0xd1, 0x40, 0xcb, 0x17, 0x0d, 0x3e, 0x9c, 0x99, 0xbe, 0xb0,
+	0x68, 0xf2, 0xb5, 0x0c, 0x5c, 0xfa, 0x6f, 0x95, 0x80, 0xba, 0xa0, 0xd1, 0x9b, 0xda, 0x19, 0x84,
+	0x40, 0x31, 0x62, 0x59, 0x12, 0x5e, 0x2c, 0x96, 0x05, 0x90, 0x75, 0x36, 0x37, 0x43, 0x8b, 0x95,
+	0xc8, 0xd0, 0xb2, 0x30, 0x08, 0x91, 0xde, 0x29, 0x59, 0xbe, 0xa0, 0xc1, 0x84, 0xa8, 0x79, 0x06,
+	0x81, 0x3e, 0x3e, 0x1c, 0x0f, 0xf4, 0xf1, 0x3d, 0x03, 0x8c, 0x2b, 0x27, 0xc2, 0xc7, 0xa7, 0x35,
+	0x98, 0x12, 0x35, 0x56, 0x49, 0x7b, 0x93, 0x78, 0xe8, 0x36, 0x8c, 0xfa, 0x5d, 0xf6, 0x21, 0xc5,
+	0x80, 0xae, 0x2b, 0x03, 0x9a, 0xf7, 0x36, 0x0d, 0x93, 0x76, 0xbf, 0xc1, 0xab, 0x28, 0x79, 0x4f,
+	0x78, 0x01, 0x96, 0x8d, 0xa9, 0xa6, 0xc1, 0x73, 0xed, 0x54, 0xe8, 0x37, 0xec, 0xda, 0x04, 0x33,
+	0x08, 0xbd, 0x44, 0xd3, 0xbf, 0x52, 0xdd, 0xce, 0x2e, 0xd1, 0x14, 0xec, 0x63, 0x5e, 0xae, 0xff,
+	0xe4, 0x50, 0x38, 0xd9, 0xf4, 0x6b, 0x53, 0x79, 0xdd, 0xf4, 0x88, 0x11, 0x90, 0x66, 0x75, 0xbf,
+	0x9f, 0xce, 0xb1, 0xe3, 0xb5, 0x26, 0x5b, 0xe0, 0xa8, 0x31, 0x3d, 0xc9, 0xd4, 0xf7, 0xe1, 0x52,
+	0x74, 0xe8, 0xe7, 0xbe, 0x0d, 0x7f, 0x2f, 0x0c, 0xbb, 0x0f, 0x9d, 0xd0, 0xcc, 0xac, 0x27, 0x61,
+	0x36, 0x94, 0xfb, 0xb4, 0x36, 0xe6, 0x8d, 0xd4, 0xd0, 0x87, 0x43, 0x3d, 0x42, 0x1f, 0xda, 0x34,
+	0xcb, 0x19, 0xfd, 0x0c, 0x03, 0xa5, 0xc1, 0x88, 0x7d, 0x50, 0x35, 0x51, 0x1a, 0xc3, 0x8c, 0x25,
+	0x09, 0x2a, 0x91, 0xd0, 0x53, 0xd3, 0xef, 0x18, 0x26, 0x51, 0x25, 0x92, 0x35, 0x59, 0x88, 0x23,
+	0x38, 0x8d, 0x01, 0x1f, 0xbf, 0xb2, 0x14, 0xd6, 0xb6, 0x8b, 0xee, 0x29, 0x61, 0x34, 0xf9, 0xd4,
+	0xe7, 0xc6, 0xd5, 0xfc, 0xe9, 0xa1, 0x70, 0x91, 0x8a, 0xac, 0x36, 0x1f, 0x04, 0xe4, 0x6e, 0x72,
+	0xeb, 0xd2, 0x3b, 0xc4, 0x11, 0x15, 0xd9, 0x92, 0x28, 0x47, 0xd9, 0xee, 0xee, 0xa7, 0x6a, 0xe0,
+	0x8c, 0x56, 0xe8, 0xbb, 0x64, 0xec, 0xe7, 0x52, 0x2c, 0xa9, 0x5f, 0x18, 0xfb, 0x79, 0x52, 0x90,
+	0x8e, 0xc5, 0x7b, 0xee, 0xc2, 0x45, 0x3f, 0xa0, 0x31, 0xcc, 0x2c, 0xa1, 0x95, 0xf4, 0x03, 0xa3,
+	0xdd, 0x29, 0x10, 0x7c, 0x99, 0xfb, 0x1a, 0xa5, 0x51, 0xe1, 0x2c, 0xfc, 0x34, 0x49, 0x46, 0x85,
+	0x95, 0x53, 0xad, 0x2d, 0xcf, 0x12, 0x10, 0x11, 0x3f, 0xbe, 0x11, 0x0a, 0x53, 0xd6, 0x34, 0x72,
+	0xf0, 0xe1, 0x5c, 0x4a, 0xe8, 0x2d, 0xb8, 0x4c, 0x25, 0x86, 0x05, 0x33, 0xb0, 0x76, 0xad, 0x60,
+	0x3f, 0xea, 0xc2, 0xf1, 0x23, 0x2e, 0x33, 0xc5, 0xc0, 0x4a, 0x16, 0x32, 0x9c, 0x4d, 0x43, 0xff,
+	0x33, 0x0d, 0x50, 0x7a, 0x09, 0x21, 0x1b, 0xc6, 0x9a, 0xd2, 0xf9, 0x47, 0x3b, 0x91, 0x80, 0xaf,
+	0x21, 0x67, 0x0e, 0x7d, 0x86, 0x42, 0x0a, 0xc8, 0x85, 0xf1, 0x87, 0xf4, 0xf1, 0xc6, 0xb6, 0xfc,
+	0xe0, 0x84, 0xe2, 0xcb, 0x86, 0xc1, 0x16, 0x1f, 0x48, 0xc4, 0x38, 0xa2, 0xa1, 0xff, 0xcc, 0x10,
+	0x8c, 0x85, 0xe1, 0xee, 0x8f, 0xb6, 0xc7, 0xe8, 0x02, 0x32, 0x95, 0x94, 0x81, 0x83, 0x68, 0x4b,
+	0x99, 0xd0, 0x58, 0x4b, 0x21, 0xc3, 0x19, 0x04, 0xd0, 0x5b, 0x70, 0xc9, 0x72, 0xb6, 0x3c, 0xc3,
+	0x0f, 0xbc, 0x2e, 0x7b, 0xd7, 0x1a, 0x24, 0xf3, 0x1e, 0xbb, 0xf3, 0x2d, 0x67, 0xa0, 0xc3, 0x99,
+	0x44, 0x68, 0x46, 0x7f, 0x9e, 0xd5, 0x43, 0x86, 0xfe, 0x2c, 0x94, 0xd1, 0x9f, 0x67, 0x0b, 0x89,
+	0xb8, 0x26, 0xff, 0xed, 0x63, 0x89, 0x9b, 0x87, 0xe5, 0xe1, 0xff, 0x4b, 0xdb, 0x91, 0xca, 0x70,
+	0x71, 0xb3, 0xd6, 0x07, 0x71, 0x54, 0x22, 0x2c, 0x4f, 0xbc, 0x10, 0x27, 0x09, 0xea, 0x7f, 0xa0,
+	0xc1, 0x30, 0x77, 0xaa, 0x3f, 0x7d, 0x09, 0xee, 0x87, 0x62, 0x12, 0x5c, 0xa1, 0xe4, 0x61, 0xac,
+	0xab, 0xb9, 0x69, 0xad, 0x3e, 0xaf, 0xc1, 0x38, 0xab, 0x71, 0x06, 0x22, 0xd5, 0x6b, 0x71, 0x91,
+	0xea, 0xb9, 0xc2, 0xa3, 0xc9, 0x11, 0xa8, 0xfe, 0xa0, 0x2c, 0xc6, 0xc2, 0x24, 0x96, 0x65, 0xb8,
+	0x28, 0x74, 0x56, 0x34, 0xd3, 0x0a, 0x5d, 0xe2, 0x8b, 0x34, 0x23, 0xb6, 0xc6, 0xb4, 0x35, 0xdc,
+	0x6f, 0x32, 0x0d, 0xc6, 0x59, 0x6d, 0xd0, 0xef, 0x68, 0x54, 0x36, 0x08, 0x3c, 0xcb, 0x1c, 0x28,
+	0x57, 0x54, 0xd8, 0xb7, 0xf9, 0x55, 0x8e, 0x8c, 0xdf, 0xa4, 0x36, 0x22, 0x21, 0x81, 0x95, 0x3e,
+	0x3a, 0x98, 0x9b, 0xcb, 0x50, 0x6f, 0x47, 0x79, 0x63, 0xfc, 0xe0, 0xc7, 0xff, 0xb8, 0x67, 0x15,
+	0xf6, 0xa4, 0x24, 0x7b, 0x8c, 0xee, 0xc2, 0xb0, 0x6f, 0xba, 0x1d, 0x72, 0x9c, 0xec, 0x77, 0xe1,
+	0x04, 0x37, 0x68, 0x4b, 0xcc, 0x11, 0xcc, 0xbe, 0x0e, 0x93, 0x6a, 0xcf, 0x33, 0x6e, 0x6a, 0x8b,
+	0xea, 0x4d, 0xed, 0xd8, 0xaf, 0xd2, 0xea, 0xcd, 0xee, 0x77, 0x4b, 0x30, 0x82, 0x49, 0x4b, 0x44,
+	0xf3, 0x3e, 0xe2, 0xe1, 0xcc, 0x92, 0x09, 0x3a, 0x4a, 0xc5, 0xad, 0x63, 0xd5, 0x68, 0xb6, 0x54,
+	0xb3, 0x1a, 0xcd, 0x81, 0x9a, 0xa3, 0x03, 0x39, 0x61, 0x8c, 0xe3, 0x72, 0xf1, 0x0c, 0x5d, 0x7c,
+	0x60, 0xa7, 0x1d, 0xd5, 0xf8, 0x5f, 0x6b, 0x30, 0x19, 0x0b, 0x1a, 0xdd, 0x86, 0xb2, 0x17, 0xe6,
+	0x6e, 0x2c, 0xfa, 0xae, 0x28, 0xed, 0x1f, 0xaf, 0xf7, 0xa8, 0x84, 0x29, 0x9d, 0x30, 0xbe, 0x74,
+	0xe9, 0x84, 0xe2, 0x4b, 0xd3, 0x6c, 0xbc, 0x57, 0xe4, 0x80, 0xe2, 0xd1, 0xd3, 0xa8, 0xd2, 0xd1,
+	0xe8, 0x58, 0x4c, 0x05, 0xa8, 0x2a, 0x51, 0x17, 0xea, 0xcb, 0xac, 0x0c, 0x87, 0x50, 0x6a, 0xfc,
+	0x29, 0x17, 0x9e, 0x10, 0x3b, 0x43, 0x9e, 0x25, 0x71, 0xe3, 0xb0, 0x06, 0xfa, 0x36, 0x25, 0x87,
+	0xca, 0x70, 0x24, 0x27, 0x84, 0x84, 0xb9, 0xc5, 0x86, 0xfe, 0xdd, 0x30, 0xde, 0x68, 0xdc, 0x5d,
+	0x30, 0x4d, 0xfa, 0x12, 0xd8, 0xff, 0x43, 0x90, 0xfe, 0xb1, 0x32, 0x4c, 0x89, 0x30, 0x90, 0x96,
+	0xd3, 0xa4, 0xaf, 0xb0, 0xa7, 0x7f, 0xa6, 0xac, 0xc3, 0x38, 0xd7, 0xbe, 0x1c, 0x91, 0x67, 0xb3,
+	0x21, 0x2b, 0x25, 0x83, 0xad, 0x87, 0x00, 0x1c, 0x21, 0x42, 0xf7, 0x60, 0xe4, 0x0d, 0xca, 0xdf,
+	0xe4, 0xbe, 0xe8, 0x8b, 0xcd, 0x84, 0x8b, 0x9e, 0xb1, 0x46, 0x1f, 0x0b, 0x14, 0xc8, 0x67, 0x06,
+	0xba, 0x4c, 0xe0, 0x1a, 0x24, 0xce, 0x4c, 0x6c, 0x66, 0xc3, 0x0c, 0x4a, 0x93, 0xc2, 0xce, 0x97,
+	0xfd, 0xc2, 0x21, 0x21, 0x96, 0x29, 0x22, 0xd6, 0xe2, 0x6d, 0x92, 0x29, 0x22, 0xd6, 0xe7, 0x9c,
+	0xa3, 0xf1, 0x39, 0xb8, 0x9c, 0x39, 0x19, 0x47, 0x8b, 0xb3, 0xfa, 0xaf, 0x97, 0x60, 0x88, 0xe6,
+	0x7b, 0x38, 0x83, 0x95, 0xf9, 0x5a, 0x4c, 0xda, 0xf9, 0xde, 0xc2, 0xb9, 0x2a, 0xf2, 0x94, 0x55,
+	0x5b, 0x09, 0x65, 0xd5, 0x07, 0x0a, 0x53, 0xe8, 0xad, 0xa9, 0xfa, 0xc5, 0x12, 0x00, 0xad, 0x56,
+	0x35, 0xcc, 0x1d, 0xce, 0x71, 0xc2, 0xd5, 0xac, 0xc5, 0x39, 0x4e, 0x7a, 0x19, 0x9e, 0xa5, 0xa1,
+	0x85, 0x4e, 0x13, 0xc0, 0xb7, 0xa2, 0x80, 0xef, 0xc0, 0x93, 0xbf, 0xb7, 0x2c, 0x9e, 0xfc, 0x9d,
+	0xfe, 0x8d, 0x73, 0x8b, 0xa1, 0x13, 0xe2, 0x16, 0xfa, 0x1e, 0xb0, 0x6c, 0xbd, 0xf4, 0x25, 0xb8,
+	0xad, 0xcc, 0x4e, 0xa9, 0xb8, 0x2c, 0x2f, 0xd0, 0x1d, 0xb9, 0xcb, 0x3f, 0xa6, 0xc1, 0xf9, 0x44,
+	0xdd, 0x3e, 0xee, 0x74, 0xa7, 0xc2, 0x33, 0xf5, 0xdf, 0xd7, 0x60, 0x8c, 0xf6, 0xe5, 0x0c, 0x18,
+	0xcd, 0x0f, 0xc6, 0x19, 0xcd, 0xfb, 0x8b, 0x4e, 0x71, 0x0e, 0x7f, 0xf9, 0x93, 0x12, 0xb0, 0xa4,
+	0x30, 0xc2, 0x9c, 0x48, 0xb1, 0xd2, 0xd1, 0x72, 0xac, 0x74, 0x6e, 0x0a, 0x23, 0x9f, 0x84, 0x8e,
+	0x52, 0x31, 0xf4, 0xf9, 0x76, 0xc5, 0x8e, 0xa7, 0x1c, 0xdf, 0x36, 0x19, 0xb6, 0x3c, 0x6f, 0xc2,
+	0x94, 0x4f, 0x9d, 0x18, 0xc2, 0x28, 0x24, 0x43, 0xc5, 0xf5, 0xd1, 0xcc, 0x1b, 0x42, 0x0e, 0x85,
+	0x3f, 0x98, 0x35, 0x54, 0xdc, 0x38, 0x4e, 0x8a, 0x46, 0x33, 0xda, 0xb4, 0x5d, 0x73, 0x87, 0x46,
+	0x53, 0x94, 0xd6, 0xef, 0xcc, 0xc0, 0xb0, 0x1a, 0x96, 0x62, 0xa5, 0xc6, 0x40, 0x76, 0x47, 0x5f,
+	0xd7, 0xf8, 0x4c, 0x1f, 0x63, 0xf1, 0x9e, 0x21, 0x47, 0x79, 0x67, 0x82, 0xa3, 0x84, 0x1c, 0x32,
+	0xc1, 0x55, 0xe6, 0xa4, 0xc0, 0x3e, 0x14, 0xe9, 0x9f, 0x63, 0xa9, 0xf0, 0x7e, 0x4b, 0x0c, 0x33,
+	0xcc, 0x2b, 0xd4, 0x81, 0x29, 0x5b, 0x4d, 0x6f, 0x5c, 0xd1, 0x8a, 0x67, 0x46, 0x0e, 0xdd, 0xa9,
+	0x62, 0xc5, 0x38, 0x4e, 0x80, 0xbe, 0x9f, 0xca, 0xd1, 0xd1, 0xc9, 0x94, 0x56, 0x56, 0x6c, 0x39,
+	0xd4, 0x55, 0x00, 0x8e, 0xd7, 0xa3, 0xe9, 0xb8, 0x1e, 0xe7, 0x7d, 0x67, 0x1a, 0x83, 0x45, 0xd2,
+	0x21, 0x4e, 0x93, 0x38, 0xe6, 0x3e, 0x93, 0x59, 0x9b, 0x2e, 0xd5, 0xd5, 0x8c, 0x3c, 0x24, 0xa4,
+	0x19, 0x6a, 0xb4, 0x1f, 0x14, 0x3e, 0x88, 0xf2, 0x48, 0x3c, 0x60, 0xe8, 0x39, 0x47, 0xe7, 0xff,
+	0x63, 0x41, 0x92, 0x12, 0xef, 0x78, 0xee, 0x66, 0x28, 0x5a, 0x9d, 0x3c, 0xf1, 0x3a, 0x43, 0xcf,
+	0x89, 0xf3, 0xff, 0xb1, 0x20, 0xa9, 0xd7, 0xe1, 0x89, 0x3e, 0x9a, 0x1e, 0x47, 0x84, 0x3e, 0x0a,
+	0x23, 0x1f, 0xfd, 0x71, 0x30, 0x7e, 0x55, 0x83, 0x27, 0x15, 0x94, 0x4b, 0x7b, 0x54, 0xaa, 0xaf,
+	0x19, 0x1d, 0xc3, 0xa4, 0x77, 0x54, 0x16, 0x59, 0xe1, 0x58, 0x69, 0x62, 0x3e, 0xa6, 0xc1, 0x28,
+	0x37, 0x7a, 0x93, 0xec, 0xf7, 0xb5, 0x01, 0xa7, 0x3c, 0xb7, 0x4b, 0x32, 0xfe, 0xb8, 0x1c, 0x1b,
+	0xff, 0xed, 0x63, 0x49, 0x5f, 0xff, 0x57, 0xc3, 0xf0, 0xae, 0xfe, 0x11, 0xa1, 0xaf, 0x6b, 0xe9,
+	0x9c, 0xd4, 0xed, 0xd3, 0xed, 0x7c, 0xa8, 0xc5, 0x10, 0x17, 0xe3, 0x07, 0xa9, 0x1c, 0x4f, 0x27,
+	0xa4, 0x20, 0x89, 0x06, 0x86, 0xfe, 0xa1, 0x06, 0x93, 0xf4, 0x58, 0x0a, 0x99, 0x0b, 0xff, 0x4c,
+	0x9d, 0x53, 0x1e, 0xe9, 0x9a, 0x42, 0x32, 0xe1, 0x25, 0xad, 0x82, 0x70, 0xac, 0x6f, 0x68, 0x23,
+	0xfe, 0x1a, 0x54, 0xee, 0xcb, 0x80, 0xed, 0xc8, 0x0c, 0x6a, 0xb3, 0x36, 0x4c, 0xc7, 0x67, 0xfe,
+	0x34, 0xd5, 0x3b, 0xd4, 0xd5, 0x3b, 0x35, 0xfa, 0x63, 0x29, 0x37, 0x7e, 0x62, 0x08, 0xe6, 0x94,
+	0xa9, 0x8e, 0x99, 0xbd, 0x4a, 0x99, 0xe0, 0x33, 0x1a, 0x4c, 0x18, 0x8e, 0x23, 0xcc, 0x47, 0xe4,
+	0xfa, 0x6d, 0x0e, 0xf8, 0x55, 0xb3, 0x48, 0xcd, 0x2f, 0x44, 0x64, 0x12, 0xf6, 0x11, 0x0a, 0x04,
+	0xab, 0xbd, 0xe9, 0x61, 0x00, 0x5b, 0x3a, 0x33, 0x03, 0x58, 0xf4, 0xc3, 0xf2, 0x20, 0xe6, 0xcb,
+	0xe8, 0x95, 0x53, 0x98, 0x1b, 0x76, 0xae, 0x67, 0x6b, 0xd3, 0xa8, 0xfd, 0x47, 0x72, 0xe6, 0x8e,
+	0xb5, 0x0a, 0x7e, 0xbd, 0x0c, 0x4f, 0xf6, 0x43, 0xbe, 0x0f, 0x1d, 0xe2, 0x67, 0x13, 0x8b, 0x85,
+	0xb3, 0x00, 0xeb, 0xb4, 0x26, 0xe4, 0x64, 0x57, 0x4c, 0xf9, 0xec, 0x4c, 0xa6, 0x07, 0xfd, 0x64,
+	0x55, 0xb8, 0xac, 0xcc, 0x8f, 0x92, 0xb1, 0x92, 0x06, 0xf4, 0xb0, 0x7c, 0x4b, 0xc6, 0xbc, 0x52,
+	0x4e, 0xe8, 0x97, 0x79, 0x31, 0x96, 0x70, 0x7d, 0x25, 0xb6, 0xf7, 0xd7, 0xdd, 0x8e, 0x6b, 0xbb,
+	0xad, 0xfd, 0x85, 0x87, 0x86, 0x47, 0xb0, 0xdb, 0x0d, 0x04, 0xb6, 0x7e, 0xcf, 0xfb, 0x55, 0xb8,
+	0xa9, 0x60, 0xcb, 0x0c, 0xde, 0x71, 0x1c, 0x74, 0x5f, 0x18, 0x85, 0x49, 0x05, 0x9f, 0x8f, 0x7e,
+	0x53, 0x83, 0x6b, 0x24, 0xef, 0x28, 0x10, 0x72, 0xec, 0x2b, 0xa7, 0x75, 0xd4, 0x88, 0x98, 0xc8,
+	0x79, 0x60, 0x9c, 0xdf, 0x33, 0xea, 0x82, 0xa5, 0xe4, 0x6d, 0x2d, 0x0d, 0xa2, 0x87, 0xcb, 0xf8,
+	0xde, 0xbd, 0xb2, 0xb6, 0xa2, 0x5f, 0xd2, 0xe0, 0x92, 0x9d, 0xb1, 0x75, 0x84, 0xc8, 0xda, 0x38,
+	0x85, 0x5d, 0xc9, 0xdf, 0x3c, 0xb3, 0x20, 0x38, 0xb3, 0x2b, 0xe8, 0x57, 0x72, 0xa3, 0xca, 0xf0,
+	0x27, 0xc9, 0xf5, 0x01, 0x3b, 0x79, 0x52, 0x01, 0x66, 0x3e, 0xa5, 0x01, 0x6a, 0xa6, 0xc4, 0xe2,
+	0xca, 0x68, 0xf1, 0x24, 0x06, 0x3d, 0xe5, 0x6d, 0xfe, 0x68, 0x9d, 0x2e, 0xc7, 0x19, 0x9d, 0x60,
+	0xdf, 0x39, 0xc8, 0xd8, 0xbe, 0x95, 0xb1, 0x13, 0xf9, 0xce, 0x59, 0x9c, 0x81, 0x7f, 0xe7, 0x2c,
+	0x08, 0xce, 0xec, 0x8a, 0xfe, 0xc9, 0x51, 0xae, 0xa5, 0x61, 0xaf, 0x8a, 0x9b, 0x30, 0xb2, 0xc9,
+	0xb4, 0x7a, 0x15, 0x6d, 0x30, 0x15, 0x22, 0xd7, 0x0d, 0xf2, 0x3b, 0x12, 0xff, 0x1f, 0x0b, 0xcc,
+	0xe8, 0x55, 0x28, 0x37, 0x1d, 0x5f, 0x6c, 0xb8, 0xef, 0x19, 0x40, 0x19, 0x16, 0xb9, 0xdd, 0x51,
+	0x7f, 0x0c, 0x8a, 0x14, 0x39, 0x30, 0xe6, 0x08, 0xc5, 0x46, 0xa5, 0x3c, 0x58, 0x4a, 0xe0, 0x50,
+	0x41, 0x12, 0xaa, 0x65, 0x64, 0x09, 0x0e, 0x69, 0x50, 0x7a, 0x09, 0x4d, 0x7e, 0x61, 0x7a, 0xa1,
+	0x6a, 0xaf, 0x97, 0xf6, 0xb4, 0xae, 0x2a, 0xea, 0x86, 0xfb, 0x57, 0xd4, 0x4d, 0xe5, 0x3e, 0x6c,
+	0x10, 0x1a, 0xc3, 0xc6, 0x72, 0x02, 0xae, 0xa8, 0x29, 0xf8, 0x08, 0x4f, 0xfb, 0xbf, 0x4e, 0xb1,
+	0x44, 0x1a, 0x11, 0xf6, 0xd3, 0xc7, 0x02, 0x39, 0x5d, 0x58, 0xbb, 0x2c, 0x31, 0x7f, 0x65, 0x74,
+	0xb0, 0x85, 0xc5, 0xd3, 0xfb, 0xf3, 0x85, 0xc5, 0xff, 0xc7, 0x02, 0x33, 0x7a, 0x9d, 0x6a, 0xd4,
+	0x84, 0xd9, 0xc4, 0xd8, 0xa0, 0xf9, 0xa0, 0x39, 0x1e, 0xe9, 0x5b, 0xc7, 0x7f, 0xe1, 0x10, 0x3f,
+	0xda, 0x84, 0x51, 0x8b, 0x7b, 0x83, 0x55, 0xc6, 0x8b, 0x2f, 0x64, 0xe1, 0x50, 0xc6, 0x2f, 0xd6,
+	0xe2, 0x07, 0x96, 0x88, 0xf5, 0x2f, 0x00, 0xd7, 0xb3, 0x0b, 0xcb, 0xb4, 0x2d, 0x18, 0x93, 0xe8,
+	0x06, 0xf1, 0xf1, 0x94, 0x09, 0x68, 0xf9, 0xd0, 0xe4, 0x2f, 0x1c, 0xe2, 0xa6, 0x21, 0x6f, 0xd3,
+	0xbe, 0xba, 0x51, 0x5a, 0x8e, 0xfe, 0xfc, 0x74, 0xdf, 0x60, 0x19, 0x23, 0x65, 0xc4, 0x8c, 0x72,
+	0xf1, 0xa5, 0x15, 0x46, 0xd3, 0x88, 0x65, 0x8a, 0x14, 0x88, 0xb1, 0x42, 0x24, 0xc7, 0x72, 0x6f,
+	0xa8, 0x90, 0xe5, 0xde, 0x0b, 0x70, 0x5e, 0x58, 0x4a, 0x2c, 0x37, 0x09, 0xbb, 0xdd, 0x09, 0x57,
+	0x0c, 0x66, 0x43, 0x53, 0x8b, 0x83, 0x70, 0xb2, 0x2e, 0xfa, 0x5d, 0x8d, 0x3a, 0xbd, 0x70, 0x91,
+	0xa3, 0x32, 0x52, 0xdc, 0xeb, 0x30, 0xfa, 0xfa, 0xf3, 0x52, 0x82, 0xe1, 0xc2, 0xf4, 0xcb, 0x92,
+	0x47, 0xc8, 0xe2, 0x13, 0x52, 0x1a, 0x84, 0xbd, 0x46, 0x7f, 0x48, 0xef, 0x0b, 0x36, 0x4b, 0x8a,
+	0xcb, 0xa2, 0x12, 0x70, 0x1f, 0x91, 0xfb, 0x03, 0x8e, 0x62, 0x21, 0xc2, 0xc8, 0x07, 0xf2, 0x7d,
+	0xe1, 0xad, 0x20, 0x82, 0x9c, 0xd0, 0x58, 0xd4, 0xee, 0xa3, 0xbf, 0xaf, 0xc1, 0x93, 0xdc, 0x31,
+	0xa7, 0x46, 0xbc, 0xc0, 0xda, 0xb2, 0x4c, 0x23, 0x20, 0x3c, 0x30, 0x88, 0xf4, 0x4b, 0xe0, 0x76,
+	0x86, 0x63, 0xc7, 0xb6, 0x33, 0x7c, 0xea, 0xf0, 0x60, 0xee, 0xc9, 0x5a, 0x1f, 0xb8, 0x71, 0x5f,
+	0x3d, 0xa0, 0xaa, 0x7e, 0x5b, 0x8d, 0x9c, 0x54, 0x19, 0x2f, 0xae, 0xea, 0x8f, 0x85, 0x60, 0xe2,
+	0xba, 0xdd, 0x58, 0x11, 0x8e, 0x93, 0x9a, 0xdd, 0x81, 0xa9, 0xd8, 0x42, 0x3b, 0x55, 0x25, 0x89,
+	0x03, 0x17, 0x92, 0xeb, 0xe1, 0x54, 0x6d, 0x6e, 0xee, 0xc1, 0x78, 0x78, 0x50, 0xa1, 0xc7, 0x15,
+	0x42, 0x91, 0x20, 0x71, 0x8f, 0xec, 0x73, 0xaa, 0x73, 0xb1, 0x0b, 0x1e, 0xd7, 0xe0, 0xbf, 0x4c,
+	0x0b, 0x04, 0x42, 0xfd, 0x8b, 0x42, 0x83, 0xbf, 0x4e, 0xda, 0x1d, 0xdb, 0x08, 0xc8, 0xdb, 0xff,
+	0xfd, 0x58, 0xff, 0x2f, 0x1a, 0x3f, 0x6f, 0xf8, 0xb1, 0x8a, 0x0c, 0x98, 0x68, 0xf3, 0xf0, 0xe0,
+	0x2c, 0x10, 0x87, 0x56, 0x3c, 0x04, 0xc8, 0x6a, 0x84, 0x06, 0xab, 0x38, 0xd1, 0x43, 0x18, 0x97,
+	0xa2, 0x8d, 0xd4, 0x48, 0xdc, 0x1e, 0x4c, 0x30, 0x08, 0xa5, 0xa8, 0xf0, 0x69, 0x52, 0x96, 0xf8,
+	0x38, 0xa2, 0xa5, 0x1b, 0x80, 0xd2, 0x6d, 0xe8, 0x2d, 0x58, 0x9a, 0xd2, 0x6b, 0xf1, 0x98, 0x9b,
+	0x29, 0x73, 0xfa, 0x23, 0xd3, 0xe0, 0xeb, 0xbf, 0x57, 0x82, 0xcc, 0x94, 0x8c, 0xf4, 0x59, 0x9a,
+	0x7b, 0xe3, 0x09, 0x22, 0x4c, 0x94, 0xe1, 0xae, 0x7a, 0x58, 0x40, 0xa8, 0xcf, 0x33, 0x55, 0x4f,
+	0x38, 0x4d, 0x16, 0xeb, 0x32, 0xe2, 0x12, 0xaa, 0xcf, 0xf3, 0x52, 0x56, 0x05, 0x9c, 0xdd, 0x8e,
+	0x26, 0x3f, 0x6b, 0x1b, 0x7b, 0x49, 0x6c, 0x03, 0x24, 0x3f, 0x5b, 0x4d, 0x61, 0xc3, 0x19, 0x14,
+	0xe8, 0x41, 0x6a, 0x98, 0x26, 0xe9, 0x04, 0xa4, 0xc9, 0x87, 0x28, 0x1f, 0x10, 0xd9, 0x41, 0xba,
+	0x10, 0x07, 0xe1, 0x64, 0x5d, 0xfd, 0x6b, 0x43, 0x70, 0x2d, 0x3e, 0x89, 0x74, 0x87, 0x4a, 0x87,
+	0xb9, 0x17, 0xa5, 0x7d, 0x3d, 0x9f, 0xc8, 0xa7, 0x93, 0xf6, 0xf5, 0x95, 0x9a, 0x47, 0xd8, 0x91,
+	0x6c, 0xd8, 0xbe, 0x6c, 0x14, 0xb3, 0xb5, 0xff, 0x26, 0x78, 0xbf, 0xe5, 0x78, 0xf9, 0x95, 0x4f,
+	0xd5, 0xcb, 0xef, 0xe3, 0x1a, 0xcc, 0xc6, 0x8b, 0x6f, 0x5b, 0x8e, 0xe5, 0x6f, 0x8b, 0x88, 0x8d,
+	0xc7, 0x37, 0xef, 0x67, 0x09, 0x52, 0x56, 0x72, 0x31, 0xe2, 0x1e, 0xd4, 0xd0, 0x27, 0x34, 0xb8,
+	0x9e, 0x98, 0x97, 0x58, 0xfc, 0xc8, 0xe3, 0x5b, 0xfa, 0x33, 0x5f, 0xfd, 0x95, 0x7c, 0x94, 0xb8,
+	0x17, 0x3d, 0xfd, 0x9f, 0x96, 0x60, 0x98, 0xbd, 0x7f, 0xbf, 0x3d, 0x0c, 0x9e, 0x59, 0x57, 0x73,
+	0x6d, 0x80, 0x5a, 0x09, 0x1b, 0xa0, 0x17, 0x8b, 0x93, 0xe8, 0x6d, 0x04, 0xf4, 0x7d, 0x70, 0x85,
+	0x55, 0x5b, 0x68, 0x32, 0xb5, 0x8c, 0x4f, 0x9a, 0x0b, 0xcd, 0x26, 0x8b, 0x14, 0x72, 0xb4, 0x2e,
+	0xfa, 0x71, 0x28, 0x77, 0x3d, 0x3b, 0x19, 0x3b, 0x87, 0xfa, 0x29, 0xd3, 0x72, 0x9d, 0x46, 0x86,
+	0x63, 0xb8, 0x95, 0xed, 0x8b, 0x76, 0x61, 0xcc, 0x13, 0x5b, 0x58, 0x7c, 0x9b, 0x95, 0xc2, 0x43,
+	0xcb, 0x60, 0x0b, 0x22, 0x69, 0xac, 0xf8, 0x85, 0x43, 0x5a, 0xfa, 0x57, 0x46, 0xa0, 0x92, 0xd7,
+	0x88, 0xfa, 0x52, 0x5f, 0x31, 0x23, 0x69, 0x8e, 0x3a, 0x95, 0xba, 0x9e, 0x15, 0x58, 0xc2, 0x30,
+	0xa4, 0xe0, 0x35, 0xb7, 0xb6, 0x10, 0xf6, 0x8a, 0xc5, 0x3b, 0xac, 0x65, 0x52, 0xc0, 0x39, 0x94,
+	0x69, 0x2a, 0x97, 0x9d, 0x28, 0xc0, 0x72, 0xa9, 0x78, 0x2a, 0x17, 0x36, 0x6c, 0x25, 0x08, 0xb3,
+	0xec, 0x14, 0xd3, 0x6c, 0x2a, 0xe5, 0x0a, 0x39, 0x4a, 0xdc, 0xf7, 0xb7, 0xef, 0x91, 0xfd, 0x8e,
+	0x61, 0xc9, 0xe7, 0xff, 0xe2, 0xc4, 0x1b, 0x8d, 0xbb, 0x02, 0x55, 0x9c, 0xb8, 0x52, 0xae, 0x90,
+	0xa3, 0x0f, 0x08, 0x53, 0xae, 0xea, 0x5a, 0x3d, 0x88, 0x75, 0x65, 0xa6, 0x8f, 0x36, 0x17, 0xa1,
+	0xe3, 0xa0, 0x38, 0x49, 0xba, 0x26, 0x66, 0xfc, 0xe4, 0x91, 0x25, 0x98, 0xda, 0xea, 0xe0, 0x19,
+	0x9f, 0x95, 0xf3, 0x8f, 0x5f, 0xc7, 0xd3, 0xe0, 0x34, 0x79, 0xd6, 0x29, 0x12, 0x98, 0xcd, 0x25,
+	0xc7, 0xf4, 0xf6, 0x99, 0xd7, 0x21, 0xed, 0xd4, 0x48, 0xf1, 0x4e, 0x2d, 0xad, 0xd7, 0x16, 0x63,
+	0xc8, 0xe2, 0x9d, 0x4a, 0x83, 0xd3, 0xe4, 0x69, 0x74, 0xcc, 0xab, 0x39, 0x6b, 0xec, 0x2f, 0x8d,
+	0x2f, 0x3c, 0x75, 0x50, 0x61, 0x73, 0xf0, 0x36, 0x71, 0x50, 0x61, 0x7d, 0xcd, 0xb1, 0x92, 0xfb,
+	0x7d, 0x6a, 0x61, 0x9c, 0x8c, 0xb4, 0xdb, 0x97, 0x7b, 0xc3, 0x99, 0x19, 0x70, 0x7d, 0x5b, 0x14,
+	0x55, 0xbf, 0x1c, 0x39, 0xcb, 0x26, 0x23, 0xea, 0xeb, 0x0f, 0x60, 0x2a, 0x66, 0x24, 0x17, 0xc6,
+	0xec, 0xd2, 0x32, 0x63, 0x76, 0xa9, 0x21, 0xb9, 0x4a, 0xbd, 0x42, 0x72, 0x45, 0x4b, 0x3e, 0xcd,
+	0xd9, 0xfe, 0xd2, 0x2c, 0xf9, 0xaf, 0x9e, 0x17, 0x4b, 0x9e, 0xbd, 0x38, 0xbc, 0x06, 0x23, 0x2c,
+	0x00, 0x98, 0x3c, 0x31, 0x9f, 0x2f, 0x1c, 0x58, 0xcc, 0xe7, 0x37, 0x29, 0xfe, 0x3f, 0x16, 0x58,
+	0xd1, 0x22, 0x5c, 0x30, 0x6d, 0xb7, 0xdb, 0x14, 0x49, 0x70, 0xd7, 0xa2, 0x4b, 0x5b, 0x18, 0x1f,
+	0xb6, 0x96, 0x80, 0xe3, 0x54, 0x0b, 0x84, 0xf9, 0x9b, 0x05, 0x3f, 0xcf, 0x0a, 0xc5, 0x87, 0xa5,
+	0xef, 0x15, 0xa3, 0xb1, 0xb7, 0x8a, 0x37, 0x00, 0x88, 0x5c, 0xbc, 0xd2, 0xaf, 0xf0, 0x85, 0x62,
+	0x91, 0x6f, 0xc3, 0x2d, 0x20, 0x85, 0xcf, 0xb0, 0xc8, 0xc7, 0x0a, 0x11, 0xe4, 0xc1, 0xc4, 0xb6,
+	0x45, 0x55, 0xb5, 0x5c, 0x8e, 0x1a, 0x2e, 0x2e, 0x22, 0xde, 0x8d, 0xd0, 0xf0, 0x3b, 0xbe, 0x52,
+	0x80, 0x55, 0x22, 0xc8, 0x03, 0x88, 0xd4, 0xc3, 0x95, 0x91, 0xe2, 0x62, 0x51, 0xa4, 0x77, 0x8e,
+	0xc6, 0x19, 0x95, 0x61, 0x85, 0x0a, 0x72, 0x00, 0x9c, 0x30, 0xf2, 0xdf, 0x20, 0x2f, 0x0e, 0x51,
+	0xfc, 0x40, 0x2e, 0x78, 0x44, 0xbf, 0xb1, 0x42, 0x81, 0xce, 0x6b, 0x3b, 0x0a, 0x25, 0x59, 0x19,
+	0x2b, 0x3e, 0xaf, 0x4a, 0x44, 0x4a, 0xa1, 0x3b, 0x89, 0x0a, 0xb0, 0x4a, 0x84, 0x8e, 0xb1, 0x1d,
+	0x06, 0x80, 0xac, 0x8c, 0x17, 0x1f, 0x63, 0x14, 0x46, 0x52, 0x24, 0xe9, 0x0b, 0x7f, 0x63, 0x85,
+	0x02, 0x7d, 0x5d, 0x09, 0x9f, 0xba, 0xa0, 0xb8, 0x06, 0xaa, 0xaf, 0x67, 0xae, 0xf7, 0x46, 0x8a,
+	0x98, 0x09, 0xb6, 0x57, 0xaf, 0x2b, 0x4a, 0x18, 0x16, 0x18, 0x93, 0xf2, 0x8f, 0x94, 0x52, 0x26,
+	0x32, 0xcf, 0x9d, 0xec, 0x69, 0x9e, 0x5b, 0x83, 0x19, 0xfe, 0x00, 0x26, 0xdc, 0x45, 0x18, 0x53,
+	0x98, 0x8a, 0x5e, 0x38, 0x1a, 0x49, 0x20, 0x4e, 0xd7, 0xe7, 0x4c, 0x9f, 0x34, 0x59, 0xdb, 0x69,
+	0x95, 0xe9, 0xf3, 0x32, 0x1c, 0x42, 0xd1, 0x2e, 0x4c, 0xfa, 0x8a, 0xad, 0x6f, 0xe5, 0xfc, 0xa0,
+	0x6f, 0x53, 0x1c, 0x0f, 0x0f, 0x0b, 0xa5, 0x96, 0xe0, 0x18, 0x1d, 0xf4, 0x96, 0x6a, 0xdc, 0x78,
+	0xa1, 0xb8, 0x63, 0x67, 0x76, 0xc0, 0xcf, 0x48, 0xc3, 0x26, 0x41, 0xbe, 0x6a, 0x73, 0xd8, 0x8d,
+	0x9b, 0xf1, 0xcd, 0x9c, 0x88, 0x23, 0xfb, 0x91, 0x66, 0x7e, 0xf4, 0xd3, 0x92, 0xbd, 0x8e, 0xeb,
+	0x53, 0xdf, 0xed, 0x30, 0x26, 0x0e, 0x8a, 0x3e, 0xed, 0x52, 0x12, 0x88, 0xd3, 0xf5, 0xd1, 0x4f,
+	0x69, 0x70, 0x81, 0x27, 0xa6, 0xa5, 0x47, 0x97, 0xeb, 0x10, 0xfa, 0x3c, 0x7a, 0xb1, 0x78, 0x68,
+	0xf2, 0x46, 0x02, 0x17, 0xcf, 0xe6, 0x95, 0x2c, 0xc5, 0x29, 0x9a, 0x74, 0xe5, 0xa8, 0xae, 0xf0,
+	0x95, 0x4b, 0xc5, 0x57, 0x8e, 0xea, 0x66, 0xcf, 0x57, 0x8e, 0x5a, 0x82, 0x63, 0x74, 0xa8, 0x6d,
+	0xb8, 0x2f, 0xb3, 0x2c, 0xb1, 0x19, 0xbc, 0x1c, 0xc5, 0xd6, 0x6a, 0xa8, 0x00, 0x1c, 0xaf, 0xa7,
+	0xff, 0x1b, 0xaa, 0x42, 0x96, 0xda, 0x83, 0xb3, 0xd0, 0x89, 0x37, 0x63, 0x0a, 0x95, 0xea, 0x40,
+	0xda, 0x0e, 0x92, 0xab, 0x19, 0xff, 0xb2, 0x06, 0xd3, 0x51, 0xb5, 0x33, 0x10, 0xd5, 0xcd, 0xb8,
+	0xa8, 0xfe, 0x81, 0xc1, 0xc6, 0x95, 0x23, 0xaf, 0xff, 0xef, 0x92, 0x3a, 0x2a, 0x26, 0x8d, 0xed,
+	0xc6, 0xde, 0x98, 0x29, 0xe9, 0xbb, 0x83, 0xbc, 0x31, 0xab, 0xee, 0xb9, 0xd1, 0x78, 0x33, 0xde,
+	0x9c, 0xff, 0xff, 0x98, 0x2c, 0x34, 0x80, 0x13, 0x7a, 0x28, 0xf8, 0x48, 0xd2, 0x7c, 0x02, 0x8e,
+	0x12, 0x8c, 0xde, 0x50, 0x59, 0x25, 0x7f, 0xad, 0x7e, 0xa9, 0x98, 0xe7, 0xb3, 0x32, 0xe0, 0x9e,
+	0x0c, 0x52, 0xff, 0xfc, 0x14, 0x4c, 0x28, 0x8a, 0xb6, 0xc4, 0x8b, 0xb9, 0x76, 0x16, 0x2f, 0xe6,
+	0x01, 0x4c, 0x98, 0x61, 0x62, 0x00, 0x39, 0xed, 0x03, 0xd2, 0x0c, 0x59, 0x74, 0x94, 0x72, 0xc0,
+	0xc7, 0x2a, 0x19, 0x2a, 0x48, 0x84, 0x6b, 0xac, 0x7c, 0x02, 0x76, 0x0c, 0xbd, 0xd6, 0xd5, 0x7b,
+	0x00, 0xa4, 0x2c, 0x4a, 0x9a, 0x22, 0xb2, 0x6b, 0x68, 0x84, 0xbe, 0xec, 0xdf, 0x0d, 0x61, 0x58,
+	0xa9, 0x97, 0x7e, 0x81, 0x1d, 0x3e, 0xb3, 0x17, 0x58, 0xba, 0x0c, 0x6c, 0x99, 0x97, 0x6a, 0x20,
+	0x9b, 0x9c, 0x30, 0xbb, 0x55, 0xb4, 0x0c, 0xc2, 0x22, 0x1f, 0x2b, 0x44, 0x72, 0x0c, 0x27, 0x46,
+	0x0b, 0x19, 0x4e, 0x74, 0xe1, 0xa2, 0x47, 0x02, 0x6f, 0xbf, 0xb6, 0x6f, 0xb2, 0x74, 0x6d, 0x5e,
+	0xc0, 0x6e, 0x94, 0x63, 0xc5, 0xa2, 0x17, 0xe1, 0x34, 0x2a, 0x9c, 0x85, 0x3f, 0x26, 0x8c, 0x8d,
+	0xf7, 0x14, 0xc6, 0xde, 0x0b, 0x13, 0x01, 0x31, 0xb7, 0x1d, 0xcb, 0x34, 0xec, 0xe5, 0x45, 0x11,
+	0xfa, 0x31, 0x92, 0x2b, 0x22, 0x10, 0x56, 0xeb, 0xa1, 0x2a, 0x94, 0xbb, 0x56, 0x53, 0x48, 0xa3,
+	0xdf, 0x19, 0xaa, 0xac, 0x97, 0x17, 0x1f, 0x1d, 0xcc, 0xbd, 0x23, 0xb2, 0x44, 0x08, 0x47, 0x75,
+	0xab, 0xb3, 0xd3, 0xba, 0x45, 0xdd, 0xd3, 0xfc, 0xf9, 0x0d, 0x9a, 0x50, 0xb3, 0x6b, 0x35, 0xb3,
+	0x8c, 0x4a, 0x26, 0x8f, 0x61, 0x54, 0xf2, 0x29, 0x0d, 0x2e, 0x1a, 0x49, 0x6d, 0x3b, 0xf1, 0x2b,
+	0x53, 0xc5, 0xb9, 0x65, 0xb6, 0x06, 0xbf, 0x7a, 0x5d, 0x8c, 0xef, 0xe2, 0x42, 0x9a, 0x1c, 0xce,
+	0xea, 0x03, 0xd5, 0x23, 0xb4, 0xad, 0x56, 0x98, 0x22, 0x4a, 0x7c, 0xf5, 0xe9, 0x62, 0x7a, 0x84,
+	0xd5, 0x14, 0x26, 0x9c, 0x81, 0x1d, 0x3d, 0x84, 0x09, 0x33, 0xd2, 0xc9, 0x57, 0xce, 0x0f, 0x20,
+	0x9f, 0x25, 0xf4, 0xfb, 0xfc, 0xe6, 0xa5, 0x14, 0x60, 0x95, 0x52, 0xf8, 0x9a, 0xa6, 0x5c, 0x79,
+	0xc5, 0x8b, 0x12, 0x1b, 0xf5, 0x85, 0xe2, 0xaf, 0x69, 0xd9, 0x18, 0x71, 0x0f, 0x6a, 0x2c, 0x66,
+	0x90, 0x1d, 0xcf, 0xe4, 0x56, 0x99, 0x29, 0xee, 0x67, 0x9c, 0x48, 0x0a, 0xc7, 0x97, 0x66, 0xa2,
+	0x10, 0x27, 0x09, 0xea, 0x5f, 0xd2, 0x84, 0xc2, 0xec, 0x0c, 0xad, 0x21, 0x4e, 0xfb, 0x29, 0x4d,
+	0xff, 0x53, 0xfa, 0x0c, 0x95, 0x94, 0xc8, 0x37, 0xa9, 0xaf, 0x9b, 0x47, 0x68, 0x9c, 0x70, 0xad,
+	0xb8, 0xdd, 0x5f, 0x8d, 0xa3, 0xe0, 0xda, 0x47, 0xf1, 0x03, 0x4b, 0xc4, 0x54, 0xea, 0x77, 0x94,
+	0xe8, 0xcf, 0x62, 0x84, 0x2f, 0x0d, 0x1a, 0x73, 0x9a, 0x4b, 0xfd, 0x6a, 0x09, 0x8e, 0xd1, 0xd1,
+	0x57, 0x00, 0xa2, 0x7b, 0xd5, 0xc0, 0x06, 0x32, 0xdf, 0x18, 0x86, 0xcb, 0x83, 0x3a, 0x1b, 0xb0,
+	0x04, 0x62, 0x64, 0xd7, 0x32, 0x83, 0x85, 0xad, 0x80, 0x78, 0xf7, 0xef, 0xaf, 0xae, 0x6f, 0x7b,
+	0xc4, 0xdf, 0x76, 0xed, 0x66, 0xc1, 0xb8, 0xa5, 0xec, 0x41, 0x6d, 0x29, 0x13, 0x23, 0xce, 0xa1,
+	0xc4, 0xee, 0x94, 0x22, 0xbc, 0x39, 0xa6, 0xc2, 0x64, 0xd7, 0xf3, 0x03, 0x11, 0x31, 0x85, 0xdf,
+	0x29, 0x93, 0x40, 0x9c, 0xae, 0x9f, 0x44, 0xb2, 0x62, 0xb5, 0x2d, 0x9e, 0xc9, 0x49, 0x4b, 0x23,
+	0x61, 0x40, 0x9c, 0xae, 0xaf, 0x22, 0xe1, 0x5f, 0x8a, 0xee, 0xf6, 0xe1, 0x34, 0x92, 0x10, 0x88,
+	0xd3, 0xf5, 0x51, 0x13, 0x1e, 0xf3, 0x88, 0xe9, 0xb6, 0xdb, 0xc4, 0x69, 0xf2, 0xdc, 0x9c, 0x86,
+	0xd7, 0xb2, 0x9c, 0xdb, 0x9e, 0xc1, 0x2a, 0x32, 0x15, 0x9d, 0xc6, 0xf2, 0x91, 0x3c, 0x86, 0x7b,
+	0xd4, 0xc3, 0x3d, 0xb1, 0xd0, 0xa4, 0xe4, 0x3c, 0x11, 0x98, 0x17, 0xc6, 0x29, 0x1f, 0x2d, 0x9e,
+	0x94, 0x7c, 0x23, 0x8e, 0x0a, 0x27, 0x71, 0xd3, 0x14, 0x7b, 0x61, 0x77, 0x14, 0x92, 0x63, 0xc5,
+	0x53, 0xec, 0xe1, 0x34, 0x3a, 0x9c, 0x45, 0x43, 0xff, 0x94, 0x06, 0xc2, 0x12, 0x99, 0x3e, 0x13,
+	0x28, 0x6f, 0x1d, 0x63, 0x89, 0x77, 0x0e, 0x99, 0x81, 0xa4, 0x94, 0x99, 0x81, 0xe4, 0x9d, 0x4a,
+	0x28, 0x9e, 0xf1, 0x88, 0xf7, 0x71, 0xcc, 0x4a, 0xf6, 0xa4, 0x77, 0xc3, 0x38, 0xe1, 0xcf, 0x68,
+	0xa1, 0x44, 0xcb, 0xac, 0xbb, 0x97, 0x64, 0x21, 0x8e, 0xe0, 0x34, 0x46, 0x92, 0xc0, 0x40, 0x29,
+	0xf5, 0x97, 0xf3, 0xe9, 0x48, 0xd3, 0x26, 0x25, 0x57, 0x55, 0x39, 0x37, 0x57, 0xd5, 0x29, 0xa5,
+	0x70, 0xfa, 0x4d, 0x0d, 0xce, 0xc7, 0x63, 0x23, 0xf9, 0xf4, 0x51, 0x47, 0x44, 0x4f, 0x14, 0xe1,
+	0xcf, 0x58, 0x53, 0x11, 0xbe, 0x00, 0x4b, 0x58, 0x5c, 0x1d, 0x36, 0xc0, 0x15, 0x33, 0x3b, 0x44,
+	0xd3, 0x11, 0xb7, 0xbd, 0x9f, 0x98, 0x81, 0x11, 0x1e, 0x7a, 0x8f, 0xf2, 0xb4, 0x0c, 0xb7, 0xcd,
+	0x7b, 0xc5, 0x23, 0xfc, 0x15, 0xf1, 0xb5, 0x53, 0xa3, 0xf2, 0x97, 0x7a, 0x46, 0xe5, 0xc7, 0x3c,
+	0x35, 0xde, 0x00, 0x4f, 0x1f, 0x34, 0x35, 0xde, 0x68, 0x2c, 0x2d, 0x5e, 0x10, 0x7b, 0x13, 0x18,
+	0x2a, 0x2e, 0xb9, 0xf1, 0x09, 0x50, 0x5e, 0x06, 0xa6, 0x7b, 0xbe, 0x0a, 0xc8, 0xd8, 0x66, 0xc3,
+	0xc5, 0x4d, 0x0d, 0xc5, 0x94, 0xf7, 0x11, 0xdb, 0x2c, 0xdc, 0x48, 0x23, 0xb9, 0x1b, 0x69, 0x0b,
+	0x46, 0xc5, 0x56, 0xa8, 0x8c, 0x16, 0x97, 0x26, 0xc4, 0x73, 0xab, 0x12, 0x8e, 0x97, 0x17, 0x60,
+	0x89, 0x9c, 0x9e, 0xb8, 0x6d, 0x63, 0x8f, 0x9a, 0x5d, 0x32, 0x8e, 0x38, 0xac, 0x56, 0x65, 0xc5,
+	0x58, 0xc2, 0x59, 0x55, 0x6e, 0xa1, 0x59, 0x19, 0x4f, 0x54, 0xe5, 0xc5, 0x58, 0xc2, 0xd1, 0xab,
+	0x30, 0xd6, 0x36, 0xf6, 0x1a, 0x5d, 0xaf, 0x45, 0x2a, 0x70, 0x84, 0x8c, 0xd7, 0x0d, 0x2c, 0x7b,
+	0x9e, 0x5e, 0xff, 0x03, 0x6f, 0x7e, 0xd9, 0x09, 0xee, 0x7b, 0x8d, 0xc0, 0x0b, 0x13, 0x4d, 0xad,
+	0x0a, 0x2c, 0x38, 0xc4, 0x87, 0x6c, 0x98, 0x6e, 0x1b, 0x7b, 0x1b, 0x8e, 0xc1, 0xc3, 0xd6, 0xd9,
+	0xa4, 0x32, 0x51, 0x90, 0x02, 0x7b, 0x16, 0x5e, 0x8d, 0xe1, 0xc2, 0x09, 0xdc, 0x19, 0x2f, 0xd0,
+	0x93, 0xa7, 0xf5, 0x02, 0xbd, 0x10, 0xfa, 0xdb, 0xf0, 0x7b, 0xdb, 0xb5, 0x4c, 0xcf, 0xf6, 0x9e,
+	0xbe, 0x34, 0xaf, 0x85, 0xbe, 0x34, 0xd3, 0xc5, 0x9f, 0x4c, 0x7b, 0xf8, 0xd1, 0x74, 0x61, 0x82,
+	0x4a, 0xd8, 0xbc, 0x94, 0x5e, 0xac, 0x0a, 0xab, 0x20, 0x17, 0x43, 0x34, 0x4a, 0x8a, 0xe4, 0x08,
+	0x35, 0x56, 0xe9, 0x50, 0x9b, 0x57, 0x91, 0xb4, 0x32, 0xaa, 0xb2, 0x66, 0x88, 0x0b, 0xd5, 0x38,
+	0xb7, 0x79, 0xbd, 0x97, 0x55, 0x01, 0x67, 0xb7, 0x8b, 0xa2, 0xb0, 0xcc, 0x64, 0x47, 0x61, 0x41,
+	0x3f, 0x93, 0xa5, 0xe7, 0x47, 0x37, 0xb5, 0xa2, 0x27, 0x03, 0xe7, 0x0d, 0x85, 0xb5, 0xfd, 0xff,
+	0x4c, 0x83, 0x4a, 0x3b, 0x27, 0x97, 0x70, 0xe5, 0x62, 0x71, 0xa7, 0xcb, 0xa3, 0xf2, 0x13, 0x57,
+	0x9f, 0x3c, 0x3c, 0x98, 0x3b, 0x32, 0x8b, 0x31, 0xce, 0xed, 0x1b, 0xf2, 0x60, 0xd4, 0xdf, 0xf7,
+	0xcd, 0xc0, 0xf6, 0x2b, 0x97, 0x8a, 0xa7, 0xac, 0x15, 0x9c, 0xb5, 0xc1, 0x31, 0x71, 0xd6, 0x1a,
+	0x05, 0x81, 0xe7, 0xa5, 0x58, 0x12, 0x42, 0x1f, 0x09, 0xf3, 0x02, 0x29, 0x9e, 0xa9, 0x97, 0x8b,
+	0x1b, 0x06, 0xd6, 0x92, 0xc8, 0xee, 0x77, 0x78, 0x00, 0xf1, 0x28, 0x39, 0x50, 0x04, 0x1b, 0xd4,
+	0x4b, 0x7c, 0x80, 0xb0, 0x97, 0xb3, 0xcf, 0xc3, 0xa4, 0x3a, 0x45, 0xc7, 0x69, 0xab, 0xff, 0xb2,
+	0x06, 0x17, 0x92, 0x47, 0x26, 0xda, 0x86, 0x51, 0xb1, 0x7f, 0x2a, 0x5a, 0x71, 0x3d, 0xa7, 0xd8,
+	0x99, 0x22, 0x42, 0x0b, 0x93, 0xc0, 0x44, 0x11, 0x96, 0xe8, 0x55, 0xeb, 0x9b, 0x52, 0x0f, 0xeb,
+	0x9b, 0x17, 0xe0, 0x4a, 0xf6, 0x4e, 0xa2, 0xf2, 0x2b, 0x75, 0xea, 0x79, 0x28, 0xee, 0x8d, 0x51,
+	0x26, 0x39, 0x5a, 0x88, 0x39, 0x4c, 0xff, 0x61, 0x48, 0x06, 0x39, 0x46, 0xaf, 0xc3, 0xb8, 0xef,
+	0x6f, 0xf3, 0xf8, 0x95, 0x15, 0x6d, 0x00, 0x85, 0x81, 0x0c, 0x82, 0x29, 0x1c, 0x2a, 0xe5, 0x4f,
+	0x1c, 0xa1, 0xaf, 0xbe, 0xf2, 0xb9, 0xaf, 0xdd, 0x38, 0xf7, 0xc5, 0xaf, 0xdd, 0x38, 0xf7, 0x95,
+	0xaf, 0xdd, 0x38, 0xf7, 0xa3, 0x87, 0x37, 0xb4, 0xcf, 0x1d, 0xde, 0xd0, 0xbe, 0x78, 0x78, 0x43,
+	0xfb, 0xca, 0xe1, 0x0d, 0xed, 0x3f, 0x1e, 0xde, 0xd0, 0x7e, 0xf6, 0x3f, 0xdd, 0x38, 0xf7, 0xea,
+	0xb3, 0x11, 0xf5, 0x5b, 0x92, 0x68, 0xf4, 0x0f, 0x55, 0x1e, 0x52, 0xea, 0xd2, 0xb1, 0x89, 0x51,
+	0xff, 0x7f, 0x03, 0x00, 0x6a, 0x7a, 0xe0, 0x8b, 0xad, 0xf3, 0x00, 0x00,
 }
 
 func (m *APIServerLogging) Marshal() (dAtA []byte, err error) {
@@ -6880,6 +7002,69 @@ func (m *ClusterAutoscaler) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ScaleDownCandidatesPoolMinCount != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.ScaleDownCandidatesPoolMinCount))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
+	}
+	if m.ScaleDownCandidatesPoolRatio != nil {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(*m.ScaleDownCandidatesPoolRatio))))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x89
+	}
+	if m.CordonNodeBeforeTerminating != nil {
+		i--
+		if *m.CordonNodeBeforeTerminating {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if len(m.PriorityClassScaleUpDelays) > 0 {
+		for iNdEx := len(m.PriorityClassScaleUpDelays) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PriorityClassScaleUpDelays[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	if m.MaxPodEvictionTime != nil {
+		{
+			size, err := m.MaxPodEvictionTime.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x72
+	}
+	if m.SkipNodesWithCustomControllerPods != nil {
+		i--
+		if *m.SkipNodesWithCustomControllerPods {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x68
+	}
 	if m.MaxEmptyBulkDelete != nil {
 		i = encodeVarintGenerated(dAtA, i, uint64(*m.MaxEmptyBulkDelete))
 		i--
@@ -6999,6 +7184,115 @@ func (m *ClusterAutoscaler) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ClusterAutoscalerOptions) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClusterAutoscalerOptions) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClusterAutoscalerOptions) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ZoneCaps) > 0 {
+		keysForZoneCaps := make([]string, 0, len(m.ZoneCaps))
+		for k := range m.ZoneCaps {
+			keysForZoneCaps = append(keysForZoneCaps, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForZoneCaps)
+		for iNdEx := len(keysForZoneCaps) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.ZoneCaps[string(keysForZoneCaps[iNdEx])]
+			baseI := i
+			i = encodeVarintGenerated(dAtA, i, uint64(v))
+			i--
+			dAtA[i] = 0x10
+			i -= len(keysForZoneCaps[iNdEx])
+			copy(dAtA[i:], keysForZoneCaps[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForZoneCaps[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.ZoneWeights) > 0 {
+		keysForZoneWeights := make([]string, 0, len(m.ZoneWeights))
+		for k := range m.ZoneWeights {
+			keysForZoneWeights = append(keysForZoneWeights, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForZoneWeights)
+		for iNdEx := len(keysForZoneWeights) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.ZoneWeights[string(keysForZoneWeights[iNdEx])]
+			baseI := i
+			i = encodeVarintGenerated(dAtA, i, uint64(v))
+			i--
+			dAtA[i] = 0x10
+			i -= len(keysForZoneWeights[iNdEx])
+			copy(dAtA[i:], keysForZoneWeights[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForZoneWeights[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.ZoneSplitStrategy != nil {
+		i -= len(*m.ZoneSplitStrategy)
+		copy(dAtA[i:], *m.ZoneSplitStrategy)
+		i = encodeVarintGenerated(dAtA, i, uint64(len(*m.ZoneSplitStrategy)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.MaxGracefulTerminationSeconds != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.MaxGracefulTerminationSeconds))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.MaxNodeProvisionTime != nil {
+		{
+			size, err := m.MaxNodeProvisionTime.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ScaleDownUnneededTime != nil {
+		{
+			size, err := m.ScaleDownUnneededTime.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ScaleDownUtilizationThreshold != nil {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(*m.ScaleDownUtilizationThreshold))))
+		i--
+		dAtA[i] = 0x9
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Condition) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -11184,6 +11478,91 @@ func (m *NodeLocalDNS) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.UpstreamDNSForward != nil {
+		{
+			size, err := m.UpstreamDNSForward.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x62
+	}
+	if m.ClusterDNSForward != nil {
+		{
+			size, err := m.ClusterDNSForward.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.CustomZones) > 0 {
+		for iNdEx := len(m.CustomZones) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.CustomZones[iNdEx])
+			copy(dAtA[i:], m.CustomZones[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.CustomZones[iNdEx])))
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.UpstreamServers) > 0 {
+		for iNdEx := len(m.UpstreamServers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.UpstreamServers[iNdEx])
+			copy(dAtA[i:], m.UpstreamServers[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.UpstreamServers[iNdEx])))
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if m.EnableDaemonSetEviction != nil {
+		i--
+		if *m.EnableDaemonSetEviction {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.Tolerations) > 0 {
+		for iNdEx := len(m.Tolerations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Tolerations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.PriorityClassName != nil {
+		i -= len(*m.PriorityClassName)
+		copy(dAtA[i:], *m.PriorityClassName)
+		i = encodeVarintGenerated(dAtA, i, uint64(len(*m.PriorityClassName)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Resources != nil {
+		{
+			size, err := m.Resources.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
 	if m.DisableForwardToUpstreamDNS != nil {
 		i--
 		if *m.DisableForwardToUpstreamDNS {
@@ -11225,6 +11604,53 @@ func (m *NodeLocalDNS) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *NodeLocalDNSForward) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NodeLocalDNSForward) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NodeLocalDNSForward) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Policy != nil {
+		i -= len(*m.Policy)
+		copy(dAtA[i:], *m.Policy)
+		i = encodeVarintGenerated(dAtA, i, uint64(len(*m.Policy)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.MaxConcurrent != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.MaxConcurrent))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.HealthCheckInterval != nil {
+		{
+			size, err := m.HealthCheckInterval.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *OIDCConfig) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -11443,6 +11869,44 @@ func (m *OpenIDConnectClientAuthentication) MarshalToSizedBuffer(dAtA []byte) (i
 	return len(dAtA) - i, nil
 }
 
+func (m *PriorityClassScaleUpDelay) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PriorityClassScaleUpDelay) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PriorityClassScaleUpDelay) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.NewPodScaleUpDelay.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.PriorityClassName)
+	copy(dAtA[i:], m.PriorityClassName)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.PriorityClassName)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *Project) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -15318,6 +15782,20 @@ func (m *Worker) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ClusterAutoscaler != nil {
+		{
+			size, err := m.ClusterAutoscaler.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xaa
+	}
 	if len(m.Sysctls) > 0 {
 		keysForSysctls := make([]string, 0, len(m.Sysctls))
 		for k := range m.Sysctls {
@@ -16174,6 +16652,71 @@ func (m *ClusterAutoscaler) Size() (n int) {
 	if m.MaxEmptyBulkDelete != nil {
 		n += 1 + sovGenerated(uint64(*m.MaxEmptyBulkDelete))
 	}
+	if m.SkipNodesWithCustomControllerPods != nil {
+		n += 2
+	}
+	if m.MaxPodEvictionTime != nil {
+		l = m.MaxPodEvictionTime.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if len(m.PriorityClassScaleUpDelays) > 0 {
+		for _, e := range m.PriorityClassScaleUpDelays {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if m.CordonNodeBeforeTerminating != nil {
+		n += 3
+	}
+	if m.ScaleDownCandidatesPoolRatio != nil {
+		n += 10
+	}
+	if m.ScaleDownCandidatesPoolMinCount != nil {
+		n += 2 + sovGenerated(uint64(*m.ScaleDownCandidatesPoolMinCount))
+	}
+	return n
+}
+
+func (m *ClusterAutoscalerOptions) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ScaleDownUtilizationThreshold != nil {
+		n += 9
+	}
+	if m.ScaleDownUnneededTime != nil {
+		l = m.ScaleDownUnneededTime.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.MaxNodeProvisionTime != nil {
+		l = m.MaxNodeProvisionTime.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.MaxGracefulTerminationSeconds != nil {
+		n += 1 + sovGenerated(uint64(*m.MaxGracefulTerminationSeconds))
+	}
+	if m.ZoneSplitStrategy != nil {
+		l = len(*m.ZoneSplitStrategy)
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if len(m.ZoneWeights) > 0 {
+		for k, v := range m.ZoneWeights {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + sovGenerated(uint64(v))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+		}
+	}
+	if len(m.ZoneCaps) > 0 {
+		for k, v := range m.ZoneCaps {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + sovGenerated(uint64(v))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+		}
+	}
 	return n
 }
 
@@ -17757,6 +18300,63 @@ func (m *NodeLocalDNS) Size() (n int) {
 	if m.DisableForwardToUpstreamDNS != nil {
 		n += 2
 	}
+	if m.Resources != nil {
+		l = m.Resources.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.PriorityClassName != nil {
+		l = len(*m.PriorityClassName)
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if len(m.Tolerations) > 0 {
+		for _, e := range m.Tolerations {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if m.EnableDaemonSetEviction != nil {
+		n += 2
+	}
+	if len(m.UpstreamServers) > 0 {
+		for _, s := range m.UpstreamServers {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.CustomZones) > 0 {
+		for _, s := range m.CustomZones {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if m.ClusterDNSForward != nil {
+		l = m.ClusterDNSForward.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.UpstreamDNSForward != nil {
+		l = m.UpstreamDNSForward.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *NodeLocalDNSForward) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.HealthCheckInterval != nil {
+		l = m.HealthCheckInterval.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.MaxConcurrent != nil {
+		n += 1 + sovGenerated(uint64(*m.MaxConcurrent))
+	}
+	if m.Policy != nil {
+		l = len(*m.Policy)
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -17853,6 +18453,19 @@ func (m *OpenIDConnectClientAuthentication) Size() (n int) {
 	return n
 }
 
+func (m *PriorityClassScaleUpDelay) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PriorityClassName)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.NewPodScaleUpDelay.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
 func (m *Project) Size() (n int) {
 	if m == nil {
 		return 0
@@ -19360,6 +19973,10 @@ func (m *Worker) Size() (n int) {
 			n += mapEntrySize + 2 + sovGenerated(uint64(mapEntrySize))
 		}
 	}
+	if m.ClusterAutoscaler != nil {
+		l = m.ClusterAutoscaler.Size()
+		n += 2 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -19725,6 +20342,11 @@ func (this *ClusterAutoscaler) String() string {
 	if this == nil {
 		return "nil"
 	}
+	repeatedStringForPriorityClassScaleUpDelays := "[]PriorityClassScaleUpDelay{"
+	for _, f := range this.PriorityClassScaleUpDelays {
+		repeatedStringForPriorityClassScaleUpDelays += strings.Replace(strings.Replace(f.String(), "PriorityClassScaleUpDelay", "PriorityClassScaleUpDelay", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForPriorityClassScaleUpDelays += "}"
 	s := strings.Join([]string{`&ClusterAutoscaler{`,
 		`ScaleDownDelayAfterAdd:` + strings.Replace(fmt.Sprintf("%v", this.ScaleDownDelayAfterAdd), "Duration", "v11.Duration", 1) + `,`,
 		`ScaleDownDelayAfterDelete:` + strings.Replace(fmt.Sprintf("%v", this.ScaleDownDelayAfterDelete), "Duration", "v11.Duration", 1) + `,`,
@@ -19738,6 +20360,48 @@ func (this *ClusterAutoscaler) String() string {
 		`IgnoreTaints:` + fmt.Sprintf("%v", this.IgnoreTaints) + `,`,
 		`NewPodScaleUpDelay:` + strings.Replace(fmt.Sprintf("%v", this.NewPodScaleUpDelay), "Duration", "v11.Duration", 1) + `,`,
 		`MaxEmptyBulkDelete:` + valueToStringGenerated(this.MaxEmptyBulkDelete) + `,`,
+		`SkipNodesWithCustomControllerPods:` + valueToStringGenerated(this.SkipNodesWithCustomControllerPods) + `,`,
+		`MaxPodEvictionTime:` + strings.Replace(fmt.Sprintf("%v", this.MaxPodEvictionTime), "Duration", "v11.Duration", 1) + `,`,
+		`PriorityClassScaleUpDelays:` + repeatedStringForPriorityClassScaleUpDelays + `,`,
+		`CordonNodeBeforeTerminating:` + valueToStringGenerated(this.CordonNodeBeforeTerminating) + `,`,
+		`ScaleDownCandidatesPoolRatio:` + valueToStringGenerated(this.ScaleDownCandidatesPoolRatio) + `,`,
+		`ScaleDownCandidatesPoolMinCount:` + valueToStringGenerated(this.ScaleDownCandidatesPoolMinCount) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ClusterAutoscalerOptions) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForZoneWeights := make([]string, 0, len(this.ZoneWeights))
+	for k := range this.ZoneWeights {
+		keysForZoneWeights = append(keysForZoneWeights, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForZoneWeights)
+	mapStringForZoneWeights := "map[string]int32{"
+	for _, k := range keysForZoneWeights {
+		mapStringForZoneWeights += fmt.Sprintf("%v: %v,", k, this.ZoneWeights[k])
+	}
+	mapStringForZoneWeights += "}"
+	keysForZoneCaps := make([]string, 0, len(this.ZoneCaps))
+	for k := range this.ZoneCaps {
+		keysForZoneCaps = append(keysForZoneCaps, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForZoneCaps)
+	mapStringForZoneCaps := "map[string]int32{"
+	for _, k := range keysForZoneCaps {
+		mapStringForZoneCaps += fmt.Sprintf("%v: %v,", k, this.ZoneCaps[k])
+	}
+	mapStringForZoneCaps += "}"
+	s := strings.Join([]string{`&ClusterAutoscalerOptions{`,
+		`ScaleDownUtilizationThreshold:` + valueToStringGenerated(this.ScaleDownUtilizationThreshold) + `,`,
+		`ScaleDownUnneededTime:` + strings.Replace(fmt.Sprintf("%v", this.ScaleDownUnneededTime), "Duration", "v11.Duration", 1) + `,`,
+		`MaxNodeProvisionTime:` + strings.Replace(fmt.Sprintf("%v", this.MaxNodeProvisionTime), "Duration", "v11.Duration", 1) + `,`,
+		`MaxGracefulTerminationSeconds:` + valueToStringGenerated(this.MaxGracefulTerminationSeconds) + `,`,
+		`ZoneSplitStrategy:` + valueToStringGenerated(this.ZoneSplitStrategy) + `,`,
+		`ZoneWeights:` + mapStringForZoneWeights + `,`,
+		`ZoneCaps:` + mapStringForZoneCaps + `,`,
 		`}`,
 	}, "")
 	return s
@@ -20784,11 +21448,36 @@ func (this *NodeLocalDNS) String() string {
 	if this == nil {
 		return "nil"
 	}
+	repeatedStringForTolerations := "[]Toleration{"
+	for _, f := range this.Tolerations {
+		repeatedStringForTolerations += fmt.Sprintf("%v", f) + ","
+	}
+	repeatedStringForTolerations += "}"
 	s := strings.Join([]string{`&NodeLocalDNS{`,
 		`Enabled:` + fmt.Sprintf("%v", this.Enabled) + `,`,
 		`ForceTCPToClusterDNS:` + valueToStringGenerated(this.ForceTCPToClusterDNS) + `,`,
 		`ForceTCPToUpstreamDNS:` + valueToStringGenerated(this.ForceTCPToUpstreamDNS) + `,`,
 		`DisableForwardToUpstreamDNS:` + valueToStringGenerated(this.DisableForwardToUpstreamDNS) + `,`,
+		`Resources:` + strings.Replace(fmt.Sprintf("%v", this.Resources), "ResourceRequirements", "v1.ResourceRequirements", 1) + `,`,
+		`PriorityClassName:` + valueToStringGenerated(this.PriorityClassName) + `,`,
+		`Tolerations:` + repeatedStringForTolerations + `,`,
+		`EnableDaemonSetEviction:` + valueToStringGenerated(this.EnableDaemonSetEviction) + `,`,
+		`UpstreamServers:` + fmt.Sprintf("%v", this.UpstreamServers) + `,`,
+		`CustomZones:` + fmt.Sprintf("%v", this.CustomZones) + `,`,
+		`ClusterDNSForward:` + strings.Replace(this.ClusterDNSForward.String(), "NodeLocalDNSForward", "NodeLocalDNSForward", 1) + `,`,
+		`UpstreamDNSForward:` + strings.Replace(this.UpstreamDNSForward.String(), "NodeLocalDNSForward", "NodeLocalDNSForward", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *NodeLocalDNSForward) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&NodeLocalDNSForward{`,
+		`HealthCheckInterval:` + strings.Replace(fmt.Sprintf("%v", this.HealthCheckInterval), "Duration", "v11.Duration", 1) + `,`,
+		`MaxConcurrent:` + valueToStringGenerated(this.MaxConcurrent) + `,`,
+		`Policy:` + valueToStringGenerated(this.Policy) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -20854,6 +21543,17 @@ func (this *OpenIDConnectClientAuthentication) String() string {
 	}, "")
 	return s
 }
+func (this *PriorityClassScaleUpDelay) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PriorityClassScaleUpDelay{`,
+		`PriorityClassName:` + fmt.Sprintf("%v", this.PriorityClassName) + `,`,
+		`NewPodScaleUpDelay:` + strings.Replace(strings.Replace(this.NewPodScaleUpDelay.String(), "Duration", "v11.Duration", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *Project) String() string {
 	if this == nil {
 		return "nil"
@@ -21954,6 +22654,7 @@ func (this *Worker) String() string {
 		`SystemComponents:` + strings.Replace(this.SystemComponents.String(), "WorkerSystemComponents", "WorkerSystemComponents", 1) + `,`,
 		`MachineControllerManagerSettings:` + strings.Replace(this.MachineControllerManagerSettings.String(), "MachineControllerManagerSettings", "MachineControllerManagerSettings", 1) + `,`,
 		`Sysctls:` + mapStringForSysctls + `,`,
+		`ClusterAutoscaler:` + strings.Replace(this.ClusterAutoscaler.String(), "ClusterAutoscalerOptions", "ClusterAutoscalerOptions", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -25639,61 +26340,11 @@ func (m *ClusterAutoscaler) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.MaxEmptyBulkDelete = &v
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Condition) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Condition: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Condition: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SkipNodesWithCustomControllerPods", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -25703,29 +26354,18 @@ func (m *Condition) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Type = ConditionType(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
+			b := bool(v != 0)
+			m.SkipNodesWithCustomControllerPods = &b
+		case 14:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPodEvictionTime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -25735,27 +26375,645 @@ func (m *Condition) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Status = ConditionStatus(dAtA[iNdEx:postIndex])
+			if m.MaxPodEvictionTime == nil {
+				m.MaxPodEvictionTime = &v11.Duration{}
+			}
+			if err := m.MaxPodEvictionTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 15:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastTransitionTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PriorityClassScaleUpDelays", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PriorityClassScaleUpDelays = append(m.PriorityClassScaleUpDelays, PriorityClassScaleUpDelay{})
+			if err := m.PriorityClassScaleUpDelays[len(m.PriorityClassScaleUpDelays)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CordonNodeBeforeTerminating", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			b := bool(v != 0)
+			m.CordonNodeBeforeTerminating = &b
+		case 17:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScaleDownCandidatesPoolRatio", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			v2 := float64(math.Float64frombits(v))
+			m.ScaleDownCandidatesPoolRatio = &v2
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScaleDownCandidatesPoolMinCount", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ScaleDownCandidatesPoolMinCount = &v
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ClusterAutoscalerOptions) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClusterAutoscalerOptions: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClusterAutoscalerOptions: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScaleDownUtilizationThreshold", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			v2 := float64(math.Float64frombits(v))
+			m.ScaleDownUtilizationThreshold = &v2
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScaleDownUnneededTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ScaleDownUnneededTime == nil {
+				m.ScaleDownUnneededTime = &v11.Duration{}
+			}
+			if err := m.ScaleDownUnneededTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxNodeProvisionTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MaxNodeProvisionTime == nil {
+				m.MaxNodeProvisionTime = &v11.Duration{}
+			}
+			if err := m.MaxNodeProvisionTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxGracefulTerminationSeconds", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.MaxGracefulTerminationSeconds = &v
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ZoneSplitStrategy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := ZoneSplitStrategy(dAtA[iNdEx:postIndex])
+			m.ZoneSplitStrategy = &s
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ZoneWeights", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ZoneWeights == nil {
+				m.ZoneWeights = make(map[string]int32)
+			}
+			var mapkey string
+			var mapvalue int32
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvalue |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.ZoneWeights[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ZoneCaps", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ZoneCaps == nil {
+				m.ZoneCaps = make(map[string]int32)
+			}
+			var mapkey string
+			var mapvalue int32
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvalue |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.ZoneCaps[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Condition) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Condition: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Condition: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = ConditionType(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = ConditionStatus(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastTransitionTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -38003,11 +39261,333 @@ func (m *NginxIngress) Unmarshal(dAtA []byte) error {
 					iNdEx += skippy
 				}
 			}
-			m.Config[mapkey] = mapvalue
+			m.Config[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalTrafficPolicy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := k8s_io_api_core_v1.ServiceExternalTrafficPolicy(dAtA[iNdEx:postIndex])
+			m.ExternalTrafficPolicy = &s
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NodeLocalDNS) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NodeLocalDNS: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NodeLocalDNS: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForceTCPToClusterDNS", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			b := bool(v != 0)
+			m.ForceTCPToClusterDNS = &b
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForceTCPToUpstreamDNS", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			b := bool(v != 0)
+			m.ForceTCPToUpstreamDNS = &b
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisableForwardToUpstreamDNS", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			b := bool(v != 0)
+			m.DisableForwardToUpstreamDNS = &b
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Resources", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Resources == nil {
+				m.Resources = &v1.ResourceRequirements{}
+			}
+			if err := m.Resources.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriorityClassName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.PriorityClassName = &s
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tolerations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tolerations = append(m.Tolerations, v1.Toleration{})
+			if err := m.Tolerations[len(m.Tolerations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnableDaemonSetEviction", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			b := bool(v != 0)
+			m.EnableDaemonSetEviction = &b
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpstreamServers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UpstreamServers = append(m.UpstreamServers, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 4:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExternalTrafficPolicy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CustomZones", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -38035,8 +39615,79 @@ func (m *NginxIngress) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := k8s_io_api_core_v1.ServiceExternalTrafficPolicy(dAtA[iNdEx:postIndex])
-			m.ExternalTrafficPolicy = &s
+			m.CustomZones = append(m.CustomZones, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClusterDNSForward", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ClusterDNSForward == nil {
+				m.ClusterDNSForward = &NodeLocalDNSForward{}
+			}
+			if err := m.ClusterDNSForward.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpstreamDNSForward", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.UpstreamDNSForward == nil {
+				m.UpstreamDNSForward = &NodeLocalDNSForward{}
+			}
+			if err := m.UpstreamDNSForward.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -38059,7 +39710,7 @@ func (m *NginxIngress) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *NodeLocalDNS) Unmarshal(dAtA []byte) error {
+func (m *NodeLocalDNSForward) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -38082,17 +39733,17 @@ func (m *NodeLocalDNS) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: NodeLocalDNS: wiretype end group for non-group")
+			return fmt.Errorf("proto: NodeLocalDNSForward: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NodeLocalDNS: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: NodeLocalDNSForward: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HealthCheckInterval", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -38102,17 +39753,33 @@ func (m *NodeLocalDNS) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Enabled = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.HealthCheckInterval == nil {
+				m.HealthCheckInterval = &v11.Duration{}
+			}
+			if err := m.HealthCheckInterval.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ForceTCPToClusterDNS", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxConcurrent", wireType)
 			}
-			var v int
+			var v int32
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -38122,18 +39789,17 @@ func (m *NodeLocalDNS) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				v |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			b := bool(v != 0)
-			m.ForceTCPToClusterDNS = &b
+			m.MaxConcurrent = &v
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ForceTCPToUpstreamDNS", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Policy", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -38143,34 +39809,25 @@ func (m *NodeLocalDNS) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			b := bool(v != 0)
-			m.ForceTCPToUpstreamDNS = &b
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DisableForwardToUpstreamDNS", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			b := bool(v != 0)
-			m.DisableForwardToUpstreamDNS = &b
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Policy = &s
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -38691,17 +40348,349 @@ func (m *ObservabilityRotation) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ObservabilityRotation: wiretype end group for non-group")
+			return fmt.Errorf("proto: ObservabilityRotation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ObservabilityRotation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastInitiationTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastInitiationTime == nil {
+				m.LastInitiationTime = &v11.Time{}
+			}
+			if err := m.LastInitiationTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastCompletionTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastCompletionTime == nil {
+				m.LastCompletionTime = &v11.Time{}
+			}
+			if err := m.LastCompletionTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OpenIDConnectClientAuthentication) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OpenIDConnectClientAuthentication: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OpenIDConnectClientAuthentication: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExtraConfig", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExtraConfig == nil {
+				m.ExtraConfig = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.ExtraConfig[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Secret", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Secret = &s
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PriorityClassScaleUpDelay) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PriorityClassScaleUpDelay: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ObservabilityRotation: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PriorityClassScaleUpDelay: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastInitiationTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PriorityClassName", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -38711,31 +40700,27 @@ func (m *ObservabilityRotation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.LastInitiationTime == nil {
-				m.LastInitiationTime = &v11.Time{}
-			}
-			if err := m.LastInitiationTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.PriorityClassName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastCompletionTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewPodScaleUpDelay", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -38762,222 +40747,9 @@ func (m *ObservabilityRotation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.LastCompletionTime == nil {
-				m.LastCompletionTime = &v11.Time{}
-			}
-			if err := m.LastCompletionTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.NewPodScaleUpDelay.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *OpenIDConnectClientAuthentication) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: OpenIDConnectClientAuthentication: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OpenIDConnectClientAuthentication: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExtraConfig", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.ExtraConfig == nil {
-				m.ExtraConfig = make(map[string]string)
-			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
-			}
-			m.ExtraConfig[mapkey] = mapvalue
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Secret", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.Secret = &s
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -51294,6 +53066,42 @@ func (m *Worker) Unmarshal(dAtA []byte) error {
 			}
 			m.Sysctls[mapkey] = mapvalue
 			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClusterAutoscaler", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ClusterAutoscaler == nil {
+				m.ClusterAutoscaler = &ClusterAutoscalerOptions{}
+			}
+			if err := m.ClusterAutoscaler.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
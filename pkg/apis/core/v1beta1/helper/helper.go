@@ -380,6 +380,20 @@ func ShootSchedulingProfile(shoot *gardencorev1beta1.Shoot) *gardencorev1beta1.S
 	return nil
 }
 
+// ShootPriorityClassScaleUpDelays returns the new pod scale-up delays of the cluster-autoscaler of the given Shoot
+// keyed by the names of the priority classes they apply to.
+func ShootPriorityClassScaleUpDelays(shoot *gardencorev1beta1.Shoot) map[string]metav1.Duration {
+	if shoot.Spec.Kubernetes.ClusterAutoscaler == nil || len(shoot.Spec.Kubernetes.ClusterAutoscaler.PriorityClassScaleUpDelays) == 0 {
+		return nil
+	}
+
+	delays := make(map[string]metav1.Duration, len(shoot.Spec.Kubernetes.ClusterAutoscaler.PriorityClassScaleUpDelays))
+	for _, delay := range shoot.Spec.Kubernetes.ClusterAutoscaler.PriorityClassScaleUpDelays {
+		delays[delay.PriorityClassName] = delay.NewPodScaleUpDelay
+	}
+	return delays
+}
+
 // ShootConfinesSpecUpdateRollout returns a bool.
 func ShootConfinesSpecUpdateRollout(maintenance *gardencorev1beta1.Maintenance) bool {
 	return maintenance != nil && maintenance.ConfineSpecUpdateRollout != nil && *maintenance.ConfineSpecUpdateRollout
@@ -1227,6 +1241,18 @@ func IsNodeLocalDNSEnabled(systemComponents *gardencorev1beta1.SystemComponents)
 	return systemComponents != nil && systemComponents.NodeLocalDNS != nil && systemComponents.NodeLocalDNS.Enabled
 }
 
+// IsNodeLocalDNSHostNetworkEnabled indicates whether the node local DNS cache shall also serve pods running in the
+// host network.
+func IsNodeLocalDNSHostNetworkEnabled(systemComponents *gardencorev1beta1.SystemComponents, annotations map[string]string) bool {
+	return IsNodeLocalDNSEnabled(systemComponents) && annotations[v1beta1constants.AnnotationNodeLocalDNSHostNetwork] == "true"
+}
+
+// IsNodeLocalDNSForwardToNodeResolversEnabled indicates whether the node local DNS cache shall forward queries for
+// non-cluster domains to the resolvers of the node.
+func IsNodeLocalDNSForwardToNodeResolversEnabled(systemComponents *gardencorev1beta1.SystemComponents, annotations map[string]string) bool {
+	return IsNodeLocalDNSEnabled(systemComponents) && annotations[v1beta1constants.AnnotationNodeLocalDNSForwardToNodeResolvers] == "true"
+}
+
 // GetNodeLocalDNS returns a pointer to the NodeLocalDNS spec.
 func GetNodeLocalDNS(systemComponents *gardencorev1beta1.SystemComponents) *gardencorev1beta1.NodeLocalDNS {
 	if systemComponents != nil {
@@ -648,6 +648,27 @@ var _ = Describe("Helper", func() {
 		),
 	)
 
+	DescribeTable("#ShootPriorityClassScaleUpDelays",
+		func(clusterAutoscaler *gardencorev1beta1.ClusterAutoscaler, expected map[string]metav1.Duration) {
+			shoot := &gardencorev1beta1.Shoot{Spec: gardencorev1beta1.ShootSpec{Kubernetes: gardencorev1beta1.Kubernetes{ClusterAutoscaler: clusterAutoscaler}}}
+			Expect(ShootPriorityClassScaleUpDelays(shoot)).To(Equal(expected))
+		},
+		Entry("no cluster-autoscaler config", nil, nil),
+		Entry("no priority class scale-up delays", &gardencorev1beta1.ClusterAutoscaler{}, nil),
+		Entry("priority class scale-up delays are set",
+			&gardencorev1beta1.ClusterAutoscaler{
+				PriorityClassScaleUpDelays: []gardencorev1beta1.PriorityClassScaleUpDelay{
+					{PriorityClassName: "batch", NewPodScaleUpDelay: metav1.Duration{Duration: 5 * time.Minute}},
+					{PriorityClassName: "critical", NewPodScaleUpDelay: metav1.Duration{}},
+				},
+			},
+			map[string]metav1.Duration{
+				"batch":    {Duration: 5 * time.Minute},
+				"critical": {},
+			},
+		),
+	)
+
 	DescribeTable("#SeedSettingExcessCapacityReservationEnabled",
 		func(settings *gardencorev1beta1.SeedSettings, expectation bool) {
 			Expect(SeedSettingExcessCapacityReservationEnabled(settings)).To(Equal(expectation))
@@ -2705,6 +2726,28 @@ var _ = Describe("Helper", func() {
 		Entry("with system components and node-local-dns is disabled", &gardencorev1beta1.SystemComponents{NodeLocalDNS: &gardencorev1beta1.NodeLocalDNS{Enabled: false}}, false),
 	)
 
+	DescribeTable("#IsNodeLocalDNSHostNetworkEnabled",
+		func(systemComponents *gardencorev1beta1.SystemComponents, annotations map[string]string, expected bool) {
+			Expect(IsNodeLocalDNSHostNetworkEnabled(systemComponents, annotations)).To(Equal(expected))
+		},
+
+		Entry("with node-local-dns disabled and no annotation", nil, nil, false),
+		Entry("with node-local-dns disabled and annotation", nil, map[string]string{v1beta1constants.AnnotationNodeLocalDNSHostNetwork: "true"}, false),
+		Entry("with node-local-dns enabled and no annotation", &gardencorev1beta1.SystemComponents{NodeLocalDNS: &gardencorev1beta1.NodeLocalDNS{Enabled: true}}, nil, false),
+		Entry("with node-local-dns enabled and annotation set to false", &gardencorev1beta1.SystemComponents{NodeLocalDNS: &gardencorev1beta1.NodeLocalDNS{Enabled: true}}, map[string]string{v1beta1constants.AnnotationNodeLocalDNSHostNetwork: "false"}, false),
+		Entry("with node-local-dns enabled and annotation set to true", &gardencorev1beta1.SystemComponents{NodeLocalDNS: &gardencorev1beta1.NodeLocalDNS{Enabled: true}}, map[string]string{v1beta1constants.AnnotationNodeLocalDNSHostNetwork: "true"}, true),
+	)
+
+	DescribeTable("#IsNodeLocalDNSForwardToNodeResolversEnabled",
+		func(systemComponents *gardencorev1beta1.SystemComponents, annotations map[string]string, expected bool) {
+			Expect(IsNodeLocalDNSForwardToNodeResolversEnabled(systemComponents, annotations)).To(Equal(expected))
+		},
+
+		Entry("with node-local-dns disabled and annotation", nil, map[string]string{v1beta1constants.AnnotationNodeLocalDNSForwardToNodeResolvers: "true"}, false),
+		Entry("with node-local-dns enabled and no annotation", &gardencorev1beta1.SystemComponents{NodeLocalDNS: &gardencorev1beta1.NodeLocalDNS{Enabled: true}}, nil, false),
+		Entry("with node-local-dns enabled and annotation set to true", &gardencorev1beta1.SystemComponents{NodeLocalDNS: &gardencorev1beta1.NodeLocalDNS{Enabled: true}}, map[string]string{v1beta1constants.AnnotationNodeLocalDNSForwardToNodeResolvers: "true"}, true),
+	)
+
 	DescribeTable("#GetNodeLocalDNS",
 		func(systemComponents *gardencorev1beta1.SystemComponents, expected *gardencorev1beta1.NodeLocalDNS) {
 			Expect(GetNodeLocalDNS(systemComponents)).To(Equal(expected))
@@ -390,6 +390,9 @@ type KubernetesDashboard struct {
 const (
 	// KubernetesDashboardAuthModeToken uses token-based mode for auth.
 	KubernetesDashboardAuthModeToken = "token"
+	// KubernetesDashboardAuthModeOIDC uses an OIDC auth proxy in front of the dashboard which authenticates users with
+	// the OIDC provider configured for kube-apiserver (see `.spec.kubernetes.kubeAPIServer.oidcConfig`).
+	KubernetesDashboardAuthModeOIDC = "oidc"
 )
 
 // NginxIngress describes configuration values for the nginx-ingress addon.
@@ -599,6 +602,45 @@ type ClusterAutoscaler struct {
 	// MaxEmptyBulkDelete specifies the maximum number of empty nodes that can be deleted at the same time (default: 10).
 	// +optional
 	MaxEmptyBulkDelete *int32 `json:"maxEmptyBulkDelete,omitempty" protobuf:"varint,12,opt,name=maxEmptyBulkDelete"`
+	// SkipNodesWithCustomControllerPods specifies whether CA should never delete nodes with pods owned by custom
+	// controllers, i.e., controllers other than ReplicaSets, Jobs, StatefulSets, and ReplicationControllers (default:
+	// true). Setting it to false allows scaling down nodes whose pods are only blocked by such custom controllers.
+	// This field is only available for Kubernetes versions >= 1.27.
+	// +optional
+	SkipNodesWithCustomControllerPods *bool `json:"skipNodesWithCustomControllerPods,omitempty" protobuf:"varint,13,opt,name=skipNodesWithCustomControllerPods"`
+	// MaxPodEvictionTime defines how long CA tries to evict a pod when draining a node before it gives up and marks the
+	// scale-down as failed (default: 2m).
+	// +optional
+	MaxPodEvictionTime *metav1.Duration `json:"maxPodEvictionTime,omitempty" protobuf:"bytes,14,opt,name=maxPodEvictionTime"`
+	// PriorityClassScaleUpDelays specifies how long CA should ignore newly created pods of the given priority classes
+	// before they have to be considered for scale-up. It overrides NewPodScaleUpDelay for pods with a matching
+	// priority class name.
+	// +optional
+	PriorityClassScaleUpDelays []PriorityClassScaleUpDelay `json:"priorityClassScaleUpDelays,omitempty" protobuf:"bytes,15,rep,name=priorityClassScaleUpDelays"`
+	// CordonNodeBeforeTerminating specifies whether CA should cordon nodes before it starts draining them during
+	// scale-down, i.e., whether no new pods are scheduled to nodes which are about to be removed (default: false).
+	// +optional
+	CordonNodeBeforeTerminating *bool `json:"cordonNodeBeforeTerminating,omitempty" protobuf:"varint,16,opt,name=cordonNodeBeforeTerminating"`
+	// ScaleDownCandidatesPoolRatio defines the ratio of nodes that are considered as additional non-empty candidates
+	// for scale-down when some candidates from the previous iteration are no longer valid (default: 0.1). Lower values
+	// reduce the CPU consumption and the loop latency of CA in large clusters but slow down the scale-down.
+	// +optional
+	ScaleDownCandidatesPoolRatio *float64 `json:"scaleDownCandidatesPoolRatio,omitempty" protobuf:"fixed64,17,opt,name=scaleDownCandidatesPoolRatio"`
+	// ScaleDownCandidatesPoolMinCount defines the minimum number of nodes that are considered as additional non-empty
+	// candidates for scale-down when some candidates from the previous iteration are no longer valid (default: 50).
+	// The effective pool size is the maximum of this value and the number of nodes multiplied by
+	// ScaleDownCandidatesPoolRatio.
+	// +optional
+	ScaleDownCandidatesPoolMinCount *int32 `json:"scaleDownCandidatesPoolMinCount,omitempty" protobuf:"varint,18,opt,name=scaleDownCandidatesPoolMinCount"`
+}
+
+// PriorityClassScaleUpDelay contains the new pod scale-up delay for pods of a certain priority class.
+type PriorityClassScaleUpDelay struct {
+	// PriorityClassName is the name of the priority class.
+	PriorityClassName string `json:"priorityClassName" protobuf:"bytes,1,opt,name=priorityClassName"`
+	// NewPodScaleUpDelay specifies how long CA should ignore newly created pods of the priority class before they have
+	// to be considered for scale-up.
+	NewPodScaleUpDelay metav1.Duration `json:"newPodScaleUpDelay" protobuf:"bytes,2,opt,name=newPodScaleUpDelay"`
 }
 
 // ExpanderMode is type used for Expander values
@@ -1414,8 +1456,56 @@ type Worker struct {
 	// Sysctls is a map of kernel settings to apply on all machines in this worker pool.
 	// +optional
 	Sysctls map[string]string `json:"sysctls,omitempty" protobuf:"bytes,20,rep,name=sysctls"`
+	// ClusterAutoscaler contains the cluster autoscaler configurations for the worker pool. They override the global
+	// settings in `.spec.kubernetes.clusterAutoscaler` for this worker pool.
+	// +optional
+	ClusterAutoscaler *ClusterAutoscalerOptions `json:"clusterAutoscaler,omitempty" protobuf:"bytes,21,opt,name=clusterAutoscaler"`
+}
+
+// ClusterAutoscalerOptions contains the cluster autoscaler configurations for a worker pool.
+type ClusterAutoscalerOptions struct {
+	// ScaleDownUtilizationThreshold defines the threshold in fraction (0.0 - 1.0) under which a node is being removed.
+	// +optional
+	ScaleDownUtilizationThreshold *float64 `json:"scaleDownUtilizationThreshold,omitempty" protobuf:"fixed64,1,opt,name=scaleDownUtilizationThreshold"`
+	// ScaleDownUnneededTime defines how long a node should be unneeded before it is eligible for scale down.
+	// +optional
+	ScaleDownUnneededTime *metav1.Duration `json:"scaleDownUnneededTime,omitempty" protobuf:"bytes,2,opt,name=scaleDownUnneededTime"`
+	// MaxNodeProvisionTime defines how long CA waits for node to be provisioned.
+	// +optional
+	MaxNodeProvisionTime *metav1.Duration `json:"maxNodeProvisionTime,omitempty" protobuf:"bytes,3,opt,name=maxNodeProvisionTime"`
+	// MaxGracefulTerminationSeconds is the number of seconds CA waits for pod termination when trying to scale
+	// down a node of this worker pool.
+	// +optional
+	MaxGracefulTerminationSeconds *int32 `json:"maxGracefulTerminationSeconds,omitempty" protobuf:"varint,4,opt,name=maxGracefulTerminationSeconds"`
+	// ZoneSplitStrategy is the strategy used by Gardener for splitting the minimum and maximum of this worker pool over
+	// the machine deployments of its zones. If not set, the split is left to the provider extension.
+	// +optional
+	ZoneSplitStrategy *ZoneSplitStrategy `json:"zoneSplitStrategy,omitempty" protobuf:"bytes,5,opt,name=zoneSplitStrategy,casttype=ZoneSplitStrategy"`
+	// ZoneWeights are the weights of the zones of this worker pool which are considered by the `Weighted` zone split
+	// strategy. Zones without weight have a weight of 1.
+	// +optional
+	ZoneWeights map[string]int32 `json:"zoneWeights,omitempty" protobuf:"bytes,6,rep,name=zoneWeights"`
+	// ZoneCaps are the maximum numbers of nodes in the zones of this worker pool which are considered by the `Capped`
+	// zone split strategy.
+	// +optional
+	ZoneCaps map[string]int32 `json:"zoneCaps,omitempty" protobuf:"bytes,7,rep,name=zoneCaps"`
 }
 
+// ZoneSplitStrategy is the strategy used for splitting the minimum and maximum of a worker pool over the machine
+// deployments of its zones.
+type ZoneSplitStrategy string
+
+const (
+	// ZoneSplitStrategyEven distributes the minimum and maximum evenly over all zones. Remainders are assigned to the
+	// first zones.
+	ZoneSplitStrategyEven ZoneSplitStrategy = "Even"
+	// ZoneSplitStrategyWeighted distributes the minimum and maximum proportionally to the weights of the zones.
+	ZoneSplitStrategyWeighted ZoneSplitStrategy = "Weighted"
+	// ZoneSplitStrategyCapped distributes the minimum and maximum evenly over all zones, however, the maximum of a zone
+	// never exceeds its cap.
+	ZoneSplitStrategyCapped ZoneSplitStrategy = "Capped"
+)
+
 // MachineControllerManagerSettings contains configurations for different worker-pools. Eg. MachineDrainTimeout, MachineHealthTimeout.
 type MachineControllerManagerSettings struct {
 	// MachineDrainTimeout is the period after which machine is forcefully deleted.
@@ -1623,6 +1713,57 @@ type NodeLocalDNS struct {
 	// Default, if unspecified, is to forward requests for external domains to upstream DNS
 	// +optional
 	DisableForwardToUpstreamDNS *bool `json:"disableForwardToUpstreamDNS,omitempty" protobuf:"varint,4,opt,name=disableForwardToUpstreamDNS"`
+	// Resources are the resource requirements of the node-cache container of node local DNS. Only CPU and memory
+	// resources are supported. Defaults to requests of 25m CPU and 25Mi memory, and a memory limit of 100Mi.
+	// +optional
+	Resources *corev1.ResourceRequirements `json:"resources,omitempty" protobuf:"bytes,5,opt,name=resources"`
+	// PriorityClassName is the name of the priority class of the node local DNS pods. The priority class must exist in
+	// the shoot cluster. Defaults to `system-node-critical`.
+	// +optional
+	PriorityClassName *string `json:"priorityClassName,omitempty" protobuf:"bytes,6,opt,name=priorityClassName"`
+	// Tolerations are the tolerations of the node local DNS pods. If set, they replace the default tolerations which
+	// tolerate all taints with the `NoSchedule` and `NoExecute` effects. This allows keeping node local DNS off
+	// specialized worker pools (e.g., Windows or confidential nodes) by not tolerating their taints.
+	// +optional
+	Tolerations []corev1.Toleration `json:"tolerations,omitempty" protobuf:"bytes,7,rep,name=tolerations"`
+	// EnableDaemonSetEviction specifies whether cluster-autoscaler evicts the node local DNS pods when scaling down a
+	// node. If set, the pods are annotated with `cluster-autoscaler.kubernetes.io/enable-ds-eviction` accordingly.
+	// Otherwise, the default behaviour of cluster-autoscaler applies.
+	// +optional
+	EnableDaemonSetEviction *bool `json:"enableDaemonSetEviction,omitempty" protobuf:"varint,8,opt,name=enableDaemonSetEviction"`
+	// UpstreamServers are the DNS servers (IP addresses, optionally with port) to which node local DNS forwards queries
+	// for non-cluster domains. If set, they replace the resolvers of the node. This is not allowed if forwarding to
+	// upstream DNS is disabled.
+	// +optional
+	UpstreamServers []string `json:"upstreamServers,omitempty" protobuf:"bytes,9,rep,name=upstreamServers"`
+	// CustomZones are additional server blocks which are appended to the Corefile of node local DNS, e.g., to forward
+	// queries for corporate domains to dedicated DNS servers.
+	// +optional
+	CustomZones []string `json:"customZones,omitempty" protobuf:"bytes,10,rep,name=customZones"`
+	// ClusterDNSForward configures how node local DNS forwards queries for the cluster domain and the reverse zones to
+	// the cluster DNS.
+	// +optional
+	ClusterDNSForward *NodeLocalDNSForward `json:"clusterDNSForward,omitempty" protobuf:"bytes,11,opt,name=clusterDNSForward"`
+	// UpstreamDNSForward configures how node local DNS forwards queries for non-cluster domains to the upstream DNS.
+	// +optional
+	UpstreamDNSForward *NodeLocalDNSForward `json:"upstreamDNSForward,omitempty" protobuf:"bytes,12,opt,name=upstreamDNSForward"`
+}
+
+// NodeLocalDNSForward contains the settings of the forward plugin of a zone of node local DNS.
+type NodeLocalDNSForward struct {
+	// HealthCheckInterval is the interval in which the health of the DNS servers the queries are forwarded to is
+	// checked. Unhealthy servers are not used until they are healthy again, hence a shorter interval speeds up the
+	// failover for flaky servers. Defaults to `0.5s`.
+	// +optional
+	HealthCheckInterval *metav1.Duration `json:"healthCheckInterval,omitempty" protobuf:"bytes,1,opt,name=healthCheckInterval"`
+	// MaxConcurrent is the maximum number of concurrent queries which are forwarded. Queries exceeding it are answered
+	// with `REFUSED`. Defaults to no limit.
+	// +optional
+	MaxConcurrent *int32 `json:"maxConcurrent,omitempty" protobuf:"varint,2,opt,name=maxConcurrent"`
+	// Policy is the policy for selecting the DNS server a query is forwarded to. Supported values are `random`,
+	// `round_robin`, and `sequential`. Defaults to `random`.
+	// +optional
+	Policy *string `json:"policy,omitempty" protobuf:"bytes,3,opt,name=policy"`
 }
 
 const (
@@ -282,6 +282,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ClusterAutoscalerOptions)(nil), (*core.ClusterAutoscalerOptions)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ClusterAutoscalerOptions_To_core_ClusterAutoscalerOptions(a.(*ClusterAutoscalerOptions), b.(*core.ClusterAutoscalerOptions), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.ClusterAutoscalerOptions)(nil), (*ClusterAutoscalerOptions)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_ClusterAutoscalerOptions_To_v1beta1_ClusterAutoscalerOptions(a.(*core.ClusterAutoscalerOptions), b.(*ClusterAutoscalerOptions), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*Condition)(nil), (*core.Condition)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_Condition_To_core_Condition(a.(*Condition), b.(*core.Condition), scope)
 	}); err != nil {
@@ -997,6 +1007,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*NodeLocalDNSForward)(nil), (*core.NodeLocalDNSForward)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_NodeLocalDNSForward_To_core_NodeLocalDNSForward(a.(*NodeLocalDNSForward), b.(*core.NodeLocalDNSForward), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.NodeLocalDNSForward)(nil), (*NodeLocalDNSForward)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_NodeLocalDNSForward_To_v1beta1_NodeLocalDNSForward(a.(*core.NodeLocalDNSForward), b.(*NodeLocalDNSForward), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*OIDCConfig)(nil), (*core.OIDCConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_OIDCConfig_To_core_OIDCConfig(a.(*OIDCConfig), b.(*core.OIDCConfig), scope)
 	}); err != nil {
@@ -1027,6 +1047,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*PriorityClassScaleUpDelay)(nil), (*core.PriorityClassScaleUpDelay)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_PriorityClassScaleUpDelay_To_core_PriorityClassScaleUpDelay(a.(*PriorityClassScaleUpDelay), b.(*core.PriorityClassScaleUpDelay), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.PriorityClassScaleUpDelay)(nil), (*PriorityClassScaleUpDelay)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_PriorityClassScaleUpDelay_To_v1beta1_PriorityClassScaleUpDelay(a.(*core.PriorityClassScaleUpDelay), b.(*PriorityClassScaleUpDelay), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*Project)(nil), (*core.Project)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_Project_To_core_Project(a.(*Project), b.(*core.Project), scope)
 	}); err != nil {
@@ -2310,6 +2340,12 @@ func autoConvert_v1beta1_ClusterAutoscaler_To_core_ClusterAutoscaler(in *Cluster
 	out.IgnoreTaints = *(*[]string)(unsafe.Pointer(&in.IgnoreTaints))
 	out.NewPodScaleUpDelay = (*metav1.Duration)(unsafe.Pointer(in.NewPodScaleUpDelay))
 	out.MaxEmptyBulkDelete = (*int32)(unsafe.Pointer(in.MaxEmptyBulkDelete))
+	out.SkipNodesWithCustomControllerPods = (*bool)(unsafe.Pointer(in.SkipNodesWithCustomControllerPods))
+	out.MaxPodEvictionTime = (*metav1.Duration)(unsafe.Pointer(in.MaxPodEvictionTime))
+	out.PriorityClassScaleUpDelays = *(*[]core.PriorityClassScaleUpDelay)(unsafe.Pointer(&in.PriorityClassScaleUpDelays))
+	out.CordonNodeBeforeTerminating = (*bool)(unsafe.Pointer(in.CordonNodeBeforeTerminating))
+	out.ScaleDownCandidatesPoolRatio = (*float64)(unsafe.Pointer(in.ScaleDownCandidatesPoolRatio))
+	out.ScaleDownCandidatesPoolMinCount = (*int32)(unsafe.Pointer(in.ScaleDownCandidatesPoolMinCount))
 	return nil
 }
 
@@ -2331,6 +2367,12 @@ func autoConvert_core_ClusterAutoscaler_To_v1beta1_ClusterAutoscaler(in *core.Cl
 	out.IgnoreTaints = *(*[]string)(unsafe.Pointer(&in.IgnoreTaints))
 	out.NewPodScaleUpDelay = (*metav1.Duration)(unsafe.Pointer(in.NewPodScaleUpDelay))
 	out.MaxEmptyBulkDelete = (*int32)(unsafe.Pointer(in.MaxEmptyBulkDelete))
+	out.SkipNodesWithCustomControllerPods = (*bool)(unsafe.Pointer(in.SkipNodesWithCustomControllerPods))
+	out.MaxPodEvictionTime = (*metav1.Duration)(unsafe.Pointer(in.MaxPodEvictionTime))
+	out.PriorityClassScaleUpDelays = *(*[]PriorityClassScaleUpDelay)(unsafe.Pointer(&in.PriorityClassScaleUpDelays))
+	out.CordonNodeBeforeTerminating = (*bool)(unsafe.Pointer(in.CordonNodeBeforeTerminating))
+	out.ScaleDownCandidatesPoolRatio = (*float64)(unsafe.Pointer(in.ScaleDownCandidatesPoolRatio))
+	out.ScaleDownCandidatesPoolMinCount = (*int32)(unsafe.Pointer(in.ScaleDownCandidatesPoolMinCount))
 	return nil
 }
 
@@ -2339,6 +2381,38 @@ func Convert_core_ClusterAutoscaler_To_v1beta1_ClusterAutoscaler(in *core.Cluste
 	return autoConvert_core_ClusterAutoscaler_To_v1beta1_ClusterAutoscaler(in, out, s)
 }
 
+func autoConvert_v1beta1_ClusterAutoscalerOptions_To_core_ClusterAutoscalerOptions(in *ClusterAutoscalerOptions, out *core.ClusterAutoscalerOptions, s conversion.Scope) error {
+	out.ScaleDownUtilizationThreshold = (*float64)(unsafe.Pointer(in.ScaleDownUtilizationThreshold))
+	out.ScaleDownUnneededTime = (*metav1.Duration)(unsafe.Pointer(in.ScaleDownUnneededTime))
+	out.MaxNodeProvisionTime = (*metav1.Duration)(unsafe.Pointer(in.MaxNodeProvisionTime))
+	out.MaxGracefulTerminationSeconds = (*int32)(unsafe.Pointer(in.MaxGracefulTerminationSeconds))
+	out.ZoneSplitStrategy = (*core.ZoneSplitStrategy)(unsafe.Pointer(in.ZoneSplitStrategy))
+	out.ZoneWeights = *(*map[string]int32)(unsafe.Pointer(&in.ZoneWeights))
+	out.ZoneCaps = *(*map[string]int32)(unsafe.Pointer(&in.ZoneCaps))
+	return nil
+}
+
+// Convert_v1beta1_ClusterAutoscalerOptions_To_core_ClusterAutoscalerOptions is an autogenerated conversion function.
+func Convert_v1beta1_ClusterAutoscalerOptions_To_core_ClusterAutoscalerOptions(in *ClusterAutoscalerOptions, out *core.ClusterAutoscalerOptions, s conversion.Scope) error {
+	return autoConvert_v1beta1_ClusterAutoscalerOptions_To_core_ClusterAutoscalerOptions(in, out, s)
+}
+
+func autoConvert_core_ClusterAutoscalerOptions_To_v1beta1_ClusterAutoscalerOptions(in *core.ClusterAutoscalerOptions, out *ClusterAutoscalerOptions, s conversion.Scope) error {
+	out.ScaleDownUtilizationThreshold = (*float64)(unsafe.Pointer(in.ScaleDownUtilizationThreshold))
+	out.ScaleDownUnneededTime = (*metav1.Duration)(unsafe.Pointer(in.ScaleDownUnneededTime))
+	out.MaxNodeProvisionTime = (*metav1.Duration)(unsafe.Pointer(in.MaxNodeProvisionTime))
+	out.MaxGracefulTerminationSeconds = (*int32)(unsafe.Pointer(in.MaxGracefulTerminationSeconds))
+	out.ZoneSplitStrategy = (*ZoneSplitStrategy)(unsafe.Pointer(in.ZoneSplitStrategy))
+	out.ZoneWeights = *(*map[string]int32)(unsafe.Pointer(&in.ZoneWeights))
+	out.ZoneCaps = *(*map[string]int32)(unsafe.Pointer(&in.ZoneCaps))
+	return nil
+}
+
+// Convert_core_ClusterAutoscalerOptions_To_v1beta1_ClusterAutoscalerOptions is an autogenerated conversion function.
+func Convert_core_ClusterAutoscalerOptions_To_v1beta1_ClusterAutoscalerOptions(in *core.ClusterAutoscalerOptions, out *ClusterAutoscalerOptions, s conversion.Scope) error {
+	return autoConvert_core_ClusterAutoscalerOptions_To_v1beta1_ClusterAutoscalerOptions(in, out, s)
+}
+
 func autoConvert_v1beta1_Condition_To_core_Condition(in *Condition, out *core.Condition, s conversion.Scope) error {
 	out.Type = core.ConditionType(in.Type)
 	out.Status = core.ConditionStatus(in.Status)
@@ -4298,6 +4372,14 @@ func autoConvert_v1beta1_NodeLocalDNS_To_core_NodeLocalDNS(in *NodeLocalDNS, out
 	out.ForceTCPToClusterDNS = (*bool)(unsafe.Pointer(in.ForceTCPToClusterDNS))
 	out.ForceTCPToUpstreamDNS = (*bool)(unsafe.Pointer(in.ForceTCPToUpstreamDNS))
 	out.DisableForwardToUpstreamDNS = (*bool)(unsafe.Pointer(in.DisableForwardToUpstreamDNS))
+	out.Resources = (*v1.ResourceRequirements)(unsafe.Pointer(in.Resources))
+	out.PriorityClassName = (*string)(unsafe.Pointer(in.PriorityClassName))
+	out.Tolerations = *(*[]v1.Toleration)(unsafe.Pointer(&in.Tolerations))
+	out.EnableDaemonSetEviction = (*bool)(unsafe.Pointer(in.EnableDaemonSetEviction))
+	out.UpstreamServers = *(*[]string)(unsafe.Pointer(&in.UpstreamServers))
+	out.CustomZones = *(*[]string)(unsafe.Pointer(&in.CustomZones))
+	out.ClusterDNSForward = (*core.NodeLocalDNSForward)(unsafe.Pointer(in.ClusterDNSForward))
+	out.UpstreamDNSForward = (*core.NodeLocalDNSForward)(unsafe.Pointer(in.UpstreamDNSForward))
 	return nil
 }
 
@@ -4311,6 +4393,14 @@ func autoConvert_core_NodeLocalDNS_To_v1beta1_NodeLocalDNS(in *core.NodeLocalDNS
 	out.ForceTCPToClusterDNS = (*bool)(unsafe.Pointer(in.ForceTCPToClusterDNS))
 	out.ForceTCPToUpstreamDNS = (*bool)(unsafe.Pointer(in.ForceTCPToUpstreamDNS))
 	out.DisableForwardToUpstreamDNS = (*bool)(unsafe.Pointer(in.DisableForwardToUpstreamDNS))
+	out.Resources = (*v1.ResourceRequirements)(unsafe.Pointer(in.Resources))
+	out.PriorityClassName = (*string)(unsafe.Pointer(in.PriorityClassName))
+	out.Tolerations = *(*[]v1.Toleration)(unsafe.Pointer(&in.Tolerations))
+	out.EnableDaemonSetEviction = (*bool)(unsafe.Pointer(in.EnableDaemonSetEviction))
+	out.UpstreamServers = *(*[]string)(unsafe.Pointer(&in.UpstreamServers))
+	out.CustomZones = *(*[]string)(unsafe.Pointer(&in.CustomZones))
+	out.ClusterDNSForward = (*NodeLocalDNSForward)(unsafe.Pointer(in.ClusterDNSForward))
+	out.UpstreamDNSForward = (*NodeLocalDNSForward)(unsafe.Pointer(in.UpstreamDNSForward))
 	return nil
 }
 
@@ -4319,6 +4409,30 @@ func Convert_core_NodeLocalDNS_To_v1beta1_NodeLocalDNS(in *core.NodeLocalDNS, ou
 	return autoConvert_core_NodeLocalDNS_To_v1beta1_NodeLocalDNS(in, out, s)
 }
 
+func autoConvert_v1beta1_NodeLocalDNSForward_To_core_NodeLocalDNSForward(in *NodeLocalDNSForward, out *core.NodeLocalDNSForward, s conversion.Scope) error {
+	out.HealthCheckInterval = (*metav1.Duration)(unsafe.Pointer(in.HealthCheckInterval))
+	out.MaxConcurrent = (*int32)(unsafe.Pointer(in.MaxConcurrent))
+	out.Policy = (*string)(unsafe.Pointer(in.Policy))
+	return nil
+}
+
+// Convert_v1beta1_NodeLocalDNSForward_To_core_NodeLocalDNSForward is an autogenerated conversion function.
+func Convert_v1beta1_NodeLocalDNSForward_To_core_NodeLocalDNSForward(in *NodeLocalDNSForward, out *core.NodeLocalDNSForward, s conversion.Scope) error {
+	return autoConvert_v1beta1_NodeLocalDNSForward_To_core_NodeLocalDNSForward(in, out, s)
+}
+
+func autoConvert_core_NodeLocalDNSForward_To_v1beta1_NodeLocalDNSForward(in *core.NodeLocalDNSForward, out *NodeLocalDNSForward, s conversion.Scope) error {
+	out.HealthCheckInterval = (*metav1.Duration)(unsafe.Pointer(in.HealthCheckInterval))
+	out.MaxConcurrent = (*int32)(unsafe.Pointer(in.MaxConcurrent))
+	out.Policy = (*string)(unsafe.Pointer(in.Policy))
+	return nil
+}
+
+// Convert_core_NodeLocalDNSForward_To_v1beta1_NodeLocalDNSForward is an autogenerated conversion function.
+func Convert_core_NodeLocalDNSForward_To_v1beta1_NodeLocalDNSForward(in *core.NodeLocalDNSForward, out *NodeLocalDNSForward, s conversion.Scope) error {
+	return autoConvert_core_NodeLocalDNSForward_To_v1beta1_NodeLocalDNSForward(in, out, s)
+}
+
 func autoConvert_v1beta1_OIDCConfig_To_core_OIDCConfig(in *OIDCConfig, out *core.OIDCConfig, s conversion.Scope) error {
 	out.CABundle = (*string)(unsafe.Pointer(in.CABundle))
 	out.ClientAuthentication = (*core.OpenIDConnectClientAuthentication)(unsafe.Pointer(in.ClientAuthentication))
@@ -4401,6 +4515,28 @@ func Convert_core_OpenIDConnectClientAuthentication_To_v1beta1_OpenIDConnectClie
 	return autoConvert_core_OpenIDConnectClientAuthentication_To_v1beta1_OpenIDConnectClientAuthentication(in, out, s)
 }
 
+func autoConvert_v1beta1_PriorityClassScaleUpDelay_To_core_PriorityClassScaleUpDelay(in *PriorityClassScaleUpDelay, out *core.PriorityClassScaleUpDelay, s conversion.Scope) error {
+	out.PriorityClassName = in.PriorityClassName
+	out.NewPodScaleUpDelay = in.NewPodScaleUpDelay
+	return nil
+}
+
+// Convert_v1beta1_PriorityClassScaleUpDelay_To_core_PriorityClassScaleUpDelay is an autogenerated conversion function.
+func Convert_v1beta1_PriorityClassScaleUpDelay_To_core_PriorityClassScaleUpDelay(in *PriorityClassScaleUpDelay, out *core.PriorityClassScaleUpDelay, s conversion.Scope) error {
+	return autoConvert_v1beta1_PriorityClassScaleUpDelay_To_core_PriorityClassScaleUpDelay(in, out, s)
+}
+
+func autoConvert_core_PriorityClassScaleUpDelay_To_v1beta1_PriorityClassScaleUpDelay(in *core.PriorityClassScaleUpDelay, out *PriorityClassScaleUpDelay, s conversion.Scope) error {
+	out.PriorityClassName = in.PriorityClassName
+	out.NewPodScaleUpDelay = in.NewPodScaleUpDelay
+	return nil
+}
+
+// Convert_core_PriorityClassScaleUpDelay_To_v1beta1_PriorityClassScaleUpDelay is an autogenerated conversion function.
+func Convert_core_PriorityClassScaleUpDelay_To_v1beta1_PriorityClassScaleUpDelay(in *core.PriorityClassScaleUpDelay, out *PriorityClassScaleUpDelay, s conversion.Scope) error {
+	return autoConvert_core_PriorityClassScaleUpDelay_To_v1beta1_PriorityClassScaleUpDelay(in, out, s)
+}
+
 func autoConvert_v1beta1_Project_To_core_Project(in *Project, out *core.Project, s conversion.Scope) error {
 	out.ObjectMeta = in.ObjectMeta
 	if err := Convert_v1beta1_ProjectSpec_To_core_ProjectSpec(&in.Spec, &out.Spec, s); err != nil {
@@ -6199,6 +6335,7 @@ func autoConvert_v1beta1_Worker_To_core_Worker(in *Worker, out *core.Worker, s c
 	out.SystemComponents = (*core.WorkerSystemComponents)(unsafe.Pointer(in.SystemComponents))
 	out.MachineControllerManagerSettings = (*core.MachineControllerManagerSettings)(unsafe.Pointer(in.MachineControllerManagerSettings))
 	out.Sysctls = *(*map[string]string)(unsafe.Pointer(&in.Sysctls))
+	out.ClusterAutoscaler = (*core.ClusterAutoscalerOptions)(unsafe.Pointer(in.ClusterAutoscaler))
 	return nil
 }
 
@@ -6238,6 +6375,7 @@ func autoConvert_core_Worker_To_v1beta1_Worker(in *core.Worker, out *Worker, s c
 	out.Zones = *(*[]string)(unsafe.Pointer(&in.Zones))
 	out.MachineControllerManagerSettings = (*MachineControllerManagerSettings)(unsafe.Pointer(in.MachineControllerManagerSettings))
 	out.Sysctls = *(*map[string]string)(unsafe.Pointer(&in.Sysctls))
+	out.ClusterAutoscaler = (*ClusterAutoscalerOptions)(unsafe.Pointer(in.ClusterAutoscaler))
 	return nil
 }
 
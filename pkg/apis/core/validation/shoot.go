@@ -25,6 +25,7 @@ import (
 	"strconv"
 	"strings"
 	"time"
+	"unicode"
 
 	"github.com/Masterminds/semver/v3"
 	"github.com/go-test/deep"
@@ -64,11 +65,16 @@ var (
 	)
 	availableKubernetesDashboardAuthenticationModes = sets.New(
 		core.KubernetesDashboardAuthModeToken,
+		core.KubernetesDashboardAuthModeOIDC,
 	)
 	availableNginxIngressExternalTrafficPolicies = sets.New(
 		string(corev1.ServiceExternalTrafficPolicyTypeCluster),
 		string(corev1.ServiceExternalTrafficPolicyTypeLocal),
 	)
+	availableClusterAutoscalerRBACNamespaces = sets.New(
+		metav1.NamespaceSystem,
+		v1beta1constants.ClusterAutoscalerRBACNamespace,
+	)
 	availableShootOperations = sets.New(
 		v1beta1constants.ShootOperationMaintain,
 		v1beta1constants.ShootOperationRetry,
@@ -104,10 +110,38 @@ var (
 		string(core.ClusterAutoscalerExpanderPriority),
 		string(core.ClusterAutoscalerExpanderRandom),
 	)
+	availableZoneSplitStrategies = sets.New(
+		string(core.ZoneSplitStrategyEven),
+		string(core.ZoneSplitStrategyWeighted),
+		string(core.ZoneSplitStrategyCapped),
+	)
 	availableCoreDNSAutoscalingModes = sets.New(
 		string(core.CoreDNSAutoscalingModeClusterProportional),
 		string(core.CoreDNSAutoscalingModeHorizontal),
 	)
+	availableNodeLocalDNSResources = sets.New(
+		string(corev1.ResourceCPU),
+		string(corev1.ResourceMemory),
+	)
+	availableNodeLocalDNSForwardPolicies = sets.New(
+		"random",
+		"round_robin",
+		"sequential",
+	)
+	// reservedNodeLocalDNSZones are the zones for which node local DNS already has server blocks, i.e., the root zone,
+	// the cluster domain and the reverse zones.
+	reservedNodeLocalDNSZones = sets.New(
+		".",
+		core.DefaultDomain+".",
+		"in-addr.arpa.",
+		"ip6.arpa.",
+	)
+	availableNodeLocalDNSZoneSchemes = sets.New(
+		"dns",
+		"grpc",
+		"https",
+		"tls",
+	)
 	availableSchedulingProfiles = sets.New(
 		string(core.SchedulingProfileBalanced),
 		string(core.SchedulingProfileBinPacking),
@@ -149,6 +183,8 @@ func ValidateShoot(shoot *core.Shoot) field.ErrorList {
 	allErrs = append(allErrs, apivalidation.ValidateObjectMeta(&shoot.ObjectMeta, true, apivalidation.NameIsDNSLabel, field.NewPath("metadata"))...)
 	allErrs = append(allErrs, validateNameConsecutiveHyphens(shoot.Name, field.NewPath("metadata", "name"))...)
 	allErrs = append(allErrs, validateShootOperation(shoot.Annotations[v1beta1constants.GardenerOperation], shoot.Annotations[v1beta1constants.GardenerMaintenanceOperation], shoot, field.NewPath("metadata", "annotations"))...)
+	allErrs = append(allErrs, validateClusterAutoscalerRBACNamespace(shoot.Annotations, field.NewPath("metadata", "annotations"))...)
+	allErrs = append(allErrs, validateNodeLocalDNSHostNetwork(shoot.Annotations, shoot.Spec.SystemComponents, field.NewPath("metadata", "annotations"))...)
 	allErrs = append(allErrs, ValidateShootSpec(shoot.ObjectMeta, &shoot.Spec, field.NewPath("spec"), false)...)
 	allErrs = append(allErrs, ValidateShootHAConfig(shoot)...)
 
@@ -239,7 +275,7 @@ func ValidateShootSpec(meta metav1.ObjectMeta, spec *core.ShootSpec, fldPath *fi
 	)
 
 	allErrs = append(allErrs, validateProvider(spec.Provider, spec.Kubernetes, spec.Networking, workerless, fldPath.Child("provider"), inTemplate)...)
-	allErrs = append(allErrs, validateAddons(spec.Addons, spec.Purpose, workerless, fldPath.Child("addons"))...)
+	allErrs = append(allErrs, validateAddons(spec.Addons, spec.Kubernetes.KubeAPIServer, spec.Purpose, workerless, fldPath.Child("addons"))...)
 	allErrs = append(allErrs, validateDNS(spec.DNS, fldPath.Child("dns"))...)
 	allErrs = append(allErrs, validateExtensions(spec.Extensions, fldPath.Child("extensions"))...)
 	allErrs = append(allErrs, validateResources(spec.Resources, fldPath.Child("resources"))...)
@@ -440,7 +476,7 @@ func validateAdvertisedURL(URL string, fldPath *field.Path) field.ErrorList {
 	return allErrors
 }
 
-func validateAddons(addons *core.Addons, purpose *core.ShootPurpose, workerless bool, fldPath *field.Path) field.ErrorList {
+func validateAddons(addons *core.Addons, kubeAPIServerConfig *core.KubeAPIServerConfig, purpose *core.ShootPurpose, workerless bool, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 
 	if workerless && addons != nil {
@@ -465,12 +501,24 @@ func validateAddons(addons *core.Addons, purpose *core.ShootPurpose, workerless
 			if !availableKubernetesDashboardAuthenticationModes.Has(*authMode) {
 				allErrs = append(allErrs, field.NotSupported(fldPath.Child("kubernetesDashboard", "authenticationMode"), *authMode, sets.List(availableKubernetesDashboardAuthenticationModes)))
 			}
+
+			if *authMode == core.KubernetesDashboardAuthModeOIDC && !oidcConfigured(kubeAPIServerConfig) {
+				allErrs = append(allErrs, field.Invalid(fldPath.Child("kubernetesDashboard", "authenticationMode"), *authMode, "authentication mode 'oidc' requires an OIDC issuer URL and client ID in .spec.kubernetes.kubeAPIServer.oidcConfig"))
+			}
 		}
 	}
 
 	return allErrs
 }
 
+func oidcConfigured(kubeAPIServerConfig *core.KubeAPIServerConfig) bool {
+	if kubeAPIServerConfig == nil || kubeAPIServerConfig.OIDCConfig == nil {
+		return false
+	}
+	oidcConfig := kubeAPIServerConfig.OIDCConfig
+	return pointer.StringDeref(oidcConfig.IssuerURL, "") != "" && pointer.StringDeref(oidcConfig.ClientID, "") != ""
+}
+
 const (
 	// kube-controller-manager's default value for --node-cidr-mask-size for IPv4
 	defaultNodeCIDRMaskSizeV4 = 24
@@ -833,7 +881,7 @@ func validateKubernetes(kubernetes core.Kubernetes, networking *core.Networking,
 		}
 
 		if clusterAutoscaler := kubernetes.ClusterAutoscaler; clusterAutoscaler != nil {
-			allErrs = append(allErrs, ValidateClusterAutoscaler(*clusterAutoscaler, fldPath.Child("clusterAutoscaler"))...)
+			allErrs = append(allErrs, ValidateClusterAutoscaler(*clusterAutoscaler, kubernetes.Version, fldPath.Child("clusterAutoscaler"))...)
 		}
 
 		if verticalPodAutoscaler := kubernetes.VerticalPodAutoscaler; verticalPodAutoscaler != nil {
@@ -1015,7 +1063,7 @@ func ValidateAPIServerRequests(requests *core.APIServerRequests, fldPath *field.
 }
 
 // ValidateClusterAutoscaler validates the given ClusterAutoscaler fields.
-func ValidateClusterAutoscaler(autoScaler core.ClusterAutoscaler, fldPath *field.Path) field.ErrorList {
+func ValidateClusterAutoscaler(autoScaler core.ClusterAutoscaler, k8sVersion string, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 	if threshold := autoScaler.ScaleDownUtilizationThreshold; threshold != nil {
 		if *threshold < 0.0 {
@@ -1053,6 +1101,127 @@ func ValidateClusterAutoscaler(autoScaler core.ClusterAutoscaler, fldPath *field
 		allErrs = append(allErrs, field.Invalid(fldPath.Child("maxEmptyBulkDelete"), *maxEmptyBulkDelete, "can not be negative"))
 	}
 
+	if autoScaler.SkipNodesWithCustomControllerPods != nil {
+		if k8sGreaterEqual127, _ := versionutils.CheckVersionMeetsConstraint(k8sVersion, ">= 1.27"); !k8sGreaterEqual127 {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("skipNodesWithCustomControllerPods"), "skipNodesWithCustomControllerPods is only available for Kubernetes versions >= 1.27"))
+		}
+	}
+
+	if maxPodEvictionTime := autoScaler.MaxPodEvictionTime; maxPodEvictionTime != nil && maxPodEvictionTime.Duration < 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("maxPodEvictionTime"), *maxPodEvictionTime, "can not be negative"))
+	}
+
+	if priorityClassScaleUpDelays := autoScaler.PriorityClassScaleUpDelays; priorityClassScaleUpDelays != nil {
+		allErrs = append(allErrs, validateClusterAutoscalerPriorityClassScaleUpDelays(priorityClassScaleUpDelays, fldPath.Child("priorityClassScaleUpDelays"))...)
+	}
+
+	if ratio := autoScaler.ScaleDownCandidatesPoolRatio; ratio != nil {
+		if *ratio <= 0.0 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("scaleDownCandidatesPoolRatio"), *ratio, "must be greater than 0"))
+		}
+		if *ratio > 1.0 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("scaleDownCandidatesPoolRatio"), *ratio, "can not be greater than 1.0"))
+		}
+	}
+
+	if minCount := autoScaler.ScaleDownCandidatesPoolMinCount; minCount != nil && *minCount < 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("scaleDownCandidatesPoolMinCount"), *minCount, "can not be negative"))
+	}
+
+	return allErrs
+}
+
+// ValidateClusterAutoscalerOptions validates the cluster autoscaler options of worker pools.
+func ValidateClusterAutoscalerOptions(caOptions *core.ClusterAutoscalerOptions, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if caOptions == nil {
+		return allErrs
+	}
+
+	if threshold := caOptions.ScaleDownUtilizationThreshold; threshold != nil {
+		if *threshold < 0.0 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("scaleDownUtilizationThreshold"), *threshold, "can not be negative"))
+		}
+		if *threshold > 1.0 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("scaleDownUtilizationThreshold"), *threshold, "can not be greater than 1.0"))
+		}
+	}
+	if scaleDownUnneededTime := caOptions.ScaleDownUnneededTime; scaleDownUnneededTime != nil && scaleDownUnneededTime.Duration < 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("scaleDownUnneededTime"), *scaleDownUnneededTime, "can not be negative"))
+	}
+	if maxNodeProvisionTime := caOptions.MaxNodeProvisionTime; maxNodeProvisionTime != nil && maxNodeProvisionTime.Duration < 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("maxNodeProvisionTime"), *maxNodeProvisionTime, "can not be negative"))
+	}
+	if maxGracefulTerminationSeconds := caOptions.MaxGracefulTerminationSeconds; maxGracefulTerminationSeconds != nil && *maxGracefulTerminationSeconds < 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("maxGracefulTerminationSeconds"), *maxGracefulTerminationSeconds, "can not be negative"))
+	}
+	if zoneSplitStrategy := caOptions.ZoneSplitStrategy; zoneSplitStrategy != nil && !availableZoneSplitStrategies.Has(string(*zoneSplitStrategy)) {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("zoneSplitStrategy"), *zoneSplitStrategy, sets.List(availableZoneSplitStrategies)))
+	}
+	for zone, weight := range caOptions.ZoneWeights {
+		if weight <= 0 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("zoneWeights").Key(zone), weight, "must be positive"))
+		}
+	}
+	for zone, zoneCap := range caOptions.ZoneCaps {
+		if zoneCap < 0 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("zoneCaps").Key(zone), zoneCap, "can not be negative"))
+		}
+	}
+
+	return allErrs
+}
+
+func validateClusterAutoscalerZones(worker core.Worker, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	caOptions := worker.ClusterAutoscaler
+	if caOptions == nil {
+		return allErrs
+	}
+
+	var zoneSplitStrategy core.ZoneSplitStrategy
+	if caOptions.ZoneSplitStrategy != nil {
+		zoneSplitStrategy = *caOptions.ZoneSplitStrategy
+	}
+
+	if len(caOptions.ZoneWeights) > 0 && zoneSplitStrategy != core.ZoneSplitStrategyWeighted {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("zoneWeights"), fmt.Sprintf("can only be set for the %q zone split strategy", core.ZoneSplitStrategyWeighted)))
+	}
+	if len(caOptions.ZoneCaps) > 0 && zoneSplitStrategy != core.ZoneSplitStrategyCapped {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("zoneCaps"), fmt.Sprintf("can only be set for the %q zone split strategy", core.ZoneSplitStrategyCapped)))
+	}
+
+	workerZones := sets.New(worker.Zones...)
+	for zone := range caOptions.ZoneWeights {
+		if !workerZones.Has(zone) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("zoneWeights").Key(zone), zone, "zone is not configured for the worker pool"))
+		}
+	}
+	for zone := range caOptions.ZoneCaps {
+		if !workerZones.Has(zone) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("zoneCaps").Key(zone), zone, "zone is not configured for the worker pool"))
+		}
+	}
+
+	// The maximum of the worker pool can only be distributed if at least one zone is uncapped or the caps sum up to the
+	// maximum.
+	if zoneSplitStrategy == core.ZoneSplitStrategyCapped && len(worker.Zones) > 0 {
+		var capSum int64
+		for _, zone := range worker.Zones {
+			zoneCap, ok := caOptions.ZoneCaps[zone]
+			if !ok {
+				return allErrs
+			}
+			capSum += int64(zoneCap)
+		}
+
+		if capSum < int64(worker.Maximum) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("zoneCaps"), capSum, fmt.Sprintf("sum of the caps of all zones must not be lower than the maximum of the worker pool (%d)", worker.Maximum)))
+		}
+	}
+
 	return allErrs
 }
 
@@ -1592,6 +1761,9 @@ func ValidateWorker(worker core.Worker, kubernetes core.Kubernetes, fldPath *fie
 		allErrs = append(allErrs, ValidateArchitecture(worker.Machine.Architecture, fldPath.Child("machine", "architecture"))...)
 	}
 
+	allErrs = append(allErrs, ValidateClusterAutoscalerOptions(worker.ClusterAutoscaler, fldPath.Child("clusterAutoscaler"))...)
+	allErrs = append(allErrs, validateClusterAutoscalerZones(worker, fldPath.Child("clusterAutoscaler"))...)
+
 	return allErrs
 }
 
@@ -1778,6 +1950,30 @@ func validateClusterAutoscalerIgnoreTaints(ignoredTaints []string, fldPath *fiel
 	return allErrs
 }
 
+func validateClusterAutoscalerPriorityClassScaleUpDelays(priorityClassScaleUpDelays []core.PriorityClassScaleUpDelay, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	priorityClassNames := sets.New[string]()
+
+	for i, delay := range priorityClassScaleUpDelays {
+		idxPath := fldPath.Index(i)
+
+		for _, msg := range apivalidation.NameIsDNSSubdomain(delay.PriorityClassName, false) {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("priorityClassName"), delay.PriorityClassName, msg))
+		}
+
+		if priorityClassNames.Has(delay.PriorityClassName) {
+			allErrs = append(allErrs, field.Duplicate(idxPath.Child("priorityClassName"), delay.PriorityClassName))
+		}
+		priorityClassNames.Insert(delay.PriorityClassName)
+
+		if delay.NewPodScaleUpDelay.Duration < 0 {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("newPodScaleUpDelay"), delay.NewPodScaleUpDelay, "can not be negative"))
+		}
+	}
+
+	return allErrs
+}
+
 // https://github.com/kubernetes/kubernetes/blob/ee9079f8ec39914ff8975b5390749771b9303ea4/pkg/apis/core/validation/validation.go#L4057-L4089
 func validateTaints(taints []corev1.Taint, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
@@ -2126,6 +2322,7 @@ func ValidateSystemComponents(systemComponents *core.SystemComponents, fldPath *
 	}
 
 	allErrs = append(allErrs, validateCoreDNS(systemComponents.CoreDNS, fldPath.Child("coreDNS"))...)
+	allErrs = append(allErrs, validateNodeLocalDNS(systemComponents.NodeLocalDNS, fldPath.Child("nodeLocalDNS"))...)
 
 	return allErrs
 }
@@ -2148,6 +2345,237 @@ func validateCoreDNS(coreDNS *core.CoreDNS, fldPath *field.Path) field.ErrorList
 	return allErrs
 }
 
+// validateNodeLocalDNS validates the given node local DNS settings.
+func validateNodeLocalDNS(nodeLocalDNS *core.NodeLocalDNS, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if nodeLocalDNS == nil {
+		return allErrs
+	}
+
+	if priorityClassName := nodeLocalDNS.PriorityClassName; priorityClassName != nil {
+		for _, msg := range validation.IsDNS1123Subdomain(*priorityClassName) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("priorityClassName"), *priorityClassName, msg))
+		}
+	}
+
+	allErrs = append(allErrs, kubernetescorevalidation.ValidateTolerations(nodeLocalDNS.Tolerations, fldPath.Child("tolerations"))...)
+
+	if nodeLocalDNS.Resources != nil {
+		allErrs = append(allErrs, validateNodeLocalDNSResources(nodeLocalDNS.Resources, fldPath.Child("resources"))...)
+	}
+
+	if len(nodeLocalDNS.UpstreamServers) > 0 && pointer.BoolDeref(nodeLocalDNS.DisableForwardToUpstreamDNS, false) {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("upstreamServers"), "upstream servers must not be set if forwarding to upstream DNS is disabled"))
+	}
+
+	for i, server := range nodeLocalDNS.UpstreamServers {
+		allErrs = append(allErrs, validateNodeLocalDNSUpstreamServer(server, fldPath.Child("upstreamServers").Index(i))...)
+	}
+
+	zones := sets.New[string]()
+	for i, zone := range nodeLocalDNS.CustomZones {
+		allErrs = append(allErrs, validateNodeLocalDNSCustomZone(zone, zones, fldPath.Child("customZones").Index(i))...)
+	}
+
+	if nodeLocalDNS.ClusterDNSForward != nil {
+		allErrs = append(allErrs, validateNodeLocalDNSForward(nodeLocalDNS.ClusterDNSForward, fldPath.Child("clusterDNSForward"))...)
+	}
+	if nodeLocalDNS.UpstreamDNSForward != nil {
+		allErrs = append(allErrs, validateNodeLocalDNSForward(nodeLocalDNS.UpstreamDNSForward, fldPath.Child("upstreamDNSForward"))...)
+	}
+
+	return allErrs
+}
+
+func validateNodeLocalDNSForward(forward *core.NodeLocalDNSForward, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if forward.HealthCheckInterval != nil && forward.HealthCheckInterval.Duration <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("healthCheckInterval"), forward.HealthCheckInterval.Duration.String(), "must be positive"))
+	}
+
+	if forward.MaxConcurrent != nil && *forward.MaxConcurrent <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("maxConcurrent"), *forward.MaxConcurrent, "must be positive"))
+	}
+
+	if forward.Policy != nil && !availableNodeLocalDNSForwardPolicies.Has(*forward.Policy) {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("policy"), *forward.Policy, sets.List(availableNodeLocalDNSForwardPolicies)))
+	}
+
+	return allErrs
+}
+
+func validateNodeLocalDNSUpstreamServer(server string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	host, port, err := net.SplitHostPort(server)
+	if err != nil {
+		// the server is specified without port
+		host, port = server, ""
+	}
+
+	if net.ParseIP(host) == nil {
+		allErrs = append(allErrs, field.Invalid(fldPath, server, "must be a valid IP address, optionally with port"))
+		return allErrs
+	}
+
+	if port != "" {
+		portNumber, err := strconv.Atoi(port)
+		if err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath, server, "port must be a number"))
+			return allErrs
+		}
+		for _, msg := range validation.IsValidPortNum(portNumber) {
+			allErrs = append(allErrs, field.Invalid(fldPath, server, msg))
+		}
+	}
+
+	return allErrs
+}
+
+func validateNodeLocalDNSCustomZone(serverBlock string, zones sets.Set[string], fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	serverBlock = strings.TrimSpace(serverBlock)
+	if serverBlock == "" {
+		allErrs = append(allErrs, field.Required(fldPath, "custom zone must not be empty"))
+		return allErrs
+	}
+
+	bodyStart := strings.Index(serverBlock, "{")
+	if bodyStart == -1 {
+		allErrs = append(allErrs, field.Invalid(fldPath, serverBlock, "must be a server block with balanced braces"))
+		return allErrs
+	}
+
+	var depth int
+	for i, c := range serverBlock[bodyStart:] {
+		switch c {
+		case '{':
+			depth++
+		case '}':
+			depth--
+		}
+
+		if depth == 0 && bodyStart+i != len(serverBlock)-1 {
+			allErrs = append(allErrs, field.Invalid(fldPath, serverBlock, "must be exactly one server block"))
+			return allErrs
+		}
+	}
+	if depth != 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath, serverBlock, "must be a server block with balanced braces"))
+		return allErrs
+	}
+
+	keys := strings.FieldsFunc(serverBlock[:bodyStart], func(r rune) bool { return unicode.IsSpace(r) || r == ',' })
+	if len(keys) == 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath, serverBlock, "server block must start with at least one zone"))
+		return allErrs
+	}
+
+	for _, key := range keys {
+		zone, err := nodeLocalDNSZoneName(key)
+		if err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath, key, err.Error()))
+			continue
+		}
+
+		if isReservedNodeLocalDNSZone(zone) {
+			allErrs = append(allErrs, field.Forbidden(fldPath, fmt.Sprintf("zone %q overlaps with the server blocks of node local DNS, reserved zones are %v including their sub-domains", key, sets.List(reservedNodeLocalDNSZones))))
+			continue
+		}
+
+		if zones.Has(zone) {
+			allErrs = append(allErrs, field.Duplicate(fldPath, key))
+			continue
+		}
+		zones.Insert(zone)
+	}
+
+	return allErrs
+}
+
+// isReservedNodeLocalDNSZone returns true if the given fully qualified zone is one of the reserved zones or a sub-domain
+// of one of them (except for the root zone).
+func isReservedNodeLocalDNSZone(zone string) bool {
+	for reservedZone := range reservedNodeLocalDNSZones {
+		if zone == reservedZone || (reservedZone != "." && strings.HasSuffix(zone, "."+reservedZone)) {
+			return true
+		}
+	}
+	return false
+}
+
+// nodeLocalDNSZoneName returns the fully qualified name of the zone of the given server block key, e.g.
+// 'corp.example.com.' for 'dns://corp.example.com:53'.
+func nodeLocalDNSZoneName(key string) (string, error) {
+	if scheme, rest, found := strings.Cut(key, "://"); found {
+		if !availableNodeLocalDNSZoneSchemes.Has(scheme) {
+			return "", fmt.Errorf("scheme %q is not supported, supported schemes are %v", scheme, sets.List(availableNodeLocalDNSZoneSchemes))
+		}
+		key = rest
+	}
+
+	name := key
+	if host, port, err := net.SplitHostPort(key); err == nil {
+		portNumber, err := strconv.Atoi(port)
+		if err != nil {
+			return "", fmt.Errorf("port %q must be a number", port)
+		}
+		if msgs := validation.IsValidPortNum(portNumber); len(msgs) > 0 {
+			return "", fmt.Errorf("%s", strings.Join(msgs, ", "))
+		}
+		name = host
+	}
+
+	name = strings.ToLower(strings.TrimSuffix(name, "."))
+	if name == "" {
+		return ".", nil
+	}
+	if msgs := validation.IsDNS1123Subdomain(name); len(msgs) > 0 {
+		return "", fmt.Errorf("zone must be a valid DNS name: %s", strings.Join(msgs, ", "))
+	}
+
+	return name + ".", nil
+}
+
+func validateNodeLocalDNSResources(resources *corev1.ResourceRequirements, resourcesPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if len(resources.Claims) > 0 {
+		allErrs = append(allErrs, field.Forbidden(resourcesPath.Child("claims"), "claims are not supported"))
+	}
+
+	for _, list := range []struct {
+		resources corev1.ResourceList
+		fldPath   *field.Path
+	}{
+		{resources.Requests, resourcesPath.Child("requests")},
+		{resources.Limits, resourcesPath.Child("limits")},
+	} {
+		for name, quantity := range list.resources {
+			idxPath := list.fldPath.Key(string(name))
+
+			if !availableNodeLocalDNSResources.Has(string(name)) {
+				allErrs = append(allErrs, field.NotSupported(idxPath, name, sets.List(availableNodeLocalDNSResources)))
+				continue
+			}
+			if quantity.Sign() <= 0 {
+				allErrs = append(allErrs, field.Invalid(idxPath, quantity.String(), "must be greater than 0"))
+			}
+		}
+	}
+
+	for name, request := range resources.Requests {
+		if limit, ok := resources.Limits[name]; ok && request.Cmp(limit) > 0 {
+			allErrs = append(allErrs, field.Invalid(resourcesPath.Child("requests").Key(string(name)), request.String(), fmt.Sprintf("must be less than or equal to %s limit of %s", name, limit.String())))
+		}
+	}
+
+	return allErrs
+}
+
 // ValidateFinalizersOnCreation validates the finalizers of a Shoot object.
 func ValidateFinalizersOnCreation(finalizers []string, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
@@ -2185,6 +2613,39 @@ func ValidateCoreDNSRewritingCommonSuffixes(commonSuffixes []string, fldPath *fi
 	return allErrs
 }
 
+// validateClusterAutoscalerRBACNamespace ensures that the cluster-autoscaler only stores its status and leases in
+// kube-system or in the namespace owned by Gardener, since Gardener deletes the namespace once it is no longer used.
+func validateClusterAutoscalerRBACNamespace(annotations map[string]string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	namespace, ok := annotations[v1beta1constants.AnnotationClusterAutoscalerRBACNamespace]
+	if !ok {
+		return allErrs
+	}
+
+	if !availableClusterAutoscalerRBACNamespaces.Has(namespace) {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Key(v1beta1constants.AnnotationClusterAutoscalerRBACNamespace), namespace, sets.List(availableClusterAutoscalerRBACNamespaces)))
+	}
+
+	return allErrs
+}
+
+// validateNodeLocalDNSHostNetwork ensures that node-local-dns does not forward to the cluster DNS if it also serves pods
+// in the host network, since CoreDNS would forward the queries back to node-local-dns in this case.
+func validateNodeLocalDNSHostNetwork(annotations map[string]string, systemComponents *core.SystemComponents, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if annotations[v1beta1constants.AnnotationNodeLocalDNSHostNetwork] != "true" || systemComponents == nil || systemComponents.NodeLocalDNS == nil {
+		return allErrs
+	}
+
+	if pointer.BoolDeref(systemComponents.NodeLocalDNS.DisableForwardToUpstreamDNS, false) {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Key(v1beta1constants.AnnotationNodeLocalDNSHostNetwork), "node-local-dns must not serve pods in the host network if forwarding to upstream DNS is disabled"))
+	}
+
+	return allErrs
+}
+
 func validateShootOperation(operation, maintenanceOperation string, shoot *core.Shoot, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 
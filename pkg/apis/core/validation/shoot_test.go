@@ -669,6 +669,25 @@ var _ = Describe("Shoot Validation Tests", func() {
 			}))))
 		})
 
+		It("should allow the 'oidc' authentication mode for the kubernetes-dashboard if OIDC is configured", func() {
+			shoot.Spec.Addons.KubernetesDashboard.AuthenticationMode = pointer.String("oidc")
+
+			Expect(ValidateShoot(shoot)).To(BeEmpty())
+		})
+
+		It("should forbid the 'oidc' authentication mode for the kubernetes-dashboard if OIDC is not configured", func() {
+			shoot.Spec.Addons.KubernetesDashboard.AuthenticationMode = pointer.String("oidc")
+			shoot.Spec.Kubernetes.KubeAPIServer.OIDCConfig = nil
+
+			errorList := ValidateShoot(shoot)
+
+			Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":   Equal(field.ErrorTypeInvalid),
+				"Field":  Equal("spec.addons.kubernetesDashboard.authenticationMode"),
+				"Detail": ContainSubstring("requires an OIDC issuer URL and client ID"),
+			}))))
+		})
+
 		It("should allow external traffic policies 'Cluster' for nginx-ingress", func() {
 			v := corev1.ServiceExternalTrafficPolicyTypeCluster
 			shoot.Spec.Addons.NginxIngress.ExternalTrafficPolicy = &v
@@ -2585,8 +2604,8 @@ var _ = Describe("Shoot Validation Tests", func() {
 
 		Context("ClusterAutoscaler validation", func() {
 			DescribeTable("cluster autoscaler values",
-				func(clusterAutoscaler core.ClusterAutoscaler, supportedVersionForIgnoreTaints string, matcher gomegatypes.GomegaMatcher) {
-					Expect(ValidateClusterAutoscaler(clusterAutoscaler, nil)).To(matcher)
+				func(clusterAutoscaler core.ClusterAutoscaler, version string, matcher gomegatypes.GomegaMatcher) {
+					Expect(ValidateClusterAutoscaler(clusterAutoscaler, version, nil)).To(matcher)
 				},
 				Entry("valid", core.ClusterAutoscaler{}, version, BeEmpty()),
 				Entry("valid with threshold", core.ClusterAutoscaler{
@@ -2671,6 +2690,56 @@ var _ = Describe("Shoot Validation Tests", func() {
 				Entry("invalid with negative maxEmptyBulkDelete", core.ClusterAutoscaler{
 					MaxEmptyBulkDelete: &negativeInteger,
 				}, version, ConsistOf(field.Invalid(field.NewPath("maxEmptyBulkDelete"), negativeInteger, "can not be negative"))),
+				Entry("valid with skipNodesWithCustomControllerPods", core.ClusterAutoscaler{
+					SkipNodesWithCustomControllerPods: pointer.Bool(false),
+				}, "1.27.0", BeEmpty()),
+				Entry("forbidden with skipNodesWithCustomControllerPods for Kubernetes < 1.27", core.ClusterAutoscaler{
+					SkipNodesWithCustomControllerPods: pointer.Bool(false),
+				}, "1.26.5", ConsistOf(field.Forbidden(field.NewPath("skipNodesWithCustomControllerPods"), "skipNodesWithCustomControllerPods is only available for Kubernetes versions >= 1.27"))),
+				Entry("valid with maxPodEvictionTime", core.ClusterAutoscaler{
+					MaxPodEvictionTime: &metav1.Duration{Duration: 5 * time.Minute},
+				}, version, BeEmpty()),
+				Entry("invalid with negative maxPodEvictionTime", core.ClusterAutoscaler{
+					MaxPodEvictionTime: &negativeDuration,
+				}, version, ConsistOf(field.Invalid(field.NewPath("maxPodEvictionTime"), negativeDuration, "can not be negative"))),
+				Entry("valid with scale-down candidates pool settings", core.ClusterAutoscaler{
+					ScaleDownCandidatesPoolRatio:    pointer.Float64(0.05),
+					ScaleDownCandidatesPoolMinCount: pointer.Int32(20),
+				}, version, BeEmpty()),
+				Entry("invalid with zero scaleDownCandidatesPoolRatio", core.ClusterAutoscaler{
+					ScaleDownCandidatesPoolRatio: pointer.Float64(0),
+				}, version, ConsistOf(field.Invalid(field.NewPath("scaleDownCandidatesPoolRatio"), float64(0), "must be greater than 0"))),
+				Entry("invalid with scaleDownCandidatesPoolRatio > 1", core.ClusterAutoscaler{
+					ScaleDownCandidatesPoolRatio: pointer.Float64(1.5),
+				}, version, ConsistOf(field.Invalid(field.NewPath("scaleDownCandidatesPoolRatio"), 1.5, "can not be greater than 1.0"))),
+				Entry("invalid with negative scaleDownCandidatesPoolMinCount", core.ClusterAutoscaler{
+					ScaleDownCandidatesPoolMinCount: &negativeInteger,
+				}, version, ConsistOf(field.Invalid(field.NewPath("scaleDownCandidatesPoolMinCount"), negativeInteger, "can not be negative"))),
+				Entry("valid with priorityClassScaleUpDelays", core.ClusterAutoscaler{
+					PriorityClassScaleUpDelays: []core.PriorityClassScaleUpDelay{
+						{PriorityClassName: "batch", NewPodScaleUpDelay: metav1.Duration{Duration: 5 * time.Minute}},
+						{PriorityClassName: "critical", NewPodScaleUpDelay: metav1.Duration{}},
+					},
+				}, version, BeEmpty()),
+				Entry("invalid with negative newPodScaleUpDelay in priorityClassScaleUpDelays", core.ClusterAutoscaler{
+					PriorityClassScaleUpDelays: []core.PriorityClassScaleUpDelay{
+						{PriorityClassName: "batch", NewPodScaleUpDelay: negativeDuration},
+					},
+				}, version, ConsistOf(field.Invalid(field.NewPath("priorityClassScaleUpDelays[0].newPodScaleUpDelay"), negativeDuration, "can not be negative"))),
+				Entry("invalid with duplicate priority class names in priorityClassScaleUpDelays", core.ClusterAutoscaler{
+					PriorityClassScaleUpDelays: []core.PriorityClassScaleUpDelay{
+						{PriorityClassName: "batch", NewPodScaleUpDelay: metav1.Duration{Duration: time.Minute}},
+						{PriorityClassName: "batch", NewPodScaleUpDelay: metav1.Duration{Duration: 2 * time.Minute}},
+					},
+				}, version, ConsistOf(field.Duplicate(field.NewPath("priorityClassScaleUpDelays[1].priorityClassName"), "batch"))),
+				Entry("invalid with invalid priority class name in priorityClassScaleUpDelays", core.ClusterAutoscaler{
+					PriorityClassScaleUpDelays: []core.PriorityClassScaleUpDelay{
+						{PriorityClassName: "Batch_Jobs", NewPodScaleUpDelay: metav1.Duration{Duration: time.Minute}},
+					},
+				}, version, ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("priorityClassScaleUpDelays[0].priorityClassName"),
+				})))),
 			)
 
 			Describe("taint validation", func() {
@@ -2680,7 +2749,7 @@ var _ = Describe("Shoot Validation Tests", func() {
 				)
 
 				It("should allow empty ignore taints list", func() {
-					errList := ValidateClusterAutoscaler(clusterAutoscaler, fldPath)
+					errList := ValidateClusterAutoscaler(clusterAutoscaler, version, fldPath)
 
 					Expect(errList).To(BeEmpty())
 				})
@@ -2691,7 +2760,7 @@ var _ = Describe("Shoot Validation Tests", func() {
 						"allowed-2",
 					}
 
-					errList := ValidateClusterAutoscaler(clusterAutoscaler, fldPath)
+					errList := ValidateClusterAutoscaler(clusterAutoscaler, version, fldPath)
 
 					Expect(errList).To(BeEmpty())
 				})
@@ -2702,7 +2771,7 @@ var _ = Describe("Shoot Validation Tests", func() {
 						"allowed-1",
 					}
 
-					errList := ValidateClusterAutoscaler(clusterAutoscaler, fldPath)
+					errList := ValidateClusterAutoscaler(clusterAutoscaler, version, fldPath)
 
 					Expect(errList).To(ConsistOf(
 						PointTo(MatchFields(IgnoreExtras, Fields{
@@ -3570,6 +3639,208 @@ var _ = Describe("Shoot Validation Tests", func() {
 				Entry("incorrect core dns autoscaler", &core.SystemComponents{CoreDNS: &core.CoreDNS{Autoscaling: &core.CoreDNSAutoscaling{Mode: "dummy"}}}, false, ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
 					"Type": Equal(field.ErrorTypeNotSupported),
 				})))),
+				Entry("node local dns without resources", &core.SystemComponents{NodeLocalDNS: &core.NodeLocalDNS{Enabled: true}}, false, BeEmpty()),
+				Entry("node local dns with valid resources", &core.SystemComponents{NodeLocalDNS: &core.NodeLocalDNS{Enabled: true, Resources: &corev1.ResourceRequirements{
+					Requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("100m"), corev1.ResourceMemory: resource.MustParse("200Mi")},
+					Limits:   corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("1Gi")},
+				}}}, false, BeEmpty()),
+				Entry("node local dns with unsupported resources and claims", &core.SystemComponents{NodeLocalDNS: &core.NodeLocalDNS{Enabled: true, Resources: &corev1.ResourceRequirements{
+					Requests: corev1.ResourceList{corev1.ResourceEphemeralStorage: resource.MustParse("1Gi")},
+					Claims:   []corev1.ResourceClaim{{Name: "foo"}},
+				}}}, false, ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeForbidden),
+						"Field": Equal("nodeLocalDNS.resources.claims"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeNotSupported),
+						"Field": Equal("nodeLocalDNS.resources.requests[ephemeral-storage]"),
+					})),
+				)),
+				Entry("node local dns with non-positive resources", &core.SystemComponents{NodeLocalDNS: &core.NodeLocalDNS{Enabled: true, Resources: &corev1.ResourceRequirements{
+					Requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("0")},
+					Limits:   corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("-1Mi")},
+				}}}, false, ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("nodeLocalDNS.resources.requests[cpu]"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("nodeLocalDNS.resources.limits[memory]"),
+					})),
+				)),
+				Entry("node local dns with requests exceeding limits", &core.SystemComponents{NodeLocalDNS: &core.NodeLocalDNS{Enabled: true, Resources: &corev1.ResourceRequirements{
+					Requests: corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("200Mi")},
+					Limits:   corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("100Mi")},
+				}}}, false, ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":   Equal(field.ErrorTypeInvalid),
+					"Field":  Equal("nodeLocalDNS.resources.requests[memory]"),
+					"Detail": Equal("must be less than or equal to memory limit of 100Mi"),
+				})))),
+				Entry("node local dns with valid scheduling settings", &core.SystemComponents{NodeLocalDNS: &core.NodeLocalDNS{
+					Enabled:                 true,
+					PriorityClassName:       pointer.String("gardener-shoot-system-800"),
+					Tolerations:             []corev1.Toleration{{Key: "node.kubernetes.io/windows", Operator: corev1.TolerationOpEqual, Value: "true", Effect: corev1.TaintEffectNoSchedule}},
+					EnableDaemonSetEviction: pointer.Bool(true),
+				}}, false, BeEmpty()),
+				Entry("node local dns with invalid priority class name", &core.SystemComponents{NodeLocalDNS: &core.NodeLocalDNS{
+					Enabled:           true,
+					PriorityClassName: pointer.String("Foo_Bar"),
+				}}, false, ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("nodeLocalDNS.priorityClassName"),
+				})))),
+				Entry("node local dns with invalid tolerations", &core.SystemComponents{NodeLocalDNS: &core.NodeLocalDNS{
+					Enabled:     true,
+					Tolerations: []corev1.Toleration{{Operator: corev1.TolerationOpEqual, Value: "foo"}, {Key: "foo", Operator: "In"}},
+				}}, false, ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("nodeLocalDNS.tolerations[0].operator"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeNotSupported),
+						"Field": Equal("nodeLocalDNS.tolerations[1].operator"),
+					})),
+				)),
+				Entry("node local dns with valid upstream servers and custom zones", &core.SystemComponents{NodeLocalDNS: &core.NodeLocalDNS{
+					Enabled:         true,
+					UpstreamServers: []string{"10.0.0.53", "10.0.0.54:5353", "[2001:db8::53]:53"},
+					CustomZones:     []string{"corp.example.com:53 {\n    forward . 10.1.0.53\n}", "dns://other.example.com:53, example.org. {\n    forward . 10.1.0.54\n}"},
+				}}, false, BeEmpty()),
+				Entry("node local dns with invalid upstream servers", &core.SystemComponents{NodeLocalDNS: &core.NodeLocalDNS{
+					Enabled:         true,
+					UpstreamServers: []string{"dns.example.com", "10.0.0.53:99999"},
+				}}, false, ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("nodeLocalDNS.upstreamServers[0]"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("nodeLocalDNS.upstreamServers[1]"),
+					})),
+				)),
+				Entry("node local dns with upstream servers but disabled forwarding to upstream dns", &core.SystemComponents{NodeLocalDNS: &core.NodeLocalDNS{
+					Enabled:                     true,
+					DisableForwardToUpstreamDNS: pointer.Bool(true),
+					UpstreamServers:             []string{"10.0.0.53"},
+				}}, false, ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("nodeLocalDNS.upstreamServers"),
+				})))),
+				Entry("node local dns with valid forward settings", &core.SystemComponents{NodeLocalDNS: &core.NodeLocalDNS{
+					Enabled:            true,
+					ClusterDNSForward:  &core.NodeLocalDNSForward{HealthCheckInterval: &metav1.Duration{Duration: 100 * time.Millisecond}, MaxConcurrent: pointer.Int32(1000), Policy: pointer.String("sequential")},
+					UpstreamDNSForward: &core.NodeLocalDNSForward{Policy: pointer.String("round_robin")},
+				}}, false, BeEmpty()),
+				Entry("node local dns with invalid forward settings", &core.SystemComponents{NodeLocalDNS: &core.NodeLocalDNS{
+					Enabled:            true,
+					ClusterDNSForward:  &core.NodeLocalDNSForward{HealthCheckInterval: &metav1.Duration{}, MaxConcurrent: pointer.Int32(0)},
+					UpstreamDNSForward: &core.NodeLocalDNSForward{Policy: pointer.String("fastest")},
+				}}, false, ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("nodeLocalDNS.clusterDNSForward.healthCheckInterval"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("nodeLocalDNS.clusterDNSForward.maxConcurrent"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeNotSupported),
+						"Field": Equal("nodeLocalDNS.upstreamDNSForward.policy"),
+					})),
+				)),
+				Entry("node local dns with invalid custom zones", &core.SystemComponents{NodeLocalDNS: &core.NodeLocalDNS{
+					Enabled:     true,
+					CustomZones: []string{" ", "corp.example.com:53 {", "corp.example.com:53", "} x {", "a {\n}\nb {\n}", "{\n}"},
+				}}, false, ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("nodeLocalDNS.customZones[0]"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("nodeLocalDNS.customZones[1]"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("nodeLocalDNS.customZones[2]"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("nodeLocalDNS.customZones[3]"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("nodeLocalDNS.customZones[4]"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("nodeLocalDNS.customZones[5]"),
+					})),
+				)),
+				Entry("node local dns with custom zones with invalid names", &core.SystemComponents{NodeLocalDNS: &core.NodeLocalDNS{
+					Enabled:     true,
+					CustomZones: []string{"corp_example.com {\n}", "ftp://corp.example.com {\n}", "corp.example.com:port {\n}"},
+				}}, false, ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":     Equal(field.ErrorTypeInvalid),
+						"Field":    Equal("nodeLocalDNS.customZones[0]"),
+						"BadValue": Equal("corp_example.com"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":     Equal(field.ErrorTypeInvalid),
+						"Field":    Equal("nodeLocalDNS.customZones[1]"),
+						"BadValue": Equal("ftp://corp.example.com"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":     Equal(field.ErrorTypeInvalid),
+						"Field":    Equal("nodeLocalDNS.customZones[2]"),
+						"BadValue": Equal("corp.example.com:port"),
+					})),
+				)),
+				Entry("node local dns with reserved custom zones", &core.SystemComponents{NodeLocalDNS: &core.NodeLocalDNS{
+					Enabled:     true,
+					CustomZones: []string{". {\n}", ".:53 {\n}", "cluster.local:53 {\n}", "foo.cluster.local {\n}", "10.in-addr.arpa {\n}", "dns://ip6.arpa. {\n}"},
+				}}, false, ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeForbidden),
+						"Field": Equal("nodeLocalDNS.customZones[0]"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeForbidden),
+						"Field": Equal("nodeLocalDNS.customZones[1]"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeForbidden),
+						"Field": Equal("nodeLocalDNS.customZones[2]"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeForbidden),
+						"Field": Equal("nodeLocalDNS.customZones[3]"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeForbidden),
+						"Field": Equal("nodeLocalDNS.customZones[4]"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeForbidden),
+						"Field": Equal("nodeLocalDNS.customZones[5]"),
+					})),
+				)),
+				Entry("node local dns with duplicate custom zones", &core.SystemComponents{NodeLocalDNS: &core.NodeLocalDNS{
+					Enabled:     true,
+					CustomZones: []string{"corp.example.com:53 {\n}", "other.example.com Corp.Example.com. {\n}"},
+				}}, false, ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":     Equal(field.ErrorTypeDuplicate),
+						"Field":    Equal("nodeLocalDNS.customZones[1]"),
+						"BadValue": Equal("Corp.Example.com."),
+					})),
+				)),
 			)
 		})
 
@@ -3597,6 +3868,45 @@ var _ = Describe("Shoot Validation Tests", func() {
 			)
 		})
 
+		Context("cluster-autoscaler RBAC namespace", func() {
+			It("should allow the namespaces owned by gardener", func() {
+				metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, "alpha.featuregates.shoot.gardener.cloud/cluster-autoscaler-rbac-namespace", "gardener-cluster-autoscaler")
+				Expect(ValidateShoot(shoot)).To(BeEmpty())
+
+				metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, "alpha.featuregates.shoot.gardener.cloud/cluster-autoscaler-rbac-namespace", "kube-system")
+				Expect(ValidateShoot(shoot)).To(BeEmpty())
+			})
+
+			It("should forbid other namespaces", func() {
+				metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, "alpha.featuregates.shoot.gardener.cloud/cluster-autoscaler-rbac-namespace", "default")
+
+				Expect(ValidateShoot(shoot)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("metadata.annotations[alpha.featuregates.shoot.gardener.cloud/cluster-autoscaler-rbac-namespace]"),
+				}))))
+			})
+		})
+
+		Context("node-local-dns for pods in the host network", func() {
+			BeforeEach(func() {
+				metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, "alpha.featuregates.shoot.gardener.cloud/node-local-dns-host-network", "true")
+				shoot.Spec.SystemComponents = &core.SystemComponents{NodeLocalDNS: &core.NodeLocalDNS{Enabled: true}}
+			})
+
+			It("should allow serving pods in the host network", func() {
+				Expect(ValidateShoot(shoot)).To(BeEmpty())
+			})
+
+			It("should forbid serving pods in the host network if forwarding to upstream DNS is disabled", func() {
+				shoot.Spec.SystemComponents.NodeLocalDNS.DisableForwardToUpstreamDNS = pointer.Bool(true)
+
+				Expect(ValidateShoot(shoot)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("metadata.annotations[alpha.featuregates.shoot.gardener.cloud/node-local-dns-host-network]"),
+				}))))
+			})
+		})
+
 		Context("operation validation", func() {
 			It("should do nothing if the operation annotation is not set", func() {
 				Expect(ValidateShoot(shoot)).To(BeEmpty())
@@ -5510,6 +5820,135 @@ var _ = Describe("Shoot Validation Tests", func() {
 			})))),
 		)
 
+		var (
+			zoneSplitStrategyWeighted = core.ZoneSplitStrategyWeighted
+			zoneSplitStrategyCapped   = core.ZoneSplitStrategyCapped
+			zoneSplitStrategyRandom   = core.ZoneSplitStrategy("Random")
+		)
+
+		DescribeTable("validate cluster autoscaler options",
+			func(caOptions *core.ClusterAutoscalerOptions, matcher gomegatypes.GomegaMatcher) {
+				maxSurge := intstr.FromInt32(1)
+				maxUnavailable := intstr.FromInt32(0)
+				worker := core.Worker{
+					Name: "worker-name",
+					Machine: core.Machine{
+						Type: "large",
+						Image: &core.ShootMachineImage{
+							Name:    "image-name",
+							Version: "1.0.0",
+						},
+						Architecture: pointer.String("amd64"),
+					},
+					MaxSurge:          &maxSurge,
+					MaxUnavailable:    &maxUnavailable,
+					Zones:             []string{"zone-a", "zone-b"},
+					ClusterAutoscaler: caOptions,
+				}
+				Expect(ValidateWorker(worker, core.Kubernetes{Version: ""}, field.NewPath("worker"), false)).To(matcher)
+			},
+
+			Entry("nil options", nil, BeEmpty()),
+			Entry("valid options", &core.ClusterAutoscalerOptions{
+				ScaleDownUtilizationThreshold: pointer.Float64(0.3),
+				ScaleDownUnneededTime:         &metav1.Duration{Duration: time.Minute},
+				MaxNodeProvisionTime:          &metav1.Duration{Duration: 10 * time.Minute},
+				MaxGracefulTerminationSeconds: pointer.Int32(3600),
+			}, BeEmpty()),
+			Entry("invalid negative threshold", &core.ClusterAutoscalerOptions{
+				ScaleDownUtilizationThreshold: pointer.Float64(-0.5),
+			}, ConsistOf(field.Invalid(field.NewPath("worker.clusterAutoscaler.scaleDownUtilizationThreshold"), -0.5, "can not be negative"))),
+			Entry("invalid > 1 threshold", &core.ClusterAutoscalerOptions{
+				ScaleDownUtilizationThreshold: pointer.Float64(1.5),
+			}, ConsistOf(field.Invalid(field.NewPath("worker.clusterAutoscaler.scaleDownUtilizationThreshold"), 1.5, "can not be greater than 1.0"))),
+			Entry("invalid negative durations", &core.ClusterAutoscalerOptions{
+				ScaleDownUnneededTime: &metav1.Duration{Duration: -time.Minute},
+				MaxNodeProvisionTime:  &metav1.Duration{Duration: -time.Minute},
+			}, ConsistOf(
+				field.Invalid(field.NewPath("worker.clusterAutoscaler.scaleDownUnneededTime"), metav1.Duration{Duration: -time.Minute}, "can not be negative"),
+				field.Invalid(field.NewPath("worker.clusterAutoscaler.maxNodeProvisionTime"), metav1.Duration{Duration: -time.Minute}, "can not be negative"),
+			)),
+			Entry("invalid negative maxGracefulTerminationSeconds", &core.ClusterAutoscalerOptions{
+				MaxGracefulTerminationSeconds: pointer.Int32(-1),
+			}, ConsistOf(field.Invalid(field.NewPath("worker.clusterAutoscaler.maxGracefulTerminationSeconds"), int32(-1), "can not be negative"))),
+			Entry("valid weighted zone split options", &core.ClusterAutoscalerOptions{
+				ZoneSplitStrategy: &zoneSplitStrategyWeighted,
+				ZoneWeights:       map[string]int32{"zone-a": 2, "zone-b": 1},
+			}, BeEmpty()),
+			Entry("valid capped zone split options", &core.ClusterAutoscalerOptions{
+				ZoneSplitStrategy: &zoneSplitStrategyCapped,
+				ZoneCaps:          map[string]int32{"zone-a": 10},
+			}, BeEmpty()),
+			Entry("invalid zone split strategy", &core.ClusterAutoscalerOptions{
+				ZoneSplitStrategy: &zoneSplitStrategyRandom,
+			}, ConsistOf(field.NotSupported(field.NewPath("worker.clusterAutoscaler.zoneSplitStrategy"), core.ZoneSplitStrategy("Random"), []string{"Capped", "Even", "Weighted"}))),
+			Entry("invalid non-positive zone weights", &core.ClusterAutoscalerOptions{
+				ZoneSplitStrategy: &zoneSplitStrategyWeighted,
+				ZoneWeights:       map[string]int32{"zone-a": 0},
+			}, ConsistOf(
+				field.Invalid(field.NewPath("worker.clusterAutoscaler.zoneWeights").Key("zone-a"), int32(0), "must be positive"),
+			)),
+			Entry("invalid negative zone caps", &core.ClusterAutoscalerOptions{
+				ZoneSplitStrategy: &zoneSplitStrategyCapped,
+				ZoneCaps:          map[string]int32{"zone-b": -2},
+			}, ConsistOf(
+				field.Invalid(field.NewPath("worker.clusterAutoscaler.zoneCaps").Key("zone-b"), int32(-2), "can not be negative"),
+			)),
+			Entry("invalid zone weights for unknown zones", &core.ClusterAutoscalerOptions{
+				ZoneSplitStrategy: &zoneSplitStrategyWeighted,
+				ZoneWeights:       map[string]int32{"zone-c": 1},
+			}, ConsistOf(
+				field.Invalid(field.NewPath("worker.clusterAutoscaler.zoneWeights").Key("zone-c"), "zone-c", "zone is not configured for the worker pool"),
+			)),
+			Entry("invalid zone caps for unknown zones", &core.ClusterAutoscalerOptions{
+				ZoneSplitStrategy: &zoneSplitStrategyCapped,
+				ZoneCaps:          map[string]int32{"zone-d": 1},
+			}, ConsistOf(
+				field.Invalid(field.NewPath("worker.clusterAutoscaler.zoneCaps").Key("zone-d"), "zone-d", "zone is not configured for the worker pool"),
+			)),
+			Entry("forbidden zone weights and caps without matching zone split strategy", &core.ClusterAutoscalerOptions{
+				ZoneWeights: map[string]int32{"zone-a": 1},
+				ZoneCaps:    map[string]int32{"zone-b": 1},
+			}, ConsistOf(
+				field.Forbidden(field.NewPath("worker.clusterAutoscaler.zoneWeights"), `can only be set for the "Weighted" zone split strategy`),
+				field.Forbidden(field.NewPath("worker.clusterAutoscaler.zoneCaps"), `can only be set for the "Capped" zone split strategy`),
+			)),
+		)
+
+		DescribeTable("validate zone caps against the maximum of the worker pool",
+			func(zoneCaps map[string]int32, matcher gomegatypes.GomegaMatcher) {
+				maxSurge := intstr.FromInt32(1)
+				maxUnavailable := intstr.FromInt32(0)
+				worker := core.Worker{
+					Name: "worker-name",
+					Machine: core.Machine{
+						Type: "large",
+						Image: &core.ShootMachineImage{
+							Name:    "image-name",
+							Version: "1.0.0",
+						},
+						Architecture: pointer.String("amd64"),
+					},
+					Minimum:        2,
+					Maximum:        10,
+					MaxSurge:       &maxSurge,
+					MaxUnavailable: &maxUnavailable,
+					Zones:          []string{"zone-a", "zone-b"},
+					ClusterAutoscaler: &core.ClusterAutoscalerOptions{
+						ZoneSplitStrategy: &zoneSplitStrategyCapped,
+						ZoneCaps:          zoneCaps,
+					},
+				}
+				Expect(ValidateWorker(worker, core.Kubernetes{Version: ""}, field.NewPath("worker"), false)).To(matcher)
+			},
+
+			Entry("one zone without cap", map[string]int32{"zone-a": 1}, BeEmpty()),
+			Entry("caps summing up to the maximum", map[string]int32{"zone-a": 4, "zone-b": 6}, BeEmpty()),
+			Entry("caps lower than the maximum", map[string]int32{"zone-a": 3, "zone-b": 4}, ConsistOf(
+				field.Invalid(field.NewPath("worker.clusterAutoscaler.zoneCaps"), int64(7), "sum of the caps of all zones must not be lower than the maximum of the worker pool (10)"),
+			)),
+		)
+
 		It("validate that container runtime has a type", func() {
 			worker := core.Worker{
 				Name: "worker",
@@ -739,6 +739,36 @@ func (in *ClusterAutoscaler) DeepCopyInto(out *ClusterAutoscaler) {
 		*out = new(int32)
 		**out = **in
 	}
+	if in.SkipNodesWithCustomControllerPods != nil {
+		in, out := &in.SkipNodesWithCustomControllerPods, &out.SkipNodesWithCustomControllerPods
+		*out = new(bool)
+		**out = **in
+	}
+	if in.MaxPodEvictionTime != nil {
+		in, out := &in.MaxPodEvictionTime, &out.MaxPodEvictionTime
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.PriorityClassScaleUpDelays != nil {
+		in, out := &in.PriorityClassScaleUpDelays, &out.PriorityClassScaleUpDelays
+		*out = make([]PriorityClassScaleUpDelay, len(*in))
+		copy(*out, *in)
+	}
+	if in.CordonNodeBeforeTerminating != nil {
+		in, out := &in.CordonNodeBeforeTerminating, &out.CordonNodeBeforeTerminating
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ScaleDownCandidatesPoolRatio != nil {
+		in, out := &in.ScaleDownCandidatesPoolRatio, &out.ScaleDownCandidatesPoolRatio
+		*out = new(float64)
+		**out = **in
+	}
+	if in.ScaleDownCandidatesPoolMinCount != nil {
+		in, out := &in.ScaleDownCandidatesPoolMinCount, &out.ScaleDownCandidatesPoolMinCount
+		*out = new(int32)
+		**out = **in
+	}
 	return
 }
 
@@ -752,6 +782,61 @@ func (in *ClusterAutoscaler) DeepCopy() *ClusterAutoscaler {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterAutoscalerOptions) DeepCopyInto(out *ClusterAutoscalerOptions) {
+	*out = *in
+	if in.ScaleDownUtilizationThreshold != nil {
+		in, out := &in.ScaleDownUtilizationThreshold, &out.ScaleDownUtilizationThreshold
+		*out = new(float64)
+		**out = **in
+	}
+	if in.ScaleDownUnneededTime != nil {
+		in, out := &in.ScaleDownUnneededTime, &out.ScaleDownUnneededTime
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.MaxNodeProvisionTime != nil {
+		in, out := &in.MaxNodeProvisionTime, &out.MaxNodeProvisionTime
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.MaxGracefulTerminationSeconds != nil {
+		in, out := &in.MaxGracefulTerminationSeconds, &out.MaxGracefulTerminationSeconds
+		*out = new(int32)
+		**out = **in
+	}
+	if in.ZoneSplitStrategy != nil {
+		in, out := &in.ZoneSplitStrategy, &out.ZoneSplitStrategy
+		*out = new(ZoneSplitStrategy)
+		**out = **in
+	}
+	if in.ZoneWeights != nil {
+		in, out := &in.ZoneWeights, &out.ZoneWeights
+		*out = make(map[string]int32, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.ZoneCaps != nil {
+		in, out := &in.ZoneCaps, &out.ZoneCaps
+		*out = make(map[string]int32, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterAutoscalerOptions.
+func (in *ClusterAutoscalerOptions) DeepCopy() *ClusterAutoscalerOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterAutoscalerOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Condition) DeepCopyInto(out *Condition) {
 	*out = *in
@@ -2973,6 +3058,48 @@ func (in *NodeLocalDNS) DeepCopyInto(out *NodeLocalDNS) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = new(v1.ResourceRequirements)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PriorityClassName != nil {
+		in, out := &in.PriorityClassName, &out.PriorityClassName
+		*out = new(string)
+		**out = **in
+	}
+	if in.Tolerations != nil {
+		in, out := &in.Tolerations, &out.Tolerations
+		*out = make([]v1.Toleration, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.EnableDaemonSetEviction != nil {
+		in, out := &in.EnableDaemonSetEviction, &out.EnableDaemonSetEviction
+		*out = new(bool)
+		**out = **in
+	}
+	if in.UpstreamServers != nil {
+		in, out := &in.UpstreamServers, &out.UpstreamServers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.CustomZones != nil {
+		in, out := &in.CustomZones, &out.CustomZones
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ClusterDNSForward != nil {
+		in, out := &in.ClusterDNSForward, &out.ClusterDNSForward
+		*out = new(NodeLocalDNSForward)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.UpstreamDNSForward != nil {
+		in, out := &in.UpstreamDNSForward, &out.UpstreamDNSForward
+		*out = new(NodeLocalDNSForward)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -2986,6 +3113,37 @@ func (in *NodeLocalDNS) DeepCopy() *NodeLocalDNS {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeLocalDNSForward) DeepCopyInto(out *NodeLocalDNSForward) {
+	*out = *in
+	if in.HealthCheckInterval != nil {
+		in, out := &in.HealthCheckInterval, &out.HealthCheckInterval
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.MaxConcurrent != nil {
+		in, out := &in.MaxConcurrent, &out.MaxConcurrent
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Policy != nil {
+		in, out := &in.Policy, &out.Policy
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeLocalDNSForward.
+func (in *NodeLocalDNSForward) DeepCopy() *NodeLocalDNSForward {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeLocalDNSForward)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *OIDCConfig) DeepCopyInto(out *OIDCConfig) {
 	*out = *in
@@ -3106,6 +3264,23 @@ func (in *OpenIDConnectClientAuthentication) DeepCopy() *OpenIDConnectClientAuth
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PriorityClassScaleUpDelay) DeepCopyInto(out *PriorityClassScaleUpDelay) {
+	*out = *in
+	out.NewPodScaleUpDelay = in.NewPodScaleUpDelay
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PriorityClassScaleUpDelay.
+func (in *PriorityClassScaleUpDelay) DeepCopy() *PriorityClassScaleUpDelay {
+	if in == nil {
+		return nil
+	}
+	out := new(PriorityClassScaleUpDelay)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Project) DeepCopyInto(out *Project) {
 	*out = *in
@@ -5167,6 +5342,11 @@ func (in *Worker) DeepCopyInto(out *Worker) {
 			(*out)[key] = val
 		}
 	}
+	if in.ClusterAutoscaler != nil {
+		in, out := &in.ClusterAutoscaler, &out.ClusterAutoscaler
+		*out = new(ClusterAutoscalerOptions)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
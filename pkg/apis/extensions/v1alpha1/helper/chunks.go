@@ -0,0 +1,80 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package helper
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+)
+
+const (
+	// FileContentChunkSecretNamePrefix is the prefix of the names of the secrets containing the data of file content
+	// chunks.
+	FileContentChunkSecretNamePrefix = "osc-chunk-"
+	// DataKeyFileContentChunk is the key in the data of a file content chunk secret containing the chunk's data.
+	DataKeyFileContentChunk = "chunk"
+)
+
+// FileContentChunkSecretName returns the name of the secret containing the data of the file content chunk with the
+// given digest.
+func FileContentChunkSecretName(digest string) string {
+	return FileContentChunkSecretNamePrefix + digest
+}
+
+// SplitIntoFileContentChunks splits the given data into content-addressed chunks of at most chunkSize bytes. It returns
+// the chunks in order and their data keyed by digest. Chunks with identical data are only returned once in the map.
+func SplitIntoFileContentChunks(data []byte, chunkSize int) ([]extensionsv1alpha1.FileContentChunk, map[string][]byte, error) {
+	if chunkSize <= 0 {
+		return nil, nil, fmt.Errorf("chunk size must be positive, got %d", chunkSize)
+	}
+
+	var (
+		chunks    []extensionsv1alpha1.FileContentChunk
+		chunkData = make(map[string][]byte)
+	)
+
+	for offset := 0; offset < len(data); offset += chunkSize {
+		end := offset + chunkSize
+		if end > len(data) {
+			end = len(data)
+		}
+
+		digest := FileContentChunkDigest(data[offset:end])
+		chunks = append(chunks, extensionsv1alpha1.FileContentChunk{Digest: digest, Size: int64(end - offset)})
+		chunkData[digest] = data[offset:end]
+	}
+
+	return chunks, chunkData, nil
+}
+
+// FileContentChunkDigest computes the digest of the given chunk data.
+func FileContentChunkDigest(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+// VerifyFileContentChunk verifies that the given data matches the size and digest of the given chunk.
+func VerifyFileContentChunk(chunk extensionsv1alpha1.FileContentChunk, data []byte) error {
+	if int64(len(data)) != chunk.Size {
+		return fmt.Errorf("size of chunk %s does not match: expected %d bytes, got %d bytes", chunk.Digest, chunk.Size, len(data))
+	}
+	if digest := FileContentChunkDigest(data); digest != chunk.Digest {
+		return fmt.Errorf("digest of chunk does not match: expected %s, got %s", chunk.Digest, digest)
+	}
+	return nil
+}
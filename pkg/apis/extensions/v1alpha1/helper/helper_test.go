@@ -103,3 +103,49 @@ var _ = Describe("filecodec", func() {
 		Entry("base64", extensionsv1alpha1.FileContentInline{Encoding: "b64", Data: "base64 data input"}),
 	)
 })
+
+var _ = Describe("chunks", func() {
+	Describe("#SplitIntoFileContentChunks", func() {
+		It("should split the data into content-addressed chunks", func() {
+			chunks, chunkData, err := SplitIntoFileContentChunks([]byte("foobarfoob"), 3)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(chunks).To(Equal([]extensionsv1alpha1.FileContentChunk{
+				{Digest: FileContentChunkDigest([]byte("foo")), Size: 3},
+				{Digest: FileContentChunkDigest([]byte("bar")), Size: 3},
+				{Digest: FileContentChunkDigest([]byte("foo")), Size: 3},
+				{Digest: FileContentChunkDigest([]byte("b")), Size: 1},
+			}))
+			Expect(chunkData).To(Equal(map[string][]byte{
+				FileContentChunkDigest([]byte("foo")): []byte("foo"),
+				FileContentChunkDigest([]byte("bar")): []byte("bar"),
+				FileContentChunkDigest([]byte("b")):   []byte("b"),
+			}))
+		})
+
+		It("should fail for a non-positive chunk size", func() {
+			_, _, err := SplitIntoFileContentChunks([]byte("foo"), 0)
+			Expect(err).To(MatchError(ContainSubstring("chunk size must be positive")))
+		})
+	})
+
+	Describe("#VerifyFileContentChunk", func() {
+		chunk := extensionsv1alpha1.FileContentChunk{Digest: FileContentChunkDigest([]byte("foo")), Size: 3}
+
+		It("should succeed for matching data", func() {
+			Expect(VerifyFileContentChunk(chunk, []byte("foo"))).To(Succeed())
+		})
+
+		It("should fail if the size does not match", func() {
+			Expect(VerifyFileContentChunk(chunk, []byte("fooo"))).To(MatchError(ContainSubstring("size of chunk")))
+		})
+
+		It("should fail if the digest does not match", func() {
+			Expect(VerifyFileContentChunk(chunk, []byte("bar"))).To(MatchError(ContainSubstring("digest of chunk does not match")))
+		})
+	})
+
+	It("#FileContentChunkSecretName", func() {
+		Expect(FileContentChunkSecretName("abc")).To(Equal("osc-chunk-abc"))
+	})
+})
@@ -101,6 +101,14 @@ type OperatingSystemConfigSpec struct {
 	// +patchStrategy=merge
 	// +optional
 	Files []File `json:"files,omitempty" patchStrategy:"merge" patchMergeKey:"path"`
+	// NodeLabels are labels which gardener-node-agent ensures on the Node object. Labels which have been declared in a
+	// previous revision but are no longer present are removed from the Node, all other labels are left untouched.
+	// +optional
+	NodeLabels map[string]string `json:"nodeLabels,omitempty"`
+	// NodeTaints are taints which gardener-node-agent ensures on the Node object. Taints which have been declared in a
+	// previous revision but are no longer present are removed from the Node, all other taints are left untouched.
+	// +optional
+	NodeTaints []corev1.Taint `json:"nodeTaints,omitempty"`
 }
 
 // Unit is a unit for the operating system configuration (usually, a systemd unit).
@@ -124,6 +132,21 @@ type Unit struct {
 	// FilePaths is a list of files the unit depends on. If any file changes a restart of the dependent unit will be
 	// triggered. For each FilePath there must exist a File with matching Path in OperatingSystemConfig.Spec.Files.
 	FilePaths []string `json:"filePaths,omitempty"`
+	// PostStartProbe is an optional probe which is executed after the unit has been (re)started. The unit is only
+	// considered healthy if the probe succeeds.
+	// +optional
+	PostStartProbe *UnitProbe `json:"postStartProbe,omitempty"`
+	// RebootRequired specifies whether a change of this unit requires a reboot of the node. If true, the node is
+	// cordoned and drained before the change is applied, and it is rebooted afterwards instead of (re)starting the unit.
+	// +optional
+	RebootRequired *bool `json:"rebootRequired,omitempty"`
+}
+
+// UnitProbe is a probe which is executed on the node to verify that a unit is healthy.
+type UnitProbe struct {
+	// Command is the command (including its arguments) which is executed. The probe succeeds if the command exits with
+	// code 0.
+	Command []string `json:"command"`
 }
 
 // UnitCommand is a string alias.
@@ -160,8 +183,15 @@ type File struct {
 	// Should be defaulted to octal 0644.
 	// +optional
 	Permissions *int32 `json:"permissions,omitempty"`
+	// Owner describes the user and group owning the file. Defaults to root:root.
+	// +optional
+	Owner *FileOwner `json:"owner,omitempty"`
 	// Content describe the file's content.
 	Content FileContent `json:"content"`
+	// RebootRequired specifies whether a change of this file requires a reboot of the node. If true, the node is
+	// cordoned and drained before the change is applied, and it is rebooted afterwards.
+	// +optional
+	RebootRequired *bool `json:"rebootRequired,omitempty"`
 }
 
 // FileContent can either reference a secret or contain inline configuration.
@@ -179,6 +209,9 @@ type FileContent struct {
 	// ImageRef describes a container image which contains a file.
 	// +optional
 	ImageRef *FileContentImageRef `json:"imageRef,omitempty"`
+	// Symlink describes a symbolic link which is created at the path of the file.
+	// +optional
+	Symlink *FileContentSymlink `json:"symlink,omitempty"`
 }
 
 // FileContentSecretRef contains keys for referencing a file content's data from a secret in the same namespace.
@@ -195,6 +228,20 @@ type FileContentInline struct {
 	Encoding string `json:"encoding"`
 	// Data is the file's data.
 	Data string `json:"data"`
+	// Chunks is a list of content-addressed chunks which make up the file's data when concatenated in order. It is meant
+	// for very large files: the data of each chunk is stored in a separate secret in the namespace of the
+	// OperatingSystemConfig secret, and gardener-node-agent only fetches chunks which are not yet present on the node.
+	// If set, Data must be empty and Encoding is ignored.
+	// +optional
+	Chunks []FileContentChunk `json:"chunks,omitempty"`
+}
+
+// FileContentChunk is a content-addressed chunk of a file's data.
+type FileContentChunk struct {
+	// Digest is the hex-encoded SHA-256 digest of the chunk's data.
+	Digest string `json:"digest"`
+	// Size is the size of the chunk's data in bytes.
+	Size int64 `json:"size"`
 }
 
 // FileContentImageRef describes a container image which contains a file
@@ -205,6 +252,22 @@ type FileContentImageRef struct {
 	FilePathInImage string `json:"filePathInImage"`
 }
 
+// FileContentSymlink describes a symbolic link.
+type FileContentSymlink struct {
+	// Target is the path the symbolic link points to. It can be absolute or relative to the directory of the link.
+	Target string `json:"target"`
+}
+
+// FileOwner describes the user and group owning a file.
+type FileOwner struct {
+	// User is the name or the numeric ID of the user owning the file. Defaults to root.
+	// +optional
+	User string `json:"user,omitempty"`
+	// Group is the name or the numeric ID of the group owning the file. Defaults to root.
+	// +optional
+	Group string `json:"group,omitempty"`
+}
+
 // OperatingSystemConfigStatus is the status for a OperatingSystemConfig resource.
 type OperatingSystemConfigStatus struct {
 	// DefaultStatus is a structure containing common fields used by all extension resources.
@@ -28,6 +28,21 @@ var _ Object = (*Worker)(nil)
 // WorkerResource is a constant for the name of the Worker resource.
 const WorkerResource = "Worker"
 
+const (
+	// ScaleDownUtilizationThresholdAnnotation is the annotation on a machine deployment which overrides the scale-down
+	// utilization threshold of the cluster-autoscaler for the node group.
+	ScaleDownUtilizationThresholdAnnotation = "autoscaler.gardener.cloud/scale-down-utilization-threshold"
+	// ScaleDownUnneededTimeAnnotation is the annotation on a machine deployment which overrides the scale-down unneeded
+	// time of the cluster-autoscaler for the node group.
+	ScaleDownUnneededTimeAnnotation = "autoscaler.gardener.cloud/scale-down-unneeded-time"
+	// MaxNodeProvisionTimeAnnotation is the annotation on a machine deployment which overrides the maximum node
+	// provision time of the cluster-autoscaler for the node group.
+	MaxNodeProvisionTimeAnnotation = "autoscaler.gardener.cloud/max-node-provision-time"
+	// MaxGracefulTerminationSecondsAnnotation is the annotation on a machine deployment which overrides the maximum
+	// number of seconds the cluster-autoscaler waits for pod termination when scaling down a node of the node group.
+	MaxGracefulTerminationSecondsAnnotation = "autoscaler.gardener.cloud/max-graceful-termination-sec"
+)
+
 // +genclient
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 // +kubebuilder:resource:scope=Namespaced,path=workers,singular=worker
@@ -154,12 +169,37 @@ type WorkerPool struct {
 	// Architecture is the CPU architecture of the worker pool machines and machine image.
 	// +optional
 	Architecture *string `json:"architecture,omitempty"`
+	// ClusterAutoscaler contains the cluster autoscaler configurations for the worker pool.
+	// +optional
+	ClusterAutoscaler *ClusterAutoscalerOptions `json:"clusterAutoscaler,omitempty"`
+}
+
+// ClusterAutoscalerOptions contains the cluster autoscaler configurations for a worker pool.
+type ClusterAutoscalerOptions struct {
+	// ScaleDownUtilizationThreshold defines the threshold in fraction (0.0 - 1.0) under which a node is being removed.
+	// +optional
+	ScaleDownUtilizationThreshold *string `json:"scaleDownUtilizationThreshold,omitempty"`
+	// ScaleDownUnneededTime defines how long a node should be unneeded before it is eligible for scale down.
+	// +optional
+	ScaleDownUnneededTime *metav1.Duration `json:"scaleDownUnneededTime,omitempty"`
+	// MaxNodeProvisionTime defines how long CA waits for node to be provisioned.
+	// +optional
+	MaxNodeProvisionTime *metav1.Duration `json:"maxNodeProvisionTime,omitempty"`
+	// MaxGracefulTerminationSeconds is the number of seconds CA waits for pod termination when trying to scale down a
+	// node of this worker pool.
+	// +optional
+	MaxGracefulTerminationSeconds *int32 `json:"maxGracefulTerminationSeconds,omitempty"`
 }
 
 // NodeTemplate contains information about the expected node properties.
 type NodeTemplate struct {
 	// Capacity represents the expected Node capacity.
 	Capacity corev1.ResourceList `json:"capacity"`
+	// Architecture is the CPU architecture of the expected Node. It is used by the Cluster Autoscaler for the
+	// `kubernetes.io/arch` label of the node template when scaling a nodeGroup from zero, so that pods which require a
+	// certain architecture are not considered schedulable on nodes of another architecture.
+	// +optional
+	Architecture *string `json:"architecture,omitempty"`
 }
 
 // MachineImage contains logical information about the name and the version of the machie image that
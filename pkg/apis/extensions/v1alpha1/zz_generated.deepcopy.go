@@ -24,6 +24,7 @@ package v1alpha1
 import (
 	v1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -418,6 +419,42 @@ func (in *Cluster) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterAutoscalerOptions) DeepCopyInto(out *ClusterAutoscalerOptions) {
+	*out = *in
+	if in.ScaleDownUtilizationThreshold != nil {
+		in, out := &in.ScaleDownUtilizationThreshold, &out.ScaleDownUtilizationThreshold
+		*out = new(string)
+		**out = **in
+	}
+	if in.ScaleDownUnneededTime != nil {
+		in, out := &in.ScaleDownUnneededTime, &out.ScaleDownUnneededTime
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.MaxNodeProvisionTime != nil {
+		in, out := &in.MaxNodeProvisionTime, &out.MaxNodeProvisionTime
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.MaxGracefulTerminationSeconds != nil {
+		in, out := &in.MaxGracefulTerminationSeconds, &out.MaxGracefulTerminationSeconds
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterAutoscalerOptions.
+func (in *ClusterAutoscalerOptions) DeepCopy() *ClusterAutoscalerOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterAutoscalerOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ClusterList) DeepCopyInto(out *ClusterList) {
 	*out = *in
@@ -1024,7 +1061,17 @@ func (in *File) DeepCopyInto(out *File) {
 		*out = new(int32)
 		**out = **in
 	}
+	if in.Owner != nil {
+		in, out := &in.Owner, &out.Owner
+		*out = new(FileOwner)
+		**out = **in
+	}
 	in.Content.DeepCopyInto(&out.Content)
+	if in.RebootRequired != nil {
+		in, out := &in.RebootRequired, &out.RebootRequired
+		*out = new(bool)
+		**out = **in
+	}
 	return
 }
 
@@ -1049,7 +1096,7 @@ func (in *FileContent) DeepCopyInto(out *FileContent) {
 	if in.Inline != nil {
 		in, out := &in.Inline, &out.Inline
 		*out = new(FileContentInline)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	if in.TransmitUnencoded != nil {
 		in, out := &in.TransmitUnencoded, &out.TransmitUnencoded
@@ -1061,6 +1108,11 @@ func (in *FileContent) DeepCopyInto(out *FileContent) {
 		*out = new(FileContentImageRef)
 		**out = **in
 	}
+	if in.Symlink != nil {
+		in, out := &in.Symlink, &out.Symlink
+		*out = new(FileContentSymlink)
+		**out = **in
+	}
 	return
 }
 
@@ -1074,6 +1126,22 @@ func (in *FileContent) DeepCopy() *FileContent {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FileContentChunk) DeepCopyInto(out *FileContentChunk) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FileContentChunk.
+func (in *FileContentChunk) DeepCopy() *FileContentChunk {
+	if in == nil {
+		return nil
+	}
+	out := new(FileContentChunk)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *FileContentImageRef) DeepCopyInto(out *FileContentImageRef) {
 	*out = *in
@@ -1093,6 +1161,11 @@ func (in *FileContentImageRef) DeepCopy() *FileContentImageRef {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *FileContentInline) DeepCopyInto(out *FileContentInline) {
 	*out = *in
+	if in.Chunks != nil {
+		in, out := &in.Chunks, &out.Chunks
+		*out = make([]FileContentChunk, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -1122,6 +1195,38 @@ func (in *FileContentSecretRef) DeepCopy() *FileContentSecretRef {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FileContentSymlink) DeepCopyInto(out *FileContentSymlink) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FileContentSymlink.
+func (in *FileContentSymlink) DeepCopy() *FileContentSymlink {
+	if in == nil {
+		return nil
+	}
+	out := new(FileContentSymlink)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FileOwner) DeepCopyInto(out *FileOwner) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FileOwner.
+func (in *FileOwner) DeepCopy() *FileOwner {
+	if in == nil {
+		return nil
+	}
+	out := new(FileOwner)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Infrastructure) DeepCopyInto(out *Infrastructure) {
 	*out = *in
@@ -1370,6 +1475,11 @@ func (in *NodeTemplate) DeepCopyInto(out *NodeTemplate) {
 			(*out)[key] = val.DeepCopy()
 		}
 	}
+	if in.Architecture != nil {
+		in, out := &in.Architecture, &out.Architecture
+		*out = new(string)
+		**out = **in
+	}
 	return
 }
 
@@ -1472,6 +1582,20 @@ func (in *OperatingSystemConfigSpec) DeepCopyInto(out *OperatingSystemConfigSpec
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.NodeLabels != nil {
+		in, out := &in.NodeLabels, &out.NodeLabels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.NodeTaints != nil {
+		in, out := &in.NodeTaints, &out.NodeTaints
+		*out = make([]v1.Taint, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -1564,6 +1688,16 @@ func (in *Unit) DeepCopyInto(out *Unit) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.PostStartProbe != nil {
+		in, out := &in.PostStartProbe, &out.PostStartProbe
+		*out = new(UnitProbe)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RebootRequired != nil {
+		in, out := &in.RebootRequired, &out.RebootRequired
+		*out = new(bool)
+		**out = **in
+	}
 	return
 }
 
@@ -1577,6 +1711,27 @@ func (in *Unit) DeepCopy() *Unit {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UnitProbe) DeepCopyInto(out *UnitProbe) {
+	*out = *in
+	if in.Command != nil {
+		in, out := &in.Command, &out.Command
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UnitProbe.
+func (in *UnitProbe) DeepCopy() *UnitProbe {
+	if in == nil {
+		return nil
+	}
+	out := new(UnitProbe)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Volume) DeepCopyInto(out *Volume) {
 	*out = *in
@@ -1748,6 +1903,11 @@ func (in *WorkerPool) DeepCopyInto(out *WorkerPool) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.ClusterAutoscaler != nil {
+		in, out := &in.ClusterAutoscaler, &out.ClusterAutoscaler
+		*out = new(ClusterAutoscalerOptions)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
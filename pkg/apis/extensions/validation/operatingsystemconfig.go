@@ -15,12 +15,16 @@
 package validation
 
 import (
+	"regexp"
 	"strings"
 
 	"github.com/go-test/deep"
+	corev1 "k8s.io/api/core/v1"
 	apiequality "k8s.io/apimachinery/pkg/api/equality"
 	apivalidation "k8s.io/apimachinery/pkg/api/validation"
+	metav1validation "k8s.io/apimachinery/pkg/apis/meta/v1/validation"
 	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
@@ -73,6 +77,8 @@ func ValidateOperatingSystemConfigSpec(spec *extensionsv1alpha1.OperatingSystemC
 
 	allErrs = append(allErrs, ValidateUnits(spec.Units, pathsFromFiles, fldPath.Child("units"))...)
 	allErrs = append(allErrs, ValidateFiles(spec.Files, fldPath.Child("files"))...)
+	allErrs = append(allErrs, metav1validation.ValidateLabels(spec.NodeLabels, fldPath.Child("nodeLabels"))...)
+	allErrs = append(allErrs, ValidateNodeTaints(spec.NodeTaints, fldPath.Child("nodeTaints"))...)
 
 	return allErrs
 }
@@ -110,6 +116,10 @@ func ValidateUnits(units []extensionsv1alpha1.Unit, pathsFromFiles sets.Set[stri
 		}
 
 		allErrs = append(allErrs, validateFilePaths(unit.FilePaths, pathsFromFiles, idxPath.Child("filePaths"))...)
+
+		if unit.PostStartProbe != nil && len(unit.PostStartProbe.Command) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("postStartProbe", "command"), "field is required"))
+		}
 	}
 
 	return allErrs
@@ -163,11 +173,22 @@ func ValidateFiles(files []extensionsv1alpha1.File, fldPath *field.Path) field.E
 			allErrs = append(allErrs, field.Required(idxPath.Child("path"), "field is required"))
 		}
 
+		if file.Owner != nil {
+			allErrs = append(allErrs, validateFileOwner(file.Owner, idxPath.Child("owner"))...)
+		}
+
+		var contentSources int
+		for _, set := range []bool{file.Content.SecretRef != nil, file.Content.Inline != nil, file.Content.ImageRef != nil, file.Content.Symlink != nil} {
+			if set {
+				contentSources++
+			}
+		}
+
 		switch {
-		case file.Content.SecretRef == nil && file.Content.Inline == nil && file.Content.ImageRef == nil:
-			allErrs = append(allErrs, field.Required(idxPath.Child("content"), "either 'secretRef', 'inline' or 'imageRef' must be provided"))
-		case file.Content.SecretRef != nil && file.Content.Inline != nil || file.Content.SecretRef != nil && file.Content.ImageRef != nil || file.Content.Inline != nil && file.Content.ImageRef != nil:
-			allErrs = append(allErrs, field.Invalid(idxPath.Child("content"), file.Content, "either 'secretRef', 'inline' or 'imageRef' must be provided, not multiple at the same time"))
+		case contentSources == 0:
+			allErrs = append(allErrs, field.Required(idxPath.Child("content"), "either 'secretRef', 'inline', 'imageRef' or 'symlink' must be provided"))
+		case contentSources > 1:
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("content"), file.Content, "either 'secretRef', 'inline', 'imageRef' or 'symlink' must be provided, not multiple at the same time"))
 		case file.Content.SecretRef != nil:
 			if len(file.Content.SecretRef.Name) == 0 {
 				allErrs = append(allErrs, field.Required(idxPath.Child("content", "secretRef", "name"), "field is required"))
@@ -175,6 +196,8 @@ func ValidateFiles(files []extensionsv1alpha1.File, fldPath *field.Path) field.E
 			if len(file.Content.SecretRef.DataKey) == 0 {
 				allErrs = append(allErrs, field.Required(idxPath.Child("content", "secretRef", "dataKey"), "field is required"))
 			}
+		case file.Content.Inline != nil && len(file.Content.Inline.Chunks) > 0:
+			allErrs = append(allErrs, validateFileContentChunks(file.Content.Inline, idxPath.Child("content", "inline"))...)
 		case file.Content.Inline != nil:
 			encodings := []string{string(extensionsv1alpha1.PlainFileCodecID), string(extensionsv1alpha1.B64FileCodecID)}
 			if !utils.ValueExists(file.Content.Inline.Encoding, encodings) {
@@ -191,6 +214,88 @@ func ValidateFiles(files []extensionsv1alpha1.File, fldPath *field.Path) field.E
 			if len(file.Content.ImageRef.FilePathInImage) == 0 {
 				allErrs = append(allErrs, field.Required(idxPath.Child("content", "imageRef", "filePathInImage"), "field is required"))
 			}
+		case file.Content.Symlink != nil:
+			if len(file.Content.Symlink.Target) == 0 {
+				allErrs = append(allErrs, field.Required(idxPath.Child("content", "symlink", "target"), "field is required"))
+			}
+			// The permissions and the owner of symbolic links are not evaluated by Linux, hence they would only pretend
+			// that they have an effect.
+			if file.Permissions != nil {
+				allErrs = append(allErrs, field.Forbidden(idxPath.Child("permissions"), "must not be set for symbolic links"))
+			}
+			if file.Owner != nil {
+				allErrs = append(allErrs, field.Forbidden(idxPath.Child("owner"), "must not be set for symbolic links"))
+			}
+		}
+	}
+
+	return allErrs
+}
+
+func validateFileContentChunks(inline *extensionsv1alpha1.FileContentInline, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if len(inline.Data) > 0 {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("data"), "must not be set when chunks are specified"))
+	}
+
+	for i, chunk := range inline.Chunks {
+		idxPath := fldPath.Child("chunks").Index(i)
+
+		if !sha256DigestRegex.MatchString(chunk.Digest) {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("digest"), chunk.Digest, "must be a hex-encoded SHA-256 digest"))
+		}
+		if chunk.Size <= 0 {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("size"), chunk.Size, "must be positive"))
+		}
+	}
+
+	return allErrs
+}
+
+var sha256DigestRegex = regexp.MustCompile(`^[a-f0-9]{64}$`)
+
+func validateFileOwner(owner *extensionsv1alpha1.FileOwner, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if owner.User != "" && !fileOwnerRegex.MatchString(owner.User) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("user"), owner.User, "must be a valid user name or a numeric user ID"))
+	}
+	if owner.Group != "" && !fileOwnerRegex.MatchString(owner.Group) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("group"), owner.Group, "must be a valid group name or a numeric group ID"))
+	}
+
+	return allErrs
+}
+
+// fileOwnerRegex matches the user and group names accepted by useradd/groupadd as well as numeric IDs.
+var fileOwnerRegex = regexp.MustCompile(`^([a-z_][a-z0-9_-]{0,31}|[0-9]+)$`)
+
+// ValidateNodeTaints validates the node taints of an operating system config.
+func ValidateNodeTaints(taints []corev1.Taint, fldPath *field.Path) field.ErrorList {
+	var (
+		allErrs         = field.ErrorList{}
+		uniqueTaints    = sets.New[string]()
+		supportedEffect = []string{string(corev1.TaintEffectNoSchedule), string(corev1.TaintEffectPreferNoSchedule), string(corev1.TaintEffectNoExecute)}
+	)
+
+	for i, taint := range taints {
+		idxPath := fldPath.Index(i)
+
+		allErrs = append(allErrs, metav1validation.ValidateLabelName(taint.Key, idxPath.Child("key"))...)
+
+		if errs := validation.IsValidLabelValue(taint.Value); len(errs) != 0 {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("value"), taint.Value, strings.Join(errs, ";")))
+		}
+
+		if !utils.ValueExists(string(taint.Effect), supportedEffect) {
+			allErrs = append(allErrs, field.NotSupported(idxPath.Child("effect"), taint.Effect, supportedEffect))
+		}
+
+		if key := taint.Key + ":" + string(taint.Effect); uniqueTaints.Has(key) {
+			allErrs = append(allErrs, field.Duplicate(idxPath, key))
+		} else {
+			uniqueTaints.Insert(key)
 		}
 	}
 
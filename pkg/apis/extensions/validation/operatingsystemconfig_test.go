@@ -15,12 +15,16 @@
 package validation_test
 
 import (
+	"strings"
+
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	. "github.com/onsi/gomega/gstruct"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/pointer"
 
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	. "github.com/gardener/gardener/pkg/apis/extensions/validation"
@@ -117,7 +121,8 @@ var _ = Describe("OperatingSystemConfig validation tests", func() {
 				DropIns: []extensionsv1alpha1.DropIn{
 					{},
 				},
-				FilePaths: []string{"non-existing-foobar"},
+				FilePaths:      []string{"non-existing-foobar"},
+				PostStartProbe: &extensionsv1alpha1.UnitProbe{},
 			}}
 			oscCopy.Status.ExtensionUnits = []extensionsv1alpha1.Unit{{
 				DropIns: []extensionsv1alpha1.DropIn{
@@ -139,6 +144,10 @@ var _ = Describe("OperatingSystemConfig validation tests", func() {
 					"Type":  Equal(field.ErrorTypeInvalid),
 					"Field": Equal("spec.units[0].filePaths[0]"),
 				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("spec.units[0].postStartProbe.command"),
+				})),
 				PointTo(MatchFields(IgnoreExtras, Fields{
 					"Type":  Equal(field.ErrorTypeRequired),
 					"Field": Equal("status.extensionUnits[0].name"),
@@ -286,7 +295,172 @@ var _ = Describe("OperatingSystemConfig validation tests", func() {
 			}))))
 		})
 
+		It("should allow OperatingSystemConfigs with chunked inline files", func() {
+			oscCopy := osc.DeepCopy()
+			oscCopy.Spec.Units = nil
+			oscCopy.Spec.Files = []extensionsv1alpha1.File{{
+				Path: "path1",
+				Content: extensionsv1alpha1.FileContent{
+					Inline: &extensionsv1alpha1.FileContentInline{
+						Chunks: []extensionsv1alpha1.FileContentChunk{{Digest: strings.Repeat("a", 64), Size: 1024}},
+					},
+				},
+			}}
+
+			Expect(ValidateOperatingSystemConfig(oscCopy)).To(BeEmpty())
+		})
+
+		It("should forbid OperatingSystemConfigs with invalid chunked inline files", func() {
+			oscCopy := osc.DeepCopy()
+			oscCopy.Spec.Units = nil
+			oscCopy.Spec.Files = []extensionsv1alpha1.File{{
+				Path: "path1",
+				Content: extensionsv1alpha1.FileContent{
+					Inline: &extensionsv1alpha1.FileContentInline{
+						Data: "some-data",
+						Chunks: []extensionsv1alpha1.FileContentChunk{
+							{Digest: strings.Repeat("a", 64), Size: 1024},
+							{Digest: "sha256:foo", Size: 0},
+						},
+					},
+				},
+			}}
+
+			Expect(ValidateOperatingSystemConfig(oscCopy)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("spec.files[0].content.inline.data"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("spec.files[0].content.inline.chunks[1].digest"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("spec.files[0].content.inline.chunks[1].size"),
+				})),
+			))
+		})
+
+		It("should allow OperatingSystemConfigs with file owners and symbolic links", func() {
+			oscCopy := osc.DeepCopy()
+			oscCopy.Spec.Units = nil
+			oscCopy.Spec.Files = []extensionsv1alpha1.File{
+				{
+					Path:  "path1",
+					Owner: &extensionsv1alpha1.FileOwner{User: "root", Group: "systemd-network"},
+					Content: extensionsv1alpha1.FileContent{
+						Inline: &extensionsv1alpha1.FileContentInline{
+							Encoding: "b64",
+							Data:     "some-data",
+						},
+					},
+				},
+				{
+					Path:    "path2",
+					Owner:   &extensionsv1alpha1.FileOwner{Group: "101"},
+					Content: osc.Spec.Files[1].Content,
+				},
+				{
+					Path: "path3",
+					Content: extensionsv1alpha1.FileContent{
+						Symlink: &extensionsv1alpha1.FileContentSymlink{Target: "../path1"},
+					},
+				},
+			}
+
+			Expect(ValidateOperatingSystemConfig(oscCopy)).To(BeEmpty())
+		})
+
+		It("should forbid OperatingSystemConfigs with invalid file owners and symbolic links", func() {
+			oscCopy := osc.DeepCopy()
+			oscCopy.Spec.Units = nil
+			oscCopy.Spec.Files = []extensionsv1alpha1.File{
+				{
+					Path:    "path1",
+					Owner:   &extensionsv1alpha1.FileOwner{User: "Root:root", Group: "-1"},
+					Content: osc.Spec.Files[0].Content,
+				},
+				{
+					Path:        "path2",
+					Permissions: pointer.Int32(0644),
+					Owner:       &extensionsv1alpha1.FileOwner{User: "root"},
+					Content: extensionsv1alpha1.FileContent{
+						Symlink: &extensionsv1alpha1.FileContentSymlink{},
+					},
+				},
+				{
+					Path: "path3",
+					Content: extensionsv1alpha1.FileContent{
+						Inline:  osc.Spec.Files[0].Content.Inline,
+						Symlink: &extensionsv1alpha1.FileContentSymlink{Target: "/path1"},
+					},
+				},
+			}
+
+			Expect(ValidateOperatingSystemConfig(oscCopy)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("spec.files[0].owner.user"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("spec.files[0].owner.group"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("spec.files[1].content.symlink.target"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("spec.files[1].permissions"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("spec.files[1].owner"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("spec.files[2].content"),
+				})),
+			))
+		})
+
+		It("should forbid OperatingSystemConfig resources with invalid node labels and taints", func() {
+			oscCopy := osc.DeepCopy()
+			oscCopy.Spec.NodeLabels = map[string]string{"foo/bar/baz": "value"}
+			oscCopy.Spec.NodeTaints = []corev1.Taint{
+				{Key: "foo", Value: "bar!", Effect: corev1.TaintEffectNoSchedule},
+				{Key: "foo", Value: "bar", Effect: corev1.TaintEffectNoSchedule},
+				{Key: "bar", Effect: "Unknown"},
+			}
+
+			Expect(ValidateOperatingSystemConfig(oscCopy)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("spec.nodeLabels"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("spec.nodeTaints[0].value"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeDuplicate),
+					"Field": Equal("spec.nodeTaints[1]"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("spec.nodeTaints[2].effect"),
+				})),
+			))
+		})
+
 		It("should allow valid osc resources", func() {
+			oscCopy := osc.DeepCopy()
+			oscCopy.Spec.NodeLabels = map[string]string{"worker.gardener.cloud/pool": "worker"}
+			oscCopy.Spec.NodeTaints = []corev1.Taint{{Key: "foo", Value: "bar", Effect: corev1.TaintEffectNoSchedule}}
+			Expect(ValidateOperatingSystemConfig(oscCopy)).To(BeEmpty())
+
 			errorList := ValidateOperatingSystemConfig(osc)
 
 			Expect(errorList).To(BeEmpty())
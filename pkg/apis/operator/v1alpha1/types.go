@@ -183,6 +183,25 @@ type DNS struct {
 	// +kubebuilder:validation:MinItems=1
 	// +optional
 	Domains []string `json:"domains,omitempty"`
+	// DomainTLS contains TLS configuration for individual domains of the virtual garden cluster. For domains with an
+	// entry, the virtual kube-apiserver serves the referenced certificate for requests to the domain. This is required
+	// if the domains are issued by different certificate authorities. All other domains are served with the certificate
+	// issued by the cluster CA or the certificate configured via `.spec.virtualCluster.kubernetes.kubeAPIServer.sni`.
+	// +optional
+	DomainTLS []DomainTLS `json:"domainTLS,omitempty"`
+}
+
+// DomainTLS contains TLS configuration for a domain of the virtual garden cluster.
+type DomainTLS struct {
+	// Domain is the domain to which the TLS configuration applies. It must be one of the domains of the virtual garden
+	// cluster.
+	// +kubebuilder:validation:MinLength=1
+	Domain string `json:"domain"`
+	// SecretName is the name of a secret in the garden namespace containing the TLS certificate (`tls.crt`) and private
+	// key (`tls.key`) for the domain. The certificate must be valid for `api.<domain>` and `gardener.<domain>`. The
+	// secret is not managed by gardener-operator, i.e., the certificate must be issued and renewed externally.
+	// +kubebuilder:validation:MinLength=1
+	SecretName string `json:"secretName"`
 }
 
 // ETCD contains configuration for the etcds of the virtual garden cluster.
@@ -277,6 +296,9 @@ type KubeAPIServerConfig struct {
 	// Authentication contains settings related to authentication.
 	// +optional
 	Authentication *Authentication `json:"authentication,omitempty"`
+	// Autoscaling contains settings related to the autoscaling of the kube-apiserver.
+	// +optional
+	Autoscaling *KubeAPIServerAutoscaling `json:"autoscaling,omitempty"`
 	// ResourcesToStoreInETCDEvents contains a list of resources which should be stored in etcd-events instead of
 	// etcd-main. The 'events' resource is always stored in etcd-events. Note that adding or removing resources from
 	// this list will not migrate them automatically from the etcd-main to etcd-events or vice versa.
@@ -304,6 +326,42 @@ type AuditWebhook struct {
 	Version *string `json:"version,omitempty"`
 }
 
+// KubeAPIServerAutoscaling contains settings related to the autoscaling of the kube-apiserver.
+type KubeAPIServerAutoscaling struct {
+	// MinReplicas is the minimum number of replicas of the kube-apiserver. Defaults to 2, or 3 if the control plane is
+	// highly available.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	MinReplicas *int32 `json:"minReplicas,omitempty"`
+	// MaxReplicas is the maximum number of replicas of the kube-apiserver. It must not be lower than MinReplicas.
+	// Defaults to 6.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	MaxReplicas *int32 `json:"maxReplicas,omitempty"`
+	// ScalingClass determines the initial resource requests of the kube-apiserver according to the expected size of
+	// the landscape. Defaults to 'small'.
+	// +kubebuilder:validation:Enum=small;medium;large
+	// +optional
+	ScalingClass *ScalingClass `json:"scalingClass,omitempty"`
+	// VPAUpdateMode is the update mode of the VerticalPodAutoscaler of the kube-apiserver. It is only considered if HVPA
+	// is disabled. Defaults to 'Off', i.e., the resource requests are only recommended.
+	// +kubebuilder:validation:Enum=Off;Initial;Auto
+	// +optional
+	VPAUpdateMode *string `json:"vpaUpdateMode,omitempty"`
+}
+
+// ScalingClass is a class for the expected size of the landscape.
+type ScalingClass string
+
+const (
+	// ScalingClassSmall is the scaling class for small landscapes.
+	ScalingClassSmall ScalingClass = "small"
+	// ScalingClassMedium is the scaling class for medium landscapes.
+	ScalingClassMedium ScalingClass = "medium"
+	// ScalingClassLarge is the scaling class for large landscapes.
+	ScalingClassLarge ScalingClass = "large"
+)
+
 // Authentication contains settings related to authentication.
 type Authentication struct {
 	// Webhook contains settings related to an authentication webhook configuration.
@@ -500,6 +558,62 @@ type GardenerSchedulerConfig struct {
 	// +kubebuilder:default=info
 	// +optional
 	LogLevel *string `json:"logLevel,omitempty"`
+	// ShootSpreadStrategy defines how shoots are spread over the seed candidates. With ProjectAntiAffinity, the shoots
+	// of the same project are spread across the seeds to reduce the blast radius of a seed outage for the project.
+	// Must be one of [LeastShoots,ProjectAntiAffinity]. Defaults to LeastShoots.
+	// +kubebuilder:validation:Enum=LeastShoots;ProjectAntiAffinity
+	// +optional
+	ShootSpreadStrategy *string `json:"shootSpreadStrategy,omitempty"`
+	// ShootStrategy defines how the seed candidates for shoots are determined. Must be one of
+	// [SameRegion,MinimalDistance]. Defaults to MinimalDistance.
+	// +kubebuilder:validation:Enum=SameRegion;MinimalDistance
+	// +optional
+	ShootStrategy *string `json:"shootStrategy,omitempty"`
+	// ShootCandidateWeights configures how the seed candidates are weighted before the ShootSpreadStrategy chooses the
+	// seed for a shoot.
+	// +optional
+	ShootCandidateWeights *ShootCandidateWeights `json:"shootCandidateWeights,omitempty"`
+	// ShootRetryInterval is the initial interval after which shoots that could not be scheduled are retried. The
+	// interval is doubled with each failed attempt until ShootMaxRetryBackoff is reached. Defaults to 5ms.
+	// +kubebuilder:validation:Type=string
+	// +kubebuilder:validation:Pattern="^([0-9]+(\\.[0-9]+)?(ns|us|µs|ms|s|m|h))+$"
+	// +optional
+	ShootRetryInterval *metav1.Duration `json:"shootRetryInterval,omitempty"`
+	// ShootMaxRetryBackoff is the maximum interval after which shoots that could not be scheduled are retried.
+	// Defaults to 1000s.
+	// +kubebuilder:validation:Type=string
+	// +kubebuilder:validation:Pattern="^([0-9]+(\\.[0-9]+)?(ns|us|µs|ms|s|m|h))+$"
+	// +optional
+	ShootMaxRetryBackoff *metav1.Duration `json:"shootMaxRetryBackoff,omitempty"`
+	// HardenedSecurityContext specifies whether the gardener-scheduler container runs with a read-only root
+	// filesystem, without privilege escalation and with the RuntimeDefault seccomp profile. Defaults to true.
+	// +optional
+	HardenedSecurityContext *bool `json:"hardenedSecurityContext,omitempty"`
+	// Profiling configures serving the profiling endpoints of the gardener-scheduler on a dedicated port. If not set,
+	// profiling is disabled.
+	// +optional
+	Profiling *GardenerSchedulerProfiling `json:"profiling,omitempty"`
+}
+
+// GardenerSchedulerProfiling contains configuration settings for the profiling endpoints of the gardener-scheduler.
+type GardenerSchedulerProfiling struct {
+	// ContentionProfiling specifies whether contention profiling (block profiles) is enabled additionally. Defaults to
+	// false.
+	// +optional
+	ContentionProfiling *bool `json:"contentionProfiling,omitempty"`
+}
+
+// ShootCandidateWeights configures how the seed candidates are weighted before the spread strategy chooses the seed for
+// a shoot.
+type ShootCandidateWeights struct {
+	// RegionAffinity maps regions to weights. Only the seed candidates in the region(s) with the highest weight are
+	// considered by the spread strategy. Regions which are not listed have a weight of 0.
+	// +optional
+	RegionAffinity map[string]int32 `json:"regionAffinity,omitempty"`
+	// SeedCapacity makes the spread strategy compare the number of shoots relative to the allocatable shoots of the
+	// seed candidates instead of the absolute number of shoots.
+	// +optional
+	SeedCapacity *bool `json:"seedCapacity,omitempty"`
 }
 
 // GardenStatus is the status of a garden environment.
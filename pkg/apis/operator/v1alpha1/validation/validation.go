@@ -24,6 +24,7 @@ import (
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/validation/field"
+	vpaautoscalingv1 "k8s.io/autoscaler/vertical-pod-autoscaler/pkg/apis/autoscaling.k8s.io/v1"
 	"k8s.io/component-base/featuregate"
 
 	admissioncontrollerconfig "github.com/gardener/gardener/pkg/admissioncontroller/apis/config"
@@ -133,6 +134,23 @@ func validateVirtualCluster(virtualCluster operatorv1alpha1.VirtualCluster, runt
 		domains.Insert(domain)
 	}
 
+	tlsDomains := sets.New[string]()
+	for i, domainTLS := range virtualCluster.DNS.DomainTLS {
+		path := fldPath.Child("dns", "domainTLS").Index(i)
+
+		if !domains.Has(domainTLS.Domain) {
+			allErrs = append(allErrs, field.Invalid(path.Child("domain"), domainTLS.Domain, "must be one of the domains of the virtual cluster"))
+		}
+		if tlsDomains.Has(domainTLS.Domain) {
+			allErrs = append(allErrs, field.Duplicate(path.Child("domain"), domainTLS.Domain))
+		}
+		tlsDomains.Insert(domainTLS.Domain)
+
+		if len(domainTLS.SecretName) == 0 {
+			allErrs = append(allErrs, field.Required(path.Child("secretName"), "secret name must be provided"))
+		}
+	}
+
 	if err := kubernetesversion.CheckIfSupported(virtualCluster.Kubernetes.Version); err != nil {
 		allErrs = append(allErrs, field.NotSupported(fldPath.Child("kubernetes", "version"), virtualCluster.Kubernetes.Version, kubernetesversion.SupportedVersions))
 	}
@@ -148,6 +166,10 @@ func validateVirtualCluster(virtualCluster operatorv1alpha1.VirtualCluster, runt
 		allErrs = append(allErrs, gardencorevalidation.ValidateKubeAPIServer(coreKubeAPIServerConfig, virtualCluster.Kubernetes.Version, true, path)...)
 	}
 
+	if kubeAPIServer := virtualCluster.Kubernetes.KubeAPIServer; kubeAPIServer != nil && kubeAPIServer.Autoscaling != nil {
+		allErrs = append(allErrs, validateKubeAPIServerAutoscaling(kubeAPIServer.Autoscaling, fldPath.Child("kubernetes", "kubeAPIServer", "autoscaling"))...)
+	}
+
 	if kubeControllerManager := virtualCluster.Kubernetes.KubeControllerManager; kubeControllerManager != nil && kubeControllerManager.KubeControllerManagerConfig != nil {
 		path := fldPath.Child("kubernetes", "kubeControllerManager")
 
@@ -177,6 +199,42 @@ func validateVirtualCluster(virtualCluster operatorv1alpha1.VirtualCluster, runt
 	return allErrs
 }
 
+var (
+	availableScalingClasses = sets.New(
+		string(operatorv1alpha1.ScalingClassSmall),
+		string(operatorv1alpha1.ScalingClassMedium),
+		string(operatorv1alpha1.ScalingClassLarge),
+	)
+	availableVPAUpdateModes = sets.New(
+		string(vpaautoscalingv1.UpdateModeOff),
+		string(vpaautoscalingv1.UpdateModeInitial),
+		string(vpaautoscalingv1.UpdateModeAuto),
+	)
+)
+
+func validateKubeAPIServerAutoscaling(autoscaling *operatorv1alpha1.KubeAPIServerAutoscaling, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if autoscaling.MinReplicas != nil && *autoscaling.MinReplicas < 1 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("minReplicas"), *autoscaling.MinReplicas, "must be at least 1"))
+	}
+	if autoscaling.MaxReplicas != nil && *autoscaling.MaxReplicas < 1 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("maxReplicas"), *autoscaling.MaxReplicas, "must be at least 1"))
+	}
+	if autoscaling.MinReplicas != nil && autoscaling.MaxReplicas != nil && *autoscaling.MaxReplicas < *autoscaling.MinReplicas {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("maxReplicas"), *autoscaling.MaxReplicas, "must not be lower than minReplicas"))
+	}
+
+	if autoscaling.ScalingClass != nil && !availableScalingClasses.Has(string(*autoscaling.ScalingClass)) {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("scalingClass"), *autoscaling.ScalingClass, sets.List(availableScalingClasses)))
+	}
+	if autoscaling.VPAUpdateMode != nil && !availableVPAUpdateModes.Has(*autoscaling.VPAUpdateMode) {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("vpaUpdateMode"), *autoscaling.VPAUpdateMode, sets.List(availableVPAUpdateModes)))
+	}
+
+	return allErrs
+}
+
 func validateGardener(config operatorv1alpha1.Gardener, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 
@@ -288,6 +346,25 @@ func validateGardenerSchedulerConfig(config *operatorv1alpha1.GardenerSchedulerC
 
 	allErrs = append(allErrs, validateGardenerFeatureGates(config.FeatureGates, fldPath.Child("featureGates"))...)
 
+	if weights := config.ShootCandidateWeights; weights != nil {
+		for region, weight := range weights.RegionAffinity {
+			if region == "" {
+				allErrs = append(allErrs, field.Invalid(fldPath.Child("shootCandidateWeights", "regionAffinity"), region, "region must not be empty"))
+			}
+			allErrs = append(allErrs, apivalidation.ValidateNonnegativeField(int64(weight), fldPath.Child("shootCandidateWeights", "regionAffinity").Key(region))...)
+		}
+	}
+
+	if config.ShootRetryInterval != nil && config.ShootRetryInterval.Duration <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("shootRetryInterval"), config.ShootRetryInterval.Duration.String(), "must be positive"))
+	}
+	if config.ShootMaxRetryBackoff != nil && config.ShootMaxRetryBackoff.Duration <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("shootMaxRetryBackoff"), config.ShootMaxRetryBackoff.Duration.String(), "must be positive"))
+	}
+	if config.ShootRetryInterval != nil && config.ShootMaxRetryBackoff != nil && config.ShootRetryInterval.Duration > config.ShootMaxRetryBackoff.Duration {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("shootRetryInterval"), config.ShootRetryInterval.Duration.String(), "must not be greater than shootMaxRetryBackoff"))
+	}
+
 	return allErrs
 }
 
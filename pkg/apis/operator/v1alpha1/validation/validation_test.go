@@ -16,6 +16,7 @@ package validation_test
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/Masterminds/semver/v3"
 	. "github.com/onsi/ginkgo/v2"
@@ -822,6 +823,39 @@ var _ = Describe("Validation Tests", func() {
 						})),
 					))
 				})
+
+				It("should allow TLS configuration for the domains", func() {
+					garden.Spec.VirtualCluster.DNS.Domains = []string{"example.com", "foo.bar"}
+					garden.Spec.VirtualCluster.DNS.DomainTLS = []operatorv1alpha1.DomainTLS{
+						{Domain: "foo.bar", SecretName: "foo-bar-tls"},
+					}
+
+					Expect(ValidateGarden(garden)).To(BeEmpty())
+				})
+
+				It("should complain about invalid TLS configuration for the domains", func() {
+					garden.Spec.VirtualCluster.DNS.Domains = []string{"example.com", "foo.bar"}
+					garden.Spec.VirtualCluster.DNS.DomainTLS = []operatorv1alpha1.DomainTLS{
+						{Domain: "foo.bar", SecretName: "foo-bar-tls"},
+						{Domain: "bar.foo", SecretName: "bar-foo-tls"},
+						{Domain: "foo.bar"},
+					}
+
+					Expect(ValidateGarden(garden)).To(ConsistOf(
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeInvalid),
+							"Field": Equal("spec.virtualCluster.dns.domainTLS[1].domain"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeDuplicate),
+							"Field": Equal("spec.virtualCluster.dns.domainTLS[2].domain"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeRequired),
+							"Field": Equal("spec.virtualCluster.dns.domainTLS[2].secretName"),
+						})),
+					))
+				})
 			})
 
 			Context("Networking", func() {
@@ -862,6 +896,67 @@ var _ = Describe("Validation Tests", func() {
 				})
 			})
 
+			Context("KubeAPIServer autoscaling", func() {
+				BeforeEach(func() {
+					garden.Spec.VirtualCluster.Kubernetes.KubeAPIServer = &operatorv1alpha1.KubeAPIServerConfig{}
+				})
+
+				It("should allow a valid autoscaling configuration", func() {
+					scalingClass := operatorv1alpha1.ScalingClassLarge
+					garden.Spec.VirtualCluster.Kubernetes.KubeAPIServer.Autoscaling = &operatorv1alpha1.KubeAPIServerAutoscaling{
+						MinReplicas:   pointer.Int32(3),
+						MaxReplicas:   pointer.Int32(3),
+						ScalingClass:  &scalingClass,
+						VPAUpdateMode: pointer.String("Auto"),
+					}
+
+					Expect(ValidateGarden(garden)).To(BeEmpty())
+				})
+
+				It("should complain about invalid replicas", func() {
+					garden.Spec.VirtualCluster.Kubernetes.KubeAPIServer.Autoscaling = &operatorv1alpha1.KubeAPIServerAutoscaling{
+						MinReplicas: pointer.Int32(0),
+						MaxReplicas: pointer.Int32(-1),
+					}
+
+					Expect(ValidateGarden(garden)).To(ConsistOf(
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeInvalid),
+							"Field": Equal("spec.virtualCluster.kubernetes.kubeAPIServer.autoscaling.minReplicas"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":   Equal(field.ErrorTypeInvalid),
+							"Field":  Equal("spec.virtualCluster.kubernetes.kubeAPIServer.autoscaling.maxReplicas"),
+							"Detail": Equal("must be at least 1"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":   Equal(field.ErrorTypeInvalid),
+							"Field":  Equal("spec.virtualCluster.kubernetes.kubeAPIServer.autoscaling.maxReplicas"),
+							"Detail": Equal("must not be lower than minReplicas"),
+						})),
+					))
+				})
+
+				It("should complain about unsupported scaling classes and VPA update modes", func() {
+					scalingClass := operatorv1alpha1.ScalingClass("huge")
+					garden.Spec.VirtualCluster.Kubernetes.KubeAPIServer.Autoscaling = &operatorv1alpha1.KubeAPIServerAutoscaling{
+						ScalingClass:  &scalingClass,
+						VPAUpdateMode: pointer.String("Recreate"),
+					}
+
+					Expect(ValidateGarden(garden)).To(ConsistOf(
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeNotSupported),
+							"Field": Equal("spec.virtualCluster.kubernetes.kubeAPIServer.autoscaling.scalingClass"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeNotSupported),
+							"Field": Equal("spec.virtualCluster.kubernetes.kubeAPIServer.autoscaling.vpaUpdateMode"),
+						})),
+					))
+				})
+			})
+
 			Context("Gardener", func() {
 				Context("APIServer", func() {
 					BeforeEach(func() {
@@ -1414,6 +1509,80 @@ var _ = Describe("Validation Tests", func() {
 							}))))
 						})
 					})
+
+					Context("Shoot candidate weights", func() {
+						It("should allow valid candidate weights", func() {
+							garden.Spec.VirtualCluster.Gardener.Scheduler = &operatorv1alpha1.GardenerSchedulerConfig{
+								ShootCandidateWeights: &operatorv1alpha1.ShootCandidateWeights{
+									RegionAffinity: map[string]int32{"europe-central-1": 10, "asia-south-1": 0},
+									SeedCapacity:   pointer.Bool(true),
+								},
+							}
+
+							Expect(ValidateGarden(garden)).To(BeEmpty())
+						})
+
+						It("should complain about empty regions and negative weights", func() {
+							garden.Spec.VirtualCluster.Gardener.Scheduler = &operatorv1alpha1.GardenerSchedulerConfig{
+								ShootCandidateWeights: &operatorv1alpha1.ShootCandidateWeights{
+									RegionAffinity: map[string]int32{"": 1, "europe-central-1": -1},
+								},
+							}
+
+							Expect(ValidateGarden(garden)).To(ConsistOf(
+								PointTo(MatchFields(IgnoreExtras, Fields{
+									"Type":  Equal(field.ErrorTypeInvalid),
+									"Field": Equal("spec.virtualCluster.gardener.gardenerScheduler.shootCandidateWeights.regionAffinity"),
+								})),
+								PointTo(MatchFields(IgnoreExtras, Fields{
+									"Type":  Equal(field.ErrorTypeInvalid),
+									"Field": Equal("spec.virtualCluster.gardener.gardenerScheduler.shootCandidateWeights.regionAffinity[europe-central-1]"),
+								})),
+							))
+						})
+					})
+
+					Context("Shoot retry backoff", func() {
+						It("should allow a valid retry backoff", func() {
+							garden.Spec.VirtualCluster.Gardener.Scheduler = &operatorv1alpha1.GardenerSchedulerConfig{
+								ShootRetryInterval:   &metav1.Duration{Duration: time.Second},
+								ShootMaxRetryBackoff: &metav1.Duration{Duration: 5 * time.Minute},
+							}
+
+							Expect(ValidateGarden(garden)).To(BeEmpty())
+						})
+
+						It("should complain when the durations are not positive", func() {
+							garden.Spec.VirtualCluster.Gardener.Scheduler = &operatorv1alpha1.GardenerSchedulerConfig{
+								ShootRetryInterval:   &metav1.Duration{Duration: -time.Second},
+								ShootMaxRetryBackoff: &metav1.Duration{},
+							}
+
+							Expect(ValidateGarden(garden)).To(ConsistOf(
+								PointTo(MatchFields(IgnoreExtras, Fields{
+									"Type":  Equal(field.ErrorTypeInvalid),
+									"Field": Equal("spec.virtualCluster.gardener.gardenerScheduler.shootRetryInterval"),
+								})),
+								PointTo(MatchFields(IgnoreExtras, Fields{
+									"Type":  Equal(field.ErrorTypeInvalid),
+									"Field": Equal("spec.virtualCluster.gardener.gardenerScheduler.shootMaxRetryBackoff"),
+								})),
+							))
+						})
+
+						It("should complain when the retry interval is greater than the max retry backoff", func() {
+							garden.Spec.VirtualCluster.Gardener.Scheduler = &operatorv1alpha1.GardenerSchedulerConfig{
+								ShootRetryInterval:   &metav1.Duration{Duration: time.Hour},
+								ShootMaxRetryBackoff: &metav1.Duration{Duration: time.Minute},
+							}
+
+							Expect(ValidateGarden(garden)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+								"Type":   Equal(field.ErrorTypeInvalid),
+								"Field":  Equal("spec.virtualCluster.gardener.gardenerScheduler.shootRetryInterval"),
+								"Detail": Equal("must not be greater than shootMaxRetryBackoff"),
+							}))))
+						})
+					})
 				})
 			})
 		})
@@ -204,6 +204,11 @@ func (in *DNS) DeepCopyInto(out *DNS) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.DomainTLS != nil {
+		in, out := &in.DomainTLS, &out.DomainTLS
+		*out = make([]DomainTLS, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -217,6 +222,22 @@ func (in *DNS) DeepCopy() *DNS {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DomainTLS) DeepCopyInto(out *DomainTLS) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DomainTLS.
+func (in *DomainTLS) DeepCopy() *DomainTLS {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainTLS)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ETCD) DeepCopyInto(out *ETCD) {
 	*out = *in
@@ -556,6 +577,41 @@ func (in *GardenerSchedulerConfig) DeepCopyInto(out *GardenerSchedulerConfig) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.ShootSpreadStrategy != nil {
+		in, out := &in.ShootSpreadStrategy, &out.ShootSpreadStrategy
+		*out = new(string)
+		**out = **in
+	}
+	if in.ShootStrategy != nil {
+		in, out := &in.ShootStrategy, &out.ShootStrategy
+		*out = new(string)
+		**out = **in
+	}
+	if in.ShootCandidateWeights != nil {
+		in, out := &in.ShootCandidateWeights, &out.ShootCandidateWeights
+		*out = new(ShootCandidateWeights)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ShootRetryInterval != nil {
+		in, out := &in.ShootRetryInterval, &out.ShootRetryInterval
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.ShootMaxRetryBackoff != nil {
+		in, out := &in.ShootMaxRetryBackoff, &out.ShootMaxRetryBackoff
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.HardenedSecurityContext != nil {
+		in, out := &in.HardenedSecurityContext, &out.HardenedSecurityContext
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Profiling != nil {
+		in, out := &in.Profiling, &out.Profiling
+		*out = new(GardenerSchedulerProfiling)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -569,6 +625,27 @@ func (in *GardenerSchedulerConfig) DeepCopy() *GardenerSchedulerConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GardenerSchedulerProfiling) DeepCopyInto(out *GardenerSchedulerProfiling) {
+	*out = *in
+	if in.ContentionProfiling != nil {
+		in, out := &in.ContentionProfiling, &out.ContentionProfiling
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GardenerSchedulerProfiling.
+func (in *GardenerSchedulerProfiling) DeepCopy() *GardenerSchedulerProfiling {
+	if in == nil {
+		return nil
+	}
+	out := new(GardenerSchedulerProfiling)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GroupResource) DeepCopyInto(out *GroupResource) {
 	*out = *in
@@ -601,6 +678,42 @@ func (in *HighAvailability) DeepCopy() *HighAvailability {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubeAPIServerAutoscaling) DeepCopyInto(out *KubeAPIServerAutoscaling) {
+	*out = *in
+	if in.MinReplicas != nil {
+		in, out := &in.MinReplicas, &out.MinReplicas
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxReplicas != nil {
+		in, out := &in.MaxReplicas, &out.MaxReplicas
+		*out = new(int32)
+		**out = **in
+	}
+	if in.ScalingClass != nil {
+		in, out := &in.ScalingClass, &out.ScalingClass
+		*out = new(ScalingClass)
+		**out = **in
+	}
+	if in.VPAUpdateMode != nil {
+		in, out := &in.VPAUpdateMode, &out.VPAUpdateMode
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubeAPIServerAutoscaling.
+func (in *KubeAPIServerAutoscaling) DeepCopy() *KubeAPIServerAutoscaling {
+	if in == nil {
+		return nil
+	}
+	out := new(KubeAPIServerAutoscaling)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *KubeAPIServerConfig) DeepCopyInto(out *KubeAPIServerConfig) {
 	*out = *in
@@ -619,6 +732,11 @@ func (in *KubeAPIServerConfig) DeepCopyInto(out *KubeAPIServerConfig) {
 		*out = new(Authentication)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Autoscaling != nil {
+		in, out := &in.Autoscaling, &out.Autoscaling
+		*out = new(KubeAPIServerAutoscaling)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.ResourcesToStoreInETCDEvents != nil {
 		in, out := &in.ResourcesToStoreInETCDEvents, &out.ResourcesToStoreInETCDEvents
 		*out = make([]GroupResource, len(*in))
@@ -997,6 +1115,34 @@ func (in *Settings) DeepCopy() *Settings {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ShootCandidateWeights) DeepCopyInto(out *ShootCandidateWeights) {
+	*out = *in
+	if in.RegionAffinity != nil {
+		in, out := &in.RegionAffinity, &out.RegionAffinity
+		*out = make(map[string]int32, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.SeedCapacity != nil {
+		in, out := &in.SeedCapacity, &out.SeedCapacity
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShootCandidateWeights.
+func (in *ShootCandidateWeights) DeepCopy() *ShootCandidateWeights {
+	if in == nil {
+		return nil
+	}
+	out := new(ShootCandidateWeights)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Storage) DeepCopyInto(out *Storage) {
 	*out = *in
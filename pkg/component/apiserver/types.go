@@ -17,6 +17,7 @@ package apiserver
 import (
 	"github.com/Masterminds/semver/v3"
 	corev1 "k8s.io/api/core/v1"
+	vpaautoscalingv1 "k8s.io/autoscaler/vertical-pod-autoscaler/pkg/apis/autoscaling.k8s.io/v1"
 
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	"github.com/gardener/gardener/pkg/component"
@@ -104,6 +105,9 @@ type AutoscalingConfig struct {
 	// ScaleDownDisabledForHvpa states whether scale-down shall be disabled when HPA or VPA are configured in an HVPA
 	// resource.
 	ScaleDownDisabledForHvpa bool
+	// VPAUpdateMode is the update mode of the VPA which is used if HVPA is disabled. It is only considered by the
+	// kube-apiserver which defaults to 'Off' if it is not set.
+	VPAUpdateMode *vpaautoscalingv1.UpdateMode
 }
 
 // ETCDEncryptionConfig contains configuration for the encryption of resources in etcd.
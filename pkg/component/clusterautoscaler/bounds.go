@@ -0,0 +1,170 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package clusterautoscaler
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/prometheus/client_golang/prometheus"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	componentmetrics "github.com/gardener/gardener/pkg/component/metrics"
+)
+
+const (
+	// EventReasonNodeGroupBoundsChanged is the reason of the events which are recorded on the cluster-autoscaler
+	// Deployment when Deploy changes the bounds of a node group.
+	EventReasonNodeGroupBoundsChanged = "NodeGroupBoundsChanged"
+
+	boundMin = "min"
+	boundMax = "max"
+
+	directionIncrease = "increase"
+	directionDecrease = "decrease"
+)
+
+// NodeGroupBoundsChanges defines the counter node_group_bounds_changes_total. It is incremented whenever Deploy changes
+// the minimum or maximum of a node group. Node groups which are added or removed are counted as changes from or to 0.
+var NodeGroupBoundsChanges = componentmetrics.Factory.NewCounterVec(
+	prometheus.CounterOpts{
+		Namespace: componentmetrics.Namespace,
+		Subsystem: "cluster_autoscaler",
+		Name:      "node_group_bounds_changes_total",
+		Help:      "Total number of changes of the bounds of the cluster-autoscaler node groups.",
+	},
+	[]string{
+		"namespace",
+		"node_group",
+		"bound",
+		"direction",
+	},
+)
+
+// nodeGroupBounds contains the minimum and maximum of a node group.
+type nodeGroupBounds struct {
+	min, max int32
+}
+
+func (b nodeGroupBounds) String() string {
+	return fmt.Sprintf("%d:%d", b.min, b.max)
+}
+
+// nodeGroupBoundsChange describes a change of the bounds of a node group.
+type nodeGroupBoundsChange struct {
+	name          string
+	before, after *nodeGroupBounds
+}
+
+// parseNodeGroupBounds parses the bounds of the machine deployments from the data recorded in the effective
+// configuration ConfigMap (format `<min>:<max>:<name>`, one per line). Malformed lines are ignored.
+func parseNodeGroupBounds(data string) map[string]nodeGroupBounds {
+	bounds := make(map[string]nodeGroupBounds)
+
+	for _, line := range strings.Split(data, "\n") {
+		parts := strings.SplitN(line, ":", 3)
+		if len(parts) != 3 {
+			continue
+		}
+
+		minimum, err := strconv.ParseInt(parts[0], 10, 32)
+		if err != nil {
+			continue
+		}
+		maximum, err := strconv.ParseInt(parts[1], 10, 32)
+		if err != nil {
+			continue
+		}
+
+		bounds[parts[2]] = nodeGroupBounds{min: int32(minimum), max: int32(maximum)}
+	}
+
+	return bounds
+}
+
+// computeNodeGroupBoundsChanges returns the changes between the old bounds and the bounds of the given machine
+// deployments sorted by the names of the node groups.
+func computeNodeGroupBoundsChanges(oldBounds map[string]nodeGroupBounds, machineDeployments []extensionsv1alpha1.MachineDeployment) []nodeGroupBoundsChange {
+	var (
+		changes   []nodeGroupBoundsChange
+		newBounds = make(map[string]nodeGroupBounds, len(machineDeployments))
+	)
+
+	for _, machineDeployment := range machineDeployments {
+		newBounds[machineDeployment.Name] = nodeGroupBounds{min: machineDeployment.Minimum, max: machineDeployment.Maximum}
+	}
+
+	for name, bounds := range newBounds {
+		bounds := bounds
+		if old, ok := oldBounds[name]; !ok {
+			changes = append(changes, nodeGroupBoundsChange{name: name, after: &bounds})
+		} else if old != bounds {
+			changes = append(changes, nodeGroupBoundsChange{name: name, before: &old, after: &bounds})
+		}
+	}
+
+	for name, bounds := range oldBounds {
+		bounds := bounds
+		if _, ok := newBounds[name]; !ok {
+			changes = append(changes, nodeGroupBoundsChange{name: name, before: &bounds})
+		}
+	}
+
+	sort.Slice(changes, func(i, j int) bool { return changes[i].name < changes[j].name })
+	return changes
+}
+
+// reportNodeGroupBoundsChanges increments the NodeGroupBoundsChanges metric and records an event on the given object
+// (if a recorder is configured) for each of the given changes.
+func (c *clusterAutoscaler) reportNodeGroupBoundsChanges(obj runtime.Object, changes []nodeGroupBoundsChange) {
+	for _, change := range changes {
+		var before, after nodeGroupBounds
+		if change.before != nil {
+			before = *change.before
+		}
+		if change.after != nil {
+			after = *change.after
+		}
+
+		for bound, values := range map[string][2]int32{
+			boundMin: {before.min, after.min},
+			boundMax: {before.max, after.max},
+		} {
+			switch {
+			case values[1] > values[0]:
+				NodeGroupBoundsChanges.WithLabelValues(c.namespace, change.name, bound, directionIncrease).Inc()
+			case values[1] < values[0]:
+				NodeGroupBoundsChanges.WithLabelValues(c.namespace, change.name, bound, directionDecrease).Inc()
+			}
+		}
+
+		if c.values.Recorder == nil {
+			continue
+		}
+
+		switch {
+		case change.before == nil:
+			c.values.Recorder.Eventf(obj, corev1.EventTypeNormal, EventReasonNodeGroupBoundsChanged, "Added node group %q with bounds %s", change.name, after)
+		case change.after == nil:
+			c.values.Recorder.Eventf(obj, corev1.EventTypeNormal, EventReasonNodeGroupBoundsChanged, "Removed node group %q with bounds %s", change.name, before)
+		default:
+			c.values.Recorder.Eventf(obj, corev1.EventTypeNormal, EventReasonNodeGroupBoundsChanged, "Changed bounds of node group %q from %s to %s", change.name, before, after)
+		}
+	}
+}
@@ -16,7 +16,13 @@ package clusterautoscaler
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
 
 	appsv1 "k8s.io/api/apps/v1"
 	autoscalingv1 "k8s.io/api/autoscaling/v1"
@@ -24,12 +30,16 @@ import (
 	networkingv1 "k8s.io/api/networking/v1"
 	policyv1 "k8s.io/api/policy/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/intstr"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation"
 	vpaautoscalingv1 "k8s.io/autoscaler/vertical-pod-autoscaler/pkg/apis/autoscaling.k8s.io/v1"
+	"k8s.io/client-go/tools/record"
 	"k8s.io/utils/pointer"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -41,10 +51,13 @@ import (
 	"github.com/gardener/gardener/pkg/component"
 	kubeapiserverconstants "github.com/gardener/gardener/pkg/component/kubeapiserver/constants"
 	"github.com/gardener/gardener/pkg/controllerutils"
+	"github.com/gardener/gardener/pkg/extensions"
 	"github.com/gardener/gardener/pkg/utils"
 	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
 	kubernetesutils "github.com/gardener/gardener/pkg/utils/kubernetes"
+	"github.com/gardener/gardener/pkg/utils/kubernetes/health"
 	"github.com/gardener/gardener/pkg/utils/managedresources"
+	"github.com/gardener/gardener/pkg/utils/retry"
 	secretsmanager "github.com/gardener/gardener/pkg/utils/secrets/manager"
 )
 
@@ -57,6 +70,74 @@ const (
 
 	portNameMetrics       = "metrics"
 	portMetrics     int32 = 8085
+
+	annotationChecksumCredentials = "checksum/credentials"
+
+	defaultTerminationGracePeriodSeconds int64 = 5
+
+	initContainerNameWaitForMCM               = "wait-for-machine-controller-manager"
+	portMetricsMachineControllerManager int32 = 10258
+
+	envControlNamespace = "CONTROL_NAMESPACE"
+	envTargetKubeconfig = "TARGET_KUBECONFIG"
+
+	// ConfigMapNameEffectiveConfig is the name of the ConfigMap which contains the effective configuration of the
+	// cluster-autoscaler.
+	ConfigMapNameEffectiveConfig = "cluster-autoscaler-effective-config"
+	// DataKeyFlags is the key in the effective configuration ConfigMap whose value contains the resolved command line
+	// flags of the cluster-autoscaler, one per line.
+	DataKeyFlags = "flags"
+	// DataKeyMachineDeployments is the key in the effective configuration ConfigMap whose value contains the bounds of
+	// the machine deployments in the format `<min>:<max>:<name>`, one per line.
+	DataKeyMachineDeployments = "machineDeployments"
+
+	// ConfigMapNamePriorityExpander is the name of the ConfigMap in the shoot which contains the configuration of the
+	// priority expander of the cluster-autoscaler.
+	ConfigMapNamePriorityExpander = "cluster-autoscaler-priority-expander"
+	// DataKeyPriorities is the key in the priority expander ConfigMap whose value maps the priorities to the regular
+	// expressions matching the names of the node groups.
+	DataKeyPriorities = "priorities"
+)
+
+var (
+	extraArgNameRegex = regexp.MustCompile(`^[a-z0-9]([a-z0-9-]*[a-z0-9])?$`)
+
+	// allowedRBACNamespaces are the namespaces which may be used as RBACNamespace.
+	allowedRBACNamespaces = sets.New(metav1.NamespaceSystem, v1beta1constants.ClusterAutoscalerRBACNamespace)
+
+	// managedArgs are the flags which are rendered by this component and hence must not be overridden by extra args.
+	managedArgs = sets.New(
+		"address",
+		"balance-similar-node-groups",
+		"balancing-ignore-label",
+		"cloud-provider",
+		"cordon-node-before-terminating",
+		"expander",
+		"expendable-pods-priority-cutoff",
+		"ignore-taint",
+		"kubeconfig",
+		"leader-elect-resource-namespace",
+		"max-empty-bulk-delete",
+		"max-graceful-termination-sec",
+		"max-node-provision-time",
+		"max-pod-eviction-time",
+		"namespace",
+		"new-pod-scale-up-delay",
+		"nodes",
+		"scale-down-candidates-pool-min-count",
+		"scale-down-candidates-pool-ratio",
+		"scale-down-delay-after-add",
+		"scale-down-delay-after-delete",
+		"scale-down-delay-after-failure",
+		"scale-down-unneeded-time",
+		"scale-down-utilization-threshold",
+		"scan-interval",
+		"skip-nodes-with-custom-controller-pods",
+		"skip-nodes-with-local-storage",
+		"skip-nodes-with-system-pods",
+		"stderrthreshold",
+		"v",
+	)
 )
 
 // Interface contains functions for a cluster-autoscaler deployer.
@@ -67,6 +148,68 @@ type Interface interface {
 	SetNamespaceUID(types.UID)
 	// SetMachineDeployments sets the machine deployments.
 	SetMachineDeployments([]extensionsv1alpha1.MachineDeployment)
+	// SetWorkerPools sets the worker pools whose minimum and maximum shall be split over the machine deployments of
+	// their zones. The computed bounds take precedence over the bounds of the machine deployments.
+	SetWorkerPools([]WorkerPool)
+	// SetCredentialsRotationMarker sets a marker identifying the most recent rotation of the credentials used by the
+	// cluster-autoscaler, e.g., the time when the preparation of a service account key rotation finished. It is part of
+	// the credentials checksum on the pod template, i.e., the pods are restarted whenever the marker changes and do not
+	// keep using stale tokens.
+	SetCredentialsRotationMarker(string)
+}
+
+// Values is a set of configuration values for the cluster-autoscaler which allow distributions to customize the
+// deployment without maintaining a patched component.
+type Values struct {
+	// ExtraArgs are additional command line flags (without leading dashes) which are passed to the cluster-autoscaler.
+	// They are rendered sorted by their names.
+	ExtraArgs map[string]string
+	// ExtraEnv are additional environment variables which are passed to the cluster-autoscaler. They are rendered
+	// sorted by their names.
+	ExtraEnv []corev1.EnvVar
+	// ImagePullSecrets are the names of the secrets used for pulling the cluster-autoscaler image. They are rendered
+	// sorted by their names.
+	ImagePullSecrets []string
+	// ReadBoundsFromCluster specifies whether the bounds of the machine deployments are derived from the worker pools of
+	// the Shoot in the Cluster resource and the machine deployments reported by the Worker resource if
+	// SetMachineDeployments was not called.
+	ReadBoundsFromCluster bool
+	// RBACNamespace is the namespace in the shoot to which the permissions for the status ConfigMap and the leader
+	// election leases are bound. If set, cluster-autoscaler stores both in this namespace and does not get any
+	// cluster-wide permissions for leases. It must be either kube-system or the Gardener-owned
+	// v1beta1constants.ClusterAutoscalerRBACNamespace which is created by this component. Other namespaces are rejected
+	// since they might exist already and would be deleted together with their contents once they are no longer
+	// managed. If empty, the status ConfigMap and the leases are stored in kube-system.
+	RBACNamespace string
+	// WaitForMachineControllerManagerImage is the image of an init container which defers the start of the
+	// cluster-autoscaler until the machine-controller-manager is ready. This prevents noisy errors while the control
+	// plane is brought up. The image must provide `sh` and `wget`, e.g., alpine. If empty, no init container is added.
+	WaitForMachineControllerManagerImage string
+	// PriorityExpanderPriorities is the configuration of the priority expander. It maps priorities to regular
+	// expressions matching the names of the node groups, node groups with higher priorities are preferred for scale-up.
+	// If set, the priority expander ConfigMap is managed in the namespace in which the cluster-autoscaler stores its
+	// status (see RBACNamespace), otherwise the ConfigMap is not managed. It is only evaluated if the priority expander
+	// is enabled via the `expander` setting.
+	PriorityExpanderPriorities map[int32][]string
+	// BalancingIgnoreLabels are the keys of node labels which are ignored when the cluster-autoscaler checks whether two
+	// node groups are similar and hence balanced, e.g. provider-specific labels which differ between the zones. They
+	// are rendered sorted as `--balancing-ignore-label` flags. The `kubernetes.io/arch` label must not be ignored if the
+	// worker pools mix architectures, since arch-specific pods could otherwise be balanced onto nodes of another
+	// architecture.
+	BalancingIgnoreLabels []string
+	// TerminationGracePeriodSeconds is the duration in seconds the cluster-autoscaler pod is given to terminate
+	// gracefully, e.g. to finish in-flight updates of node groups during a rollout. It includes the time needed by the
+	// PreStop hook. If nil, 5 seconds are used.
+	TerminationGracePeriodSeconds *int64
+	// PreStop is the handler which is executed in the cluster-autoscaler container before it is stopped, e.g. a sleep
+	// deferring the termination until in-flight scale operations are finished, or a call releasing the leader election
+	// lease. Exec handlers require the command to be available in the cluster-autoscaler image. If nil, no PreStop hook
+	// is configured.
+	PreStop *corev1.LifecycleHandler
+	// Recorder is used for recording events on the cluster-autoscaler Deployment whenever Deploy changes the bounds of
+	// the node groups, i.e., the `--nodes` flags. If nil, no events are recorded. The changes are also counted in the
+	// NodeGroupBoundsChanges metric.
+	Recorder record.EventRecorder
 }
 
 // New creates a new instance of DeployWaiter for the cluster-autoscaler.
@@ -77,6 +220,7 @@ func New(
 	image string,
 	replicas int32,
 	config *gardencorev1beta1.ClusterAutoscaler,
+	values Values,
 ) Interface {
 	return &clusterAutoscaler{
 		client:         client,
@@ -85,6 +229,7 @@ func New(
 		image:          image,
 		replicas:       replicas,
 		config:         config,
+		values:         values,
 	}
 }
 
@@ -95,9 +240,12 @@ type clusterAutoscaler struct {
 	image          string
 	replicas       int32
 	config         *gardencorev1beta1.ClusterAutoscaler
+	values         Values
 
-	namespaceUID       types.UID
-	machineDeployments []extensionsv1alpha1.MachineDeployment
+	namespaceUID              types.UID
+	machineDeployments        []extensionsv1alpha1.MachineDeployment
+	workerPools               []WorkerPool
+	credentialsRotationMarker string
 }
 
 func (c *clusterAutoscaler) Deploy(ctx context.Context) error {
@@ -109,13 +257,26 @@ func (c *clusterAutoscaler) Deploy(ctx context.Context) error {
 		service             = c.emptyService()
 		deployment          = c.emptyDeployment()
 		podDisruptionBudget = c.emptyPodDisruptionBudget()
+		effectiveConfigMap  = c.emptyEffectiveConfigMap()
 
-		pdbMaxUnavailable = intstr.FromInt32(1)
-		vpaUpdateMode     = vpaautoscalingv1.UpdateModeAuto
-		controlledValues  = vpaautoscalingv1.ContainerControlledValuesRequestsOnly
-		command           = c.computeCommand()
+		pdbOneReplica    = intstr.FromInt32(1)
+		vpaUpdateMode    = vpaautoscalingv1.UpdateModeAuto
+		controlledValues = vpaautoscalingv1.ContainerControlledValuesRequestsOnly
 	)
 
+	if err := c.validateValues(); err != nil {
+		return err
+	}
+
+	machineDeployments, err := c.computeMachineDeployments(ctx)
+	if err != nil {
+		return err
+	}
+	command, err := c.renderCommand(machineDeployments)
+	if err != nil {
+		return err
+	}
+
 	genericTokenKubeconfigSecret, found := c.secretsManager.Get(v1beta1constants.SecretNameGenericTokenKubeconfig)
 	if !found {
 		return fmt.Errorf("secret %q not found", v1beta1constants.SecretNameGenericTokenKubeconfig)
@@ -190,6 +351,9 @@ func (c *clusterAutoscaler) Deploy(ctx context.Context) error {
 		deployment.Spec.Selector = &metav1.LabelSelector{MatchLabels: getLabels()}
 		deployment.Spec.Template = corev1.PodTemplateSpec{
 			ObjectMeta: metav1.ObjectMeta{
+				Annotations: map[string]string{
+					annotationChecksumCredentials: c.computeCredentialsChecksum(genericTokenKubeconfigSecret.Name, shootAccessSecret.Secret.Name),
+				},
 				Labels: utils.MergeStringMaps(getLabels(), map[string]string{
 					v1beta1constants.GardenRole:                           v1beta1constants.GardenRoleControlPlane,
 					v1beta1constants.LabelPodMaintenanceRestart:           "true",
@@ -212,16 +376,16 @@ func (c *clusterAutoscaler) Deploy(ctx context.Context) error {
 								Protocol:      corev1.ProtocolTCP,
 							},
 						},
-						Env: []corev1.EnvVar{
+						Env: append([]corev1.EnvVar{
 							{
-								Name:  "CONTROL_NAMESPACE",
+								Name:  envControlNamespace,
 								Value: c.namespace,
 							},
 							{
-								Name:  "TARGET_KUBECONFIG",
+								Name:  envTargetKubeconfig,
 								Value: gardenerutils.PathGenericKubeconfig,
 							},
-						},
+						}, c.computeExtraEnv()...),
 						Resources: corev1.ResourceRequirements{
 							Requests: corev1.ResourceList{
 								corev1.ResourceCPU:    resource.MustParse("100m"),
@@ -230,24 +394,59 @@ func (c *clusterAutoscaler) Deploy(ctx context.Context) error {
 						},
 					},
 				},
+				ImagePullSecrets:              c.computeImagePullSecrets(),
 				PriorityClassName:             v1beta1constants.PriorityClassNameShootControlPlane300,
 				ServiceAccountName:            serviceAccount.Name,
-				TerminationGracePeriodSeconds: pointer.Int64(5),
+				TerminationGracePeriodSeconds: pointer.Int64(c.computeTerminationGracePeriodSeconds()),
 			},
 		}
 
+		if c.values.PreStop != nil {
+			deployment.Spec.Template.Spec.Containers[0].Lifecycle = &corev1.Lifecycle{PreStop: c.values.PreStop.DeepCopy()}
+		}
+
+		if c.values.WaitForMachineControllerManagerImage != "" {
+			deployment.Spec.Template.Labels[gardenerutils.NetworkPolicyLabel(v1beta1constants.DeploymentNameMachineControllerManager, portMetricsMachineControllerManager)] = v1beta1constants.LabelNetworkPolicyAllowed
+			deployment.Spec.Template.Spec.InitContainers = []corev1.Container{c.waitForMachineControllerManagerInitContainer()}
+		}
+
 		utilruntime.Must(gardenerutils.InjectGenericKubeconfig(deployment, genericTokenKubeconfigSecret.Name, shootAccessSecret.Secret.Name))
 		return nil
 	}); err != nil {
 		return err
 	}
 
+	var boundsChanges []nodeGroupBoundsChange
+	if _, err := controllerutils.GetAndCreateOrMergePatch(ctx, c.client, effectiveConfigMap, func() error {
+		// The bounds recorded by the previous Deploy are compared to the new ones. Nothing is reported when the ConfigMap
+		// is created since the previous bounds are not known in this case.
+		if effectiveConfigMap.ResourceVersion != "" {
+			boundsChanges = computeNodeGroupBoundsChanges(parseNodeGroupBounds(effectiveConfigMap.Data[DataKeyMachineDeployments]), machineDeployments)
+		}
+
+		effectiveConfigMap.Labels = getLabels()
+		effectiveConfigMap.OwnerReferences = []metav1.OwnerReference{*metav1.NewControllerRef(deployment, appsv1.SchemeGroupVersion.WithKind("Deployment"))}
+		effectiveConfigMap.Data = computeEffectiveConfigData(command, machineDeployments)
+		return nil
+	}); err != nil {
+		return err
+	}
+	c.reportNodeGroupBoundsChanges(deployment, boundsChanges)
+
 	if _, err := controllerutils.GetAndCreateOrMergePatch(ctx, c.client, podDisruptionBudget, func() error {
 		podDisruptionBudget.Labels = getLabels()
 		podDisruptionBudget.Spec = policyv1.PodDisruptionBudgetSpec{
-			MaxUnavailable: &pdbMaxUnavailable,
+			MaxUnavailable: &pdbOneReplica,
 			Selector:       deployment.Spec.Selector,
 		}
+		// The high-availability webhook of gardener-resource-manager scales the deployment up for control planes which
+		// shall tolerate failures. With multiple replicas, at least one of them must stay available so that there is
+		// always a candidate for taking over the leader election lease (cluster-autoscaler enables leader election by
+		// default).
+		if pointer.Int32Deref(deployment.Spec.Replicas, 0) > 1 {
+			podDisruptionBudget.Spec.MaxUnavailable = nil
+			podDisruptionBudget.Spec.MinAvailable = &pdbOneReplica
+		}
 		return nil
 	}); err != nil {
 		return err
@@ -286,6 +485,11 @@ func (c *clusterAutoscaler) Deploy(ctx context.Context) error {
 	return managedresources.CreateForShoot(ctx, c.client, c.namespace, managedResourceTargetName, managedresources.LabelValueGardener, false, data)
 }
 
+// computeTerminationGracePeriodSeconds returns the termination grace period of the cluster-autoscaler pods.
+func (c *clusterAutoscaler) computeTerminationGracePeriodSeconds() int64 {
+	return pointer.Int64Deref(c.values.TerminationGracePeriodSeconds, defaultTerminationGracePeriodSeconds)
+}
+
 func getLabels() map[string]string {
 	return map[string]string{
 		v1beta1constants.LabelApp:  v1beta1constants.LabelKubernetes,
@@ -301,6 +505,7 @@ func (c *clusterAutoscaler) Destroy(ctx context.Context) error {
 		c.emptyManagedResourceSecret(),
 		c.emptyVPA(),
 		c.emptyPodDisruptionBudget(),
+		c.emptyEffectiveConfigMap(),
 		c.emptyDeployment(),
 		c.emptyClusterRoleBinding(),
 		c.newShootAccessSecret().Secret,
@@ -309,12 +514,120 @@ func (c *clusterAutoscaler) Destroy(ctx context.Context) error {
 	)
 }
 
-func (c *clusterAutoscaler) Wait(_ context.Context) error        { return nil }
-func (c *clusterAutoscaler) WaitCleanup(_ context.Context) error { return nil }
-func (c *clusterAutoscaler) SetNamespaceUID(uid types.UID)       { c.namespaceUID = uid }
+var (
+	// IntervalWaitForDeployment is the interval used while waiting for the Deployments to become healthy.
+	IntervalWaitForDeployment = 5 * time.Second
+	// TimeoutWaitForDeployment is the timeout used while waiting for the Deployments to become healthy.
+	TimeoutWaitForDeployment = 5 * time.Minute
+
+	// ErrDeploymentProgressDeadline is returned by Wait if the rollout of the cluster-autoscaler deployment exceeded its
+	// progress deadline.
+	ErrDeploymentProgressDeadline = errors.New("cluster-autoscaler deployment exceeded its progress deadline")
+)
+
+// Wait waits until the machine-controller-manager deployment is healthy since cluster-autoscaler cannot scale the
+// machine deployments without it. Afterwards, it waits until the cluster-autoscaler deployment is updated and the
+// ManagedResource containing its RBAC objects in the shoot is healthy. Waiting stops early if the rollout exceeded its
+// progress deadline since it will not succeed without intervention. If the deployment has more than one replica, e.g.,
+// because it was scaled up by the high-availability webhook of gardener-resource-manager, it is sufficient that one
+// replica of the current generation is available since it can acquire the leader election lease, e.g., while another
+// replica cannot be scheduled due to a disrupted seed node.
+func (c *clusterAutoscaler) Wait(ctx context.Context) error {
+	timeoutCtx, cancel := context.WithTimeout(ctx, TimeoutWaitForDeployment)
+	defer cancel()
+
+	if err := retry.Until(timeoutCtx, IntervalWaitForDeployment, func(ctx context.Context) (done bool, err error) {
+		machineControllerManager := &appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: v1beta1constants.DeploymentNameMachineControllerManager, Namespace: c.namespace}}
+		if err := c.client.Get(ctx, client.ObjectKeyFromObject(machineControllerManager), machineControllerManager); err != nil {
+			if apierrors.IsNotFound(err) {
+				return retry.MinorError(fmt.Errorf("deployment %q does not exist yet", client.ObjectKeyFromObject(machineControllerManager)))
+			}
+			return retry.SevereError(err)
+		}
+
+		if err := health.CheckDeployment(machineControllerManager); err != nil {
+			return retry.MinorError(fmt.Errorf("machine-controller-manager is not ready yet: %w", err))
+		}
+
+		return retry.Ok()
+	}); err != nil {
+		return err
+	}
+
+	var (
+		deployment        = c.emptyDeployment()
+		deploymentUpdated = health.IsDeploymentUpdated(c.client, deployment)
+	)
+
+	if err := retry.Until(timeoutCtx, IntervalWaitForDeployment, func(ctx context.Context) (bool, error) {
+		done, err := deploymentUpdated(ctx)
+		if err == nil || done {
+			return done, err
+		}
+
+		if progressDeadlineExceeded(deployment) {
+			return retry.SevereError(ErrDeploymentProgressDeadline)
+		}
+
+		if pointer.Int32Deref(deployment.Spec.Replicas, 0) > 1 && hasAvailableUpdatedReplica(deployment) {
+			return retry.Ok()
+		}
+
+		return done, err
+	}); err != nil {
+		return err
+	}
+
+	return managedresources.WaitUntilHealthy(timeoutCtx, c.client, c.namespace, managedResourceTargetName)
+}
+
+func hasAvailableUpdatedReplica(deployment *appsv1.Deployment) bool {
+	return deployment.Status.ObservedGeneration >= deployment.Generation &&
+		deployment.Status.UpdatedReplicas > 0 &&
+		deployment.Status.AvailableReplicas > 0
+}
+
+func progressDeadlineExceeded(deployment *appsv1.Deployment) bool {
+	for _, condition := range deployment.Status.Conditions {
+		if condition.Type == appsv1.DeploymentProgressing && condition.Status == corev1.ConditionFalse && condition.Reason == "ProgressDeadlineExceeded" {
+			return true
+		}
+	}
+	return false
+}
+
+// WaitCleanup waits until the ManagedResource for the shoot resources and its secret are deleted. This prevents that
+// the resources in the shoot are still being reconciled while the shoot deletion flow continues.
+func (c *clusterAutoscaler) WaitCleanup(ctx context.Context) error {
+	timeoutCtx, cancel := context.WithTimeout(ctx, TimeoutWaitForManagedResource)
+	defer cancel()
+
+	if err := managedresources.WaitUntilDeleted(timeoutCtx, c.client, c.namespace, managedResourceTargetName); err != nil {
+		return err
+	}
+
+	return kubernetesutils.WaitUntilResourceDeleted(timeoutCtx, c.client, c.emptyManagedResourceSecret(), managedresources.IntervalWait)
+}
+
+func (c *clusterAutoscaler) SetNamespaceUID(uid types.UID) { c.namespaceUID = uid }
 func (c *clusterAutoscaler) SetMachineDeployments(machineDeployments []extensionsv1alpha1.MachineDeployment) {
 	c.machineDeployments = machineDeployments
 }
+func (c *clusterAutoscaler) SetWorkerPools(workerPools []WorkerPool) { c.workerPools = workerPools }
+func (c *clusterAutoscaler) SetCredentialsRotationMarker(marker string) {
+	c.credentialsRotationMarker = marker
+}
+
+// computeCredentialsChecksum computes a checksum of the credentials used by the cluster-autoscaler. The token of the
+// shoot access secret is deliberately not part of it since it is renewed regularly, which would restart the pods each
+// time. Instead, the rotation marker ensures that the pods are restarted after the credentials have been rotated.
+func (c *clusterAutoscaler) computeCredentialsChecksum(genericTokenKubeconfigSecretName, shootAccessSecretName string) string {
+	return utils.ComputeChecksum(map[string]string{
+		"genericTokenKubeconfigSecretName": genericTokenKubeconfigSecretName,
+		"shootAccessSecretName":            shootAccessSecretName,
+		"credentialsRotationMarker":        c.credentialsRotationMarker,
+	})
+}
 
 func (c *clusterAutoscaler) emptyClusterRoleBinding() *rbacv1.ClusterRoleBinding {
 	return &rbacv1.ClusterRoleBinding{ObjectMeta: metav1.ObjectMeta{Name: "cluster-autoscaler-" + c.namespace}}
@@ -340,6 +653,10 @@ func (c *clusterAutoscaler) emptyDeployment() *appsv1.Deployment {
 	return &appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: v1beta1constants.DeploymentNameClusterAutoscaler, Namespace: c.namespace}}
 }
 
+func (c *clusterAutoscaler) emptyEffectiveConfigMap() *corev1.ConfigMap {
+	return &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: ConfigMapNameEffectiveConfig, Namespace: c.namespace}}
+}
+
 func (c *clusterAutoscaler) emptyPodDisruptionBudget() *policyv1.PodDisruptionBudget {
 	return &policyv1.PodDisruptionBudget{ObjectMeta: metav1.ObjectMeta{Name: v1beta1constants.DeploymentNameClusterAutoscaler, Namespace: c.namespace}}
 }
@@ -353,7 +670,122 @@ func (c *clusterAutoscaler) emptyManagedResourceSecret() *corev1.Secret {
 	return &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "managedresource-" + managedResourceTargetName, Namespace: c.namespace}}
 }
 
-func (c *clusterAutoscaler) computeCommand() []string {
+// computeMachineDeployments returns the machine deployments whose bounds are rendered into the command. Bounds which
+// are computed by splitting the worker pools over their zones take precedence over the bounds of the machine
+// deployments. If the machine deployments were not set and ReadBoundsFromCluster is enabled, the worker pools are
+// derived from the Cluster resource.
+func (c *clusterAutoscaler) computeMachineDeployments(ctx context.Context) ([]extensionsv1alpha1.MachineDeployment, error) {
+	workerPools := c.workerPools
+
+	if c.machineDeployments == nil && c.values.ReadBoundsFromCluster {
+		clusterWorkerPools, err := c.workerPoolsFromCluster(ctx)
+		if err != nil {
+			return nil, err
+		}
+		// Explicitly set worker pools are appended last so that they take precedence.
+		workerPools = append(clusterWorkerPools, workerPools...)
+	}
+
+	if err := validateBalancingIgnoreLabels(c.values.BalancingIgnoreLabels, workerPools); err != nil {
+		return nil, err
+	}
+
+	if len(workerPools) == 0 {
+		return c.machineDeployments, nil
+	}
+
+	var (
+		machineDeployments []extensionsv1alpha1.MachineDeployment
+		splitBounds        = make(map[string]extensionsv1alpha1.MachineDeployment)
+		splitOrder         []string
+	)
+
+	for _, pool := range workerPools {
+		split, err := SplitOverZones(pool)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, machineDeployment := range split {
+			if _, ok := splitBounds[machineDeployment.Name]; !ok {
+				splitOrder = append(splitOrder, machineDeployment.Name)
+			}
+			splitBounds[machineDeployment.Name] = machineDeployment
+		}
+	}
+
+	for _, machineDeployment := range c.machineDeployments {
+		if split, ok := splitBounds[machineDeployment.Name]; ok {
+			machineDeployment = split
+			delete(splitBounds, machineDeployment.Name)
+		}
+		machineDeployments = append(machineDeployments, machineDeployment)
+	}
+
+	for _, name := range splitOrder {
+		if split, ok := splitBounds[name]; ok {
+			machineDeployments = append(machineDeployments, split)
+		}
+	}
+
+	return machineDeployments, nil
+}
+
+// workerPoolsFromCluster derives the worker pools from the Shoot embedded in the Cluster resource and the machine
+// deployments reported by its Worker resource. Only worker pools which are scaled by the cluster-autoscaler, i.e. whose
+// maximum is greater than their minimum, and which have at least one machine deployment are considered.
+func (c *clusterAutoscaler) workerPoolsFromCluster(ctx context.Context) ([]WorkerPool, error) {
+	shoot, err := extensions.GetShoot(ctx, c.client, c.namespace)
+	if err != nil {
+		return nil, fmt.Errorf("failed reading shoot from cluster resource %q: %w", c.namespace, err)
+	}
+	if shoot == nil {
+		return nil, fmt.Errorf("cluster resource %q does not contain a shoot", c.namespace)
+	}
+
+	worker := &extensionsv1alpha1.Worker{}
+	if err := c.client.Get(ctx, client.ObjectKey{Name: shoot.Name, Namespace: c.namespace}, worker); err != nil {
+		return nil, fmt.Errorf("failed reading worker resource %q: %w", client.ObjectKey{Name: shoot.Name, Namespace: c.namespace}, err)
+	}
+
+	var workerPools []WorkerPool
+	for _, w := range shoot.Spec.Provider.Workers {
+		if w.Maximum <= w.Minimum || len(w.Zones) == 0 {
+			continue
+		}
+
+		if pool := NewWorkerPool(c.namespace, w, worker.Status.MachineDeployments); len(pool.Zones) > 0 {
+			workerPools = append(workerPools, pool)
+		}
+	}
+
+	return workerPools, nil
+}
+
+// RenderCommand returns the command of the cluster-autoscaler container which Deploy renders for the given namespace,
+// configuration, values, and machine deployments. The bounds of the machine deployments are rendered as given, i.e.,
+// worker pools are not split over zones. It allows extensions and distributions to assert the rendered flags without
+// deploying the component. The given configuration is not modified.
+func RenderCommand(namespace string, config *gardencorev1beta1.ClusterAutoscaler, values Values, machineDeployments []extensionsv1alpha1.MachineDeployment) ([]string, error) {
+	c := &clusterAutoscaler{namespace: namespace, config: config.DeepCopy(), values: values}
+
+	if err := c.validateValues(); err != nil {
+		return nil, err
+	}
+
+	return c.renderCommand(machineDeployments)
+}
+
+// renderCommand returns the command for the given machine deployments after validating the names of their node groups.
+func (c *clusterAutoscaler) renderCommand(machineDeployments []extensionsv1alpha1.MachineDeployment) ([]string, error) {
+	if err := ValidateNodeGroupNames(machineDeployments); err != nil {
+		return nil, err
+	}
+
+	return c.computeCommand(machineDeployments), nil
+}
+
+func (c *clusterAutoscaler) computeCommand(machineDeployments []extensionsv1alpha1.MachineDeployment) []string {
 	var (
 		command = []string{
 			"./cluster-autoscaler",
@@ -398,18 +830,72 @@ func (c *clusterAutoscaler) computeCommand() []string {
 		command = append(command, fmt.Sprintf("--new-pod-scale-up-delay=%s", c.config.NewPodScaleUpDelay.Duration))
 	}
 
+	if c.config.SkipNodesWithCustomControllerPods != nil {
+		command = append(command, fmt.Sprintf("--skip-nodes-with-custom-controller-pods=%t", *c.config.SkipNodesWithCustomControllerPods))
+	}
+
+	if c.config.MaxPodEvictionTime != nil {
+		command = append(command, fmt.Sprintf("--max-pod-eviction-time=%s", c.config.MaxPodEvictionTime.Duration))
+	}
+
+	if c.config.CordonNodeBeforeTerminating != nil {
+		command = append(command, fmt.Sprintf("--cordon-node-before-terminating=%t", *c.config.CordonNodeBeforeTerminating))
+	}
+
+	if c.config.ScaleDownCandidatesPoolRatio != nil {
+		command = append(command, fmt.Sprintf("--scale-down-candidates-pool-ratio=%f", *c.config.ScaleDownCandidatesPoolRatio))
+	}
+
+	if c.config.ScaleDownCandidatesPoolMinCount != nil {
+		command = append(command, fmt.Sprintf("--scale-down-candidates-pool-min-count=%d", *c.config.ScaleDownCandidatesPoolMinCount))
+	}
+
 	for _, taint := range c.config.IgnoreTaints {
 		command = append(command, fmt.Sprintf("--ignore-taint=%s", taint))
 	}
 
-	for _, machineDeployment := range c.machineDeployments {
+	for _, label := range sets.List(sets.New(c.values.BalancingIgnoreLabels...)) {
+		command = append(command, "--balancing-ignore-label="+label)
+	}
+
+	if c.values.RBACNamespace != "" {
+		command = append(command,
+			"--namespace="+c.values.RBACNamespace,
+			"--leader-elect-resource-namespace="+c.values.RBACNamespace,
+		)
+	}
+
+	for _, machineDeployment := range machineDeployments {
 		command = append(command, fmt.Sprintf("--nodes=%d:%d:%s.%s", machineDeployment.Minimum, machineDeployment.Maximum, c.namespace, machineDeployment.Name))
 	}
 
+	for _, name := range sets.List(sets.KeySet(c.values.ExtraArgs)) {
+		command = append(command, fmt.Sprintf("--%s=%s", name, c.values.ExtraArgs[name]))
+	}
+
 	return command
 }
 
+// computeEffectiveConfigData returns the data of the ConfigMap which records the effective configuration of the
+// cluster-autoscaler. It is meant for debugging and for comparing the desired with the actual configuration.
+func computeEffectiveConfigData(command []string, machineDeployments []extensionsv1alpha1.MachineDeployment) map[string]string {
+	bounds := make([]string, 0, len(machineDeployments))
+	for _, machineDeployment := range machineDeployments {
+		bounds = append(bounds, fmt.Sprintf("%d:%d:%s", machineDeployment.Minimum, machineDeployment.Maximum, machineDeployment.Name))
+	}
+
+	return map[string]string{
+		DataKeyFlags:              strings.Join(command[1:], "\n"),
+		DataKeyMachineDeployments: strings.Join(bounds, "\n"),
+	}
+}
+
 func (c *clusterAutoscaler) computeShootResourcesData(serviceAccountName string) (map[string][]byte, error) {
+	rbacNamespace := metav1.NamespaceSystem
+	if c.values.RBACNamespace != "" {
+		rbacNamespace = c.values.RBACNamespace
+	}
+
 	var (
 		registry = managedresources.NewRegistry(kubernetes.ShootScheme, kubernetes.ShootCodec, kubernetes.ShootSerializer)
 
@@ -464,27 +950,33 @@ func (c *clusterAutoscaler) computeShootResourcesData(serviceAccountName string)
 					Resources: []string{"storageclasses", "csinodes", "csidrivers", "csistoragecapacities"},
 					Verbs:     []string{"watch", "list", "get"},
 				},
-				{
-					APIGroups: []string{"coordination.k8s.io"},
-					Resources: []string{"leases"},
-					Verbs:     []string{"create"},
-				},
-				{
-					APIGroups:     []string{"coordination.k8s.io"},
-					Resources:     []string{"leases"},
-					ResourceNames: []string{"cluster-autoscaler"},
-					Verbs:         []string{"get", "update"},
-				},
-				{
-					APIGroups: []string{"batch", "extensions"},
-					Resources: []string{"jobs"},
-					Verbs:     []string{"get", "list", "patch", "watch"},
-				},
-				{
-					APIGroups: []string{"batch"},
-					Resources: []string{"jobs", "cronjobs"},
-					Verbs:     []string{"get", "list", "watch"},
-				},
+			},
+		}
+
+		leaseRules = []rbacv1.PolicyRule{
+			{
+				APIGroups: []string{"coordination.k8s.io"},
+				Resources: []string{"leases"},
+				Verbs:     []string{"create"},
+			},
+			{
+				APIGroups:     []string{"coordination.k8s.io"},
+				Resources:     []string{"leases"},
+				ResourceNames: []string{"cluster-autoscaler"},
+				Verbs:         []string{"get", "update"},
+			},
+		}
+
+		jobRules = []rbacv1.PolicyRule{
+			{
+				APIGroups: []string{"batch", "extensions"},
+				Resources: []string{"jobs"},
+				Verbs:     []string{"get", "list", "patch", "watch"},
+			},
+			{
+				APIGroups: []string{"batch"},
+				Resources: []string{"jobs", "cronjobs"},
+				Verbs:     []string{"get", "list", "watch"},
 			},
 		}
 
@@ -508,7 +1000,7 @@ func (c *clusterAutoscaler) computeShootResourcesData(serviceAccountName string)
 			TypeMeta: metav1.TypeMeta{},
 			ObjectMeta: metav1.ObjectMeta{
 				Name:      "gardener.cloud:target:cluster-autoscaler",
-				Namespace: metav1.NamespaceSystem,
+				Namespace: rbacNamespace,
 			},
 			Rules: []rbacv1.PolicyRule{
 				{
@@ -528,7 +1020,7 @@ func (c *clusterAutoscaler) computeShootResourcesData(serviceAccountName string)
 		rolebinding = &rbacv1.RoleBinding{
 			ObjectMeta: metav1.ObjectMeta{
 				Name:      "gardener.cloud:target:cluster-autoscaler",
-				Namespace: metav1.NamespaceSystem,
+				Namespace: rbacNamespace,
 			},
 			Subjects: []rbacv1.Subject{{
 				Kind: rbacv1.ServiceAccountKind,
@@ -542,10 +1034,180 @@ func (c *clusterAutoscaler) computeShootResourcesData(serviceAccountName string)
 		}
 	)
 
-	return registry.AddAllAndSerialize(
+	objects := []client.Object{
 		clusterRole,
 		clusterRoleBinding,
 		role,
 		rolebinding,
-	)
+	}
+
+	if c.values.RBACNamespace == "" {
+		clusterRole.Rules = append(clusterRole.Rules, leaseRules...)
+	} else {
+		// The permissions for the leases are bound to the dedicated namespace instead of being granted cluster-wide.
+		role.Rules = append(role.Rules, leaseRules...)
+		rolebinding.Subjects[0].Namespace = metav1.NamespaceSystem
+
+		if c.values.RBACNamespace == v1beta1constants.ClusterAutoscalerRBACNamespace {
+			objects = append(objects, &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: c.values.RBACNamespace}})
+		}
+	}
+	clusterRole.Rules = append(clusterRole.Rules, jobRules...)
+
+	if len(c.values.PriorityExpanderPriorities) > 0 {
+		objects = append(objects, &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      ConfigMapNamePriorityExpander,
+				Namespace: rbacNamespace,
+			},
+			Data: map[string]string{DataKeyPriorities: c.priorityExpanderPriorities()},
+		})
+	}
+
+	return registry.AddAllAndSerialize(objects...)
+}
+
+// priorityExpanderPriorities renders the configuration of the priority expander in the YAML format expected by the
+// cluster-autoscaler. The priorities are sorted in descending order, the regular expressions are rendered as quoted
+// strings to prevent them from being interpreted by the YAML parser.
+func (c *clusterAutoscaler) priorityExpanderPriorities() string {
+	priorities := make([]int32, 0, len(c.values.PriorityExpanderPriorities))
+	for priority := range c.values.PriorityExpanderPriorities {
+		priorities = append(priorities, priority)
+	}
+	sort.Slice(priorities, func(i, j int) bool { return priorities[i] > priorities[j] })
+
+	var out strings.Builder
+	for _, priority := range priorities {
+		fmt.Fprintf(&out, "%d:\n", priority)
+		for _, expression := range c.values.PriorityExpanderPriorities[priority] {
+			fmt.Fprintf(&out, "- %s\n", strconv.Quote(expression))
+		}
+	}
+	return out.String()
+}
+
+// validateValues checks that the extra args, extra environment variables, and image pull secrets neither are malformed
+// nor conflict with the configuration managed by this component.
+func (c *clusterAutoscaler) validateValues() error {
+	for name := range c.values.ExtraArgs {
+		if !extraArgNameRegex.MatchString(name) {
+			return fmt.Errorf("invalid extra arg name %q, must match %s", name, extraArgNameRegex.String())
+		}
+		if managedArgs.Has(name) {
+			return fmt.Errorf("extra arg %q must not override a flag managed by gardener", name)
+		}
+	}
+
+	envNames := sets.New[string]()
+	for _, env := range c.values.ExtraEnv {
+		if errs := validation.IsEnvVarName(env.Name); len(errs) > 0 {
+			return fmt.Errorf("invalid extra env name %q: %s", env.Name, strings.Join(errs, ", "))
+		}
+		if env.Name == envControlNamespace || env.Name == envTargetKubeconfig {
+			return fmt.Errorf("extra env %q must not override an environment variable managed by gardener", env.Name)
+		}
+		if envNames.Has(env.Name) {
+			return fmt.Errorf("duplicate extra env %q", env.Name)
+		}
+		envNames.Insert(env.Name)
+	}
+
+	for _, name := range c.values.ImagePullSecrets {
+		if errs := validation.IsDNS1123Subdomain(name); len(errs) > 0 {
+			return fmt.Errorf("invalid image pull secret name %q: %s", name, strings.Join(errs, ", "))
+		}
+	}
+
+	if c.values.RBACNamespace != "" && !allowedRBACNamespaces.Has(c.values.RBACNamespace) {
+		return fmt.Errorf("invalid RBAC namespace %q, must be one of %v", c.values.RBACNamespace, sets.List(allowedRBACNamespaces))
+	}
+
+	if c.values.TerminationGracePeriodSeconds != nil && *c.values.TerminationGracePeriodSeconds < 0 {
+		return fmt.Errorf("termination grace period must not be negative but got %d", *c.values.TerminationGracePeriodSeconds)
+	}
+
+	if c.values.PreStop != nil && c.values.PreStop.Exec == nil && c.values.PreStop.HTTPGet == nil && c.values.PreStop.TCPSocket == nil {
+		return fmt.Errorf("preStop hook must specify a handler")
+	}
+
+	for _, label := range c.values.BalancingIgnoreLabels {
+		if errs := validation.IsQualifiedName(label); len(errs) > 0 {
+			return fmt.Errorf("invalid balancing ignore label %q: %s", label, strings.Join(errs, ", "))
+		}
+	}
+
+	for priority, expressions := range c.values.PriorityExpanderPriorities {
+		if len(expressions) == 0 {
+			return fmt.Errorf("priority %d of the priority expander must have at least one node group expression", priority)
+		}
+		for _, expression := range expressions {
+			if _, err := regexp.Compile(expression); err != nil {
+				return fmt.Errorf("invalid node group expression %q for priority %d of the priority expander: %w", expression, priority, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// validateBalancingIgnoreLabels returns an error if the architecture label is ignored for balancing although the given
+// worker pools mix architectures. Worker pools without architecture are not considered.
+func validateBalancingIgnoreLabels(balancingIgnoreLabels []string, workerPools []WorkerPool) error {
+	if !sets.New(balancingIgnoreLabels...).Has(corev1.LabelArchStable) {
+		return nil
+	}
+
+	architectures := sets.New[string]()
+	for _, pool := range workerPools {
+		if pool.Architecture != "" {
+			architectures.Insert(pool.Architecture)
+		}
+	}
+
+	if architectures.Len() > 1 {
+		return fmt.Errorf("balancing ignore label %q must not be set since the worker pools mix the architectures %s", corev1.LabelArchStable, strings.Join(sets.List(architectures), ", "))
+	}
+	return nil
+}
+
+func (c *clusterAutoscaler) waitForMachineControllerManagerInitContainer() corev1.Container {
+	return corev1.Container{
+		Name:            initContainerNameWaitForMCM,
+		Image:           c.values.WaitForMachineControllerManagerImage,
+		ImagePullPolicy: corev1.PullIfNotPresent,
+		Command: []string{
+			"sh",
+			"-c",
+			fmt.Sprintf(`until wget -q -T 5 -O /dev/null http://%s:%d/healthz; do echo "waiting for machine-controller-manager to be ready"; sleep 2; done`, v1beta1constants.DeploymentNameMachineControllerManager, portMetricsMachineControllerManager),
+		},
+		Resources: corev1.ResourceRequirements{
+			Requests: corev1.ResourceList{
+				corev1.ResourceCPU:    resource.MustParse("5m"),
+				corev1.ResourceMemory: resource.MustParse("10Mi"),
+			},
+		},
+	}
+}
+
+func (c *clusterAutoscaler) computeExtraEnv() []corev1.EnvVar {
+	if len(c.values.ExtraEnv) == 0 {
+		return nil
+	}
+
+	env := append([]corev1.EnvVar{}, c.values.ExtraEnv...)
+	sort.Slice(env, func(i, j int) bool { return env[i].Name < env[j].Name })
+	return env
+}
+
+func (c *clusterAutoscaler) computeImagePullSecrets() []corev1.LocalObjectReference {
+	if len(c.values.ImagePullSecrets) == 0 {
+		return nil
+	}
+
+	var imagePullSecrets []corev1.LocalObjectReference
+	for _, name := range sets.List(sets.New(c.values.ImagePullSecrets...)) {
+		imagePullSecrets = append(imagePullSecrets, corev1.LocalObjectReference{Name: name})
+	}
+	return imagePullSecrets
 }
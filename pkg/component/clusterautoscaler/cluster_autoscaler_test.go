@@ -16,11 +16,15 @@ package clusterautoscaler_test
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"strings"
 	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	"github.com/prometheus/client_golang/prometheus/testutil"
 	"go.uber.org/mock/gomock"
 	appsv1 "k8s.io/api/apps/v1"
 	autoscalingv1 "k8s.io/api/autoscaling/v1"
@@ -29,13 +33,16 @@ import (
 	rbacv1 "k8s.io/api/rbac/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/intstr"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	vpaautoscalingv1 "k8s.io/autoscaler/vertical-pod-autoscaler/pkg/apis/autoscaling.k8s.io/v1"
+	"k8s.io/client-go/tools/record"
 	"k8s.io/utils/pointer"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
 
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
@@ -46,8 +53,11 @@ import (
 	mockclient "github.com/gardener/gardener/pkg/mock/controller-runtime/client"
 	"github.com/gardener/gardener/pkg/resourcemanager/controller/garbagecollector/references"
 	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
+	"github.com/gardener/gardener/pkg/utils/retry"
+	retryfake "github.com/gardener/gardener/pkg/utils/retry/fake"
 	secretsmanager "github.com/gardener/gardener/pkg/utils/secrets/manager"
 	fakesecretsmanager "github.com/gardener/gardener/pkg/utils/secrets/manager/fake"
+	"github.com/gardener/gardener/pkg/utils/test"
 	. "github.com/gardener/gardener/pkg/utils/test/matchers"
 )
 
@@ -87,17 +97,23 @@ var _ = Describe("ClusterAutoscaler", func() {
 		configScaleDownUtilizationThreshold       = pointer.Float64(1.2345)
 		configScanInterval                        = &metav1.Duration{Duration: time.Second}
 		configIgnoreTaints                        = []string{"taint-1", "taint-2"}
+		configMaxPodEvictionTime                  = &metav1.Duration{Duration: 5 * time.Minute}
 		configFull                                = &gardencorev1beta1.ClusterAutoscaler{
-			Expander:                      &configExpander,
-			MaxGracefulTerminationSeconds: &configMaxGracefulTerminationSeconds,
-			MaxNodeProvisionTime:          configMaxNodeProvisionTime,
-			ScaleDownDelayAfterAdd:        configScaleDownDelayAfterAdd,
-			ScaleDownDelayAfterDelete:     configScaleDownDelayAfterDelete,
-			ScaleDownDelayAfterFailure:    configScaleDownDelayAfterFailure,
-			ScaleDownUnneededTime:         configScaleDownUnneededTime,
-			ScaleDownUtilizationThreshold: configScaleDownUtilizationThreshold,
-			ScanInterval:                  configScanInterval,
-			IgnoreTaints:                  configIgnoreTaints,
+			Expander:                          &configExpander,
+			MaxGracefulTerminationSeconds:     &configMaxGracefulTerminationSeconds,
+			MaxNodeProvisionTime:              configMaxNodeProvisionTime,
+			ScaleDownDelayAfterAdd:            configScaleDownDelayAfterAdd,
+			ScaleDownDelayAfterDelete:         configScaleDownDelayAfterDelete,
+			ScaleDownDelayAfterFailure:        configScaleDownDelayAfterFailure,
+			ScaleDownUnneededTime:             configScaleDownUnneededTime,
+			ScaleDownUtilizationThreshold:     configScaleDownUtilizationThreshold,
+			ScanInterval:                      configScanInterval,
+			IgnoreTaints:                      configIgnoreTaints,
+			SkipNodesWithCustomControllerPods: pointer.Bool(false),
+			MaxPodEvictionTime:                configMaxPodEvictionTime,
+			CordonNodeBeforeTerminating:       pointer.Bool(true),
+			ScaleDownCandidatesPoolRatio:      pointer.Float64(0.05),
+			ScaleDownCandidatesPoolMinCount:   pointer.Int32(20),
 		}
 
 		genericTokenKubeconfigSecretName = "generic-token-kubeconfig"
@@ -108,6 +124,7 @@ var _ = Describe("ClusterAutoscaler", func() {
 		pdbName                          = "cluster-autoscaler"
 		serviceName                      = "cluster-autoscaler"
 		deploymentName                   = "cluster-autoscaler"
+		effectiveConfigMapName           = "cluster-autoscaler-effective-config"
 		managedResourceName              = "shoot-core-cluster-autoscaler"
 		managedResourceSecretName        = "managedresource-shoot-core-cluster-autoscaler"
 
@@ -284,6 +301,11 @@ var _ = Describe("ClusterAutoscaler", func() {
 					fmt.Sprintf("--scale-down-delay-after-delete=%s", configScaleDownDelayAfterDelete.Duration),
 					fmt.Sprintf("--scale-down-delay-after-failure=%s", configScaleDownDelayAfterFailure.Duration),
 					fmt.Sprintf("--scan-interval=%s", configScanInterval.Duration),
+					"--skip-nodes-with-custom-controller-pods=false",
+					fmt.Sprintf("--max-pod-eviction-time=%s", configMaxPodEvictionTime.Duration),
+					"--cordon-node-before-terminating=true",
+					"--scale-down-candidates-pool-ratio=0.050000",
+					"--scale-down-candidates-pool-min-count=20",
 					fmt.Sprintf("--ignore-taint=%s", configIgnoreTaints[0]),
 					fmt.Sprintf("--ignore-taint=%s", configIgnoreTaints[1]),
 				)
@@ -334,6 +356,9 @@ var _ = Describe("ClusterAutoscaler", func() {
 					},
 					Template: corev1.PodTemplateSpec{
 						ObjectMeta: metav1.ObjectMeta{
+							Annotations: map[string]string{
+								"checksum/credentials": "3a9a9ae53c28478c96c5859e7bc78cd8aeb87d383c54493438e839f657b239c6",
+							},
 							Labels: map[string]string{
 								"app":                                "kubernetes",
 								"role":                               "cluster-autoscaler",
@@ -615,7 +640,7 @@ subjects:
 		By("Create secrets managed outside of this package for whose secretsmanager.Get() will be called")
 		Expect(fakeClient.Create(ctx, &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "generic-token-kubeconfig", Namespace: namespace}})).To(Succeed())
 
-		clusterAutoscaler = New(c, namespace, sm, image, replicas, nil)
+		clusterAutoscaler = New(c, namespace, sm, image, replicas, nil, Values{})
 		clusterAutoscaler.SetNamespaceUID(namespaceUID)
 		clusterAutoscaler.SetMachineDeployments(machineDeployments)
 	})
@@ -632,7 +657,7 @@ subjects:
 					config = configFull
 				}
 
-				clusterAutoscaler = New(fakeClient, namespace, sm, image, replicas, config)
+				clusterAutoscaler = New(fakeClient, namespace, sm, image, replicas, config, Values{})
 				clusterAutoscaler.SetNamespaceUID(namespaceUID)
 				clusterAutoscaler.SetMachineDeployments(machineDeployments)
 
@@ -683,6 +708,627 @@ subjects:
 			It("w/o config", func() { test(false) })
 			It("w/ config", func() { test(true) })
 		})
+
+		Context("effective configuration", func() {
+			BeforeEach(func() {
+				clusterAutoscaler = New(fakeClient, namespace, sm, image, replicas, nil, Values{ExtraArgs: map[string]string{"foo": "bar"}})
+				clusterAutoscaler.SetNamespaceUID(namespaceUID)
+				clusterAutoscaler.SetMachineDeployments(machineDeployments)
+			})
+
+			It("should record the resolved flags and bounds owner-referenced to the deployment", func() {
+				Expect(clusterAutoscaler.Deploy(ctx)).To(Succeed())
+
+				actualDeployment := &appsv1.Deployment{}
+				Expect(fakeClient.Get(ctx, client.ObjectKey{Namespace: namespace, Name: deploymentName}, actualDeployment)).To(Succeed())
+
+				configMap := &corev1.ConfigMap{}
+				Expect(fakeClient.Get(ctx, client.ObjectKey{Namespace: namespace, Name: effectiveConfigMapName}, configMap)).To(Succeed())
+				Expect(configMap.Labels).To(Equal(map[string]string{"app": "kubernetes", "role": "cluster-autoscaler"}))
+				Expect(configMap.OwnerReferences).To(ConsistOf(metav1.OwnerReference{
+					APIVersion:         "apps/v1",
+					Kind:               "Deployment",
+					Name:               deploymentName,
+					UID:                actualDeployment.UID,
+					Controller:         pointer.Bool(true),
+					BlockOwnerDeletion: pointer.Bool(true),
+				}))
+				Expect(configMap.Data).To(HaveKeyWithValue("flags", strings.Join(actualDeployment.Spec.Template.Spec.Containers[0].Command[1:], "\n")))
+				Expect(configMap.Data).To(HaveKeyWithValue("flags", ContainSubstring("--foo=bar")))
+				Expect(configMap.Data).To(HaveKeyWithValue("machineDeployments", fmt.Sprintf("%d:%d:%s\n%d:%d:%s",
+					machineDeployment1Min, machineDeployment1Max, machineDeployment1Name,
+					machineDeployment2Min, machineDeployment2Max, machineDeployment2Name,
+				)))
+			})
+
+			It("should update the configuration on each deployment", func() {
+				Expect(clusterAutoscaler.Deploy(ctx)).To(Succeed())
+
+				clusterAutoscaler.SetMachineDeployments(machineDeployments[:1])
+				Expect(clusterAutoscaler.Deploy(ctx)).To(Succeed())
+
+				configMap := &corev1.ConfigMap{}
+				Expect(fakeClient.Get(ctx, client.ObjectKey{Namespace: namespace, Name: effectiveConfigMapName}, configMap)).To(Succeed())
+				Expect(configMap.Data).To(HaveKeyWithValue("machineDeployments", fmt.Sprintf("%d:%d:%s", machineDeployment1Min, machineDeployment1Max, machineDeployment1Name)))
+				Expect(configMap.Data).To(HaveKeyWithValue("flags", Not(ContainSubstring(machineDeployment2Name))))
+			})
+
+			It("should fail if node group names are invalid", func() {
+				clusterAutoscaler.SetMachineDeployments([]extensionsv1alpha1.MachineDeployment{{Name: "pool_1"}})
+				Expect(clusterAutoscaler.Deploy(ctx)).To(MatchError(ContainSubstring(`name of machine deployment "pool_1" is invalid`)))
+			})
+		})
+
+		Context("node group bounds changes", func() {
+			var recorder *record.FakeRecorder
+
+			BeforeEach(func() {
+				NodeGroupBoundsChanges.Reset()
+				recorder = record.NewFakeRecorder(10)
+
+				clusterAutoscaler = New(fakeClient, namespace, sm, image, replicas, nil, Values{Recorder: recorder})
+				clusterAutoscaler.SetNamespaceUID(namespaceUID)
+				clusterAutoscaler.SetMachineDeployments(machineDeployments)
+			})
+
+			It("should not report anything on the first deployment", func() {
+				Expect(clusterAutoscaler.Deploy(ctx)).To(Succeed())
+
+				Expect(testutil.CollectAndCount(NodeGroupBoundsChanges)).To(BeZero())
+				Expect(recorder.Events).To(BeEmpty())
+			})
+
+			It("should not report anything if the bounds did not change", func() {
+				Expect(clusterAutoscaler.Deploy(ctx)).To(Succeed())
+				Expect(clusterAutoscaler.Deploy(ctx)).To(Succeed())
+
+				Expect(testutil.CollectAndCount(NodeGroupBoundsChanges)).To(BeZero())
+				Expect(recorder.Events).To(BeEmpty())
+			})
+
+			It("should report changed, added, and removed node groups", func() {
+				Expect(clusterAutoscaler.Deploy(ctx)).To(Succeed())
+
+				clusterAutoscaler.SetMachineDeployments([]extensionsv1alpha1.MachineDeployment{
+					{Name: machineDeployment1Name, Minimum: machineDeployment1Min - 1, Maximum: machineDeployment1Max + 2},
+					{Name: "pool3", Minimum: 1, Maximum: 3},
+				})
+				Expect(clusterAutoscaler.Deploy(ctx)).To(Succeed())
+
+				Expect(testutil.ToFloat64(NodeGroupBoundsChanges.WithLabelValues(namespace, machineDeployment1Name, "min", "decrease"))).To(Equal(float64(1)))
+				Expect(testutil.ToFloat64(NodeGroupBoundsChanges.WithLabelValues(namespace, machineDeployment1Name, "max", "increase"))).To(Equal(float64(1)))
+				Expect(testutil.ToFloat64(NodeGroupBoundsChanges.WithLabelValues(namespace, machineDeployment2Name, "min", "decrease"))).To(Equal(float64(1)))
+				Expect(testutil.ToFloat64(NodeGroupBoundsChanges.WithLabelValues(namespace, machineDeployment2Name, "max", "decrease"))).To(Equal(float64(1)))
+				Expect(testutil.ToFloat64(NodeGroupBoundsChanges.WithLabelValues(namespace, "pool3", "min", "increase"))).To(Equal(float64(1)))
+				Expect(testutil.ToFloat64(NodeGroupBoundsChanges.WithLabelValues(namespace, "pool3", "max", "increase"))).To(Equal(float64(1)))
+				Expect(testutil.CollectAndCount(NodeGroupBoundsChanges)).To(Equal(6))
+
+				Expect(recorder.Events).To(HaveLen(3))
+				Expect(<-recorder.Events).To(Equal(`Normal NodeGroupBoundsChanged Changed bounds of node group "pool1" from 2:4 to 1:6`))
+				Expect(<-recorder.Events).To(Equal(`Normal NodeGroupBoundsChanged Removed node group "pool2" with bounds 3:5`))
+				Expect(<-recorder.Events).To(Equal(`Normal NodeGroupBoundsChanged Added node group "pool3" with bounds 1:3`))
+			})
+
+			It("should only count the changes if no recorder is configured", func() {
+				clusterAutoscaler = New(fakeClient, namespace, sm, image, replicas, nil, Values{})
+				clusterAutoscaler.SetNamespaceUID(namespaceUID)
+				clusterAutoscaler.SetMachineDeployments(machineDeployments)
+				Expect(clusterAutoscaler.Deploy(ctx)).To(Succeed())
+
+				clusterAutoscaler.SetMachineDeployments([]extensionsv1alpha1.MachineDeployment{
+					{Name: machineDeployment1Name, Minimum: machineDeployment1Min, Maximum: machineDeployment1Max + 1},
+					{Name: machineDeployment2Name, Minimum: machineDeployment2Min, Maximum: machineDeployment2Max},
+				})
+				Expect(clusterAutoscaler.Deploy(ctx)).To(Succeed())
+
+				Expect(testutil.ToFloat64(NodeGroupBoundsChanges.WithLabelValues(namespace, machineDeployment1Name, "max", "increase"))).To(Equal(float64(1)))
+				Expect(testutil.CollectAndCount(NodeGroupBoundsChanges)).To(Equal(1))
+			})
+		})
+
+		Context("with values", func() {
+			var values Values
+
+			BeforeEach(func() {
+				values = Values{
+					ExtraArgs:        map[string]string{"provider-flag": "b", "another-flag": "a"},
+					ExtraEnv:         []corev1.EnvVar{{Name: "ZZZ", Value: "z"}, {Name: "AAA", Value: "a"}},
+					ImagePullSecrets: []string{"pull-secret-b", "pull-secret-a", "pull-secret-b"},
+				}
+			})
+
+			deploy := func() (*appsv1.Deployment, error) {
+				clusterAutoscaler = New(fakeClient, namespace, sm, image, replicas, nil, values)
+				clusterAutoscaler.SetNamespaceUID(namespaceUID)
+				clusterAutoscaler.SetMachineDeployments(machineDeployments)
+
+				if err := clusterAutoscaler.Deploy(ctx); err != nil {
+					return nil, err
+				}
+
+				actualDeployment := &appsv1.Deployment{}
+				Expect(fakeClient.Get(ctx, client.ObjectKey{Namespace: namespace, Name: deploymentName}, actualDeployment)).To(Succeed())
+				return actualDeployment, nil
+			}
+
+			It("should render the extra args, extra env, and image pull secrets in a stable order", func() {
+				actualDeployment, err := deploy()
+				Expect(err).NotTo(HaveOccurred())
+
+				command := actualDeployment.Spec.Template.Spec.Containers[0].Command
+				Expect(command[len(command)-2:]).To(Equal([]string{"--another-flag=a", "--provider-flag=b"}))
+				Expect(actualDeployment.Spec.Template.Spec.Containers[0].Env).To(Equal([]corev1.EnvVar{
+					{Name: "CONTROL_NAMESPACE", Value: namespace},
+					{Name: "TARGET_KUBECONFIG", Value: "/var/run/secrets/gardener.cloud/shoot/generic-kubeconfig/kubeconfig"},
+					{Name: "AAA", Value: "a"},
+					{Name: "ZZZ", Value: "z"},
+				}))
+				Expect(actualDeployment.Spec.Template.Spec.ImagePullSecrets).To(Equal([]corev1.LocalObjectReference{
+					{Name: "pull-secret-a"},
+					{Name: "pull-secret-b"},
+				}))
+			})
+
+			It("should fail if an extra arg overrides a managed flag", func() {
+				values.ExtraArgs = map[string]string{"nodes": "1:2:foo"}
+
+				_, err := deploy()
+				Expect(err).To(MatchError(ContainSubstring("must not override a flag managed by gardener")))
+			})
+
+			It("should fail if an extra arg name is invalid", func() {
+				values.ExtraArgs = map[string]string{"--foo": "bar"}
+
+				_, err := deploy()
+				Expect(err).To(MatchError(ContainSubstring("invalid extra arg name")))
+			})
+
+			It("should fail if an extra env overrides a managed environment variable", func() {
+				values.ExtraEnv = []corev1.EnvVar{{Name: "CONTROL_NAMESPACE", Value: "foo"}}
+
+				_, err := deploy()
+				Expect(err).To(MatchError(ContainSubstring("must not override an environment variable managed by gardener")))
+			})
+
+			It("should fail if an extra env is duplicated", func() {
+				values.ExtraEnv = []corev1.EnvVar{{Name: "FOO"}, {Name: "FOO"}}
+
+				_, err := deploy()
+				Expect(err).To(MatchError(ContainSubstring("duplicate extra env")))
+			})
+
+			It("should fail if an image pull secret name is invalid", func() {
+				values.ImagePullSecrets = []string{"Invalid_Name"}
+
+				_, err := deploy()
+				Expect(err).To(MatchError(ContainSubstring("invalid image pull secret name")))
+			})
+
+			It("should render the balancing ignore labels in a stable order", func() {
+				values.BalancingIgnoreLabels = []string{"topology.ebs.csi.aws.com/zone", "kubernetes.io/arch", "topology.ebs.csi.aws.com/zone"}
+
+				actualDeployment, err := deploy()
+				Expect(err).NotTo(HaveOccurred())
+
+				var balancingIgnoreLabelFlags []string
+				for _, flag := range actualDeployment.Spec.Template.Spec.Containers[0].Command {
+					if strings.HasPrefix(flag, "--balancing-ignore-label=") {
+						balancingIgnoreLabelFlags = append(balancingIgnoreLabelFlags, flag)
+					}
+				}
+				Expect(balancingIgnoreLabelFlags).To(Equal([]string{
+					"--balancing-ignore-label=kubernetes.io/arch",
+					"--balancing-ignore-label=topology.ebs.csi.aws.com/zone",
+				}))
+			})
+
+			It("should fail if a balancing ignore label is invalid", func() {
+				values.BalancingIgnoreLabels = []string{"foo/bar/baz"}
+
+				_, err := deploy()
+				Expect(err).To(MatchError(ContainSubstring("invalid balancing ignore label")))
+			})
+		})
+
+		Context("waiting for the machine-controller-manager", func() {
+			It("should add an init container waiting for the machine-controller-manager", func() {
+				clusterAutoscaler = New(fakeClient, namespace, sm, image, replicas, nil, Values{WaitForMachineControllerManagerImage: "alpine:3.18"})
+				clusterAutoscaler.SetNamespaceUID(namespaceUID)
+				clusterAutoscaler.SetMachineDeployments(machineDeployments)
+
+				Expect(clusterAutoscaler.Deploy(ctx)).To(Succeed())
+
+				actualDeployment := &appsv1.Deployment{}
+				Expect(fakeClient.Get(ctx, client.ObjectKey{Namespace: namespace, Name: deploymentName}, actualDeployment)).To(Succeed())
+				Expect(actualDeployment.Spec.Template.Labels).To(HaveKeyWithValue("networking.resources.gardener.cloud/to-machine-controller-manager-tcp-10258", "allowed"))
+				Expect(actualDeployment.Spec.Template.Spec.InitContainers).To(ConsistOf(MatchFields(IgnoreExtras, Fields{
+					"Name":    Equal("wait-for-machine-controller-manager"),
+					"Image":   Equal("alpine:3.18"),
+					"Command": ContainElement(ContainSubstring("http://machine-controller-manager:10258/healthz")),
+				})))
+			})
+
+			It("should not add an init container by default", func() {
+				clusterAutoscaler = New(fakeClient, namespace, sm, image, replicas, nil, Values{})
+				clusterAutoscaler.SetNamespaceUID(namespaceUID)
+				clusterAutoscaler.SetMachineDeployments(machineDeployments)
+
+				Expect(clusterAutoscaler.Deploy(ctx)).To(Succeed())
+
+				actualDeployment := &appsv1.Deployment{}
+				Expect(fakeClient.Get(ctx, client.ObjectKey{Namespace: namespace, Name: deploymentName}, actualDeployment)).To(Succeed())
+				Expect(actualDeployment.Spec.Template.Spec.InitContainers).To(BeEmpty())
+			})
+		})
+
+		Context("credentials rotation", func() {
+			It("should change the credentials checksum when the rotation marker changes", func() {
+				clusterAutoscaler = New(fakeClient, namespace, sm, image, replicas, nil, Values{})
+				clusterAutoscaler.SetNamespaceUID(namespaceUID)
+				clusterAutoscaler.SetMachineDeployments(machineDeployments)
+
+				Expect(clusterAutoscaler.Deploy(ctx)).To(Succeed())
+
+				actualDeployment := &appsv1.Deployment{}
+				Expect(fakeClient.Get(ctx, client.ObjectKey{Namespace: namespace, Name: deploymentName}, actualDeployment)).To(Succeed())
+				Expect(actualDeployment.Spec.Template.Annotations).To(HaveKeyWithValue("checksum/credentials", "3a9a9ae53c28478c96c5859e7bc78cd8aeb87d383c54493438e839f657b239c6"))
+
+				clusterAutoscaler.SetCredentialsRotationMarker("sa:2023-10-01T10:00:00Z")
+				Expect(clusterAutoscaler.Deploy(ctx)).To(Succeed())
+
+				Expect(fakeClient.Get(ctx, client.ObjectKey{Namespace: namespace, Name: deploymentName}, actualDeployment)).To(Succeed())
+				Expect(actualDeployment.Spec.Template.Annotations).To(HaveKeyWithValue("checksum/credentials", "091c1c0ad96b9571078ca3d213a42380a1c8bc26986276cdae705a2625766029"))
+			})
+		})
+
+		Context("with a dedicated RBAC namespace", func() {
+			var actualMRSecret *corev1.Secret
+
+			deploy := func(rbacNamespace string) {
+				clusterAutoscaler = New(fakeClient, namespace, sm, image, replicas, nil, Values{RBACNamespace: rbacNamespace})
+				clusterAutoscaler.SetNamespaceUID(namespaceUID)
+				clusterAutoscaler.SetMachineDeployments(machineDeployments)
+
+				Expect(clusterAutoscaler.Deploy(ctx)).To(Succeed())
+
+				actualMr := &resourcesv1alpha1.ManagedResource{}
+				Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(managedResource), actualMr)).To(Succeed())
+				actualMRSecret = &corev1.Secret{}
+				Expect(fakeClient.Get(ctx, client.ObjectKey{Namespace: namespace, Name: actualMr.Spec.SecretRefs[0].Name}, actualMRSecret)).To(Succeed())
+			}
+
+			It("should bind the permissions for the status ConfigMap and the leases to the dedicated namespace", func() {
+				deploy("gardener-cluster-autoscaler")
+
+				Expect(actualMRSecret.Data).To(HaveLen(5))
+				Expect(actualMRSecret.Data).To(HaveKey("namespace____gardener-cluster-autoscaler.yaml"))
+				Expect(string(actualMRSecret.Data["clusterrole____gardener.cloud_target_cluster-autoscaler.yaml"])).NotTo(ContainSubstring("leases"))
+				Expect(string(actualMRSecret.Data["role__gardener-cluster-autoscaler__gardener.cloud_target_cluster-autoscaler.yaml"])).To(And(
+					ContainSubstring("configmaps"),
+					ContainSubstring("leases"),
+				))
+				Expect(string(actualMRSecret.Data["rolebinding__gardener-cluster-autoscaler__gardener.cloud_target_cluster-autoscaler.yaml"])).To(ContainSubstring(`subjects:
+- kind: ServiceAccount
+  name: cluster-autoscaler
+  namespace: kube-system
+`))
+
+				actualDeployment := &appsv1.Deployment{}
+				Expect(fakeClient.Get(ctx, client.ObjectKey{Namespace: namespace, Name: deploymentName}, actualDeployment)).To(Succeed())
+				Expect(actualDeployment.Spec.Template.Spec.Containers[0].Command).To(ContainElements(
+					"--namespace=gardener-cluster-autoscaler",
+					"--leader-elect-resource-namespace=gardener-cluster-autoscaler",
+				))
+			})
+
+			It("should not render the namespace if it is kube-system", func() {
+				deploy("kube-system")
+
+				Expect(actualMRSecret.Data).To(HaveLen(4))
+				Expect(string(actualMRSecret.Data["clusterrole____gardener.cloud_target_cluster-autoscaler.yaml"])).NotTo(ContainSubstring("leases"))
+				Expect(string(actualMRSecret.Data["role__kube-system__gardener.cloud_target_cluster-autoscaler.yaml"])).To(ContainSubstring("leases"))
+			})
+
+			It("should fail if the namespace is not owned by gardener", func() {
+				clusterAutoscaler = New(fakeClient, namespace, sm, image, replicas, nil, Values{RBACNamespace: "default"})
+				clusterAutoscaler.SetNamespaceUID(namespaceUID)
+				clusterAutoscaler.SetMachineDeployments(machineDeployments)
+
+				Expect(clusterAutoscaler.Deploy(ctx)).To(MatchError(ContainSubstring(`invalid RBAC namespace "default"`)))
+			})
+		})
+
+		Context("with a priority expander configuration", func() {
+			deploy := func(values Values) (*corev1.Secret, error) {
+				clusterAutoscaler = New(fakeClient, namespace, sm, image, replicas, nil, values)
+				clusterAutoscaler.SetNamespaceUID(namespaceUID)
+				clusterAutoscaler.SetMachineDeployments(machineDeployments)
+
+				if err := clusterAutoscaler.Deploy(ctx); err != nil {
+					return nil, err
+				}
+
+				actualMr := &resourcesv1alpha1.ManagedResource{}
+				Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(managedResource), actualMr)).To(Succeed())
+				actualMRSecret := &corev1.Secret{}
+				Expect(fakeClient.Get(ctx, client.ObjectKey{Namespace: namespace, Name: actualMr.Spec.SecretRefs[0].Name}, actualMRSecret)).To(Succeed())
+				return actualMRSecret, nil
+			}
+
+			It("should manage the priority expander config map with the priorities in descending order", func() {
+				actualMRSecret, err := deploy(Values{PriorityExpanderPriorities: map[int32][]string{
+					10: {".*"},
+					50: {`.*\.pool-a-z1`, ".*pool-b.*"},
+				}})
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(actualMRSecret.Data).To(HaveLen(5))
+				Expect(string(actualMRSecret.Data["configmap__kube-system__cluster-autoscaler-priority-expander.yaml"])).To(Equal(`apiVersion: v1
+data:
+  priorities: |
+    50:
+    - ".*\\.pool-a-z1"
+    - ".*pool-b.*"
+    10:
+    - ".*"
+kind: ConfigMap
+metadata:
+  creationTimestamp: null
+  name: cluster-autoscaler-priority-expander
+  namespace: kube-system
+`))
+			})
+
+			It("should manage the priority expander config map in the dedicated RBAC namespace", func() {
+				actualMRSecret, err := deploy(Values{RBACNamespace: "gardener-cluster-autoscaler", PriorityExpanderPriorities: map[int32][]string{10: {".*"}}})
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(actualMRSecret.Data).To(HaveKey("configmap__gardener-cluster-autoscaler__cluster-autoscaler-priority-expander.yaml"))
+			})
+
+			It("should not manage the priority expander config map by default", func() {
+				actualMRSecret, err := deploy(Values{})
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(actualMRSecret.Data).To(HaveLen(4))
+			})
+
+			It("should fail if a node group expression is invalid", func() {
+				_, err := deploy(Values{PriorityExpanderPriorities: map[int32][]string{10: {"("}}})
+				Expect(err).To(MatchError(ContainSubstring("invalid node group expression")))
+			})
+
+			It("should fail if a priority has no node group expressions", func() {
+				_, err := deploy(Values{PriorityExpanderPriorities: map[int32][]string{10: nil}})
+				Expect(err).To(MatchError(ContainSubstring("must have at least one node group expression")))
+			})
+		})
+
+		Context("reading bounds from the cluster resource", func() {
+			BeforeEach(func() {
+				clusterAutoscaler = New(fakeClient, namespace, sm, image, replicas, nil, Values{ReadBoundsFromCluster: true})
+				clusterAutoscaler.SetNamespaceUID(namespaceUID)
+			})
+
+			It("should derive the bounds from the shoot in the cluster resource", func() {
+				shoot := &gardencorev1beta1.Shoot{
+					TypeMeta:   metav1.TypeMeta{APIVersion: gardencorev1beta1.SchemeGroupVersion.String(), Kind: "Shoot"},
+					ObjectMeta: metav1.ObjectMeta{Name: "shoot"},
+					Spec: gardencorev1beta1.ShootSpec{
+						Provider: gardencorev1beta1.Provider{
+							Workers: []gardencorev1beta1.Worker{
+								{Name: "pool1", Minimum: 3, Maximum: 5, Zones: []string{"zone-a", "zone-b"}},
+								{Name: "pool2", Minimum: 2, Maximum: 2, Zones: []string{"zone-a"}},
+								{Name: "pool3", Minimum: 1, Maximum: 3, Zones: []string{"zone-a"}},
+							},
+						},
+					},
+				}
+				shootRaw, err := json.Marshal(shoot)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(fakeClient.Create(ctx, &extensionsv1alpha1.Cluster{
+					ObjectMeta: metav1.ObjectMeta{Name: namespace},
+					Spec: extensionsv1alpha1.ClusterSpec{
+						CloudProfile: runtime.RawExtension{Raw: []byte("{}")},
+						Seed:         runtime.RawExtension{Raw: []byte("{}")},
+						Shoot:        runtime.RawExtension{Raw: shootRaw},
+					},
+				})).To(Succeed())
+				Expect(fakeClient.Create(ctx, &extensionsv1alpha1.Worker{
+					ObjectMeta: metav1.ObjectMeta{Name: "shoot", Namespace: namespace},
+					Status: extensionsv1alpha1.WorkerStatus{
+						MachineDeployments: []extensionsv1alpha1.MachineDeployment{
+							{Name: namespace + "-pool1-z1", Minimum: 1, Maximum: 2},
+							{Name: namespace + "-pool1-z2", Minimum: 2, Maximum: 3},
+							{Name: namespace + "-pool2-z1", Minimum: 2, Maximum: 2},
+						},
+					},
+				})).To(Succeed())
+
+				Expect(clusterAutoscaler.Deploy(ctx)).To(Succeed())
+
+				actualDeployment := &appsv1.Deployment{}
+				Expect(fakeClient.Get(ctx, client.ObjectKey{Namespace: namespace, Name: deploymentName}, actualDeployment)).To(Succeed())
+				command := actualDeployment.Spec.Template.Spec.Containers[0].Command
+				Expect(command).To(ContainElements(
+					fmt.Sprintf("--nodes=2:3:%s.%s-pool1-z1", namespace, namespace),
+					fmt.Sprintf("--nodes=1:2:%s.%s-pool1-z2", namespace, namespace),
+				))
+				Expect(command).NotTo(ContainElement(ContainSubstring("pool2")))
+				Expect(command).NotTo(ContainElement(ContainSubstring("pool3")))
+			})
+
+			It("should fail if the worker resource does not exist", func() {
+				shootRaw, err := json.Marshal(&gardencorev1beta1.Shoot{ObjectMeta: metav1.ObjectMeta{Name: "shoot"}})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(fakeClient.Create(ctx, &extensionsv1alpha1.Cluster{
+					ObjectMeta: metav1.ObjectMeta{Name: namespace},
+					Spec: extensionsv1alpha1.ClusterSpec{
+						CloudProfile: runtime.RawExtension{Raw: []byte("{}")},
+						Seed:         runtime.RawExtension{Raw: []byte("{}")},
+						Shoot:        runtime.RawExtension{Raw: shootRaw},
+					},
+				})).To(Succeed())
+
+				Expect(clusterAutoscaler.Deploy(ctx)).To(MatchError(ContainSubstring("failed reading worker resource")))
+			})
+
+			It("should fail if the cluster resource does not exist", func() {
+				Expect(clusterAutoscaler.Deploy(ctx)).To(MatchError(ContainSubstring("failed reading shoot from cluster resource")))
+			})
+
+			It("should prefer the machine deployments if they were set", func() {
+				clusterAutoscaler.SetMachineDeployments(machineDeployments)
+
+				Expect(clusterAutoscaler.Deploy(ctx)).To(Succeed())
+			})
+		})
+
+		Context("with worker pools", func() {
+			BeforeEach(func() {
+				clusterAutoscaler = New(fakeClient, namespace, sm, image, replicas, nil, Values{})
+				clusterAutoscaler.SetNamespaceUID(namespaceUID)
+				clusterAutoscaler.SetMachineDeployments(machineDeployments)
+			})
+
+			It("should render the bounds split over the zones of the worker pools", func() {
+				clusterAutoscaler.SetWorkerPools([]WorkerPool{{
+					Name:    "pool",
+					Minimum: 5,
+					Maximum: 9,
+					Zones: []WorkerPoolZone{
+						{Name: "zone-a", MachineDeploymentName: machineDeployment1Name},
+						{Name: "zone-b", MachineDeploymentName: "pool-z2"},
+					},
+				}})
+
+				Expect(clusterAutoscaler.Deploy(ctx)).To(Succeed())
+
+				actualDeployment := &appsv1.Deployment{}
+				Expect(fakeClient.Get(ctx, client.ObjectKey{Namespace: namespace, Name: deploymentName}, actualDeployment)).To(Succeed())
+				Expect(actualDeployment.Spec.Template.Spec.Containers[0].Command).To(ContainElements(
+					fmt.Sprintf("--nodes=3:5:%s.%s", namespace, machineDeployment1Name),
+					fmt.Sprintf("--nodes=%d:%d:%s.%s", machineDeployment2Min, machineDeployment2Max, namespace, machineDeployment2Name),
+					fmt.Sprintf("--nodes=2:4:%s.pool-z2", namespace),
+				))
+				Expect(actualDeployment.Spec.Template.Spec.Containers[0].Command).NotTo(ContainElement(
+					fmt.Sprintf("--nodes=%d:%d:%s.%s", machineDeployment1Min, machineDeployment1Max, namespace, machineDeployment1Name),
+				))
+			})
+
+			It("should fail if the architecture label is ignored for balancing although the worker pools mix architectures", func() {
+				clusterAutoscaler = New(fakeClient, namespace, sm, image, replicas, nil, Values{BalancingIgnoreLabels: []string{"kubernetes.io/arch"}})
+				clusterAutoscaler.SetNamespaceUID(namespaceUID)
+				clusterAutoscaler.SetWorkerPools([]WorkerPool{
+					{Name: "pool1", Minimum: 1, Maximum: 2, Architecture: "amd64", Zones: []WorkerPoolZone{{Name: "zone-a", MachineDeploymentName: "pool1-z1"}}},
+					{Name: "pool2", Minimum: 1, Maximum: 2, Architecture: "arm64", Zones: []WorkerPoolZone{{Name: "zone-a", MachineDeploymentName: "pool2-z1"}}},
+				})
+
+				Expect(clusterAutoscaler.Deploy(ctx)).To(MatchError(ContainSubstring("worker pools mix the architectures amd64, arm64")))
+			})
+
+			It("should allow ignoring the architecture label for balancing if the worker pools share the architecture", func() {
+				clusterAutoscaler = New(fakeClient, namespace, sm, image, replicas, nil, Values{BalancingIgnoreLabels: []string{"kubernetes.io/arch"}})
+				clusterAutoscaler.SetNamespaceUID(namespaceUID)
+				clusterAutoscaler.SetWorkerPools([]WorkerPool{
+					{Name: "pool1", Minimum: 1, Maximum: 2, Architecture: "arm64", Zones: []WorkerPoolZone{{Name: "zone-a", MachineDeploymentName: "pool1-z1"}}},
+					{Name: "pool2", Minimum: 1, Maximum: 2, Architecture: "arm64", Zones: []WorkerPoolZone{{Name: "zone-a", MachineDeploymentName: "pool2-z1"}}},
+				})
+
+				Expect(clusterAutoscaler.Deploy(ctx)).To(Succeed())
+			})
+
+			It("should fail if a worker pool cannot be split", func() {
+				clusterAutoscaler.SetWorkerPools([]WorkerPool{{Name: "pool", Minimum: 1, Maximum: 2}})
+
+				Expect(clusterAutoscaler.Deploy(ctx)).To(MatchError(ContainSubstring("has no zones")))
+			})
+		})
+
+		Context("with multiple replicas", func() {
+			deploy := func(replicas int32) (*appsv1.Deployment, *policyv1.PodDisruptionBudget) {
+				// Simulate the high-availability webhook of gardener-resource-manager which scales up the deployment.
+				webhookClient := interceptor.NewClient(fakeClient.(client.WithWatch), interceptor.Funcs{
+					Create: func(ctx context.Context, c client.WithWatch, obj client.Object, opts ...client.CreateOption) error {
+						if deployment, ok := obj.(*appsv1.Deployment); ok && pointer.Int32Deref(deployment.Spec.Replicas, 0) > 0 {
+							deployment.Spec.Replicas = pointer.Int32(replicas)
+						}
+						return c.Create(ctx, obj, opts...)
+					},
+				})
+
+				clusterAutoscaler = New(webhookClient, namespace, sm, image, 1, nil, Values{})
+				clusterAutoscaler.SetNamespaceUID(namespaceUID)
+				clusterAutoscaler.SetMachineDeployments(machineDeployments)
+				Expect(clusterAutoscaler.Deploy(ctx)).To(Succeed())
+
+				actualDeployment := &appsv1.Deployment{}
+				Expect(fakeClient.Get(ctx, client.ObjectKey{Namespace: namespace, Name: deploymentName}, actualDeployment)).To(Succeed())
+				actualPDB := &policyv1.PodDisruptionBudget{}
+				Expect(fakeClient.Get(ctx, client.ObjectKey{Namespace: namespace, Name: pdbName}, actualPDB)).To(Succeed())
+				return actualDeployment, actualPDB
+			}
+
+			It("should keep one replica available", func() {
+				actualDeployment, actualPDB := deploy(2)
+
+				Expect(actualDeployment.Spec.Replicas).To(PointTo(Equal(int32(2))))
+				Expect(actualPDB.Spec.MaxUnavailable).To(BeNil())
+				Expect(actualPDB.Spec.MinAvailable).To(PointTo(Equal(intstr.FromInt32(1))))
+			})
+
+			It("should allow one unavailable replica if the deployment was not scaled up", func() {
+				actualDeployment, actualPDB := deploy(1)
+
+				Expect(actualDeployment.Spec.Replicas).To(PointTo(Equal(int32(1))))
+				Expect(actualPDB.Spec.MaxUnavailable).To(PointTo(Equal(intstr.FromInt32(1))))
+				Expect(actualPDB.Spec.MinAvailable).To(BeNil())
+			})
+		})
+
+		Context("with termination settings", func() {
+			deploy := func(values Values) (*appsv1.Deployment, error) {
+				clusterAutoscaler = New(fakeClient, namespace, sm, image, 1, nil, values)
+				clusterAutoscaler.SetNamespaceUID(namespaceUID)
+				clusterAutoscaler.SetMachineDeployments(machineDeployments)
+
+				if err := clusterAutoscaler.Deploy(ctx); err != nil {
+					return nil, err
+				}
+
+				actualDeployment := &appsv1.Deployment{}
+				Expect(fakeClient.Get(ctx, client.ObjectKey{Namespace: namespace, Name: deploymentName}, actualDeployment)).To(Succeed())
+				return actualDeployment, nil
+			}
+
+			It("should use the default termination grace period without preStop hook", func() {
+				actualDeployment, err := deploy(Values{})
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(actualDeployment.Spec.Template.Spec.TerminationGracePeriodSeconds).To(PointTo(Equal(int64(5))))
+				Expect(actualDeployment.Spec.Template.Spec.Containers[0].Lifecycle).To(BeNil())
+			})
+
+			It("should configure the termination grace period and the preStop hook", func() {
+				preStop := &corev1.LifecycleHandler{Exec: &corev1.ExecAction{Command: []string{"/sleep", "30"}}}
+
+				actualDeployment, err := deploy(Values{TerminationGracePeriodSeconds: pointer.Int64(60), PreStop: preStop})
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(actualDeployment.Spec.Template.Spec.TerminationGracePeriodSeconds).To(PointTo(Equal(int64(60))))
+				Expect(actualDeployment.Spec.Template.Spec.Containers[0].Lifecycle).To(Equal(&corev1.Lifecycle{PreStop: preStop}))
+			})
+
+			It("should fail if the termination grace period is negative", func() {
+				_, err := deploy(Values{TerminationGracePeriodSeconds: pointer.Int64(-1)})
+				Expect(err).To(MatchError("termination grace period must not be negative but got -1"))
+			})
+
+			It("should fail if the preStop hook has no handler", func() {
+				_, err := deploy(Values{PreStop: &corev1.LifecycleHandler{}})
+				Expect(err).To(MatchError("preStop hook must specify a handler"))
+			})
+		})
 	})
 
 	Describe("#Destroy", func() {
@@ -724,12 +1370,25 @@ subjects:
 			Expect(clusterAutoscaler.Destroy(ctx)).To(MatchError(fakeErr))
 		})
 
+		It("should fail because the effective configuration config map cannot be deleted", func() {
+			gomock.InOrder(
+				c.EXPECT().Delete(ctx, &resourcesv1alpha1.ManagedResource{ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: managedResourceName}}),
+				c.EXPECT().Delete(ctx, &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: managedResourceSecretName}}),
+				c.EXPECT().Delete(ctx, &vpaautoscalingv1.VerticalPodAutoscaler{ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: vpaName}}),
+				c.EXPECT().Delete(ctx, &policyv1.PodDisruptionBudget{ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: pdbName}}),
+				c.EXPECT().Delete(ctx, &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: effectiveConfigMapName}}).Return(fakeErr),
+			)
+
+			Expect(clusterAutoscaler.Destroy(ctx)).To(MatchError(fakeErr))
+		})
+
 		It("should fail because the deployment cannot be deleted", func() {
 			gomock.InOrder(
 				c.EXPECT().Delete(ctx, &resourcesv1alpha1.ManagedResource{ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: managedResourceName}}),
 				c.EXPECT().Delete(ctx, &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: managedResourceSecretName}}),
 				c.EXPECT().Delete(ctx, &vpaautoscalingv1.VerticalPodAutoscaler{ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: vpaName}}),
 				c.EXPECT().Delete(ctx, &policyv1.PodDisruptionBudget{ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: pdbName}}),
+				c.EXPECT().Delete(ctx, &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: effectiveConfigMapName}}),
 				c.EXPECT().Delete(ctx, &appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: deploymentName}}).Return(fakeErr),
 			)
 
@@ -742,6 +1401,7 @@ subjects:
 				c.EXPECT().Delete(ctx, &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: managedResourceSecretName}}),
 				c.EXPECT().Delete(ctx, &vpaautoscalingv1.VerticalPodAutoscaler{ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: vpaName}}),
 				c.EXPECT().Delete(ctx, &policyv1.PodDisruptionBudget{ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: pdbName}}),
+				c.EXPECT().Delete(ctx, &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: effectiveConfigMapName}}),
 				c.EXPECT().Delete(ctx, &appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: deploymentName}}),
 				c.EXPECT().Delete(ctx, &rbacv1.ClusterRoleBinding{ObjectMeta: metav1.ObjectMeta{Name: clusterRoleBindingName}}).Return(fakeErr),
 			)
@@ -755,6 +1415,7 @@ subjects:
 				c.EXPECT().Delete(ctx, &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: managedResourceSecretName}}),
 				c.EXPECT().Delete(ctx, &vpaautoscalingv1.VerticalPodAutoscaler{ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: vpaName}}),
 				c.EXPECT().Delete(ctx, &policyv1.PodDisruptionBudget{ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: pdbName}}),
+				c.EXPECT().Delete(ctx, &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: effectiveConfigMapName}}),
 				c.EXPECT().Delete(ctx, &appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: deploymentName}}),
 				c.EXPECT().Delete(ctx, &rbacv1.ClusterRoleBinding{ObjectMeta: metav1.ObjectMeta{Name: clusterRoleBindingName}}),
 				c.EXPECT().Delete(ctx, &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: secretName}}).Return(fakeErr),
@@ -769,6 +1430,7 @@ subjects:
 				c.EXPECT().Delete(ctx, &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: managedResourceSecretName}}),
 				c.EXPECT().Delete(ctx, &vpaautoscalingv1.VerticalPodAutoscaler{ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: vpaName}}),
 				c.EXPECT().Delete(ctx, &policyv1.PodDisruptionBudget{ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: pdbName}}),
+				c.EXPECT().Delete(ctx, &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: effectiveConfigMapName}}),
 				c.EXPECT().Delete(ctx, &appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: deploymentName}}),
 				c.EXPECT().Delete(ctx, &rbacv1.ClusterRoleBinding{ObjectMeta: metav1.ObjectMeta{Name: clusterRoleBindingName}}),
 				c.EXPECT().Delete(ctx, &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: secretName}}),
@@ -784,6 +1446,7 @@ subjects:
 				c.EXPECT().Delete(ctx, &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: managedResourceSecretName}}),
 				c.EXPECT().Delete(ctx, &vpaautoscalingv1.VerticalPodAutoscaler{ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: vpaName}}),
 				c.EXPECT().Delete(ctx, &policyv1.PodDisruptionBudget{ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: pdbName}}),
+				c.EXPECT().Delete(ctx, &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: effectiveConfigMapName}}),
 				c.EXPECT().Delete(ctx, &appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: deploymentName}}),
 				c.EXPECT().Delete(ctx, &rbacv1.ClusterRoleBinding{ObjectMeta: metav1.ObjectMeta{Name: clusterRoleBindingName}}),
 				c.EXPECT().Delete(ctx, &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: secretName}}),
@@ -800,6 +1463,7 @@ subjects:
 				c.EXPECT().Delete(ctx, &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: managedResourceSecretName}}),
 				c.EXPECT().Delete(ctx, &vpaautoscalingv1.VerticalPodAutoscaler{ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: vpaName}}),
 				c.EXPECT().Delete(ctx, &policyv1.PodDisruptionBudget{ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: pdbName}}),
+				c.EXPECT().Delete(ctx, &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: effectiveConfigMapName}}),
 				c.EXPECT().Delete(ctx, &appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: deploymentName}}),
 				c.EXPECT().Delete(ctx, &rbacv1.ClusterRoleBinding{ObjectMeta: metav1.ObjectMeta{Name: clusterRoleBindingName}}),
 				c.EXPECT().Delete(ctx, &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: secretName}}),
@@ -812,14 +1476,215 @@ subjects:
 	})
 
 	Describe("#Wait", func() {
-		It("should return nil as it's not implemented as of now", func() {
-			Expect(clusterAutoscaler.Wait(ctx)).To(Succeed())
+		var (
+			fakeOps   *retryfake.Ops
+			resetVars func()
+
+			availableConditions = []appsv1.DeploymentCondition{
+				{Type: appsv1.DeploymentAvailable, Status: corev1.ConditionTrue},
+				{Type: appsv1.DeploymentProgressing, Status: corev1.ConditionTrue, Reason: "NewReplicaSetAvailable"},
+			}
+		)
+
+		BeforeEach(func() {
+			clusterAutoscaler = New(fakeClient, namespace, sm, image, replicas, nil, Values{})
+
+			fakeOps = &retryfake.Ops{MaxAttempts: 1}
+			resetVars = test.WithVars(
+				&retry.Until, fakeOps.Until,
+				&retry.UntilTimeout, fakeOps.UntilTimeout,
+			)
+		})
+
+		AfterEach(func() {
+			resetVars()
+		})
+
+		It("should fail if the machine-controller-manager deployment does not exist", func() {
+			Expect(clusterAutoscaler.Wait(ctx)).To(MatchError(ContainSubstring("does not exist yet")))
+		})
+
+		It("should fail if the machine-controller-manager deployment is not healthy", func() {
+			Expect(fakeClient.Create(ctx, &appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: "machine-controller-manager", Namespace: namespace}})).To(Succeed())
+
+			Expect(clusterAutoscaler.Wait(ctx)).To(MatchError(ContainSubstring("machine-controller-manager is not ready yet")))
+		})
+
+		It("should fail if the cluster-autoscaler deployment is not updated", func() {
+			Expect(fakeClient.Create(ctx, &appsv1.Deployment{
+				ObjectMeta: metav1.ObjectMeta{Name: "machine-controller-manager", Namespace: namespace},
+				Status:     appsv1.DeploymentStatus{Conditions: availableConditions},
+			})).To(Succeed())
+			Expect(fakeClient.Create(ctx, &appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: deploymentName, Namespace: namespace}})).To(Succeed())
+
+			Expect(clusterAutoscaler.Wait(ctx)).To(MatchError(ContainSubstring("condition \"Progressing\" is missing")))
+		})
+
+		It("should fail with a typed error if the cluster-autoscaler deployment exceeded its progress deadline", func() {
+			Expect(fakeClient.Create(ctx, &appsv1.Deployment{
+				ObjectMeta: metav1.ObjectMeta{Name: "machine-controller-manager", Namespace: namespace},
+				Status:     appsv1.DeploymentStatus{Conditions: availableConditions},
+			})).To(Succeed())
+			Expect(fakeClient.Create(ctx, &appsv1.Deployment{
+				ObjectMeta: metav1.ObjectMeta{Name: deploymentName, Namespace: namespace},
+				Status: appsv1.DeploymentStatus{Conditions: []appsv1.DeploymentCondition{
+					{Type: appsv1.DeploymentProgressing, Status: corev1.ConditionFalse, Reason: "ProgressDeadlineExceeded"},
+				}},
+			})).To(Succeed())
+
+			Expect(clusterAutoscaler.Wait(ctx)).To(MatchError(ErrDeploymentProgressDeadline))
+		})
+
+		It("should only wait for one available replica if the deployment has multiple replicas", func() {
+			Expect(fakeClient.Create(ctx, &appsv1.Deployment{
+				ObjectMeta: metav1.ObjectMeta{Name: "machine-controller-manager", Namespace: namespace},
+				Status:     appsv1.DeploymentStatus{Conditions: availableConditions},
+			})).To(Succeed())
+			Expect(fakeClient.Create(ctx, &appsv1.Deployment{
+				ObjectMeta: metav1.ObjectMeta{Name: deploymentName, Namespace: namespace},
+				Spec:       appsv1.DeploymentSpec{Replicas: pointer.Int32(2)},
+				Status: appsv1.DeploymentStatus{
+					Replicas:          2,
+					UpdatedReplicas:   1,
+					AvailableReplicas: 1,
+					Conditions: []appsv1.DeploymentCondition{
+						{Type: appsv1.DeploymentAvailable, Status: corev1.ConditionFalse},
+						{Type: appsv1.DeploymentProgressing, Status: corev1.ConditionTrue, Reason: "ReplicaSetUpdated"},
+					},
+				},
+			})).To(Succeed())
+
+			// The managed resource is checked only after the deployment.
+			Expect(clusterAutoscaler.Wait(ctx)).To(MatchError(ContainSubstring("not found")))
+		})
+
+		Context("both deployments are healthy", func() {
+			BeforeEach(func() {
+				Expect(fakeClient.Create(ctx, &appsv1.Deployment{
+					ObjectMeta: metav1.ObjectMeta{Name: "machine-controller-manager", Namespace: namespace},
+					Status:     appsv1.DeploymentStatus{Conditions: availableConditions},
+				})).To(Succeed())
+				Expect(fakeClient.Create(ctx, &appsv1.Deployment{
+					ObjectMeta: metav1.ObjectMeta{Name: deploymentName, Namespace: namespace},
+					Spec: appsv1.DeploymentSpec{
+						Replicas: pointer.Int32(0),
+						Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "kubernetes", "role": "cluster-autoscaler"}},
+					},
+					Status: appsv1.DeploymentStatus{Conditions: availableConditions},
+				})).To(Succeed())
+			})
+
+			It("should fail if the managed resource does not exist", func() {
+				Expect(clusterAutoscaler.Wait(ctx)).To(MatchError(ContainSubstring("not found")))
+			})
+
+			It("should fail if the managed resource is not healthy", func() {
+				Expect(fakeClient.Create(ctx, &resourcesv1alpha1.ManagedResource{
+					ObjectMeta: metav1.ObjectMeta{Name: "shoot-core-cluster-autoscaler", Namespace: namespace, Generation: 1},
+					Status: resourcesv1alpha1.ManagedResourceStatus{
+						ObservedGeneration: 1,
+						Conditions: []gardencorev1beta1.Condition{
+							{Type: resourcesv1alpha1.ResourcesApplied, Status: gardencorev1beta1.ConditionFalse},
+							{Type: resourcesv1alpha1.ResourcesHealthy, Status: gardencorev1beta1.ConditionTrue},
+						},
+					},
+				})).To(Succeed())
+
+				Expect(clusterAutoscaler.Wait(ctx)).To(MatchError(ContainSubstring("is not healthy")))
+			})
+
+			It("should succeed if the managed resource is healthy", func() {
+				Expect(fakeClient.Create(ctx, &resourcesv1alpha1.ManagedResource{
+					ObjectMeta: metav1.ObjectMeta{Name: "shoot-core-cluster-autoscaler", Namespace: namespace, Generation: 1},
+					Status: resourcesv1alpha1.ManagedResourceStatus{
+						ObservedGeneration: 1,
+						Conditions: []gardencorev1beta1.Condition{
+							{Type: resourcesv1alpha1.ResourcesApplied, Status: gardencorev1beta1.ConditionTrue},
+							{Type: resourcesv1alpha1.ResourcesHealthy, Status: gardencorev1beta1.ConditionTrue},
+						},
+					},
+				})).To(Succeed())
+
+				Expect(clusterAutoscaler.Wait(ctx)).To(Succeed())
+			})
 		})
 	})
 
 	Describe("#WaitCleanup", func() {
-		It("should return nil as it's not implemented as of now", func() {
+		var (
+			fakeOps   *retryfake.Ops
+			resetVars func()
+		)
+
+		BeforeEach(func() {
+			clusterAutoscaler = New(fakeClient, namespace, sm, image, replicas, nil, Values{})
+
+			fakeOps = &retryfake.Ops{MaxAttempts: 1}
+			resetVars = test.WithVars(
+				&retry.Until, fakeOps.Until,
+				&retry.UntilTimeout, fakeOps.UntilTimeout,
+			)
+		})
+
+		AfterEach(func() {
+			resetVars()
+		})
+
+		It("should fail when the wait for the managed resource deletion times out", func() {
+			Expect(fakeClient.Create(ctx, &resourcesv1alpha1.ManagedResource{ObjectMeta: metav1.ObjectMeta{Name: managedResourceName, Namespace: namespace}})).To(Succeed())
+
+			Expect(clusterAutoscaler.WaitCleanup(ctx)).To(MatchError(ContainSubstring("still exists")))
+		})
+
+		It("should fail when the wait for the managed resource secret deletion times out", func() {
+			Expect(fakeClient.Create(ctx, &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "managedresource-" + managedResourceName, Namespace: namespace}})).To(Succeed())
+
+			Expect(clusterAutoscaler.WaitCleanup(ctx)).To(MatchError(ContainSubstring("still exists")))
+		})
+
+		It("should not return an error when they are already removed", func() {
 			Expect(clusterAutoscaler.WaitCleanup(ctx)).To(Succeed())
 		})
 	})
+
+	Describe("#RenderCommand", func() {
+		It("should render the command which is deployed", func() {
+			values := Values{RBACNamespace: "gardener-cluster-autoscaler", ExtraArgs: map[string]string{"max-nodes-total": "100"}}
+
+			command, err := RenderCommand(namespace, configFull, values, machineDeployments)
+			Expect(err).NotTo(HaveOccurred())
+
+			clusterAutoscaler = New(fakeClient, namespace, sm, image, replicas, configFull, values)
+			clusterAutoscaler.SetNamespaceUID(namespaceUID)
+			clusterAutoscaler.SetMachineDeployments(machineDeployments)
+			Expect(clusterAutoscaler.Deploy(ctx)).To(Succeed())
+
+			deployment := &appsv1.Deployment{}
+			Expect(fakeClient.Get(ctx, client.ObjectKey{Name: deploymentName, Namespace: namespace}, deployment)).To(Succeed())
+			Expect(deployment.Spec.Template.Spec.Containers[0].Command).To(Equal(command))
+		})
+
+		It("should render the defaults and the node groups", func() {
+			command, err := RenderCommand(namespace, nil, Values{}, []extensionsv1alpha1.MachineDeployment{{Name: "pool1", Minimum: 1, Maximum: 3}})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(command).To(ContainElements(
+				"--expander=least-waste",
+				"--max-graceful-termination-sec=600",
+				"--nodes=1:3:"+namespace+".pool1",
+			))
+		})
+
+		It("should not modify the given configuration", func() {
+			config := &gardencorev1beta1.ClusterAutoscaler{}
+
+			_, err := RenderCommand(namespace, config, Values{}, machineDeployments)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(config).To(Equal(&gardencorev1beta1.ClusterAutoscaler{}))
+		})
+
+		It("should fail for invalid values", func() {
+			_, err := RenderCommand(namespace, nil, Values{ExtraArgs: map[string]string{"nodes": "1:2:foo"}}, machineDeployments)
+			Expect(err).To(MatchError(ContainSubstring(`extra arg "nodes" must not override a flag managed by gardener`)))
+		})
+	})
 })
@@ -9,6 +9,7 @@ import (
 	reflect "reflect"
 
 	v1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	clusterautoscaler "github.com/gardener/gardener/pkg/component/clusterautoscaler"
 	gomock "go.uber.org/mock/gomock"
 	types "k8s.io/apimachinery/pkg/types"
 )
@@ -94,6 +95,18 @@ func (mr *MockInterfaceMockRecorder) ScrapeConfigs() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ScrapeConfigs", reflect.TypeOf((*MockInterface)(nil).ScrapeConfigs))
 }
 
+// SetCredentialsRotationMarker mocks base method.
+func (m *MockInterface) SetCredentialsRotationMarker(arg0 string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetCredentialsRotationMarker", arg0)
+}
+
+// SetCredentialsRotationMarker indicates an expected call of SetCredentialsRotationMarker.
+func (mr *MockInterfaceMockRecorder) SetCredentialsRotationMarker(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetCredentialsRotationMarker", reflect.TypeOf((*MockInterface)(nil).SetCredentialsRotationMarker), arg0)
+}
+
 // SetMachineDeployments mocks base method.
 func (m *MockInterface) SetMachineDeployments(arg0 []v1alpha1.MachineDeployment) {
 	m.ctrl.T.Helper()
@@ -118,6 +131,18 @@ func (mr *MockInterfaceMockRecorder) SetNamespaceUID(arg0 interface{}) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetNamespaceUID", reflect.TypeOf((*MockInterface)(nil).SetNamespaceUID), arg0)
 }
 
+// SetWorkerPools mocks base method.
+func (m *MockInterface) SetWorkerPools(arg0 []clusterautoscaler.WorkerPool) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetWorkerPools", arg0)
+}
+
+// SetWorkerPools indicates an expected call of SetWorkerPools.
+func (mr *MockInterfaceMockRecorder) SetWorkerPools(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetWorkerPools", reflect.TypeOf((*MockInterface)(nil).SetWorkerPools), arg0)
+}
+
 // Wait mocks base method.
 func (m *MockInterface) Wait(arg0 context.Context) error {
 	m.ctrl.T.Helper()
@@ -0,0 +1,37 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package clusterautoscaler
+
+import (
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/validation"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+)
+
+// ValidateNodeGroupNames validates the names of the given machine deployments before they are rendered into the
+// --nodes flags of the cluster-autoscaler. The MCM cloud provider looks up the machine deployments by exactly these
+// names, hence they are never changed but an error is returned if a name is not a valid object name.
+func ValidateNodeGroupNames(machineDeployments []extensionsv1alpha1.MachineDeployment) error {
+	for _, machineDeployment := range machineDeployments {
+		if errs := validation.IsDNS1123Subdomain(machineDeployment.Name); len(errs) > 0 {
+			return fmt.Errorf("name of machine deployment %q is invalid: %s", machineDeployment.Name, strings.Join(errs, ", "))
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,44 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package clusterautoscaler_test
+
+import (
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	. "github.com/gardener/gardener/pkg/component/clusterautoscaler"
+)
+
+var _ = Describe("NodeGroups", func() {
+	Describe("#ValidateNodeGroupNames", func() {
+		It("should accept valid names", func() {
+			Expect(ValidateNodeGroupNames([]extensionsv1alpha1.MachineDeployment{
+				{Name: "shoot--foo--bar-pool1-z1"},
+				{Name: "pool.2"},
+				{Name: "shoot--project--" + strings.Repeat("a", 60) + "-worker-pool-z1"},
+			})).To(Succeed())
+		})
+
+		It("should reject invalid names", func() {
+			Expect(ValidateNodeGroupNames([]extensionsv1alpha1.MachineDeployment{
+				{Name: "pool1"},
+				{Name: "Pool:1"},
+			})).To(MatchError(ContainSubstring(`name of machine deployment "Pool:1" is invalid`)))
+		})
+	})
+})
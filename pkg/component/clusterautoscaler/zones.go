@@ -0,0 +1,252 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package clusterautoscaler
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"k8s.io/utils/pointer"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+)
+
+// ZoneSplitStrategy is the strategy used for splitting the minimum and maximum of a worker pool over the machine
+// deployments of its zones.
+type ZoneSplitStrategy string
+
+const (
+	// ZoneSplitStrategyEven distributes the minimum and maximum evenly over all zones. Remainders are assigned to the
+	// first zones.
+	ZoneSplitStrategyEven ZoneSplitStrategy = "Even"
+	// ZoneSplitStrategyWeighted distributes the minimum and maximum proportionally to the weights of the zones.
+	ZoneSplitStrategyWeighted ZoneSplitStrategy = "Weighted"
+	// ZoneSplitStrategyCapped distributes the minimum and maximum evenly over all zones, however, the maximum of a zone
+	// never exceeds its cap. The excess is distributed over the remaining zones.
+	ZoneSplitStrategyCapped ZoneSplitStrategy = "Capped"
+)
+
+// WorkerPool contains the information required for splitting the minimum and maximum of a worker pool over the
+// machine deployments of its zones.
+type WorkerPool struct {
+	// Name is the name of the worker pool.
+	Name string
+	// Minimum is the minimum number of nodes of the worker pool.
+	Minimum int32
+	// Maximum is the maximum number of nodes of the worker pool.
+	Maximum int32
+	// Strategy is the strategy used for splitting the minimum and maximum. Defaults to ZoneSplitStrategyEven.
+	Strategy ZoneSplitStrategy
+	// Zones are the zones of the worker pool.
+	Zones []WorkerPoolZone
+	// Architecture is the CPU architecture of the machines of the worker pool, e.g. `amd64` or `arm64`. It is used for
+	// preventing that node groups of different architectures are balanced, see Values.BalancingIgnoreLabels.
+	Architecture string
+}
+
+// WorkerPoolZone is a zone of a worker pool.
+type WorkerPoolZone struct {
+	// Name is the name of the zone.
+	Name string
+	// MachineDeploymentName is the name of the machine deployment for this zone.
+	MachineDeploymentName string
+	// Weight is the weight of the zone which is considered by ZoneSplitStrategyWeighted. Defaults to 1.
+	Weight int32
+	// Cap is the maximum number of nodes in this zone which is considered by ZoneSplitStrategyCapped.
+	Cap *int32
+}
+
+// NewWorkerPool returns the WorkerPool for the given worker of a shoot whose control plane runs in the given namespace.
+// The zones are derived from the given machine deployments which were reported by the Worker resource: the machine
+// deployment `<namespace>-<worker>-z<index>` belongs to the zone with the given (one-based) index of the worker. Zones
+// without machine deployment are not part of the pool, i.e., bounds are never computed for machine deployments which do
+// not exist. The zone split strategy, the weights and the caps are taken from the cluster-autoscaler options of the
+// worker.
+func NewWorkerPool(namespace string, worker gardencorev1beta1.Worker, machineDeployments []extensionsv1alpha1.MachineDeployment) WorkerPool {
+	pool := WorkerPool{
+		Name:         worker.Name,
+		Minimum:      worker.Minimum,
+		Maximum:      worker.Maximum,
+		Architecture: pointer.StringDeref(worker.Machine.Architecture, ""),
+	}
+
+	var options gardencorev1beta1.ClusterAutoscalerOptions
+	if worker.ClusterAutoscaler != nil {
+		options = *worker.ClusterAutoscaler
+	}
+	if options.ZoneSplitStrategy != nil {
+		pool.Strategy = ZoneSplitStrategy(*options.ZoneSplitStrategy)
+	}
+
+	var (
+		prefix       = fmt.Sprintf("%s-%s-z", namespace, worker.Name)
+		zoneIndices  = make(map[string]int)
+		machineNames []string
+	)
+
+	for _, machineDeployment := range machineDeployments {
+		index, err := strconv.Atoi(strings.TrimPrefix(machineDeployment.Name, prefix))
+		if err != nil || machineDeployment.Name != prefix+strconv.Itoa(index) || index < 1 || index > len(worker.Zones) {
+			continue
+		}
+		if _, ok := zoneIndices[machineDeployment.Name]; !ok {
+			machineNames = append(machineNames, machineDeployment.Name)
+		}
+		zoneIndices[machineDeployment.Name] = index - 1
+	}
+
+	sort.Slice(machineNames, func(i, j int) bool {
+		return zoneIndices[machineNames[i]] < zoneIndices[machineNames[j]]
+	})
+
+	for _, machineName := range machineNames {
+		zone := worker.Zones[zoneIndices[machineName]]
+		workerPoolZone := WorkerPoolZone{
+			Name:                  zone,
+			MachineDeploymentName: machineName,
+			Weight:                options.ZoneWeights[zone],
+		}
+		if zoneCap, ok := options.ZoneCaps[zone]; ok {
+			workerPoolZone.Cap = pointer.Int32(zoneCap)
+		}
+		pool.Zones = append(pool.Zones, workerPoolZone)
+	}
+
+	return pool
+}
+
+// SplitOverZones splits the minimum and maximum of the given worker pool over the machine deployments of its zones
+// according to the configured strategy.
+func SplitOverZones(pool WorkerPool) ([]extensionsv1alpha1.MachineDeployment, error) {
+	if len(pool.Zones) == 0 {
+		return nil, fmt.Errorf("worker pool %q has no zones", pool.Name)
+	}
+	if pool.Minimum < 0 || pool.Maximum < pool.Minimum {
+		return nil, fmt.Errorf("worker pool %q has invalid minimum %d and maximum %d", pool.Name, pool.Minimum, pool.Maximum)
+	}
+
+	var minimums, maximums []int32
+
+	switch pool.Strategy {
+	case "", ZoneSplitStrategyEven:
+		weights := make([]int32, len(pool.Zones))
+		for i := range weights {
+			weights[i] = 1
+		}
+		minimums, maximums = distribute(pool.Minimum, weights), distribute(pool.Maximum, weights)
+
+	case ZoneSplitStrategyWeighted:
+		weights := make([]int32, len(pool.Zones))
+		for i, zone := range pool.Zones {
+			if zone.Weight < 0 {
+				return nil, fmt.Errorf("zone %q of worker pool %q has negative weight %d", zone.Name, pool.Name, zone.Weight)
+			}
+			weights[i] = zone.Weight
+			if weights[i] == 0 {
+				weights[i] = 1
+			}
+		}
+		minimums, maximums = distribute(pool.Minimum, weights), distribute(pool.Maximum, weights)
+
+	case ZoneSplitStrategyCapped:
+		var err error
+		if maximums, err = distributeCapped(pool, pool.Maximum); err != nil {
+			return nil, err
+		}
+		if minimums, err = distributeCapped(pool, pool.Minimum); err != nil {
+			return nil, err
+		}
+
+	default:
+		return nil, fmt.Errorf("worker pool %q has unknown zone split strategy %q", pool.Name, pool.Strategy)
+	}
+
+	machineDeployments := make([]extensionsv1alpha1.MachineDeployment, 0, len(pool.Zones))
+	for i, zone := range pool.Zones {
+		machineDeployments = append(machineDeployments, extensionsv1alpha1.MachineDeployment{
+			Name:    zone.MachineDeploymentName,
+			Minimum: minimums[i],
+			Maximum: maximums[i],
+		})
+	}
+
+	return machineDeployments, nil
+}
+
+// distribute splits the total proportionally to the given weights. Remainders are assigned one by one to the first
+// entries.
+func distribute(total int32, weights []int32) []int32 {
+	var (
+		result      = make([]int32, len(weights))
+		weightSum   int32
+		distributed int32
+	)
+
+	for _, weight := range weights {
+		weightSum += weight
+	}
+
+	for i, weight := range weights {
+		result[i] = int32(int64(total) * int64(weight) / int64(weightSum))
+		distributed += result[i]
+	}
+
+	for i := 0; distributed < total; i = (i + 1) % len(result) {
+		result[i]++
+		distributed++
+	}
+
+	return result
+}
+
+// distributeCapped splits the total evenly over the zones of the given pool while respecting their caps.
+func distributeCapped(pool WorkerPool, total int32) ([]int32, error) {
+	var (
+		result    = make([]int32, len(pool.Zones))
+		remaining = total
+	)
+
+	for remaining > 0 {
+		var open []int
+		for i, zone := range pool.Zones {
+			if zone.Cap == nil || result[i] < *zone.Cap {
+				open = append(open, i)
+			}
+		}
+
+		if len(open) == 0 {
+			return nil, fmt.Errorf("caps of the zones of worker pool %q are too low for distributing %d nodes", pool.Name, total)
+		}
+
+		weights := make([]int32, len(open))
+		for i := range weights {
+			weights[i] = 1
+		}
+
+		for i, share := range distribute(remaining, weights) {
+			zone := pool.Zones[open[i]]
+			if zone.Cap != nil && result[open[i]]+share > *zone.Cap {
+				share = *zone.Cap - result[open[i]]
+			}
+			result[open[i]] += share
+			remaining -= share
+		}
+	}
+
+	return result, nil
+}
@@ -0,0 +1,180 @@
+// Copyright 2020 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package clusterautoscaler_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/utils/pointer"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	. "github.com/gardener/gardener/pkg/component/clusterautoscaler"
+)
+
+var _ = Describe("Zones", func() {
+	Describe("#NewWorkerPool", func() {
+		var (
+			worker             gardencorev1beta1.Worker
+			machineDeployments []extensionsv1alpha1.MachineDeployment
+		)
+
+		BeforeEach(func() {
+			worker = gardencorev1beta1.Worker{
+				Name:    "pool",
+				Minimum: 3,
+				Maximum: 7,
+				Machine: gardencorev1beta1.Machine{Architecture: pointer.String("arm64")},
+				Zones:   []string{"zone-a", "zone-b"},
+			}
+			machineDeployments = []extensionsv1alpha1.MachineDeployment{
+				{Name: "shoot--foo--bar-pool-z2"},
+				{Name: "shoot--foo--bar-pool-z1"},
+				{Name: "shoot--foo--bar-other-z1"},
+			}
+		})
+
+		It("should compute the worker pool without cluster-autoscaler options", func() {
+			Expect(NewWorkerPool("shoot--foo--bar", worker, machineDeployments)).To(Equal(WorkerPool{
+				Name:         "pool",
+				Minimum:      3,
+				Maximum:      7,
+				Architecture: "arm64",
+				Zones: []WorkerPoolZone{
+					{Name: "zone-a", MachineDeploymentName: "shoot--foo--bar-pool-z1"},
+					{Name: "zone-b", MachineDeploymentName: "shoot--foo--bar-pool-z2"},
+				},
+			}))
+		})
+
+		It("should take over the zone split strategy, weights and caps", func() {
+			strategy := gardencorev1beta1.ZoneSplitStrategyCapped
+			worker.ClusterAutoscaler = &gardencorev1beta1.ClusterAutoscalerOptions{
+				ZoneSplitStrategy: &strategy,
+				ZoneWeights:       map[string]int32{"zone-b": 2},
+				ZoneCaps:          map[string]int32{"zone-a": 1},
+			}
+
+			Expect(NewWorkerPool("shoot--foo--bar", worker, machineDeployments)).To(Equal(WorkerPool{
+				Name:         "pool",
+				Minimum:      3,
+				Maximum:      7,
+				Strategy:     ZoneSplitStrategyCapped,
+				Architecture: "arm64",
+				Zones: []WorkerPoolZone{
+					{Name: "zone-a", MachineDeploymentName: "shoot--foo--bar-pool-z1", Cap: pointer.Int32(1)},
+					{Name: "zone-b", MachineDeploymentName: "shoot--foo--bar-pool-z2", Weight: 2},
+				},
+			}))
+		})
+
+		It("should only consider the zones for which machine deployments were reported", func() {
+			machineDeployments = []extensionsv1alpha1.MachineDeployment{
+				{Name: "shoot--foo--bar-pool-z2"},
+				{Name: "shoot--foo--bar-pool-z3"},
+				{Name: "shoot--foo--bar-pool-z02"},
+				{Name: "shoot--foo--bar-pool-zone"},
+			}
+
+			Expect(NewWorkerPool("shoot--foo--bar", worker, machineDeployments).Zones).To(Equal([]WorkerPoolZone{
+				{Name: "zone-b", MachineDeploymentName: "shoot--foo--bar-pool-z2"},
+			}))
+		})
+
+		It("should not have any zones if no machine deployments were reported", func() {
+			Expect(NewWorkerPool("shoot--foo--bar", worker, nil).Zones).To(BeEmpty())
+		})
+	})
+
+	Describe("#SplitOverZones", func() {
+		var pool WorkerPool
+
+		BeforeEach(func() {
+			pool = WorkerPool{
+				Name:    "pool",
+				Minimum: 3,
+				Maximum: 7,
+				Zones: []WorkerPoolZone{
+					{Name: "zone-a", MachineDeploymentName: "pool-z1"},
+					{Name: "zone-b", MachineDeploymentName: "pool-z2"},
+				},
+			}
+		})
+
+		It("should split evenly by default and assign remainders to the first zones", func() {
+			Expect(SplitOverZones(pool)).To(Equal([]extensionsv1alpha1.MachineDeployment{
+				{Name: "pool-z1", Minimum: 2, Maximum: 4},
+				{Name: "pool-z2", Minimum: 1, Maximum: 3},
+			}))
+		})
+
+		It("should split according to the weights of the zones", func() {
+			pool.Strategy = ZoneSplitStrategyWeighted
+			pool.Minimum, pool.Maximum = 5, 10
+			pool.Zones[0].Weight = 3
+			pool.Zones = append(pool.Zones, WorkerPoolZone{Name: "zone-c", MachineDeploymentName: "pool-z3"})
+
+			Expect(SplitOverZones(pool)).To(Equal([]extensionsv1alpha1.MachineDeployment{
+				{Name: "pool-z1", Minimum: 3, Maximum: 6},
+				{Name: "pool-z2", Minimum: 1, Maximum: 2},
+				{Name: "pool-z3", Minimum: 1, Maximum: 2},
+			}))
+		})
+
+		It("should respect the caps of the zones and distribute the excess over the remaining zones", func() {
+			pool.Strategy = ZoneSplitStrategyCapped
+			pool.Minimum, pool.Maximum = 0, 10
+			pool.Zones[0].Cap = pointer.Int32(2)
+			pool.Zones = append(pool.Zones, WorkerPoolZone{Name: "zone-c", MachineDeploymentName: "pool-z3"})
+
+			Expect(SplitOverZones(pool)).To(Equal([]extensionsv1alpha1.MachineDeployment{
+				{Name: "pool-z1", Minimum: 0, Maximum: 2},
+				{Name: "pool-z2", Minimum: 0, Maximum: 4},
+				{Name: "pool-z3", Minimum: 0, Maximum: 4},
+			}))
+		})
+
+		It("should fail if the caps of the zones are too low", func() {
+			pool.Strategy = ZoneSplitStrategyCapped
+			pool.Zones[0].Cap = pointer.Int32(1)
+			pool.Zones[1].Cap = pointer.Int32(1)
+
+			_, err := SplitOverZones(pool)
+			Expect(err).To(MatchError(ContainSubstring("too low")))
+		})
+
+		It("should fail if a zone has a negative weight", func() {
+			pool.Strategy = ZoneSplitStrategyWeighted
+			pool.Zones[1].Weight = -1
+
+			_, err := SplitOverZones(pool)
+			Expect(err).To(MatchError(ContainSubstring("negative weight")))
+		})
+
+		It("should fail if the maximum is lower than the minimum", func() {
+			pool.Maximum = 2
+
+			_, err := SplitOverZones(pool)
+			Expect(err).To(MatchError(ContainSubstring("invalid minimum")))
+		})
+
+		It("should fail for an unknown strategy", func() {
+			pool.Strategy = "Foo"
+
+			_, err := SplitOverZones(pool)
+			Expect(err).To(MatchError(ContainSubstring("unknown zone split strategy")))
+		})
+	})
+})
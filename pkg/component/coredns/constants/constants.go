@@ -24,4 +24,7 @@ const (
 	PortServiceServer = 53
 	// PortServer is the target port used for the DNS server.
 	PortServer = 8053
+	// MinReplicas is the minimum number of CoreDNS replicas, regardless of whether CoreDNS is scaled horizontally or
+	// cluster proportionally. Clients forwarding to the cluster DNS (e.g. node-local-dns) can size their limits for it.
+	MinReplicas int32 = 2
 )
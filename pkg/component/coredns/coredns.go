@@ -67,6 +67,10 @@ const (
 	volumeNameConfigCustom      = "custom-config-volume"
 	volumeMountPathConfig       = "/etc/coredns"
 	volumeMountPathConfigCustom = "/etc/coredns/custom"
+
+	pathResolvConf                = "/etc/resolv.conf"
+	volumeNameHostResolvConf      = "host-resolv-conf"
+	volumeMountPathHostResolvConf = "/etc/host-resolv.conf"
 )
 
 // Interface contains functions for a CoreDNS deployer.
@@ -102,6 +106,10 @@ type Values struct {
 	SearchPathRewritesEnabled bool
 	// SearchPathRewriteCommonSuffixes contains common suffixes to be rewritten when SearchPathRewritesEnabled is set.
 	SearchPathRewriteCommonSuffixes []string
+	// ForwardToHostResolvConf indicates whether CoreDNS forwards queries for non-cluster domains to the resolvers
+	// configured in the resolv.conf of the host instead of the resolv.conf handed out by the kubelet. This is required
+	// if the kubelet hands out node-local-dns as resolver, since CoreDNS would forward to node-local-dns otherwise.
+	ForwardToHostResolvConf bool
 }
 
 // New creates a new instance of DeployWaiter for coredns.
@@ -241,7 +249,7 @@ func (c *coreDNS) computeResourcesData() (map[string][]byte, error) {
       ttl 30
   }
   prometheus :` + strconv.Itoa(portMetrics) + `
-  forward . /etc/resolv.conf
+  forward . ` + c.upstreamResolvConf() + `
   cache 30
   loop
   reload
@@ -348,7 +356,7 @@ import custom/*.server
 				}),
 			},
 			Spec: appsv1.DeploymentSpec{
-				Replicas:             pointer.Int32(2),
+				Replicas:             pointer.Int32(corednsconstants.MinReplicas),
 				RevisionHistoryLimit: pointer.Int32(2),
 				Strategy: appsv1.DeploymentStrategy{
 					Type: appsv1.RollingUpdateDeploymentStrategyType,
@@ -581,7 +589,7 @@ import custom/*.server
 								"--namespace=" + metav1.NamespaceSystem,
 								"--configmap=coredns-autoscaler",
 								"--target=deployment/" + deployment.Name,
-								`--default-params={"linear":{"coresPerReplica":256,"nodesPerReplica":16,"min":2,"preventSinglePointFailure":true,"includeUnschedulableNodes":true}}`,
+								`--default-params={"linear":{"coresPerReplica":256,"nodesPerReplica":16,"min":` + strconv.Itoa(int(corednsconstants.MinReplicas)) + `,"preventSinglePointFailure":true,"includeUnschedulableNodes":true}}`,
 								"--logtostderr=true",
 								"--v=2",
 							},
@@ -653,7 +661,7 @@ import custom/*.server
 				},
 			},
 			Spec: autoscalingv2.HorizontalPodAutoscalerSpec{
-				MinReplicas: pointer.Int32(2),
+				MinReplicas: pointer.Int32(corednsconstants.MinReplicas),
 				MaxReplicas: 5,
 				Metrics: []autoscalingv2.MetricSpec{{
 					Type: autoscalingv2.ResourceMetricSourceType,
@@ -693,6 +701,24 @@ import custom/*.server
 		})
 	}
 
+	if c.values.ForwardToHostResolvConf {
+		hostPathFile := corev1.HostPathFile
+		deployment.Spec.Template.Spec.Containers[0].VolumeMounts = append(deployment.Spec.Template.Spec.Containers[0].VolumeMounts, corev1.VolumeMount{
+			Name:      volumeNameHostResolvConf,
+			MountPath: volumeMountPathHostResolvConf,
+			ReadOnly:  true,
+		})
+		deployment.Spec.Template.Spec.Volumes = append(deployment.Spec.Template.Spec.Volumes, corev1.Volume{
+			Name: volumeNameHostResolvConf,
+			VolumeSource: corev1.VolumeSource{
+				HostPath: &corev1.HostPathVolumeSource{
+					Path: pathResolvConf,
+					Type: &hostPathFile,
+				},
+			},
+		})
+	}
+
 	if c.values.NodeNetworkCIDR != nil {
 		networkPolicy.Spec.Ingress[0].From = append(networkPolicy.Spec.Ingress[0].From, networkingv1.NetworkPolicyPeer{
 			IPBlock: &networkingv1.IPBlock{CIDR: *c.values.NodeNetworkCIDR},
@@ -719,6 +745,13 @@ import custom/*.server
 	return registry.AddAllAndSerialize(managedObjects...)
 }
 
+func (c *coreDNS) upstreamResolvConf() string {
+	if c.values.ForwardToHostResolvConf {
+		return volumeMountPathHostResolvConf
+	}
+	return pathResolvConf
+}
+
 func (c *coreDNS) SetPodAnnotations(v map[string]string) {
 	c.values.PodAnnotations = v
 }
@@ -115,7 +115,7 @@ subjects:
   name: coredns
   namespace: kube-system
 `
-		configMapYAML = func(rewritingEnabled bool, commonSuffixes []string) string {
+		configMapYAML = func(rewritingEnabled bool, commonSuffixes []string, upstreamResolvConf string) string {
 			out := `apiVersion: v1
 data:
   Corefile: |
@@ -151,7 +151,7 @@ data:
           ttl 30
       }
       prometheus :9153
-      forward . /etc/resolv.conf
+      forward . ` + upstreamResolvConf + `
       cache 30
       loop
       reload
@@ -615,6 +615,8 @@ status: {}
 			vpaEnabled       bool
 			rewritingEnabled bool
 			commonSuffixes   []string
+
+			upstreamResolvConf string
 		)
 
 		BeforeEach(func() {
@@ -622,6 +624,7 @@ status: {}
 			vpaEnabled = false
 			rewritingEnabled = false
 			commonSuffixes = []string{}
+			upstreamResolvConf = "/etc/resolv.conf"
 		})
 
 		JustBeforeEach(func() {
@@ -669,7 +672,7 @@ status: {}
 			Expect(string(managedResourceSecret.Data["serviceaccount__kube-system__coredns.yaml"])).To(Equal(serviceAccountYAML))
 			Expect(string(managedResourceSecret.Data["clusterrole____system_coredns.yaml"])).To(Equal(clusterRoleYAML))
 			Expect(string(managedResourceSecret.Data["clusterrolebinding____system_coredns.yaml"])).To(Equal(clusterRoleBindingYAML))
-			Expect(string(managedResourceSecret.Data["configmap__kube-system__coredns.yaml"])).To(Equal(configMapYAML(rewritingEnabled, commonSuffixes)))
+			Expect(string(managedResourceSecret.Data["configmap__kube-system__coredns.yaml"])).To(Equal(configMapYAML(rewritingEnabled, commonSuffixes, upstreamResolvConf)))
 			Expect(string(managedResourceSecret.Data["configmap__kube-system__coredns-custom.yaml"])).To(Equal(configMapCustomYAML))
 			Expect(string(managedResourceSecret.Data["service__kube-system__kube-dns.yaml"])).To(Equal(serviceYAML))
 			Expect(string(managedResourceSecret.Data["networkpolicy__kube-system__gardener.cloud--allow-dns.yaml"])).To(Equal(networkPolicyYAML))
@@ -740,6 +743,32 @@ status: {}
 			})
 		})
 
+		Context("w/ forwarding to the resolv.conf of the host", func() {
+			BeforeEach(func() {
+				upstreamResolvConf = "/etc/host-resolv.conf"
+				values.ForwardToHostResolvConf = true
+				component = New(c, namespace, values)
+			})
+
+			It("should mount the resolv.conf of the host", func() {
+				Expect(string(managedResourceSecret.Data["deployment__kube-system__coredns.yaml"])).To(And(
+					ContainSubstring(`        - mountPath: /etc/host-resolv.conf
+          name: host-resolv-conf
+          readOnly: true
+`),
+					ContainSubstring(`      - hostPath:
+          path: /etc/resolv.conf
+          type: File
+        name: host-resolv-conf
+`),
+				))
+			})
+
+			AfterEach(func() {
+				values.ForwardToHostResolvConf = false
+			})
+		})
+
 		Context("w/ cluster proportional autoscaler enabled", func() {
 			BeforeEach(func() {
 				cpaEnabled = true
@@ -112,7 +112,8 @@ controllers:
 kind: NodeAgentConfiguration
 logFormat: ""
 logLevel: ""
-server: {}
+server:
+  metricsClientCAFile: /var/lib/kubelet/ca.crt
 `))}},
 					},
 					extensionsv1alpha1.File{
@@ -196,7 +197,8 @@ controllers:
 kind: NodeAgentConfiguration
 logFormat: ""
 logLevel: ""
-server: {}
+server:
+  metricsClientCAFile: /var/lib/kubelet/ca.crt
 `))}},
 				}))
 			})
@@ -37,6 +37,7 @@ import (
 	"github.com/gardener/gardener/pkg/component/extensions/operatingsystemconfig/downloader"
 	"github.com/gardener/gardener/pkg/component/extensions/operatingsystemconfig/original"
 	"github.com/gardener/gardener/pkg/component/extensions/operatingsystemconfig/original/components"
+	"github.com/gardener/gardener/pkg/component/nodelocaldns"
 	"github.com/gardener/gardener/pkg/controllerutils"
 	"github.com/gardener/gardener/pkg/extensions"
 	"github.com/gardener/gardener/pkg/utils"
@@ -127,6 +128,14 @@ type OriginalValues struct {
 	ValiIngressHostName string
 	// NodeLocalDNSEnabled indicates whether node local dns is enabled or not.
 	NodeLocalDNSEnabled bool
+	// NodeLocalDNSHostNetworkEnabled indicates whether node local dns also serves pods running in the host network.
+	NodeLocalDNSHostNetworkEnabled bool
+	// NodeLocalDNSForwardToNodeResolvers indicates whether node local dns forwards queries for non-cluster domains to
+	// the resolvers of the node. If set, the resolvers are captured on the nodes before the kubelet starts.
+	NodeLocalDNSForwardToNodeResolvers bool
+	// NodeLocalDNSStaticPodValues are the values of node local dns if it runs as a static pod managed by the kubelet.
+	// If set, the static pod manifest and the Corefile are added to the operating system config.
+	NodeLocalDNSStaticPodValues *nodelocaldns.Values
 }
 
 // New creates a new instance of Interface.
@@ -519,6 +528,11 @@ func (o *operatingSystemConfig) newDeployer(osc *extensionsv1alpha1.OperatingSys
 		valiIngressHostName:     o.values.ValiIngressHostName,
 		valitailEnabled:         o.values.ValitailEnabled,
 		nodeLocalDNSEnabled:     o.values.NodeLocalDNSEnabled,
+
+		nodeLocalDNSHostNetworkEnabled: o.values.NodeLocalDNSHostNetworkEnabled,
+		nodeLocalDNSStaticPodValues:    o.values.NodeLocalDNSStaticPodValues,
+
+		nodeLocalDNSForwardToNodeResolvers: o.values.NodeLocalDNSForwardToNodeResolvers,
 	}, nil
 }
 
@@ -580,6 +594,11 @@ type deployer struct {
 	valiIngressHostName     string
 	valitailEnabled         bool
 	nodeLocalDNSEnabled     bool
+
+	nodeLocalDNSHostNetworkEnabled bool
+	nodeLocalDNSStaticPodValues    *nodelocaldns.Values
+
+	nodeLocalDNSForwardToNodeResolvers bool
 }
 
 // exposed for testing
@@ -632,6 +651,11 @@ func (d *deployer) deploy(ctx context.Context, operation string) (extensionsv1al
 			ValiIngress:             d.valiIngressHostName,
 			APIServerURL:            d.apiServerURL,
 			Sysctls:                 d.worker.Sysctls,
+
+			NodeLocalDNSHostNetworkEnabled: d.nodeLocalDNSHostNetworkEnabled,
+			NodeLocalDNSStaticPodValues:    d.nodeLocalDNSStaticPodValues,
+
+			NodeLocalDNSForwardToNodeResolvers: d.nodeLocalDNSForwardToNodeResolvers,
 		})
 		if err != nil {
 			return nil, err
@@ -698,6 +722,8 @@ func (d *deployer) deploy(ctx context.Context, operation string) (extensionsv1al
 
 		if d.purpose == extensionsv1alpha1.OperatingSystemConfigPurposeReconcile {
 			d.osc.Spec.ReloadConfigFilePath = pointer.String(downloader.PathDownloadedCloudConfig)
+			d.osc.Spec.NodeLabels = gardenerutils.NodeLabelsForWorkerPool(d.worker, d.nodeLocalDNSEnabled)
+			d.osc.Spec.NodeTaints = d.worker.Taints
 		}
 
 		return nil
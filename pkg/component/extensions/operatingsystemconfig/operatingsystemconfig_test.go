@@ -297,6 +297,8 @@ var _ = Describe("OperatingSystemConfig", func() {
 						Purpose:              extensionsv1alpha1.OperatingSystemConfigPurposeReconcile,
 						CRIConfig:            criConfig,
 						ReloadConfigFilePath: pointer.String("/var/lib/cloud-config-downloader/downloads/cloud_config"),
+						NodeLabels:           gardenerutils.NodeLabelsForWorkerPool(worker, false),
+						NodeTaints:           worker.Taints,
 						Units:                originalUnits,
 						Files: append(append(originalFiles, downloaderFiles...), extensionsv1alpha1.File{
 							Path:        "/etc/systemd/system/cloud-config-downloader.service",
@@ -19,6 +19,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/component/nodelocaldns"
 	"github.com/gardener/gardener/pkg/utils/imagevector"
 )
 
@@ -51,4 +52,11 @@ type Context struct {
 	APIServerURL            string
 	Sysctls                 map[string]string
 	OSCSyncJitterPeriod     *metav1.Duration
+	// NodeLocalDNSHostNetworkEnabled indicates whether node-local-dns also serves pods running in the host network.
+	NodeLocalDNSHostNetworkEnabled bool
+	// NodeLocalDNSStaticPodValues are the values of node-local-dns if it runs as a static pod managed by the kubelet.
+	NodeLocalDNSStaticPodValues *nodelocaldns.Values
+	// NodeLocalDNSForwardToNodeResolvers indicates whether node-local-dns forwards queries for non-cluster domains to
+	// the resolvers of the node.
+	NodeLocalDNSForwardToNodeResolvers bool
 }
@@ -65,6 +65,8 @@ type ConfigurableKubeletConfigParameters struct {
 	MemorySwap                       *kubeletconfigv1beta1.MemorySwapConfiguration
 	PodPidsLimit                     *int64
 	ProtectKernelDefaults            *bool
+	ResolverConfig                   *string
+	StaticPodPath                    *string
 	SystemReserved                   map[string]string
 }
 
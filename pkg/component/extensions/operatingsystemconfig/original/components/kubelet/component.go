@@ -32,6 +32,8 @@ import (
 	"github.com/gardener/gardener/pkg/component/extensions/operatingsystemconfig/original/components/containerd"
 	"github.com/gardener/gardener/pkg/component/extensions/operatingsystemconfig/original/components/docker"
 	oscutils "github.com/gardener/gardener/pkg/component/extensions/operatingsystemconfig/utils"
+	"github.com/gardener/gardener/pkg/component/nodelocaldns"
+	nodelocaldnsconstants "github.com/gardener/gardener/pkg/component/nodelocaldns/constants"
 	"github.com/gardener/gardener/pkg/features"
 	"github.com/gardener/gardener/pkg/utils"
 )
@@ -41,6 +43,11 @@ var (
 	//go:embed templates/scripts/health-monitor.tpl.sh
 	tplContentHealthMonitor string
 	tplHealthMonitor        *template.Template
+
+	tplNameCaptureNodeResolvers = "capture-node-resolvers"
+	//go:embed templates/scripts/capture-node-resolvers.tpl.sh
+	tplContentCaptureNodeResolvers string
+	tplCaptureNodeResolvers        *template.Template
 )
 
 func init() {
@@ -52,6 +59,14 @@ func init() {
 	if err != nil {
 		panic(err)
 	}
+
+	tplCaptureNodeResolvers, err = template.
+		New(tplNameCaptureNodeResolvers).
+		Funcs(sprig.TxtFuncMap()).
+		Parse(tplContentCaptureNodeResolvers)
+	if err != nil {
+		panic(err)
+	}
 }
 
 const (
@@ -74,6 +89,12 @@ const (
 	// PathNodeName is the path for a file containing the name of the Node registered by kubelet for the respective
 	// machine.
 	PathNodeName = PathKubeletDirectory + "/nodename"
+	// PathResolvConfNodeLocalDNS is the path for the resolv.conf file used by the kubelet if node-local-dns also
+	// serves pods running in the host network.
+	PathResolvConfNodeLocalDNS = PathKubeletDirectory + "/resolv-node-local-dns.conf"
+	// PathScriptCaptureNodeResolvers is the path for the script capturing the resolvers of the node for node-local-dns
+	// before the kubelet starts.
+	PathScriptCaptureNodeResolvers = v1beta1constants.OperatingSystemConfigFilePathBinaries + "/capture-node-resolvers"
 
 	pathVolumePluginDirectory = "/var/lib/kubelet/volumeplugins"
 )
@@ -106,7 +127,17 @@ func (component) Config(ctx components.Context) ([]extensionsv1alpha1.Unit, []ex
 		return nil, nil, err
 	}
 
-	fileContentKubeletConfig, err := getFileContentKubeletConfig(ctx.KubernetesVersion, ctx.ClusterDNSAddress, ctx.ClusterDomain, ctx.KubeletConfigParameters)
+	kubeletConfigParameters := ctx.KubeletConfigParameters
+	if ctx.NodeLocalDNSHostNetworkEnabled {
+		// Pods running in the host network (and pods with the 'Default' DNS policy) resolve via the resolv.conf
+		// handed out by the kubelet, hence it has to point to node-local-dns as well.
+		kubeletConfigParameters.ResolverConfig = pointer.String(PathResolvConfNodeLocalDNS)
+	}
+	if ctx.NodeLocalDNSStaticPodValues != nil {
+		kubeletConfigParameters.StaticPodPath = pointer.String(v1beta1constants.OperatingSystemConfigFilePathStaticPodManifests)
+	}
+
+	fileContentKubeletConfig, err := getFileContentKubeletConfig(ctx.KubernetesVersion, ctx.ClusterDNSAddress, ctx.ClusterDomain, kubeletConfigParameters)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -140,6 +171,44 @@ ExecStartPre=` + PathScriptCopyKubernetesBinary + ` kubectl`
 		},
 	}
 
+	if ctx.NodeLocalDNSHostNetworkEnabled {
+		kubeletFiles = append(kubeletFiles, extensionsv1alpha1.File{
+			Path:        PathResolvConfNodeLocalDNS,
+			Permissions: pointer.Int32(0644),
+			Content: extensionsv1alpha1.FileContent{
+				Inline: &extensionsv1alpha1.FileContentInline{
+					Encoding: "b64",
+					Data:     utils.EncodeBase64([]byte("nameserver " + nodelocaldnsconstants.IPVSAddress + "\n")),
+				},
+			},
+		})
+	}
+
+	if ctx.NodeLocalDNSForwardToNodeResolvers {
+		var captureNodeResolversScript bytes.Buffer
+		if err := tplCaptureNodeResolvers.Execute(&captureNodeResolversScript, map[string]string{
+			"nodeLocalDNSAddress": nodelocaldnsconstants.IPVSAddress,
+			"pathNodeResolvConf":  nodelocaldns.PathNodeResolvConf,
+		}); err != nil {
+			return nil, nil, err
+		}
+
+		kubeletFiles = append(kubeletFiles, extensionsv1alpha1.File{
+			Path:        PathScriptCaptureNodeResolvers,
+			Permissions: pointer.Int32(0755),
+			Content: extensionsv1alpha1.FileContent{
+				Inline: &extensionsv1alpha1.FileContentInline{
+					Encoding: "b64",
+					Data:     utils.EncodeBase64(captureNodeResolversScript.Bytes()),
+				},
+			},
+		})
+		// The resolvers are captured before the kubelet starts node-local-dns. Failures must not prevent the kubelet from
+		// starting, node-local-dns does not start until the resolvers have been captured successfully.
+		kubeletStartPre += `
+ExecStartPre=-` + PathScriptCaptureNodeResolvers
+	}
+
 	healthMonitorFiles := []extensionsv1alpha1.File{
 		{
 			Path:        pathHealthMonitor,
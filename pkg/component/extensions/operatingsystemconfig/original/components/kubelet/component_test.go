@@ -25,6 +25,7 @@ import (
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	"github.com/gardener/gardener/pkg/component/extensions/operatingsystemconfig/original/components"
 	. "github.com/gardener/gardener/pkg/component/extensions/operatingsystemconfig/original/components/kubelet"
+	"github.com/gardener/gardener/pkg/component/nodelocaldns"
 	"github.com/gardener/gardener/pkg/features"
 	"github.com/gardener/gardener/pkg/utils"
 	"github.com/gardener/gardener/pkg/utils/imagevector"
@@ -127,6 +128,94 @@ var _ = Describe("Component", func() {
 			true,
 		),
 	)
+
+	It("should configure the kubelet to hand out node-local-dns as resolver if enabled for the host network", func() {
+		ctx.CRIName = extensionsv1alpha1.CRINameContainerD
+		ctx.KubernetesVersion = semver.MustParse("1.26.1")
+		ctx.Images = map[string]*imagevector.Image{
+			"pause-container": {Name: "pause-container", Repository: pauseContainerImageRepo, Tag: pointer.String(pauseContainerImageTag)},
+		}
+		ctx.NodeLocalDNSHostNetworkEnabled = true
+
+		units, files, err := component.Config(ctx)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(files).To(ContainElement(extensionsv1alpha1.File{
+			Path:        "/var/lib/kubelet/resolv-node-local-dns.conf",
+			Permissions: pointer.Int32(0644),
+			Content: extensionsv1alpha1.FileContent{
+				Inline: &extensionsv1alpha1.FileContentInline{
+					Encoding: "b64",
+					Data:     utils.EncodeBase64([]byte("nameserver 169.254.20.10\n")),
+				},
+			},
+		}))
+		Expect(units[0].FilePaths).To(ContainElement("/var/lib/kubelet/resolv-node-local-dns.conf"))
+
+		var kubeletConfigFile *extensionsv1alpha1.File
+		for i, file := range files {
+			if file.Path == "/var/lib/kubelet/config/kubelet" {
+				kubeletConfigFile = &files[i]
+			}
+		}
+		Expect(kubeletConfigFile).NotTo(BeNil())
+		kubeletConfigContent, err := utils.DecodeBase64(kubeletConfigFile.Content.Inline.Data)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(kubeletConfigContent)).To(ContainSubstring("resolvConf: /var/lib/kubelet/resolv-node-local-dns.conf"))
+	})
+
+	It("should capture the resolvers of the node if node-local-dns forwards to them", func() {
+		ctx.CRIName = extensionsv1alpha1.CRINameContainerD
+		ctx.KubernetesVersion = semver.MustParse("1.27.0")
+		ctx.Images = map[string]*imagevector.Image{
+			"pause-container": {Name: "pause-container", Repository: pauseContainerImageRepo, Tag: pointer.String(pauseContainerImageTag)},
+		}
+		ctx.NodeLocalDNSForwardToNodeResolvers = true
+
+		units, files, err := component.Config(ctx)
+		Expect(err).NotTo(HaveOccurred())
+
+		var scriptFile *extensionsv1alpha1.File
+		for i, file := range files {
+			if file.Path == "/opt/bin/capture-node-resolvers" {
+				scriptFile = &files[i]
+			}
+		}
+		Expect(scriptFile).NotTo(BeNil())
+		Expect(scriptFile.Permissions).To(Equal(pointer.Int32(0755)))
+		script, err := utils.DecodeBase64(scriptFile.Content.Inline.Data)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(script)).To(And(
+			ContainSubstring(`$2 != "169.254.20.10"`),
+			ContainSubstring(`mv "/var/lib/node-local-dns/node-resolv.conf.tmp" "/var/lib/node-local-dns/node-resolv.conf"`),
+		))
+
+		Expect(units[0].FilePaths).To(ContainElement("/opt/bin/capture-node-resolvers"))
+		Expect(*units[0].Content).To(ContainSubstring("ExecStartPre=-/opt/bin/capture-node-resolvers\nExecStart="))
+	})
+
+	It("should configure the static pod path if node-local-dns runs as static pod", func() {
+		ctx.CRIName = extensionsv1alpha1.CRINameContainerD
+		ctx.KubernetesVersion = semver.MustParse("1.26.1")
+		ctx.Images = map[string]*imagevector.Image{
+			"pause-container": {Name: "pause-container", Repository: pauseContainerImageRepo, Tag: pointer.String(pauseContainerImageTag)},
+		}
+		ctx.NodeLocalDNSStaticPodValues = &nodelocaldns.Values{StaticPodEnabled: true}
+
+		_, files, err := component.Config(ctx)
+		Expect(err).NotTo(HaveOccurred())
+
+		var kubeletConfigFile *extensionsv1alpha1.File
+		for i, file := range files {
+			if file.Path == "/var/lib/kubelet/config/kubelet" {
+				kubeletConfigFile = &files[i]
+			}
+		}
+		Expect(kubeletConfigFile).NotTo(BeNil())
+		kubeletConfigContent, err := utils.DecodeBase64(kubeletConfigFile.Content.Inline.Data)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(kubeletConfigContent)).To(ContainSubstring("staticPodPath: /etc/kubernetes/manifests"))
+	})
 })
 
 const (
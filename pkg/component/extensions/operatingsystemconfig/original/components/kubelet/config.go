@@ -91,12 +91,13 @@ func Config(kubernetesVersion *semver.Version, clusterDNSAddress, clusterDomain
 		PodPidsLimit:                     params.PodPidsLimit,
 		ProtectKernelDefaults:            *params.ProtectKernelDefaults,
 		ReadOnlyPort:                     0,
-		ResolverConfig:                   pointer.String("/etc/resolv.conf"),
+		ResolverConfig:                   params.ResolverConfig,
 		RotateCertificates:               true,
 		RuntimeRequestTimeout:            metav1.Duration{Duration: 2 * time.Minute},
 		SeccompDefault:                   params.SeccompDefault,
 		SerializeImagePulls:              params.SerializeImagePulls,
 		ServerTLSBootstrap:               true,
+		StaticPodPath:                    pointer.StringDeref(params.StaticPodPath, ""),
 		StreamingConnectionIdleTimeout:   *params.StreamingConnectionIdleTimeout,
 		RegisterWithTaints: []corev1.Taint{{
 			Key:    v1beta1constants.TaintNodeCriticalComponentsNotReady,
@@ -257,6 +258,10 @@ func setConfigDefaults(c *components.ConfigurableKubeletConfigParameters, kubern
 		c.ContainerLogMaxSize = pointer.String("100Mi")
 	}
 
+	if c.ResolverConfig == nil {
+		c.ResolverConfig = pointer.String("/etc/resolv.conf")
+	}
+
 	c.ProtectKernelDefaults = pointer.Bool(ShouldProtectKernelDefaultsBeEnabled(c, kubernetesVersion))
 
 	if c.StreamingConnectionIdleTimeout == nil {
@@ -0,0 +1,22 @@
+#!/bin/bash
+set -o nounset
+set -o pipefail
+
+# systemd-resolved only lists its stub resolver in /etc/resolv.conf, hence the resolvers managed by it (e.g., handed
+# out via DHCP) are read from its own resolv.conf if present.
+source_file="/etc/resolv.conf"
+if [[ -s /run/systemd/resolve/resolv.conf ]]; then
+  source_file="/run/systemd/resolve/resolv.conf"
+fi
+
+# Loopback addresses and node-local-dns itself must not be captured to prevent forwarding loops.
+resolvers="$(awk '$1 == "nameserver" && $2 !~ /^127\./ && $2 != "::1" && $2 != "{{ .nodeLocalDNSAddress }}" { print "nameserver " $2 }' "$source_file")"
+if [[ -z "$resolvers" ]]; then
+  echo "No resolvers found in $source_file, keeping the previously captured resolvers."
+  exit 0
+fi
+
+mkdir -p "$(dirname "{{ .pathNodeResolvConf }}")"
+echo "$resolvers" > "{{ .pathNodeResolvConf }}.tmp"
+mv "{{ .pathNodeResolvConf }}.tmp" "{{ .pathNodeResolvConf }}"
+echo "Captured the resolvers of the node from $source_file."
@@ -31,6 +31,7 @@ import (
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	extensionsv1alpha1helper "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1/helper"
 	"github.com/gardener/gardener/pkg/component/extensions/operatingsystemconfig/original/components"
+	"github.com/gardener/gardener/pkg/component/extensions/operatingsystemconfig/original/components/kubelet"
 	nodeagentv1alpha1 "github.com/gardener/gardener/pkg/nodeagent/apis/config/v1alpha1"
 	"github.com/gardener/gardener/pkg/utils"
 )
@@ -103,6 +104,7 @@ LimitMEMLOCK=infinity
 ExecStart=` + nodeagentv1alpha1.BinaryDir + `/gardener-node-agent --config=` + nodeagentv1alpha1.ConfigFilePath + `
 Restart=always
 RestartSec=5
+WatchdogSec=2min
 
 [Install]
 WantedBy=multi-user.target`
@@ -131,6 +133,11 @@ func ComponentConfig(
 				SecretName: AccessSecretName,
 			},
 		},
+		Server: nodeagentv1alpha1.ServerConfiguration{
+			// The metrics are scraped via the node proxy of kube-apiserver which authenticates with the client
+			// certificate it also uses for talking to the kubelet.
+			MetricsClientCAFile: pointer.String(kubelet.PathKubeletCACert),
+		},
 	}
 }
 
@@ -87,6 +87,7 @@ LimitMEMLOCK=infinity
 ExecStart=/opt/bin/gardener-node-agent --config=/var/lib/gardener-node-agent/config.yaml
 Restart=always
 RestartSec=5
+WatchdogSec=2min
 
 [Install]
 WantedBy=multi-user.target`),
@@ -108,6 +109,7 @@ LimitMEMLOCK=infinity
 ExecStart=/opt/bin/gardener-node-agent --config=/var/lib/gardener-node-agent/config.yaml
 Restart=always
 RestartSec=5
+WatchdogSec=2min
 
 [Install]
 WantedBy=multi-user.target`))
@@ -131,6 +133,9 @@ WantedBy=multi-user.target`))
 						SecretName: "gardener-node-agent",
 					},
 				},
+				Server: nodeagentv1alpha1.ServerConfiguration{
+					MetricsClientCAFile: pointer.String("/var/lib/kubelet/ca.crt"),
+				},
 			}))
 		})
 	})
@@ -162,7 +167,8 @@ controllers:
 kind: NodeAgentConfiguration
 logFormat: ""
 logLevel: ""
-server: {}
+server:
+  metricsClientCAFile: /var/lib/kubelet/ca.crt
 `))}},
 			}))
 		})
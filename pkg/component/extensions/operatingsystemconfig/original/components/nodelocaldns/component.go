@@ -0,0 +1,45 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package nodelocaldns
+
+import (
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/component/extensions/operatingsystemconfig/original/components"
+	"github.com/gardener/gardener/pkg/component/nodelocaldns"
+)
+
+type component struct{}
+
+// New returns a new node-local-dns component.
+func New() *component {
+	return &component{}
+}
+
+func (component) Name() string {
+	return "node-local-dns"
+}
+
+func (component) Config(ctx components.Context) ([]extensionsv1alpha1.Unit, []extensionsv1alpha1.File, error) {
+	if ctx.NodeLocalDNSStaticPodValues == nil {
+		return nil, nil, nil
+	}
+
+	files, err := nodelocaldns.StaticPodFiles(*ctx.NodeLocalDNSStaticPodValues)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return nil, files, nil
+}
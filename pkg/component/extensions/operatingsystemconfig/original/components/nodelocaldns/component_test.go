@@ -0,0 +1,64 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package nodelocaldns_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/gardener/gardener/pkg/component/extensions/operatingsystemconfig/original/components"
+	. "github.com/gardener/gardener/pkg/component/extensions/operatingsystemconfig/original/components/nodelocaldns"
+	"github.com/gardener/gardener/pkg/component/nodelocaldns"
+)
+
+var _ = Describe("Component", func() {
+	Describe("#Config", func() {
+		var component components.Component
+
+		BeforeEach(func() {
+			component = New()
+		})
+
+		It("should return nothing if node-local-dns does not run as static pod", func() {
+			units, files, err := component.Config(components.Context{})
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(units).To(BeNil())
+			Expect(files).To(BeNil())
+		})
+
+		It("should return the static pod files if node-local-dns runs as static pod", func() {
+			values := &nodelocaldns.Values{
+				Image:            "some-image:some-tag",
+				ClusterDNS:       "100.64.0.10",
+				StaticPodEnabled: true,
+			}
+
+			expectedFiles, err := nodelocaldns.StaticPodFiles(*values)
+			Expect(err).NotTo(HaveOccurred())
+
+			units, files, err := component.Config(components.Context{NodeLocalDNSStaticPodValues: values})
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(units).To(BeNil())
+			Expect(files).To(Equal(expectedFiles))
+		})
+
+		It("should return an error if the values are invalid", func() {
+			_, _, err := component.Config(components.Context{NodeLocalDNSStaticPodValues: &nodelocaldns.Values{ClusterDomain: "-invalid"}})
+			Expect(err).To(MatchError(ContainSubstring("invalid cluster domain")))
+		})
+	})
+})
@@ -23,6 +23,7 @@ import (
 	"github.com/gardener/gardener/pkg/component/extensions/operatingsystemconfig/original/components/journald"
 	"github.com/gardener/gardener/pkg/component/extensions/operatingsystemconfig/original/components/kernelconfig"
 	"github.com/gardener/gardener/pkg/component/extensions/operatingsystemconfig/original/components/kubelet"
+	"github.com/gardener/gardener/pkg/component/extensions/operatingsystemconfig/original/components/nodelocaldns"
 	"github.com/gardener/gardener/pkg/component/extensions/operatingsystemconfig/original/components/rootcertificates"
 	"github.com/gardener/gardener/pkg/component/extensions/operatingsystemconfig/original/components/sshdensurer"
 	"github.com/gardener/gardener/pkg/component/extensions/operatingsystemconfig/original/components/valitail"
@@ -63,6 +64,7 @@ func Components(criName extensionsv1alpha1.CRIName, sshAccessEnabled bool) []com
 		journald.New(),
 		kernelconfig.New(),
 		kubelet.New(),
+		nodelocaldns.New(),
 		sshdensurer.New(),
 	}
 
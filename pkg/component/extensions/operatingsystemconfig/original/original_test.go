@@ -121,6 +121,7 @@ var _ = Describe("Original", func() {
 				"journald",
 				"kernel-config",
 				"kubelet",
+				"node-local-dns",
 				"sshd-ensurer",
 				"gardener-user",
 			}))
@@ -140,6 +141,7 @@ var _ = Describe("Original", func() {
 				"journald",
 				"kernel-config",
 				"kubelet",
+				"node-local-dns",
 				"sshd-ensurer",
 				"gardener-user",
 				"containerd-initializer",
@@ -160,6 +162,7 @@ var _ = Describe("Original", func() {
 				"journald",
 				"kernel-config",
 				"kubelet",
+				"node-local-dns",
 				"sshd-ensurer",
 				"containerd-initializer",
 			}))
@@ -17,6 +17,7 @@ package worker
 import (
 	"context"
 	"fmt"
+	"strconv"
 	"time"
 
 	"github.com/Masterminds/semver/v3"
@@ -25,6 +26,7 @@ import (
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/pointer"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
@@ -185,6 +187,18 @@ func (w *worker) deploy(ctx context.Context, operation string) (extensionsv1alph
 			workerPoolKubernetesVersion = *workerPool.Kubernetes.Version
 		}
 
+		var clusterAutoscaler *extensionsv1alpha1.ClusterAutoscalerOptions
+		if workerPool.ClusterAutoscaler != nil {
+			clusterAutoscaler = &extensionsv1alpha1.ClusterAutoscalerOptions{
+				ScaleDownUnneededTime:         workerPool.ClusterAutoscaler.ScaleDownUnneededTime,
+				MaxNodeProvisionTime:          workerPool.ClusterAutoscaler.MaxNodeProvisionTime,
+				MaxGracefulTerminationSeconds: workerPool.ClusterAutoscaler.MaxGracefulTerminationSeconds,
+			}
+			if threshold := workerPool.ClusterAutoscaler.ScaleDownUtilizationThreshold; threshold != nil {
+				clusterAutoscaler.ScaleDownUtilizationThreshold = pointer.String(strconv.FormatFloat(*threshold, 'f', -1, 64))
+			}
+		}
+
 		nodeTemplate, machineType := w.findNodeTemplateAndMachineTypeByPoolName(obj, workerPool.Name)
 
 		if nodeTemplate == nil || machineType != workerPool.Machine.Type {
@@ -202,6 +216,11 @@ func (w *worker) deploy(ctx context.Context, operation string) (extensionsv1alph
 			}
 		}
 
+		if nodeTemplate != nil {
+			nodeTemplate = nodeTemplate.DeepCopy()
+			nodeTemplate.Architecture = workerPool.Machine.Architecture
+		}
+
 		pools = append(pools, extensionsv1alpha1.WorkerPool{
 			Name:           workerPool.Name,
 			Minimum:        workerPool.Minimum,
@@ -226,6 +245,7 @@ func (w *worker) deploy(ctx context.Context, operation string) (extensionsv1alph
 			Zones:                            workerPool.Zones,
 			MachineControllerManagerSettings: workerPool.MachineControllerManagerSettings,
 			Architecture:                     workerPool.Machine.Architecture,
+			ClusterAutoscaler:                clusterAutoscaler,
 		})
 	}
 
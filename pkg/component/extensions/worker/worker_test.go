@@ -70,34 +70,40 @@ var _ = Describe("Worker", func() {
 		workerKubernetesVersion      = "1.27.6"
 		infrastructureProviderStatus = &runtime.RawExtension{Raw: []byte(`{"baz":"foo"}`)}
 
-		worker1Name                           = "worker1"
-		worker1Minimum                  int32 = 1
-		worker1Maximum                  int32 = 2
-		worker1MaxSurge                       = intstr.FromInt32(3)
-		worker1MaxUnavailable                 = intstr.FromInt32(4)
-		worker1Labels                         = map[string]string{"foo": "bar"}
-		worker1Annotations                    = map[string]string{"bar": "foo"}
-		worker1Taints                         = []corev1.Taint{{}}
-		worker1MachineType                    = "worker1machinetype"
-		worker1MachineImageName               = "worker1machineimage"
-		worker1MachineImageVersion            = "worker1machineimagev1"
-		worker1MCMSettings                    = &gardencorev1beta1.MachineControllerManagerSettings{}
-		worker1UserData                       = []byte("bootstrap-me")
-		worker1VolumeName                     = "worker1volumename"
-		worker1VolumeType                     = "worker1volumetype"
-		worker1VolumeSize                     = "42Gi"
-		worker1VolumeEncrypted                = true
-		worker1DataVolume1Name                = "worker1datavolume1name"
-		worker1DataVolume1Type                = "worker1datavolume1type"
-		worker1DataVolume1Size                = "43Gi"
-		worker1DataVolume1Encrypted           = false
-		worker1KubeletDataVolumeName          = "worker1kubeletdatavol"
-		worker1CRIName                        = gardencorev1beta1.CRIName("cri")
-		worker1CRIContainerRuntime1Type       = "cruntime"
-		worker1ProviderConfig                 = &runtime.RawExtension{Raw: []byte(`{"bar":"baz"}`)}
-		worker1Zone1                          = "worker1zone1"
-		worker1Zone2                          = "worker1zone1"
-		worker1Arch                           = pointer.String("amd64")
+		worker1Name                      = "worker1"
+		worker1Minimum             int32 = 1
+		worker1Maximum             int32 = 2
+		worker1MaxSurge                  = intstr.FromInt32(3)
+		worker1MaxUnavailable            = intstr.FromInt32(4)
+		worker1Labels                    = map[string]string{"foo": "bar"}
+		worker1Annotations               = map[string]string{"bar": "foo"}
+		worker1Taints                    = []corev1.Taint{{}}
+		worker1MachineType               = "worker1machinetype"
+		worker1MachineImageName          = "worker1machineimage"
+		worker1MachineImageVersion       = "worker1machineimagev1"
+		worker1MCMSettings               = &gardencorev1beta1.MachineControllerManagerSettings{}
+		worker1ClusterAutoscaler         = &gardencorev1beta1.ClusterAutoscalerOptions{
+			ScaleDownUtilizationThreshold: pointer.Float64(0.25),
+			ScaleDownUnneededTime:         &metav1.Duration{Duration: 5 * time.Minute},
+			MaxNodeProvisionTime:          &metav1.Duration{Duration: 15 * time.Minute},
+			MaxGracefulTerminationSeconds: pointer.Int32(3600),
+		}
+		worker1UserData                 = []byte("bootstrap-me")
+		worker1VolumeName               = "worker1volumename"
+		worker1VolumeType               = "worker1volumetype"
+		worker1VolumeSize               = "42Gi"
+		worker1VolumeEncrypted          = true
+		worker1DataVolume1Name          = "worker1datavolume1name"
+		worker1DataVolume1Type          = "worker1datavolume1type"
+		worker1DataVolume1Size          = "43Gi"
+		worker1DataVolume1Encrypted     = false
+		worker1KubeletDataVolumeName    = "worker1kubeletdatavol"
+		worker1CRIName                  = gardencorev1beta1.CRIName("cri")
+		worker1CRIContainerRuntime1Type = "cruntime"
+		worker1ProviderConfig           = &runtime.RawExtension{Raw: []byte(`{"bar":"baz"}`)}
+		worker1Zone1                    = "worker1zone1"
+		worker1Zone2                    = "worker1zone1"
+		worker1Arch                     = pointer.String("amd64")
 
 		worker2Name                      = "worker2"
 		worker2Minimum             int32 = 5
@@ -131,6 +137,7 @@ var _ = Describe("Worker", func() {
 				"gpu":    machineTypes[0].GPU,
 				"memory": machineTypes[0].Memory,
 			},
+			Architecture: worker1Arch,
 		}
 
 		workerPool2NodeTemplate = &extensionsv1alpha1.NodeTemplate{
@@ -139,6 +146,7 @@ var _ = Describe("Worker", func() {
 				"gpu":    machineTypes[1].GPU,
 				"memory": machineTypes[1].Memory,
 			},
+			Architecture: worker2Arch,
 		}
 
 		w, empty *extensionsv1alpha1.Worker
@@ -220,6 +228,7 @@ var _ = Describe("Worker", func() {
 					ProviderConfig:                   worker1ProviderConfig,
 					MachineControllerManagerSettings: worker1MCMSettings,
 					Zones:                            []string{worker1Zone1, worker1Zone2},
+					ClusterAutoscaler:                worker1ClusterAutoscaler,
 				},
 				{
 					Name:           worker2Name,
@@ -310,6 +319,12 @@ var _ = Describe("Worker", func() {
 					MachineControllerManagerSettings: worker1MCMSettings,
 					NodeTemplate:                     workerPool1NodeTemplate,
 					Architecture:                     worker1Arch,
+					ClusterAutoscaler: &extensionsv1alpha1.ClusterAutoscalerOptions{
+						ScaleDownUtilizationThreshold: pointer.String("0.25"),
+						ScaleDownUnneededTime:         &metav1.Duration{Duration: 5 * time.Minute},
+						MaxNodeProvisionTime:          &metav1.Duration{Duration: 15 * time.Minute},
+						MaxGracefulTerminationSeconds: pointer.Int32(3600),
+					},
 				},
 				{
 					Name:           worker2Name,
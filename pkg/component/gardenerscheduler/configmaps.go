@@ -15,6 +15,11 @@
 package gardenerscheduler
 
 import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -24,9 +29,12 @@ import (
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	componentbaseconfigv1alpha1 "k8s.io/component-base/config/v1alpha1"
 	"k8s.io/utils/pointer"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/gardener/gardener/pkg/logger"
+	"github.com/gardener/gardener/pkg/resourcemanager/controller/garbagecollector/references"
 	schedulerv1alpha1 "github.com/gardener/gardener/pkg/scheduler/apis/config/v1alpha1"
+	"github.com/gardener/gardener/pkg/utils"
 	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
 	kubernetesutils "github.com/gardener/gardener/pkg/utils/kubernetes"
 )
@@ -76,12 +84,28 @@ func (g *gardenerScheduler) configMapSchedulerConfig() (*corev1.ConfigMap, error
 		},
 		Schedulers: schedulerv1alpha1.SchedulerControllerConfiguration{
 			Shoot: &schedulerv1alpha1.ShootSchedulerConfiguration{
-				Strategy: schedulerv1alpha1.MinimalDistance,
+				Strategy:         schedulerv1alpha1.MinimalDistance,
+				SpreadStrategy:   g.values.ShootSpreadStrategy,
+				CandidateWeights: g.values.ShootCandidateWeights,
+				RetryInterval:    g.values.ShootRetryInterval,
+				MaxRetryBackoff:  g.values.ShootMaxRetryBackoff,
 			},
 		},
 		FeatureGates: g.values.FeatureGates,
 	}
 
+	if g.values.ShootStrategy != "" {
+		schedulerConfig.Schedulers.Shoot.Strategy = g.values.ShootStrategy
+	}
+
+	if g.values.Profiling != nil {
+		schedulerConfig.Debugging = &componentbaseconfigv1alpha1.DebuggingConfiguration{
+			EnableProfiling:           pointer.Bool(true),
+			EnableContentionProfiling: pointer.Bool(g.values.Profiling.ContentionProfilingEnabled),
+		}
+		schedulerConfig.Server.Profiling = &schedulerv1alpha1.Server{Port: profilingPort}
+	}
+
 	data, err := runtime.Encode(schedulerCodec, schedulerConfig)
 	if err != nil {
 		return nil, err
@@ -101,3 +125,52 @@ func (g *gardenerScheduler) configMapSchedulerConfig() (*corev1.ConfigMap, error
 	utilruntime.Must(kubernetesutils.MakeUnique(configMap))
 	return configMap, nil
 }
+
+// pruneConfigMapsSchedulerConfig deletes the previous generations of the scheduler configuration ConfigMap except for
+// the most recent ones (see Values.ConfigMapHistoryLimit). Since the ConfigMaps are made unique with a hash suffix,
+// they would accumulate if they are not garbage collected by gardener-resource-manager, e.g. because its garbage
+// collector is disabled.
+func (g *gardenerScheduler) pruneConfigMapsSchedulerConfig(ctx context.Context) error {
+	current, err := g.configMapSchedulerConfig()
+	if err != nil {
+		return err
+	}
+
+	configMapList := &metav1.PartialObjectMetadataList{}
+	configMapList.SetGroupVersionKind(corev1.SchemeGroupVersion.WithKind("ConfigMapList"))
+	if err := g.client.List(ctx, configMapList, client.InNamespace(g.namespace), client.MatchingLabels(utils.MergeStringMaps(GetLabels(), map[string]string{
+		references.LabelKeyGarbageCollectable: references.LabelValueGarbageCollectable,
+	}))); err != nil {
+		return fmt.Errorf("failed listing scheduler configuration ConfigMaps: %w", err)
+	}
+
+	var previous []metav1.PartialObjectMetadata
+	for _, configMap := range configMapList.Items {
+		if configMap.Name != current.Name && strings.HasPrefix(configMap.Name, configMapSchedulerPrefix+"-") {
+			previous = append(previous, configMap)
+		}
+	}
+
+	// Sort the previous generations from the newest to the oldest one.
+	sort.Slice(previous, func(i, j int) bool {
+		if !previous[i].CreationTimestamp.Equal(&previous[j].CreationTimestamp) {
+			return previous[j].CreationTimestamp.Before(&previous[i].CreationTimestamp)
+		}
+		return previous[i].Name > previous[j].Name
+	})
+
+	limit := int(pointer.Int32Deref(g.values.ConfigMapHistoryLimit, defaultConfigMapHistoryLimit))
+	if limit < 0 {
+		limit = 0
+	}
+	if len(previous) <= limit {
+		return nil
+	}
+
+	var toDelete []client.Object
+	for _, configMap := range previous[limit:] {
+		toDelete = append(toDelete, &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: configMap.Name, Namespace: configMap.Namespace}})
+	}
+
+	return kubernetesutils.DeleteObjects(ctx, g.client, toDelete...)
+}
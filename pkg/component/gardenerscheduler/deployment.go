@@ -128,7 +128,25 @@ func (g *gardenerScheduler) deployment(secretGenericTokenKubeconfig, secretVirtu
 		},
 	}
 
-	utilruntime.Must(gardenerutils.InjectGenericKubeconfig(deployment, secretGenericTokenKubeconfig, secretVirtualGardenAccess))
+	if g.values.HardenedSecurityContext {
+		deployment.Spec.Template.Spec.SecurityContext.SeccompProfile = &corev1.SeccompProfile{
+			Type: corev1.SeccompProfileTypeRuntimeDefault,
+		}
+		deployment.Spec.Template.Spec.Containers[0].SecurityContext = &corev1.SecurityContext{
+			AllowPrivilegeEscalation: pointer.Bool(false),
+			ReadOnlyRootFilesystem:   pointer.Bool(true),
+			RunAsNonRoot:             pointer.Bool(true),
+			Capabilities: &corev1.Capabilities{
+				Drop: []corev1.Capability{"ALL"},
+			},
+		}
+	}
+
+	if g.values.RenderMode == RenderModeManifests {
+		injectKubeconfigSecret(deployment, g.values.KubeconfigSecretName)
+	} else {
+		utilruntime.Must(gardenerutils.InjectGenericKubeconfig(deployment, secretGenericTokenKubeconfig, secretVirtualGardenAccess))
+	}
 	utilruntime.Must(references.InjectAnnotations(deployment))
 
 	return deployment
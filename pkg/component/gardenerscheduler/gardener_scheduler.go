@@ -19,11 +19,13 @@ import (
 	"fmt"
 	"time"
 
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 	"github.com/gardener/gardener/pkg/component"
 	operatorclient "github.com/gardener/gardener/pkg/operator/client"
+	schedulerv1alpha1 "github.com/gardener/gardener/pkg/scheduler/apis/config/v1alpha1"
 	"github.com/gardener/gardener/pkg/utils/flow"
 	kubernetesutils "github.com/gardener/gardener/pkg/utils/kubernetes"
 	"github.com/gardener/gardener/pkg/utils/managedresources"
@@ -34,8 +36,9 @@ const (
 	// DeploymentName is the name of the deployment.
 	DeploymentName = "gardener-scheduler"
 
-	probePort   = 10251
-	metricsPort = 19251
+	probePort     = 10251
+	metricsPort   = 19251
+	profilingPort = 19253
 
 	// ManagedResourceNameRuntime is the name of the ManagedResource for the runtime resources.
 	ManagedResourceNameRuntime = "gardener-scheduler-runtime"
@@ -43,6 +46,8 @@ const (
 	ManagedResourceNameVirtual = "gardener-scheduler-virtual"
 
 	roleName = "scheduler"
+
+	defaultConfigMapHistoryLimit = 2
 )
 
 // TimeoutWaitForManagedResource is the timeout used while waiting for the ManagedResources to become healthy or
@@ -57,10 +62,68 @@ type Values struct {
 	LogLevel string
 	// FeatureGates is the set of feature gates.
 	FeatureGates map[string]bool
+	// ShootSpreadStrategy is the strategy used for spreading the shoots over the seed candidates. If empty, the default
+	// of gardener-scheduler is used.
+	ShootSpreadStrategy schedulerv1alpha1.SpreadStrategy
+	// ShootStrategy is the strategy used for determining the seed candidates for shoots. If empty, MinimalDistance is
+	// used.
+	ShootStrategy schedulerv1alpha1.CandidateDeterminationStrategy
+	// ShootCandidateWeights configures how the seed candidates are weighted before the ShootSpreadStrategy chooses the
+	// seed for a shoot. If nil, the candidates are not weighted.
+	ShootCandidateWeights *schedulerv1alpha1.CandidateWeights
+	// ShootRetryInterval is the initial interval after which shoots that could not be scheduled are retried. If nil,
+	// the default of gardener-scheduler is used.
+	ShootRetryInterval *metav1.Duration
+	// ShootMaxRetryBackoff is the maximum interval after which shoots that could not be scheduled are retried. If nil,
+	// the default of gardener-scheduler is used.
+	ShootMaxRetryBackoff *metav1.Duration
+	// HardenedSecurityContext specifies whether the gardener-scheduler container runs with a hardened security context,
+	// i.e., with a read-only root filesystem, without privilege escalation and with the RuntimeDefault seccomp profile.
+	HardenedSecurityContext bool
+	// Profiling contains the configuration for serving the profiling endpoints of gardener-scheduler. If nil, profiling
+	// is disabled.
+	Profiling *Profiling
+	// ServiceAccountName is the name of the service account in the virtual garden which is used by gardener-scheduler.
+	// If empty, it defaults to "gardener-scheduler".
+	ServiceAccountName string
+	// ServiceAccountNamespace is the namespace of the service account in the virtual garden which is used by
+	// gardener-scheduler. If empty, it defaults to "kube-system".
+	ServiceAccountNamespace string
+	// RenderMode is the mode in which the resources are rendered. If empty, it defaults to RenderModeManagedResources.
+	RenderMode RenderMode
+	// KubeconfigSecretName is the name of the secret in the runtime namespace whose `kubeconfig` key contains the
+	// kubeconfig used by gardener-scheduler to access the virtual garden. It is only used in RenderModeManifests.
+	KubeconfigSecretName string
+	// ConfigMapHistoryLimit is the number of previous generations of the scheduler configuration ConfigMap which are
+	// kept by DeployRuntime. Older generations are deleted even if they are not garbage collected by
+	// gardener-resource-manager. It should be at least 1 so that pods of the previous rollout can still be started. If
+	// nil, it defaults to 2.
+	ConfigMapHistoryLimit *int32
+}
+
+// Profiling contains the configuration for serving the profiling endpoints of gardener-scheduler on a dedicated port.
+// The port is exposed by the gardener-scheduler Service, and only pods in the runtime namespace which are labeled with
+// `networking.resources.gardener.cloud/to-gardener-scheduler-tcp-19253=allowed` are allowed to access it.
+type Profiling struct {
+	// ContentionProfilingEnabled specifies whether contention profiling (block profiles) is enabled additionally.
+	ContentionProfilingEnabled bool
+}
+
+// Interface contains functions for a gardener-scheduler deployer.
+type Interface interface {
+	component.DeployWaiter
+	// DeployRuntime deploys the resources of gardener-scheduler in the runtime cluster. It does not require the virtual
+	// garden to be reachable.
+	DeployRuntime(context.Context) error
+	// DeployVirtual deploys the resources of gardener-scheduler in the virtual garden cluster, i.e., its RBAC.
+	DeployVirtual(context.Context) error
+	// Manifests returns the resources of gardener-scheduler as plain manifests. It is only supported in
+	// RenderModeManifests.
+	Manifests() (*RenderedManifests, error)
 }
 
 // New creates a new instance of DeployWaiter for the gardener-scheduler.
-func New(client client.Client, namespace string, secretsManager secretsmanager.Interface, values Values) component.DeployWaiter {
+func New(client client.Client, namespace string, secretsManager secretsmanager.Interface, values Values) Interface {
 	return &gardenerScheduler{
 		client:         client,
 		namespace:      namespace,
@@ -77,6 +140,17 @@ type gardenerScheduler struct {
 }
 
 func (g *gardenerScheduler) Deploy(ctx context.Context) error {
+	if err := g.DeployRuntime(ctx); err != nil {
+		return err
+	}
+	return g.DeployVirtual(ctx)
+}
+
+func (g *gardenerScheduler) DeployRuntime(ctx context.Context) error {
+	if g.values.RenderMode == RenderModeManifests {
+		return ErrRenderModeManifests
+	}
+
 	var (
 		runtimeRegistry           = managedresources.NewRegistry(operatorclient.RuntimeScheme, operatorclient.RuntimeCodec, operatorclient.RuntimeSerializer)
 		virtualGardenAccessSecret = g.newVirtualGardenAccessSecret()
@@ -86,23 +160,17 @@ func (g *gardenerScheduler) Deploy(ctx context.Context) error {
 		return err
 	}
 
-	schedulerConfigConfigMap, err := g.configMapSchedulerConfig()
-	if err != nil {
-		return err
-	}
-
 	secretGenericTokenKubeconfig, found := g.secretsManager.Get(v1beta1constants.SecretNameGenericTokenKubeconfig)
 	if !found {
 		return fmt.Errorf("secret %q not found", v1beta1constants.SecretNameGenericTokenKubeconfig)
 	}
 
-	runtimeResources, err := runtimeRegistry.AddAllAndSerialize(
-		schedulerConfigConfigMap,
-		g.podDisruptionBudget(),
-		g.service(),
-		g.verticalPodAutoscaler(),
-		g.deployment(secretGenericTokenKubeconfig.Name, virtualGardenAccessSecret.Secret.Name, schedulerConfigConfigMap.Name),
-	)
+	runtimeObjects, err := g.runtimeObjects(secretGenericTokenKubeconfig.Name, virtualGardenAccessSecret.Secret.Name)
+	if err != nil {
+		return err
+	}
+
+	runtimeResources, err := runtimeRegistry.AddAllAndSerialize(runtimeObjects...)
 	if err != nil {
 		return err
 	}
@@ -111,14 +179,17 @@ func (g *gardenerScheduler) Deploy(ctx context.Context) error {
 		return err
 	}
 
-	var (
-		virtualRegistry = managedresources.NewRegistry(operatorclient.VirtualScheme, operatorclient.VirtualCodec, operatorclient.VirtualSerializer)
-	)
+	return g.pruneConfigMapsSchedulerConfig(ctx)
+}
 
-	virtualResources, err := virtualRegistry.AddAllAndSerialize(
-		g.clusterRole(),
-		g.clusterRoleBinding(virtualGardenAccessSecret.ServiceAccountName),
-	)
+func (g *gardenerScheduler) DeployVirtual(ctx context.Context) error {
+	if g.values.RenderMode == RenderModeManifests {
+		return ErrRenderModeManifests
+	}
+
+	virtualRegistry := managedresources.NewRegistry(operatorclient.VirtualScheme, operatorclient.VirtualCodec, operatorclient.VirtualSerializer)
+
+	virtualResources, err := virtualRegistry.AddAllAndSerialize(g.virtualObjects(g.newVirtualGardenAccessSecret().ServiceAccountName)...)
 	if err != nil {
 		return err
 	}
@@ -126,7 +197,42 @@ func (g *gardenerScheduler) Deploy(ctx context.Context) error {
 	return managedresources.CreateForShoot(ctx, g.client, g.namespace, ManagedResourceNameVirtual, managedresources.LabelValueGardener, false, virtualResources)
 }
 
+// runtimeObjects returns the resources of gardener-scheduler in the runtime cluster. They are the single source of truth
+// for both render modes.
+func (g *gardenerScheduler) runtimeObjects(secretGenericTokenKubeconfig, secretVirtualGardenAccess string) ([]client.Object, error) {
+	schedulerConfigConfigMap, err := g.configMapSchedulerConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	runtimeObjects := []client.Object{
+		schedulerConfigConfigMap,
+		g.podDisruptionBudget(),
+		g.service(),
+		g.verticalPodAutoscaler(),
+		g.deployment(secretGenericTokenKubeconfig, secretVirtualGardenAccess, schedulerConfigConfigMap.Name),
+	}
+
+	if g.values.Profiling != nil {
+		runtimeObjects = append(runtimeObjects, g.networkPolicyProfiling())
+	}
+
+	return runtimeObjects, nil
+}
+
+// virtualObjects returns the resources of gardener-scheduler in the virtual garden cluster.
+func (g *gardenerScheduler) virtualObjects(serviceAccountName string) []client.Object {
+	return []client.Object{
+		g.clusterRole(),
+		g.clusterRoleBinding(serviceAccountName, g.serviceAccountNamespace()),
+	}
+}
+
 func (g *gardenerScheduler) Wait(ctx context.Context) error {
+	if g.values.RenderMode == RenderModeManifests {
+		return ErrRenderModeManifests
+	}
+
 	timeoutCtx, cancel := context.WithTimeout(ctx, TimeoutWaitForManagedResource)
 	defer cancel()
 
@@ -141,6 +247,10 @@ func (g *gardenerScheduler) Wait(ctx context.Context) error {
 }
 
 func (g *gardenerScheduler) Destroy(ctx context.Context) error {
+	if g.values.RenderMode == RenderModeManifests {
+		return ErrRenderModeManifests
+	}
+
 	if err := managedresources.DeleteForShoot(ctx, g.client, g.namespace, ManagedResourceNameVirtual); err != nil {
 		return err
 	}
@@ -153,6 +263,10 @@ func (g *gardenerScheduler) Destroy(ctx context.Context) error {
 }
 
 func (g *gardenerScheduler) WaitCleanup(ctx context.Context) error {
+	if g.values.RenderMode == RenderModeManifests {
+		return ErrRenderModeManifests
+	}
+
 	timeoutCtx, cancel := context.WithTimeout(ctx, TimeoutWaitForManagedResource)
 	defer cancel()
 
@@ -17,6 +17,8 @@ package gardenerscheduler_test
 import (
 	"context"
 	"encoding/json"
+	"strings"
+	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -24,6 +26,7 @@ import (
 	autoscalingv1 "k8s.io/api/autoscaling/v1"
 	coordinationv1beta1 "k8s.io/api/coordination/v1beta1"
 	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
 	policyv1 "k8s.io/api/policy/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
@@ -39,7 +42,6 @@ import (
 
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
-	"github.com/gardener/gardener/pkg/component"
 	. "github.com/gardener/gardener/pkg/component/gardenerscheduler"
 	componenttest "github.com/gardener/gardener/pkg/component/test"
 	operatorclient "github.com/gardener/gardener/pkg/operator/client"
@@ -65,7 +67,7 @@ var _ = Describe("GardenerScheduler", func() {
 
 		fakeClient        client.Client
 		fakeSecretManager secretsmanager.Interface
-		deployer          component.DeployWaiter
+		deployer          Interface
 		values            Values
 
 		fakeOps *retryfake.Ops
@@ -383,6 +385,178 @@ var _ = Describe("GardenerScheduler", func() {
 				Expect(managedResourceSecretVirtual.Immutable).To(Equal(pointer.Bool(true)))
 				Expect(managedResourceSecretVirtual.Labels["resources.gardener.cloud/garbage-collectable-reference"]).To(Equal("true"))
 			})
+
+			Context("with profiling enabled", func() {
+				BeforeEach(func() {
+					values.Profiling = &Profiling{ContentionProfilingEnabled: true}
+				})
+
+				It("should render the profiling port, the service port and the network policy", func() {
+					Expect(deployer.Deploy(ctx)).To(Succeed())
+
+					Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(managedResourceRuntime), managedResourceRuntime)).To(Succeed())
+					managedResourceSecretRuntime.Name = managedResourceRuntime.Spec.SecretRefs[0].Name
+					Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(managedResourceSecretRuntime), managedResourceSecretRuntime)).To(Succeed())
+					Expect(managedResourceSecretRuntime.Data).To(HaveLen(6))
+
+					var configMapData []byte
+					for key, data := range managedResourceSecretRuntime.Data {
+						if strings.HasPrefix(key, "configmap__some-namespace__gardener-scheduler-config-") {
+							configMapData = data
+						}
+					}
+					Expect(string(configMapData)).To(Equal(configMap(namespace, values)))
+					Expect(string(configMapData)).To(ContainSubstring("enableProfiling: true"))
+					Expect(string(configMapData)).To(ContainSubstring("enableContentionProfiling: true"))
+
+					serviceRuntime.Spec.Ports = append(serviceRuntime.Spec.Ports, corev1.ServicePort{
+						Name:       "profiling",
+						Port:       19253,
+						Protocol:   corev1.ProtocolTCP,
+						TargetPort: intstr.FromInt32(19253),
+					})
+					Expect(string(managedResourceSecretRuntime.Data["service__some-namespace__gardener-scheduler.yaml"])).To(Equal(componenttest.Serialize(serviceRuntime)))
+
+					protocol := corev1.ProtocolTCP
+					port := intstr.FromInt32(19253)
+					Expect(string(managedResourceSecretRuntime.Data["networkpolicy__some-namespace__gardener-scheduler-profiling.yaml"])).To(Equal(componenttest.Serialize(&networkingv1.NetworkPolicy{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "gardener-scheduler-profiling",
+							Namespace: namespace,
+							Labels: map[string]string{
+								"app":  "gardener",
+								"role": "scheduler",
+							},
+						},
+						Spec: networkingv1.NetworkPolicySpec{
+							PodSelector: metav1.LabelSelector{MatchLabels: map[string]string{
+								"app":  "gardener",
+								"role": "scheduler",
+							}},
+							Ingress: []networkingv1.NetworkPolicyIngressRule{{
+								From: []networkingv1.NetworkPolicyPeer{{
+									PodSelector: &metav1.LabelSelector{MatchLabels: map[string]string{
+										"networking.resources.gardener.cloud/to-gardener-scheduler-tcp-19253": "allowed",
+									}},
+								}},
+								Ports: []networkingv1.NetworkPolicyPort{{Port: &port, Protocol: &protocol}},
+							}},
+							PolicyTypes: []networkingv1.PolicyType{networkingv1.PolicyTypeIngress},
+						},
+					})))
+				})
+			})
+
+			Context("with shoot spread strategy", func() {
+				BeforeEach(func() {
+					values.ShootSpreadStrategy = "ProjectAntiAffinity"
+				})
+
+				It("should render the spread strategy into the scheduler configuration", func() {
+					Expect(deployer.Deploy(ctx)).To(Succeed())
+
+					Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(managedResourceRuntime), managedResourceRuntime)).To(Succeed())
+					managedResourceSecretRuntime.Name = managedResourceRuntime.Spec.SecretRefs[0].Name
+					Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(managedResourceSecretRuntime), managedResourceSecretRuntime)).To(Succeed())
+
+					var configMapData []byte
+					for key, data := range managedResourceSecretRuntime.Data {
+						if strings.HasPrefix(key, "configmap__some-namespace__gardener-scheduler-config-") {
+							configMapData = data
+						}
+					}
+					Expect(string(configMapData)).To(Equal(configMap(namespace, values)))
+					Expect(string(configMapData)).To(ContainSubstring("spreadStrategy: ProjectAntiAffinity"))
+				})
+			})
+
+			Context("with shoot strategy and candidate weights", func() {
+				BeforeEach(func() {
+					values.ShootStrategy = "SameRegion"
+					values.ShootCandidateWeights = &schedulerv1alpha1.CandidateWeights{
+						RegionAffinity: map[string]int32{"europe-central-1": 10},
+						SeedCapacity:   true,
+					}
+				})
+
+				It("should render the strategy and the candidate weights into the scheduler configuration", func() {
+					Expect(deployer.Deploy(ctx)).To(Succeed())
+
+					Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(managedResourceRuntime), managedResourceRuntime)).To(Succeed())
+					managedResourceSecretRuntime.Name = managedResourceRuntime.Spec.SecretRefs[0].Name
+					Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(managedResourceSecretRuntime), managedResourceSecretRuntime)).To(Succeed())
+
+					var configMapData []byte
+					for key, data := range managedResourceSecretRuntime.Data {
+						if strings.HasPrefix(key, "configmap__some-namespace__gardener-scheduler-config-") {
+							configMapData = data
+						}
+					}
+					Expect(string(configMapData)).To(Equal(configMap(namespace, values)))
+					Expect(string(configMapData)).To(ContainSubstring("candidateDeterminationStrategy: SameRegion"))
+					Expect(string(configMapData)).To(ContainSubstring("europe-central-1: 10"))
+					Expect(string(configMapData)).To(ContainSubstring("seedCapacity: true"))
+				})
+			})
+
+			Context("with shoot retry backoff", func() {
+				BeforeEach(func() {
+					values.ShootRetryInterval = &metav1.Duration{Duration: 10 * time.Second}
+					values.ShootMaxRetryBackoff = &metav1.Duration{Duration: 10 * time.Minute}
+				})
+
+				It("should render the retry backoff into the scheduler configuration", func() {
+					Expect(deployer.Deploy(ctx)).To(Succeed())
+
+					Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(managedResourceRuntime), managedResourceRuntime)).To(Succeed())
+					managedResourceSecretRuntime.Name = managedResourceRuntime.Spec.SecretRefs[0].Name
+					Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(managedResourceSecretRuntime), managedResourceSecretRuntime)).To(Succeed())
+
+					var configMapData []byte
+					for key, data := range managedResourceSecretRuntime.Data {
+						if strings.HasPrefix(key, "configmap__some-namespace__gardener-scheduler-config-") {
+							configMapData = data
+						}
+					}
+					Expect(string(configMapData)).To(Equal(configMap(namespace, values)))
+					Expect(string(configMapData)).To(ContainSubstring("retryInterval: 10s"))
+					Expect(string(configMapData)).To(ContainSubstring("maxRetryBackoff: 10m0s"))
+				})
+			})
+
+			Context("with hardened security context", func() {
+				BeforeEach(func() {
+					values.HardenedSecurityContext = true
+				})
+
+				It("should render the deployment with the hardened security context", func() {
+					Expect(deployer.Deploy(ctx)).To(Succeed())
+
+					Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(managedResourceRuntime), managedResourceRuntime)).To(Succeed())
+					managedResourceSecretRuntime.Name = managedResourceRuntime.Spec.SecretRefs[0].Name
+					Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(managedResourceSecretRuntime), managedResourceSecretRuntime)).To(Succeed())
+					Expect(string(managedResourceSecretRuntime.Data["deployment__some-namespace__gardener-scheduler.yaml"])).To(Equal(deployment(namespace, "gardener-scheduler-config-3cf6616e", values)))
+				})
+			})
+
+			Context("with custom service account", func() {
+				BeforeEach(func() {
+					values.ServiceAccountName = "custom-scheduler"
+					values.ServiceAccountNamespace = "custom-namespace"
+				})
+
+				It("should bind the cluster role to the configured service account", func() {
+					Expect(deployer.Deploy(ctx)).To(Succeed())
+
+					Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(managedResourceVirtual), managedResourceVirtual)).To(Succeed())
+					managedResourceSecretVirtual.Name = managedResourceVirtual.Spec.SecretRefs[0].Name
+					Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(managedResourceSecretVirtual), managedResourceSecretVirtual)).To(Succeed())
+
+					clusterRoleBinding.Subjects[0].Name = "custom-scheduler"
+					clusterRoleBinding.Subjects[0].Namespace = "custom-namespace"
+					Expect(string(managedResourceSecretVirtual.Data["clusterrolebinding____gardener.cloud_system_scheduler.yaml"])).To(Equal(componenttest.Serialize(clusterRoleBinding)))
+				})
+			})
 		})
 
 		Context("secrets", func() {
@@ -414,6 +588,146 @@ var _ = Describe("GardenerScheduler", func() {
 				accessSecret.ResourceVersion = "1"
 				Expect(actualShootAccessSecret).To(Equal(accessSecret))
 			})
+
+			Context("with custom service account", func() {
+				BeforeEach(func() {
+					values.ServiceAccountName = "custom-scheduler"
+					values.ServiceAccountNamespace = "custom-namespace"
+				})
+
+				It("should request the token for the configured service account", func() {
+					Expect(deployer.Deploy(ctx)).To(Succeed())
+
+					accessSecret := &corev1.Secret{}
+					Expect(fakeClient.Get(ctx, client.ObjectKey{Name: "shoot-access-gardener-scheduler", Namespace: namespace}, accessSecret)).To(Succeed())
+					Expect(accessSecret.Annotations).To(Equal(map[string]string{
+						"serviceaccount.resources.gardener.cloud/name":      "custom-scheduler",
+						"serviceaccount.resources.gardener.cloud/namespace": "custom-namespace",
+					}))
+				})
+			})
+		})
+
+		Context("scheduler configuration ConfigMap pruning", func() {
+			var now time.Time
+
+			BeforeEach(func() {
+				now = time.Date(2023, 10, 16, 0, 0, 0, 0, time.UTC)
+			})
+
+			// deploy deploys gardener-scheduler with the current values and simulates gardener-resource-manager by creating
+			// the scheduler configuration ConfigMap contained in the runtime ManagedResource without garbage collecting the
+			// previous ones. It returns the name of the ConfigMap.
+			deploy := func() string {
+				Expect(New(fakeClient, namespace, fakeSecretManager, values).Deploy(ctx)).To(Succeed())
+
+				Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(managedResourceRuntime), managedResourceRuntime)).To(Succeed())
+				managedResourceSecretRuntime.Name = managedResourceRuntime.Spec.SecretRefs[0].Name
+				Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(managedResourceSecretRuntime), managedResourceSecretRuntime)).To(Succeed())
+
+				var name string
+				for key := range managedResourceSecretRuntime.Data {
+					if strings.HasPrefix(key, "configmap__"+namespace+"__gardener-scheduler-config-") {
+						name = strings.TrimSuffix(strings.TrimPrefix(key, "configmap__"+namespace+"__"), ".yaml")
+					}
+				}
+				Expect(name).NotTo(BeEmpty())
+
+				now = now.Add(time.Hour)
+				Expect(client.IgnoreAlreadyExists(fakeClient.Create(ctx, &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{
+					Name:              name,
+					Namespace:         namespace,
+					CreationTimestamp: metav1.Time{Time: now},
+					Labels: map[string]string{
+						"app":  "gardener",
+						"role": "scheduler",
+						"resources.gardener.cloud/garbage-collectable-reference": "true",
+					},
+				}}))).To(Succeed())
+
+				return name
+			}
+
+			expectConfigMaps := func(names ...string) {
+				GinkgoHelper()
+
+				configMapList := &corev1.ConfigMapList{}
+				Expect(fakeClient.List(ctx, configMapList, client.InNamespace(namespace))).To(Succeed())
+
+				var actual []string
+				for _, configMap := range configMapList.Items {
+					actual = append(actual, configMap.Name)
+				}
+				Expect(actual).To(ConsistOf(names))
+			}
+
+			It("should keep the previous generations up to the default limit across upgrades", func() {
+				values.LogLevel = "info"
+				configMapInfo := deploy()
+
+				values.LogLevel = "debug"
+				configMapDebug := deploy()
+
+				values.LogLevel = "error"
+				configMapError := deploy()
+				expectConfigMaps(configMapInfo, configMapDebug, configMapError)
+
+				values.ShootRetryInterval = &metav1.Duration{Duration: time.Minute}
+				configMapRetry := deploy()
+				expectConfigMaps(configMapDebug, configMapError, configMapRetry)
+
+				By("Deploy the same configuration again")
+				Expect(deploy()).To(Equal(configMapRetry))
+				expectConfigMaps(configMapDebug, configMapError, configMapRetry)
+
+				By("Roll back to a configuration whose ConfigMap was already pruned")
+				values.LogLevel = "info"
+				values.ShootRetryInterval = nil
+				Expect(deploy()).To(Equal(configMapInfo))
+				expectConfigMaps(configMapError, configMapRetry, configMapInfo)
+			})
+
+			It("should respect the configured limit and not touch other ConfigMaps", func() {
+				values.ConfigMapHistoryLimit = pointer.Int32(0)
+
+				Expect(fakeClient.Create(ctx, &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "gardener-scheduler-config-foo", Namespace: namespace, Labels: map[string]string{"app": "gardener", "role": "scheduler"}}})).To(Succeed())
+				Expect(fakeClient.Create(ctx, &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "other", Namespace: namespace, Labels: map[string]string{"app": "gardener", "role": "scheduler", "resources.gardener.cloud/garbage-collectable-reference": "true"}}})).To(Succeed())
+
+				values.LogLevel = "info"
+				deploy()
+
+				values.LogLevel = "debug"
+				configMapDebug := deploy()
+				expectConfigMaps("gardener-scheduler-config-foo", "other", configMapDebug)
+			})
+		})
+	})
+
+	Describe("#DeployRuntime", func() {
+		It("should only deploy the runtime resources", func() {
+			Expect(deployer.DeployRuntime(ctx)).To(Succeed())
+
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(managedResourceRuntime), managedResourceRuntime)).To(Succeed())
+			Expect(managedResourceRuntime.Spec.SecretRefs).To(HaveLen(1))
+			managedResourceSecretRuntime.Name = managedResourceRuntime.Spec.SecretRefs[0].Name
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(managedResourceSecretRuntime), managedResourceSecretRuntime)).To(Succeed())
+
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(managedResourceVirtual), managedResourceVirtual)).To(BeNotFoundError())
+			Expect(managedResourceSecretNames(ctx, fakeClient, namespace)).To(ConsistOf(managedResourceSecretRuntime.Name))
+		})
+	})
+
+	Describe("#DeployVirtual", func() {
+		It("should only deploy the virtual resources", func() {
+			Expect(deployer.DeployVirtual(ctx)).To(Succeed())
+
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(managedResourceVirtual), managedResourceVirtual)).To(Succeed())
+			Expect(managedResourceVirtual.Spec.SecretRefs).To(HaveLen(1))
+			managedResourceSecretVirtual.Name = managedResourceVirtual.Spec.SecretRefs[0].Name
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(managedResourceSecretVirtual), managedResourceSecretVirtual)).To(Succeed())
+
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(managedResourceRuntime), managedResourceRuntime)).To(BeNotFoundError())
+			Expect(managedResourceSecretNames(ctx, fakeClient, namespace)).To(ConsistOf(managedResourceSecretVirtual.Name))
 		})
 	})
 
@@ -438,6 +752,82 @@ var _ = Describe("GardenerScheduler", func() {
 		})
 	})
 
+	Describe("#Manifests", func() {
+		BeforeEach(func() {
+			values = Values{
+				LogLevel:             "info",
+				RenderMode:           RenderModeManifests,
+				KubeconfigSecretName: "gardener-scheduler-kubeconfig",
+			}
+		})
+
+		It("should render the resources as plain manifests", func() {
+			manifests, err := deployer.Manifests()
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(manifests.Runtime).To(HaveLen(5))
+			Expect(string(manifests.Runtime["configmap__some-namespace__gardener-scheduler-config-3cf6616e.yaml"])).To(Equal(configMap(namespace, values)))
+			Expect(string(manifests.Runtime["poddisruptionbudget__some-namespace__gardener-scheduler.yaml"])).To(Equal(componenttest.Serialize(podDisruptionBudget)))
+			Expect(string(manifests.Runtime["service__some-namespace__gardener-scheduler.yaml"])).To(Equal(componenttest.Serialize(serviceRuntime)))
+			Expect(string(manifests.Runtime["verticalpodautoscaler__some-namespace__gardener-scheduler-vpa.yaml"])).To(Equal(componenttest.Serialize(vpa)))
+
+			deployment := &appsv1.Deployment{}
+			_, _, err = operatorclient.RuntimeCodec.UniversalDecoder().Decode(manifests.Runtime["deployment__some-namespace__gardener-scheduler.yaml"], nil, deployment)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(deployment.Spec.Template.Spec.Volumes).To(ContainElement(corev1.Volume{
+				Name: "kubeconfig",
+				VolumeSource: corev1.VolumeSource{
+					Secret: &corev1.SecretVolumeSource{
+						SecretName:  "gardener-scheduler-kubeconfig",
+						Items:       []corev1.KeyToPath{{Key: "kubeconfig", Path: "kubeconfig"}},
+						DefaultMode: pointer.Int32(420),
+					},
+				},
+			}))
+			Expect(deployment.Spec.Template.Spec.Containers[0].VolumeMounts).To(ContainElement(corev1.VolumeMount{
+				Name:      "kubeconfig",
+				MountPath: "/var/run/secrets/gardener.cloud/shoot/generic-kubeconfig",
+				ReadOnly:  true,
+			}))
+			Expect(deployment.Annotations).To(HaveKey(references.AnnotationKey(references.KindSecret, "gardener-scheduler-kubeconfig")))
+
+			Expect(manifests.Virtual).To(HaveLen(3))
+			Expect(string(manifests.Virtual["clusterrole____gardener.cloud_system_scheduler.yaml"])).To(Equal(componenttest.Serialize(clusterRole)))
+			Expect(string(manifests.Virtual["clusterrolebinding____gardener.cloud_system_scheduler.yaml"])).To(Equal(componenttest.Serialize(clusterRoleBinding)))
+			Expect(string(manifests.Virtual["serviceaccount__kube-system__gardener-scheduler.yaml"])).To(ContainSubstring("automountServiceAccountToken: false"))
+
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(managedResourceRuntime), managedResourceRuntime)).To(BeNotFoundError())
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(managedResourceVirtual), managedResourceVirtual)).To(BeNotFoundError())
+		})
+
+		It("should fail if the kubeconfig secret name is not set", func() {
+			values.KubeconfigSecretName = ""
+			deployer = New(fakeClient, namespace, fakeSecretManager, values)
+
+			_, err := deployer.Manifests()
+			Expect(err).To(MatchError(ContainSubstring("kubeconfig secret name must be set")))
+		})
+
+		It("should fail if the resources are rendered as ManagedResources", func() {
+			values.RenderMode = ""
+			deployer = New(fakeClient, namespace, fakeSecretManager, values)
+
+			_, err := deployer.Manifests()
+			Expect(err).To(MatchError(ContainSubstring("manifests can only be rendered in render mode")))
+		})
+
+		It("should not deploy, destroy or wait for any resources", func() {
+			Expect(deployer.Deploy(ctx)).To(MatchError(ErrRenderModeManifests))
+			Expect(deployer.DeployRuntime(ctx)).To(MatchError(ErrRenderModeManifests))
+			Expect(deployer.DeployVirtual(ctx)).To(MatchError(ErrRenderModeManifests))
+			Expect(deployer.Wait(ctx)).To(MatchError(ErrRenderModeManifests))
+			Expect(deployer.Destroy(ctx)).To(MatchError(ErrRenderModeManifests))
+			Expect(deployer.WaitCleanup(ctx)).To(MatchError(ErrRenderModeManifests))
+
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(managedResourceRuntime), managedResourceRuntime)).To(BeNotFoundError())
+		})
+	})
+
 	Context("waiting functions", func() {
 		Describe("#Wait", func() {
 			It("should fail because reading the runtime ManagedResource fails", func() {
@@ -728,12 +1118,28 @@ func configMap(namespace string, testValues Values) string {
 		},
 		Schedulers: schedulerv1alpha1.SchedulerControllerConfiguration{
 			Shoot: &schedulerv1alpha1.ShootSchedulerConfiguration{
-				Strategy: "MinimalDistance",
+				Strategy:         "MinimalDistance",
+				SpreadStrategy:   testValues.ShootSpreadStrategy,
+				CandidateWeights: testValues.ShootCandidateWeights,
+				RetryInterval:    testValues.ShootRetryInterval,
+				MaxRetryBackoff:  testValues.ShootMaxRetryBackoff,
 			},
 		},
 		FeatureGates: testValues.FeatureGates,
 	}
 
+	if testValues.ShootStrategy != "" {
+		schedulerConfig.Schedulers.Shoot.Strategy = testValues.ShootStrategy
+	}
+
+	if testValues.Profiling != nil {
+		schedulerConfig.Debugging = &componentbaseconfigv1alpha1.DebuggingConfiguration{
+			EnableProfiling:           pointer.Bool(true),
+			EnableContentionProfiling: pointer.Bool(testValues.Profiling.ContentionProfilingEnabled),
+		}
+		schedulerConfig.Server.Profiling = &schedulerv1alpha1.Server{Port: 19253}
+	}
+
 	data, err := json.Marshal(schedulerConfig)
 	utilruntime.Must(err)
 	data, err = yaml.JSONToYAML(data)
@@ -894,7 +1300,34 @@ func deployment(namespace, configSecretName string, testValues Values) string {
 		},
 	}
 
+	if testValues.HardenedSecurityContext {
+		deployment.Spec.Template.Spec.SecurityContext.SeccompProfile = &corev1.SeccompProfile{
+			Type: corev1.SeccompProfileTypeRuntimeDefault,
+		}
+		deployment.Spec.Template.Spec.Containers[0].SecurityContext = &corev1.SecurityContext{
+			AllowPrivilegeEscalation: pointer.Bool(false),
+			ReadOnlyRootFilesystem:   pointer.Bool(true),
+			RunAsNonRoot:             pointer.Bool(true),
+			Capabilities: &corev1.Capabilities{
+				Drop: []corev1.Capability{"ALL"},
+			},
+		}
+	}
+
 	utilruntime.Must(references.InjectAnnotations(deployment))
 
 	return componenttest.Serialize(deployment)
 }
+
+func managedResourceSecretNames(ctx context.Context, c client.Client, namespace string) []string {
+	secretList := &corev1.SecretList{}
+	ExpectWithOffset(1, c.List(ctx, secretList, client.InNamespace(namespace))).To(Succeed())
+
+	var names []string
+	for _, secret := range secretList.Items {
+		if strings.HasPrefix(secret.Name, "managedresource-") {
+			names = append(names, secret.Name)
+		}
+	}
+	return names
+}
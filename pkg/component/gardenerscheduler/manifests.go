@@ -0,0 +1,121 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gardenerscheduler
+
+import (
+	"errors"
+	"fmt"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	operatorclient "github.com/gardener/gardener/pkg/operator/client"
+	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
+	"github.com/gardener/gardener/pkg/utils/managedresources"
+	"github.com/gardener/gardener/pkg/utils/secrets"
+)
+
+// RenderMode is the mode in which the resources of gardener-scheduler are rendered.
+type RenderMode string
+
+const (
+	// RenderModeManagedResources wraps the resources into ManagedResources which are reconciled by
+	// gardener-resource-manager. The access to the virtual garden is managed via a token requested for the access
+	// secret. This is the default mode.
+	RenderModeManagedResources RenderMode = "ManagedResources"
+	// RenderModeManifests renders the resources as plain manifests which can be applied directly, e.g., for standalone
+	// installations which run neither gardener-operator nor gardener-resource-manager. The kubeconfig for the virtual
+	// garden must be provided via the secret referenced in Values.KubeconfigSecretName.
+	RenderModeManifests RenderMode = "Manifests"
+
+	volumeNameKubeconfig = "kubeconfig"
+)
+
+// ErrRenderModeManifests is returned by the functions of the DeployWaiter if the resources are rendered as plain
+// manifests.
+var ErrRenderModeManifests = errors.New("gardener-scheduler is rendered as plain manifests, use Manifests instead")
+
+// RenderedManifests contains the plain manifests of gardener-scheduler. The keys of the maps are file names in the
+// format `<kind>__<namespace>__<name>.yaml`, the values are the serialized objects.
+type RenderedManifests struct {
+	// Runtime are the manifests which must be applied to the runtime cluster.
+	Runtime map[string][]byte
+	// Virtual are the manifests which must be applied to the virtual garden cluster.
+	Virtual map[string][]byte
+}
+
+func (g *gardenerScheduler) Manifests() (*RenderedManifests, error) {
+	if g.values.RenderMode != RenderModeManifests {
+		return nil, fmt.Errorf("manifests can only be rendered in render mode %q", RenderModeManifests)
+	}
+	if g.values.KubeconfigSecretName == "" {
+		return nil, fmt.Errorf("kubeconfig secret name must be set in render mode %q", RenderModeManifests)
+	}
+
+	runtimeObjects, err := g.runtimeObjects("", "")
+	if err != nil {
+		return nil, err
+	}
+
+	runtimeManifests, err := managedresources.NewRegistry(operatorclient.RuntimeScheme, operatorclient.RuntimeCodec, operatorclient.RuntimeSerializer).AddAllAndSerialize(runtimeObjects...)
+	if err != nil {
+		return nil, err
+	}
+
+	// Without gardener-resource-manager, the service account is not created by the token requestor, hence it is part of
+	// the manifests.
+	serviceAccountName := g.newVirtualGardenAccessSecret().ServiceAccountName
+	virtualObjects := append([]client.Object{&corev1.ServiceAccount{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      serviceAccountName,
+			Namespace: g.serviceAccountNamespace(),
+			Labels:    GetLabels(),
+		},
+		AutomountServiceAccountToken: pointer.Bool(false),
+	}}, g.virtualObjects(serviceAccountName)...)
+
+	virtualManifests, err := managedresources.NewRegistry(operatorclient.VirtualScheme, operatorclient.VirtualCodec, operatorclient.VirtualSerializer).AddAllAndSerialize(virtualObjects...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &RenderedManifests{Runtime: runtimeManifests, Virtual: virtualManifests}, nil
+}
+
+// injectKubeconfigSecret mounts the given kubeconfig secret to the same path as the generic kubeconfig, so that the
+// scheduler configuration is identical in both render modes.
+func injectKubeconfigSecret(deployment *appsv1.Deployment, secretName string) {
+	deployment.Spec.Template.Spec.Volumes = append(deployment.Spec.Template.Spec.Volumes, corev1.Volume{
+		Name: volumeNameKubeconfig,
+		VolumeSource: corev1.VolumeSource{
+			Secret: &corev1.SecretVolumeSource{
+				SecretName: secretName,
+				Items: []corev1.KeyToPath{{
+					Key:  secrets.DataKeyKubeconfig,
+					Path: secrets.DataKeyKubeconfig,
+				}},
+				DefaultMode: pointer.Int32(420),
+			},
+		},
+	})
+	deployment.Spec.Template.Spec.Containers[0].VolumeMounts = append(deployment.Spec.Template.Spec.Containers[0].VolumeMounts, corev1.VolumeMount{
+		Name:      volumeNameKubeconfig,
+		MountPath: gardenerutils.VolumeMountPathGenericKubeconfig,
+		ReadOnly:  true,
+	})
+}
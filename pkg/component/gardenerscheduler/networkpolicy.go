@@ -0,0 +1,55 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gardenerscheduler
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	"github.com/gardener/gardener/pkg/utils"
+	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
+)
+
+const networkPolicyNameProfiling = "gardener-scheduler-profiling"
+
+// networkPolicyProfiling allows the access to the profiling port for pods in the runtime namespace which are labeled
+// with the network policy label of the profiling port of the gardener-scheduler Service. Other pods must not access the
+// profiling endpoints since profiles can reveal sensitive data.
+func (g *gardenerScheduler) networkPolicyProfiling() *networkingv1.NetworkPolicy {
+	return &networkingv1.NetworkPolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      networkPolicyNameProfiling,
+			Namespace: g.namespace,
+			Labels:    GetLabels(),
+		},
+		Spec: networkingv1.NetworkPolicySpec{
+			PodSelector: metav1.LabelSelector{MatchLabels: GetLabels()},
+			Ingress: []networkingv1.NetworkPolicyIngressRule{{
+				From: []networkingv1.NetworkPolicyPeer{{
+					PodSelector: &metav1.LabelSelector{MatchLabels: map[string]string{
+						gardenerutils.NetworkPolicyLabel(serviceName, profilingPort): v1beta1constants.LabelNetworkPolicyAllowed,
+					}},
+				}},
+				Ports: []networkingv1.NetworkPolicyPort{{
+					Port:     utils.IntStrPtrFromInt32(profilingPort),
+					Protocol: utils.ProtocolPtr(corev1.ProtocolTCP),
+				}},
+			}},
+			PolicyTypes: []networkingv1.PolicyType{networkingv1.PolicyTypeIngress},
+		},
+	}
+}
@@ -89,7 +89,7 @@ func (g *gardenerScheduler) clusterRole() *rbacv1.ClusterRole {
 	}
 }
 
-func (g *gardenerScheduler) clusterRoleBinding(serviceAccountName string) *rbacv1.ClusterRoleBinding {
+func (g *gardenerScheduler) clusterRoleBinding(serviceAccountName, serviceAccountNamespace string) *rbacv1.ClusterRoleBinding {
 	return &rbacv1.ClusterRoleBinding{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:   clusterRoleBindingName,
@@ -103,7 +103,7 @@ func (g *gardenerScheduler) clusterRoleBinding(serviceAccountName string) *rbacv
 		Subjects: []rbacv1.Subject{{
 			Kind:      "ServiceAccount",
 			Name:      serviceAccountName,
-			Namespace: metav1.NamespaceSystem,
+			Namespace: serviceAccountNamespace,
 		}},
 	}
 }
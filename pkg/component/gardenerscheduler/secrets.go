@@ -15,9 +15,25 @@
 package gardenerscheduler
 
 import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
 	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
 )
 
 func (g *gardenerScheduler) newVirtualGardenAccessSecret() *gardenerutils.AccessSecret {
-	return gardenerutils.NewShootAccessSecret(DeploymentName, g.namespace)
+	accessSecret := gardenerutils.NewShootAccessSecret(DeploymentName, g.namespace).
+		WithServiceAccountNamespace(g.serviceAccountNamespace())
+
+	if g.values.ServiceAccountName != "" {
+		accessSecret.WithServiceAccountName(g.values.ServiceAccountName)
+	}
+
+	return accessSecret
+}
+
+func (g *gardenerScheduler) serviceAccountNamespace() string {
+	if g.values.ServiceAccountNamespace != "" {
+		return g.values.ServiceAccountNamespace
+	}
+	return metav1.NamespaceSystem
 }
@@ -41,5 +41,14 @@ func (g *gardenerScheduler) service() *corev1.Service {
 		},
 	}
 
+	if g.values.Profiling != nil {
+		service.Spec.Ports = append(service.Spec.Ports, corev1.ServicePort{
+			Name:       "profiling",
+			Port:       int32(profilingPort),
+			Protocol:   corev1.ProtocolTCP,
+			TargetPort: intstr.FromInt32(profilingPort),
+		})
+	}
+
 	return service
 }
@@ -52,23 +52,10 @@ func NewDependencyWatchdogWeederConfiguration() (map[string]weederapi.DependantS
 }
 
 // NewDependencyWatchdogProberConfiguration returns the configuration for the dependency watchdog (probe role)
-// ensuring that its dependant pods are scaled as soon a prober fails.
+// ensuring that its dependant pods are scaled as soon a prober fails. The configuration for kube-controller-manager is
+// rendered by its component, see kubecontrollermanager.NewDependencyWatchdogProberConfiguration.
 func NewDependencyWatchdogProberConfiguration() ([]proberapi.DependentResourceInfo, error) {
 	return []proberapi.DependentResourceInfo{
-		{
-			Ref: &autoscalingv1.CrossVersionObjectReference{
-				Kind:       "Deployment",
-				Name:       v1beta1constants.DeploymentNameKubeControllerManager,
-				APIVersion: appsv1.SchemeGroupVersion.String(),
-			},
-			Optional: false,
-			ScaleUpInfo: &proberapi.ScaleInfo{
-				Level: 0,
-			},
-			ScaleDownInfo: &proberapi.ScaleInfo{
-				Level: 1,
-			},
-		},
 		{
 			Ref: &autoscalingv1.CrossVersionObjectReference{
 				Kind:       "Deployment",
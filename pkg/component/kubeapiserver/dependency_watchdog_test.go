@@ -60,20 +60,6 @@ var _ = Describe("DependencyWatchdog", func() {
 		It("should compute the correct configuration", func() {
 			config, err := NewDependencyWatchdogProberConfiguration()
 			Expect(config).To(ConsistOf([]proberapi.DependentResourceInfo{
-				{
-					Ref: &autoscalingv1.CrossVersionObjectReference{
-						Kind:       "Deployment",
-						Name:       v1beta1constants.DeploymentNameKubeControllerManager,
-						APIVersion: "apps/v1",
-					},
-					Optional: false,
-					ScaleUpInfo: &proberapi.ScaleInfo{
-						Level: 0,
-					},
-					ScaleDownInfo: &proberapi.ScaleInfo{
-						Level: 1,
-					},
-				},
 				{
 					Ref: &autoscalingv1.CrossVersionObjectReference{
 						Kind:       "Deployment",
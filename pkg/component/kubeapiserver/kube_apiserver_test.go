@@ -338,6 +338,22 @@ var _ = Describe("KubeAPIServer", func() {
 						},
 					}))
 				})
+
+				It("should use the configured update mode", func() {
+					updateMode := vpaautoscalingv1.UpdateModeAuto
+					autoscalingConfig.VPAUpdateMode = &updateMode
+					kapi = New(kubernetesInterface, namespace, sm, Values{
+						Values: apiserver.Values{
+							Autoscaling:    autoscalingConfig,
+							RuntimeVersion: runtimeVersion,
+						},
+						Version: version,
+					})
+
+					Expect(kapi.Deploy(ctx)).To(Succeed())
+					Expect(c.Get(ctx, client.ObjectKeyFromObject(verticalPodAutoscaler), verticalPodAutoscaler)).To(Succeed())
+					Expect(verticalPodAutoscaler.Spec.UpdatePolicy.UpdateMode).To(PointTo(Equal(vpaautoscalingv1.UpdateModeAuto)))
+				})
 			})
 		})
 
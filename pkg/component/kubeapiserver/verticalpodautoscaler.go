@@ -37,6 +37,9 @@ func (k *kubeAPIServer) reconcileVerticalPodAutoscaler(ctx context.Context, vert
 	}
 
 	vpaUpdateMode := vpaautoscalingv1.UpdateModeOff
+	if k.values.Autoscaling.VPAUpdateMode != nil {
+		vpaUpdateMode = *k.values.Autoscaling.VPAUpdateMode
+	}
 	controlledValues := vpaautoscalingv1.ContainerControlledValuesRequestsOnly
 
 	_, err := controllerutils.GetAndCreateOrMergePatch(ctx, k.client.Client(), verticalPodAutoscaler, func() error {
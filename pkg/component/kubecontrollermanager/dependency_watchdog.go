@@ -0,0 +1,50 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kubecontrollermanager
+
+import (
+	proberapi "github.com/gardener/dependency-watchdog/api/prober"
+	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv1 "k8s.io/api/autoscaling/v1"
+
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+)
+
+// AnnotationKeyDependencyWatchdogIgnoreScaling is the key of an annotation which makes the dependency-watchdog prober
+// ignore the annotated deployment, i.e., it is neither scaled down nor up when the kube-apiserver is not reachable.
+const AnnotationKeyDependencyWatchdogIgnoreScaling = "dependency-watchdog.gardener.cloud/ignore-scaling"
+
+// NewDependencyWatchdogProberConfiguration returns the configuration for the dependency watchdog (probe role)
+// ensuring that kube-controller-manager is scaled down as soon as the kube-apiserver cannot be reached via its external
+// endpoint (e.g. because of a load balancer outage), so that it does not mark the nodes as not ready. Scaling can be
+// disabled per shoot, see Values.DependencyWatchdogScalingDisabled.
+func NewDependencyWatchdogProberConfiguration() ([]proberapi.DependentResourceInfo, error) {
+	return []proberapi.DependentResourceInfo{
+		{
+			Ref: &autoscalingv1.CrossVersionObjectReference{
+				Kind:       "Deployment",
+				Name:       v1beta1constants.DeploymentNameKubeControllerManager,
+				APIVersion: appsv1.SchemeGroupVersion.String(),
+			},
+			Optional: false,
+			ScaleUpInfo: &proberapi.ScaleInfo{
+				Level: 0,
+			},
+			ScaleDownInfo: &proberapi.ScaleInfo{
+				Level: 1,
+			},
+		},
+	}, nil
+}
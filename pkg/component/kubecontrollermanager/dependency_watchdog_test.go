@@ -0,0 +1,47 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kubecontrollermanager_test
+
+import (
+	proberapi "github.com/gardener/dependency-watchdog/api/prober"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	autoscalingv1 "k8s.io/api/autoscaling/v1"
+
+	. "github.com/gardener/gardener/pkg/component/kubecontrollermanager"
+)
+
+var _ = Describe("DependencyWatchdog", func() {
+	Describe("#NewDependencyWatchdogProberConfiguration", func() {
+		It("should compute the correct configuration", func() {
+			config, err := NewDependencyWatchdogProberConfiguration()
+			Expect(config).To(ConsistOf(proberapi.DependentResourceInfo{
+				Ref: &autoscalingv1.CrossVersionObjectReference{
+					Kind:       "Deployment",
+					Name:       "kube-controller-manager",
+					APIVersion: "apps/v1",
+				},
+				Optional: false,
+				ScaleUpInfo: &proberapi.ScaleInfo{
+					Level: 0,
+				},
+				ScaleDownInfo: &proberapi.ScaleInfo{
+					Level: 1,
+				},
+			}))
+			Expect(err).NotTo(HaveOccurred())
+		})
+	})
+})
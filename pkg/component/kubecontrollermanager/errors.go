@@ -0,0 +1,83 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kubecontrollermanager
+
+import (
+	"regexp"
+	"slices"
+
+	corev1 "k8s.io/api/core/v1"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+)
+
+const (
+	reasonErrImagePull     = "ErrImagePull"
+	reasonImagePullBackOff = "ImagePullBackOff"
+	reasonInvalidImageName = "InvalidImageName"
+)
+
+// badFlagsRegex is used to check if the termination message of a kube-controller-manager container indicates that it
+// was started with unknown or invalid flags.
+var badFlagsRegex = regexp.MustCompile(`(?i)(unknown flag|unknown shorthand flag|flag provided but not defined|invalid argument .* for .* flag|unrecognized feature gate|unknown controller|is not in the list of known controllers)`)
+
+// ErrorCodesForContainerStatus returns the gardener error codes describing the failure of the given kube-controller-manager
+// container status. Invalid image names and unknown or invalid flags (detected from the termination message) are
+// reported as configuration problems while image pull errors are reported as retryable configuration problems since
+// they might be caused by temporary registry issues.
+func ErrorCodesForContainerStatus(status corev1.ContainerStatus) []gardencorev1beta1.ErrorCode {
+	var codes []gardencorev1beta1.ErrorCode
+
+	if waiting := status.State.Waiting; waiting != nil {
+		switch waiting.Reason {
+		case reasonInvalidImageName:
+			codes = append(codes, gardencorev1beta1.ErrorConfigurationProblem)
+		case reasonErrImagePull, reasonImagePullBackOff:
+			codes = append(codes, gardencorev1beta1.ErrorRetryableConfigurationProblem)
+		}
+	}
+
+	for _, state := range []corev1.ContainerState{status.State, status.LastTerminationState} {
+		if state.Terminated != nil && badFlagsRegex.MatchString(state.Terminated.Message) {
+			codes = append(codes, gardencorev1beta1.ErrorConfigurationProblem)
+			break
+		}
+	}
+
+	return codes
+}
+
+// DetermineErrorCodes returns the (de-duplicated) gardener error codes describing the failures of the containers of the
+// given kube-controller-manager pods.
+func DetermineErrorCodes(pods []corev1.Pod) []gardencorev1beta1.ErrorCode {
+	var codes []gardencorev1beta1.ErrorCode
+
+	for _, pod := range pods {
+		for _, containerStatus := range pod.Status.ContainerStatuses {
+			codes = appendErrorCodes(codes, ErrorCodesForContainerStatus(containerStatus)...)
+		}
+	}
+
+	return codes
+}
+
+func appendErrorCodes(codes []gardencorev1beta1.ErrorCode, newCodes ...gardencorev1beta1.ErrorCode) []gardencorev1beta1.ErrorCode {
+	for _, code := range newCodes {
+		if !slices.Contains(codes, code) {
+			codes = append(codes, code)
+		}
+	}
+	return codes
+}
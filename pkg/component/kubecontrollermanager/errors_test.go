@@ -0,0 +1,72 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kubecontrollermanager_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/types"
+	corev1 "k8s.io/api/core/v1"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	. "github.com/gardener/gardener/pkg/component/kubecontrollermanager"
+)
+
+var _ = Describe("Errors", func() {
+	waiting := func(reason string) corev1.ContainerState {
+		return corev1.ContainerState{Waiting: &corev1.ContainerStateWaiting{Reason: reason}}
+	}
+	terminated := func(message string) corev1.ContainerState {
+		return corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{ExitCode: 1, Message: message}}
+	}
+
+	DescribeTable("#ErrorCodesForContainerStatus",
+		func(status corev1.ContainerStatus, matcher types.GomegaMatcher) {
+			Expect(ErrorCodesForContainerStatus(status)).To(matcher)
+		},
+
+		Entry("running container", corev1.ContainerStatus{State: corev1.ContainerState{Running: &corev1.ContainerStateRunning{}}}, BeEmpty()),
+		Entry("invalid image name", corev1.ContainerStatus{State: waiting("InvalidImageName")}, ConsistOf(gardencorev1beta1.ErrorConfigurationProblem)),
+		Entry("image pull error", corev1.ContainerStatus{State: waiting("ErrImagePull")}, ConsistOf(gardencorev1beta1.ErrorRetryableConfigurationProblem)),
+		Entry("image pull back-off", corev1.ContainerStatus{State: waiting("ImagePullBackOff")}, ConsistOf(gardencorev1beta1.ErrorRetryableConfigurationProblem)),
+		Entry("crash-looping because of unknown flag", corev1.ContainerStatus{State: waiting("CrashLoopBackOff"), LastTerminationState: terminated("Error: unknown flag: --foo")}, ConsistOf(gardencorev1beta1.ErrorConfigurationProblem)),
+		Entry("crash-looping because of invalid flag value", corev1.ContainerStatus{State: waiting("CrashLoopBackOff"), LastTerminationState: terminated(`invalid argument "foo" for "--node-monitor-grace-period" flag: time: invalid duration "foo"`)}, ConsistOf(gardencorev1beta1.ErrorConfigurationProblem)),
+		Entry("terminated because of unrecognized feature gate", corev1.ContainerStatus{State: terminated("unrecognized feature gate: Foo")}, ConsistOf(gardencorev1beta1.ErrorConfigurationProblem)),
+		Entry("crash-looping because of other reasons", corev1.ContainerStatus{State: waiting("CrashLoopBackOff"), LastTerminationState: terminated("failed to connect to kube-apiserver")}, BeEmpty()),
+	)
+
+	Describe("#DetermineErrorCodes", func() {
+		It("should return the de-duplicated error codes of all containers", func() {
+			pods := []corev1.Pod{
+				{Status: corev1.PodStatus{ContainerStatuses: []corev1.ContainerStatus{
+					{State: waiting("InvalidImageName")},
+					{State: waiting("ImagePullBackOff")},
+				}}},
+				{Status: corev1.PodStatus{ContainerStatuses: []corev1.ContainerStatus{
+					{State: waiting("CrashLoopBackOff"), LastTerminationState: terminated("flag provided but not defined: -foo")},
+				}}},
+			}
+
+			Expect(DetermineErrorCodes(pods)).To(Equal([]gardencorev1beta1.ErrorCode{
+				gardencorev1beta1.ErrorConfigurationProblem,
+				gardencorev1beta1.ErrorRetryableConfigurationProblem,
+			}))
+		})
+
+		It("should return nil if no container failed", func() {
+			Expect(DetermineErrorCodes([]corev1.Pod{{}})).To(BeNil())
+		})
+	})
+})
@@ -0,0 +1,152 @@
+// Copyright 2020 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kubecontrollermanager
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/Masterminds/semver/v3"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+// flagChanges describes the flags rendered by the command builder which were added or removed in a Kubernetes minor
+// version.
+type flagChanges struct {
+	added   []string
+	removed []string
+}
+
+// flagChangesPerMinor contains the changes of the supported kube-controller-manager flags per Kubernetes minor version.
+// The first entry contains all flags supported by the oldest version. Every Kubernetes minor version which shall be
+// supported must have an entry (even if it is empty), i.e., the flags used by the command builder must be reviewed
+// whenever a new Kubernetes version is added.
+// When reviewing a new version, check whether kube-controller-manager has gained a `--config` flag. In this case, the
+// settings can be rendered into a configuration file for this and later versions, while older versions keep using
+// flags.
+var flagChangesPerMinor = []struct {
+	minor   string
+	changes flagChanges
+}{
+	{"1.24", flagChanges{added: []string{
+		"allocate-node-cidrs",
+		"attach-detach-reconcile-sync-period",
+		"authentication-kubeconfig",
+		"authorization-always-allow-paths",
+		"authorization-kubeconfig",
+		"cluster-cidr",
+		"cluster-name",
+		"cluster-signing-duration",
+		"cluster-signing-kube-apiserver-client-cert-file",
+		"cluster-signing-kube-apiserver-client-key-file",
+		"cluster-signing-kubelet-client-cert-file",
+		"cluster-signing-kubelet-client-key-file",
+		"cluster-signing-kubelet-serving-cert-file",
+		"cluster-signing-kubelet-serving-key-file",
+		"cluster-signing-legacy-unknown-cert-file",
+		"cluster-signing-legacy-unknown-key-file",
+		"concurrent-deployment-syncs",
+		"concurrent-endpoint-syncs",
+		"concurrent-gc-syncs",
+		"concurrent-namespace-syncs",
+		"concurrent-replicaset-syncs",
+		"concurrent-resource-quota-syncs",
+		"concurrent-service-endpoint-syncs",
+		"concurrent-serviceaccount-token-syncs",
+		"concurrent-statefulset-syncs",
+		"controllers",
+		"feature-gates",
+		"horizontal-pod-autoscaler-cpu-initialization-period",
+		"horizontal-pod-autoscaler-downscale-stabilization",
+		"horizontal-pod-autoscaler-initial-readiness-delay",
+		"horizontal-pod-autoscaler-sync-period",
+		"horizontal-pod-autoscaler-tolerance",
+		"kube-api-burst",
+		"kube-api-qps",
+		"kubeconfig",
+		"leader-elect",
+		"leader-elect-lease-duration",
+		"leader-elect-renew-deadline",
+		"leader-elect-resource-lock",
+		"leader-elect-retry-period",
+		"node-cidr-mask-size",
+		"node-cidr-mask-size-ipv4",
+		"node-cidr-mask-size-ipv6",
+		"node-monitor-grace-period",
+		"pod-eviction-timeout",
+		"profiling",
+		"requestheader-allowed-names",
+		"requestheader-client-ca-file",
+		"requestheader-extra-headers-prefix",
+		"requestheader-group-headers",
+		"requestheader-username-headers",
+		"resource-quota-sync-period",
+		"root-ca-file",
+		"secure-port",
+		"service-account-private-key-file",
+		"service-cluster-ip-range",
+		"tls-cert-file",
+		"tls-cipher-suites",
+		"tls-private-key-file",
+		"use-service-account-credentials",
+		"v",
+	}}},
+	{"1.25", flagChanges{}},
+	{"1.26", flagChanges{}},
+	{"1.27", flagChanges{removed: []string{"pod-eviction-timeout"}}},
+	{"1.28", flagChanges{}},
+}
+
+// SupportedFlagsMatrix maps Kubernetes minor versions to the set of kube-controller-manager flags which are supported
+// by the command builder for this version. It is generated from the flag changes per minor version.
+var SupportedFlagsMatrix = generateSupportedFlagsMatrix()
+
+func generateSupportedFlagsMatrix() map[string]sets.Set[string] {
+	var (
+		matrix = make(map[string]sets.Set[string], len(flagChangesPerMinor))
+		flags  = sets.New[string]()
+	)
+
+	for _, entry := range flagChangesPerMinor {
+		flags = flags.Clone().Insert(entry.changes.added...).Delete(entry.changes.removed...)
+		matrix[entry.minor] = flags
+	}
+
+	return matrix
+}
+
+// ValidateFlags checks that the given command only contains flags which are supported by kube-controller-manager in
+// the given version.
+func ValidateFlags(command []string, version *semver.Version) error {
+	minor := fmt.Sprintf("%d.%d", version.Major(), version.Minor())
+
+	supportedFlags, ok := SupportedFlagsMatrix[minor]
+	if !ok {
+		return fmt.Errorf("kube-controller-manager flag compatibility matrix does not cover Kubernetes version %s", minor)
+	}
+
+	for _, arg := range command {
+		if !strings.HasPrefix(arg, "--") {
+			continue
+		}
+
+		flag, _, _ := strings.Cut(strings.TrimPrefix(arg, "--"), "=")
+		if !supportedFlags.Has(flag) {
+			return fmt.Errorf("flag %q is not supported by kube-controller-manager for Kubernetes version %s", flag, minor)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,60 @@
+// Copyright 2020 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kubecontrollermanager_test
+
+import (
+	"github.com/Masterminds/semver/v3"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	. "github.com/gardener/gardener/pkg/component/kubecontrollermanager"
+	"github.com/gardener/gardener/pkg/utils/validation/kubernetesversion"
+)
+
+var _ = Describe("Flags", func() {
+	Describe("#SupportedFlagsMatrix", func() {
+		It("should cover all supported Kubernetes versions", func() {
+			for _, version := range kubernetesversion.SupportedVersions {
+				Expect(SupportedFlagsMatrix).To(HaveKey(version), "kube-controller-manager flags must be reviewed for Kubernetes version %s", version)
+			}
+		})
+
+		It("should remove flags in the version they were removed", func() {
+			Expect(SupportedFlagsMatrix["1.26"].Has("pod-eviction-timeout")).To(BeTrue())
+			Expect(SupportedFlagsMatrix["1.27"].Has("pod-eviction-timeout")).To(BeFalse())
+			Expect(SupportedFlagsMatrix["1.28"].Has("pod-eviction-timeout")).To(BeFalse())
+		})
+
+		It("should not support a configuration file in any version", func() {
+			for version, flags := range SupportedFlagsMatrix {
+				Expect(flags.Has("config")).To(BeFalse(), "kube-controller-manager %s supports a configuration file, consider rendering the settings into it", version)
+			}
+		})
+	})
+
+	Describe("#ValidateFlags", func() {
+		It("should succeed if all flags are supported", func() {
+			Expect(ValidateFlags([]string{"/usr/local/bin/kube-controller-manager", "--pod-eviction-timeout=2m0s", "--v=2"}, semver.MustParse("1.26.4"))).To(Succeed())
+		})
+
+		It("should fail if a flag is not supported", func() {
+			Expect(ValidateFlags([]string{"--pod-eviction-timeout=2m0s"}, semver.MustParse("1.27.3"))).To(MatchError(ContainSubstring(`flag "pod-eviction-timeout" is not supported`)))
+		})
+
+		It("should fail if the version is not covered", func() {
+			Expect(ValidateFlags([]string{"--v=2"}, semver.MustParse("1.99.0"))).To(MatchError(ContainSubstring("does not cover Kubernetes version 1.99")))
+		})
+	})
+})
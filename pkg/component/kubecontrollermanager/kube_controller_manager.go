@@ -31,12 +31,20 @@ import (
 	networkingv1 "k8s.io/api/networking/v1"
 	policyv1 "k8s.io/api/policy/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/intstr"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation"
 	vpaautoscalingv1 "k8s.io/autoscaler/vertical-pod-autoscaler/pkg/apis/autoscaling.k8s.io/v1"
+	clientcmdlatest "k8s.io/client-go/tools/clientcmd/api/latest"
+	clientcmdv1 "k8s.io/client-go/tools/clientcmd/api/v1"
+	"k8s.io/client-go/tools/leaderelection"
+	"k8s.io/client-go/tools/leaderelection/resourcelock"
+	"k8s.io/client-go/tools/record"
 	"k8s.io/utils/pointer"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -46,6 +54,7 @@ import (
 	"github.com/gardener/gardener/pkg/client/kubernetes"
 	"github.com/gardener/gardener/pkg/component"
 	kubeapiserverconstants "github.com/gardener/gardener/pkg/component/kubeapiserver/constants"
+	componentmetrics "github.com/gardener/gardener/pkg/component/metrics"
 	"github.com/gardener/gardener/pkg/controllerutils"
 	"github.com/gardener/gardener/pkg/utils"
 	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
@@ -61,22 +70,73 @@ const (
 	// ManagedResourceName is the name of the ManagedResource containing the resource specifications.
 	ManagedResourceName = "shoot-core-kube-controller-manager"
 
+	// EventReasonDeployed is the reason of the events which are recorded when Deploy rolls out a new version or image.
+	EventReasonDeployed = "Deployed"
+	// EventReasonScaled is the reason of the events which are recorded when Deploy changes the number of replicas.
+	EventReasonScaled = "Scaled"
+	// EventReasonDeployFailed is the reason of the events which are recorded when Deploy fails.
+	EventReasonDeployFailed = "DeployFailed"
+	// EventReasonDestroying is the reason of the events which are recorded when Destroy is initiated.
+	EventReasonDestroying = "Destroying"
+	// EventReasonDestroyFailed is the reason of the events which are recorded when Destroy fails.
+	EventReasonDestroyFailed = "DestroyFailed"
+
 	serviceName      = "kube-controller-manager"
 	containerName    = v1beta1constants.DeploymentNameKubeControllerManager
 	secretNameServer = "kube-controller-manager-server"
-	portNameMetrics  = "metrics"
+	// secretNameAuthDelegationKubeconfig is the name of the secret containing the kubeconfig used for the delegated
+	// authentication and authorization.
+	secretNameAuthDelegationKubeconfig = "kube-controller-manager-auth-delegation-kubeconfig"
+	// shootAccessNameAuthDelegation is the name of the shoot access secret and service account used for the delegated
+	// authentication and authorization.
+	shootAccessNameAuthDelegation = v1beta1constants.DeploymentNameKubeControllerManager + "-auth-delegator"
+	portNameMetrics               = "metrics"
+	pathHealthz                   = "/healthz"
+
+	// defaultPortMetrics is the default secure port on which kube-controller-manager serves its metrics.
+	defaultPortMetrics int32 = 10257
+
+	// defaultNodeCIDRMaskSizeIPv4 and defaultNodeCIDRMaskSizeIPv6 are the default sizes of the node CIDR masks of
+	// kube-controller-manager for the respective IP family.
+	defaultNodeCIDRMaskSizeIPv4 int32 = 24
+	defaultNodeCIDRMaskSizeIPv6 int32 = 64
+
+	// defaultLeaderElectLeaseDuration, defaultLeaderElectRenewDeadline and defaultLeaderElectRetryPeriod are the
+	// defaults of kube-controller-manager for the leader election.
+	defaultLeaderElectLeaseDuration = 15 * time.Second
+	defaultLeaderElectRenewDeadline = 10 * time.Second
+	defaultLeaderElectRetryPeriod   = 2 * time.Second
 
 	volumeNameServer            = "server"
 	volumeNameServiceAccountKey = "service-account-key"
 	volumeNameCA                = "ca"
 	volumeNameCAClient          = "ca-client"
 	volumeNameCAKubelet         = "ca-kubelet"
+	volumeNameRequestHeaderCA   = "requestheader-client-ca"
+
+	volumeNameAuthDelegationKubeconfig      = "auth-delegation-kubeconfig"
+	volumeMountPathAuthDelegationKubeconfig = "/var/run/secrets/gardener.cloud/shoot/auth-delegation-kubeconfig"
+
+	volumeMountPathServiceAccountKeyBundle = "/srv/kubernetes/service-account-key-bundle"
 
 	volumeMountPathCA                = "/srv/kubernetes/ca"
 	volumeMountPathCAClient          = "/srv/kubernetes/ca-client"
 	volumeMountPathCAKubelet         = "/srv/kubernetes/ca-kubelet"
 	volumeMountPathServiceAccountKey = "/srv/kubernetes/service-account-key"
 	volumeMountPathServer            = "/var/lib/kube-controller-manager-server"
+	volumeMountPathRequestHeaderCA   = "/srv/kubernetes/requestheader-client-ca"
+
+	// hvpaVPARole is the value of the role label of the VPA objects created by the HVPA controller.
+	hvpaVPARole = "kube-controller-manager-vpa"
+)
+
+var (
+	// IntervalWaitForStaleAutoscalerDeletion is the interval used while waiting for the autoscaler objects of the
+	// previously configured autoscaling mode to be deleted.
+	IntervalWaitForStaleAutoscalerDeletion = 2 * time.Second
+	// TimeoutWaitForStaleAutoscalerDeletion is the timeout used while waiting for the autoscaler objects of the
+	// previously configured autoscaling mode to be deleted.
+	TimeoutWaitForStaleAutoscalerDeletion = time.Minute
 )
 
 // Interface contains functions for a kube-controller-manager deployer.
@@ -148,10 +208,12 @@ type Values struct {
 	HVPAConfig *HVPAConfig
 	// IsWorkerless specifies whether the cluster has worker nodes.
 	IsWorkerless bool
-	// PodNetwork is the pod CIDR of the target cluster.
-	PodNetwork *net.IPNet
-	// ServiceNetwork is the service CIDR of the target cluster.
-	ServiceNetwork *net.IPNet
+	// PodNetworks are the pod CIDRs of the target cluster. Two CIDRs of different IP families configure a dual-stack
+	// cluster, the first CIDR determines the primary IP family.
+	PodNetworks []net.IPNet
+	// ServiceNetworks are the service CIDRs of the target cluster. Two CIDRs of different IP families configure a
+	// dual-stack cluster, the first CIDR determines the primary IP family.
+	ServiceNetworks []net.IPNet
 	// ClusterSigningDuration is the value for the `--cluster-signing-duration` flag.
 	ClusterSigningDuration *time.Duration
 	// ControllerWorkers is used for configuring the workers for controllers.
@@ -160,6 +222,124 @@ type Values struct {
 	ControllerSyncPeriods ControllerSyncPeriods
 	// RuntimeConfig contains information about enabled or disabled APIs.
 	RuntimeConfig map[string]bool
+	// WaitProgressFunc is an optional callback which is called with a message describing the progress whenever the
+	// deployment is not yet updated while waiting for it.
+	WaitProgressFunc func(message string)
+	// LeaderElection is the configuration of the leader election. Unset values default to the ones of
+	// kube-controller-manager, which always enables the leader election. The replicas and topology spread constraints of
+	// highly available shoots are not configured by this component but added by the high-availability-config webhook of
+	// gardener-resource-manager, which selects the deployment via its
+	// `high-availability-config.resources.gardener.cloud/type=controller` label.
+	LeaderElection *LeaderElection
+	// ClientConnection is the configuration for the client-side rate limits of the connection to the kube-apiserver.
+	ClientConnection ClientConnection
+	// MetricsPort is the secure port on which kube-controller-manager serves its metrics. Defaults to 10257.
+	// kube-controller-manager does not support a dedicated port for its health endpoints, hence they are served on this
+	// port as well. The health endpoints are allowed without authorization by default, i.e., the liveness probe does not
+	// require the permissions needed for scraping the metrics.
+	MetricsPort *int32
+	// RBACReport specifies whether a config map enumerating the service accounts and roles which are effectively needed
+	// by the enabled controllers shall be maintained in the control plane namespace.
+	RBACReport bool
+	// LivenessProbe is the configuration for the liveness probe of kube-controller-manager.
+	LivenessProbe LivenessProbe
+	// AuthorizationAlwaysAllowPaths are HTTP paths which are served without authorization in addition to the health
+	// endpoints, e.g. `/metrics` if the metrics shall be scraped by a Prometheus which is not able to authenticate.
+	// The paths must be absolute and may end with `/*` to match all paths with the given prefix.
+	AuthorizationAlwaysAllowPaths []string
+	// RequestHeader is the configuration for authenticating requests by request headers set by an authenticating
+	// proxy in front of kube-controller-manager. If it is not set, the `--requestheader-*` flags are not rendered.
+	RequestHeader *RequestHeader
+	// ServiceAccountKeyBundleSecretName is the name of a secret in the control plane namespace containing an externally
+	// managed bundle of versioned service account signing keys, see secretsrotation.ServiceAccountKeyBundle. If set,
+	// kube-controller-manager signs tokens with the current key of the bundle instead of the key generated by the
+	// secrets manager. Changing the current version rolls out kube-controller-manager, the old keys of the bundle must be
+	// passed to kube-apiserver for verifying the tokens signed before.
+	ServiceAccountKeyBundleSecretName string
+	// PrometheusInstance is the name of a Prometheus instance which shall scrape the metrics of kube-controller-manager.
+	// If set, a config map containing the scrape configuration (see ScrapeConfigs) is maintained in the control plane
+	// namespace. It is labeled with `prometheus=<PrometheusInstance>` (see LabelKeyPrometheus) so that the scrape
+	// configuration can be discovered instead of being written against the metrics service by hand. If empty, the
+	// config map is deleted.
+	PrometheusInstance string
+	// SeparateAuthDelegationKubeconfig specifies whether kube-controller-manager uses a separate kubeconfig for the
+	// `--authentication-kubeconfig` and `--authorization-kubeconfig` flags. Its service account is only bound to the
+	// `system:auth-delegator` cluster role, i.e., it is only allowed to create TokenReviews and SubjectAccessReviews for
+	// authenticating and authorizing the requests to the metrics endpoint. If false, the generic kubeconfig with the
+	// permissions of kube-controller-manager is used for all flags.
+	SeparateAuthDelegationKubeconfig bool
+	// DependencyWatchdogScalingDisabled specifies whether the dependency-watchdog prober shall not scale down the
+	// kube-controller-manager if the kube-apiserver cannot be reached via its external endpoint, e.g. because the
+	// shoot owner prefers the nodes to be marked as not ready over stopping all controllers.
+	DependencyWatchdogScalingDisabled bool
+	// TenantLabels are labels identifying the tenant of the control plane, e.g. the owner of the shoot. They are added
+	// to all objects kube-controller-manager deploys into the seed and injected into the objects it deploys into the
+	// shoot via its ManagedResource, so that policy engines on multi-tenant seeds can enforce isolation rules per tenant.
+	// Labels of the component take precedence, and the labels are never used in selectors.
+	TenantLabels map[string]string
+	// Recorder is used for recording events on the kube-controller-manager Deployment for the major lifecycle actions
+	// and failures of Deploy and Destroy, see the EventReason* constants. If nil, no events are recorded.
+	Recorder record.EventRecorder
+}
+
+// RequestHeader contains configuration for authenticating requests by request headers, see the `--requestheader-*`
+// flags of kube-controller-manager.
+type RequestHeader struct {
+	// ClientCASecretName is the name of a secret in the control plane namespace whose `ca.crt` key contains the CA
+	// bundle used to verify the client certificates of the authenticating proxy. Requests are only authenticated by
+	// request headers if they are sent with a client certificate signed by this CA.
+	ClientCASecretName string
+	// AllowedNames are the common names of the client certificates which are allowed to provide the request headers.
+	// If empty, any client certificate signed by the client CA is allowed.
+	AllowedNames []string
+	// UsernameHeaders are the request headers to inspect for the user name.
+	UsernameHeaders []string
+	// GroupHeaders are the request headers to inspect for the groups.
+	GroupHeaders []string
+	// ExtraHeadersPrefixes are the request header prefixes to inspect for extra user information.
+	ExtraHeadersPrefixes []string
+}
+
+// LivenessProbe contains configuration for the liveness probe of kube-controller-manager. Fields which are not set
+// default to the current behaviour, i.e., an HTTPS request to the health endpoint sent by the kubelet.
+type LivenessProbe struct {
+	// InitialDelaySeconds is the number of seconds after the container has started before the probe is initiated.
+	// Defaults to 15.
+	InitialDelaySeconds *int32
+	// PeriodSeconds is the number of seconds between two probes. Defaults to 10.
+	PeriodSeconds *int32
+	// TimeoutSeconds is the number of seconds after which a probe times out. Defaults to 15.
+	TimeoutSeconds *int32
+	// FailureThreshold is the number of consecutive failed probes after which the container is restarted. Defaults to 2.
+	FailureThreshold *int32
+	// ExecCommand makes the probe execute the given command in the container instead of sending an HTTPS request to the
+	// health endpoint. This is useful for environments in which the kubelet must not reach the pods via HTTPS. The
+	// command must be available in the image of kube-controller-manager, note that the upstream images are distroless.
+	// The health endpoint is served on `https://localhost:<MetricsPort>/healthz` without authorization.
+	ExecCommand []string
+}
+
+// ClientConnection contains configuration for the client-side rate limits which kube-controller-manager applies when
+// communicating with the kube-apiserver. Large clusters might need higher limits, e.g., for the namespace and garbage
+// collector controllers. If not set, the defaults of kube-controller-manager are used.
+type ClientConnection struct {
+	// QPS is the number of queries per second to the kube-apiserver.
+	QPS *float32
+	// Burst is the number of queries to the kube-apiserver which may be sent in a burst.
+	Burst *int32
+}
+
+// LeaderElection contains configuration for the leader election of kube-controller-manager, see the
+// `--leader-elect-*` flags.
+type LeaderElection struct {
+	// LeaseDuration is the duration that non-leader candidates wait before trying to acquire the leadership.
+	LeaseDuration *time.Duration
+	// RenewDeadline is the duration that the leader retries to renew the leadership before giving it up.
+	RenewDeadline *time.Duration
+	// RetryPeriod is the duration the candidates wait between tries to acquire or renew the leadership.
+	RetryPeriod *time.Duration
+	// ResourceLock is the type of the resource object used for locking during the leader election.
+	ResourceLock *string
 }
 
 // ControllerWorkers is used for configuring the workers for controllers.
@@ -205,7 +385,18 @@ const (
 	defaultControllerWorkersServiceAccountToken = 15
 )
 
-func (k *kubeControllerManager) Deploy(ctx context.Context) error {
+func (k *kubeControllerManager) Deploy(ctx context.Context) (err error) {
+	defer componentmetrics.ObserveOperation(v1beta1constants.DeploymentNameKubeControllerManager, componentmetrics.OperationDeploy, time.Now(), &err)
+	defer func() {
+		if err != nil {
+			k.recordEvent(k.emptyDeployment(), corev1.EventTypeWarning, EventReasonDeployFailed, "Failed deploying kube-controller-manager: %v", err)
+		}
+	}()
+
+	if err := k.validateTenantLabels(); err != nil {
+		return err
+	}
+
 	serverSecret, err := k.secretsManager.Generate(ctx, &secrets.CertificateSecretConfig{
 		Name:                        secretNameServer,
 		CommonName:                  k.values.NamePrefix + v1beta1constants.DeploymentNameKubeControllerManager,
@@ -240,9 +431,17 @@ func (k *kubeControllerManager) Deploy(ctx context.Context) error {
 		return fmt.Errorf("secret %q not found", v1beta1constants.SecretNameGenericTokenKubeconfig)
 	}
 
-	serviceAccountKeySecret, found := k.secretsManager.Get(v1beta1constants.SecretNameServiceAccountKey, secretsmanager.Current)
-	if !found {
-		return fmt.Errorf("secret %q not found", v1beta1constants.SecretNameServiceAccountKey)
+	serviceAccountKey, err := k.serviceAccountKey(ctx)
+	if err != nil {
+		return err
+	}
+
+	var authDelegationKubeconfigSecret *corev1.Secret
+	if k.values.SeparateAuthDelegationKubeconfig {
+		authDelegationKubeconfigSecret, err = k.reconcileAuthDelegationKubeconfigSecret(ctx, genericTokenKubeconfigSecret)
+		if err != nil {
+			return err
+		}
 	}
 
 	var (
@@ -253,12 +452,13 @@ func (k *kubeControllerManager) Deploy(ctx context.Context) error {
 		deployment          = k.emptyDeployment()
 		podDisruptionBudget = k.emptyPodDisruptionBudget()
 
-		port               int32 = 10257
-		probeURIScheme           = corev1.URISchemeHTTPS
-		command                  = k.computeCommand(port)
-		controlledValues         = vpaautoscalingv1.ContainerControlledValuesRequestsOnly
-		pdbMaxUnavailable        = intstr.FromInt32(1)
-		hvpaResourcePolicy       = &vpaautoscalingv1.PodResourcePolicy{
+		port               = pointer.Int32Deref(k.values.MetricsPort, defaultPortMetrics)
+		probeURIScheme     = corev1.URISchemeHTTPS
+		commandOptions     = k.computeCommandOptions(port, serviceAccountKey.privateKeyFile)
+		command            = commandOptions.render()
+		controlledValues   = vpaautoscalingv1.ContainerControlledValuesRequestsOnly
+		pdbMaxUnavailable  = intstr.FromInt32(1)
+		hvpaResourcePolicy = &vpaautoscalingv1.PodResourcePolicy{
 			ContainerPolicies: []vpaautoscalingv1.ContainerResourcePolicy{{
 				ContainerName: containerName,
 				MinAllowed: corev1.ResourceList{
@@ -286,13 +486,34 @@ func (k *kubeControllerManager) Deploy(ctx context.Context) error {
 		}
 	)
 
+	if port < 1 || port > 65535 {
+		return fmt.Errorf("invalid metrics port %d, must be between 1 and 65535", port)
+	}
+
+	if err := k.validateAuthenticationAndAuthorization(); err != nil {
+		return err
+	}
+
+	if err := k.validateLeaderElection(commandOptions); err != nil {
+		return err
+	}
+
+	if err := ValidateFlags(command, k.values.TargetVersion); err != nil {
+		return err
+	}
+
+	livenessProbe, err := k.computeLivenessProbe(port, probeURIScheme)
+	if err != nil {
+		return err
+	}
+
 	resourceRequirements, err := k.computeResourceRequirements(ctx)
 	if err != nil {
 		return err
 	}
 
 	if _, err := controllerutils.GetAndCreateOrMergePatch(ctx, k.seedClient.Client(), service, func() error {
-		service.Labels = getLabels()
+		service.Labels = k.withTenantLabels(getLabels())
 
 		utilruntime.Must(gardenerutils.InjectNetworkPolicyAnnotationsForScrapeTargets(service, networkingv1.NetworkPolicyPort{
 			Port:     utils.IntStrPtrFromInt32(port),
@@ -319,22 +540,46 @@ func (k *kubeControllerManager) Deploy(ctx context.Context) error {
 		return err
 	}
 
+	authDelegationShootAccessSecret := k.newAuthDelegationShootAccessSecret()
+	if k.values.SeparateAuthDelegationKubeconfig {
+		if err := authDelegationShootAccessSecret.Reconcile(ctx, k.seedClient.Client()); err != nil {
+			return err
+		}
+	} else if err := kubernetesutils.DeleteObject(ctx, k.seedClient.Client(), authDelegationShootAccessSecret.Secret); err != nil {
+		return err
+	}
+
+	var (
+		oldImage    string
+		oldReplicas *int32
+	)
+
 	if _, err := controllerutils.GetAndCreateOrMergePatch(ctx, k.seedClient.Client(), deployment, func() error {
-		deployment.Labels = utils.MergeStringMaps(getLabels(), map[string]string{
+		if containers := deployment.Spec.Template.Spec.Containers; len(containers) > 0 {
+			oldImage = containers[0].Image
+		}
+		oldReplicas = deployment.Spec.Replicas
+
+		deployment.Labels = k.withTenantLabels(utils.MergeStringMaps(getLabels(), map[string]string{
 			v1beta1constants.GardenRole:                  v1beta1constants.GardenRoleControlPlane,
 			resourcesv1alpha1.HighAvailabilityConfigType: resourcesv1alpha1.HighAvailabilityConfigTypeController,
-		})
+		}))
+		if k.values.DependencyWatchdogScalingDisabled {
+			metav1.SetMetaDataAnnotation(&deployment.ObjectMeta, AnnotationKeyDependencyWatchdogIgnoreScaling, "true")
+		} else {
+			delete(deployment.Annotations, AnnotationKeyDependencyWatchdogIgnoreScaling)
+		}
 		deployment.Spec.Replicas = &k.values.Replicas
 		deployment.Spec.RevisionHistoryLimit = pointer.Int32(1)
 		deployment.Spec.Selector = &metav1.LabelSelector{MatchLabels: getLabels()}
 		deployment.Spec.Template = corev1.PodTemplateSpec{
 			ObjectMeta: metav1.ObjectMeta{
-				Labels: utils.MergeStringMaps(getLabels(), map[string]string{
+				Labels: k.withTenantLabels(utils.MergeStringMaps(getLabels(), map[string]string{
 					v1beta1constants.GardenRole:                 v1beta1constants.GardenRoleControlPlane,
 					v1beta1constants.LabelPodMaintenanceRestart: "true",
 					v1beta1constants.LabelNetworkPolicyToDNS:    v1beta1constants.LabelNetworkPolicyAllowed,
 					gardenerutils.NetworkPolicyLabel(k.values.NamePrefix+v1beta1constants.DeploymentNameKubeAPIServer, kubeapiserverconstants.Port): v1beta1constants.LabelNetworkPolicyAllowed,
-				}),
+				})),
 			},
 			Spec: corev1.PodSpec{
 				AutomountServiceAccountToken: pointer.Bool(false),
@@ -353,20 +598,7 @@ func (k *kubeControllerManager) Deploy(ctx context.Context) error {
 						Image:           k.values.Image,
 						ImagePullPolicy: corev1.PullIfNotPresent,
 						Command:         command,
-						LivenessProbe: &corev1.Probe{
-							ProbeHandler: corev1.ProbeHandler{
-								HTTPGet: &corev1.HTTPGetAction{
-									Path:   "/healthz",
-									Scheme: probeURIScheme,
-									Port:   intstr.FromInt32(port),
-								},
-							},
-							SuccessThreshold:    1,
-							FailureThreshold:    2,
-							InitialDelaySeconds: 15,
-							PeriodSeconds:       10,
-							TimeoutSeconds:      15,
-						},
+						LivenessProbe:   livenessProbe,
 						Ports: []corev1.ContainerPort{
 							{
 								Name:          portNameMetrics,
@@ -386,7 +618,7 @@ func (k *kubeControllerManager) Deploy(ctx context.Context) error {
 							},
 							{
 								Name:      volumeNameServiceAccountKey,
-								MountPath: volumeMountPathServiceAccountKey,
+								MountPath: serviceAccountKey.mountPath,
 							},
 							{
 								Name:      volumeNameServer,
@@ -417,7 +649,7 @@ func (k *kubeControllerManager) Deploy(ctx context.Context) error {
 						Name: volumeNameServiceAccountKey,
 						VolumeSource: corev1.VolumeSource{
 							Secret: &corev1.SecretVolumeSource{
-								SecretName:  serviceAccountKeySecret.Name,
+								SecretName:  serviceAccountKey.secretName,
 								DefaultMode: pointer.Int32(0640),
 							},
 						},
@@ -452,14 +684,41 @@ func (k *kubeControllerManager) Deploy(ctx context.Context) error {
 			})
 		}
 
+		if requestHeader := k.values.RequestHeader; requestHeader != nil {
+			deployment.Spec.Template.Spec.Containers[0].VolumeMounts = append(deployment.Spec.Template.Spec.Containers[0].VolumeMounts, corev1.VolumeMount{
+				Name:      volumeNameRequestHeaderCA,
+				MountPath: volumeMountPathRequestHeaderCA,
+			})
+
+			deployment.Spec.Template.Spec.Volumes = append(deployment.Spec.Template.Spec.Volumes, corev1.Volume{
+				Name: volumeNameRequestHeaderCA,
+				VolumeSource: corev1.VolumeSource{
+					Secret: &corev1.SecretVolumeSource{
+						SecretName: requestHeader.ClientCASecretName,
+					},
+				},
+			})
+		}
+
 		utilruntime.Must(gardenerutils.InjectGenericKubeconfig(deployment, genericTokenKubeconfigSecret.Name, shootAccessSecret.Secret.Name))
+
+		if authDelegationKubeconfigSecret != nil {
+			injectAuthDelegationKubeconfig(deployment, authDelegationKubeconfigSecret.Name, authDelegationShootAccessSecret.Secret.Name)
+		}
 		return nil
 	}); err != nil {
 		return err
 	}
 
+	switch {
+	case oldImage != k.values.Image:
+		k.recordEvent(deployment, corev1.EventTypeNormal, EventReasonDeployed, "Deployed kube-controller-manager version %s with %d replica(s)", k.values.TargetVersion, k.values.Replicas)
+	case pointer.Int32Deref(oldReplicas, 0) != k.values.Replicas:
+		k.recordEvent(deployment, corev1.EventTypeNormal, EventReasonScaled, "Scaled kube-controller-manager from %d to %d replica(s)", pointer.Int32Deref(oldReplicas, 0), k.values.Replicas)
+	}
+
 	if _, err := controllerutils.GetAndCreateOrMergePatch(ctx, k.seedClient.Client(), podDisruptionBudget, func() error {
-		podDisruptionBudget.Labels = getLabels()
+		podDisruptionBudget.Labels = k.withTenantLabels(getLabels())
 		podDisruptionBudget.Spec = policyv1.PodDisruptionBudgetSpec{
 			MaxUnavailable: &pdbMaxUnavailable,
 			Selector:       deployment.Spec.Selector,
@@ -470,13 +729,13 @@ func (k *kubeControllerManager) Deploy(ctx context.Context) error {
 	}
 
 	if k.values.HVPAConfig != nil && k.values.HVPAConfig.Enabled {
-		if err := kubernetesutils.DeleteObject(ctx, k.seedClient.Client(), vpa); err != nil {
+		if err := k.deleteStaleAutoscalers(ctx, vpa); err != nil {
 			return err
 		}
 
 		var (
 			updateModeAuto = hvpav1alpha1.UpdateModeAuto
-			vpaLabels      = map[string]string{v1beta1constants.LabelRole: "kube-controller-manager-vpa"}
+			vpaLabels      = map[string]string{v1beta1constants.LabelRole: hvpaVPARole}
 		)
 
 		scaleDownUpdateMode := k.values.HVPAConfig.ScaleDownUpdateMode
@@ -487,6 +746,7 @@ func (k *kubeControllerManager) Deploy(ctx context.Context) error {
 		if _, err := controllerutils.GetAndCreateOrMergePatch(ctx, k.seedClient.Client(), hvpa, func() error {
 			hvpa.Labels = utils.MergeStringMaps(
 				hvpa.Labels,
+				k.values.TenantLabels,
 				getLabels(),
 				map[string]string{
 					resourcesv1alpha1.HighAvailabilityConfigType: resourcesv1alpha1.HighAvailabilityConfigTypeController,
@@ -545,13 +805,19 @@ func (k *kubeControllerManager) Deploy(ctx context.Context) error {
 			return err
 		}
 	} else {
-		if err := kubernetesutils.DeleteObject(ctx, k.seedClient.Client(), hvpa); err != nil {
+		hvpaVPAs, err := k.hvpaVPAs(ctx)
+		if err != nil {
+			return err
+		}
+
+		if err := k.deleteStaleAutoscalers(ctx, append([]client.Object{hvpa}, hvpaVPAs...)...); err != nil {
 			return err
 		}
 
 		vpaUpdateMode := vpaautoscalingv1.UpdateModeAuto
 
 		if _, err := controllerutils.GetAndCreateOrMergePatch(ctx, k.seedClient.Client(), vpa, func() error {
+			vpa.Labels = utils.MergeStringMaps(vpa.Labels, k.values.TenantLabels)
 			vpa.Spec.TargetRef = &autoscalingv1.CrossVersionObjectReference{
 				APIVersion: appsv1.SchemeGroupVersion.String(),
 				Kind:       "Deployment",
@@ -567,10 +833,27 @@ func (k *kubeControllerManager) Deploy(ctx context.Context) error {
 		}
 	}
 
-	return k.reconcileShootResources(ctx, shootAccessSecret.ServiceAccountName)
+	if err := k.reconcileRBACReport(ctx, shootAccessSecret.ServiceAccountName); err != nil {
+		return err
+	}
+
+	if err := k.reconcileScrapeConfigMap(ctx); err != nil {
+		return err
+	}
+
+	return k.reconcileShootResources(ctx, shootAccessSecret.ServiceAccountName, authDelegationShootAccessSecret.ServiceAccountName)
 }
 
-func (k *kubeControllerManager) Destroy(ctx context.Context) error {
+func (k *kubeControllerManager) Destroy(ctx context.Context) (err error) {
+	defer componentmetrics.ObserveOperation(v1beta1constants.DeploymentNameKubeControllerManager, componentmetrics.OperationDestroy, time.Now(), &err)
+	defer func() {
+		if err != nil {
+			k.recordEvent(k.emptyDeployment(), corev1.EventTypeWarning, EventReasonDestroyFailed, "Failed destroying kube-controller-manager: %v", err)
+		}
+	}()
+
+	k.recordEvent(k.emptyDeployment(), corev1.EventTypeNormal, EventReasonDestroying, "Destroying kube-controller-manager")
+
 	return kubernetesutils.DeleteObjects(ctx, k.seedClient.Client(),
 		k.emptyManagedResource(),
 		k.emptyManagedResourceSecret(),
@@ -580,7 +863,131 @@ func (k *kubeControllerManager) Destroy(ctx context.Context) error {
 		k.emptyPodDisruptionBudget(),
 		k.emptyDeployment(),
 		k.newShootAccessSecret().Secret,
+		k.newAuthDelegationShootAccessSecret().Secret,
+		k.emptyRBACReportConfigMap(),
+		k.emptyScrapeConfigMap(),
+	)
+}
+
+// recordEvent records an event on the given object if a recorder is configured.
+func (k *kubeControllerManager) recordEvent(obj runtime.Object, eventType, reason, messageFmt string, args ...interface{}) {
+	if k.values.Recorder == nil {
+		return
+	}
+	k.values.Recorder.Eventf(obj, eventType, reason, messageFmt, args...)
+}
+
+// reconcileAuthDelegationKubeconfigSecret generates a kubeconfig which points to the same cluster as the generic token
+// kubeconfig but reads the token of the auth delegation shoot access secret.
+func (k *kubeControllerManager) reconcileAuthDelegationKubeconfigSecret(ctx context.Context, genericTokenKubeconfigSecret *corev1.Secret) (*corev1.Secret, error) {
+	cluster, err := genericTokenKubeconfigCluster(genericTokenKubeconfigSecret)
+	if err != nil {
+		return nil, err
+	}
+
+	return k.secretsManager.Generate(ctx, &secrets.KubeconfigSecretConfig{
+		Name:        secretNameAuthDelegationKubeconfig,
+		ContextName: k.namespace,
+		Cluster:     cluster,
+		AuthInfo:    clientcmdv1.AuthInfo{TokenFile: volumeMountPathAuthDelegationKubeconfig + "/" + resourcesv1alpha1.DataKeyToken},
+	}, secretsmanager.Rotate(secretsmanager.InPlace))
+}
+
+func genericTokenKubeconfigCluster(genericTokenKubeconfigSecret *corev1.Secret) (clientcmdv1.Cluster, error) {
+	genericTokenKubeconfig := &clientcmdv1.Config{}
+	if _, _, err := clientcmdlatest.Codec.Decode(genericTokenKubeconfigSecret.Data[kubernetes.KubeConfig], nil, genericTokenKubeconfig); err != nil {
+		return clientcmdv1.Cluster{}, fmt.Errorf("failed decoding kubeconfig of secret %q: %w", genericTokenKubeconfigSecret.Name, err)
+	}
+	if len(genericTokenKubeconfig.Clusters) == 0 {
+		return clientcmdv1.Cluster{}, fmt.Errorf("kubeconfig of secret %q does not contain any cluster", genericTokenKubeconfigSecret.Name)
+	}
+	return genericTokenKubeconfig.Clusters[0].Cluster, nil
+}
+
+// injectAuthDelegationKubeconfig injects the volume and volume mount for the auth delegation kubeconfig and the token of
+// the respective shoot access secret into the kube-controller-manager container.
+func injectAuthDelegationKubeconfig(deployment *appsv1.Deployment, kubeconfigSecretName, accessSecretName string) {
+	deployment.Spec.Template.Spec.Containers[0].VolumeMounts = append(deployment.Spec.Template.Spec.Containers[0].VolumeMounts, corev1.VolumeMount{
+		Name:      volumeNameAuthDelegationKubeconfig,
+		MountPath: volumeMountPathAuthDelegationKubeconfig,
+		ReadOnly:  true,
+	})
+
+	deployment.Spec.Template.Spec.Volumes = append(deployment.Spec.Template.Spec.Volumes, corev1.Volume{
+		Name: volumeNameAuthDelegationKubeconfig,
+		VolumeSource: corev1.VolumeSource{
+			Projected: &corev1.ProjectedVolumeSource{
+				DefaultMode: pointer.Int32(420),
+				Sources: []corev1.VolumeProjection{
+					{
+						Secret: &corev1.SecretProjection{
+							LocalObjectReference: corev1.LocalObjectReference{Name: kubeconfigSecretName},
+							Items:                []corev1.KeyToPath{{Key: secrets.DataKeyKubeconfig, Path: secrets.DataKeyKubeconfig}},
+							Optional:             pointer.Bool(false),
+						},
+					},
+					{
+						Secret: &corev1.SecretProjection{
+							LocalObjectReference: corev1.LocalObjectReference{Name: accessSecretName},
+							Items:                []corev1.KeyToPath{{Key: resourcesv1alpha1.DataKeyToken, Path: resourcesv1alpha1.DataKeyToken}},
+							Optional:             pointer.Bool(false),
+						},
+					},
+				},
+			},
+		},
+	})
+}
+
+// deleteStaleAutoscalers deletes the autoscaler objects of the previously configured autoscaling mode (HVPA or VPA) and
+// waits until they are gone. Otherwise, both autoscalers would act on the kube-controller-manager deployment at the same
+// time until the stale objects are finally removed. Objects whose kind is not known to the seed, e.g. the HVPA if its CRD
+// is not installed, are considered deleted.
+func (k *kubeControllerManager) deleteStaleAutoscalers(ctx context.Context, objs ...client.Object) error {
+	if err := kubernetesutils.DeleteObjects(ctx, k.seedClient.Client(), objs...); err != nil {
+		return err
+	}
+
+	timeoutCtx, cancel := context.WithTimeout(ctx, TimeoutWaitForStaleAutoscalerDeletion)
+	defer cancel()
+
+	for _, obj := range objs {
+		if err := kubernetesutils.WaitUntilResourceDeleted(timeoutCtx, k.seedClient.Client(), obj, IntervalWaitForStaleAutoscalerDeletion); err != nil {
+			if meta.IsNoMatchError(err) {
+				continue
+			}
+			return fmt.Errorf("failed waiting for stale autoscaler %s to be deleted before switching the autoscaling mode: %w", client.ObjectKeyFromObject(obj), err)
+		}
+	}
+
+	return nil
+}
+
+// hvpaVPAs returns the VPA objects which were created by the HVPA controller for the kube-controller-manager HVPA.
+// They are usually garbage collected after the HVPA was deleted, but they are deleted explicitly to not depend on the
+// garbage collector when switching from HVPA to VPA.
+func (k *kubeControllerManager) hvpaVPAs(ctx context.Context) ([]client.Object, error) {
+	vpaList := &vpaautoscalingv1.VerticalPodAutoscalerList{}
+	if err := k.seedClient.Client().List(ctx, vpaList, client.InNamespace(k.namespace), client.MatchingLabels{v1beta1constants.LabelRole: hvpaVPARole}); err != nil {
+		return nil, err
+	}
+
+	var (
+		hvpaName = k.emptyHVPA().Name
+		objs     []client.Object
 	)
+
+	for i := range vpaList.Items {
+		vpa := &vpaList.Items[i]
+		for _, ownerRef := range vpa.OwnerReferences {
+			if ownerRef.Kind == "Hvpa" && ownerRef.Name == hvpaName {
+				objs = append(objs, vpa)
+				break
+			}
+		}
+	}
+
+	return objs, nil
 }
 
 func (k *kubeControllerManager) SetShootClient(c client.Client) { k.shootClient = c }
@@ -613,6 +1020,10 @@ func (k *kubeControllerManager) newShootAccessSecret() *gardenerutils.AccessSecr
 	return gardenerutils.NewShootAccessSecret(v1beta1constants.DeploymentNameKubeControllerManager, k.namespace)
 }
 
+func (k *kubeControllerManager) newAuthDelegationShootAccessSecret() *gardenerutils.AccessSecret {
+	return gardenerutils.NewShootAccessSecret(shootAccessNameAuthDelegation, k.namespace)
+}
+
 func (k *kubeControllerManager) emptyManagedResource() *resourcesv1alpha1.ManagedResource {
 	return &resourcesv1alpha1.ManagedResource{ObjectMeta: metav1.ObjectMeta{Name: ManagedResourceName, Namespace: k.namespace}}
 }
@@ -622,6 +1033,25 @@ func (k *kubeControllerManager) emptyManagedResourceSecret() *corev1.Secret {
 	return &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "managedresource-" + ManagedResourceName, Namespace: k.namespace}}
 }
 
+// withTenantLabels returns the given labels of the component merged with the tenant labels. The labels of the component
+// take precedence.
+func (k *kubeControllerManager) withTenantLabels(labels map[string]string) map[string]string {
+	return utils.MergeStringMaps(k.values.TenantLabels, labels)
+}
+
+// validateTenantLabels returns an error if the tenant labels are no valid Kubernetes labels.
+func (k *kubeControllerManager) validateTenantLabels() error {
+	for key, value := range k.values.TenantLabels {
+		if errs := validation.IsQualifiedName(key); len(errs) > 0 {
+			return fmt.Errorf("invalid tenant label key %q: %s", key, strings.Join(errs, "; "))
+		}
+		if errs := validation.IsValidLabelValue(value); len(errs) > 0 {
+			return fmt.Errorf("invalid value of tenant label %q: %s", key, strings.Join(errs, "; "))
+		}
+	}
+	return nil
+}
+
 func getLabels() map[string]string {
 	return map[string]string{
 		v1beta1constants.LabelApp:  v1beta1constants.LabelKubernetes,
@@ -629,78 +1059,245 @@ func getLabels() map[string]string {
 	}
 }
 
-func (k *kubeControllerManager) computeCommand(port int32) []string {
+func (k *kubeControllerManager) computeLivenessProbe(port int32, scheme corev1.URIScheme) (*corev1.Probe, error) {
+	config := k.values.LivenessProbe
+
+	probe := &corev1.Probe{
+		ProbeHandler: corev1.ProbeHandler{
+			HTTPGet: &corev1.HTTPGetAction{
+				Path:   pathHealthz,
+				Scheme: scheme,
+				Port:   intstr.FromInt32(port),
+			},
+		},
+		SuccessThreshold:    1,
+		FailureThreshold:    pointer.Int32Deref(config.FailureThreshold, 2),
+		InitialDelaySeconds: pointer.Int32Deref(config.InitialDelaySeconds, 15),
+		PeriodSeconds:       pointer.Int32Deref(config.PeriodSeconds, 10),
+		TimeoutSeconds:      pointer.Int32Deref(config.TimeoutSeconds, 15),
+	}
+
+	if probe.InitialDelaySeconds < 0 {
+		return nil, fmt.Errorf("invalid liveness probe initial delay %d, must not be negative", probe.InitialDelaySeconds)
+	}
+	for name, value := range map[string]int32{
+		"period":            probe.PeriodSeconds,
+		"timeout":           probe.TimeoutSeconds,
+		"failure threshold": probe.FailureThreshold,
+	} {
+		if value < 1 {
+			return nil, fmt.Errorf("invalid liveness probe %s %d, must be at least 1", name, value)
+		}
+	}
+
+	if len(config.ExecCommand) > 0 {
+		probe.ProbeHandler = corev1.ProbeHandler{
+			Exec: &corev1.ExecAction{Command: append([]string(nil), config.ExecCommand...)},
+		}
+	}
+
+	return probe, nil
+}
+
+func (k *kubeControllerManager) computeCommandOptions(port int32, serviceAccountPrivateKeyFile string) *commandOptions {
 	var (
 		defaultHorizontalPodAutoscalerConfig = k.getHorizontalPodAutoscalerConfig()
-		podEvictionTimeout                   = metav1.Duration{Duration: 2 * time.Minute}
-		nodeMonitorGracePeriod               = metav1.Duration{Duration: 2 * time.Minute}
-		command                              = []string{
-			"/usr/local/bin/kube-controller-manager",
-			"--authentication-kubeconfig=" + gardenerutils.PathGenericKubeconfig,
-			"--authorization-kubeconfig=" + gardenerutils.PathGenericKubeconfig,
-			"--kubeconfig=" + gardenerutils.PathGenericKubeconfig,
+		nodeMonitorGracePeriod               = 2 * time.Minute
+
+		options = &commandOptions{
+			AuthenticationKubeconfig: gardenerutils.PathGenericKubeconfig,
+			AuthorizationKubeconfig:  gardenerutils.PathGenericKubeconfig,
+			Kubeconfig:               gardenerutils.PathGenericKubeconfig,
+
+			ClusterName: k.namespace,
+			ClusterSigningKubeAPIServerClientCertFile: fmt.Sprintf("%s/%s", volumeMountPathCAClient, secrets.DataKeyCertificateCA),
+			ClusterSigningKubeAPIServerClientKeyFile:  fmt.Sprintf("%s/%s", volumeMountPathCAClient, secrets.DataKeyPrivateKeyCA),
+			ClusterSigningLegacyUnknownCertFile:       fmt.Sprintf("%s/%s", volumeMountPathCAClient, secrets.DataKeyCertificateCA),
+			ClusterSigningLegacyUnknownKeyFile:        fmt.Sprintf("%s/%s", volumeMountPathCAClient, secrets.DataKeyPrivateKeyCA),
+			ClusterSigningDuration:                    pointer.DurationDeref(k.values.ClusterSigningDuration, 720*time.Hour),
+			ConcurrentEndpointSyncs:                   pointer.IntDeref(k.values.ControllerWorkers.Endpoint, defaultControllerWorkersEndpoint),
+			ConcurrentGCSyncs:                         pointer.IntDeref(k.values.ControllerWorkers.GarbageCollector, defaultControllerWorkersGarbageCollector),
+			ConcurrentServiceEndpointSyncs:            pointer.IntDeref(k.values.ControllerWorkers.ServiceEndpoint, defaultControllerWorkersServiceEndpoint),
+			KubeAPIQPS:                                k.values.ClientConnection.QPS,
+			KubeAPIBurst:                              k.values.ClientConnection.Burst,
+
+			RootCAFile:                   fmt.Sprintf("%s/%s", volumeMountPathCA, secrets.DataKeyCertificateBundle),
+			ServiceAccountPrivateKeyFile: serviceAccountPrivateKeyFile,
+			SecurePort:                   port,
+			Profiling:                    false,
+			TLSCertFile:                  fmt.Sprintf("%s/%s", volumeMountPathServer, secrets.DataKeyCertificate),
+			TLSPrivateKeyFile:            fmt.Sprintf("%s/%s", volumeMountPathServer, secrets.DataKeyPrivateKey),
+			TLSCipherSuites:              kubernetesutils.TLSCipherSuites,
+			UseServiceAccountCredentials: true,
+			Verbosity:                    2,
 		}
-
-		controllersToEnable  = sets.New("*", "bootstrapsigner", "tokencleaner")
-		controllersToDisable = sets.New[string]()
 	)
 
+	if k.values.SeparateAuthDelegationKubeconfig {
+		options.AuthenticationKubeconfig = volumeMountPathAuthDelegationKubeconfig + "/" + secrets.DataKeyKubeconfig
+		options.AuthorizationKubeconfig = volumeMountPathAuthDelegationKubeconfig + "/" + secrets.DataKeyKubeconfig
+	}
+
+	// The flag replaces the default paths of kube-controller-manager, hence the health endpoints are only added
+	// explicitly if additional paths are configured.
+	if len(k.values.AuthorizationAlwaysAllowPaths) > 0 {
+		options.AuthorizationAlwaysAllowPaths = append([]string{pathHealthz, "/livez", "/readyz"}, k.values.AuthorizationAlwaysAllowPaths...)
+	}
+
 	if versionutils.ConstraintK8sGreaterEqual127.Check(k.values.TargetVersion) {
-		nodeMonitorGracePeriod = metav1.Duration{Duration: 40 * time.Second}
+		nodeMonitorGracePeriod = 40 * time.Second
 	}
 
 	if !k.values.IsWorkerless {
 		if v := k.values.Config.NodeMonitorGracePeriod; v != nil {
-			nodeMonitorGracePeriod = *v
-		}
-		if k.values.Config.NodeCIDRMaskSize != nil {
-			command = append(command, fmt.Sprintf("--node-cidr-mask-size=%d", *k.values.Config.NodeCIDRMaskSize))
-		}
-
-		command = append(command,
-			"--allocate-node-cidrs=true",
-			"--attach-detach-reconcile-sync-period=1m0s",
-			fmt.Sprintf("--cluster-cidr=%s", k.values.PodNetwork.String()),
-			fmt.Sprintf("--cluster-signing-kubelet-client-cert-file=%s/%s", volumeMountPathCAClient, secrets.DataKeyCertificateCA),
-			fmt.Sprintf("--cluster-signing-kubelet-client-key-file=%s/%s", volumeMountPathCAClient, secrets.DataKeyPrivateKeyCA),
-			fmt.Sprintf("--cluster-signing-kubelet-serving-cert-file=%s/%s", volumeMountPathCAKubelet, secrets.DataKeyCertificateCA),
-			fmt.Sprintf("--cluster-signing-kubelet-serving-key-file=%s/%s", volumeMountPathCAKubelet, secrets.DataKeyPrivateKeyCA),
-			fmt.Sprintf("--horizontal-pod-autoscaler-downscale-stabilization=%s", defaultHorizontalPodAutoscalerConfig.DownscaleStabilization.Duration.String()),
-			fmt.Sprintf("--horizontal-pod-autoscaler-initial-readiness-delay=%s", defaultHorizontalPodAutoscalerConfig.InitialReadinessDelay.Duration.String()),
-			fmt.Sprintf("--horizontal-pod-autoscaler-cpu-initialization-period=%s", defaultHorizontalPodAutoscalerConfig.CPUInitializationPeriod.Duration.String()),
-			fmt.Sprintf("--horizontal-pod-autoscaler-sync-period=%s", defaultHorizontalPodAutoscalerConfig.SyncPeriod.Duration.String()),
-			fmt.Sprintf("--horizontal-pod-autoscaler-tolerance=%v", *defaultHorizontalPodAutoscalerConfig.Tolerance),
-			"--leader-elect=true",
-			fmt.Sprintf("--node-monitor-grace-period=%s", nodeMonitorGracePeriod.Duration),
-		)
+			nodeMonitorGracePeriod = v.Duration
+		}
+
+		k.setNodeCIDRMaskSizes(options)
+		options.AllocateNodeCIDRs = pointer.Bool(true)
+		options.AttachDetachReconcileSyncPeriod = pointer.Duration(time.Minute)
+		options.ClusterCIDRs = cidrStrings(k.values.PodNetworks)
+		options.ClusterSigningKubeletClientCertFile = fmt.Sprintf("%s/%s", volumeMountPathCAClient, secrets.DataKeyCertificateCA)
+		options.ClusterSigningKubeletClientKeyFile = fmt.Sprintf("%s/%s", volumeMountPathCAClient, secrets.DataKeyPrivateKeyCA)
+		options.ClusterSigningKubeletServingCertFile = fmt.Sprintf("%s/%s", volumeMountPathCAKubelet, secrets.DataKeyCertificateCA)
+		options.ClusterSigningKubeletServingKeyFile = fmt.Sprintf("%s/%s", volumeMountPathCAKubelet, secrets.DataKeyPrivateKeyCA)
+		options.HorizontalPodAutoscalerDownscaleStabilization = &defaultHorizontalPodAutoscalerConfig.DownscaleStabilization.Duration
+		options.HorizontalPodAutoscalerInitialReadinessDelay = &defaultHorizontalPodAutoscalerConfig.InitialReadinessDelay.Duration
+		options.HorizontalPodAutoscalerCPUInitializationPeriod = &defaultHorizontalPodAutoscalerConfig.CPUInitializationPeriod.Duration
+		options.HorizontalPodAutoscalerSyncPeriod = &defaultHorizontalPodAutoscalerConfig.SyncPeriod.Duration
+		options.HorizontalPodAutoscalerTolerance = defaultHorizontalPodAutoscalerConfig.Tolerance
+		options.LeaderElect = pointer.Bool(true)
+		options.NodeMonitorGracePeriod = &nodeMonitorGracePeriod
 
 		if versionutils.ConstraintK8sLess127.Check(k.values.TargetVersion) {
+			podEvictionTimeout := 2 * time.Minute
 			if v := k.values.Config.PodEvictionTimeout; v != nil {
-				podEvictionTimeout = *v
+				podEvictionTimeout = v.Duration
 			}
+			options.PodEvictionTimeout = &podEvictionTimeout
+		}
+
+		options.ConcurrentDeploymentSyncs = pointer.Int(pointer.IntDeref(k.values.ControllerWorkers.Deployment, defaultControllerWorkersDeployment))
+		options.ConcurrentReplicaSetSyncs = pointer.Int(pointer.IntDeref(k.values.ControllerWorkers.ReplicaSet, defaultControllerWorkersReplicaSet))
+		options.ConcurrentStatefulSetSyncs = pointer.Int(pointer.IntDeref(k.values.ControllerWorkers.StatefulSet, defaultControllerWorkersStatefulSet))
+	}
 
-			command = append(command, fmt.Sprintf("--pod-eviction-timeout=%s", podEvictionTimeout.Duration))
+	controllersToEnable, controllersToDisable := k.computeControllers()
+	options.Controllers = sets.List(controllersToEnable.Difference(controllersToDisable))
+	for _, controller := range sets.List(controllersToDisable) {
+		options.Controllers = append(options.Controllers, "-"+controller)
+	}
+
+	if v := pointer.IntDeref(k.values.ControllerWorkers.Namespace, defaultControllerWorkersNamespace); v != 0 {
+		options.ConcurrentNamespaceSyncs = &v
+	}
+
+	if v := pointer.IntDeref(k.values.ControllerWorkers.ResourceQuota, defaultControllerWorkersResourceQuota); v != 0 {
+		options.ConcurrentResourceQuotaSyncs = &v
+		options.ResourceQuotaSyncPeriod = k.values.ControllerSyncPeriods.ResourceQuota
+	}
+
+	if v := pointer.IntDeref(k.values.ControllerWorkers.ServiceAccountToken, defaultControllerWorkersServiceAccountToken); v != 0 {
+		options.ConcurrentServiceAccountTokenSyncs = &v
+	}
+
+	if k.values.Config != nil {
+		options.FeatureGates = k.values.Config.FeatureGates
+	}
+
+	if leaderElection := k.values.LeaderElection; leaderElection != nil {
+		options.LeaderElectLeaseDuration = leaderElection.LeaseDuration
+		options.LeaderElectRenewDeadline = leaderElection.RenewDeadline
+		options.LeaderElectRetryPeriod = leaderElection.RetryPeriod
+		options.LeaderElectResourceLock = pointer.StringDeref(leaderElection.ResourceLock, "")
+	}
+
+	options.ServiceClusterIPRanges = cidrStrings(k.values.ServiceNetworks)
+
+	if requestHeader := k.values.RequestHeader; requestHeader != nil {
+		options.RequestHeaderClientCAFile = fmt.Sprintf("%s/%s", volumeMountPathRequestHeaderCA, secrets.DataKeyCertificateCA)
+		options.RequestHeaderAllowedNames = requestHeader.AllowedNames
+		options.RequestHeaderUsernameHeaders = requestHeader.UsernameHeaders
+		options.RequestHeaderGroupHeaders = requestHeader.GroupHeaders
+		options.RequestHeaderExtraHeadersPrefixes = requestHeader.ExtraHeadersPrefixes
+	}
+
+	return options
+}
+
+// validateAuthenticationAndAuthorization validates the configured additional authorization always allow paths and the
+// request header authentication since invalid values would only be detected when kube-controller-manager starts.
+func (k *kubeControllerManager) validateAuthenticationAndAuthorization() error {
+	for _, path := range k.values.AuthorizationAlwaysAllowPaths {
+		if !strings.HasPrefix(path, "/") {
+			return fmt.Errorf("invalid authorization always allow path %q, must be absolute", path)
+		}
+		if strings.Contains(strings.TrimSuffix(path, "/*"), "*") {
+			return fmt.Errorf("invalid authorization always allow path %q, wildcards are only allowed as the last path segment", path)
 		}
+	}
 
-		command = append(command,
-			fmt.Sprintf("--concurrent-deployment-syncs=%d", pointer.IntDeref(k.values.ControllerWorkers.Deployment, defaultControllerWorkersDeployment)),
-			fmt.Sprintf("--concurrent-replicaset-syncs=%d", pointer.IntDeref(k.values.ControllerWorkers.ReplicaSet, defaultControllerWorkersReplicaSet)),
-			fmt.Sprintf("--concurrent-statefulset-syncs=%d", pointer.IntDeref(k.values.ControllerWorkers.StatefulSet, defaultControllerWorkersStatefulSet)),
-		)
-	} else {
+	if requestHeader := k.values.RequestHeader; requestHeader != nil && requestHeader.ClientCASecretName == "" {
+		return fmt.Errorf("the client CA secret name is required for request header authentication")
+	}
+
+	return nil
+}
+
+// validateLeaderElection validates the effective leader election configuration rendered into the given options since
+// invalid values would only be detected when kube-controller-manager starts.
+func (k *kubeControllerManager) validateLeaderElection(options *commandOptions) error {
+	var (
+		leaseDuration = pointer.DurationDeref(options.LeaderElectLeaseDuration, defaultLeaderElectLeaseDuration)
+		renewDeadline = pointer.DurationDeref(options.LeaderElectRenewDeadline, defaultLeaderElectRenewDeadline)
+		retryPeriod   = pointer.DurationDeref(options.LeaderElectRetryPeriod, defaultLeaderElectRetryPeriod)
+	)
+
+	if retryPeriod <= 0 {
+		return fmt.Errorf("invalid leader election retry period %s, must be positive", retryPeriod)
+	}
+	if renewDeadline >= leaseDuration {
+		return fmt.Errorf("invalid leader election renew deadline %s, must be less than the lease duration %s", renewDeadline, leaseDuration)
+	}
+	// kube-controller-manager requires the renew deadline to be greater than the retry period including its jitter.
+	if float64(renewDeadline) <= leaderelection.JitterFactor*float64(retryPeriod) {
+		return fmt.Errorf("invalid leader election renew deadline %s, must be greater than %v times the retry period %s", renewDeadline, leaderelection.JitterFactor, retryPeriod)
+	}
+
+	if resourceLock := options.LeaderElectResourceLock; resourceLock != "" {
+		supportedResourceLocks := sets.New(resourcelock.LeasesResourceLock)
+		if !versionutils.ConstraintK8sGreaterEqual128.Check(k.values.TargetVersion) {
+			supportedResourceLocks.Insert("endpointsleases", "configmapsleases")
+		}
+
+		if !supportedResourceLocks.Has(resourceLock) {
+			return fmt.Errorf("unsupported leader election resource lock %q, supported are %s", resourceLock, strings.Join(sets.List(supportedResourceLocks), ", "))
+		}
+	}
+
+	return nil
+}
+
+// computeControllers computes the controllers which are explicitly enabled and disabled via the `--controllers` flag.
+// The RBAC report uses the same computation to determine the controllers which are effectively running.
+func (k *kubeControllerManager) computeControllers() (enabled, disabled sets.Set[string]) {
+	enabled = sets.New("*", "bootstrapsigner", "tokencleaner")
+	disabled = sets.New[string]()
+
+	if k.values.IsWorkerless {
 		if v := pointer.IntDeref(k.values.ControllerWorkers.Namespace, defaultControllerWorkersNamespace); v == 0 {
-			controllersToDisable.Insert("namespace")
+			disabled.Insert("namespace")
 		}
 
 		if v := pointer.IntDeref(k.values.ControllerWorkers.ServiceAccountToken, defaultControllerWorkersServiceAccountToken); v == 0 {
-			controllersToDisable.Insert("serviceaccount-token")
+			disabled.Insert("serviceaccount-token")
 		}
 
 		if v := pointer.IntDeref(k.values.ControllerWorkers.ResourceQuota, defaultControllerWorkersResourceQuota); v == 0 {
-			controllersToDisable.Insert("resourcequota")
+			disabled.Insert("resourcequota")
 		}
 
-		controllersToDisable.Insert(
+		disabled.Insert(
 			"nodeipam",
 			"nodelifecycle",
 			"cloud-node-lifecycle",
@@ -711,79 +1308,62 @@ func (k *kubeControllerManager) computeCommand(port int32) []string {
 		)
 	}
 
-	command = append(command,
-		fmt.Sprintf("--cluster-name=%s", k.namespace),
-		fmt.Sprintf("--cluster-signing-kube-apiserver-client-cert-file=%s/%s", volumeMountPathCAClient, secrets.DataKeyCertificateCA),
-		fmt.Sprintf("--cluster-signing-kube-apiserver-client-key-file=%s/%s", volumeMountPathCAClient, secrets.DataKeyPrivateKeyCA),
-		fmt.Sprintf("--cluster-signing-legacy-unknown-cert-file=%s/%s", volumeMountPathCAClient, secrets.DataKeyCertificateCA),
-		fmt.Sprintf("--cluster-signing-legacy-unknown-key-file=%s/%s", volumeMountPathCAClient, secrets.DataKeyPrivateKeyCA),
-		"--cluster-signing-duration="+pointer.DurationDeref(k.values.ClusterSigningDuration, 720*time.Hour).String(),
-		fmt.Sprintf("--concurrent-endpoint-syncs=%d", pointer.IntDeref(k.values.ControllerWorkers.Endpoint, defaultControllerWorkersEndpoint)),
-		fmt.Sprintf("--concurrent-gc-syncs=%d", pointer.IntDeref(k.values.ControllerWorkers.GarbageCollector, defaultControllerWorkersGarbageCollector)),
-		fmt.Sprintf("--concurrent-service-endpoint-syncs=%d", pointer.IntDeref(k.values.ControllerWorkers.ServiceEndpoint, defaultControllerWorkersServiceEndpoint)),
-	)
-
-	for api, enabled := range k.values.RuntimeConfig {
-		if enabled {
+	for api, apiEnabled := range k.values.RuntimeConfig {
+		if apiEnabled {
 			continue
 		}
 
 		if controllerVersionRange, present := kubernetesutils.APIGroupControllerMap[getTrimmedAPI(api)]; present {
 			for controller, versionRange := range controllerVersionRange {
 				if contains, err := versionRange.Contains(k.values.TargetVersion.String()); err == nil && contains {
-					controllersToDisable.Insert(controller)
+					disabled.Insert(controller)
 				}
 			}
 		}
 	}
 
-	cmdControllers := "--controllers=" + strings.Join(sets.List(controllersToEnable.Difference(controllersToDisable)), ",")
-	if controllersToDisable.Len() > 0 {
-		cmdControllers += ",-" + strings.Join(sets.List(controllersToDisable), ",-")
-	}
-	command = append(command, cmdControllers)
+	return enabled, disabled
+}
 
-	if v := pointer.IntDeref(k.values.ControllerWorkers.Namespace, defaultControllerWorkersNamespace); v != 0 {
-		command = append(command, fmt.Sprintf("--concurrent-namespace-syncs=%d", v))
+// setNodeCIDRMaskSizes sets the node CIDR mask sizes. kube-controller-manager does not accept `--node-cidr-mask-size`
+// for dual-stack clusters, hence the mask sizes are set per IP family in this case. The configured mask size applies to
+// the primary IP family, the other IP family uses the default mask size.
+func (k *kubeControllerManager) setNodeCIDRMaskSizes(options *commandOptions) {
+	if !isDualStack(k.values.PodNetworks) {
+		options.NodeCIDRMaskSize = k.values.Config.NodeCIDRMaskSize
+		return
 	}
 
-	if v := pointer.IntDeref(k.values.ControllerWorkers.ResourceQuota, defaultControllerWorkersResourceQuota); v != 0 {
-		command = append(command, fmt.Sprintf("--concurrent-resource-quota-syncs=%d", v))
-		if k.values.ControllerSyncPeriods.ResourceQuota != nil {
-			command = append(command, "--resource-quota-sync-period="+k.values.ControllerSyncPeriods.ResourceQuota.String())
-		}
-	}
+	options.NodeCIDRMaskSizeIPv4 = pointer.Int32(defaultNodeCIDRMaskSizeIPv4)
+	options.NodeCIDRMaskSizeIPv6 = pointer.Int32(defaultNodeCIDRMaskSizeIPv6)
 
-	if v := pointer.IntDeref(k.values.ControllerWorkers.ServiceAccountToken, defaultControllerWorkersServiceAccountToken); v != 0 {
-		command = append(command, fmt.Sprintf("--concurrent-serviceaccount-token-syncs=%d", v))
+	if size := k.values.Config.NodeCIDRMaskSize; size != nil {
+		if k.values.PodNetworks[0].IP.To4() != nil {
+			options.NodeCIDRMaskSizeIPv4 = size
+		} else {
+			options.NodeCIDRMaskSizeIPv6 = size
+		}
 	}
+}
 
-	if k.values.Config != nil && len(k.values.Config.FeatureGates) > 0 {
-		command = append(command, kubernetesutils.FeatureGatesToCommandLineParameter(k.values.Config.FeatureGates))
+func isDualStack(networks []net.IPNet) bool {
+	var hasIPv4, hasIPv6 bool
+	for _, network := range networks {
+		if network.IP.To4() != nil {
+			hasIPv4 = true
+		} else {
+			hasIPv6 = true
+		}
 	}
+	return hasIPv4 && hasIPv6
+}
 
-	command = append(command,
-		fmt.Sprintf("--root-ca-file=%s/%s", volumeMountPathCA, secrets.DataKeyCertificateBundle),
-		fmt.Sprintf("--service-account-private-key-file=%s/%s", volumeMountPathServiceAccountKey, secrets.DataKeyRSAPrivateKey),
-		fmt.Sprintf("--secure-port=%d", port),
-	)
-
-	if k.values.ServiceNetwork != nil {
-		command = append(command,
-			fmt.Sprintf("--service-cluster-ip-range=%s", k.values.ServiceNetwork.String()),
-		)
+func cidrStrings(networks []net.IPNet) []string {
+	var cidrs []string
+	for _, network := range networks {
+		cidrs = append(cidrs, network.String())
 	}
-
-	command = append(command,
-		"--profiling=false",
-		fmt.Sprintf("--tls-cert-file=%s/%s", volumeMountPathServer, secrets.DataKeyCertificate),
-		fmt.Sprintf("--tls-private-key-file=%s/%s", volumeMountPathServer, secrets.DataKeyPrivateKey),
-		fmt.Sprintf("--tls-cipher-suites=%s", strings.Join(kubernetesutils.TLSCipherSuites, ",")),
-		"--use-service-account-credentials=true",
-		"--v=2",
-	)
-
-	return command
+	return cidrs
 }
 
 func (k *kubeControllerManager) getHorizontalPodAutoscalerConfig() gardencorev1beta1.HorizontalPodAutoscalerConfig {
@@ -16,8 +16,13 @@ package kubecontrollermanager_test
 
 import (
 	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"errors"
 	"fmt"
 	"net"
+	"os"
+	"path/filepath"
 	"strings"
 	"time"
 
@@ -31,28 +36,36 @@ import (
 	autoscalingv2beta1 "k8s.io/api/autoscaling/v2beta1"
 	corev1 "k8s.io/api/core/v1"
 	policyv1 "k8s.io/api/policy/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/intstr"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	vpaautoscalingv1 "k8s.io/autoscaler/vertical-pod-autoscaler/pkg/apis/autoscaling.k8s.io/v1"
+	"k8s.io/client-go/tools/record"
 	"k8s.io/utils/pointer"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+	"sigs.k8s.io/yaml"
 
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
 	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
 	"github.com/gardener/gardener/pkg/client/kubernetes"
 	kubernetesfake "github.com/gardener/gardener/pkg/client/kubernetes/fake"
 	. "github.com/gardener/gardener/pkg/component/kubecontrollermanager"
+	componentmetrics "github.com/gardener/gardener/pkg/component/metrics"
 	"github.com/gardener/gardener/pkg/resourcemanager/controller/garbagecollector/references"
+	"github.com/gardener/gardener/pkg/utils"
 	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
 	kubernetesutils "github.com/gardener/gardener/pkg/utils/kubernetes"
 	secretsmanager "github.com/gardener/gardener/pkg/utils/secrets/manager"
 	fakesecretsmanager "github.com/gardener/gardener/pkg/utils/secrets/manager/fake"
 	"github.com/gardener/gardener/pkg/utils/test"
 	. "github.com/gardener/gardener/pkg/utils/test/matchers"
+	"github.com/gardener/gardener/pkg/utils/validation/kubernetesversion"
 	versionutils "github.com/gardener/gardener/pkg/utils/version"
 )
 
@@ -575,8 +588,8 @@ namespace: kube-system
 			PriorityClassName: priorityClassName,
 			HVPAConfig:        hvpaConfigDisabled,
 			IsWorkerless:      isWorkerless,
-			PodNetwork:        podCIDR,
-			ServiceNetwork:    serviceCIDR,
+			PodNetworks:       []net.IPNet{*podCIDR},
+			ServiceNetworks:   []net.IPNet{*serviceCIDR},
 		}
 		kubeControllerManager = New(
 			testLogger,
@@ -702,8 +715,8 @@ namespace: kube-system
 					PriorityClassName:      priorityClassName,
 					HVPAConfig:             hvpaConfig,
 					IsWorkerless:           isWorkerless,
-					PodNetwork:             podCIDR,
-					ServiceNetwork:         serviceCIDR,
+					PodNetworks:            []net.IPNet{*podCIDR},
+					ServiceNetworks:        []net.IPNet{*serviceCIDR},
 					ClusterSigningDuration: clusterSigningDuration,
 					ControllerWorkers:      controllerWorkers,
 					ControllerSyncPeriods:  controllerSyncPeriods,
@@ -740,8 +753,8 @@ namespace: kube-system
 					PriorityClassName:      priorityClassName,
 					HVPAConfig:             hvpaConfig,
 					IsWorkerless:           isWorkerless,
-					PodNetwork:             podCIDR,
-					ServiceNetwork:         serviceCIDR,
+					PodNetworks:            []net.IPNet{*podCIDR},
+					ServiceNetworks:        []net.IPNet{*serviceCIDR},
 					ClusterSigningDuration: clusterSigningDuration,
 					ControllerWorkers:      controllerWorkers,
 					ControllerSyncPeriods:  controllerSyncPeriods,
@@ -777,8 +790,8 @@ namespace: kube-system
 					Config:                 config,
 					PriorityClassName:      priorityClassName,
 					IsWorkerless:           workerless,
-					PodNetwork:             podCIDR,
-					ServiceNetwork:         serviceCIDR,
+					PodNetworks:            []net.IPNet{*podCIDR},
+					ServiceNetworks:        []net.IPNet{*serviceCIDR},
 					ClusterSigningDuration: clusterSigningDuration,
 					ControllerWorkers:      controllerWorkers,
 					ControllerSyncPeriods:  controllerSyncPeriods,
@@ -876,6 +889,925 @@ namespace: kube-system
 				"--controllers=*,bootstrapsigner,tokencleaner,-clusterrole-aggregation,-endpointslice,-endpointslicemirroring,-resource-claim-controller,-storage-version-gc",
 			),
 		)
+
+		Context("with event recorder", func() {
+			var recorder *record.FakeRecorder
+
+			BeforeEach(func() {
+				recorder = record.NewFakeRecorder(10)
+				values.Recorder = recorder
+			})
+
+			JustBeforeEach(func() {
+				kubeControllerManager = New(testLogger, fakeInterface, namespace, sm, values)
+				kubeControllerManager.SetReplicaCount(1)
+			})
+
+			It("should record an event when the version is deployed", func() {
+				Expect(kubeControllerManager.Deploy(ctx)).To(Succeed())
+
+				Expect(recorder.Events).To(HaveLen(1))
+				Expect(<-recorder.Events).To(Equal("Normal Deployed Deployed kube-controller-manager version 1.27.3 with 1 replica(s)"))
+			})
+
+			It("should not record events if nothing changed", func() {
+				Expect(kubeControllerManager.Deploy(ctx)).To(Succeed())
+				<-recorder.Events
+
+				Expect(kubeControllerManager.Deploy(ctx)).To(Succeed())
+				Expect(recorder.Events).To(BeEmpty())
+			})
+
+			It("should record an event when the replicas are changed", func() {
+				Expect(kubeControllerManager.Deploy(ctx)).To(Succeed())
+				<-recorder.Events
+
+				kubeControllerManager.SetReplicaCount(0)
+				Expect(kubeControllerManager.Deploy(ctx)).To(Succeed())
+
+				Expect(recorder.Events).To(HaveLen(1))
+				Expect(<-recorder.Events).To(Equal("Normal Scaled Scaled kube-controller-manager from 1 to 0 replica(s)"))
+			})
+
+			It("should record an event when the deployment fails", func() {
+				values.MetricsPort = pointer.Int32(0)
+
+				kubeControllerManager = New(testLogger, fakeInterface, namespace, sm, values)
+				Expect(kubeControllerManager.Deploy(ctx)).To(MatchError(ContainSubstring("invalid metrics port")))
+
+				Expect(recorder.Events).To(HaveLen(1))
+				Expect(<-recorder.Events).To(Equal("Warning DeployFailed Failed deploying kube-controller-manager: invalid metrics port 0, must be between 1 and 65535"))
+			})
+		})
+
+		Context("with leader election config", func() {
+			var deployment *appsv1.Deployment
+
+			BeforeEach(func() {
+				values.LeaderElection = &LeaderElection{
+					LeaseDuration: pointer.Duration(60 * time.Second),
+					RenewDeadline: pointer.Duration(40 * time.Second),
+					RetryPeriod:   pointer.Duration(5 * time.Second),
+					ResourceLock:  pointer.String("leases"),
+				}
+
+				deployment = &appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: "kube-controller-manager", Namespace: namespace}}
+			})
+
+			JustBeforeEach(func() {
+				kubeControllerManager = New(testLogger, fakeInterface, namespace, sm, values)
+				kubeControllerManager.SetReplicaCount(1)
+			})
+
+			It("should render the leader election flags", func() {
+				Expect(kubeControllerManager.Deploy(ctx)).To(Succeed())
+
+				Expect(c.Get(ctx, client.ObjectKeyFromObject(deployment), deployment)).To(Succeed())
+				Expect(deployment.Spec.Template.Spec.Containers[0].Command).To(ContainElements(
+					"--leader-elect-lease-duration=1m0s",
+					"--leader-elect-renew-deadline=40s",
+					"--leader-elect-retry-period=5s",
+					"--leader-elect-resource-lock=leases",
+				))
+			})
+
+			It("should fail if the renew deadline is not less than the lease duration", func() {
+				values.LeaderElection.RenewDeadline = pointer.Duration(60 * time.Second)
+
+				Expect(kubeControllerManager.Deploy(ctx)).To(MatchError("invalid leader election renew deadline 1m0s, must be less than the lease duration 1m0s"))
+			})
+
+			It("should fail if the renew deadline is not greater than the retry period including its jitter", func() {
+				values.LeaderElection.LeaseDuration = nil
+				values.LeaderElection.RenewDeadline = nil
+				values.LeaderElection.RetryPeriod = pointer.Duration(9 * time.Second)
+
+				Expect(kubeControllerManager.Deploy(ctx)).To(MatchError("invalid leader election renew deadline 10s, must be greater than 1.2 times the retry period 9s"))
+			})
+
+			It("should fail if the retry period is not positive", func() {
+				values.LeaderElection.RetryPeriod = pointer.Duration(0)
+
+				Expect(kubeControllerManager.Deploy(ctx)).To(MatchError("invalid leader election retry period 0s, must be positive"))
+			})
+
+			It("should allow the migration resource locks for Kubernetes < 1.28", func() {
+				values.LeaderElection.ResourceLock = pointer.String("endpointsleases")
+
+				Expect(kubeControllerManager.Deploy(ctx)).To(Succeed())
+			})
+
+			It("should fail for an unsupported resource lock", func() {
+				values.LeaderElection.ResourceLock = pointer.String("endpointsleases")
+				values.TargetVersion = semver.MustParse("1.28.2")
+
+				kubeControllerManager = New(testLogger, fakeInterface, namespace, sm, values)
+				kubeControllerManager.SetReplicaCount(1)
+				Expect(kubeControllerManager.Deploy(ctx)).To(MatchError(`unsupported leader election resource lock "endpointsleases", supported are leases`))
+			})
+		})
+
+		Context("high availability", func() {
+			It("should label the deployment for the high-availability-config webhook", func() {
+				kubeControllerManager = New(testLogger, fakeInterface, namespace, sm, values)
+				kubeControllerManager.SetReplicaCount(1)
+
+				Expect(kubeControllerManager.Deploy(ctx)).To(Succeed())
+
+				deployment := &appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: "kube-controller-manager", Namespace: namespace}}
+				Expect(c.Get(ctx, client.ObjectKeyFromObject(deployment), deployment)).To(Succeed())
+				Expect(deployment.Labels).To(HaveKeyWithValue("high-availability-config.resources.gardener.cloud/type", "controller"))
+				Expect(deployment.Spec.Template.Spec.Containers[0].Command).NotTo(ContainElement("--leader-elect=false"))
+			})
+		})
+
+		Context("with client connection config", func() {
+			var deployment *appsv1.Deployment
+
+			BeforeEach(func() {
+				kubeControllerManager.SetReplicaCount(1)
+				deployment = &appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: "kube-controller-manager", Namespace: namespace}}
+			})
+
+			It("should not configure client-side rate limits by default", func() {
+				Expect(kubeControllerManager.Deploy(ctx)).To(Succeed())
+
+				Expect(c.Get(ctx, client.ObjectKeyFromObject(deployment), deployment)).To(Succeed())
+				Expect(deployment.Spec.Template.Spec.Containers[0].Command).NotTo(ContainElement(HavePrefix("--kube-api-")))
+			})
+
+			It("should configure the client-side rate limits", func() {
+				values.ClientConnection = ClientConnection{
+					QPS:   pointer.Float32(100),
+					Burst: pointer.Int32(150),
+				}
+				kubeControllerManager = New(testLogger, fakeInterface, namespace, sm, values)
+				kubeControllerManager.SetReplicaCount(1)
+
+				Expect(kubeControllerManager.Deploy(ctx)).To(Succeed())
+
+				Expect(c.Get(ctx, client.ObjectKeyFromObject(deployment), deployment)).To(Succeed())
+				Expect(deployment.Spec.Template.Spec.Containers[0].Command).To(ContainElements(
+					"--kube-api-qps=100",
+					"--kube-api-burst=150",
+				))
+			})
+		})
+
+		Context("metrics port", func() {
+			var (
+				deployment *appsv1.Deployment
+				service    *corev1.Service
+			)
+
+			BeforeEach(func() {
+				deployment = &appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: "kube-controller-manager", Namespace: namespace}}
+				service = &corev1.Service{ObjectMeta: metav1.ObjectMeta{Name: "kube-controller-manager", Namespace: namespace}}
+			})
+
+			It("should use the configured metrics port for serving, probing, and scraping", func() {
+				values.MetricsPort = pointer.Int32(10258)
+				kubeControllerManager = New(testLogger, fakeInterface, namespace, sm, values)
+				kubeControllerManager.SetReplicaCount(1)
+
+				Expect(kubeControllerManager.Deploy(ctx)).To(Succeed())
+
+				Expect(c.Get(ctx, client.ObjectKeyFromObject(deployment), deployment)).To(Succeed())
+				container := deployment.Spec.Template.Spec.Containers[0]
+				Expect(container.Command).To(ContainElement("--secure-port=10258"))
+				Expect(container.LivenessProbe.HTTPGet.Port).To(Equal(intstr.FromInt32(10258)))
+				Expect(container.LivenessProbe.HTTPGet.Path).To(Equal("/healthz"))
+				Expect(container.Ports).To(ConsistOf(corev1.ContainerPort{Name: "metrics", ContainerPort: 10258, Protocol: corev1.ProtocolTCP}))
+
+				Expect(c.Get(ctx, client.ObjectKeyFromObject(service), service)).To(Succeed())
+				Expect(service.Spec.Ports).To(ConsistOf(HaveField("Port", int32(10258))))
+				Expect(service.Annotations).To(HaveKeyWithValue("networking.resources.gardener.cloud/from-all-scrape-targets-allowed-ports", `[{"protocol":"TCP","port":10258}]`))
+			})
+
+			It("should use the configured liveness probe parameters", func() {
+				values.LivenessProbe = LivenessProbe{
+					InitialDelaySeconds: pointer.Int32(30),
+					PeriodSeconds:       pointer.Int32(20),
+					TimeoutSeconds:      pointer.Int32(5),
+					FailureThreshold:    pointer.Int32(4),
+				}
+				kubeControllerManager = New(testLogger, fakeInterface, namespace, sm, values)
+				kubeControllerManager.SetReplicaCount(1)
+
+				Expect(kubeControllerManager.Deploy(ctx)).To(Succeed())
+
+				Expect(c.Get(ctx, client.ObjectKeyFromObject(deployment), deployment)).To(Succeed())
+				probe := deployment.Spec.Template.Spec.Containers[0].LivenessProbe
+				Expect(probe.HTTPGet).NotTo(BeNil())
+				Expect(probe.InitialDelaySeconds).To(Equal(int32(30)))
+				Expect(probe.PeriodSeconds).To(Equal(int32(20)))
+				Expect(probe.TimeoutSeconds).To(Equal(int32(5)))
+				Expect(probe.FailureThreshold).To(Equal(int32(4)))
+				Expect(probe.SuccessThreshold).To(Equal(int32(1)))
+			})
+
+			It("should use an exec liveness probe if a command is configured", func() {
+				command := []string{"wget", "--no-check-certificate", "-q", "-O", "/dev/null", "https://localhost:10257/healthz"}
+				values.LivenessProbe = LivenessProbe{ExecCommand: command}
+				kubeControllerManager = New(testLogger, fakeInterface, namespace, sm, values)
+				kubeControllerManager.SetReplicaCount(1)
+
+				Expect(kubeControllerManager.Deploy(ctx)).To(Succeed())
+
+				Expect(c.Get(ctx, client.ObjectKeyFromObject(deployment), deployment)).To(Succeed())
+				probe := deployment.Spec.Template.Spec.Containers[0].LivenessProbe
+				Expect(probe.HTTPGet).To(BeNil())
+				Expect(probe.Exec).To(Equal(&corev1.ExecAction{Command: command}))
+				Expect(probe.InitialDelaySeconds).To(Equal(int32(15)))
+				Expect(probe.PeriodSeconds).To(Equal(int32(10)))
+				Expect(probe.TimeoutSeconds).To(Equal(int32(15)))
+				Expect(probe.FailureThreshold).To(Equal(int32(2)))
+			})
+
+			It("should fail for invalid liveness probe parameters", func() {
+				values.LivenessProbe = LivenessProbe{PeriodSeconds: pointer.Int32(0)}
+				kubeControllerManager = New(testLogger, fakeInterface, namespace, sm, values)
+				kubeControllerManager.SetReplicaCount(1)
+
+				Expect(kubeControllerManager.Deploy(ctx)).To(MatchError(ContainSubstring("invalid liveness probe period 0")))
+			})
+
+			It("should fail for an invalid metrics port", func() {
+				values.MetricsPort = pointer.Int32(70000)
+				kubeControllerManager = New(testLogger, fakeInterface, namespace, sm, values)
+				kubeControllerManager.SetReplicaCount(1)
+
+				Expect(kubeControllerManager.Deploy(ctx)).To(MatchError(ContainSubstring("invalid metrics port")))
+			})
+		})
+
+		Context("dependency-watchdog", func() {
+			var deployment *appsv1.Deployment
+
+			BeforeEach(func() {
+				deployment = &appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: "kube-controller-manager", Namespace: namespace}}
+			})
+
+			It("should not annotate the deployment by default", func() {
+				kubeControllerManager = New(testLogger, fakeInterface, namespace, sm, values)
+				kubeControllerManager.SetReplicaCount(1)
+
+				Expect(kubeControllerManager.Deploy(ctx)).To(Succeed())
+
+				Expect(c.Get(ctx, client.ObjectKeyFromObject(deployment), deployment)).To(Succeed())
+				Expect(deployment.Annotations).NotTo(HaveKey("dependency-watchdog.gardener.cloud/ignore-scaling"))
+			})
+
+			It("should add and remove the annotation disabling the scaling", func() {
+				values.DependencyWatchdogScalingDisabled = true
+				kubeControllerManager = New(testLogger, fakeInterface, namespace, sm, values)
+				kubeControllerManager.SetReplicaCount(1)
+
+				Expect(kubeControllerManager.Deploy(ctx)).To(Succeed())
+
+				Expect(c.Get(ctx, client.ObjectKeyFromObject(deployment), deployment)).To(Succeed())
+				Expect(deployment.Annotations).To(HaveKeyWithValue("dependency-watchdog.gardener.cloud/ignore-scaling", "true"))
+
+				values.DependencyWatchdogScalingDisabled = false
+				kubeControllerManager = New(testLogger, fakeInterface, namespace, sm, values)
+				kubeControllerManager.SetReplicaCount(1)
+
+				Expect(kubeControllerManager.Deploy(ctx)).To(Succeed())
+
+				Expect(c.Get(ctx, client.ObjectKeyFromObject(deployment), deployment)).To(Succeed())
+				Expect(deployment.Annotations).NotTo(HaveKey("dependency-watchdog.gardener.cloud/ignore-scaling"))
+			})
+		})
+
+		Context("authentication and authorization of the metrics endpoint", func() {
+			var deployment *appsv1.Deployment
+
+			BeforeEach(func() {
+				deployment = &appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: "kube-controller-manager", Namespace: namespace}}
+			})
+
+			It("should allow the additional paths without authorization", func() {
+				values.AuthorizationAlwaysAllowPaths = []string{"/metrics", "/debug/*"}
+				kubeControllerManager = New(testLogger, fakeInterface, namespace, sm, values)
+				kubeControllerManager.SetReplicaCount(1)
+
+				Expect(kubeControllerManager.Deploy(ctx)).To(Succeed())
+
+				Expect(c.Get(ctx, client.ObjectKeyFromObject(deployment), deployment)).To(Succeed())
+				Expect(deployment.Spec.Template.Spec.Containers[0].Command).To(ContainElement("--authorization-always-allow-paths=/healthz,/livez,/readyz,/metrics,/debug/*"))
+			})
+
+			It("should configure the request header authentication", func() {
+				values.RequestHeader = &RequestHeader{
+					ClientCASecretName:   "front-proxy-ca",
+					AllowedNames:         []string{"front-proxy", "prometheus-proxy"},
+					UsernameHeaders:      []string{"X-Remote-User"},
+					GroupHeaders:         []string{"X-Remote-Group"},
+					ExtraHeadersPrefixes: []string{"X-Remote-Extra-"},
+				}
+				kubeControllerManager = New(testLogger, fakeInterface, namespace, sm, values)
+				kubeControllerManager.SetReplicaCount(1)
+
+				Expect(kubeControllerManager.Deploy(ctx)).To(Succeed())
+
+				Expect(c.Get(ctx, client.ObjectKeyFromObject(deployment), deployment)).To(Succeed())
+				container := deployment.Spec.Template.Spec.Containers[0]
+				Expect(container.Command).To(ContainElements(
+					"--requestheader-client-ca-file=/srv/kubernetes/requestheader-client-ca/ca.crt",
+					"--requestheader-allowed-names=front-proxy,prometheus-proxy",
+					"--requestheader-username-headers=X-Remote-User",
+					"--requestheader-group-headers=X-Remote-Group",
+					"--requestheader-extra-headers-prefix=X-Remote-Extra-",
+				))
+				Expect(container.VolumeMounts).To(ContainElement(corev1.VolumeMount{Name: "requestheader-client-ca", MountPath: "/srv/kubernetes/requestheader-client-ca"}))
+				Expect(deployment.Spec.Template.Spec.Volumes).To(ContainElement(corev1.Volume{
+					Name:         "requestheader-client-ca",
+					VolumeSource: corev1.VolumeSource{Secret: &corev1.SecretVolumeSource{SecretName: "front-proxy-ca"}},
+				}))
+			})
+
+			It("should not render the request header flags if request header authentication is not configured", func() {
+				kubeControllerManager = New(testLogger, fakeInterface, namespace, sm, values)
+				kubeControllerManager.SetReplicaCount(1)
+
+				Expect(kubeControllerManager.Deploy(ctx)).To(Succeed())
+
+				Expect(c.Get(ctx, client.ObjectKeyFromObject(deployment), deployment)).To(Succeed())
+				Expect(deployment.Spec.Template.Spec.Containers[0].Command).NotTo(ContainElement(HavePrefix("--requestheader-")))
+			})
+
+			It("should fail for a relative authorization always allow path", func() {
+				values.AuthorizationAlwaysAllowPaths = []string{"metrics"}
+				kubeControllerManager = New(testLogger, fakeInterface, namespace, sm, values)
+				kubeControllerManager.SetReplicaCount(1)
+
+				Expect(kubeControllerManager.Deploy(ctx)).To(MatchError(ContainSubstring(`invalid authorization always allow path "metrics"`)))
+			})
+
+			It("should fail for a wildcard which is not the last path segment", func() {
+				values.AuthorizationAlwaysAllowPaths = []string{"/debug/*/pprof"}
+				kubeControllerManager = New(testLogger, fakeInterface, namespace, sm, values)
+				kubeControllerManager.SetReplicaCount(1)
+
+				Expect(kubeControllerManager.Deploy(ctx)).To(MatchError(ContainSubstring("wildcards are only allowed as the last path segment")))
+			})
+
+			It("should fail if the client CA secret name is missing", func() {
+				values.RequestHeader = &RequestHeader{UsernameHeaders: []string{"X-Remote-User"}}
+				kubeControllerManager = New(testLogger, fakeInterface, namespace, sm, values)
+				kubeControllerManager.SetReplicaCount(1)
+
+				Expect(kubeControllerManager.Deploy(ctx)).To(MatchError(ContainSubstring("client CA secret name is required")))
+			})
+
+			It("should sign tokens with the current key of the externally managed service account key bundle", func() {
+				currentKey, err := rsa.GenerateKey(rand.Reader, 2048)
+				Expect(err).NotTo(HaveOccurred())
+				oldKey, err := rsa.GenerateKey(rand.Reader, 2048)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(c.Create(ctx, &corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:        "sa-key-bundle",
+						Namespace:   namespace,
+						Annotations: map[string]string{"credentials.gardener.cloud/current-key-version": "v2"},
+					},
+					Data: map[string][]byte{
+						"v1": utils.EncodePrivateKey(oldKey),
+						"v2": utils.EncodePrivateKey(currentKey),
+					},
+				})).To(Succeed())
+
+				values.ServiceAccountKeyBundleSecretName = "sa-key-bundle"
+				kubeControllerManager = New(testLogger, fakeInterface, namespace, sm, values)
+				kubeControllerManager.SetReplicaCount(1)
+
+				Expect(kubeControllerManager.Deploy(ctx)).To(Succeed())
+
+				Expect(c.Get(ctx, client.ObjectKeyFromObject(deployment), deployment)).To(Succeed())
+				container := deployment.Spec.Template.Spec.Containers[0]
+				Expect(container.Command).To(ContainElement("--service-account-private-key-file=/srv/kubernetes/service-account-key-bundle/v2"))
+				Expect(container.VolumeMounts).To(ContainElement(corev1.VolumeMount{Name: "service-account-key", MountPath: "/srv/kubernetes/service-account-key-bundle"}))
+				Expect(deployment.Spec.Template.Spec.Volumes).To(ContainElement(corev1.Volume{
+					Name:         "service-account-key",
+					VolumeSource: corev1.VolumeSource{Secret: &corev1.SecretVolumeSource{SecretName: "sa-key-bundle", DefaultMode: pointer.Int32(0640)}},
+				}))
+			})
+
+			It("should fail if the service account key bundle does not contain the current version", func() {
+				Expect(c.Create(ctx, &corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:        "sa-key-bundle",
+						Namespace:   namespace,
+						Annotations: map[string]string{"credentials.gardener.cloud/current-key-version": "v2"},
+					},
+				})).To(Succeed())
+
+				values.ServiceAccountKeyBundleSecretName = "sa-key-bundle"
+				kubeControllerManager = New(testLogger, fakeInterface, namespace, sm, values)
+				kubeControllerManager.SetReplicaCount(1)
+
+				Expect(kubeControllerManager.Deploy(ctx)).To(MatchError(ContainSubstring(`does not contain the current key version "v2"`)))
+			})
+		})
+
+		Context("separate auth delegation kubeconfig", func() {
+			var (
+				deployment   *appsv1.Deployment
+				accessSecret *corev1.Secret
+			)
+
+			BeforeEach(func() {
+				deployment = &appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: "kube-controller-manager", Namespace: namespace}}
+				accessSecret = &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "shoot-access-kube-controller-manager-auth-delegator", Namespace: namespace}}
+
+				genericTokenKubeconfigSecret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: genericTokenKubeconfigSecretName, Namespace: namespace}}
+				Expect(c.Get(ctx, client.ObjectKeyFromObject(genericTokenKubeconfigSecret), genericTokenKubeconfigSecret)).To(Succeed())
+				genericTokenKubeconfigSecret.Data = map[string][]byte{"kubeconfig": []byte(`apiVersion: v1
+kind: Config
+clusters:
+- name: shoot
+  cluster:
+    server: https://kube-apiserver
+    certificate-authority-data: Y2EtZGF0YQ==
+contexts:
+- name: shoot
+  context:
+    cluster: shoot
+    user: shoot
+current-context: shoot
+users:
+- name: shoot
+  user:
+    tokenFile: /var/run/secrets/gardener.cloud/shoot/generic-kubeconfig/token
+`)}
+				Expect(c.Update(ctx, genericTokenKubeconfigSecret)).To(Succeed())
+
+				values.SeparateAuthDelegationKubeconfig = true
+				kubeControllerManager = New(testLogger, fakeInterface, namespace, sm, values)
+				kubeControllerManager.SetReplicaCount(1)
+			})
+
+			It("should use a kubeconfig bound to system:auth-delegator for the authentication and authorization", func() {
+				Expect(kubeControllerManager.Deploy(ctx)).To(Succeed())
+
+				Expect(c.Get(ctx, client.ObjectKeyFromObject(accessSecret), accessSecret)).To(Succeed())
+				Expect(accessSecret.Annotations).To(HaveKeyWithValue("serviceaccount.resources.gardener.cloud/name", "kube-controller-manager-auth-delegator"))
+				Expect(accessSecret.Annotations).To(HaveKeyWithValue("serviceaccount.resources.gardener.cloud/namespace", "kube-system"))
+
+				secretList := &corev1.SecretList{}
+				Expect(c.List(ctx, secretList, client.InNamespace(namespace), client.MatchingLabels{"name": "kube-controller-manager-auth-delegation-kubeconfig"})).To(Succeed())
+				Expect(secretList.Items).To(HaveLen(1))
+				authDelegationKubeconfigSecret := &secretList.Items[0]
+				Expect(string(authDelegationKubeconfigSecret.Data["kubeconfig"])).To(And(
+					ContainSubstring("server: https://kube-apiserver"),
+					ContainSubstring("tokenFile: /var/run/secrets/gardener.cloud/shoot/auth-delegation-kubeconfig/token"),
+				))
+
+				Expect(c.Get(ctx, client.ObjectKeyFromObject(deployment), deployment)).To(Succeed())
+				Expect(deployment.Spec.Template.Spec.Containers[0].Command).To(ContainElements(
+					"--authentication-kubeconfig=/var/run/secrets/gardener.cloud/shoot/auth-delegation-kubeconfig/kubeconfig",
+					"--authorization-kubeconfig=/var/run/secrets/gardener.cloud/shoot/auth-delegation-kubeconfig/kubeconfig",
+					"--kubeconfig="+gardenerutils.PathGenericKubeconfig,
+				))
+				Expect(deployment.Spec.Template.Spec.Containers[0].VolumeMounts).To(ContainElement(corev1.VolumeMount{
+					Name:      "auth-delegation-kubeconfig",
+					MountPath: "/var/run/secrets/gardener.cloud/shoot/auth-delegation-kubeconfig",
+					ReadOnly:  true,
+				}))
+				Expect(deployment.Spec.Template.Spec.Volumes).To(ContainElement(corev1.Volume{
+					Name: "auth-delegation-kubeconfig",
+					VolumeSource: corev1.VolumeSource{
+						Projected: &corev1.ProjectedVolumeSource{
+							DefaultMode: pointer.Int32(420),
+							Sources: []corev1.VolumeProjection{
+								{
+									Secret: &corev1.SecretProjection{
+										LocalObjectReference: corev1.LocalObjectReference{Name: authDelegationKubeconfigSecret.Name},
+										Items:                []corev1.KeyToPath{{Key: "kubeconfig", Path: "kubeconfig"}},
+										Optional:             pointer.Bool(false),
+									},
+								},
+								{
+									Secret: &corev1.SecretProjection{
+										LocalObjectReference: corev1.LocalObjectReference{Name: accessSecret.Name},
+										Items:                []corev1.KeyToPath{{Key: "token", Path: "token"}},
+										Optional:             pointer.Bool(false),
+									},
+								},
+							},
+						},
+					},
+				}))
+
+				managedResource := &resourcesv1alpha1.ManagedResource{ObjectMeta: metav1.ObjectMeta{Name: "shoot-core-kube-controller-manager", Namespace: namespace}}
+				Expect(c.Get(ctx, client.ObjectKeyFromObject(managedResource), managedResource)).To(Succeed())
+				managedResourceSecret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: managedResource.Spec.SecretRefs[0].Name, Namespace: namespace}}
+				Expect(c.Get(ctx, client.ObjectKeyFromObject(managedResourceSecret), managedResourceSecret)).To(Succeed())
+				Expect(string(managedResourceSecret.Data["clusterrolebinding____gardener.cloud_target_kube-controller-manager_auth-delegator.yaml"])).To(And(
+					ContainSubstring("name: system:auth-delegator"),
+					ContainSubstring("name: kube-controller-manager-auth-delegator"),
+				))
+			})
+
+			It("should delete the auth delegation shoot access secret if the separate kubeconfig is disabled", func() {
+				Expect(kubeControllerManager.Deploy(ctx)).To(Succeed())
+				Expect(c.Get(ctx, client.ObjectKeyFromObject(accessSecret), accessSecret)).To(Succeed())
+
+				values.SeparateAuthDelegationKubeconfig = false
+				kubeControllerManager = New(testLogger, fakeInterface, namespace, sm, values)
+				kubeControllerManager.SetReplicaCount(1)
+				Expect(kubeControllerManager.Deploy(ctx)).To(Succeed())
+
+				Expect(c.Get(ctx, client.ObjectKeyFromObject(accessSecret), accessSecret)).To(BeNotFoundError())
+				Expect(c.Get(ctx, client.ObjectKeyFromObject(deployment), deployment)).To(Succeed())
+				Expect(deployment.Spec.Template.Spec.Containers[0].Command).To(ContainElement("--authentication-kubeconfig=" + gardenerutils.PathGenericKubeconfig))
+				Expect(deployment.Spec.Template.Spec.Volumes).NotTo(ContainElement(HaveField("Name", "auth-delegation-kubeconfig")))
+			})
+		})
+
+		Context("autoscaling mode switch", func() {
+			var (
+				actualHVPA *hvpav1alpha1.Hvpa
+				actualVPA  *vpaautoscalingv1.VerticalPodAutoscaler
+				hvpaVPA    *vpaautoscalingv1.VerticalPodAutoscaler
+				foreignVPA *vpaautoscalingv1.VerticalPodAutoscaler
+			)
+
+			BeforeEach(func() {
+				actualHVPA = &hvpav1alpha1.Hvpa{ObjectMeta: metav1.ObjectMeta{Name: hvpaName, Namespace: namespace}}
+				actualVPA = &vpaautoscalingv1.VerticalPodAutoscaler{ObjectMeta: metav1.ObjectMeta{Name: vpaName, Namespace: namespace}}
+				hvpaVPA = &vpaautoscalingv1.VerticalPodAutoscaler{ObjectMeta: metav1.ObjectMeta{
+					Name:            "kube-controller-manager-vpa-abcde",
+					Namespace:       namespace,
+					Labels:          map[string]string{"role": "kube-controller-manager-vpa"},
+					OwnerReferences: []metav1.OwnerReference{{APIVersion: "autoscaling.k8s.io/v1alpha1", Kind: "Hvpa", Name: hvpaName, UID: "1"}},
+				}}
+				foreignVPA = &vpaautoscalingv1.VerticalPodAutoscaler{ObjectMeta: metav1.ObjectMeta{
+					Name:            "foo-vpa",
+					Namespace:       namespace,
+					Labels:          map[string]string{"role": "kube-controller-manager-vpa"},
+					OwnerReferences: []metav1.OwnerReference{{APIVersion: "autoscaling.k8s.io/v1alpha1", Kind: "Hvpa", Name: "foo", UID: "2"}},
+				}}
+
+				DeferCleanup(test.WithVars(
+					&IntervalWaitForStaleAutoscalerDeletion, time.Millisecond,
+					&TimeoutWaitForStaleAutoscalerDeletion, 50*time.Millisecond,
+				))
+			})
+
+			It("should delete the VPA when switching from VPA to HVPA", func() {
+				Expect(kubeControllerManager.Deploy(ctx)).To(Succeed())
+				Expect(c.Get(ctx, client.ObjectKeyFromObject(actualVPA), actualVPA)).To(Succeed())
+				Expect(c.Get(ctx, client.ObjectKeyFromObject(actualHVPA), actualHVPA)).To(BeNotFoundError())
+
+				values.HVPAConfig = hvpaConfigEnabled
+				kubeControllerManager = New(testLogger, fakeInterface, namespace, sm, values)
+				Expect(kubeControllerManager.Deploy(ctx)).To(Succeed())
+
+				Expect(c.Get(ctx, client.ObjectKeyFromObject(actualVPA), actualVPA)).To(BeNotFoundError())
+				Expect(c.Get(ctx, client.ObjectKeyFromObject(actualHVPA), actualHVPA)).To(Succeed())
+			})
+
+			It("should delete the HVPA and the VPAs created for it when switching from HVPA to VPA", func() {
+				values.HVPAConfig = hvpaConfigEnabled
+				kubeControllerManager = New(testLogger, fakeInterface, namespace, sm, values)
+				Expect(kubeControllerManager.Deploy(ctx)).To(Succeed())
+				Expect(c.Get(ctx, client.ObjectKeyFromObject(actualHVPA), actualHVPA)).To(Succeed())
+				Expect(c.Create(ctx, hvpaVPA)).To(Succeed())
+				Expect(c.Create(ctx, foreignVPA)).To(Succeed())
+
+				values.HVPAConfig = hvpaConfigDisabled
+				kubeControllerManager = New(testLogger, fakeInterface, namespace, sm, values)
+				Expect(kubeControllerManager.Deploy(ctx)).To(Succeed())
+
+				Expect(c.Get(ctx, client.ObjectKeyFromObject(actualHVPA), actualHVPA)).To(BeNotFoundError())
+				Expect(c.Get(ctx, client.ObjectKeyFromObject(hvpaVPA), hvpaVPA)).To(BeNotFoundError())
+				Expect(c.Get(ctx, client.ObjectKeyFromObject(foreignVPA), foreignVPA)).To(Succeed())
+				Expect(c.Get(ctx, client.ObjectKeyFromObject(actualVPA), actualVPA)).To(Succeed())
+			})
+
+			It("should fail and not create the HVPA if the stale VPA is not deleted in time", func() {
+				actualVPA.Finalizers = []string{"foo"}
+				Expect(c.Create(ctx, actualVPA)).To(Succeed())
+
+				values.HVPAConfig = hvpaConfigEnabled
+				kubeControllerManager = New(testLogger, fakeInterface, namespace, sm, values)
+				Expect(kubeControllerManager.Deploy(ctx)).To(MatchError(ContainSubstring("failed waiting for stale autoscaler " + namespace + "/" + vpaName + " to be deleted")))
+
+				Expect(c.Get(ctx, client.ObjectKeyFromObject(actualVPA), actualVPA)).To(Succeed())
+				Expect(actualVPA.DeletionTimestamp).NotTo(BeNil())
+				Expect(c.Get(ctx, client.ObjectKeyFromObject(actualHVPA), actualHVPA)).To(BeNotFoundError())
+			})
+
+			It("should fail and not create the VPA if the stale HVPA is not deleted in time", func() {
+				actualHVPA.Finalizers = []string{"foo"}
+				Expect(c.Create(ctx, actualHVPA)).To(Succeed())
+
+				Expect(kubeControllerManager.Deploy(ctx)).To(MatchError(ContainSubstring("failed waiting for stale autoscaler " + namespace + "/" + hvpaName + " to be deleted")))
+
+				Expect(c.Get(ctx, client.ObjectKeyFromObject(actualHVPA), actualHVPA)).To(Succeed())
+				Expect(actualHVPA.DeletionTimestamp).NotTo(BeNil())
+				Expect(c.Get(ctx, client.ObjectKeyFromObject(actualVPA), actualVPA)).To(BeNotFoundError())
+			})
+
+			It("should create the VPA if the HVPA kind is not known to the seed", func() {
+				noHVPAMatch := func(obj client.Object) error {
+					if _, ok := obj.(*hvpav1alpha1.Hvpa); ok {
+						return &meta.NoKindMatchError{GroupKind: hvpav1alpha1.SchemeGroupVersionHvpa.WithKind("Hvpa").GroupKind()}
+					}
+					return nil
+				}
+				seedClient := interceptor.NewClient(c.(client.WithWatch), interceptor.Funcs{
+					Get: func(ctx context.Context, c client.WithWatch, key client.ObjectKey, obj client.Object, opts ...client.GetOption) error {
+						if err := noHVPAMatch(obj); err != nil {
+							return err
+						}
+						return c.Get(ctx, key, obj, opts...)
+					},
+					Delete: func(ctx context.Context, c client.WithWatch, obj client.Object, opts ...client.DeleteOption) error {
+						if err := noHVPAMatch(obj); err != nil {
+							return err
+						}
+						return c.Delete(ctx, obj, opts...)
+					},
+				})
+				fakeInterface = kubernetesfake.NewClientSetBuilder().WithAPIReader(seedClient).WithClient(seedClient).Build()
+				kubeControllerManager = New(testLogger, fakeInterface, namespace, sm, values)
+				kubeControllerManager.SetReplicaCount(1)
+
+				Expect(kubeControllerManager.Deploy(ctx)).To(Succeed())
+				Expect(c.Get(ctx, client.ObjectKeyFromObject(actualVPA), actualVPA)).To(Succeed())
+			})
+		})
+
+		Context("dual-stack networks", func() {
+			var (
+				_, podCIDRIPv6, _     = net.ParseCIDR("2001:db8:1::/48")
+				_, serviceCIDRIPv6, _ = net.ParseCIDR("2001:db8:2::/108")
+			)
+
+			deployAndGetCommand := func(config *gardencorev1beta1.KubeControllerManagerConfig, podNetworks, serviceNetworks []net.IPNet) []string {
+				values = Values{
+					RuntimeVersion:    runtimeKubernetesVersion,
+					TargetVersion:     semverVersion,
+					Image:             image,
+					Config:            config,
+					PriorityClassName: priorityClassName,
+					HVPAConfig:        hvpaConfigDisabled,
+					PodNetworks:       podNetworks,
+					ServiceNetworks:   serviceNetworks,
+				}
+				kubeControllerManager = New(testLogger, fakeInterface, namespace, sm, values)
+				kubeControllerManager.SetReplicaCount(1)
+
+				Expect(kubeControllerManager.Deploy(ctx)).To(Succeed())
+
+				deployment := &appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: "kube-controller-manager", Namespace: namespace}}
+				Expect(c.Get(ctx, client.ObjectKeyFromObject(deployment), deployment)).To(Succeed())
+				return deployment.Spec.Template.Spec.Containers[0].Command
+			}
+
+			It("should render comma-separated CIDRs and the default mask sizes per IP family", func() {
+				command := deployAndGetCommand(&gardencorev1beta1.KubeControllerManagerConfig{},
+					[]net.IPNet{*podCIDR, *podCIDRIPv6},
+					[]net.IPNet{*serviceCIDR, *serviceCIDRIPv6},
+				)
+
+				Expect(command).To(ContainElements(
+					"--cluster-cidr=100.96.0.0/11,2001:db8:1::/48",
+					"--service-cluster-ip-range=100.64.0.0/13,2001:db8:2::/108",
+					"--node-cidr-mask-size-ipv4=24",
+					"--node-cidr-mask-size-ipv6=64",
+				))
+				Expect(command).NotTo(ContainElement(HavePrefix("--node-cidr-mask-size=")))
+			})
+
+			It("should apply the configured mask size to the primary IP family", func() {
+				command := deployAndGetCommand(&gardencorev1beta1.KubeControllerManagerConfig{NodeCIDRMaskSize: pointer.Int32(80)},
+					[]net.IPNet{*podCIDRIPv6, *podCIDR},
+					[]net.IPNet{*serviceCIDRIPv6, *serviceCIDR},
+				)
+
+				Expect(command).To(ContainElements(
+					"--cluster-cidr=2001:db8:1::/48,100.96.0.0/11",
+					"--service-cluster-ip-range=2001:db8:2::/108,100.64.0.0/13",
+					"--node-cidr-mask-size-ipv4=24",
+					"--node-cidr-mask-size-ipv6=80",
+				))
+				Expect(command).NotTo(ContainElement(HavePrefix("--node-cidr-mask-size=")))
+			})
+
+			It("should render the single mask size for single-stack networks", func() {
+				command := deployAndGetCommand(&gardencorev1beta1.KubeControllerManagerConfig{NodeCIDRMaskSize: pointer.Int32(64)},
+					[]net.IPNet{*podCIDRIPv6},
+					[]net.IPNet{*serviceCIDRIPv6},
+				)
+
+				Expect(command).To(ContainElements(
+					"--node-cidr-mask-size=64",
+					"--cluster-cidr=2001:db8:1::/48",
+					"--service-cluster-ip-range=2001:db8:2::/108",
+				))
+				Expect(command).NotTo(ContainElement(HavePrefix("--node-cidr-mask-size-ipv")))
+			})
+		})
+
+		Context("RBAC report", func() {
+			var configMap *corev1.ConfigMap
+
+			BeforeEach(func() {
+				configMap = &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "kube-controller-manager-rbac-report", Namespace: namespace}}
+				kubeControllerManager.SetReplicaCount(1)
+			})
+
+			deployAndGetReport := func() *RBACReport {
+				kubeControllerManager = New(testLogger, fakeInterface, namespace, sm, values)
+				kubeControllerManager.SetReplicaCount(1)
+				Expect(kubeControllerManager.Deploy(ctx)).To(Succeed())
+
+				Expect(c.Get(ctx, client.ObjectKeyFromObject(configMap), configMap)).To(Succeed())
+				report := &RBACReport{}
+				Expect(yaml.Unmarshal([]byte(configMap.Data["report.yaml"]), report)).To(Succeed())
+				return report
+			}
+
+			It("should not create the report by default", func() {
+				Expect(kubeControllerManager.Deploy(ctx)).To(Succeed())
+				Expect(c.Get(ctx, client.ObjectKeyFromObject(configMap), configMap)).To(BeNotFoundError())
+			})
+
+			It("should report the service accounts and roles of the enabled controllers", func() {
+				values.RBACReport = true
+				values.IsWorkerless = false
+				report := deployAndGetReport()
+
+				Expect(report.ServiceAccount).To(Equal("kube-controller-manager"))
+				Expect(report.ClusterRole).To(Equal("system:kube-controller-manager"))
+				Expect(report.Controllers).To(ContainElements(
+					ControllerRBACReport{Name: "attachdetach", ServiceAccount: "attachdetach-controller", Role: "system:controller:attachdetach-controller"},
+					ControllerRBACReport{Name: "bootstrapsigner", ServiceAccount: "bootstrap-signer", Role: "system:controller:bootstrap-signer"},
+					ControllerRBACReport{Name: "serviceaccount-token"},
+				))
+				Expect(report.DisabledControllers).To(ConsistOf(
+					HaveField("Name", "resource-claim-controller"),
+					HaveField("Name", "storage-version-gc"),
+				))
+			})
+
+			It("should report the controllers disabled for workerless shoots and by the runtime config", func() {
+				values.RBACReport = true
+				values.IsWorkerless = true
+				values.RuntimeConfig = map[string]bool{"batch/v1": false}
+				report := deployAndGetReport()
+
+				Expect(report.DisabledControllers).To(ContainElements(
+					ControllerRBACReport{Name: "attachdetach", ServiceAccount: "attachdetach-controller", Role: "system:controller:attachdetach-controller"},
+					ControllerRBACReport{Name: "nodelifecycle", ServiceAccount: "node-controller", Role: "system:controller:node-controller"},
+					ControllerRBACReport{Name: "cronjob", ServiceAccount: "cronjob-controller", Role: "system:controller:cronjob-controller"},
+				))
+				Expect(report.Controllers).NotTo(ContainElement(HaveField("Name", "attachdetach")))
+				Expect(report.Controllers).To(ContainElement(HaveField("Name", "deployment")))
+			})
+
+			It("should delete the report when it is disabled again", func() {
+				values.RBACReport = true
+				deployAndGetReport()
+
+				values.RBACReport = false
+				kubeControllerManager = New(testLogger, fakeInterface, namespace, sm, values)
+				kubeControllerManager.SetReplicaCount(1)
+				Expect(kubeControllerManager.Deploy(ctx)).To(Succeed())
+				Expect(c.Get(ctx, client.ObjectKeyFromObject(configMap), configMap)).To(BeNotFoundError())
+			})
+		})
+
+		Context("scrape config map", func() {
+			var configMap *corev1.ConfigMap
+
+			BeforeEach(func() {
+				configMap = &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "kube-controller-manager-scrape-config", Namespace: namespace}}
+				kubeControllerManager.SetReplicaCount(1)
+			})
+
+			It("should not create the config map by default", func() {
+				Expect(kubeControllerManager.Deploy(ctx)).To(Succeed())
+				Expect(c.Get(ctx, client.ObjectKeyFromObject(configMap), configMap)).To(BeNotFoundError())
+			})
+
+			It("should create the config map for the Prometheus instance and delete it when it is disabled again", func() {
+				values.PrometheusInstance = "seed"
+				kubeControllerManager = New(testLogger, fakeInterface, namespace, sm, values)
+				kubeControllerManager.SetReplicaCount(1)
+				Expect(kubeControllerManager.Deploy(ctx)).To(Succeed())
+
+				Expect(c.Get(ctx, client.ObjectKeyFromObject(configMap), configMap)).To(Succeed())
+				Expect(configMap.Labels).To(Equal(map[string]string{
+					"app":        "kubernetes",
+					"role":       "controller-manager",
+					"prometheus": "seed",
+				}))
+				Expect(configMap.Data).To(HaveKeyWithValue("scrape_config", And(
+					HavePrefix("- job_name: kube-controller-manager\n"),
+					ContainSubstring("    names: ["+namespace+"]\n"),
+					ContainSubstring("  regex: kube-controller-manager;metrics\n"),
+					ContainSubstring("  insecure_skip_verify: true\n"),
+				)))
+
+				values.PrometheusInstance = ""
+				kubeControllerManager = New(testLogger, fakeInterface, namespace, sm, values)
+				kubeControllerManager.SetReplicaCount(1)
+				Expect(kubeControllerManager.Deploy(ctx)).To(Succeed())
+				Expect(c.Get(ctx, client.ObjectKeyFromObject(configMap), configMap)).To(BeNotFoundError())
+			})
+		})
+
+		Context("tenant labels", func() {
+			BeforeEach(func() {
+				values.TenantLabels = map[string]string{
+					"tenant.example.com/owner": "foo",
+					"role":                     "tenant",
+				}
+				kubeControllerManager = New(testLogger, fakeInterface, namespace, sm, values)
+				kubeControllerManager.SetReplicaCount(1)
+			})
+
+			It("should add the tenant labels to the objects in the seed without overwriting the component labels", func() {
+				Expect(kubeControllerManager.Deploy(ctx)).To(Succeed())
+
+				deployment := &appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: "kube-controller-manager", Namespace: namespace}}
+				Expect(c.Get(ctx, client.ObjectKeyFromObject(deployment), deployment)).To(Succeed())
+				Expect(deployment.Labels).To(HaveKeyWithValue("tenant.example.com/owner", "foo"))
+				Expect(deployment.Labels).To(HaveKeyWithValue("role", "controller-manager"))
+				Expect(deployment.Spec.Template.Labels).To(HaveKeyWithValue("tenant.example.com/owner", "foo"))
+				Expect(deployment.Spec.Selector.MatchLabels).To(Equal(map[string]string{"app": "kubernetes", "role": "controller-manager"}))
+
+				service := &corev1.Service{ObjectMeta: metav1.ObjectMeta{Name: "kube-controller-manager", Namespace: namespace}}
+				Expect(c.Get(ctx, client.ObjectKeyFromObject(service), service)).To(Succeed())
+				Expect(service.Labels).To(HaveKeyWithValue("tenant.example.com/owner", "foo"))
+				Expect(service.Spec.Selector).NotTo(HaveKey("tenant.example.com/owner"))
+
+				podDisruptionBudget := &policyv1.PodDisruptionBudget{ObjectMeta: metav1.ObjectMeta{Name: "kube-controller-manager", Namespace: namespace}}
+				Expect(c.Get(ctx, client.ObjectKeyFromObject(podDisruptionBudget), podDisruptionBudget)).To(Succeed())
+				Expect(podDisruptionBudget.Labels).To(HaveKeyWithValue("tenant.example.com/owner", "foo"))
+			})
+
+			It("should add the tenant labels to the managed resource and inject them into the objects in the shoot", func() {
+				Expect(kubeControllerManager.Deploy(ctx)).To(Succeed())
+
+				managedResource := &resourcesv1alpha1.ManagedResource{ObjectMeta: metav1.ObjectMeta{Name: "shoot-core-kube-controller-manager", Namespace: namespace}}
+				Expect(c.Get(ctx, client.ObjectKeyFromObject(managedResource), managedResource)).To(Succeed())
+				Expect(managedResource.Labels).To(Equal(map[string]string{
+					"origin":                   "gardener",
+					"tenant.example.com/owner": "foo",
+					"role":                     "tenant",
+				}))
+				Expect(managedResource.Spec.InjectLabels).To(Equal(map[string]string{
+					"shoot.gardener.cloud/no-cleanup": "true",
+					"tenant.example.com/owner":        "foo",
+					"role":                            "tenant",
+				}))
+
+				managedResourceSecret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: managedResource.Spec.SecretRefs[0].Name, Namespace: namespace}}
+				Expect(c.Get(ctx, client.ObjectKeyFromObject(managedResourceSecret), managedResourceSecret)).To(Succeed())
+				Expect(managedResourceSecret.Labels).To(HaveKeyWithValue("tenant.example.com/owner", "foo"))
+				Expect(managedResourceSecret.Labels).To(HaveKeyWithValue("resources.gardener.cloud/garbage-collectable-reference", "true"))
+			})
+
+			It("should fail if a tenant label is invalid", func() {
+				values.TenantLabels = map[string]string{"tenant.example.com/owner": "not a valid value"}
+				kubeControllerManager = New(testLogger, fakeInterface, namespace, sm, values)
+
+				Expect(kubeControllerManager.Deploy(ctx)).To(MatchError(ContainSubstring(`invalid value of tenant label "tenant.example.com/owner"`)))
+			})
+		})
+
+		Context("command golden files", func() {
+			for _, minor := range kubernetesversion.SupportedVersions {
+				minor := minor
+
+				It("should render the expected command for Kubernetes "+minor, func() {
+					values = Values{
+						RuntimeVersion:    runtimeKubernetesVersion,
+						TargetVersion:     semver.MustParse(minor + ".0"),
+						Image:             image,
+						Config:            &gardencorev1beta1.KubeControllerManagerConfig{},
+						PriorityClassName: priorityClassName,
+						HVPAConfig:        hvpaConfigDisabled,
+						PodNetworks:       []net.IPNet{*podCIDR},
+						ServiceNetworks:   []net.IPNet{*serviceCIDR},
+					}
+					kubeControllerManager = New(testLogger, fakeInterface, namespace, sm, values)
+					kubeControllerManager.SetReplicaCount(1)
+
+					Expect(kubeControllerManager.Deploy(ctx)).To(Succeed())
+
+					deployment := &appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: "kube-controller-manager", Namespace: namespace}}
+					Expect(c.Get(ctx, client.ObjectKeyFromObject(deployment), deployment)).To(Succeed())
+					expectGoldenCommand(deployment.Spec.Template.Spec.Containers[0].Command, filepath.Join("testdata", "command-"+minor+".golden"))
+				})
+			}
+		})
 	})
 
 	Describe("#Destroy", func() {
@@ -888,6 +1820,8 @@ namespace: kube-system
 			pdb := &policyv1.PodDisruptionBudget{ObjectMeta: metav1.ObjectMeta{Name: pdbName, Namespace: namespace}}
 			deploy := &appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: "kube-controller-manager", Namespace: namespace}}
 			secret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: secretName, Namespace: namespace}}
+			rbacReport := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "kube-controller-manager-rbac-report", Namespace: namespace}}
+			scrapeConfig := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "kube-controller-manager-scrape-config", Namespace: namespace}}
 			Expect(c.Create(ctx, mr)).To(Succeed())
 			Expect(c.Create(ctx, mrSecret)).To(Succeed())
 			Expect(c.Create(ctx, vpa)).To(Succeed())
@@ -896,6 +1830,8 @@ namespace: kube-system
 			Expect(c.Create(ctx, deploy)).To(Succeed())
 			Expect(c.Create(ctx, pdb)).To(Succeed())
 			Expect(c.Create(ctx, secret)).To(Succeed())
+			Expect(c.Create(ctx, rbacReport)).To(Succeed())
+			Expect(c.Create(ctx, scrapeConfig)).To(Succeed())
 
 			kubeControllerManager = New(
 				testLogger,
@@ -905,7 +1841,10 @@ namespace: kube-system
 				values,
 			)
 
+			componentmetrics.OperationDuration.Reset()
+
 			Expect(kubeControllerManager.Destroy(ctx)).To(Succeed())
+			Expect(componentmetrics.OperationDuration.DeleteLabelValues("kube-controller-manager", "destroy", "success")).To(BeTrue())
 
 			Expect(c.Get(ctx, client.ObjectKeyFromObject(mr), mr)).To(BeNotFoundError())
 			Expect(c.Get(ctx, client.ObjectKeyFromObject(mrSecret), mrSecret)).To(BeNotFoundError())
@@ -915,6 +1854,19 @@ namespace: kube-system
 			Expect(c.Get(ctx, client.ObjectKeyFromObject(deploy), deploy)).To(BeNotFoundError())
 			Expect(c.Get(ctx, client.ObjectKeyFromObject(pdb), pdb)).To(BeNotFoundError())
 			Expect(c.Get(ctx, client.ObjectKeyFromObject(secret), secret)).To(BeNotFoundError())
+			Expect(c.Get(ctx, client.ObjectKeyFromObject(rbacReport), rbacReport)).To(BeNotFoundError())
+			Expect(c.Get(ctx, client.ObjectKeyFromObject(scrapeConfig), scrapeConfig)).To(BeNotFoundError())
+		})
+
+		It("should record an event when the destruction is initiated", func() {
+			recorder := record.NewFakeRecorder(10)
+			values.Recorder = recorder
+			kubeControllerManager = New(testLogger, fakeInterface, namespace, sm, values)
+
+			Expect(kubeControllerManager.Destroy(ctx)).To(Succeed())
+
+			Expect(recorder.Events).To(HaveLen(1))
+			Expect(<-recorder.Events).To(Equal("Normal Destroying Destroying kube-controller-manager"))
 		})
 	})
 
@@ -977,6 +1929,119 @@ namespace: kube-system
 
 			Expect(kubeControllerManager.Wait(ctx)).To(Succeed())
 		})
+
+		Context("failures", func() {
+			var messages []string
+
+			BeforeEach(func() {
+				messages = nil
+				values = Values{
+					RuntimeVersion:   semver.MustParse("1.25.0"),
+					IsWorkerless:     isWorkerless,
+					WaitProgressFunc: func(message string) { messages = append(messages, message) },
+				}
+				kubeControllerManager = New(testLogger, fakeInterface, namespace, nil, values)
+
+				DeferCleanup(test.WithVars(
+					&IntervalWaitForDeployment, time.Millisecond,
+					&TimeoutWaitForDeployment, 50*time.Millisecond,
+				))
+			})
+
+			It("should return a typed error if the deployment exceeded its progress deadline", func() {
+				deploy := deployment.DeepCopy()
+				deploy.Status.Conditions = []appsv1.DeploymentCondition{
+					{Type: appsv1.DeploymentProgressing, Status: corev1.ConditionFalse, Reason: "ProgressDeadlineExceeded"},
+				}
+				Expect(c.Create(ctx, deploy)).To(Succeed())
+
+				Expect(kubeControllerManager.Wait(ctx)).To(MatchError(ErrDeploymentProgressDeadline))
+				Expect(messages).NotTo(BeEmpty())
+			})
+
+			It("should return a typed error with container status extracts if the pods are crash-looping", func() {
+				Expect(c.Create(ctx, deployment.DeepCopy())).To(Succeed())
+				Expect(c.Create(ctx, &corev1.Pod{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "pod",
+						Namespace: deployment.Namespace,
+						Labels:    labels,
+					},
+					Status: corev1.PodStatus{
+						ContainerStatuses: []corev1.ContainerStatus{{
+							Name:                 "kube-controller-manager",
+							RestartCount:         5,
+							State:                corev1.ContainerState{Waiting: &corev1.ContainerStateWaiting{Reason: "CrashLoopBackOff"}},
+							LastTerminationState: corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{ExitCode: 1, Reason: "Error", Message: "Error: unknown flag: --foo"}},
+						}},
+					},
+				})).To(Succeed())
+
+				err := kubeControllerManager.Wait(ctx)
+				Expect(err).To(MatchError(ErrPodsCrashLooping))
+
+				var crashLoopingErr *PodsCrashLoopingError
+				Expect(errors.As(err, &crashLoopingErr)).To(BeTrue())
+				Expect(crashLoopingErr.ContainerStatuses).To(ConsistOf(ContainerStatusExtract{
+					PodName:       "pod",
+					ContainerName: "kube-controller-manager",
+					RestartCount:  5,
+					ExitCode:      1,
+					Reason:        "Error",
+					Message:       "Error: unknown flag: --foo",
+				}))
+				Expect(v1beta1helper.ExtractErrorCodes(err)).To(ConsistOf(gardencorev1beta1.ErrorConfigurationProblem))
+				Expect(messages).NotTo(BeEmpty())
+			})
+
+			It("should return an error with error codes if the images of the pods cannot be pulled", func() {
+				Expect(c.Create(ctx, deployment.DeepCopy())).To(Succeed())
+				Expect(c.Create(ctx, &corev1.Pod{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "pod",
+						Namespace: deployment.Namespace,
+						Labels:    labels,
+					},
+					Status: corev1.PodStatus{
+						ContainerStatuses: []corev1.ContainerStatus{{
+							Name:  "kube-controller-manager",
+							State: corev1.ContainerState{Waiting: &corev1.ContainerStateWaiting{Reason: "ImagePullBackOff", Message: "Back-off pulling image"}},
+						}},
+					},
+				})).To(Succeed())
+
+				err := kubeControllerManager.Wait(ctx)
+				Expect(err).To(MatchError(ErrPodsImagePullFailing))
+				Expect(err).To(MatchError(ContainSubstring(`container "kube-controller-manager" of pod "pod" (reason ImagePullBackOff: Back-off pulling image)`)))
+				Expect(v1beta1helper.ExtractErrorCodes(err)).To(ConsistOf(gardencorev1beta1.ErrorRetryableConfigurationProblem))
+				Expect(messages).NotTo(BeEmpty())
+			})
+
+			It("should return a typed error if the containers of the pods were OOM-killed", func() {
+				Expect(c.Create(ctx, deployment.DeepCopy())).To(Succeed())
+				Expect(c.Create(ctx, &corev1.Pod{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "pod",
+						Namespace: deployment.Namespace,
+						Labels:    labels,
+					},
+					Status: corev1.PodStatus{
+						ContainerStatuses: []corev1.ContainerStatus{{
+							Name:                 "kube-controller-manager",
+							RestartCount:         2,
+							State:                corev1.ContainerState{Running: &corev1.ContainerStateRunning{}},
+							LastTerminationState: corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{ExitCode: 137, Reason: "OOMKilled"}},
+						}},
+					},
+				})).To(Succeed())
+
+				err := kubeControllerManager.Wait(ctx)
+				Expect(err).To(MatchError(ErrPodsOOMKilled))
+				Expect(err).To(MatchError(ContainSubstring(`container "kube-controller-manager" of pod "pod" (restarted 2 times)`)))
+				Expect(v1beta1helper.ExtractErrorCodes(err)).To(BeEmpty())
+				Expect(messages).NotTo(BeEmpty())
+			})
+		})
 	})
 
 	Describe("#WaitCleanup", func() {
@@ -988,6 +2053,20 @@ namespace: kube-system
 
 // Utility functions
 
+// expectGoldenCommand compares the given command with the golden file at the given path, which contains one argument
+// per line. Run the tests with UPDATE_GOLDEN_FILES=true to regenerate the golden files after intended changes.
+func expectGoldenCommand(command []string, path string) {
+	actual := strings.Join(command, "\n") + "\n"
+
+	if os.Getenv("UPDATE_GOLDEN_FILES") == "true" {
+		ExpectWithOffset(1, os.WriteFile(path, []byte(actual), 0644)).To(Succeed())
+	}
+
+	expected, err := os.ReadFile(path)
+	ExpectWithOffset(1, err).NotTo(HaveOccurred())
+	ExpectWithOffset(1, actual).To(Equal(string(expected)), "command does not match golden file %s, run the tests with UPDATE_GOLDEN_FILES=true to update it", path)
+}
+
 func commandForKubernetesVersion(
 	version string,
 	port int32,
@@ -16,15 +16,28 @@ package kubecontrollermanager
 
 import (
 	"bytes"
+	"context"
+	"fmt"
 	"strings"
 	"text/template"
 
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	"github.com/gardener/gardener/pkg/controllerutils"
+	"github.com/gardener/gardener/pkg/utils"
+	kubernetesutils "github.com/gardener/gardener/pkg/utils/kubernetes"
 )
 
 const (
+	// LabelKeyPrometheus is the key of the label on the scrape config map whose value is the name of the Prometheus
+	// instance which shall scrape kube-controller-manager, see Values.PrometheusInstance.
+	LabelKeyPrometheus = "prometheus"
+
+	configMapNameScrapeConfig = "kube-controller-manager-scrape-config"
+
 	monitoringPrometheusJobName             = "kube-controller-manager"
 	monitoringMetricRestClientRequestsTotal = "rest_client_requests_total"
 	monitoringMetricProcessMaxFds           = "process_max_fds"
@@ -104,6 +117,39 @@ func (k *kubeControllerManager) ScrapeConfigs() ([]string, error) {
 	return []string{scrapeConfig.String()}, nil
 }
 
+// reconcileScrapeConfigMap creates or updates the config map containing the scrape configuration for the Prometheus
+// instance configured in the values, otherwise it deletes it. The config map is not labeled as extension monitoring
+// configuration, hence it is not picked up in addition to ScrapeConfigs by the shoot monitoring.
+func (k *kubeControllerManager) reconcileScrapeConfigMap(ctx context.Context) error {
+	configMap := k.emptyScrapeConfigMap()
+
+	if k.values.PrometheusInstance == "" {
+		return kubernetesutils.DeleteObject(ctx, k.seedClient.Client(), configMap)
+	}
+
+	scrapeConfigs, err := k.ScrapeConfigs()
+	if err != nil {
+		return err
+	}
+
+	// The scrape configurations are rendered as list, like in the monitoring configurations of extensions.
+	var scrapeConfig strings.Builder
+	for _, config := range scrapeConfigs {
+		scrapeConfig.WriteString(fmt.Sprintf("- %s\n", utils.Indent(config, 2)))
+	}
+
+	_, err = controllerutils.GetAndCreateOrMergePatch(ctx, k.seedClient.Client(), configMap, func() error {
+		configMap.Labels = k.withTenantLabels(utils.MergeStringMaps(getLabels(), map[string]string{LabelKeyPrometheus: k.values.PrometheusInstance}))
+		configMap.Data = map[string]string{v1beta1constants.PrometheusConfigMapScrapeConfig: scrapeConfig.String()}
+		return nil
+	})
+	return err
+}
+
+func (k *kubeControllerManager) emptyScrapeConfigMap() *corev1.ConfigMap {
+	return &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: k.values.NamePrefix + configMapNameScrapeConfig, Namespace: k.namespace}}
+}
+
 // AlertingRules returns the alerting rules for AlertManager.
 func (k *kubeControllerManager) AlertingRules() (map[string]string, error) {
 	return map[string]string{"kube-controller-manager.rules.yaml": monitoringAlertingRules}, nil
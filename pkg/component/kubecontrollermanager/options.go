@@ -0,0 +1,224 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kubecontrollermanager
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	kubernetesutils "github.com/gardener/gardener/pkg/utils/kubernetes"
+)
+
+const commandBinary = "/usr/local/bin/kube-controller-manager"
+
+// commandOptions contains the command line options of kube-controller-manager. Nil pointers, empty strings, and empty
+// slices or maps are not rendered, all other fields are always rendered.
+// Unlike kube-scheduler, kube-controller-manager does not support loading its configuration from a file (there is no
+// `--config` flag, the `KubeControllerManagerConfiguration` type is only used internally). Hence, all settings have to
+// be passed as command line flags.
+type commandOptions struct {
+	AuthenticationKubeconfig      string
+	AuthorizationAlwaysAllowPaths []string
+	AuthorizationKubeconfig       string
+	Kubeconfig                    string
+
+	RequestHeaderClientCAFile         string
+	RequestHeaderAllowedNames         []string
+	RequestHeaderUsernameHeaders      []string
+	RequestHeaderGroupHeaders         []string
+	RequestHeaderExtraHeadersPrefixes []string
+
+	NodeCIDRMaskSize                               *int32
+	NodeCIDRMaskSizeIPv4                           *int32
+	NodeCIDRMaskSizeIPv6                           *int32
+	AllocateNodeCIDRs                              *bool
+	AttachDetachReconcileSyncPeriod                *time.Duration
+	ClusterCIDRs                                   []string
+	ClusterSigningKubeletClientCertFile            string
+	ClusterSigningKubeletClientKeyFile             string
+	ClusterSigningKubeletServingCertFile           string
+	ClusterSigningKubeletServingKeyFile            string
+	HorizontalPodAutoscalerDownscaleStabilization  *time.Duration
+	HorizontalPodAutoscalerInitialReadinessDelay   *time.Duration
+	HorizontalPodAutoscalerCPUInitializationPeriod *time.Duration
+	HorizontalPodAutoscalerSyncPeriod              *time.Duration
+	HorizontalPodAutoscalerTolerance               *float64
+	LeaderElect                                    *bool
+	NodeMonitorGracePeriod                         *time.Duration
+	PodEvictionTimeout                             *time.Duration
+	ConcurrentDeploymentSyncs                      *int
+	ConcurrentReplicaSetSyncs                      *int
+	ConcurrentStatefulSetSyncs                     *int
+
+	ClusterName                               string
+	ClusterSigningKubeAPIServerClientCertFile string
+	ClusterSigningKubeAPIServerClientKeyFile  string
+	ClusterSigningLegacyUnknownCertFile       string
+	ClusterSigningLegacyUnknownKeyFile        string
+	ClusterSigningDuration                    time.Duration
+	ConcurrentEndpointSyncs                   int
+	ConcurrentGCSyncs                         int
+	ConcurrentServiceEndpointSyncs            int
+	Controllers                               []string
+	ConcurrentNamespaceSyncs                  *int
+	ConcurrentResourceQuotaSyncs              *int
+	ResourceQuotaSyncPeriod                   *time.Duration
+	ConcurrentServiceAccountTokenSyncs        *int
+	FeatureGates                              map[string]bool
+	KubeAPIQPS                                *float32
+	KubeAPIBurst                              *int32
+	LeaderElectLeaseDuration                  *time.Duration
+	LeaderElectRenewDeadline                  *time.Duration
+	LeaderElectRetryPeriod                    *time.Duration
+	LeaderElectResourceLock                   string
+
+	RootCAFile                   string
+	ServiceAccountPrivateKeyFile string
+	SecurePort                   int32
+	ServiceClusterIPRanges       []string
+	Profiling                    bool
+	TLSCertFile                  string
+	TLSPrivateKeyFile            string
+	TLSCipherSuites              []string
+	UseServiceAccountCredentials bool
+	Verbosity                    int
+}
+
+// render returns the command of the kube-controller-manager container for the options. The flags are rendered in a
+// fixed order which must not be changed arbitrarily since any change to the command rolls the kube-controller-manager
+// pods of all shoots.
+func (o *commandOptions) render() []string {
+	r := &commandRenderer{command: []string{commandBinary}}
+
+	r.string("authentication-kubeconfig", o.AuthenticationKubeconfig)
+	r.stringSlice("authorization-always-allow-paths", o.AuthorizationAlwaysAllowPaths)
+	r.string("authorization-kubeconfig", o.AuthorizationKubeconfig)
+	r.string("kubeconfig", o.Kubeconfig)
+
+	r.string("requestheader-client-ca-file", o.RequestHeaderClientCAFile)
+	r.stringSlice("requestheader-allowed-names", o.RequestHeaderAllowedNames)
+	r.stringSlice("requestheader-username-headers", o.RequestHeaderUsernameHeaders)
+	r.stringSlice("requestheader-group-headers", o.RequestHeaderGroupHeaders)
+	r.stringSlice("requestheader-extra-headers-prefix", o.RequestHeaderExtraHeadersPrefixes)
+
+	r.int32Ptr("node-cidr-mask-size", o.NodeCIDRMaskSize)
+	r.int32Ptr("node-cidr-mask-size-ipv4", o.NodeCIDRMaskSizeIPv4)
+	r.int32Ptr("node-cidr-mask-size-ipv6", o.NodeCIDRMaskSizeIPv6)
+	r.boolPtr("allocate-node-cidrs", o.AllocateNodeCIDRs)
+	r.durationPtr("attach-detach-reconcile-sync-period", o.AttachDetachReconcileSyncPeriod)
+	r.stringSlice("cluster-cidr", o.ClusterCIDRs)
+	r.string("cluster-signing-kubelet-client-cert-file", o.ClusterSigningKubeletClientCertFile)
+	r.string("cluster-signing-kubelet-client-key-file", o.ClusterSigningKubeletClientKeyFile)
+	r.string("cluster-signing-kubelet-serving-cert-file", o.ClusterSigningKubeletServingCertFile)
+	r.string("cluster-signing-kubelet-serving-key-file", o.ClusterSigningKubeletServingKeyFile)
+	r.durationPtr("horizontal-pod-autoscaler-downscale-stabilization", o.HorizontalPodAutoscalerDownscaleStabilization)
+	r.durationPtr("horizontal-pod-autoscaler-initial-readiness-delay", o.HorizontalPodAutoscalerInitialReadinessDelay)
+	r.durationPtr("horizontal-pod-autoscaler-cpu-initialization-period", o.HorizontalPodAutoscalerCPUInitializationPeriod)
+	r.durationPtr("horizontal-pod-autoscaler-sync-period", o.HorizontalPodAutoscalerSyncPeriod)
+	if o.HorizontalPodAutoscalerTolerance != nil {
+		r.flag("horizontal-pod-autoscaler-tolerance", fmt.Sprintf("%v", *o.HorizontalPodAutoscalerTolerance))
+	}
+	r.boolPtr("leader-elect", o.LeaderElect)
+	r.durationPtr("node-monitor-grace-period", o.NodeMonitorGracePeriod)
+	r.durationPtr("pod-eviction-timeout", o.PodEvictionTimeout)
+	r.intPtr("concurrent-deployment-syncs", o.ConcurrentDeploymentSyncs)
+	r.intPtr("concurrent-replicaset-syncs", o.ConcurrentReplicaSetSyncs)
+	r.intPtr("concurrent-statefulset-syncs", o.ConcurrentStatefulSetSyncs)
+
+	r.string("cluster-name", o.ClusterName)
+	r.string("cluster-signing-kube-apiserver-client-cert-file", o.ClusterSigningKubeAPIServerClientCertFile)
+	r.string("cluster-signing-kube-apiserver-client-key-file", o.ClusterSigningKubeAPIServerClientKeyFile)
+	r.string("cluster-signing-legacy-unknown-cert-file", o.ClusterSigningLegacyUnknownCertFile)
+	r.string("cluster-signing-legacy-unknown-key-file", o.ClusterSigningLegacyUnknownKeyFile)
+	r.flag("cluster-signing-duration", o.ClusterSigningDuration.String())
+	r.flag("concurrent-endpoint-syncs", strconv.Itoa(o.ConcurrentEndpointSyncs))
+	r.flag("concurrent-gc-syncs", strconv.Itoa(o.ConcurrentGCSyncs))
+	r.flag("concurrent-service-endpoint-syncs", strconv.Itoa(o.ConcurrentServiceEndpointSyncs))
+	r.stringSlice("controllers", o.Controllers)
+	r.intPtr("concurrent-namespace-syncs", o.ConcurrentNamespaceSyncs)
+	r.intPtr("concurrent-resource-quota-syncs", o.ConcurrentResourceQuotaSyncs)
+	r.durationPtr("resource-quota-sync-period", o.ResourceQuotaSyncPeriod)
+	r.intPtr("concurrent-serviceaccount-token-syncs", o.ConcurrentServiceAccountTokenSyncs)
+	if len(o.FeatureGates) > 0 {
+		r.command = append(r.command, kubernetesutils.FeatureGatesToCommandLineParameter(o.FeatureGates))
+	}
+	if o.KubeAPIQPS != nil {
+		r.flag("kube-api-qps", fmt.Sprintf("%v", *o.KubeAPIQPS))
+	}
+	r.int32Ptr("kube-api-burst", o.KubeAPIBurst)
+	r.durationPtr("leader-elect-lease-duration", o.LeaderElectLeaseDuration)
+	r.durationPtr("leader-elect-renew-deadline", o.LeaderElectRenewDeadline)
+	r.durationPtr("leader-elect-retry-period", o.LeaderElectRetryPeriod)
+	r.string("leader-elect-resource-lock", o.LeaderElectResourceLock)
+
+	r.string("root-ca-file", o.RootCAFile)
+	r.string("service-account-private-key-file", o.ServiceAccountPrivateKeyFile)
+	r.flag("secure-port", strconv.Itoa(int(o.SecurePort)))
+	r.stringSlice("service-cluster-ip-range", o.ServiceClusterIPRanges)
+	r.flag("profiling", strconv.FormatBool(o.Profiling))
+	r.string("tls-cert-file", o.TLSCertFile)
+	r.string("tls-private-key-file", o.TLSPrivateKeyFile)
+	r.stringSlice("tls-cipher-suites", o.TLSCipherSuites)
+	r.flag("use-service-account-credentials", strconv.FormatBool(o.UseServiceAccountCredentials))
+	r.flag("v", strconv.Itoa(o.Verbosity))
+
+	return r.command
+}
+
+type commandRenderer struct {
+	command []string
+}
+
+func (r *commandRenderer) flag(name, value string) {
+	r.command = append(r.command, "--"+name+"="+value)
+}
+
+func (r *commandRenderer) string(name, value string) {
+	if value != "" {
+		r.flag(name, value)
+	}
+}
+
+func (r *commandRenderer) stringSlice(name string, values []string) {
+	if len(values) > 0 {
+		r.flag(name, strings.Join(values, ","))
+	}
+}
+
+func (r *commandRenderer) boolPtr(name string, value *bool) {
+	if value != nil {
+		r.flag(name, strconv.FormatBool(*value))
+	}
+}
+
+func (r *commandRenderer) intPtr(name string, value *int) {
+	if value != nil {
+		r.flag(name, strconv.Itoa(*value))
+	}
+}
+
+func (r *commandRenderer) int32Ptr(name string, value *int32) {
+	if value != nil {
+		r.flag(name, strconv.FormatInt(int64(*value), 10))
+	}
+}
+
+func (r *commandRenderer) durationPtr(name string, value *time.Duration) {
+	if value != nil {
+		r.flag(name, value.String())
+	}
+}
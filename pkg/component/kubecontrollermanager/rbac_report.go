@@ -0,0 +1,185 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kubecontrollermanager
+
+import (
+	"context"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/yaml"
+
+	"github.com/gardener/gardener/pkg/controllerutils"
+	"github.com/gardener/gardener/pkg/utils"
+	kubernetesutils "github.com/gardener/gardener/pkg/utils/kubernetes"
+)
+
+const (
+	configMapNameRBACReport = "kube-controller-manager-rbac-report"
+	// DataKeyRBACReport is the key in the data of the RBAC report config map which contains the report.
+	DataKeyRBACReport = "report.yaml"
+
+	clusterRoleNameKubeControllerManager = "system:kube-controller-manager"
+	controllerRolePrefix                 = "system:controller:"
+)
+
+// controllerRBAC describes the identity which a kube-controller-manager controller uses in the target cluster. Since
+// `--use-service-account-credentials` is enabled, each controller uses a dedicated service account in the kube-system
+// namespace whose permissions are granted by the bootstrap RBAC policy of kube-apiserver.
+type controllerRBAC struct {
+	// serviceAccountName is the name of the service account. It is empty if the controller uses the credentials of
+	// kube-controller-manager itself.
+	serviceAccountName string
+	// disabledByDefault is true for controllers which are not enabled by `*`.
+	disabledByDefault bool
+}
+
+// knownControllers contains the controllers of kube-controller-manager for all supported Kubernetes versions. Controllers
+// which do not exist in the target version are never started, hence reporting them does not grant any permission.
+// resource-claim-controller and storage-version-gc are treated as disabled by default since they are only started if
+// their alpha feature gates are enabled.
+var knownControllers = map[string]controllerRBAC{
+	"attachdetach":                         {serviceAccountName: "attachdetach-controller"},
+	"bootstrapsigner":                      {serviceAccountName: "bootstrap-signer", disabledByDefault: true},
+	"cloud-node-lifecycle":                 {serviceAccountName: "cloud-node-lifecycle-controller"},
+	"clusterrole-aggregation":              {serviceAccountName: "clusterrole-aggregation-controller"},
+	"cronjob":                              {serviceAccountName: "cronjob-controller"},
+	"csrapproving":                         {serviceAccountName: "certificate-controller"},
+	"csrcleaner":                           {serviceAccountName: "certificate-controller"},
+	"csrsigning":                           {serviceAccountName: "certificate-controller"},
+	"daemonset":                            {serviceAccountName: "daemon-set-controller"},
+	"deployment":                           {serviceAccountName: "deployment-controller"},
+	"disruption":                           {serviceAccountName: "disruption-controller"},
+	"endpoint":                             {serviceAccountName: "endpoint-controller"},
+	"endpointslice":                        {serviceAccountName: "endpointslice-controller"},
+	"endpointslicemirroring":               {serviceAccountName: "endpointslicemirroring-controller"},
+	"ephemeral-volume":                     {serviceAccountName: "ephemeral-volume-controller"},
+	"garbagecollector":                     {serviceAccountName: "generic-garbage-collector"},
+	"horizontalpodautoscaling":             {serviceAccountName: "horizontal-pod-autoscaler"},
+	"job":                                  {serviceAccountName: "job-controller"},
+	"legacy-service-account-token-cleaner": {serviceAccountName: "legacy-service-account-token-cleaner"},
+	"namespace":                            {serviceAccountName: "namespace-controller"},
+	"nodeipam":                             {serviceAccountName: "node-controller"},
+	"nodelifecycle":                        {serviceAccountName: "node-controller"},
+	"persistentvolume-binder":              {serviceAccountName: "persistent-volume-binder"},
+	"persistentvolume-expander":            {serviceAccountName: "expand-controller"},
+	"podgc":                                {serviceAccountName: "pod-garbage-collector"},
+	"pv-protection":                        {serviceAccountName: "pv-protection-controller"},
+	"pvc-protection":                       {serviceAccountName: "pvc-protection-controller"},
+	"replicaset":                           {serviceAccountName: "replicaset-controller"},
+	"replicationcontroller":                {serviceAccountName: "replication-controller"},
+	"resource-claim-controller":            {serviceAccountName: "resource-claim-controller", disabledByDefault: true},
+	"resourcequota":                        {serviceAccountName: "resourcequota-controller"},
+	"root-ca-cert-publisher":               {serviceAccountName: "root-ca-cert-publisher"},
+	"route":                                {serviceAccountName: "route-controller"},
+	"service":                              {serviceAccountName: "service-controller"},
+	"serviceaccount":                       {serviceAccountName: "service-account-controller"},
+	"serviceaccount-token":                 {},
+	"storage-version-gc":                   {serviceAccountName: "storage-version-garbage-collector", disabledByDefault: true},
+	"statefulset":                          {serviceAccountName: "statefulset-controller"},
+	"tokencleaner":                         {serviceAccountName: "token-cleaner", disabledByDefault: true},
+	"ttl":                                  {serviceAccountName: "ttl-controller"},
+	"ttl-after-finished":                   {serviceAccountName: "ttl-after-finished-controller"},
+}
+
+// RBACReport enumerates the RBAC permissions which kube-controller-manager effectively needs in the target cluster.
+// The roles of the controllers are part of the bootstrap policy of kube-apiserver and not managed by this component, i.e.,
+// the shoot resources only bind the cluster role of kube-controller-manager itself. Hence, the roles of disabled
+// controllers still exist in the target cluster, but they are not used by any running controller.
+type RBACReport struct {
+	// ServiceAccount is the service account used by kube-controller-manager itself.
+	ServiceAccount string `json:"serviceAccount"`
+	// ClusterRole is the cluster role which is bound to the service account of kube-controller-manager.
+	ClusterRole string `json:"clusterRole"`
+	// Controllers are the controllers which are running.
+	Controllers []ControllerRBACReport `json:"controllers"`
+	// DisabledControllers are the controllers which are not running. The roles of their service accounts are not
+	// needed.
+	DisabledControllers []ControllerRBACReport `json:"disabledControllers,omitempty"`
+}
+
+// ControllerRBACReport describes the RBAC identity of a kube-controller-manager controller.
+type ControllerRBACReport struct {
+	// Name is the name of the controller.
+	Name string `json:"name"`
+	// ServiceAccount is the service account in the kube-system namespace used by the controller. It is empty if the
+	// controller uses the credentials of kube-controller-manager itself.
+	ServiceAccount string `json:"serviceAccount,omitempty"`
+	// Role is the name of the (cluster) role granting the permissions of the controller.
+	Role string `json:"role,omitempty"`
+}
+
+// computeRBACReport computes the RBAC report based on the same controller computation which is used for the
+// `--controllers` flag.
+func (k *kubeControllerManager) computeRBACReport(serviceAccountName string) *RBACReport {
+	enabled, disabled := k.computeControllers()
+
+	report := &RBACReport{
+		ServiceAccount: serviceAccountName,
+		ClusterRole:    clusterRoleNameKubeControllerManager,
+	}
+
+	for _, name := range sets.List(sets.KeySet(knownControllers)) {
+		controller := knownControllers[name]
+
+		entry := ControllerRBACReport{Name: name}
+		if controller.serviceAccountName != "" {
+			entry.ServiceAccount = controller.serviceAccountName
+			entry.Role = controllerRolePrefix + controller.serviceAccountName
+		}
+
+		if isControllerRunning(name, controller, enabled, disabled) {
+			report.Controllers = append(report.Controllers, entry)
+		} else {
+			report.DisabledControllers = append(report.DisabledControllers, entry)
+		}
+	}
+
+	return report
+}
+
+func isControllerRunning(name string, controller controllerRBAC, enabled, disabled sets.Set[string]) bool {
+	if disabled.Has(name) {
+		return false
+	}
+	return enabled.Has(name) || (enabled.Has("*") && !controller.disabledByDefault)
+}
+
+// reconcileRBACReport creates or updates the config map containing the RBAC report if it is enabled, otherwise it
+// deletes it.
+func (k *kubeControllerManager) reconcileRBACReport(ctx context.Context, serviceAccountName string) error {
+	configMap := k.emptyRBACReportConfigMap()
+
+	if !k.values.RBACReport {
+		return kubernetesutils.DeleteObject(ctx, k.seedClient.Client(), configMap)
+	}
+
+	data, err := yaml.Marshal(k.computeRBACReport(serviceAccountName))
+	if err != nil {
+		return err
+	}
+
+	_, err = controllerutils.GetAndCreateOrMergePatch(ctx, k.seedClient.Client(), configMap, func() error {
+		configMap.Labels = utils.MergeStringMaps(configMap.Labels, k.values.TenantLabels)
+		configMap.Data = map[string]string{DataKeyRBACReport: string(data)}
+		return nil
+	})
+	return err
+}
+
+func (k *kubeControllerManager) emptyRBACReportConfigMap() *corev1.ConfigMap {
+	return &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: k.values.NamePrefix + configMapNameRBACReport, Namespace: k.namespace}}
+}
@@ -0,0 +1,70 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kubecontrollermanager
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	"github.com/gardener/gardener/pkg/utils/gardener/secretsrotation"
+	"github.com/gardener/gardener/pkg/utils/secrets"
+	secretsmanager "github.com/gardener/gardener/pkg/utils/secrets/manager"
+)
+
+// serviceAccountKey describes the secret containing the key used by kube-controller-manager for signing service
+// account tokens.
+type serviceAccountKey struct {
+	secretName     string
+	mountPath      string
+	privateKeyFile string
+}
+
+// serviceAccountKey returns the key generated by the secrets manager or, if Values.ServiceAccountKeyBundleSecretName is
+// set, the current key of the externally managed bundle. In the latter case, the file name contains the current
+// version, hence kube-controller-manager is rolled out whenever the bundle is rotated.
+func (k *kubeControllerManager) serviceAccountKey(ctx context.Context) (serviceAccountKey, error) {
+	if k.values.ServiceAccountKeyBundleSecretName == "" {
+		secret, found := k.secretsManager.Get(v1beta1constants.SecretNameServiceAccountKey, secretsmanager.Current)
+		if !found {
+			return serviceAccountKey{}, fmt.Errorf("secret %q not found", v1beta1constants.SecretNameServiceAccountKey)
+		}
+
+		return serviceAccountKey{
+			secretName:     secret.Name,
+			mountPath:      volumeMountPathServiceAccountKey,
+			privateKeyFile: fmt.Sprintf("%s/%s", volumeMountPathServiceAccountKey, secrets.DataKeyRSAPrivateKey),
+		}, nil
+	}
+
+	secret := &corev1.Secret{}
+	if err := k.seedClient.Client().Get(ctx, client.ObjectKey{Name: k.values.ServiceAccountKeyBundleSecretName, Namespace: k.namespace}, secret); err != nil {
+		return serviceAccountKey{}, fmt.Errorf("failed reading service account key bundle secret: %w", err)
+	}
+
+	bundle, err := secretsrotation.ServiceAccountKeyBundleFromSecret(secret)
+	if err != nil {
+		return serviceAccountKey{}, err
+	}
+
+	return serviceAccountKey{
+		secretName:     bundle.SecretName,
+		mountPath:      volumeMountPathServiceAccountKeyBundle,
+		privateKeyFile: fmt.Sprintf("%s/%s", volumeMountPathServiceAccountKeyBundle, bundle.CurrentVersion),
+	}, nil
+}
@@ -16,15 +16,18 @@ package kubecontrollermanager
 
 import (
 	"context"
+	"fmt"
 
 	rbacv1 "k8s.io/api/rbac/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 	"github.com/gardener/gardener/pkg/client/kubernetes"
 	"github.com/gardener/gardener/pkg/utils/managedresources"
 )
 
-func (k *kubeControllerManager) reconcileShootResources(ctx context.Context, serviceAccountName string) error {
+func (k *kubeControllerManager) reconcileShootResources(ctx context.Context, serviceAccountName, authDelegationServiceAccountName string) error {
 	var (
 		registry = managedresources.NewRegistry(kubernetes.ShootScheme, kubernetes.ShootCodec, kubernetes.ShootSerializer)
 
@@ -45,10 +48,48 @@ func (k *kubeControllerManager) reconcileShootResources(ctx context.Context, ser
 		}
 	)
 
-	data, err := registry.AddAllAndSerialize(clusterRoleBinding)
+	objects := []client.Object{clusterRoleBinding}
+	if k.values.SeparateAuthDelegationKubeconfig {
+		// system:auth-delegator only allows creating TokenReviews and SubjectAccessReviews.
+		objects = append(objects, &rbacv1.ClusterRoleBinding{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "gardener.cloud:target:kube-controller-manager:auth-delegator",
+			},
+			RoleRef: rbacv1.RoleRef{
+				APIGroup: rbacv1.GroupName,
+				Kind:     "ClusterRole",
+				Name:     "system:auth-delegator",
+			},
+			Subjects: []rbacv1.Subject{{
+				Kind:      rbacv1.ServiceAccountKind,
+				Name:      authDelegationServiceAccountName,
+				Namespace: metav1.NamespaceSystem,
+			}},
+		})
+	}
+
+	data, err := registry.AddAllAndSerialize(objects...)
 	if err != nil {
 		return err
 	}
 
-	return managedresources.CreateForShoot(ctx, k.seedClient.Client(), k.namespace, ManagedResourceName, managedresources.LabelValueGardener, true, data)
+	// The tenant labels are added to the ManagedResource and its secret in the seed and injected into all objects in the
+	// shoot.
+	secretName, secret := managedresources.NewSecret(k.seedClient.Client(), k.namespace, ManagedResourceName, data, true)
+	secret.AddLabels(k.values.TenantLabels)
+
+	managedResource := managedresources.NewForShoot(k.seedClient.Client(), k.namespace, ManagedResourceName, managedresources.LabelValueGardener, true).
+		WithSecretRef(secretName).
+		WithLabels(k.withTenantLabels(map[string]string{managedresources.LabelKeyOrigin: managedresources.LabelValueGardener})).
+		WithInjectedLabels(k.withTenantLabels(map[string]string{v1beta1constants.ShootNoCleanup: "true"}))
+
+	if err := secret.Reconcile(ctx); err != nil {
+		return fmt.Errorf("could not create or update secret of managed resource: %w", err)
+	}
+
+	if err := managedResource.Reconcile(ctx); err != nil {
+		return fmt.Errorf("could not create or update managed resource: %w", err)
+	}
+
+	return nil
 }
@@ -16,7 +16,9 @@ package kubecontrollermanager
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	appsv1 "k8s.io/api/apps/v1"
@@ -26,13 +28,22 @@ import (
 	"k8s.io/client-go/tools/leaderelection/resourcelock"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	componentmetrics "github.com/gardener/gardener/pkg/component/metrics"
 	kubernetesutils "github.com/gardener/gardener/pkg/utils/kubernetes"
 	"github.com/gardener/gardener/pkg/utils/kubernetes/health"
 	"github.com/gardener/gardener/pkg/utils/managedresources"
 	"github.com/gardener/gardener/pkg/utils/retry"
 )
 
+const (
+	reasonProgressDeadlineExceeded = "ProgressDeadlineExceeded"
+	reasonCrashLoopBackOff         = "CrashLoopBackOff"
+	reasonOOMKilled                = "OOMKilled"
+)
+
 var (
 	// IntervalWaitForDeployment is the interval used while waiting for the Deployments to become healthy or deleted.
 	IntervalWaitForDeployment = 5 * time.Second
@@ -40,16 +51,224 @@ var (
 	TimeoutWaitForDeployment = 3 * time.Minute
 	// Until is an alias for retry.Until. Exposed for tests.
 	Until = retry.Until
+
+	// ErrDeploymentProgressDeadline is returned by Wait if the rollout of the kube-controller-manager deployment
+	// exceeded its progress deadline.
+	ErrDeploymentProgressDeadline = errors.New("kube-controller-manager deployment exceeded its progress deadline")
+	// ErrPodsCrashLooping is returned by Wait (wrapped in a PodsCrashLoopingError) if containers of the
+	// kube-controller-manager pods are crash-looping.
+	ErrPodsCrashLooping = errors.New("kube-controller-manager pods are crash-looping")
+	// ErrPodsImagePullFailing is returned by Wait (wrapped in an error exposing gardener error codes) if the images of
+	// the kube-controller-manager pods cannot be pulled.
+	ErrPodsImagePullFailing = errors.New("kube-controller-manager pods cannot pull their images")
+	// ErrPodsOOMKilled is returned by Wait (wrapped) if containers of the kube-controller-manager pods are not ready
+	// because they were killed for exceeding their memory limit. No gardener error code is attached since none of them
+	// fits a memory shortage of a control plane component which cannot be resolved by the shoot owner.
+	ErrPodsOOMKilled = errors.New("kube-controller-manager pods were killed because they ran out of memory")
 )
 
-func (k *kubeControllerManager) Wait(ctx context.Context) error {
+// ContainerStatusExtract contains the relevant information about the status of a crash-looping container.
+type ContainerStatusExtract struct {
+	// PodName is the name of the pod.
+	PodName string
+	// ContainerName is the name of the container.
+	ContainerName string
+	// RestartCount is the number of times the container has been restarted.
+	RestartCount int32
+	// ExitCode is the exit code of the last termination of the container.
+	ExitCode int32
+	// Reason is the reason of the last termination of the container.
+	Reason string
+	// Message is the message of the last termination of the container.
+	Message string
+}
+
+// PodsCrashLoopingError is returned by Wait if containers of the kube-controller-manager pods are crash-looping.
+type PodsCrashLoopingError struct {
+	// ContainerStatuses are extracts of the statuses of the crash-looping containers.
+	ContainerStatuses []ContainerStatusExtract
+
+	codes []gardencorev1beta1.ErrorCode
+}
+
+func (e *PodsCrashLoopingError) Error() string {
+	var details []string
+	for _, status := range e.ContainerStatuses {
+		detail := fmt.Sprintf("container %q of pod %q restarted %d times (exit code %d", status.ContainerName, status.PodName, status.RestartCount, status.ExitCode)
+		if status.Reason != "" {
+			detail += ", reason " + status.Reason
+		}
+		if status.Message != "" {
+			detail += ": " + status.Message
+		}
+		details = append(details, detail+")")
+	}
+
+	return fmt.Sprintf("%s: %s", ErrPodsCrashLooping.Error(), strings.Join(details, "; "))
+}
+
+// Is returns true if the target is ErrPodsCrashLooping.
+func (e *PodsCrashLoopingError) Is(target error) bool {
+	return target == ErrPodsCrashLooping
+}
+
+// Codes returns the gardener error codes determined from the statuses of the crash-looping containers, see
+// ErrorCodesForContainerStatus.
+func (e *PodsCrashLoopingError) Codes() []gardencorev1beta1.ErrorCode {
+	return e.codes
+}
+
+func (k *kubeControllerManager) Wait(ctx context.Context) (err error) {
+	defer componentmetrics.ObserveOperation(v1beta1constants.DeploymentNameKubeControllerManager, componentmetrics.OperationWait, time.Now(), &err)
+
 	timeoutCtx, cancel := context.WithTimeout(ctx, TimeoutWaitForDeployment)
 	defer cancel()
 
-	return Until(timeoutCtx, IntervalWaitForDeployment, health.IsDeploymentUpdated(k.seedClient.APIReader(), k.emptyDeployment()))
+	var (
+		deployment        = k.emptyDeployment()
+		deploymentUpdated = health.IsDeploymentUpdated(k.seedClient.APIReader(), deployment)
+	)
+
+	return Until(timeoutCtx, IntervalWaitForDeployment, func(ctx context.Context) (bool, error) {
+		done, err := deploymentUpdated(ctx)
+		if err == nil || done {
+			return done, err
+		}
+
+		if k.values.WaitProgressFunc != nil {
+			k.values.WaitProgressFunc(err.Error())
+		}
+
+		if progressDeadlineExceeded(deployment) {
+			return retry.SevereError(ErrDeploymentProgressDeadline)
+		}
+
+		pods, listErr := k.listPods(ctx, deployment)
+		if listErr != nil {
+			return retry.SevereError(listErr)
+		}
+		if crashLoopingErr := podsCrashLooping(pods); crashLoopingErr != nil {
+			return retry.MinorError(crashLoopingErr)
+		}
+		if imagePullErr := podsImagePullFailing(pods); imagePullErr != nil {
+			return retry.MinorError(imagePullErr)
+		}
+		if oomKilledErr := podsOOMKilled(pods); oomKilledErr != nil {
+			return retry.MinorError(oomKilledErr)
+		}
+
+		return done, err
+	})
+}
+
+func progressDeadlineExceeded(deployment *appsv1.Deployment) bool {
+	for _, condition := range deployment.Status.Conditions {
+		if condition.Type == appsv1.DeploymentProgressing && condition.Status == corev1.ConditionFalse && condition.Reason == reasonProgressDeadlineExceeded {
+			return true
+		}
+	}
+	return false
+}
+
+func (k *kubeControllerManager) listPods(ctx context.Context, deployment *appsv1.Deployment) ([]corev1.Pod, error) {
+	if deployment.Spec.Selector == nil {
+		return nil, nil
+	}
+
+	podList := &corev1.PodList{}
+	if err := k.seedClient.APIReader().List(ctx, podList, client.InNamespace(deployment.Namespace), client.MatchingLabels(deployment.Spec.Selector.MatchLabels)); err != nil {
+		return nil, fmt.Errorf("could not list pods of deployment %s: %w", client.ObjectKeyFromObject(deployment), err)
+	}
+
+	return podList.Items, nil
+}
+
+func podsCrashLooping(pods []corev1.Pod) *PodsCrashLoopingError {
+	var (
+		statuses []ContainerStatusExtract
+		codes    []gardencorev1beta1.ErrorCode
+	)
+
+	for _, pod := range pods {
+		for _, containerStatus := range pod.Status.ContainerStatuses {
+			if containerStatus.State.Waiting == nil || containerStatus.State.Waiting.Reason != reasonCrashLoopBackOff {
+				continue
+			}
+
+			status := ContainerStatusExtract{
+				PodName:       pod.Name,
+				ContainerName: containerStatus.Name,
+				RestartCount:  containerStatus.RestartCount,
+			}
+			if terminated := containerStatus.LastTerminationState.Terminated; terminated != nil {
+				status.ExitCode = terminated.ExitCode
+				status.Reason = terminated.Reason
+				status.Message = terminated.Message
+			}
+			statuses = append(statuses, status)
+			codes = appendErrorCodes(codes, ErrorCodesForContainerStatus(containerStatus)...)
+		}
+	}
+
+	if len(statuses) == 0 {
+		return nil
+	}
+	return &PodsCrashLoopingError{ContainerStatuses: statuses, codes: codes}
+}
+
+func podsImagePullFailing(pods []corev1.Pod) error {
+	var (
+		details []string
+		codes   []gardencorev1beta1.ErrorCode
+	)
+
+	for _, pod := range pods {
+		for _, containerStatus := range pod.Status.ContainerStatuses {
+			waiting := containerStatus.State.Waiting
+			if waiting == nil || (waiting.Reason != reasonErrImagePull && waiting.Reason != reasonImagePullBackOff && waiting.Reason != reasonInvalidImageName) {
+				continue
+			}
+
+			details = append(details, fmt.Sprintf("container %q of pod %q (reason %s: %s)", containerStatus.Name, pod.Name, waiting.Reason, waiting.Message))
+			codes = appendErrorCodes(codes, ErrorCodesForContainerStatus(containerStatus)...)
+		}
+	}
+
+	if len(details) == 0 {
+		return nil
+	}
+	return v1beta1helper.NewErrorWithCodes(fmt.Errorf("%w: %s", ErrPodsImagePullFailing, strings.Join(details, "; ")), codes...)
 }
 
-func (k *kubeControllerManager) WaitCleanup(ctx context.Context) error {
+// podsOOMKilled returns an error if containers of the given pods are not ready and their current or last termination
+// was caused by exceeding their memory limit. Crash-looping containers are already covered by podsCrashLooping.
+func podsOOMKilled(pods []corev1.Pod) error {
+	var details []string
+
+	for _, pod := range pods {
+		for _, containerStatus := range pod.Status.ContainerStatuses {
+			if containerStatus.Ready {
+				continue
+			}
+
+			for _, state := range []corev1.ContainerState{containerStatus.State, containerStatus.LastTerminationState} {
+				if state.Terminated != nil && state.Terminated.Reason == reasonOOMKilled {
+					details = append(details, fmt.Sprintf("container %q of pod %q (restarted %d times)", containerStatus.Name, pod.Name, containerStatus.RestartCount))
+					break
+				}
+			}
+		}
+	}
+
+	if len(details) == 0 {
+		return nil
+	}
+	return fmt.Errorf("%w: %s", ErrPodsOOMKilled, strings.Join(details, "; "))
+}
+
+func (k *kubeControllerManager) WaitCleanup(ctx context.Context) (err error) {
+	defer componentmetrics.ObserveOperation(v1beta1constants.DeploymentNameKubeControllerManager, componentmetrics.OperationWaitCleanup, time.Now(), &err)
+
 	timeoutCtx, cancel := context.WithTimeout(ctx, TimeoutWaitForDeployment)
 	defer cancel()
 
@@ -16,6 +16,7 @@ package kubernetesdashboard
 
 import (
 	"context"
+	"fmt"
 	"time"
 
 	appsv1 "k8s.io/api/apps/v1"
@@ -25,6 +26,7 @@ import (
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/intstr"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	vpaautoscalingv1 "k8s.io/autoscaler/vertical-pod-autoscaler/pkg/apis/autoscaling.k8s.io/v1"
 	"k8s.io/utils/pointer"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -33,7 +35,10 @@ import (
 	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
 	"github.com/gardener/gardener/pkg/client/kubernetes"
 	"github.com/gardener/gardener/pkg/component"
+	kubernetesutils "github.com/gardener/gardener/pkg/utils/kubernetes"
 	"github.com/gardener/gardener/pkg/utils/managedresources"
+	secretsutils "github.com/gardener/gardener/pkg/utils/secrets"
+	secretsmanager "github.com/gardener/gardener/pkg/utils/secrets/manager"
 )
 
 const (
@@ -42,6 +47,15 @@ const (
 	labelKey    = "k8s-app"
 	// ManagedResourceName is the name of the ManagedResource containing the resource specifications.
 	ManagedResourceName = "shoot-addon-kubernetes-dashboard"
+
+	oidcProxyName                   = "oidc-proxy"
+	oidcProxyPort                   = 4180
+	oidcProxyVolumeMountPath        = "/etc/oidc-proxy"
+	secretNameOIDCProxy             = name + "-" + oidcProxyName
+	secretNameOIDCProxyCookieSecret = name + "-" + oidcProxyName + "-cookie-secret"
+	dataKeyOIDCProxyCookieSecret    = "cookie-secret"
+	dataKeyOIDCProxyClientSecret    = "client-secret"
+	dataKeyOIDCProxyCABundle        = "ca.crt"
 )
 
 // Interface contains functions for a kubernetes-dashboard deployer.
@@ -61,29 +75,68 @@ type Values struct {
 	VPAEnabled bool
 	// AuthenticationMode defines the authentication mode for the kubernetes-dashboard.
 	AuthenticationMode string
+	// OIDCProxy is the configuration of the OIDC auth proxy running in front of kubernetes-dashboard. If nil, no proxy
+	// is deployed.
+	OIDCProxy *OIDCProxy
+}
+
+// OIDCProxy contains the configuration of the OIDC auth proxy running in front of kubernetes-dashboard. The proxy
+// authenticates users at the OIDC provider and forwards their ID token to kubernetes-dashboard, hence the OIDC
+// provider must be trusted by kube-apiserver and the dashboard must use the token authentication mode.
+type OIDCProxy struct {
+	// Image is the container image used for the OIDC auth proxy.
+	Image string
+	// IssuerURL is the URL of the OIDC provider.
+	IssuerURL string
+	// ClientID is the ID of the OIDC client.
+	ClientID string
+	// ClientSecret is the secret of the OIDC client. If empty, the authorization code flow is protected with PKCE
+	// instead.
+	ClientSecret string
+	// CABundle is the PEM encoded CA bundle used for verifying the OIDC provider. If empty, the system trust store is
+	// used.
+	CABundle string
 }
 
 // New creates a new instance of DeployWaiter for the kubernetes-dashboard.
 func New(
 	client client.Client,
 	namespace string,
+	secretsManager secretsmanager.Interface,
 	values Values,
 ) Interface {
 	return &kubernetesDashboard{
-		client:    client,
-		namespace: namespace,
-		values:    values,
+		client:         client,
+		namespace:      namespace,
+		secretsManager: secretsManager,
+		values:         values,
 	}
 }
 
 type kubernetesDashboard struct {
-	client    client.Client
-	namespace string
-	values    Values
+	client         client.Client
+	namespace      string
+	secretsManager secretsmanager.Interface
+	values         Values
 }
 
 func (k *kubernetesDashboard) Deploy(ctx context.Context) error {
-	data, err := k.computeResourcesData()
+	var oidcProxyCookieSecret []byte
+	if k.values.OIDCProxy != nil {
+		// The cookie secret is used for encrypting the session cookies of the OIDC auth proxy. It must be 16, 24 or 32
+		// bytes long.
+		secret, err := k.secretsManager.Generate(ctx, &secretsutils.BasicAuthSecretConfig{
+			Name:           secretNameOIDCProxyCookieSecret,
+			Format:         secretsutils.BasicAuthFormatNormal,
+			PasswordLength: 32,
+		}, secretsmanager.Persist(), secretsmanager.Rotate(secretsmanager.InPlace))
+		if err != nil {
+			return err
+		}
+		oidcProxyCookieSecret = secret.Data[secretsutils.DataKeyPassword]
+	}
+
+	data, err := k.computeResourcesData(oidcProxyCookieSecret)
 	if err != nil {
 		return err
 	}
@@ -112,7 +165,7 @@ func (k *kubernetesDashboard) WaitCleanup(ctx context.Context) error {
 	return managedresources.WaitUntilDeleted(timeoutCtx, k.client, k.namespace, ManagedResourceName)
 }
 
-func (k *kubernetesDashboard) computeResourcesData() (map[string][]byte, error) {
+func (k *kubernetesDashboard) computeResourcesData(oidcProxyCookieSecret []byte) (map[string][]byte, error) {
 	var (
 		registry = managedresources.NewRegistry(kubernetes.ShootScheme, kubernetes.ShootCodec, kubernetes.ShootSerializer)
 
@@ -483,7 +536,8 @@ func (k *kubernetesDashboard) computeResourcesData() (map[string][]byte, error)
 			},
 		}
 
-		vpa *vpaautoscalingv1.VerticalPodAutoscaler
+		vpa             *vpaautoscalingv1.VerticalPodAutoscaler
+		secretOIDCProxy *corev1.Secret
 	)
 
 	if k.values.VPAEnabled {
@@ -520,6 +574,43 @@ func (k *kubernetesDashboard) computeResourcesData() (map[string][]byte, error)
 		})
 	}
 
+	if k.values.OIDCProxy != nil {
+		secretOIDCProxy = &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      secretNameOIDCProxy,
+				Namespace: v1beta1constants.KubernetesDashboardNamespace,
+				Labels:    map[string]string{labelKey: name},
+			},
+			Type: corev1.SecretTypeOpaque,
+			Data: map[string][]byte{dataKeyOIDCProxyCookieSecret: oidcProxyCookieSecret},
+		}
+		if k.values.OIDCProxy.ClientSecret != "" {
+			secretOIDCProxy.Data[dataKeyOIDCProxyClientSecret] = []byte(k.values.OIDCProxy.ClientSecret)
+		}
+		if k.values.OIDCProxy.CABundle != "" {
+			secretOIDCProxy.Data[dataKeyOIDCProxyCABundle] = []byte(k.values.OIDCProxy.CABundle)
+		}
+		utilruntime.Must(kubernetesutils.MakeUnique(secretOIDCProxy))
+
+		deploymentDashboard.Spec.Template.Spec.Containers = append(deploymentDashboard.Spec.Template.Spec.Containers, k.oidcProxyContainer(secretOIDCProxy.Name))
+		deploymentDashboard.Spec.Template.Spec.Volumes = append(deploymentDashboard.Spec.Template.Spec.Volumes, corev1.Volume{
+			Name: oidcProxyName,
+			VolumeSource: corev1.VolumeSource{
+				Secret: &corev1.SecretVolumeSource{
+					SecretName: secretOIDCProxy.Name,
+				},
+			},
+		})
+
+		// Services with multiple ports require names for all of them.
+		serviceDashboard.Spec.Ports[0].Name = "https"
+		serviceDashboard.Spec.Ports = append(serviceDashboard.Spec.Ports, corev1.ServicePort{
+			Name:       oidcProxyName,
+			Port:       int32(oidcProxyPort),
+			TargetPort: intstr.FromInt32(oidcProxyPort),
+		})
+	}
+
 	return registry.AddAllAndSerialize(
 		namespace,
 		role,
@@ -536,9 +627,92 @@ func (k *kubernetesDashboard) computeResourcesData() (map[string][]byte, error)
 		serviceDashboard,
 		serviceMetricsScraper,
 		vpa,
+		secretOIDCProxy,
 	)
 }
 
+// oidcProxyContainer returns the container of the OIDC auth proxy which runs as sidecar of kubernetes-dashboard. It
+// forwards the ID token of the authenticated user to kubernetes-dashboard, which uses it for its requests to
+// kube-apiserver.
+func (k *kubernetesDashboard) oidcProxyContainer(secretName string) corev1.Container {
+	args := []string{
+		"--provider=oidc",
+		"--oidc-issuer-url=" + k.values.OIDCProxy.IssuerURL,
+		"--client-id=" + k.values.OIDCProxy.ClientID,
+		fmt.Sprintf("--http-address=0.0.0.0:%d", oidcProxyPort),
+		"--upstream=https://127.0.0.1:8443/",
+		// kubernetes-dashboard serves an auto-generated self-signed certificate.
+		"--ssl-upstream-insecure-skip-verify=true",
+		"--pass-authorization-header=true",
+		"--email-domain=*",
+		"--skip-provider-button=true",
+	}
+
+	if k.values.OIDCProxy.ClientSecret != "" {
+		args = append(args, "--client-secret-file="+oidcProxyVolumeMountPath+"/"+dataKeyOIDCProxyClientSecret)
+	} else {
+		args = append(args, "--code-challenge-method=S256")
+	}
+
+	if k.values.OIDCProxy.CABundle != "" {
+		args = append(args, "--provider-ca-file="+oidcProxyVolumeMountPath+"/"+dataKeyOIDCProxyCABundle)
+	}
+
+	return corev1.Container{
+		Name:            oidcProxyName,
+		Image:           k.values.OIDCProxy.Image,
+		ImagePullPolicy: corev1.PullIfNotPresent,
+		Args:            args,
+		Env: []corev1.EnvVar{{
+			Name: "OAUTH2_PROXY_COOKIE_SECRET",
+			ValueFrom: &corev1.EnvVarSource{
+				SecretKeyRef: &corev1.SecretKeySelector{
+					LocalObjectReference: corev1.LocalObjectReference{Name: secretName},
+					Key:                  dataKeyOIDCProxyCookieSecret,
+				},
+			},
+		}},
+		Ports: []corev1.ContainerPort{
+			{
+				Name:          oidcProxyName,
+				ContainerPort: int32(oidcProxyPort),
+				Protocol:      corev1.ProtocolTCP,
+			},
+		},
+		LivenessProbe: &corev1.Probe{
+			ProbeHandler: corev1.ProbeHandler{
+				HTTPGet: &corev1.HTTPGetAction{
+					Scheme: corev1.URISchemeHTTP,
+					Path:   "/ping",
+					Port:   intstr.FromInt32(oidcProxyPort),
+				},
+			},
+			InitialDelaySeconds: int32(10),
+			TimeoutSeconds:      int32(5),
+		},
+		Resources: corev1.ResourceRequirements{
+			Requests: corev1.ResourceList{
+				corev1.ResourceCPU:    resource.MustParse("10m"),
+				corev1.ResourceMemory: resource.MustParse("32Mi"),
+			},
+			Limits: corev1.ResourceList{
+				corev1.ResourceMemory: resource.MustParse("128Mi"),
+			},
+		},
+		SecurityContext: &corev1.SecurityContext{
+			AllowPrivilegeEscalation: pointer.Bool(false),
+			ReadOnlyRootFilesystem:   pointer.Bool(true),
+		},
+		VolumeMounts: []corev1.VolumeMount{
+			{
+				Name:      oidcProxyName,
+				MountPath: oidcProxyVolumeMountPath,
+				ReadOnly:  true,
+			},
+		},
+	}
+}
+
 func getLabels(labelValue string) map[string]string {
 	return map[string]string{
 		"origin":                    "gardener",
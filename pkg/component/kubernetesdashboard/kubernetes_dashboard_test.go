@@ -17,13 +17,18 @@ package kubernetesdashboard_test
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	"k8s.io/utils/pointer"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -37,6 +42,8 @@ import (
 	"github.com/gardener/gardener/pkg/resourcemanager/controller/garbagecollector/references"
 	"github.com/gardener/gardener/pkg/utils/retry"
 	retryfake "github.com/gardener/gardener/pkg/utils/retry/fake"
+	secretsmanager "github.com/gardener/gardener/pkg/utils/secrets/manager"
+	fakesecretsmanager "github.com/gardener/gardener/pkg/utils/secrets/manager/fake"
 	"github.com/gardener/gardener/pkg/utils/test"
 	. "github.com/gardener/gardener/pkg/utils/test/matchers"
 )
@@ -50,9 +57,10 @@ var _ = Describe("Kubernetes Dashboard", func() {
 		image               = "some-image:some-tag"
 		scraperImage        = "scraper-image:scraper-tag"
 
-		c         client.Client
-		values    Values
-		component component.DeployWaiter
+		c              client.Client
+		secretsManager secretsmanager.Interface
+		values         Values
+		component      component.DeployWaiter
 
 		managedResource       *resourcesv1alpha1.ManagedResource
 		managedResourceSecret *corev1.Secret
@@ -443,11 +451,12 @@ status: {}
 
 	BeforeEach(func() {
 		c = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).Build()
+		secretsManager = fakesecretsmanager.New(c, namespace)
 		values = Values{
 			Image:               image,
 			MetricsScraperImage: scraperImage,
 		}
-		component = New(c, namespace, values)
+		component = New(c, namespace, secretsManager, values)
 
 		managedResource = &resourcesv1alpha1.ManagedResource{
 			ObjectMeta: metav1.ObjectMeta{
@@ -464,10 +473,11 @@ status: {}
 	})
 
 	Describe("#Deploy", func() {
-		var vpaEnabled bool
+		var vpaEnabled, oidcProxyEnabled bool
 
 		BeforeEach(func() {
 			vpaEnabled = false
+			oidcProxyEnabled = false
 		})
 
 		JustBeforeEach(func() {
@@ -503,12 +513,17 @@ status: {}
 			Expect(managedResourceSecret.Type).To(Equal(corev1.SecretTypeOpaque))
 			Expect(managedResourceSecret.Immutable).To(Equal(pointer.Bool(true)))
 			Expect(managedResourceSecret.Labels["resources.gardener.cloud/garbage-collectable-reference"]).To(Equal("true"))
+			expectedDataLen := 14
 			if vpaEnabled {
-				Expect(managedResourceSecret.Data).To(HaveLen(15))
+				expectedDataLen++
 				Expect(string(managedResourceSecret.Data["verticalpodautoscaler__kubernetes-dashboard__kubernetes-dashboard.yaml"])).To(Equal(vpaYAML))
+			}
+			if oidcProxyEnabled {
+				expectedDataLen++
 			} else {
-				Expect(managedResourceSecret.Data).To(HaveLen(14))
+				Expect(string(managedResourceSecret.Data["service__kubernetes-dashboard__kubernetes-dashboard.yaml"])).To(Equal(serviceDashboardYAML))
 			}
+			Expect(managedResourceSecret.Data).To(HaveLen(expectedDataLen))
 
 			Expect(string(managedResourceSecret.Data["namespace____kubernetes-dashboard.yaml"])).To(Equal(namespacesYAML))
 			Expect(string(managedResourceSecret.Data["role__kubernetes-dashboard__kubernetes-dashboard.yaml"])).To(Equal(roleYAML))
@@ -520,7 +535,6 @@ status: {}
 			Expect(string(managedResourceSecret.Data["secret__kubernetes-dashboard__kubernetes-dashboard-csrf.yaml"])).To(Equal(secretCSRFYAML))
 			Expect(string(managedResourceSecret.Data["secret__kubernetes-dashboard__kubernetes-dashboard-key-holder.yaml"])).To(Equal(secretKeyHolderYAML))
 			Expect(string(managedResourceSecret.Data["configmap__kubernetes-dashboard__kubernetes-dashboard-settings.yaml"])).To(Equal(configMapYAML))
-			Expect(string(managedResourceSecret.Data["service__kubernetes-dashboard__kubernetes-dashboard.yaml"])).To(Equal(serviceDashboardYAML))
 			Expect(string(managedResourceSecret.Data["service__kubernetes-dashboard__dashboard-metrics-scraper.yaml"])).To(Equal(serviceMetricsScraperYAML))
 			fmt.Println(string(managedResourceSecret.Data["deployment__kubernetes-dashboard__dashboard-metrics-scraper.yaml"]))
 			Expect(string(managedResourceSecret.Data["deployment__kubernetes-dashboard__dashboard-metrics-scraper.yaml"])).To(Equal(deploymentMetricsScraperYAML))
@@ -543,13 +557,116 @@ status: {}
 				values.VPAEnabled = true
 				values.APIServerHost = &apiserverHost
 				values.AuthenticationMode = authenticationMode
-				component = New(c, namespace, values)
+				component = New(c, namespace, secretsManager, values)
 			})
 
 			It("should successfully deploy all resources", func() {
 				Expect(string(managedResourceSecret.Data["deployment__kubernetes-dashboard__kubernetes-dashboard.yaml"])).To(Equal(deploymentDashboardYAMLFor(&apiserverHost, authenticationMode)))
 			})
 		})
+
+		Context("w/ OIDC proxy", func() {
+			var (
+				deployment *appsv1.Deployment
+				service    *corev1.Service
+				secret     *corev1.Secret
+			)
+
+			BeforeEach(func() {
+				oidcProxyEnabled = true
+				values.AuthenticationMode = "token"
+				values.OIDCProxy = &OIDCProxy{
+					Image:     "oidc-proxy-image:oidc-proxy-tag",
+					IssuerURL: "https://issuer.example.com",
+					ClientID:  "dashboard",
+				}
+			})
+
+			JustBeforeEach(func() {
+				deployment = &appsv1.Deployment{}
+				Expect(runtime.DecodeInto(newCodec(), managedResourceSecret.Data["deployment__kubernetes-dashboard__kubernetes-dashboard.yaml"], deployment)).To(Succeed())
+
+				service = &corev1.Service{}
+				Expect(runtime.DecodeInto(newCodec(), managedResourceSecret.Data["service__kubernetes-dashboard__kubernetes-dashboard.yaml"], service)).To(Succeed())
+
+				var secretKey string
+				for key := range managedResourceSecret.Data {
+					if strings.HasPrefix(key, "secret__kubernetes-dashboard__kubernetes-dashboard-oidc-proxy-") {
+						secretKey = key
+					}
+				}
+				Expect(secretKey).NotTo(BeEmpty())
+				secret = &corev1.Secret{}
+				Expect(runtime.DecodeInto(newCodec(), managedResourceSecret.Data[secretKey], secret)).To(Succeed())
+			})
+
+			Context("w/o client secret, w/o CA bundle", func() {
+				BeforeEach(func() {
+					component = New(c, namespace, secretsManager, values)
+				})
+
+				It("should deploy the OIDC proxy as sidecar", func() {
+					Expect(deployment.Spec.Template.Spec.Containers).To(HaveLen(2))
+					container := deployment.Spec.Template.Spec.Containers[1]
+					Expect(container.Name).To(Equal("oidc-proxy"))
+					Expect(container.Image).To(Equal("oidc-proxy-image:oidc-proxy-tag"))
+					Expect(container.Args).To(ConsistOf(
+						"--provider=oidc",
+						"--oidc-issuer-url=https://issuer.example.com",
+						"--client-id=dashboard",
+						"--http-address=0.0.0.0:4180",
+						"--upstream=https://127.0.0.1:8443/",
+						"--ssl-upstream-insecure-skip-verify=true",
+						"--pass-authorization-header=true",
+						"--email-domain=*",
+						"--skip-provider-button=true",
+						"--code-challenge-method=S256",
+					))
+					Expect(container.Env).To(ConsistOf(corev1.EnvVar{
+						Name: "OAUTH2_PROXY_COOKIE_SECRET",
+						ValueFrom: &corev1.EnvVarSource{
+							SecretKeyRef: &corev1.SecretKeySelector{
+								LocalObjectReference: corev1.LocalObjectReference{Name: secret.Name},
+								Key:                  "cookie-secret",
+							},
+						},
+					}))
+					Expect(deployment.Spec.Template.Spec.Volumes).To(ContainElement(corev1.Volume{
+						Name:         "oidc-proxy",
+						VolumeSource: corev1.VolumeSource{Secret: &corev1.SecretVolumeSource{SecretName: secret.Name}},
+					}))
+
+					Expect(service.Spec.Ports).To(ConsistOf(
+						corev1.ServicePort{Name: "https", Port: 443, TargetPort: intstr.FromInt32(8443)},
+						corev1.ServicePort{Name: "oidc-proxy", Port: 4180, TargetPort: intstr.FromInt32(4180)},
+					))
+
+					Expect(secret.Immutable).To(PointTo(BeTrue()))
+					Expect(secret.Data).To(HaveLen(1))
+					Expect(secret.Data).To(HaveKeyWithValue("cookie-secret", HaveLen(32)))
+				})
+			})
+
+			Context("w/ client secret, w/ CA bundle", func() {
+				BeforeEach(func() {
+					values.OIDCProxy.ClientSecret = "client-secret"
+					values.OIDCProxy.CABundle = "ca-bundle"
+					component = New(c, namespace, secretsManager, values)
+				})
+
+				It("should configure the OIDC proxy with the client secret and the CA bundle", func() {
+					container := deployment.Spec.Template.Spec.Containers[1]
+					Expect(container.Args).To(ContainElements(
+						"--client-secret-file=/etc/oidc-proxy/client-secret",
+						"--provider-ca-file=/etc/oidc-proxy/ca.crt",
+					))
+					Expect(container.Args).NotTo(ContainElement("--code-challenge-method=S256"))
+
+					Expect(secret.Data).To(HaveKeyWithValue("client-secret", []byte("client-secret")))
+					Expect(secret.Data).To(HaveKeyWithValue("ca.crt", []byte("ca-bundle")))
+				})
+			})
+		})
 	})
 
 	Describe("#Destroy", func() {
@@ -660,3 +777,11 @@ status: {}
 		})
 	})
 })
+
+func newCodec() runtime.Codec {
+	var groupVersions []schema.GroupVersion
+	for k := range kubernetes.ShootScheme.AllKnownTypes() {
+		groupVersions = append(groupVersions, k.GroupVersion())
+	}
+	return kubernetes.ShootCodec.CodecForVersions(kubernetes.ShootSerializer, kubernetes.ShootSerializer, schema.GroupVersions(groupVersions), schema.GroupVersions(groupVersions))
+}
@@ -0,0 +1,76 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metrics
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	runtimemetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+// Namespace is the metric namespace for the operations of components.
+const Namespace = "gardener_component"
+
+const (
+	// OperationDeploy is the value of the 'operation' label for Deploy operations.
+	OperationDeploy = "deploy"
+	// OperationDestroy is the value of the 'operation' label for Destroy operations.
+	OperationDestroy = "destroy"
+	// OperationWait is the value of the 'operation' label for Wait operations.
+	OperationWait = "wait"
+	// OperationWaitCleanup is the value of the 'operation' label for WaitCleanup operations.
+	OperationWaitCleanup = "wait_cleanup"
+
+	// ResultSuccess is the value of the 'result' label for operations which succeeded.
+	ResultSuccess = "success"
+	// ResultError is the value of the 'result' label for operations which failed.
+	ResultError = "error"
+)
+
+var (
+	// Factory is used for registering metrics in the controller-runtime metrics registry.
+	Factory = promauto.With(runtimemetrics.Registry)
+
+	// OperationDuration defines the histogram operation_duration_seconds.
+	OperationDuration = Factory.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: Namespace,
+			Name:      "operation_duration_seconds",
+			Help:      "Histogram of duration of component operations (deploy, destroy, wait, wait_cleanup).",
+			// Start with 100ms with the last bucket being [~200s, Inf)
+			Buckets: prometheus.ExponentialBuckets(0.1, 2, 12),
+		},
+		[]string{
+			"component",
+			"operation",
+			"result",
+		},
+	)
+)
+
+// ObserveOperation records the duration (measured from the given start time) and the result of an operation of the
+// given component. It is meant to be deferred with a pointer to the named error return value of the operation, e.g.
+//
+//	defer metrics.ObserveOperation("foo", metrics.OperationDeploy, time.Now(), &err)
+func ObserveOperation(component, operation string, start time.Time, err *error) {
+	result := ResultSuccess
+	if err != nil && *err != nil {
+		result = ResultError
+	}
+
+	OperationDuration.WithLabelValues(component, operation, result).Observe(time.Since(start).Seconds())
+}
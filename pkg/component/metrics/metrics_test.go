@@ -0,0 +1,56 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metrics_test
+
+import (
+	"errors"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+
+	. "github.com/gardener/gardener/pkg/component/metrics"
+)
+
+var _ = Describe("Metrics", func() {
+	Describe("#ObserveOperation", func() {
+		BeforeEach(func() {
+			OperationDuration.Reset()
+		})
+
+		It("should record a successful operation", func() {
+			var err error
+			ObserveOperation("foo", OperationDeploy, time.Now(), &err)
+
+			Expect(testutil.CollectAndCount(OperationDuration)).To(Equal(1))
+			Expect(OperationDuration.DeleteLabelValues("foo", OperationDeploy, ResultSuccess)).To(BeTrue())
+		})
+
+		It("should record a failed operation", func() {
+			err := errors.New("fake")
+			ObserveOperation("foo", OperationWait, time.Now(), &err)
+
+			Expect(testutil.CollectAndCount(OperationDuration)).To(Equal(1))
+			Expect(OperationDuration.DeleteLabelValues("foo", OperationWait, ResultError)).To(BeTrue())
+		})
+
+		It("should treat a nil error pointer as success", func() {
+			ObserveOperation("foo", OperationDestroy, time.Now(), nil)
+
+			Expect(OperationDuration.DeleteLabelValues("foo", OperationDestroy, ResultSuccess)).To(BeTrue())
+		})
+	})
+})
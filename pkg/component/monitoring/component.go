@@ -110,6 +110,9 @@ type Values struct {
 	MonitoringConfig *gardencorev1beta1.Monitoring
 	// NamespaceUID is the UID of the namespace in the runtime cluster.
 	NamespaceUID types.UID
+	// NodeAgentEnabled specifies whether the worker nodes are managed by gardener-node-agent, i.e. whether its metrics
+	// are scraped.
+	NodeAgentEnabled bool
 	// NodeLocalDNSEnabled specifies whether node-local-dns is enabled.
 	NodeLocalDNSEnabled bool
 	// ProjectName is the name of the project.
@@ -265,6 +268,7 @@ func (m *monitoring) Deploy(ctx context.Context) error {
 				"name":                m.values.TargetName,
 				"project":             m.values.ProjectName,
 				"workerless":          m.values.IsWorkerless,
+				"nodeAgentEnabled":    m.values.NodeAgentEnabled,
 			},
 			"ignoreAlerts":            m.values.IgnoreAlerts,
 			"alerting":                alerting,
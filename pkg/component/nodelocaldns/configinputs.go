@@ -0,0 +1,171 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package nodelocaldns
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	nodelocaldnsconstants "github.com/gardener/gardener/pkg/component/nodelocaldns/constants"
+	"github.com/gardener/gardener/pkg/utils"
+)
+
+const (
+	// UpstreamModeClusterDNS means that queries for non-cluster domains are forwarded to the cluster DNS.
+	UpstreamModeClusterDNS = "cluster-dns"
+	// UpstreamModeUpstreamServers means that queries for non-cluster domains are forwarded to Values.UpstreamServers.
+	UpstreamModeUpstreamServers = "upstream-servers"
+	// UpstreamModeNodeResolvers means that queries for non-cluster domains are forwarded to the resolvers of the node
+	// captured before the kubelet starts.
+	UpstreamModeNodeResolvers = "node-resolvers"
+	// UpstreamModeHostResolvConf means that queries for non-cluster domains are forwarded to the resolvers configured in
+	// the resolv.conf of the host.
+	UpstreamModeHostResolvConf = "host-resolv-conf"
+	// UpstreamModeDefault means that queries for non-cluster domains are forwarded to the resolvers configured in the
+	// resolv.conf of the node-local-dns container.
+	UpstreamModeDefault = "default"
+)
+
+// ConfigInputs are the effective inputs used for rendering the configuration of node-local-dns. Their hash is added to
+// the node-local-dns ConfigMap, DaemonSet and pods (see nodelocaldnsconstants.AnnotationKeyConfigInputsHash), which
+// helps to identify why a node resolves differently than expected.
+type ConfigInputs struct {
+	// ClusterDomain is the domain used for cluster-wide DNS records.
+	ClusterDomain string `json:"clusterDomain"`
+	// ClusterDNS is the address to which queries for cluster domains are forwarded.
+	ClusterDNS string `json:"clusterDNS"`
+	// BindAddresses are the addresses node-local-dns binds.
+	BindAddresses []string `json:"bindAddresses"`
+	// HostNetworkEnabled indicates whether node-local-dns also serves pods running in the host network.
+	HostNetworkEnabled bool `json:"hostNetworkEnabled,omitempty"`
+	// ForceTCPToClusterDNS indicates whether queries to the cluster DNS are forced to use TCP.
+	ForceTCPToClusterDNS bool `json:"forceTCPToClusterDNS"`
+	// ForceTCPToUpstreamDNS indicates whether queries to the upstream DNS are forced to use TCP.
+	ForceTCPToUpstreamDNS bool `json:"forceTCPToUpstreamDNS"`
+	// UpstreamMode describes to which resolvers queries for non-cluster domains are forwarded, see the UpstreamMode*
+	// constants.
+	UpstreamMode string `json:"upstreamMode"`
+	// UpstreamServers are the DNS servers to which queries for non-cluster domains are forwarded if UpstreamMode is
+	// UpstreamModeUpstreamServers.
+	UpstreamServers []string `json:"upstreamServers,omitempty"`
+	// ClusterDNSForward are the settings of the forward plugin for the zones forwarded to the cluster DNS.
+	ClusterDNSForward *gardencorev1beta1.NodeLocalDNSForward `json:"clusterDNSForward,omitempty"`
+	// UpstreamDNSForward are the settings of the forward plugin for the zone forwarded to the upstream DNS.
+	UpstreamDNSForward *gardencorev1beta1.NodeLocalDNSForward `json:"upstreamDNSForward,omitempty"`
+	// CustomZones are the additional server blocks which are appended to the Corefile.
+	CustomZones []string `json:"customZones,omitempty"`
+}
+
+// Hash returns the hash of the config inputs.
+func (i ConfigInputs) Hash() string {
+	return utils.ComputeChecksum(i)[:16]
+}
+
+// ConfigInputsFor returns the effective inputs used for rendering the configuration of node-local-dns with the given
+// values.
+func ConfigInputsFor(values Values) (ConfigInputs, error) {
+	c := &nodeLocalDNS{values: values}
+
+	clusterDomain, err := c.clusterDomain()
+	if err != nil {
+		return ConfigInputs{}, err
+	}
+
+	return c.configInputs(clusterDomain), nil
+}
+
+func (c *nodeLocalDNS) configInputs(clusterDomain string) ConfigInputs {
+	inputs := ConfigInputs{
+		ClusterDomain:         clusterDomain,
+		ClusterDNS:            c.values.ClusterDNS,
+		BindAddresses:         c.ipvsAddresses(),
+		HostNetworkEnabled:    c.values.HostNetworkEnabled,
+		ForceTCPToClusterDNS:  c.forceTcpToClusterDNS() == "force_tcp",
+		ForceTCPToUpstreamDNS: c.forceTcpToUpstreamDNS() == "force_tcp",
+		ClusterDNSForward:     c.clusterDNSForward(),
+		UpstreamDNSForward:    c.upstreamDNSForward(),
+		CustomZones:           c.values.CustomZones,
+	}
+
+	if c.values.DNSServer != "" {
+		inputs.BindAddresses = append(inputs.BindAddresses, c.values.DNSServer)
+	}
+
+	// The order of the cases must match upstreamDNSAddress.
+	switch {
+	case c.values.Config != nil && pointer.BoolDeref(c.values.Config.DisableForwardToUpstreamDNS, false):
+		inputs.UpstreamMode = UpstreamModeClusterDNS
+	case len(c.values.UpstreamServers) > 0:
+		inputs.UpstreamMode = UpstreamModeUpstreamServers
+		inputs.UpstreamServers = c.values.UpstreamServers
+	case c.values.ForwardToNodeResolvers:
+		inputs.UpstreamMode = UpstreamModeNodeResolvers
+	case c.values.HostNetworkEnabled:
+		inputs.UpstreamMode = UpstreamModeHostResolvConf
+	default:
+		inputs.UpstreamMode = UpstreamModeDefault
+	}
+
+	return inputs
+}
+
+// configInputsHash returns the hash of the config inputs. An invalid cluster domain is already reported by Deploy and
+// StaticPodFiles, hence the default domain is used in this case.
+func (c *nodeLocalDNS) configInputsHash() string {
+	clusterDomain, err := c.clusterDomain()
+	if err != nil {
+		clusterDomain = gardencorev1beta1.DefaultDomain
+	}
+	return c.configInputs(clusterDomain).Hash()
+}
+
+// DetectConfigInputsDrift compares the config inputs hash of the node-local-dns pods in the shoot with the hash of the
+// config inputs for the given values. It returns the names of the nodes whose node-local-dns pod was rendered with
+// other inputs mapped to the hash found on the pod. Pods which were rendered before the hash was introduced are reported
+// with an empty hash, pods which are not scheduled yet with their own name.
+func DetectConfigInputsDrift(ctx context.Context, shootClient client.Reader, values Values) (map[string]string, error) {
+	inputs, err := ConfigInputsFor(values)
+	if err != nil {
+		return nil, err
+	}
+	expectedHash := inputs.Hash()
+
+	podList := &corev1.PodList{}
+	if err := shootClient.List(ctx, podList, client.InNamespace(metav1.NamespaceSystem), client.MatchingLabels{labelKey: nodelocaldnsconstants.LabelValue}); err != nil {
+		return nil, fmt.Errorf("failed listing node-local-dns pods: %w", err)
+	}
+
+	drift := make(map[string]string)
+	for _, pod := range podList.Items {
+		hash := pod.Annotations[nodelocaldnsconstants.AnnotationKeyConfigInputsHash]
+		if hash == expectedHash {
+			continue
+		}
+
+		if pod.Spec.NodeName != "" {
+			drift[pod.Spec.NodeName] = hash
+		} else {
+			drift[pod.Name] = hash
+		}
+	}
+
+	return drift, nil
+}
@@ -0,0 +1,191 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package nodelocaldns_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	. "github.com/gardener/gardener/pkg/component/nodelocaldns"
+)
+
+var _ = Describe("ConfigInputs", func() {
+	var values Values
+
+	BeforeEach(func() {
+		values = Values{
+			Image:      "some-image:some-tag",
+			ClusterDNS: "10.0.0.10",
+			DNSServer:  "10.0.0.10",
+		}
+	})
+
+	Describe("#ConfigInputsFor", func() {
+		It("should return the effective inputs", func() {
+			Expect(ConfigInputsFor(values)).To(Equal(ConfigInputs{
+				ClusterDomain:         "cluster.local",
+				ClusterDNS:            "10.0.0.10",
+				BindAddresses:         []string{"169.254.20.10", "10.0.0.10"},
+				ForceTCPToClusterDNS:  true,
+				ForceTCPToUpstreamDNS: true,
+				UpstreamMode:          "default",
+			}))
+		})
+
+		It("should consider the configuration and the upstream servers", func() {
+			values.Config = &gardencorev1beta1.NodeLocalDNS{ForceTCPToClusterDNS: pointer.Bool(false), ForceTCPToUpstreamDNS: pointer.Bool(false)}
+			values.UpstreamServers = []string{"1.1.1.1", "8.8.8.8"}
+			values.IPFamilies = []gardencorev1beta1.IPFamily{gardencorev1beta1.IPFamilyIPv6}
+
+			Expect(ConfigInputsFor(values)).To(Equal(ConfigInputs{
+				ClusterDomain:   "cluster.local",
+				ClusterDNS:      "10.0.0.10",
+				BindAddresses:   []string{"fd30:1319:f1e:230b::1", "10.0.0.10"},
+				UpstreamMode:    "upstream-servers",
+				UpstreamServers: []string{"1.1.1.1", "8.8.8.8"},
+			}))
+		})
+
+		It("should prefer forwarding to the cluster DNS", func() {
+			values.Config = &gardencorev1beta1.NodeLocalDNS{DisableForwardToUpstreamDNS: pointer.Bool(true)}
+			values.UpstreamServers = []string{"1.1.1.1"}
+
+			inputs, err := ConfigInputsFor(values)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(inputs.UpstreamMode).To(Equal("cluster-dns"))
+			Expect(inputs.UpstreamServers).To(BeEmpty())
+		})
+
+		It("should contain the cluster DNS forward settings derived from the CoreDNS replicas hint", func() {
+			values.CoreDNSReplicas = 2
+
+			inputs, err := ConfigInputsFor(values)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(inputs.ClusterDNSForward).To(Equal(&gardencorev1beta1.NodeLocalDNSForward{MaxConcurrent: pointer.Int32(2000)}))
+			Expect(inputs.UpstreamDNSForward).To(BeNil())
+		})
+
+		It("should fail for an invalid cluster domain", func() {
+			values.ClusterDomain = "Invalid_Domain"
+
+			_, err := ConfigInputsFor(values)
+			Expect(err).To(MatchError(ContainSubstring("invalid cluster domain")))
+		})
+	})
+
+	Describe("#Hash", func() {
+		It("should only change if the inputs change", func() {
+			inputs, err := ConfigInputsFor(values)
+			Expect(err).NotTo(HaveOccurred())
+			hash := inputs.Hash()
+
+			values.Image = "other-image:other-tag"
+			values.VPAEnabled = true
+			inputs, err = ConfigInputsFor(values)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(inputs.Hash()).To(Equal(hash))
+
+			values.ForwardToNodeResolvers = true
+			inputs, err = ConfigInputsFor(values)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(inputs.Hash()).NotTo(Equal(hash))
+			hash = inputs.Hash()
+
+			values.Config = &gardencorev1beta1.NodeLocalDNS{UpstreamDNSForward: &gardencorev1beta1.NodeLocalDNSForward{Policy: pointer.String("sequential")}}
+			inputs, err = ConfigInputsFor(values)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(inputs.Hash()).NotTo(Equal(hash))
+			hash = inputs.Hash()
+
+			values.CustomZones = []string{"corp.example.com:53 {\n    forward . 10.1.0.53\n}\n"}
+			inputs, err = ConfigInputsFor(values)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(inputs.Hash()).NotTo(Equal(hash))
+		})
+	})
+
+	Describe("#DetectConfigInputsDrift", func() {
+		var (
+			ctx         = context.TODO()
+			shootClient client.Client
+		)
+
+		BeforeEach(func() {
+			shootClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.ShootScheme).Build()
+
+			inputs, err := ConfigInputsFor(values)
+			Expect(err).NotTo(HaveOccurred())
+
+			for _, pod := range []*corev1.Pod{
+				newNodeLocalDNSPod("pod1", "node1", inputs.Hash()),
+				newNodeLocalDNSPod("pod2", "node2", "outdated"),
+				newNodeLocalDNSPod("pod3", "node3", ""),
+				newNodeLocalDNSPod("pod4", "", "outdated"),
+			} {
+				Expect(shootClient.Create(ctx, pod)).To(Succeed())
+			}
+			Expect(shootClient.Create(ctx, &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "other", Namespace: "kube-system"}})).To(Succeed())
+		})
+
+		It("should return the nodes whose pods were rendered with other inputs", func() {
+			Expect(DetectConfigInputsDrift(ctx, shootClient, values)).To(Equal(map[string]string{
+				"node2": "outdated",
+				"node3": "",
+				"pod4":  "outdated",
+			}))
+		})
+
+		It("should not return any drift if all pods were rendered with the inputs", func() {
+			values.ForwardToNodeResolvers = true
+			inputs, err := ConfigInputsFor(values)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(DetectConfigInputsDrift(ctx, shootClient, values)).To(HaveLen(4))
+
+			podList := &corev1.PodList{}
+			Expect(shootClient.List(ctx, podList, client.MatchingLabels{"k8s-app": "node-local-dns"})).To(Succeed())
+			for _, pod := range podList.Items {
+				metav1.SetMetaDataAnnotation(&pod.ObjectMeta, "node-local-dns.gardener.cloud/config-inputs-hash", inputs.Hash())
+				Expect(shootClient.Update(ctx, &pod)).To(Succeed())
+			}
+
+			Expect(DetectConfigInputsDrift(ctx, shootClient, values)).To(BeEmpty())
+		})
+	})
+})
+
+func newNodeLocalDNSPod(name, nodeName, hash string) *corev1.Pod {
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: "kube-system",
+			Labels:    map[string]string{"k8s-app": "node-local-dns"},
+		},
+		Spec: corev1.PodSpec{NodeName: nodeName},
+	}
+	if hash != "" {
+		metav1.SetMetaDataAnnotation(&pod.ObjectMeta, "node-local-dns.gardener.cloud/config-inputs-hash", hash)
+	}
+	return pod
+}
@@ -17,6 +17,13 @@ package constants
 const (
 	// IPVSAddress is the IPv4 address used by node-local-dns when IPVS is used.
 	IPVSAddress = "169.254.20.10"
+	// IPVSIPv6Address is the IPv6 address used by node-local-dns when IPVS is used. IPv6 link-local addresses are only
+	// unique per interface and cannot be used in a resolv.conf without a zone, hence an address of the unique local
+	// range is used instead.
+	IPVSIPv6Address = "fd30:1319:f1e:230b::1"
 	// LabelValue is the value of a label used for the identification of node-local-dns pods.
 	LabelValue = "node-local-dns"
+	// AnnotationKeyConfigInputsHash is the key of an annotation on the node-local-dns ConfigMap, DaemonSet and pods whose
+	// value is the hash of the effective inputs used for rendering the configuration of node-local-dns.
+	AnnotationKeyConfigInputsHash = "node-local-dns.gardener.cloud/config-inputs-hash"
 )
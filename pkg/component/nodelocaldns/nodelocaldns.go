@@ -16,7 +16,10 @@ package nodelocaldns
 
 import (
 	"context"
+	"fmt"
+	"net"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/Masterminds/semver/v3"
@@ -27,19 +30,23 @@ import (
 	rbacv1 "k8s.io/api/rbac/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/intstr"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/validation"
 	vpaautoscalingv1 "k8s.io/autoscaler/vertical-pod-autoscaler/pkg/apis/autoscaling.k8s.io/v1"
 	"k8s.io/utils/pointer"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
 	"github.com/gardener/gardener/pkg/client/kubernetes"
 	"github.com/gardener/gardener/pkg/component"
 	nodelocaldnsconstants "github.com/gardener/gardener/pkg/component/nodelocaldns/constants"
 	"github.com/gardener/gardener/pkg/resourcemanager/controller/garbagecollector/references"
+	"github.com/gardener/gardener/pkg/utils"
 	kubernetesutils "github.com/gardener/gardener/pkg/utils/kubernetes"
 	"github.com/gardener/gardener/pkg/utils/managedresources"
 )
@@ -58,10 +65,33 @@ const (
 	prometheusScrape    = true
 	prometheusErrorPort = 9353
 
-	domain            = gardencorev1beta1.DefaultDomain
-	serviceName       = "kube-dns-upstream"
-	livenessProbePort = 8099
-	configDataKey     = "Corefile"
+	serviceName        = "kube-dns-upstream"
+	serviceAccountName = "node-local-dns"
+	livenessProbePort  = 8099
+	configDataKey      = "Corefile"
+
+	annotationEnableDaemonSetEviction = "cluster-autoscaler.kubernetes.io/enable-ds-eviction"
+
+	// DefaultMaxConcurrentPerCoreDNSReplica is the default number of concurrent queries node-local-dns forwards to the
+	// cluster DNS per CoreDNS replica if Values.CoreDNSReplicas is set.
+	DefaultMaxConcurrentPerCoreDNSReplica int32 = 1000
+
+	// PathStaticPodManifest is the path of the static pod manifest for node-local-dns if it runs as a static pod.
+	PathStaticPodManifest = v1beta1constants.OperatingSystemConfigFilePathStaticPodManifests + "/node-local-dns.yaml"
+	// PathCorefileDirectory is the path of the directory containing the Corefile for node-local-dns if it runs as a
+	// static pod.
+	PathCorefileDirectory = "/var/lib/node-local-dns"
+	// PathNodeResolvConf is the path of the file on the nodes containing the resolvers of the node which were captured
+	// before the kubelet starts. node-local-dns forwards queries for non-cluster domains to them if
+	// Values.ForwardToNodeResolvers is set.
+	PathNodeResolvConf = PathCorefileDirectory + "/node-resolv.conf"
+
+	pathHostResolvConf        = "/etc/resolv.conf"
+	volumeMountPathResolvConf = "/etc/host-resolv.conf"
+	volumeNameHostResolvConf  = "host-resolv-conf"
+
+	volumeMountPathNodeResolvConf = "/etc/node-resolv.conf"
+	volumeNameNodeResolvConf      = "node-resolv-conf"
 )
 
 // Interface contains functions for a node-local-dns deployer.
@@ -86,6 +116,42 @@ type Values struct {
 	PSPDisabled bool
 	// KubernetesVersion is the Kubernetes version of the Shoot.
 	KubernetesVersion *semver.Version
+	// ClusterDomain is the domain used for cluster-wide DNS records. Defaults to the default cluster domain.
+	ClusterDomain string
+	// HostNetworkEnabled indicates whether node-local-dns also serves pods running in the host network. If enabled,
+	// node-local-dns forwards non-cluster queries to the resolvers configured in the resolv.conf of the host, since the
+	// kubelet is configured to hand out node-local-dns as resolver. No additional address is bound, since the link-local
+	// address of node-local-dns is reachable from the host network, and a dedicated port cannot be expressed in a
+	// resolv.conf anyway.
+	HostNetworkEnabled bool
+	// StaticPodEnabled indicates whether node-local-dns runs as a static pod managed by the kubelet instead of a
+	// DaemonSet. In this case, the static pod manifest and the Corefile are part of the OperatingSystemConfig (see
+	// StaticPodFiles), hence node-local-dns does not depend on the API server for bootstrapping DNS on the nodes. The
+	// Corefile cannot be completed by node-local-dns based on the environment of the kube-dns-upstream Service then,
+	// hence ClusterDNS must be an IP address and DNSServer must be empty.
+	StaticPodEnabled bool
+	// ForwardToNodeResolvers indicates whether node-local-dns forwards queries for non-cluster domains to the resolvers
+	// of the node (e.g., handed out via DHCP) instead of the resolvers configured in its own resolv.conf. The resolvers
+	// are captured to PathNodeResolvConf before the kubelet starts, see the kubelet component of the
+	// OperatingSystemConfig. This is required for environments with node-specific resolvers.
+	ForwardToNodeResolvers bool
+	// UpstreamServers are the DNS servers to which node-local-dns forwards queries for non-cluster domains. If set, they
+	// take precedence over the resolvers of the node.
+	UpstreamServers []string
+	// CustomZones are additional server blocks which are appended to the Corefile.
+	CustomZones []string
+	// IPFamilies are the IP families of the shoot networking. node-local-dns binds an address for each of them. If
+	// empty, IPv4 is assumed.
+	IPFamilies []gardencorev1beta1.IPFamily
+	// CoreDNSReplicas is a hint for the number of CoreDNS replicas serving the queries forwarded to the cluster DNS,
+	// e.g. the minimum number of replicas CoreDNS is scaled to. If set, the maximum number of concurrent queries forwarded
+	// to the cluster DNS is derived from it unless it is configured explicitly, so that node-local-dns refuses excess
+	// queries early instead of piling them up on saturated CoreDNS replicas. The hint should be stable, i.e. it should not
+	// follow the current number of replicas, since changing it changes the Corefile and hence rolls node-local-dns.
+	CoreDNSReplicas int32
+	// MaxConcurrentPerCoreDNSReplica is the number of concurrent queries forwarded to the cluster DNS per CoreDNS
+	// replica. Defaults to DefaultMaxConcurrentPerCoreDNSReplica.
+	MaxConcurrentPerCoreDNSReplica int32
 }
 
 // New creates a new instance of DeployWaiter for node-local-dns.
@@ -138,12 +204,17 @@ func (c *nodeLocalDNS) WaitCleanup(ctx context.Context) error {
 }
 
 func (c *nodeLocalDNS) computeResourcesData() (map[string][]byte, error) {
+	clusterDomain, err := c.clusterDomain()
+	if err != nil {
+		return nil, err
+	}
+
 	var (
 		registry = managedresources.NewRegistry(kubernetes.ShootScheme, kubernetes.ShootCodec, kubernetes.ShootSerializer)
 
 		serviceAccount = &corev1.ServiceAccount{
 			ObjectMeta: metav1.ObjectMeta{
-				Name:      "node-local-dns",
+				Name:      serviceAccountName,
 				Namespace: metav1.NamespaceSystem,
 			},
 			AutomountServiceAccountToken: pointer.Bool(false),
@@ -156,57 +227,12 @@ func (c *nodeLocalDNS) computeResourcesData() (map[string][]byte, error) {
 				Labels: map[string]string{
 					labelKey: nodelocaldnsconstants.LabelValue,
 				},
+				Annotations: map[string]string{
+					nodelocaldnsconstants.AnnotationKeyConfigInputsHash: c.configInputs(clusterDomain).Hash(),
+				},
 			},
 			Data: map[string]string{
-				configDataKey: domain + `:53 {
-    errors
-    cache {
-            success 9984 30
-            denial 9984 5
-    }
-    reload
-    loop
-    bind ` + c.bindIP() + `
-    forward . ` + c.values.ClusterDNS + ` {
-            ` + c.forceTcpToClusterDNS() + `
-    }
-    prometheus :` + strconv.Itoa(prometheusPort) + `
-    health ` + nodelocaldnsconstants.IPVSAddress + `:` + strconv.Itoa(livenessProbePort) + `
-    }
-in-addr.arpa:53 {
-    errors
-    cache 30
-    reload
-    loop
-    bind ` + c.bindIP() + `
-    forward . ` + c.values.ClusterDNS + ` {
-            ` + c.forceTcpToClusterDNS() + `
-    }
-    prometheus :` + strconv.Itoa(prometheusPort) + `
-    }
-ip6.arpa:53 {
-    errors
-    cache 30
-    reload
-    loop
-    bind ` + c.bindIP() + `
-    forward . ` + c.values.ClusterDNS + ` {
-            ` + c.forceTcpToClusterDNS() + `
-    }
-    prometheus :` + strconv.Itoa(prometheusPort) + `
-    }
-.:53 {
-    errors
-    cache 30
-    reload
-    loop
-    bind ` + c.bindIP() + `
-    forward . ` + c.upstreamDNSAddress() + ` {
-            ` + c.forceTcpToUpstreamDNS() + `
-    }
-    prometheus :` + strconv.Itoa(prometheusPort) + `
-    }
-`,
+				configDataKey: c.corefile(clusterDomain),
 			},
 		}
 	)
@@ -240,9 +266,8 @@ ip6.arpa:53 {
 			},
 		}
 
-		maxUnavailable       = intstr.FromString("10%")
-		hostPathFileOrCreate = corev1.HostPathFileOrCreate
-		daemonSet            = &appsv1.DaemonSet{
+		maxUnavailable = intstr.FromString("10%")
+		daemonSet      = &appsv1.DaemonSet{
 			ObjectMeta: metav1.ObjectMeta{
 				Name:      "node-local-dns",
 				Namespace: metav1.NamespaceSystem,
@@ -252,6 +277,9 @@ ip6.arpa:53 {
 					managedresources.LabelKeyOrigin:             managedresources.LabelValueGardener,
 					v1beta1constants.LabelNodeCriticalComponent: "true",
 				},
+				Annotations: map[string]string{
+					nodelocaldnsconstants.AnnotationKeyConfigInputsHash: c.configInputs(clusterDomain).Hash(),
+				},
 			},
 			Spec: appsv1.DaemonSetSpec{
 				UpdateStrategy: appsv1.DaemonSetUpdateStrategy{
@@ -264,159 +292,7 @@ ip6.arpa:53 {
 						labelKey: nodelocaldnsconstants.LabelValue,
 					},
 				},
-				Template: corev1.PodTemplateSpec{
-					ObjectMeta: metav1.ObjectMeta{
-						Labels: map[string]string{
-							labelKey:                                    nodelocaldnsconstants.LabelValue,
-							v1beta1constants.LabelNetworkPolicyToDNS:    "allowed",
-							v1beta1constants.LabelNodeCriticalComponent: "true",
-						},
-						Annotations: map[string]string{
-							"prometheus.io/port":   strconv.Itoa(prometheusPort),
-							"prometheus.io/scrape": strconv.FormatBool(prometheusScrape),
-						},
-					},
-					Spec: corev1.PodSpec{
-						PriorityClassName:  "system-node-critical",
-						ServiceAccountName: serviceAccount.Name,
-						HostNetwork:        true,
-						DNSPolicy:          corev1.DNSDefault,
-						SecurityContext: &corev1.PodSecurityContext{
-							SeccompProfile: &corev1.SeccompProfile{
-								Type: corev1.SeccompProfileTypeRuntimeDefault,
-							},
-						},
-						Tolerations: []corev1.Toleration{
-							{
-								Operator: corev1.TolerationOpExists,
-								Effect:   corev1.TaintEffectNoExecute,
-							},
-							{
-								Operator: corev1.TolerationOpExists,
-								Effect:   corev1.TaintEffectNoSchedule,
-							},
-						},
-						NodeSelector: map[string]string{
-							v1beta1constants.LabelNodeLocalDNS: "true",
-						},
-						Containers: []corev1.Container{
-							{
-								Name:  "node-cache",
-								Image: c.values.Image,
-								Resources: corev1.ResourceRequirements{
-									Requests: corev1.ResourceList{
-										corev1.ResourceCPU:    resource.MustParse("25m"),
-										corev1.ResourceMemory: resource.MustParse("25Mi"),
-									},
-									Limits: corev1.ResourceList{
-										corev1.ResourceMemory: resource.MustParse("100Mi"),
-									},
-								},
-								Args: []string{
-									"-localip",
-									c.containerArg(),
-									"-conf",
-									"/etc/Corefile",
-									"-upstreamsvc",
-									serviceName,
-									"-health-port",
-									strconv.Itoa(livenessProbePort),
-								},
-								SecurityContext: &corev1.SecurityContext{
-									Capabilities: &corev1.Capabilities{
-										Add: []corev1.Capability{"NET_ADMIN"},
-									},
-								},
-								Ports: []corev1.ContainerPort{
-									{
-										ContainerPort: int32(53),
-										Name:          "dns",
-										Protocol:      corev1.ProtocolUDP,
-									},
-									{
-										ContainerPort: int32(53),
-										Name:          "dns-tcp",
-										Protocol:      corev1.ProtocolTCP,
-									},
-									{
-										ContainerPort: int32(prometheusPort),
-										Name:          "metrics",
-										Protocol:      corev1.ProtocolTCP,
-									},
-									{
-										ContainerPort: int32(prometheusErrorPort),
-										Name:          "errormetrics",
-										Protocol:      corev1.ProtocolTCP,
-									},
-								},
-								LivenessProbe: &corev1.Probe{
-									ProbeHandler: corev1.ProbeHandler{
-										HTTPGet: &corev1.HTTPGetAction{
-											Host: nodelocaldnsconstants.IPVSAddress,
-											Path: "/health",
-											Port: intstr.FromInt32(livenessProbePort),
-										},
-									},
-									InitialDelaySeconds: int32(60),
-									TimeoutSeconds:      int32(5),
-								},
-								VolumeMounts: []corev1.VolumeMount{
-									{
-										MountPath: "/run/xtables.lock",
-										Name:      "xtables-lock",
-										ReadOnly:  false,
-									},
-									{
-										MountPath: "/etc/coredns",
-										Name:      "config-volume",
-									},
-									{
-										MountPath: "/etc/kube-dns",
-										Name:      "kube-dns-config",
-									},
-								},
-							},
-						},
-						Volumes: []corev1.Volume{
-							{
-								Name: "xtables-lock",
-								VolumeSource: corev1.VolumeSource{
-									HostPath: &corev1.HostPathVolumeSource{
-										Path: "/run/xtables.lock",
-										Type: &hostPathFileOrCreate,
-									},
-								},
-							},
-							{
-								Name: "kube-dns-config",
-								VolumeSource: corev1.VolumeSource{
-									ConfigMap: &corev1.ConfigMapVolumeSource{
-										LocalObjectReference: corev1.LocalObjectReference{
-											Name: "kube-dns",
-										},
-										Optional: pointer.Bool(true),
-									},
-								},
-							},
-							{
-								Name: "config-volume",
-								VolumeSource: corev1.VolumeSource{
-									ConfigMap: &corev1.ConfigMapVolumeSource{
-										LocalObjectReference: corev1.LocalObjectReference{
-											Name: configMap.Name,
-										},
-										Items: []corev1.KeyToPath{
-											{
-												Key:  configDataKey,
-												Path: "Corefile.base",
-											},
-										},
-									},
-								},
-							},
-						},
-					},
-				},
+				Template: c.podTemplate(configMap.Name),
 			},
 		}
 		vpa               *vpaautoscalingv1.VerticalPodAutoscaler
@@ -424,6 +300,12 @@ ip6.arpa:53 {
 		clusterRolePSP    *rbacv1.ClusterRole
 		roleBindingPSP    *rbacv1.RoleBinding
 	)
+
+	if c.values.StaticPodEnabled {
+		// The static pod and its Corefile are part of the OperatingSystemConfig, see StaticPodFiles.
+		return registry.AddAllAndSerialize(service)
+	}
+
 	utilruntime.Must(references.InjectAnnotations(daemonSet))
 
 	if c.values.VPAEnabled {
@@ -449,10 +331,7 @@ ip6.arpa:53 {
 							MinAllowed: corev1.ResourceList{
 								corev1.ResourceMemory: resource.MustParse("20Mi"),
 							},
-							MaxAllowed: corev1.ResourceList{
-								corev1.ResourceCPU:    resource.MustParse("100m"),
-								corev1.ResourceMemory: resource.MustParse("200Mi"),
-							},
+							MaxAllowed: c.vpaMaxAllowed(),
 						},
 					},
 				},
@@ -476,11 +355,7 @@ ip6.arpa:53 {
 				AllowedCapabilities: []corev1.Capability{
 					"NET_ADMIN",
 				},
-				AllowedHostPaths: []policyv1beta1.AllowedHostPath{
-					{
-						PathPrefix: "/run/xtables.lock",
-					},
-				},
+				AllowedHostPaths: c.allowedHostPaths(),
 				FSGroup: policyv1beta1.FSGroupStrategyOptions{
 					Rule: policyv1beta1.FSGroupStrategyRunAsAny,
 				},
@@ -569,18 +444,387 @@ ip6.arpa:53 {
 	)
 }
 
+// StaticPodFiles returns the files for running node-local-dns as a static pod managed by the kubelet, i.e., the
+// Corefile and the static pod manifest. They are meant to be added to the OperatingSystemConfig if
+// Values.StaticPodEnabled is set.
+func StaticPodFiles(values Values) ([]extensionsv1alpha1.File, error) {
+	c := &nodeLocalDNS{values: values}
+
+	clusterDomain, err := c.clusterDomain()
+	if err != nil {
+		return nil, err
+	}
+
+	// The static pod does not bind the ClusterIP of the kube-dns Service, hence it forwards the queries for the
+	// cluster domain to it directly.
+	if net.ParseIP(values.ClusterDNS) == nil {
+		return nil, fmt.Errorf("cluster DNS address %q is not an IP address", values.ClusterDNS)
+	}
+	if values.DNSServer != "" {
+		return nil, fmt.Errorf("binding the DNS server address %q is not supported for the static pod", values.DNSServer)
+	}
+
+	var (
+		template          = c.podTemplate("")
+		hostPathDirectory = corev1.HostPathDirectoryOrCreate
+		pod               = &corev1.Pod{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: corev1.SchemeGroupVersion.String(),
+				Kind:       "Pod",
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        "node-local-dns",
+				Namespace:   metav1.NamespaceSystem,
+				Labels:      template.Labels,
+				Annotations: template.Annotations,
+			},
+			Spec: template.Spec,
+		}
+	)
+
+	// Static pods must neither reference service accounts nor config maps, and they are not subject to scheduling.
+	pod.Spec.ServiceAccountName = ""
+	pod.Spec.NodeSelector = nil
+
+	var volumes []corev1.Volume
+	for _, volume := range pod.Spec.Volumes {
+		switch volume.Name {
+		case "kube-dns-config":
+			continue
+		case "config-volume":
+			volume.VolumeSource = corev1.VolumeSource{
+				HostPath: &corev1.HostPathVolumeSource{
+					Path: PathCorefileDirectory,
+					Type: &hostPathDirectory,
+				},
+			}
+		}
+		volumes = append(volumes, volume)
+	}
+	pod.Spec.Volumes = volumes
+
+	var volumeMounts []corev1.VolumeMount
+	for _, volumeMount := range pod.Spec.Containers[0].VolumeMounts {
+		if volumeMount.Name != "kube-dns-config" {
+			volumeMounts = append(volumeMounts, volumeMount)
+		}
+	}
+	pod.Spec.Containers[0].VolumeMounts = volumeMounts
+
+	manifest, err := runtime.Encode(kubernetes.ShootCodec.EncoderForVersion(kubernetes.ShootSerializer, corev1.SchemeGroupVersion), pod)
+	if err != nil {
+		return nil, fmt.Errorf("failed encoding static pod manifest: %w", err)
+	}
+
+	return []extensionsv1alpha1.File{
+		{
+			Path:        PathCorefileDirectory + "/Corefile.base",
+			Permissions: pointer.Int32(0644),
+			Content: extensionsv1alpha1.FileContent{
+				Inline: &extensionsv1alpha1.FileContentInline{
+					Encoding: "b64",
+					Data:     utils.EncodeBase64([]byte(c.corefile(clusterDomain))),
+				},
+			},
+		},
+		{
+			Path:        PathStaticPodManifest,
+			Permissions: pointer.Int32(0644),
+			Content: extensionsv1alpha1.FileContent{
+				Inline: &extensionsv1alpha1.FileContentInline{
+					Encoding: "b64",
+					Data:     utils.EncodeBase64(manifest),
+				},
+			},
+		},
+	}, nil
+}
+
+func (c *nodeLocalDNS) corefile(clusterDomain string) string {
+	return clusterDomain + `:53 {
+    errors
+    cache {
+            success 9984 30
+            denial 9984 5
+    }
+    reload
+    loop
+    bind ` + c.bindIP() + `
+    forward . ` + c.values.ClusterDNS + ` {
+            ` + forwardOptions(c.forceTcpToClusterDNS(), c.clusterDNSForward()) + `
+    }
+    prometheus :` + strconv.Itoa(prometheusPort) + `
+    health ` + net.JoinHostPort(c.ipvsAddresses()[0], strconv.Itoa(livenessProbePort)) + `
+    }
+in-addr.arpa:53 {
+    errors
+    cache 30
+    reload
+    loop
+    bind ` + c.bindIP() + `
+    forward . ` + c.values.ClusterDNS + ` {
+            ` + forwardOptions(c.forceTcpToClusterDNS(), c.clusterDNSForward()) + `
+    }
+    prometheus :` + strconv.Itoa(prometheusPort) + `
+    }
+ip6.arpa:53 {
+    errors
+    cache 30
+    reload
+    loop
+    bind ` + c.bindIP() + `
+    forward . ` + c.values.ClusterDNS + ` {
+            ` + forwardOptions(c.forceTcpToClusterDNS(), c.clusterDNSForward()) + `
+    }
+    prometheus :` + strconv.Itoa(prometheusPort) + `
+    }
+.:53 {
+    errors
+    cache 30
+    reload
+    loop
+    bind ` + c.bindIP() + `
+    forward . ` + c.upstreamDNSAddress() + ` {
+            ` + forwardOptions(c.forceTcpToUpstreamDNS(), c.upstreamDNSForward()) + `
+    }
+    prometheus :` + strconv.Itoa(prometheusPort) + `
+    }
+` + c.customZones()
+}
+
+func (c *nodeLocalDNS) customZones() string {
+	var out string
+	for _, zone := range c.values.CustomZones {
+		out += strings.TrimSpace(zone) + "\n"
+	}
+	return out
+}
+
+func (c *nodeLocalDNS) podTemplate(configMapName string) corev1.PodTemplateSpec {
+	hostPathFileOrCreate := corev1.HostPathFileOrCreate
+
+	template := corev1.PodTemplateSpec{
+		ObjectMeta: metav1.ObjectMeta{
+			Labels: map[string]string{
+				labelKey:                                    nodelocaldnsconstants.LabelValue,
+				v1beta1constants.LabelNetworkPolicyToDNS:    "allowed",
+				v1beta1constants.LabelNodeCriticalComponent: "true",
+			},
+			Annotations: c.podAnnotations(),
+		},
+		Spec: corev1.PodSpec{
+			PriorityClassName:  c.priorityClassName(),
+			ServiceAccountName: serviceAccountName,
+			HostNetwork:        true,
+			DNSPolicy:          corev1.DNSDefault,
+			SecurityContext: &corev1.PodSecurityContext{
+				SeccompProfile: &corev1.SeccompProfile{
+					Type: corev1.SeccompProfileTypeRuntimeDefault,
+				},
+			},
+			Tolerations: c.tolerations(),
+			NodeSelector: map[string]string{
+				v1beta1constants.LabelNodeLocalDNS: "true",
+			},
+			Containers: []corev1.Container{
+				{
+					Name:      "node-cache",
+					Image:     c.values.Image,
+					Resources: c.resources(),
+					Args: []string{
+						"-localip",
+						c.containerArg(),
+						"-conf",
+						"/etc/Corefile",
+						"-upstreamsvc",
+						serviceName,
+						"-health-port",
+						strconv.Itoa(livenessProbePort),
+					},
+					SecurityContext: &corev1.SecurityContext{
+						Capabilities: &corev1.Capabilities{
+							Add: []corev1.Capability{"NET_ADMIN"},
+						},
+					},
+					Ports: []corev1.ContainerPort{
+						{
+							ContainerPort: int32(53),
+							Name:          "dns",
+							Protocol:      corev1.ProtocolUDP,
+						},
+						{
+							ContainerPort: int32(53),
+							Name:          "dns-tcp",
+							Protocol:      corev1.ProtocolTCP,
+						},
+						{
+							ContainerPort: int32(prometheusPort),
+							Name:          "metrics",
+							Protocol:      corev1.ProtocolTCP,
+						},
+						{
+							ContainerPort: int32(prometheusErrorPort),
+							Name:          "errormetrics",
+							Protocol:      corev1.ProtocolTCP,
+						},
+					},
+					LivenessProbe: &corev1.Probe{
+						ProbeHandler: corev1.ProbeHandler{
+							HTTPGet: &corev1.HTTPGetAction{
+								Host: c.ipvsAddresses()[0],
+								Path: "/health",
+								Port: intstr.FromInt32(livenessProbePort),
+							},
+						},
+						InitialDelaySeconds: int32(60),
+						TimeoutSeconds:      int32(5),
+					},
+					VolumeMounts: []corev1.VolumeMount{
+						{
+							MountPath: "/run/xtables.lock",
+							Name:      "xtables-lock",
+							ReadOnly:  false,
+						},
+						{
+							MountPath: "/etc/coredns",
+							Name:      "config-volume",
+						},
+						{
+							MountPath: "/etc/kube-dns",
+							Name:      "kube-dns-config",
+						},
+					},
+				},
+			},
+			Volumes: []corev1.Volume{
+				{
+					Name: "xtables-lock",
+					VolumeSource: corev1.VolumeSource{
+						HostPath: &corev1.HostPathVolumeSource{
+							Path: "/run/xtables.lock",
+							Type: &hostPathFileOrCreate,
+						},
+					},
+				},
+				{
+					Name: "kube-dns-config",
+					VolumeSource: corev1.VolumeSource{
+						ConfigMap: &corev1.ConfigMapVolumeSource{
+							LocalObjectReference: corev1.LocalObjectReference{
+								Name: "kube-dns",
+							},
+							Optional: pointer.Bool(true),
+						},
+					},
+				},
+				{
+					Name: "config-volume",
+					VolumeSource: corev1.VolumeSource{
+						ConfigMap: &corev1.ConfigMapVolumeSource{
+							LocalObjectReference: corev1.LocalObjectReference{
+								Name: configMapName,
+							},
+							Items: []corev1.KeyToPath{
+								{
+									Key:  configDataKey,
+									Path: "Corefile.base",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	if c.values.HostNetworkEnabled {
+		hostPathFile := corev1.HostPathFile
+		template.Spec.Containers[0].VolumeMounts = append(template.Spec.Containers[0].VolumeMounts, corev1.VolumeMount{
+			Name:      volumeNameHostResolvConf,
+			MountPath: volumeMountPathResolvConf,
+			ReadOnly:  true,
+		})
+		template.Spec.Volumes = append(template.Spec.Volumes, corev1.Volume{
+			Name: volumeNameHostResolvConf,
+			VolumeSource: corev1.VolumeSource{
+				HostPath: &corev1.HostPathVolumeSource{
+					Path: pathHostResolvConf,
+					Type: &hostPathFile,
+				},
+			},
+		})
+	}
+
+	if c.values.ForwardToNodeResolvers {
+		hostPathFile := corev1.HostPathFile
+		template.Spec.Containers[0].VolumeMounts = append(template.Spec.Containers[0].VolumeMounts, corev1.VolumeMount{
+			Name:      volumeNameNodeResolvConf,
+			MountPath: volumeMountPathNodeResolvConf,
+			ReadOnly:  true,
+		})
+		template.Spec.Volumes = append(template.Spec.Volumes, corev1.Volume{
+			Name: volumeNameNodeResolvConf,
+			VolumeSource: corev1.VolumeSource{
+				HostPath: &corev1.HostPathVolumeSource{
+					Path: PathNodeResolvConf,
+					Type: &hostPathFile,
+				},
+			},
+		})
+	}
+
+	return template
+}
+
+func (c *nodeLocalDNS) clusterDomain() (string, error) {
+	if c.values.ClusterDomain == "" {
+		return gardencorev1beta1.DefaultDomain, nil
+	}
+
+	if errs := validation.IsDNS1123Subdomain(c.values.ClusterDomain); len(errs) > 0 {
+		return "", fmt.Errorf("invalid cluster domain %q: %s", c.values.ClusterDomain, strings.Join(errs, ", "))
+	}
+
+	return c.values.ClusterDomain, nil
+}
+
+// IPVSAddresses returns the addresses node-local-dns binds for the given IP families. The address of the primary IP
+// family comes first. If no IP family is given, IPv4 is assumed.
+func IPVSAddresses(ipFamilies []gardencorev1beta1.IPFamily) []string {
+	var addresses []string
+	for _, ipFamily := range ipFamilies {
+		switch ipFamily {
+		case gardencorev1beta1.IPFamilyIPv4:
+			addresses = append(addresses, nodelocaldnsconstants.IPVSAddress)
+		case gardencorev1beta1.IPFamilyIPv6:
+			addresses = append(addresses, nodelocaldnsconstants.IPVSIPv6Address)
+		}
+	}
+
+	if len(addresses) == 0 {
+		return []string{nodelocaldnsconstants.IPVSAddress}
+	}
+	return addresses
+}
+
+func (c *nodeLocalDNS) ipvsAddresses() []string {
+	return IPVSAddresses(c.values.IPFamilies)
+}
+
 func (c *nodeLocalDNS) bindIP() string {
+	bindIP := strings.Join(c.ipvsAddresses(), " ")
 	if c.values.DNSServer != "" {
-		return nodelocaldnsconstants.IPVSAddress + " " + c.values.DNSServer
+		bindIP += " " + c.values.DNSServer
 	}
-	return nodelocaldnsconstants.IPVSAddress
+	return bindIP
 }
 
 func (c *nodeLocalDNS) containerArg() string {
+	localIPs := c.ipvsAddresses()
 	if c.values.DNSServer != "" {
-		return nodelocaldnsconstants.IPVSAddress + "," + c.values.DNSServer
+		localIPs = append(localIPs, c.values.DNSServer)
 	}
-	return nodelocaldnsconstants.IPVSAddress
+	return strings.Join(localIPs, ",")
 }
 
 func (c *nodeLocalDNS) forceTcpToClusterDNS() string {
@@ -597,9 +841,184 @@ func (c *nodeLocalDNS) forceTcpToUpstreamDNS() string {
 	return "prefer_udp"
 }
 
+func (c *nodeLocalDNS) clusterDNSForward() *gardencorev1beta1.NodeLocalDNSForward {
+	var forward *gardencorev1beta1.NodeLocalDNSForward
+	if c.values.Config != nil {
+		forward = c.values.Config.ClusterDNSForward
+	}
+
+	if c.values.CoreDNSReplicas <= 0 || (forward != nil && forward.MaxConcurrent != nil) {
+		return forward
+	}
+
+	// The configured settings must not be mutated since they are part of the shoot spec.
+	if forward == nil {
+		forward = &gardencorev1beta1.NodeLocalDNSForward{}
+	} else {
+		forward = forward.DeepCopy()
+	}
+
+	perReplica := c.values.MaxConcurrentPerCoreDNSReplica
+	if perReplica <= 0 {
+		perReplica = DefaultMaxConcurrentPerCoreDNSReplica
+	}
+	forward.MaxConcurrent = pointer.Int32(c.values.CoreDNSReplicas * perReplica)
+
+	return forward
+}
+
+func (c *nodeLocalDNS) upstreamDNSForward() *gardencorev1beta1.NodeLocalDNSForward {
+	if c.values.Config == nil {
+		return nil
+	}
+	return c.values.Config.UpstreamDNSForward
+}
+
+// forwardOptions returns the options of a forward plugin block. The protocol option is always set, the other options
+// only if they are configured, i.e., the defaults of the forward plugin apply otherwise.
+func forwardOptions(protocol string, forward *gardencorev1beta1.NodeLocalDNSForward) string {
+	options := []string{protocol}
+
+	if forward != nil {
+		if forward.HealthCheckInterval != nil {
+			options = append(options, "health_check "+forward.HealthCheckInterval.Duration.String())
+		}
+		if forward.MaxConcurrent != nil {
+			options = append(options, "max_concurrent "+strconv.Itoa(int(*forward.MaxConcurrent)))
+		}
+		if forward.Policy != nil {
+			options = append(options, "policy "+*forward.Policy)
+		}
+	}
+
+	return strings.Join(options, "\n            ")
+}
+
 func (c *nodeLocalDNS) upstreamDNSAddress() string {
 	if c.values.Config != nil && pointer.BoolDeref(c.values.Config.DisableForwardToUpstreamDNS, false) {
 		return c.values.ClusterDNS
 	}
+	if len(c.values.UpstreamServers) > 0 {
+		return strings.Join(c.values.UpstreamServers, " ")
+	}
+	if c.values.ForwardToNodeResolvers {
+		return volumeMountPathNodeResolvConf
+	}
+	if c.values.HostNetworkEnabled {
+		// The resolv.conf handed out by the kubelet points to node-local-dns itself, hence the resolvers of the host
+		// have to be used to prevent forwarding loops.
+		return volumeMountPathResolvConf
+	}
+	if c.values.StaticPodEnabled {
+		// The static pod uses the 'Default' DNS policy, i.e., its resolv.conf contains the resolvers of the host.
+		return pathHostResolvConf
+	}
 	return "__PILLAR__UPSTREAM__SERVERS__"
 }
+
+func (c *nodeLocalDNS) podAnnotations() map[string]string {
+	annotations := map[string]string{
+		"prometheus.io/port":   strconv.Itoa(prometheusPort),
+		"prometheus.io/scrape": strconv.FormatBool(prometheusScrape),
+		// All config inputs are part of the Corefile or the container arguments, hence the pods are rolled anyway when
+		// the hash changes.
+		nodelocaldnsconstants.AnnotationKeyConfigInputsHash: c.configInputsHash(),
+	}
+
+	if c.values.Config != nil && c.values.Config.EnableDaemonSetEviction != nil {
+		annotations[annotationEnableDaemonSetEviction] = strconv.FormatBool(*c.values.Config.EnableDaemonSetEviction)
+	}
+
+	return annotations
+}
+
+func (c *nodeLocalDNS) priorityClassName() string {
+	if c.values.Config != nil && c.values.Config.PriorityClassName != nil {
+		return *c.values.Config.PriorityClassName
+	}
+	return "system-node-critical"
+}
+
+// tolerations returns the tolerations of the node-local-dns pods. Tolerations for node conditions like memory or disk
+// pressure are added by the DaemonSet controller anyway, hence custom tolerations only need to cover the taints of the
+// worker pools the pods should be scheduled to.
+func (c *nodeLocalDNS) tolerations() []corev1.Toleration {
+	if c.values.Config != nil && len(c.values.Config.Tolerations) > 0 {
+		tolerations := make([]corev1.Toleration, 0, len(c.values.Config.Tolerations))
+		for _, toleration := range c.values.Config.Tolerations {
+			tolerations = append(tolerations, *toleration.DeepCopy())
+		}
+		return tolerations
+	}
+
+	return []corev1.Toleration{
+		{
+			Operator: corev1.TolerationOpExists,
+			Effect:   corev1.TaintEffectNoExecute,
+		},
+		{
+			Operator: corev1.TolerationOpExists,
+			Effect:   corev1.TaintEffectNoSchedule,
+		},
+	}
+}
+
+func (c *nodeLocalDNS) resources() corev1.ResourceRequirements {
+	if c.values.Config != nil && c.values.Config.Resources != nil {
+		return *c.values.Config.Resources.DeepCopy()
+	}
+
+	return corev1.ResourceRequirements{
+		Requests: corev1.ResourceList{
+			corev1.ResourceCPU:    resource.MustParse("25m"),
+			corev1.ResourceMemory: resource.MustParse("25Mi"),
+		},
+		Limits: corev1.ResourceList{
+			corev1.ResourceMemory: resource.MustParse("100Mi"),
+		},
+	}
+}
+
+// vpaMaxAllowed returns the maximum resources the VPA may recommend. They are raised to the configured requests and
+// limits of the node-cache container so that the VPA never recommends less than what was explicitly configured.
+func (c *nodeLocalDNS) vpaMaxAllowed() corev1.ResourceList {
+	maxAllowed := corev1.ResourceList{
+		corev1.ResourceCPU:    resource.MustParse("100m"),
+		corev1.ResourceMemory: resource.MustParse("200Mi"),
+	}
+
+	resources := c.resources()
+	for _, list := range []corev1.ResourceList{resources.Requests, resources.Limits} {
+		for name, quantity := range list {
+			if current, ok := maxAllowed[name]; ok && quantity.Cmp(current) > 0 {
+				maxAllowed[name] = quantity.DeepCopy()
+			}
+		}
+	}
+
+	return maxAllowed
+}
+
+func (c *nodeLocalDNS) allowedHostPaths() []policyv1beta1.AllowedHostPath {
+	allowedHostPaths := []policyv1beta1.AllowedHostPath{
+		{
+			PathPrefix: "/run/xtables.lock",
+		},
+	}
+
+	if c.values.HostNetworkEnabled {
+		allowedHostPaths = append(allowedHostPaths, policyv1beta1.AllowedHostPath{
+			PathPrefix: pathHostResolvConf,
+			ReadOnly:   true,
+		})
+	}
+
+	if c.values.ForwardToNodeResolvers {
+		allowedHostPaths = append(allowedHostPaths, policyv1beta1.AllowedHostPath{
+			PathPrefix: PathNodeResolvConf,
+			ReadOnly:   true,
+		})
+	}
+
+	return allowedHostPaths
+}
@@ -17,6 +17,8 @@ package nodelocaldns_test
 import (
 	"context"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/Masterminds/semver/v3"
 	. "github.com/onsi/ginkgo/v2"
@@ -238,6 +240,8 @@ data:
 immutable: true
 kind: ConfigMap
 metadata:
+  annotations:
+    node-local-dns.gardener.cloud/config-inputs-hash: ` + configInputsHash(values) + `
   creationTimestamp: null
   labels:
     k8s-app: node-local-dns
@@ -289,6 +293,9 @@ status:
 							managedresources.LabelKeyOrigin:             managedresources.LabelValueGardener,
 							v1beta1constants.LabelNodeCriticalComponent: "true",
 						},
+						Annotations: map[string]string{
+							"node-local-dns.gardener.cloud/config-inputs-hash": configInputsHash(values),
+						},
 					},
 					Spec: appsv1.DaemonSetSpec{
 						UpdateStrategy: appsv1.DaemonSetUpdateStrategy{
@@ -309,8 +316,9 @@ status:
 									v1beta1constants.LabelNodeCriticalComponent: "true",
 								},
 								Annotations: map[string]string{
-									"prometheus.io/port":   strconv.Itoa(prometheusPort),
-									"prometheus.io/scrape": strconv.FormatBool(prometheusScrape),
+									"prometheus.io/port":                               strconv.Itoa(prometheusPort),
+									"prometheus.io/scrape":                             strconv.FormatBool(prometheusScrape),
+									"node-local-dns.gardener.cloud/config-inputs-hash": configInputsHash(values),
 								},
 							},
 							Spec: corev1.PodSpec{
@@ -765,6 +773,78 @@ ip6.arpa:53 {
 						Expect(daemonset).To(DeepEqual(managedResourceDaemonset))
 					})
 				})
+
+				Context("custom resources", func() {
+					var resources *corev1.ResourceRequirements
+
+					BeforeEach(func() {
+						resources = &corev1.ResourceRequirements{
+							Requests: corev1.ResourceList{
+								corev1.ResourceCPU:    resource.MustParse("50m"),
+								corev1.ResourceMemory: resource.MustParse("100Mi"),
+							},
+							Limits: corev1.ResourceList{
+								corev1.ResourceMemory: resource.MustParse("500Mi"),
+							},
+						}
+						values.Config = &gardencorev1beta1.NodeLocalDNS{Enabled: true,
+							ForceTCPToClusterDNS:        pointer.Bool(true),
+							ForceTCPToUpstreamDNS:       pointer.Bool(true),
+							DisableForwardToUpstreamDNS: pointer.Bool(false),
+							Resources:                   resources,
+						}
+						values.VPAEnabled = true
+						upstreamDNSAddress = "__PILLAR__UPSTREAM__SERVERS__"
+						forceTcpToClusterDNS = "force_tcp"
+						forceTcpToUpstreamDNS = "force_tcp"
+					})
+
+					It("should use the configured resources and raise the maximum allowed resources of the VPA", func() {
+						Expect(string(managedResourceSecret.Data["verticalpodautoscaler__kube-system__node-local-dns.yaml"])).To(Equal(strings.Replace(vpaYAML, "memory: 200Mi", "memory: 500Mi", 1)))
+						managedResourceDaemonset, _, err := kubernetes.ShootCodec.UniversalDecoder().Decode(managedResourceSecret.Data["daemonset__kube-system__node-local-dns.yaml"], nil, &appsv1.DaemonSet{})
+						Expect(err).ToNot(HaveOccurred())
+						daemonset := daemonSetYAMLFor()
+						daemonset.Spec.Template.Spec.Containers[0].Resources = *resources
+						utilruntime.Must(references.InjectAnnotations(daemonset))
+						Expect(daemonset).To(DeepEqual(managedResourceDaemonset))
+					})
+				})
+
+				Context("custom scheduling settings", func() {
+					var tolerations []corev1.Toleration
+
+					BeforeEach(func() {
+						tolerations = []corev1.Toleration{{
+							Key:      "dedicated",
+							Operator: corev1.TolerationOpEqual,
+							Value:    "dns",
+							Effect:   corev1.TaintEffectNoSchedule,
+						}}
+						values.Config = &gardencorev1beta1.NodeLocalDNS{Enabled: true,
+							ForceTCPToClusterDNS:        pointer.Bool(true),
+							ForceTCPToUpstreamDNS:       pointer.Bool(true),
+							DisableForwardToUpstreamDNS: pointer.Bool(false),
+							PriorityClassName:           pointer.String("gardener-shoot-system-800"),
+							Tolerations:                 tolerations,
+							EnableDaemonSetEviction:     pointer.Bool(false),
+						}
+						values.VPAEnabled = true
+						upstreamDNSAddress = "__PILLAR__UPSTREAM__SERVERS__"
+						forceTcpToClusterDNS = "force_tcp"
+						forceTcpToUpstreamDNS = "force_tcp"
+					})
+
+					It("should use the configured priority class, tolerations and eviction annotation", func() {
+						managedResourceDaemonset, _, err := kubernetes.ShootCodec.UniversalDecoder().Decode(managedResourceSecret.Data["daemonset__kube-system__node-local-dns.yaml"], nil, &appsv1.DaemonSet{})
+						Expect(err).ToNot(HaveOccurred())
+						daemonset := daemonSetYAMLFor()
+						daemonset.Spec.Template.Annotations["cluster-autoscaler.kubernetes.io/enable-ds-eviction"] = "false"
+						daemonset.Spec.Template.Spec.PriorityClassName = "gardener-shoot-system-800"
+						daemonset.Spec.Template.Spec.Tolerations = tolerations
+						utilruntime.Must(references.InjectAnnotations(daemonset))
+						Expect(daemonset).To(DeepEqual(managedResourceDaemonset))
+					})
+				})
 			})
 		})
 		Context("NodeLocalDNS with ipvsEnabled enabled", func() {
@@ -1099,6 +1179,435 @@ ip6.arpa:53 {
 		})
 	})
 
+	Describe("#Deploy with cluster domain", func() {
+		BeforeEach(func() {
+			values.ClusterDNS = "__PILLAR__CLUSTER__DNS__"
+			values.Config = &gardencorev1beta1.NodeLocalDNS{Enabled: true}
+		})
+
+		It("should render the custom cluster domain into the Corefile", func() {
+			values.ClusterDomain = "custom.domain"
+			component = New(c, namespace, values)
+			Expect(component.Deploy(ctx)).To(Succeed())
+
+			Expect(c.Get(ctx, client.ObjectKeyFromObject(managedResource), managedResource)).To(Succeed())
+			managedResourceSecret.Name = managedResource.Spec.SecretRefs[0].Name
+			Expect(c.Get(ctx, client.ObjectKeyFromObject(managedResourceSecret), managedResourceSecret)).To(Succeed())
+
+			configMap := &corev1.ConfigMap{}
+			for key, data := range managedResourceSecret.Data {
+				if strings.HasPrefix(key, "configmap__kube-system__node-local-dns-") {
+					_, _, err := kubernetes.ShootCodec.UniversalDecoder().Decode(data, nil, configMap)
+					Expect(err).NotTo(HaveOccurred())
+				}
+			}
+			Expect(configMap.Data["Corefile"]).To(HavePrefix("custom.domain:53 {"))
+			Expect(configMap.Data["Corefile"]).NotTo(ContainSubstring("cluster.local"))
+		})
+
+		It("should fail for an invalid cluster domain", func() {
+			values.ClusterDomain = "Invalid_Domain"
+			component = New(c, namespace, values)
+
+			Expect(component.Deploy(ctx)).To(MatchError(ContainSubstring("invalid cluster domain")))
+		})
+	})
+
+	Describe("#Deploy with host network enabled", func() {
+		var (
+			configMap *corev1.ConfigMap
+			daemonSet *appsv1.DaemonSet
+		)
+
+		BeforeEach(func() {
+			values.ClusterDNS = "__PILLAR__CLUSTER__DNS__"
+			values.Config = &gardencorev1beta1.NodeLocalDNS{Enabled: true}
+			values.HostNetworkEnabled = true
+		})
+
+		JustBeforeEach(func() {
+			component = New(c, namespace, values)
+			Expect(component.Deploy(ctx)).To(Succeed())
+
+			Expect(c.Get(ctx, client.ObjectKeyFromObject(managedResource), managedResource)).To(Succeed())
+			managedResourceSecret.Name = managedResource.Spec.SecretRefs[0].Name
+			Expect(c.Get(ctx, client.ObjectKeyFromObject(managedResourceSecret), managedResourceSecret)).To(Succeed())
+
+			configMap, daemonSet = &corev1.ConfigMap{}, &appsv1.DaemonSet{}
+			for key, data := range managedResourceSecret.Data {
+				if strings.HasPrefix(key, "configmap__kube-system__node-local-dns-") {
+					_, _, err := kubernetes.ShootCodec.UniversalDecoder().Decode(data, nil, configMap)
+					Expect(err).NotTo(HaveOccurred())
+				}
+				if key == "daemonset__kube-system__node-local-dns.yaml" {
+					_, _, err := kubernetes.ShootCodec.UniversalDecoder().Decode(data, nil, daemonSet)
+					Expect(err).NotTo(HaveOccurred())
+				}
+			}
+		})
+
+		It("should forward to the resolvers of the host", func() {
+			Expect(configMap.Data["Corefile"]).To(ContainSubstring("bind 169.254.20.10\n"))
+			Expect(configMap.Data["Corefile"]).To(ContainSubstring("forward . /etc/host-resolv.conf {"))
+			Expect(configMap.Data["Corefile"]).NotTo(ContainSubstring("__PILLAR__UPSTREAM__SERVERS__"))
+
+			container := daemonSet.Spec.Template.Spec.Containers[0]
+			Expect(container.VolumeMounts).To(ContainElement(corev1.VolumeMount{Name: "host-resolv-conf", MountPath: "/etc/host-resolv.conf", ReadOnly: true}))
+			Expect(daemonSet.Spec.Template.Spec.Volumes).To(ContainElement(HaveField("HostPath.Path", "/etc/resolv.conf")))
+		})
+	})
+
+	Describe("#Deploy with forwarding to the node resolvers enabled", func() {
+		var (
+			configMap *corev1.ConfigMap
+			daemonSet *appsv1.DaemonSet
+		)
+
+		BeforeEach(func() {
+			values.ClusterDNS = "__PILLAR__CLUSTER__DNS__"
+			values.Config = &gardencorev1beta1.NodeLocalDNS{Enabled: true}
+			values.ForwardToNodeResolvers = true
+		})
+
+		JustBeforeEach(func() {
+			component = New(c, namespace, values)
+			Expect(component.Deploy(ctx)).To(Succeed())
+
+			Expect(c.Get(ctx, client.ObjectKeyFromObject(managedResource), managedResource)).To(Succeed())
+			managedResourceSecret.Name = managedResource.Spec.SecretRefs[0].Name
+			Expect(c.Get(ctx, client.ObjectKeyFromObject(managedResourceSecret), managedResourceSecret)).To(Succeed())
+
+			configMap, daemonSet = &corev1.ConfigMap{}, &appsv1.DaemonSet{}
+			for key, data := range managedResourceSecret.Data {
+				if strings.HasPrefix(key, "configmap__kube-system__node-local-dns-") {
+					_, _, err := kubernetes.ShootCodec.UniversalDecoder().Decode(data, nil, configMap)
+					Expect(err).NotTo(HaveOccurred())
+				}
+				if key == "daemonset__kube-system__node-local-dns.yaml" {
+					_, _, err := kubernetes.ShootCodec.UniversalDecoder().Decode(data, nil, daemonSet)
+					Expect(err).NotTo(HaveOccurred())
+				}
+			}
+		})
+
+		It("should forward to the captured resolvers of the node", func() {
+			Expect(configMap.Data["Corefile"]).To(ContainSubstring("forward . /etc/node-resolv.conf {"))
+			Expect(configMap.Data["Corefile"]).NotTo(ContainSubstring("__PILLAR__UPSTREAM__SERVERS__"))
+
+			container := daemonSet.Spec.Template.Spec.Containers[0]
+			Expect(container.VolumeMounts).To(ContainElement(corev1.VolumeMount{Name: "node-resolv-conf", MountPath: "/etc/node-resolv.conf", ReadOnly: true}))
+			Expect(daemonSet.Spec.Template.Spec.Volumes).To(ContainElement(HaveField("HostPath.Path", "/var/lib/node-local-dns/node-resolv.conf")))
+		})
+
+		Context("host network enabled", func() {
+			BeforeEach(func() {
+				values.HostNetworkEnabled = true
+			})
+
+			It("should prefer the captured resolvers of the node", func() {
+				Expect(configMap.Data["Corefile"]).To(ContainSubstring("forward . /etc/node-resolv.conf {"))
+				Expect(configMap.Data["Corefile"]).NotTo(ContainSubstring("forward . /etc/host-resolv.conf"))
+			})
+		})
+
+		Context("DisableForwardToUpstreamDNS true", func() {
+			BeforeEach(func() {
+				values.Config.DisableForwardToUpstreamDNS = pointer.Bool(true)
+			})
+
+			It("should still forward to the cluster DNS", func() {
+				Expect(configMap.Data["Corefile"]).NotTo(ContainSubstring("/etc/node-resolv.conf"))
+			})
+		})
+	})
+
+	Describe("#Deploy with custom upstream servers and zones", func() {
+		var configMap *corev1.ConfigMap
+
+		BeforeEach(func() {
+			values.ClusterDNS = "__PILLAR__CLUSTER__DNS__"
+			values.Config = &gardencorev1beta1.NodeLocalDNS{Enabled: true}
+			values.UpstreamServers = []string{"10.0.0.53", "10.0.0.54:5353"}
+			values.CustomZones = []string{"corp.example.com:53 {\n    forward . 10.1.0.53\n}\n"}
+		})
+
+		JustBeforeEach(func() {
+			component = New(c, namespace, values)
+			Expect(component.Deploy(ctx)).To(Succeed())
+
+			Expect(c.Get(ctx, client.ObjectKeyFromObject(managedResource), managedResource)).To(Succeed())
+			managedResourceSecret.Name = managedResource.Spec.SecretRefs[0].Name
+			Expect(c.Get(ctx, client.ObjectKeyFromObject(managedResourceSecret), managedResourceSecret)).To(Succeed())
+
+			configMap = &corev1.ConfigMap{}
+			for key, data := range managedResourceSecret.Data {
+				if strings.HasPrefix(key, "configmap__kube-system__node-local-dns-") {
+					_, _, err := kubernetes.ShootCodec.UniversalDecoder().Decode(data, nil, configMap)
+					Expect(err).NotTo(HaveOccurred())
+				}
+			}
+		})
+
+		It("should forward to the upstream servers and append the custom zones", func() {
+			Expect(configMap.Data["Corefile"]).To(ContainSubstring("forward . 10.0.0.53 10.0.0.54:5353 {"))
+			Expect(configMap.Data["Corefile"]).NotTo(ContainSubstring("__PILLAR__UPSTREAM__SERVERS__"))
+			Expect(configMap.Data["Corefile"]).To(HaveSuffix("    }\ncorp.example.com:53 {\n    forward . 10.1.0.53\n}\n"))
+		})
+
+		It("should consider the custom zones in the config inputs hash", func() {
+			valuesWithoutCustomZones := values
+			valuesWithoutCustomZones.CustomZones = nil
+
+			Expect(configMap.Annotations).To(HaveKeyWithValue("node-local-dns.gardener.cloud/config-inputs-hash", configInputsHash(values)))
+			Expect(configMap.Annotations["node-local-dns.gardener.cloud/config-inputs-hash"]).NotTo(Equal(configInputsHash(valuesWithoutCustomZones)))
+		})
+
+		Context("forward settings configured", func() {
+			BeforeEach(func() {
+				values.Config.ClusterDNSForward = &gardencorev1beta1.NodeLocalDNSForward{
+					HealthCheckInterval: &metav1.Duration{Duration: 100 * time.Millisecond},
+				}
+				values.Config.UpstreamDNSForward = &gardencorev1beta1.NodeLocalDNSForward{
+					HealthCheckInterval: &metav1.Duration{Duration: 2 * time.Second},
+					MaxConcurrent:       pointer.Int32(1000),
+					Policy:              pointer.String("sequential"),
+				}
+			})
+
+			It("should render the settings into the forward blocks of the zones", func() {
+				Expect(strings.Count(configMap.Data["Corefile"], "forward . __PILLAR__CLUSTER__DNS__ {\n            force_tcp\n            health_check 100ms\n    }")).To(Equal(3))
+				Expect(configMap.Data["Corefile"]).To(ContainSubstring("forward . 10.0.0.53 10.0.0.54:5353 {\n            force_tcp\n            health_check 2s\n            max_concurrent 1000\n            policy sequential\n    }"))
+			})
+
+			Context("CoreDNS replicas hint provided", func() {
+				BeforeEach(func() {
+					values.CoreDNSReplicas = 2
+				})
+
+				It("should derive the maximum number of concurrent queries to the cluster DNS", func() {
+					Expect(strings.Count(configMap.Data["Corefile"], "forward . __PILLAR__CLUSTER__DNS__ {\n            force_tcp\n            health_check 100ms\n            max_concurrent 2000\n    }")).To(Equal(3))
+					Expect(configMap.Data["Corefile"]).To(ContainSubstring("forward . 10.0.0.53 10.0.0.54:5353 {\n            force_tcp\n            health_check 2s\n            max_concurrent 1000\n            policy sequential\n    }"))
+					Expect(values.Config.ClusterDNSForward.MaxConcurrent).To(BeNil())
+				})
+
+				Context("number of concurrent queries per replica configured", func() {
+					BeforeEach(func() {
+						values.MaxConcurrentPerCoreDNSReplica = 300
+					})
+
+					It("should consider the configured number of concurrent queries per replica", func() {
+						Expect(strings.Count(configMap.Data["Corefile"], "max_concurrent 600\n")).To(Equal(3))
+					})
+				})
+
+				Context("maximum number of concurrent queries configured", func() {
+					BeforeEach(func() {
+						values.Config.ClusterDNSForward.MaxConcurrent = pointer.Int32(150)
+					})
+
+					It("should prefer the configured maximum number of concurrent queries", func() {
+						Expect(strings.Count(configMap.Data["Corefile"], "max_concurrent 150\n")).To(Equal(3))
+						Expect(configMap.Data["Corefile"]).NotTo(ContainSubstring("max_concurrent 2000"))
+					})
+				})
+			})
+		})
+
+		Context("CoreDNS replicas hint provided without forward settings", func() {
+			BeforeEach(func() {
+				values.CoreDNSReplicas = 3
+			})
+
+			It("should only limit the concurrent queries to the cluster DNS", func() {
+				Expect(strings.Count(configMap.Data["Corefile"], "forward . __PILLAR__CLUSTER__DNS__ {\n            force_tcp\n            max_concurrent 3000\n    }")).To(Equal(3))
+				Expect(configMap.Data["Corefile"]).To(ContainSubstring("forward . 10.0.0.53 10.0.0.54:5353 {\n            force_tcp\n    }"))
+			})
+		})
+
+		Context("forwarding to the node resolvers enabled", func() {
+			BeforeEach(func() {
+				values.ForwardToNodeResolvers = true
+			})
+
+			It("should prefer the upstream servers", func() {
+				Expect(configMap.Data["Corefile"]).To(ContainSubstring("forward . 10.0.0.53 10.0.0.54:5353 {"))
+				Expect(configMap.Data["Corefile"]).NotTo(ContainSubstring("forward . /etc/node-resolv.conf"))
+			})
+		})
+	})
+
+	Describe("#Deploy with IPv6 and dual-stack networking", func() {
+		var (
+			configMap *corev1.ConfigMap
+			daemonSet *appsv1.DaemonSet
+		)
+
+		BeforeEach(func() {
+			values.ClusterDNS = "__PILLAR__CLUSTER__DNS__"
+			values.Config = &gardencorev1beta1.NodeLocalDNS{Enabled: true}
+		})
+
+		JustBeforeEach(func() {
+			component = New(c, namespace, values)
+			Expect(component.Deploy(ctx)).To(Succeed())
+
+			Expect(c.Get(ctx, client.ObjectKeyFromObject(managedResource), managedResource)).To(Succeed())
+			managedResourceSecret.Name = managedResource.Spec.SecretRefs[0].Name
+			Expect(c.Get(ctx, client.ObjectKeyFromObject(managedResourceSecret), managedResourceSecret)).To(Succeed())
+
+			configMap, daemonSet = &corev1.ConfigMap{}, &appsv1.DaemonSet{}
+			for key, data := range managedResourceSecret.Data {
+				if strings.HasPrefix(key, "configmap__kube-system__node-local-dns-") {
+					_, _, err := kubernetes.ShootCodec.UniversalDecoder().Decode(data, nil, configMap)
+					Expect(err).NotTo(HaveOccurred())
+				}
+				if key == "daemonset__kube-system__node-local-dns.yaml" {
+					_, _, err := kubernetes.ShootCodec.UniversalDecoder().Decode(data, nil, daemonSet)
+					Expect(err).NotTo(HaveOccurred())
+				}
+			}
+		})
+
+		Context("IPv6 single-stack", func() {
+			BeforeEach(func() {
+				values.IPFamilies = []gardencorev1beta1.IPFamily{gardencorev1beta1.IPFamilyIPv6}
+			})
+
+			It("should only bind the IPv6 address", func() {
+				Expect(configMap.Data["Corefile"]).To(ContainSubstring("bind fd30:1319:f1e:230b::1\n"))
+				Expect(configMap.Data["Corefile"]).To(ContainSubstring("health [fd30:1319:f1e:230b::1]:8099"))
+				Expect(configMap.Data["Corefile"]).NotTo(ContainSubstring("169.254.20.10"))
+
+				container := daemonSet.Spec.Template.Spec.Containers[0]
+				Expect(container.Args).To(HaveExactElements("-localip", "fd30:1319:f1e:230b::1", "-conf", "/etc/Corefile", "-upstreamsvc", "kube-dns-upstream", "-health-port", "8099"))
+				Expect(container.LivenessProbe.HTTPGet.Host).To(Equal("fd30:1319:f1e:230b::1"))
+			})
+		})
+
+		Context("dual-stack", func() {
+			BeforeEach(func() {
+				values.IPFamilies = []gardencorev1beta1.IPFamily{gardencorev1beta1.IPFamilyIPv4, gardencorev1beta1.IPFamilyIPv6}
+				values.DNSServer = "1.2.3.4"
+			})
+
+			It("should bind the addresses of both IP families", func() {
+				Expect(configMap.Data["Corefile"]).To(ContainSubstring("bind 169.254.20.10 fd30:1319:f1e:230b::1 1.2.3.4\n"))
+				Expect(configMap.Data["Corefile"]).To(ContainSubstring("health 169.254.20.10:8099"))
+
+				container := daemonSet.Spec.Template.Spec.Containers[0]
+				Expect(container.Args).To(HaveExactElements("-localip", "169.254.20.10,fd30:1319:f1e:230b::1,1.2.3.4", "-conf", "/etc/Corefile", "-upstreamsvc", "kube-dns-upstream", "-health-port", "8099"))
+				Expect(container.LivenessProbe.HTTPGet.Host).To(Equal("169.254.20.10"))
+			})
+		})
+	})
+
+	Describe("#IPVSAddresses", func() {
+		It("should return the IPv4 address if no IP family is given", func() {
+			Expect(IPVSAddresses(nil)).To(HaveExactElements("169.254.20.10"))
+		})
+
+		It("should return the addresses in the order of the IP families", func() {
+			Expect(IPVSAddresses([]gardencorev1beta1.IPFamily{gardencorev1beta1.IPFamilyIPv6, gardencorev1beta1.IPFamilyIPv4})).To(HaveExactElements("fd30:1319:f1e:230b::1", "169.254.20.10"))
+		})
+	})
+
+	Describe("#Deploy with static pod enabled", func() {
+		BeforeEach(func() {
+			values.ClusterDNS = "__PILLAR__CLUSTER__DNS__"
+			values.Config = &gardencorev1beta1.NodeLocalDNS{Enabled: true}
+			values.StaticPodEnabled = true
+			values.VPAEnabled = true
+		})
+
+		It("should only deploy the upstream service", func() {
+			component = New(c, namespace, values)
+			Expect(component.Deploy(ctx)).To(Succeed())
+
+			Expect(c.Get(ctx, client.ObjectKeyFromObject(managedResource), managedResource)).To(Succeed())
+			managedResourceSecret.Name = managedResource.Spec.SecretRefs[0].Name
+			Expect(c.Get(ctx, client.ObjectKeyFromObject(managedResourceSecret), managedResourceSecret)).To(Succeed())
+
+			Expect(managedResourceSecret.Data).To(HaveLen(1))
+			Expect(managedResourceSecret.Data).To(HaveKey("service__kube-system__kube-dns-upstream.yaml"))
+		})
+	})
+
+	Describe("#StaticPodFiles", func() {
+		BeforeEach(func() {
+			values.ClusterDNS = "100.64.0.10"
+			values.Config = &gardencorev1beta1.NodeLocalDNS{Enabled: true}
+			values.StaticPodEnabled = true
+		})
+
+		It("should return the Corefile and the static pod manifest", func() {
+			files, err := StaticPodFiles(values)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(files).To(HaveLen(2))
+
+			Expect(files[0].Path).To(Equal("/var/lib/node-local-dns/Corefile.base"))
+			Expect(files[0].Permissions).To(Equal(pointer.Int32(0644)))
+			corefile, err := utils.DecodeBase64(files[0].Content.Inline.Data)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(corefile)).To(HavePrefix("cluster.local:53 {"))
+			Expect(string(corefile)).NotTo(ContainSubstring("__PILLAR__"))
+			Expect(strings.Count(string(corefile), "forward . 100.64.0.10 {")).To(Equal(3))
+			Expect(string(corefile)).To(ContainSubstring("forward . /etc/resolv.conf {"))
+			Expect(string(corefile)).To(ContainSubstring("bind 169.254.20.10\n"))
+
+			Expect(files[1].Path).To(Equal("/etc/kubernetes/manifests/node-local-dns.yaml"))
+			Expect(files[1].Permissions).To(Equal(pointer.Int32(0644)))
+			manifest, err := utils.DecodeBase64(files[1].Content.Inline.Data)
+			Expect(err).NotTo(HaveOccurred())
+
+			pod := &corev1.Pod{}
+			_, _, err = kubernetes.ShootCodec.UniversalDecoder().Decode(manifest, nil, pod)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(pod.Name).To(Equal("node-local-dns"))
+			Expect(pod.Namespace).To(Equal("kube-system"))
+			Expect(pod.Spec.HostNetwork).To(BeTrue())
+			Expect(pod.Spec.ServiceAccountName).To(BeEmpty())
+			Expect(pod.Spec.NodeSelector).To(BeEmpty())
+			Expect(pod.Spec.Containers[0].Image).To(Equal(image))
+			Expect(pod.Spec.Containers[0].VolumeMounts).NotTo(ContainElement(HaveField("Name", "kube-dns-config")))
+			Expect(pod.Spec.Volumes).To(ConsistOf(
+				HaveField("Name", "xtables-lock"),
+				And(HaveField("Name", "config-volume"), HaveField("HostPath.Path", "/var/lib/node-local-dns")),
+			))
+		})
+
+		It("should forward to the configured upstream servers", func() {
+			values.UpstreamServers = []string{"8.8.8.8"}
+
+			files, err := StaticPodFiles(values)
+			Expect(err).NotTo(HaveOccurred())
+			corefile, err := utils.DecodeBase64(files[0].Content.Inline.Data)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(corefile)).To(ContainSubstring("forward . 8.8.8.8 {"))
+		})
+
+		It("should fail for an invalid cluster domain", func() {
+			values.ClusterDomain = "Invalid_Domain"
+
+			_, err := StaticPodFiles(values)
+			Expect(err).To(MatchError(ContainSubstring("invalid cluster domain")))
+		})
+
+		It("should fail if the cluster DNS address is not an IP address", func() {
+			values.ClusterDNS = "__PILLAR__CLUSTER__DNS__"
+
+			_, err := StaticPodFiles(values)
+			Expect(err).To(MatchError(`cluster DNS address "__PILLAR__CLUSTER__DNS__" is not an IP address`))
+		})
+
+		It("should fail if the DNS server address should be bound", func() {
+			values.DNSServer = "100.64.0.10"
+
+			_, err := StaticPodFiles(values)
+			Expect(err).To(MatchError(ContainSubstring(`binding the DNS server address "100.64.0.10" is not supported`)))
+		})
+	})
+
 	Describe("#Destroy", func() {
 		It("should successfully destroy all resources", func() {
 			component = New(c, namespace, values)
@@ -1212,6 +1721,12 @@ ip6.arpa:53 {
 
 })
 
+func configInputsHash(values Values) string {
+	inputs, err := ConfigInputsFor(values)
+	Expect(err).NotTo(HaveOccurred())
+	return inputs.Hash()
+}
+
 func bindIP(values Values) string {
 	if values.DNSServer != "" {
 		return "169.254.20.10 " + values.DNSServer
@@ -67,6 +67,7 @@ import (
 	"github.com/gardener/gardener/pkg/resourcemanager/webhook/extensionvalidation"
 	"github.com/gardener/gardener/pkg/resourcemanager/webhook/highavailabilityconfig"
 	"github.com/gardener/gardener/pkg/resourcemanager/webhook/kubernetesservicehost"
+	"github.com/gardener/gardener/pkg/resourcemanager/webhook/podscaleupdelay"
 	"github.com/gardener/gardener/pkg/resourcemanager/webhook/podschedulername"
 	"github.com/gardener/gardener/pkg/resourcemanager/webhook/podtopologyspreadconstraints"
 	"github.com/gardener/gardener/pkg/resourcemanager/webhook/projectedtokenmount"
@@ -316,6 +317,10 @@ type Values struct {
 	KubernetesServiceHost *string
 	// PodTopologySpreadConstraintsEnabled specifies if the pod's TSC should be mutated to support rolling updates.
 	PodTopologySpreadConstraintsEnabled bool
+	// PriorityClassScaleUpDelays maps names of priority classes to the delay after which the cluster-autoscaler should
+	// consider newly created pods of this priority class for scale-up. If it is non-empty, the GRM's pod-scale-up-delay
+	// webhook will be enabled. This value is only applicable when TargetDiffersFromSourceCluster=true.
+	PriorityClassScaleUpDelays map[string]metav1.Duration
 	// FailureToleranceType determines the failure tolerance type for the resource manager deployment.
 	FailureToleranceType *gardencorev1beta1.FailureToleranceType
 	// Zones is number of availability zones.
@@ -634,6 +639,11 @@ func (r *resourceManager) ensureConfigMap(ctx context.Context, configMap *corev1
 		config.Webhooks.KubernetesServiceHost.Host = *r.values.KubernetesServiceHost
 	}
 
+	if r.podScaleUpDelayWebhookEnabled() {
+		config.Webhooks.PodScaleUpDelay.Enabled = true
+		config.Webhooks.PodScaleUpDelay.PriorityClassScaleUpDelays = r.values.PriorityClassScaleUpDelays
+	}
+
 	if r.values.TargetDiffersFromSourceCluster {
 		config.Webhooks.SystemComponentsConfig = resourcemanagerv1alpha1.SystemComponentsConfigWebhookConfig{
 			Enabled: true,
@@ -1267,9 +1277,18 @@ func (r *resourceManager) getMutatingWebhookConfigurationWebhooks(
 		webhooks = append(webhooks, GetPodTopologySpreadConstraintsMutatingWebhook(r.values.NamePrefix, namespaceSelector, objectSelector, secretServerCA, buildClientConfigFn))
 	}
 
+	if r.podScaleUpDelayWebhookEnabled() {
+		// pod scale-up delay webhook should be active on all namespaces
+		webhooks = append(webhooks, GetPodScaleUpDelayMutatingWebhook(&metav1.LabelSelector{}, secretServerCA, buildClientConfigFn))
+	}
+
 	return webhooks
 }
 
+func (r *resourceManager) podScaleUpDelayWebhookEnabled() bool {
+	return r.values.TargetDiffersFromSourceCluster && len(r.values.PriorityClassScaleUpDelays) > 0
+}
+
 func (r *resourceManager) getValidatingWebhookConfigurationWebhooks(
 	secretServerCA *corev1.Secret,
 	buildClientConfigFn func(*corev1.Secret, string) admissionregistrationv1.WebhookClientConfig,
@@ -1574,6 +1593,36 @@ func (r *resourceManager) getProjectedTokenMountMutatingWebhook(namespaceSelecto
 	}
 }
 
+// GetPodScaleUpDelayMutatingWebhook returns the pod-scale-up-delay mutating webhook for the resourcemanager component for reuse
+// between the component and integration tests.
+func GetPodScaleUpDelayMutatingWebhook(namespaceSelector *metav1.LabelSelector, secretServerCA *corev1.Secret, buildClientConfigFn func(*corev1.Secret, string) admissionregistrationv1.WebhookClientConfig) admissionregistrationv1.MutatingWebhook {
+	var (
+		failurePolicy = admissionregistrationv1.Ignore
+		matchPolicy   = admissionregistrationv1.Exact
+		sideEffect    = admissionregistrationv1.SideEffectClassNone
+	)
+
+	return admissionregistrationv1.MutatingWebhook{
+		Name: "pod-scale-up-delay.resources.gardener.cloud",
+		Rules: []admissionregistrationv1.RuleWithOperations{{
+			Rule: admissionregistrationv1.Rule{
+				APIGroups:   []string{corev1.GroupName},
+				APIVersions: []string{corev1.SchemeGroupVersion.Version},
+				Resources:   []string{"pods"},
+			},
+			Operations: []admissionregistrationv1.OperationType{admissionregistrationv1.Create},
+		}},
+		NamespaceSelector:       namespaceSelector,
+		ObjectSelector:          &metav1.LabelSelector{},
+		ClientConfig:            buildClientConfigFn(secretServerCA, podscaleupdelay.WebhookPath),
+		AdmissionReviewVersions: []string{admissionv1beta1.SchemeGroupVersion.Version, admissionv1.SchemeGroupVersion.Version},
+		FailurePolicy:           &failurePolicy,
+		MatchPolicy:             &matchPolicy,
+		SideEffects:             &sideEffect,
+		TimeoutSeconds:          pointer.Int32(10),
+	}
+}
+
 // GetPodSchedulerNameMutatingWebhook returns the pod-scheduler-name1 mutating webhook for the resourcemanager component for reuse
 // between the component and integration tests.
 func GetPodSchedulerNameMutatingWebhook(namespaceSelector *metav1.LabelSelector, secretServerCA *corev1.Secret, buildClientConfigFn func(*corev1.Secret, string) admissionregistrationv1.WebhookClientConfig) admissionregistrationv1.MutatingWebhook {
@@ -2051,6 +2100,7 @@ func disableControllersAndWebhooksForWorkerlessShoot(config *resourcemanagerv1al
 	config.Controllers.Node.Enabled = false
 
 	// disable unneeded webhooks
+	config.Webhooks.PodScaleUpDelay.Enabled = false
 	config.Webhooks.PodSchedulerName.Enabled = false
 	config.Webhooks.SystemComponentsConfig.Enabled = false
 	config.Webhooks.ProjectedTokenMount.Enabled = false
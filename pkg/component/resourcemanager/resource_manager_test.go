@@ -345,6 +345,7 @@ var _ = Describe("ResourceManager", func() {
 			DefaultSeccompProfileEnabled:        false,
 			EndpointSliceHintsEnabled:           false,
 			PodTopologySpreadConstraintsEnabled: true,
+			PriorityClassScaleUpDelays:          map[string]metav1.Duration{"batch": {Duration: 5 * time.Minute}},
 			LogLevel:                            "info",
 			LogFormat:                           "json",
 			Zones:                               []string{"a", "b"},
@@ -466,6 +467,10 @@ var _ = Describe("ResourceManager", func() {
 				}
 
 				config.Controllers.Node.Enabled = !isWorkerless
+				config.Webhooks.PodScaleUpDelay = resourcemanagerv1alpha1.PodScaleUpDelayWebhookConfig{
+					Enabled:                    !isWorkerless,
+					PriorityClassScaleUpDelays: map[string]metav1.Duration{"batch": {Duration: 5 * time.Minute}},
+				}
 				config.Webhooks.PodSchedulerName = resourcemanagerv1alpha1.PodSchedulerNameWebhookConfig{
 					Enabled:       !isWorkerless,
 					SchedulerName: pointer.String("bin-packing-scheduler"),
@@ -1413,6 +1418,27 @@ webhooks:
     - pods
   sideEffects: None
   timeoutSeconds: 10
+- admissionReviewVersions:
+  - v1beta1
+  - v1
+  clientConfig:
+    url: https://gardener-resource-manager.` + deployNamespace + `:443/webhooks/pod-scale-up-delay
+  failurePolicy: Ignore
+  matchPolicy: Exact
+  name: pod-scale-up-delay.resources.gardener.cloud
+  namespaceSelector: {}
+  objectSelector: {}
+  rules:
+  - apiGroups:
+    - ""
+    apiVersions:
+    - v1
+    operations:
+    - CREATE
+    resources:
+    - pods
+  sideEffects: None
+  timeoutSeconds: 10
 `
 		clusterRoleBindingTargetYAML := `apiVersion: rbac.authorization.k8s.io/v1
 kind: ClusterRoleBinding
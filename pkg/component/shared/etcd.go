@@ -23,6 +23,8 @@ import (
 
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 	"github.com/gardener/gardener/pkg/component/etcd"
+	etcdconstants "github.com/gardener/gardener/pkg/component/etcd/constants"
+	"github.com/gardener/gardener/pkg/utils/gardener/secretsrotation"
 	secretsutils "github.com/gardener/gardener/pkg/utils/secrets"
 	secretsmanager "github.com/gardener/gardener/pkg/utils/secrets/manager"
 )
@@ -47,3 +49,37 @@ func SnapshotEtcd(ctx context.Context, secretsManager secretsmanager.Interface,
 
 	return etcdMain.Snapshot(ctx, httpClient)
 }
+
+// NewEtcdMainReader returns a reader for the raw data stored in ETCD main in the given namespace. It authenticates with
+// the ETCD client certificate.
+func NewEtcdMainReader(secretsManager secretsmanager.Interface, namespace string) (secretsrotation.EtcdReader, error) {
+	etcdCASecret, found := secretsManager.Get(v1beta1constants.SecretNameCAETCD)
+	if !found {
+		return nil, fmt.Errorf("secret %q not found", v1beta1constants.SecretNameCAETCD)
+	}
+
+	etcdClientSecret, found := secretsManager.Get(etcd.SecretNameClient)
+	if !found {
+		return nil, fmt.Errorf("secret %q not found", etcd.SecretNameClient)
+	}
+
+	clientCertificate, err := tls.X509KeyPair(etcdClientSecret.Data[secretsutils.DataKeyCertificate], etcdClientSecret.Data[secretsutils.DataKeyPrivateKey])
+	if err != nil {
+		return nil, fmt.Errorf("failed parsing ETCD client certificate: %w", err)
+	}
+
+	caCerts := x509.NewCertPool()
+	caCerts.AppendCertsFromPEM(etcdCASecret.Data[secretsutils.DataKeyCertificateBundle])
+
+	httpClient := &http.Client{
+		Transport: &http.Transport{
+			TLSClientConfig: &tls.Config{
+				RootCAs:      caCerts,
+				Certificates: []tls.Certificate{clientCertificate},
+			},
+		},
+	}
+
+	endpoint := fmt.Sprintf("https://%s.%s:%d", etcdconstants.ServiceName(v1beta1constants.ETCDRoleMain), namespace, etcdconstants.PortEtcdClient)
+	return secretsrotation.NewEtcdGatewayReader(httpClient, endpoint), nil
+}
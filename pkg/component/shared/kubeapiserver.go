@@ -54,6 +54,9 @@ var (
 	codec         runtime.Codec
 )
 
+// KubeAPIServerEncryptedResources are the resources which are encrypted by the kube-apiserver.
+var KubeAPIServerEncryptedResources = []string{corev1.Resource("secrets").String()}
+
 func init() {
 	runtimeScheme = runtime.NewScheme()
 	utilruntime.Must(admissionapiv1alpha1.AddToScheme(runtimeScheme))
@@ -246,7 +249,7 @@ func DeployKubeAPIServer(
 	kubeAPIServer.SetExternalHostname(externalHostname)
 	kubeAPIServer.SetExternalServer(externalServer)
 
-	etcdEncryptionConfig, err := computeAPIServerETCDEncryptionConfig(ctx, runtimeClient, runtimeNamespace, deploymentName, etcdEncryptionKeyRotationPhase, []string{corev1.Resource("secrets").String()})
+	etcdEncryptionConfig, err := computeAPIServerETCDEncryptionConfig(ctx, runtimeClient, runtimeNamespace, deploymentName, etcdEncryptionKeyRotationPhase, KubeAPIServerEncryptedResources)
 	if err != nil {
 		return err
 	}
@@ -20,6 +20,7 @@ import (
 
 	"github.com/Masterminds/semver/v3"
 	"github.com/go-logr/logr"
+	"k8s.io/client-go/tools/record"
 
 	"github.com/gardener/gardener/imagevector"
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
@@ -42,11 +43,12 @@ func NewKubeControllerManager(
 	priorityClassName string,
 	isWorkerless bool,
 	hvpaConfig *kubecontrollermanager.HVPAConfig,
-	podNetwork *net.IPNet,
-	serviceNetwork *net.IPNet,
+	podNetworks []net.IPNet,
+	serviceNetworks []net.IPNet,
 	clusterSigningDuration *time.Duration,
 	controllerWorkers kubecontrollermanager.ControllerWorkers,
 	controllerSyncPeriods kubecontrollermanager.ControllerSyncPeriods,
+	recorder record.EventRecorder,
 ) (
 	kubecontrollermanager.Interface,
 	error,
@@ -70,11 +72,12 @@ func NewKubeControllerManager(
 			NamePrefix:             namePrefix,
 			HVPAConfig:             hvpaConfig,
 			IsWorkerless:           isWorkerless,
-			PodNetwork:             podNetwork,
-			ServiceNetwork:         serviceNetwork,
+			PodNetworks:            podNetworks,
+			ServiceNetworks:        serviceNetworks,
 			ClusterSigningDuration: clusterSigningDuration,
 			ControllerWorkers:      controllerWorkers,
 			ControllerSyncPeriods:  controllerSyncPeriods,
+			Recorder:               recorder,
 		},
 	), nil
 }
@@ -124,6 +124,7 @@ func NewTargetGardenerResourceManager(
 	namePrefix string,
 	podTopologySpreadConstraintsEnabled bool,
 	priorityClassName string,
+	priorityClassScaleUpDelays map[string]metav1.Duration,
 	schedulingProfile *gardencorev1beta1.SchedulingProfile,
 	secretNameServerCA string,
 	systemComponentsToleration []corev1.Toleration,
@@ -159,6 +160,7 @@ func NewTargetGardenerResourceManager(
 		NamePrefix:                           namePrefix,
 		PodTopologySpreadConstraintsEnabled:  podTopologySpreadConstraintsEnabled,
 		PriorityClassName:                    priorityClassName,
+		PriorityClassScaleUpDelays:           priorityClassScaleUpDelays,
 		SchedulingProfile:                    schedulingProfile,
 		SecretNameServerCA:                   secretNameServerCA,
 		SyncPeriod:                           &metav1.Duration{Duration: time.Minute},
@@ -357,8 +357,8 @@ func (s *shootSystem) shootInfoData() map[string]string {
 		"provider":          s.values.Shoot.GetInfo().Spec.Provider.Type,
 		"region":            s.values.Shoot.GetInfo().Spec.Region,
 		"kubernetesVersion": s.values.Shoot.GetInfo().Spec.Kubernetes.Version,
-		"podNetwork":        s.values.Shoot.Networks.Pods.String(),
-		"serviceNetwork":    s.values.Shoot.Networks.Services.String(),
+		"podNetwork":        s.values.Shoot.Networks.PrimaryPods().String(),
+		"serviceNetwork":    s.values.Shoot.Networks.PrimaryServices().String(),
 		"maintenanceBegin":  s.values.Shoot.GetInfo().Spec.Maintenance.TimeWindow.Begin,
 		"maintenanceEnd":    s.values.Shoot.GetInfo().Spec.Maintenance.TimeWindow.End,
 	}
@@ -0,0 +1,74 @@
+// Copyright 2021 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package routes
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/go-logr/logr"
+)
+
+// ProfilingServer serves the ProfilingHandlers on a dedicated address, e.g., so that access to them can be restricted
+// independently of the metrics endpoint. It implements manager.Runnable and manager.LeaderElectionRunnable.
+type ProfilingServer struct {
+	// Log is the logger.
+	Log logr.Logger
+	// Address is the address the server listens on.
+	Address string
+}
+
+// Start starts the server and blocks until the given context is cancelled.
+func (s *ProfilingServer) Start(ctx context.Context) error {
+	listener, err := net.Listen("tcp", s.Address)
+	if err != nil {
+		return fmt.Errorf("failed listening on %q: %w", s.Address, err)
+	}
+
+	mux := http.NewServeMux()
+	for path, handler := range ProfilingHandlers {
+		mux.Handle(path, handler)
+	}
+
+	server := &http.Server{
+		Handler:           mux,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	go func() {
+		<-ctx.Done()
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		if err := server.Shutdown(shutdownCtx); err != nil {
+			s.Log.Error(err, "Failed shutting down profiling server")
+		}
+	}()
+
+	s.Log.Info("Starting profiling server", "address", listener.Addr().String())
+	if err := server.Serve(listener); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		return fmt.Errorf("failed serving profiling server: %w", err)
+	}
+	return nil
+}
+
+// NeedLeaderElection returns false since the profiling endpoints must also be served by instances which are not the
+// leader.
+func (s *ProfilingServer) NeedLeaderElection() bool {
+	return false
+}
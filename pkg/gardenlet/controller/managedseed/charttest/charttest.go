@@ -189,6 +189,11 @@ func getGardenletClusterRole(labels map[string]string) *rbacv1.ClusterRole {
 				Resources: []string{"persistentvolumeclaims"},
 				Verbs:     []string{"create"},
 			},
+			{
+				APIGroups: []string{""},
+				Resources: []string{"events"},
+				Verbs:     []string{"create", "patch"},
+			},
 			{
 				APIGroups: []string{""},
 				Resources: []string{"endpoints", "persistentvolumes"},
@@ -43,6 +43,7 @@ import (
 	"github.com/gardener/gardener/pkg/component/extensions/operatingsystemconfig/downloader"
 	"github.com/gardener/gardener/pkg/component/kubeapiserver"
 	kubeapiserverconstants "github.com/gardener/gardener/pkg/component/kubeapiserver/constants"
+	"github.com/gardener/gardener/pkg/component/kubecontrollermanager"
 	"github.com/gardener/gardener/pkg/component/kubeproxy"
 	"github.com/gardener/gardener/pkg/component/kubernetesdashboard"
 	"github.com/gardener/gardener/pkg/component/kubescheduler"
@@ -221,6 +222,7 @@ func defaultDependencyWatchdogs(
 		var (
 			dependencyWatchdogProberConfigurationFuncs = []dependencywatchdog.ProberConfigurationFunc{
 				kubeapiserver.NewDependencyWatchdogProberConfiguration,
+				kubecontrollermanager.NewDependencyWatchdogProberConfiguration,
 			}
 			dependencyWatchdogProberConfiguration = proberapi.Config{
 				InternalKubeConfigSecretName: dependencywatchdog.InternalProbeSecretName,
@@ -48,6 +48,9 @@ func (r *Reconciler) AddToManager(mgr manager.Manager, gardenCluster cluster.Clu
 	if r.Recorder == nil {
 		r.Recorder = gardenCluster.GetEventRecorderFor(ControllerName + "-controller")
 	}
+	if r.SeedRecorder == nil {
+		r.SeedRecorder = mgr.GetEventRecorderFor(ControllerName + "-controller")
+	}
 	if r.Clock == nil {
 		r.Clock = clock.RealClock{}
 	}
@@ -71,6 +71,7 @@ type Reconciler struct {
 	ShootClientMap              clientmap.ClientMap
 	Config                      config.GardenletConfiguration
 	Recorder                    record.EventRecorder
+	SeedRecorder                record.EventRecorder
 	Identity                    *gardencorev1beta1.Gardener
 	GardenClusterIdentity       string
 	Clock                       clock.Clock
@@ -133,7 +134,7 @@ func (r *Reconciler) reconcileShoot(ctx context.Context, log logr.Logger, shoot
 	}
 
 	r.Recorder.Event(shoot, corev1.EventTypeNormal, gardencorev1beta1.EventReconciled, fmt.Sprintf("%s Shoot cluster", utils.IifString(isRestoring, "Restored", "Reconciled")))
-	if err := r.patchShootStatusOperationSuccess(ctx, shoot, o.Shoot.SeedNamespace, &o.Seed.GetInfo().Name, operationType); err != nil {
+	if err := r.patchShootStatusOperationSuccess(ctx, shoot, o.Shoot.SeedNamespace, &o.Seed.GetInfo().Name, operationType, o.Shoot.EncryptedDataRewritePaused); err != nil {
 		return reconcile.Result{}, err
 	}
 
@@ -384,6 +385,7 @@ func (r *Reconciler) initializeOperation(
 	if err != nil {
 		return nil, err
 	}
+	op.SeedRecorder = r.SeedRecorder
 
 	// Only set UID once the operation was initialized successfully.
 	// This serves as a marker in the lifecycle of a shoot that all necessary information is available to begin with the
@@ -438,7 +440,7 @@ func (r *Reconciler) finalizeShootMigration(ctx context.Context, shoot *gardenco
 	}
 
 	r.Recorder.Event(shoot, corev1.EventTypeNormal, gardencorev1beta1.EventMigrationPrepared, "Prepared Shoot cluster for migration")
-	return reconcile.Result{}, r.patchShootStatusOperationSuccess(ctx, shoot, o.Shoot.SeedNamespace, nil, gardencorev1beta1.LastOperationTypeMigrate)
+	return reconcile.Result{}, r.patchShootStatusOperationSuccess(ctx, shoot, o.Shoot.SeedNamespace, nil, gardencorev1beta1.LastOperationTypeMigrate, false)
 }
 
 func (r *Reconciler) finalizeShootDeletion(ctx context.Context, log logr.Logger, shoot *gardencorev1beta1.Shoot) (reconcile.Result, error) {
@@ -462,7 +464,7 @@ func (r *Reconciler) deleteClusterResourceFromSeed(ctx context.Context, shoot *g
 }
 
 func (r *Reconciler) removeFinalizerFromShoot(ctx context.Context, log logr.Logger, shoot *gardencorev1beta1.Shoot) error {
-	if err := r.patchShootStatusOperationSuccess(ctx, shoot, "", nil, gardencorev1beta1.LastOperationTypeDelete); err != nil {
+	if err := r.patchShootStatusOperationSuccess(ctx, shoot, "", nil, gardencorev1beta1.LastOperationTypeDelete, false); err != nil {
 		return err
 	}
 
@@ -630,6 +632,7 @@ func (r *Reconciler) patchShootStatusOperationSuccess(
 	shootSeedNamespace string,
 	seedName *string,
 	operationType gardencorev1beta1.LastOperationType,
+	encryptedDataRewritePaused bool,
 ) error {
 	var (
 		now                        = metav1.NewTime(r.Clock.Now().UTC())
@@ -695,6 +698,10 @@ func (r *Reconciler) patchShootStatusOperationSuccess(
 		shoot.Status.SeedName = shoot.Spec.SeedName
 	}
 
+	if encryptedDataRewritePaused {
+		description += " The rewrite of encrypted data for the ETCD encryption key rotation is paused."
+	}
+
 	shoot.Status.RetryCycleStartTime = nil
 	shoot.Status.LastErrors = nil
 	shoot.Status.LastOperation = &gardencorev1beta1.LastOperation{
@@ -737,20 +744,23 @@ func (r *Reconciler) patchShootStatusOperationSuccess(
 		})
 	}
 
-	switch v1beta1helper.GetShootETCDEncryptionKeyRotationPhase(shoot.Status.Credentials) {
-	case gardencorev1beta1.RotationPreparing:
-		v1beta1helper.MutateShootETCDEncryptionKeyRotation(shoot, func(rotation *gardencorev1beta1.ETCDEncryptionKeyRotation) {
-			rotation.Phase = gardencorev1beta1.RotationPrepared
-			rotation.LastInitiationFinishedTime = &now
-		})
+	// The ETCD encryption key rotation remains in its current phase while the rewrite of encrypted data is paused.
+	if !encryptedDataRewritePaused {
+		switch v1beta1helper.GetShootETCDEncryptionKeyRotationPhase(shoot.Status.Credentials) {
+		case gardencorev1beta1.RotationPreparing:
+			v1beta1helper.MutateShootETCDEncryptionKeyRotation(shoot, func(rotation *gardencorev1beta1.ETCDEncryptionKeyRotation) {
+				rotation.Phase = gardencorev1beta1.RotationPrepared
+				rotation.LastInitiationFinishedTime = &now
+			})
 
-	case gardencorev1beta1.RotationCompleting:
-		v1beta1helper.MutateShootETCDEncryptionKeyRotation(shoot, func(rotation *gardencorev1beta1.ETCDEncryptionKeyRotation) {
-			rotation.Phase = gardencorev1beta1.RotationCompleted
-			rotation.LastCompletionTime = &now
-			rotation.LastInitiationFinishedTime = nil
-			rotation.LastCompletionTriggeredTime = nil
-		})
+		case gardencorev1beta1.RotationCompleting:
+			v1beta1helper.MutateShootETCDEncryptionKeyRotation(shoot, func(rotation *gardencorev1beta1.ETCDEncryptionKeyRotation) {
+				rotation.Phase = gardencorev1beta1.RotationCompleted
+				rotation.LastCompletionTime = &now
+				rotation.LastInitiationFinishedTime = nil
+				rotation.LastCompletionTriggeredTime = nil
+			})
+		}
 	}
 
 	if v1beta1helper.IsShootKubeconfigRotationInitiationTimeAfterLastCompletionTime(shoot.Status.Credentials) {
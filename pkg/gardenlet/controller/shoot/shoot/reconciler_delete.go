@@ -348,6 +348,14 @@ func (r *Reconciler) runDeleteShootFlow(ctx context.Context, o *operation.Operat
 			SkipIf:       botanist.Shoot.IsWorkerless,
 			Dependencies: flow.NewTaskIDs(initializeShootClients),
 		})
+		waitUntilClusterAutoscalerDeleted = g.Add(flow.Task{
+			Name: "Waiting until cluster autoscaler has been deleted",
+			Fn: flow.TaskFn(func(ctx context.Context) error {
+				return botanist.Shoot.Components.ControlPlane.ClusterAutoscaler.WaitCleanup(ctx)
+			}),
+			SkipIf:       botanist.Shoot.IsWorkerless,
+			Dependencies: flow.NewTaskIDs(deleteClusterAutoscaler),
+		})
 
 		cleanupWebhooks = g.Add(flow.Task{
 			Name:         "Cleaning up webhooks",
@@ -365,7 +373,7 @@ func (r *Reconciler) runDeleteShootFlow(ctx context.Context, o *operation.Operat
 			Name:         "Cleaning extended API groups",
 			Fn:           flow.TaskFn(botanist.CleanExtendedAPIs).Timeout(10 * time.Minute),
 			SkipIf:       !cleanupShootResources || metav1.HasAnnotation(botanist.Shoot.GetInfo().ObjectMeta, v1beta1constants.AnnotationShootSkipCleanup),
-			Dependencies: flow.NewTaskIDs(initializeShootClients, deleteClusterAutoscaler, waitForControllersToBeActive),
+			Dependencies: flow.NewTaskIDs(initializeShootClients, waitUntilClusterAutoscalerDeleted, waitForControllersToBeActive),
 		})
 
 		syncPointReadyForCleanup = flow.NewTaskIDs(
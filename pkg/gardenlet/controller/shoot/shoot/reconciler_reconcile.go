@@ -17,11 +17,13 @@ package shoot
 import (
 	"context"
 	"fmt"
+	"strconv"
 	"time"
 
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
@@ -30,6 +32,7 @@ import (
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	"github.com/gardener/gardener/pkg/client/kubernetes/clientmap/keys"
 	"github.com/gardener/gardener/pkg/component/kubeapiserver"
+	"github.com/gardener/gardener/pkg/component/shared"
 	"github.com/gardener/gardener/pkg/controllerutils"
 	"github.com/gardener/gardener/pkg/gardenlet/controller/shoot/shoot/helper"
 	"github.com/gardener/gardener/pkg/operation"
@@ -108,6 +111,20 @@ func (r *Reconciler) runReconcileShootFlow(ctx context.Context, o *operation.Ope
 		shootControlPlaneLoggingEnabled = botanist.Shoot.IsShootControlPlaneLoggingEnabled(botanist.Config)
 		deployKubeAPIServerTaskTimeout  = defaultTimeout
 		shootSSHAccessEnabled           = v1beta1helper.ShootEnablesSSHAccess(o.Shoot.GetInfo())
+		rewriteOptions                  = secretsrotation.RewriteOptions{
+			NamespaceByNamespace: o.Shoot.GetInfo().Annotations[v1beta1constants.AnnotationEncryptedDataRewriteNamespaceByNamespace] == "true",
+			ProgressSink: secretsrotation.NewMultiProgressSink(
+				secretsrotation.NewLogProgressSink(o.Logger),
+				secretsrotation.NewMetricsProgressSink(o.Shoot.SeedNamespace),
+				secretsrotation.ProgressSinkFunc(func(_ context.Context, progress secretsrotation.Progress) error {
+					if progress.Paused {
+						o.Shoot.EncryptedDataRewritePaused = true
+					}
+					return nil
+				}),
+			),
+		}
+		encryptedGVKs = []schema.GroupVersionKind{corev1.SchemeGroupVersion.WithKind("SecretList")}
 	)
 
 	// During the 'Preparing' phase of different rotation operations, components are deployed twice. Also, the
@@ -118,6 +135,14 @@ func (r *Reconciler) runReconcileShootFlow(ctx context.Context, o *operation.Ope
 		deployKubeAPIServerTaskTimeout = kubeapiserver.TimeoutWaitForDeployment
 	}
 
+	// Invalid values are ignored, i.e., the defaults of the rewrite are used.
+	if pageSize, err := strconv.ParseInt(o.Shoot.GetInfo().Annotations[v1beta1constants.AnnotationEncryptedDataRewritePageSize], 10, 64); err == nil {
+		rewriteOptions.PageSize = pageSize
+	}
+	if qps, err := strconv.ParseFloat(o.Shoot.GetInfo().Annotations[v1beta1constants.AnnotationEncryptedDataRewriteQPS], 32); err == nil {
+		rewriteOptions.QPS = float32(qps)
+	}
+
 	var (
 		deployExtensionAfterKAPIMsg = "Deploying extension resources after kube-apiserver"
 		waitExtensionAfterKAPIMsg   = "Waiting until extension resources handled after kube-apiserver are ready"
@@ -411,7 +436,19 @@ func (r *Reconciler) runReconcileShootFlow(ctx context.Context, o *operation.Ope
 		rewriteSecretsAddLabel = g.Add(flow.Task{
 			Name: "Labeling secrets to encrypt them with new ETCD encryption key",
 			Fn: flow.TaskFn(func(ctx context.Context) error {
-				return secretsrotation.RewriteEncryptedDataAddLabel(ctx, o.Logger, o.ShootClientSet.Client(), o.SecretsManager, corev1.SchemeGroupVersion.WithKind("SecretList"))
+				stateMachine, err := secretsrotation.NewETCDEncryptionKeyStateMachine(ctx, o.SeedClientSet.Client(), o.Shoot.SeedNamespace, v1beta1constants.DeploymentNameKubeAPIServer, gardencorev1beta1.RotationPreparing)
+				if err != nil {
+					return err
+				}
+
+				gvks, err := secretsrotation.GetResourcesForEncryption(ctx, o.Logger, o.ShootClientSet.Client(), encryptedGVKs)
+				if err != nil {
+					return err
+				}
+
+				opts := rewriteOptions
+				opts.StepRunner = stateMachine
+				return secretsrotation.RewriteEncryptedDataAddLabel(ctx, o.Logger, o.ShootClientSet.Client(), o.SecretsManager, opts, gvks...)
 			}).RetryUntilTimeout(30*time.Second, 10*time.Minute),
 			SkipIf:       v1beta1helper.GetShootETCDEncryptionKeyRotationPhase(o.Shoot.GetInfo().Status.Credentials) != gardencorev1beta1.RotationPreparing,
 			Dependencies: flow.NewTaskIDs(initializeShootClients),
@@ -419,15 +456,61 @@ func (r *Reconciler) runReconcileShootFlow(ctx context.Context, o *operation.Ope
 		_ = g.Add(flow.Task{
 			Name: "Snapshotting ETCD after secrets were re-encrypted with new ETCD encryption key",
 			Fn: flow.TaskFn(func(ctx context.Context) error {
+				if o.Shoot.EncryptedDataRewritePaused {
+					return nil
+				}
 				return secretsrotation.SnapshotETCDAfterRewritingEncryptedData(ctx, o.SeedClientSet.Client(), botanist.SnapshotEtcd, o.Shoot.SeedNamespace, v1beta1constants.DeploymentNameKubeAPIServer)
 			}),
 			SkipIf:       !allowBackup || v1beta1helper.GetShootETCDEncryptionKeyRotationPhase(o.Shoot.GetInfo().Status.Credentials) != gardencorev1beta1.RotationPreparing,
 			Dependencies: flow.NewTaskIDs(rewriteSecretsAddLabel),
 		})
 		_ = g.Add(flow.Task{
-			Name: "Removing label from secrets after rotation of ETCD encryption key",
+			Name: "Verifying that secrets stored in ETCD are encrypted with new ETCD encryption key",
+			Fn: flow.TaskFn(func(ctx context.Context) error {
+				if o.Shoot.EncryptedDataRewritePaused {
+					return nil
+				}
+				return botanist.VerifyEncryptedDataAtRest(ctx)
+			}).RetryUntilTimeout(defaultInterval, defaultTimeout),
+			SkipIf:       o.Shoot.GetInfo().Annotations[v1beta1constants.AnnotationEncryptedDataVerifyAtRest] != "true" || v1beta1helper.GetShootETCDEncryptionKeyRotationPhase(o.Shoot.GetInfo().Status.Credentials) != gardencorev1beta1.RotationPreparing,
+			Dependencies: flow.NewTaskIDs(rewriteSecretsAddLabel),
+		})
+		_ = g.Add(flow.Task{
+			Name: "Removing label from encrypted resources after rotation of ETCD encryption key",
 			Fn: flow.TaskFn(func(ctx context.Context) error {
-				return secretsrotation.RewriteEncryptedDataRemoveLabel(ctx, o.Logger, o.SeedClientSet.Client(), o.ShootClientSet.Client(), o.Shoot.SeedNamespace, v1beta1constants.DeploymentNameKubeAPIServer, corev1.SchemeGroupVersion.WithKind("SecretList"))
+				stateMachine, err := secretsrotation.NewETCDEncryptionKeyStateMachine(ctx, o.SeedClientSet.Client(), o.Shoot.SeedNamespace, v1beta1constants.DeploymentNameKubeAPIServer, gardencorev1beta1.RotationCompleting)
+				if err != nil {
+					return err
+				}
+
+				opts := rewriteOptions
+				opts.StepRunner = stateMachine
+
+				discoveredGVKs, err := secretsrotation.DiscoverEncryptedResources(ctx, o.Logger, o.ShootClientSet.Client(), shared.KubeAPIServerEncryptedResources, encryptedGVKs)
+				if err != nil {
+					return err
+				}
+				discoveredGVKs, err = secretsrotation.GetResourcesForEncryption(ctx, o.Logger, o.ShootClientSet.Client(), discoveredGVKs)
+				if err != nil {
+					return err
+				}
+				if len(discoveredGVKs) > 0 {
+					// Objects of resources registered after the start of the rotation might still be encrypted with the old
+					// key, hence they must be rewritten before the old key is removed. The rewrite is a step of the same
+					// state machine, i.e., it is not repeated once the label was removed from all objects.
+					if err := secretsrotation.RewriteDiscoveredEncryptedDataAddLabel(ctx, o.Logger, o.ShootClientSet.Client(), o.SecretsManager, opts, discoveredGVKs...); err != nil {
+						return err
+					}
+					if o.Shoot.EncryptedDataRewritePaused {
+						return nil
+					}
+				}
+
+				gvks, err := secretsrotation.GetResourcesForEncryption(ctx, o.Logger, o.ShootClientSet.Client(), encryptedGVKs)
+				if err != nil {
+					return err
+				}
+				return secretsrotation.RewriteEncryptedDataRemoveLabel(ctx, o.Logger, o.SeedClientSet.Client(), o.ShootClientSet.Client(), o.Shoot.SeedNamespace, v1beta1constants.DeploymentNameKubeAPIServer, opts, append(gvks, discoveredGVKs...)...)
 			}).RetryUntilTimeout(30*time.Second, 10*time.Minute),
 			SkipIf:       v1beta1helper.GetShootETCDEncryptionKeyRotationPhase(o.Shoot.GetInfo().Status.Credentials) != gardencorev1beta1.RotationCompleting,
 			Dependencies: flow.NewTaskIDs(initializeShootClients),
@@ -715,6 +798,12 @@ func (r *Reconciler) runReconcileShootFlow(ctx context.Context, o *operation.Ope
 			SkipIf:       o.Shoot.IsWorkerless || o.Shoot.HibernationEnabled,
 			Dependencies: flow.NewTaskIDs(waitUntilWorkerStatusUpdate, deployManagedResourcesForAddons, deployManagedResourceForCloudConfigExecutor),
 		})
+		_ = g.Add(flow.Task{
+			Name:         "Waiting until cluster autoscaler is ready",
+			Fn:           flow.TaskFn(botanist.WaitForClusterAutoscaler),
+			SkipIf:       o.Shoot.IsWorkerless || o.Shoot.HibernationEnabled || skipReadiness,
+			Dependencies: flow.NewTaskIDs(deployClusterAutoscaler),
+		})
 		waitUntilWorkerReady = g.Add(flow.Task{
 			Name: "Waiting until shoot worker nodes have been reconciled",
 			Fn: flow.TaskFn(func(ctx context.Context) error {
@@ -17,9 +17,11 @@ package v1alpha1
 import (
 	"time"
 
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	componentbaseconfigv1alpha1 "k8s.io/component-base/config/v1alpha1"
+	"k8s.io/utils/pointer"
 
 	"github.com/gardener/gardener/pkg/logger"
 )
@@ -47,6 +49,65 @@ func SetDefaults_OperatingSystemConfigControllerConfig(obj *OperatingSystemConfi
 	if obj.SyncJitterPeriod == nil {
 		obj.SyncJitterPeriod = &metav1.Duration{Duration: 5 * time.Minute}
 	}
+
+	if obj.DiskUsageQuota == nil {
+		quota := resource.MustParse("1Gi")
+		obj.DiskUsageQuota = &quota
+	}
+
+	if obj.DriftDetectionEnabled == nil {
+		obj.DriftDetectionEnabled = pointer.Bool(false)
+	}
+
+	if obj.ConcurrentFileWrites == nil {
+		obj.ConcurrentFileWrites = pointer.Int(5)
+	}
+
+	if obj.UnitHealthVerificationTimeout == nil {
+		obj.UnitHealthVerificationTimeout = &metav1.Duration{Duration: time.Minute}
+	}
+
+	if obj.RequeueBackoff == nil {
+		obj.RequeueBackoff = &RequeueBackoff{}
+	}
+
+	if obj.Reboot == nil {
+		obj.Reboot = &Reboot{}
+	}
+}
+
+// SetDefaults_RequeueBackoff sets defaults for the RequeueBackoff object.
+func SetDefaults_RequeueBackoff(obj *RequeueBackoff) {
+	if obj.InitialInterval == nil {
+		obj.InitialInterval = &metav1.Duration{Duration: 5 * time.Second}
+	}
+
+	if obj.MaxInterval == nil {
+		obj.MaxInterval = &metav1.Duration{Duration: 5 * time.Minute}
+	}
+
+	if obj.Multiplier == nil {
+		obj.Multiplier = pointer.Float64(2)
+	}
+
+	if obj.JitterPeriod == nil {
+		obj.JitterPeriod = &metav1.Duration{Duration: 5 * time.Second}
+	}
+}
+
+// SetDefaults_Reboot sets defaults for the Reboot object.
+func SetDefaults_Reboot(obj *Reboot) {
+	if obj.Enabled == nil {
+		obj.Enabled = pointer.Bool(false)
+	}
+
+	if obj.MaxConcurrentReboots == nil {
+		obj.MaxConcurrentReboots = pointer.Int32(1)
+	}
+
+	if obj.DrainTimeout == nil {
+		obj.DrainTimeout = &metav1.Duration{Duration: 30 * time.Minute}
+	}
 }
 
 // SetDefaults_ClientConnectionConfiguration sets defaults for the garden client connection.
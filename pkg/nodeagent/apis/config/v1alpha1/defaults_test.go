@@ -20,7 +20,9 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	. "github.com/onsi/gomega/gstruct"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
 
 	"github.com/gardener/gardener/pkg/logger"
 	. "github.com/gardener/gardener/pkg/nodeagent/apis/config/v1alpha1"
@@ -65,18 +67,87 @@ var _ = Describe("Defaults", func() {
 
 					Expect(obj.SyncPeriod).To(PointTo(Equal(metav1.Duration{Duration: 10 * time.Minute})))
 					Expect(obj.SyncJitterPeriod).To(PointTo(Equal(metav1.Duration{Duration: 5 * time.Minute})))
+					Expect(obj.DiskUsageQuota).To(PointTo(Equal(resource.MustParse("1Gi"))))
+					Expect(obj.DriftDetectionEnabled).To(PointTo(BeFalse()))
+					Expect(obj.ConcurrentFileWrites).To(PointTo(Equal(5)))
+					Expect(obj.UnitHealthVerificationTimeout).To(PointTo(Equal(metav1.Duration{Duration: time.Minute})))
+					Expect(obj.RequeueBackoff).To(PointTo(Equal(RequeueBackoff{})))
+					Expect(obj.Reboot).To(PointTo(Equal(Reboot{})))
 				})
 
 				It("should not overwrite existing values", func() {
 					obj := &OperatingSystemConfigControllerConfig{
-						SyncPeriod:       &metav1.Duration{Duration: time.Second},
-						SyncJitterPeriod: &metav1.Duration{Duration: time.Minute},
+						SyncPeriod:                    &metav1.Duration{Duration: time.Second},
+						SyncJitterPeriod:              &metav1.Duration{Duration: time.Minute},
+						DiskUsageQuota:                resource.NewQuantity(1<<20, resource.BinarySI),
+						DriftDetectionEnabled:         pointer.Bool(true),
+						ConcurrentFileWrites:          pointer.Int(1),
+						UnitHealthVerificationTimeout: &metav1.Duration{Duration: 5 * time.Minute},
 					}
 
 					SetDefaults_OperatingSystemConfigControllerConfig(obj)
 
 					Expect(obj.SyncPeriod).To(PointTo(Equal(metav1.Duration{Duration: time.Second})))
 					Expect(obj.SyncJitterPeriod).To(PointTo(Equal(metav1.Duration{Duration: time.Minute})))
+					Expect(obj.DiskUsageQuota).To(PointTo(Equal(*resource.NewQuantity(1<<20, resource.BinarySI))))
+					Expect(obj.DriftDetectionEnabled).To(PointTo(BeTrue()))
+					Expect(obj.ConcurrentFileWrites).To(PointTo(Equal(1)))
+					Expect(obj.UnitHealthVerificationTimeout).To(PointTo(Equal(metav1.Duration{Duration: 5 * time.Minute})))
+				})
+			})
+
+			Describe("Requeue backoff", func() {
+				It("should default the object", func() {
+					obj := &RequeueBackoff{}
+
+					SetDefaults_RequeueBackoff(obj)
+
+					Expect(obj.InitialInterval).To(PointTo(Equal(metav1.Duration{Duration: 5 * time.Second})))
+					Expect(obj.MaxInterval).To(PointTo(Equal(metav1.Duration{Duration: 5 * time.Minute})))
+					Expect(obj.Multiplier).To(PointTo(Equal(2.0)))
+					Expect(obj.JitterPeriod).To(PointTo(Equal(metav1.Duration{Duration: 5 * time.Second})))
+				})
+
+				It("should not overwrite existing values", func() {
+					obj := &RequeueBackoff{
+						InitialInterval: &metav1.Duration{Duration: time.Second},
+						MaxInterval:     &metav1.Duration{Duration: time.Minute},
+						Multiplier:      pointer.Float64(1.5),
+						JitterPeriod:    &metav1.Duration{},
+					}
+
+					SetDefaults_RequeueBackoff(obj)
+
+					Expect(obj.InitialInterval).To(PointTo(Equal(metav1.Duration{Duration: time.Second})))
+					Expect(obj.MaxInterval).To(PointTo(Equal(metav1.Duration{Duration: time.Minute})))
+					Expect(obj.Multiplier).To(PointTo(Equal(1.5)))
+					Expect(obj.JitterPeriod).To(PointTo(Equal(metav1.Duration{})))
+				})
+			})
+
+			Describe("Reboot", func() {
+				It("should default the object", func() {
+					obj := &Reboot{}
+
+					SetDefaults_Reboot(obj)
+
+					Expect(obj.Enabled).To(PointTo(BeFalse()))
+					Expect(obj.MaxConcurrentReboots).To(PointTo(Equal(int32(1))))
+					Expect(obj.DrainTimeout).To(PointTo(Equal(metav1.Duration{Duration: 30 * time.Minute})))
+				})
+
+				It("should not overwrite existing values", func() {
+					obj := &Reboot{
+						Enabled:              pointer.Bool(true),
+						MaxConcurrentReboots: pointer.Int32(3),
+						DrainTimeout:         &metav1.Duration{Duration: time.Hour},
+					}
+
+					SetDefaults_Reboot(obj)
+
+					Expect(obj.Enabled).To(PointTo(BeTrue()))
+					Expect(obj.MaxConcurrentReboots).To(PointTo(Equal(int32(3))))
+					Expect(obj.DrainTimeout).To(PointTo(Equal(metav1.Duration{Duration: time.Hour})))
 				})
 			})
 		})
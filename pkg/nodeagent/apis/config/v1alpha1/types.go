@@ -16,6 +16,7 @@ package v1alpha1
 
 import (
 	"github.com/Masterminds/semver/v3"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	componentbaseconfigv1alpha1 "k8s.io/component-base/config/v1alpha1"
 )
@@ -70,6 +71,9 @@ type NodeAgentConfiguration struct {
 	Bootstrap *BootstrapConfiguration `json:"bootstrap,omitempty"`
 	// Controllers defines the configuration of the controllers.
 	Controllers ControllerConfiguration `json:"controllers"`
+	// Diagnostics contains configuration for the local diagnostics server, for crash dumps, and for failure reports.
+	// +optional
+	Diagnostics *DiagnosticsConfiguration `json:"diagnostics,omitempty"`
 }
 
 // APIServer contains information about the API server.
@@ -88,6 +92,27 @@ type BootstrapConfiguration struct {
 	KubeletDataVolumeSize *int64 `json:"kubeletDataVolumeSize,omitempty"`
 }
 
+// DiagnosticsConfiguration contains configuration for the local diagnostics server, for crash dumps, and for failure
+// reports.
+type DiagnosticsConfiguration struct {
+	// SocketPath is the path of the unix socket on which the diagnostics server listens. Only the owner of the socket
+	// (root) may connect to it. If not set, the diagnostics server is not started.
+	// +optional
+	SocketPath *string `json:"socketPath,omitempty"`
+	// PersistCrashDumps specifies whether the stack traces of all goroutines are written to the state directory when
+	// gardener-node-agent panics.
+	// +optional
+	PersistCrashDumps bool `json:"persistCrashDumps,omitempty"`
+	// PersistFailureReports specifies whether a structured failure report is written to the state directory when the
+	// reconciliation of the operating system config fails.
+	// +optional
+	PersistFailureReports bool `json:"persistFailureReports,omitempty"`
+	// RecordFailureReportEvents specifies whether a summary of the failure report is additionally recorded as event for
+	// the node.
+	// +optional
+	RecordFailureReportEvents bool `json:"recordFailureReportEvents,omitempty"`
+}
+
 // ControllerConfiguration defines the configuration of the controllers.
 type ControllerConfiguration struct {
 	// OperatingSystemConfig is the configuration for the operating system config controller.
@@ -112,6 +137,71 @@ type OperatingSystemConfigControllerConfig struct {
 	// KubernetesVersion contains the Kubernetes version of the kubelet, used for annotating the corresponding node
 	// resource with a kubernetes version annotation.
 	KubernetesVersion *semver.Version `json:"kubernetesVersion"`
+	// DiskUsageQuota is the maximum disk space which may be consumed by the caches and temporary directories of
+	// gardener-node-agent, i.e., the extraction cache for files from container images, the chunk cache, and temporary
+	// directories. The files of the operating system config cannot be evicted and hence do not count against it. When
+	// it is exceeded, the least recently used entries of the extraction cache are removed.
+	// It is defaulted to 1Gi.
+	// +optional
+	DiskUsageQuota *resource.Quantity `json:"diskUsageQuota,omitempty"`
+	// DriftDetectionEnabled specifies whether the files and units applied to the node are verified on every sync. If
+	// their content or permissions on the disk do not match the operating system config anymore (e.g., because they were
+	// edited manually), they are applied again. It is defaulted to false.
+	// +optional
+	DriftDetectionEnabled *bool `json:"driftDetectionEnabled,omitempty"`
+	// ConcurrentFileWrites is the number of workers which write the new or changed inline files of the operating system
+	// config concurrently. Files from container images are always applied one after another. It is defaulted to 5.
+	// +optional
+	ConcurrentFileWrites *int `json:"concurrentFileWrites,omitempty"`
+	// UnitHealthVerificationTimeout is the duration the restarted units have to become active and to pass their
+	// post-start probes. The units are verified in parallel, i.e., the timeout applies to all of them together. It is
+	// defaulted to 1m.
+	// +optional
+	UnitHealthVerificationTimeout *metav1.Duration `json:"unitHealthVerificationTimeout,omitempty"`
+	// RequeueBackoff is the backoff for requeuing the operating system config while the reconciliation waits for the
+	// node, e.g. until it is registered by the kubelet or drained before a reboot.
+	// +optional
+	RequeueBackoff *RequeueBackoff `json:"requeueBackoff,omitempty"`
+	// Reboot is the configuration for rebooting the node when an applied change of a file or unit requires it.
+	// +optional
+	Reboot *Reboot `json:"reboot,omitempty"`
+}
+
+// RequeueBackoff defines the backoff for requeuing the operating system config. The duration after which it is
+// requeued starts at InitialInterval and is multiplied by Multiplier after each consecutive requeue up to MaxInterval.
+type RequeueBackoff struct {
+	// InitialInterval is the duration after which the operating system config is requeued for the first time. It is
+	// defaulted to 5s.
+	// +optional
+	InitialInterval *metav1.Duration `json:"initialInterval,omitempty"`
+	// MaxInterval is the maximum duration after which the operating system config is requeued. It is defaulted to 5m.
+	// +optional
+	MaxInterval *metav1.Duration `json:"maxInterval,omitempty"`
+	// Multiplier is the factor by which the duration is multiplied after each consecutive requeue. It is defaulted to 2.
+	// +optional
+	Multiplier *float64 `json:"multiplier,omitempty"`
+	// JitterPeriod is the maximum random duration added to each requeue (including the requeue after the sync period
+	// and the first reconciliation after a restart), so that the nodes of a cluster do not hit the kube-apiserver at
+	// the same time, e.g. after a rollout of the operating system config. It is defaulted to 5s.
+	// +optional
+	JitterPeriod *metav1.Duration `json:"jitterPeriod,omitempty"`
+}
+
+// Reboot defines the configuration for rebooting the node when an applied change of a file or unit requires it.
+type Reboot struct {
+	// Enabled specifies whether the node is cordoned, drained and rebooted when an applied change requires it. If it is
+	// disabled, such changes are applied like any other change, i.e., the units are restarted without rebooting the node.
+	// It is defaulted to false.
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+	// MaxConcurrentReboots is the maximum number of nodes of the cluster which may be cordoned, drained and rebooted at
+	// the same time. It is defaulted to 1.
+	// +optional
+	MaxConcurrentReboots *int32 `json:"maxConcurrentReboots,omitempty"`
+	// DrainTimeout is the maximum duration for draining the node before it is rebooted. When it has passed, the node is
+	// rebooted even if pods could not be evicted, e.g. because of PodDisruptionBudgets. It is defaulted to 30m.
+	// +optional
+	DrainTimeout *metav1.Duration `json:"drainTimeout,omitempty"`
 }
 
 // TokenControllerConfig defines the configuration of the access token controller.
@@ -129,6 +219,11 @@ type ServerConfiguration struct {
 	// Metrics is the configuration for serving the metrics endpoint.
 	// +optional
 	Metrics *Server `json:"metrics,omitempty"`
+	// MetricsClientCAFile is the path to a file containing the bundle of certificate authorities used for verifying the
+	// client certificates of requests to the metrics endpoint. If set, the metrics endpoint is served via HTTPS and only
+	// requests with a client certificate signed by one of these authorities are accepted.
+	// +optional
+	MetricsClientCAFile *string `json:"metricsClientCAFile,omitempty"`
 }
 
 // Server contains information for HTTP(S) server configuration.
@@ -26,6 +26,7 @@ import (
 
 	v3 "github.com/Masterminds/semver/v3"
 	config "github.com/gardener/gardener/pkg/nodeagent/apis/config"
+	resource "k8s.io/apimachinery/pkg/api/resource"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	conversion "k8s.io/apimachinery/pkg/conversion"
 	runtime "k8s.io/apimachinery/pkg/runtime"
@@ -70,6 +71,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*DiagnosticsConfiguration)(nil), (*config.DiagnosticsConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_DiagnosticsConfiguration_To_config_DiagnosticsConfiguration(a.(*DiagnosticsConfiguration), b.(*config.DiagnosticsConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.DiagnosticsConfiguration)(nil), (*DiagnosticsConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_DiagnosticsConfiguration_To_v1alpha1_DiagnosticsConfiguration(a.(*config.DiagnosticsConfiguration), b.(*DiagnosticsConfiguration), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*NodeAgentConfiguration)(nil), (*config.NodeAgentConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_NodeAgentConfiguration_To_config_NodeAgentConfiguration(a.(*NodeAgentConfiguration), b.(*config.NodeAgentConfiguration), scope)
 	}); err != nil {
@@ -90,6 +101,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*Reboot)(nil), (*config.Reboot)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_Reboot_To_config_Reboot(a.(*Reboot), b.(*config.Reboot), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.Reboot)(nil), (*Reboot)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_Reboot_To_v1alpha1_Reboot(a.(*config.Reboot), b.(*Reboot), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*RequeueBackoff)(nil), (*config.RequeueBackoff)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_RequeueBackoff_To_config_RequeueBackoff(a.(*RequeueBackoff), b.(*config.RequeueBackoff), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.RequeueBackoff)(nil), (*RequeueBackoff)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_RequeueBackoff_To_v1alpha1_RequeueBackoff(a.(*config.RequeueBackoff), b.(*RequeueBackoff), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*Server)(nil), (*config.Server)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_Server_To_config_Server(a.(*Server), b.(*config.Server), scope)
 	}); err != nil {
@@ -195,6 +226,32 @@ func Convert_config_ControllerConfiguration_To_v1alpha1_ControllerConfiguration(
 	return autoConvert_config_ControllerConfiguration_To_v1alpha1_ControllerConfiguration(in, out, s)
 }
 
+func autoConvert_v1alpha1_DiagnosticsConfiguration_To_config_DiagnosticsConfiguration(in *DiagnosticsConfiguration, out *config.DiagnosticsConfiguration, s conversion.Scope) error {
+	out.SocketPath = (*string)(unsafe.Pointer(in.SocketPath))
+	out.PersistCrashDumps = in.PersistCrashDumps
+	out.PersistFailureReports = in.PersistFailureReports
+	out.RecordFailureReportEvents = in.RecordFailureReportEvents
+	return nil
+}
+
+// Convert_v1alpha1_DiagnosticsConfiguration_To_config_DiagnosticsConfiguration is an autogenerated conversion function.
+func Convert_v1alpha1_DiagnosticsConfiguration_To_config_DiagnosticsConfiguration(in *DiagnosticsConfiguration, out *config.DiagnosticsConfiguration, s conversion.Scope) error {
+	return autoConvert_v1alpha1_DiagnosticsConfiguration_To_config_DiagnosticsConfiguration(in, out, s)
+}
+
+func autoConvert_config_DiagnosticsConfiguration_To_v1alpha1_DiagnosticsConfiguration(in *config.DiagnosticsConfiguration, out *DiagnosticsConfiguration, s conversion.Scope) error {
+	out.SocketPath = (*string)(unsafe.Pointer(in.SocketPath))
+	out.PersistCrashDumps = in.PersistCrashDumps
+	out.PersistFailureReports = in.PersistFailureReports
+	out.RecordFailureReportEvents = in.RecordFailureReportEvents
+	return nil
+}
+
+// Convert_config_DiagnosticsConfiguration_To_v1alpha1_DiagnosticsConfiguration is an autogenerated conversion function.
+func Convert_config_DiagnosticsConfiguration_To_v1alpha1_DiagnosticsConfiguration(in *config.DiagnosticsConfiguration, out *DiagnosticsConfiguration, s conversion.Scope) error {
+	return autoConvert_config_DiagnosticsConfiguration_To_v1alpha1_DiagnosticsConfiguration(in, out, s)
+}
+
 func autoConvert_v1alpha1_NodeAgentConfiguration_To_config_NodeAgentConfiguration(in *NodeAgentConfiguration, out *config.NodeAgentConfiguration, s conversion.Scope) error {
 	if err := configv1alpha1.Convert_v1alpha1_ClientConnectionConfiguration_To_config_ClientConnectionConfiguration(&in.ClientConnection, &out.ClientConnection, s); err != nil {
 		return err
@@ -221,6 +278,7 @@ func autoConvert_v1alpha1_NodeAgentConfiguration_To_config_NodeAgentConfiguratio
 	if err := Convert_v1alpha1_ControllerConfiguration_To_config_ControllerConfiguration(&in.Controllers, &out.Controllers, s); err != nil {
 		return err
 	}
+	out.Diagnostics = (*config.DiagnosticsConfiguration)(unsafe.Pointer(in.Diagnostics))
 	return nil
 }
 
@@ -255,6 +313,7 @@ func autoConvert_config_NodeAgentConfiguration_To_v1alpha1_NodeAgentConfiguratio
 	if err := Convert_config_ControllerConfiguration_To_v1alpha1_ControllerConfiguration(&in.Controllers, &out.Controllers, s); err != nil {
 		return err
 	}
+	out.Diagnostics = (*DiagnosticsConfiguration)(unsafe.Pointer(in.Diagnostics))
 	return nil
 }
 
@@ -268,6 +327,12 @@ func autoConvert_v1alpha1_OperatingSystemConfigControllerConfig_To_config_Operat
 	out.SyncJitterPeriod = (*v1.Duration)(unsafe.Pointer(in.SyncJitterPeriod))
 	out.SecretName = in.SecretName
 	out.KubernetesVersion = (*v3.Version)(unsafe.Pointer(in.KubernetesVersion))
+	out.DiskUsageQuota = (*resource.Quantity)(unsafe.Pointer(in.DiskUsageQuota))
+	out.DriftDetectionEnabled = (*bool)(unsafe.Pointer(in.DriftDetectionEnabled))
+	out.ConcurrentFileWrites = (*int)(unsafe.Pointer(in.ConcurrentFileWrites))
+	out.UnitHealthVerificationTimeout = (*v1.Duration)(unsafe.Pointer(in.UnitHealthVerificationTimeout))
+	out.RequeueBackoff = (*config.RequeueBackoff)(unsafe.Pointer(in.RequeueBackoff))
+	out.Reboot = (*config.Reboot)(unsafe.Pointer(in.Reboot))
 	return nil
 }
 
@@ -281,6 +346,12 @@ func autoConvert_config_OperatingSystemConfigControllerConfig_To_v1alpha1_Operat
 	out.SyncJitterPeriod = (*v1.Duration)(unsafe.Pointer(in.SyncJitterPeriod))
 	out.SecretName = in.SecretName
 	out.KubernetesVersion = (*v3.Version)(unsafe.Pointer(in.KubernetesVersion))
+	out.DiskUsageQuota = (*resource.Quantity)(unsafe.Pointer(in.DiskUsageQuota))
+	out.DriftDetectionEnabled = (*bool)(unsafe.Pointer(in.DriftDetectionEnabled))
+	out.ConcurrentFileWrites = (*int)(unsafe.Pointer(in.ConcurrentFileWrites))
+	out.UnitHealthVerificationTimeout = (*v1.Duration)(unsafe.Pointer(in.UnitHealthVerificationTimeout))
+	out.RequeueBackoff = (*RequeueBackoff)(unsafe.Pointer(in.RequeueBackoff))
+	out.Reboot = (*Reboot)(unsafe.Pointer(in.Reboot))
 	return nil
 }
 
@@ -289,6 +360,56 @@ func Convert_config_OperatingSystemConfigControllerConfig_To_v1alpha1_OperatingS
 	return autoConvert_config_OperatingSystemConfigControllerConfig_To_v1alpha1_OperatingSystemConfigControllerConfig(in, out, s)
 }
 
+func autoConvert_v1alpha1_Reboot_To_config_Reboot(in *Reboot, out *config.Reboot, s conversion.Scope) error {
+	out.Enabled = (*bool)(unsafe.Pointer(in.Enabled))
+	out.MaxConcurrentReboots = (*int32)(unsafe.Pointer(in.MaxConcurrentReboots))
+	out.DrainTimeout = (*v1.Duration)(unsafe.Pointer(in.DrainTimeout))
+	return nil
+}
+
+// Convert_v1alpha1_Reboot_To_config_Reboot is an autogenerated conversion function.
+func Convert_v1alpha1_Reboot_To_config_Reboot(in *Reboot, out *config.Reboot, s conversion.Scope) error {
+	return autoConvert_v1alpha1_Reboot_To_config_Reboot(in, out, s)
+}
+
+func autoConvert_config_Reboot_To_v1alpha1_Reboot(in *config.Reboot, out *Reboot, s conversion.Scope) error {
+	out.Enabled = (*bool)(unsafe.Pointer(in.Enabled))
+	out.MaxConcurrentReboots = (*int32)(unsafe.Pointer(in.MaxConcurrentReboots))
+	out.DrainTimeout = (*v1.Duration)(unsafe.Pointer(in.DrainTimeout))
+	return nil
+}
+
+// Convert_config_Reboot_To_v1alpha1_Reboot is an autogenerated conversion function.
+func Convert_config_Reboot_To_v1alpha1_Reboot(in *config.Reboot, out *Reboot, s conversion.Scope) error {
+	return autoConvert_config_Reboot_To_v1alpha1_Reboot(in, out, s)
+}
+
+func autoConvert_v1alpha1_RequeueBackoff_To_config_RequeueBackoff(in *RequeueBackoff, out *config.RequeueBackoff, s conversion.Scope) error {
+	out.InitialInterval = (*v1.Duration)(unsafe.Pointer(in.InitialInterval))
+	out.MaxInterval = (*v1.Duration)(unsafe.Pointer(in.MaxInterval))
+	out.Multiplier = (*float64)(unsafe.Pointer(in.Multiplier))
+	out.JitterPeriod = (*v1.Duration)(unsafe.Pointer(in.JitterPeriod))
+	return nil
+}
+
+// Convert_v1alpha1_RequeueBackoff_To_config_RequeueBackoff is an autogenerated conversion function.
+func Convert_v1alpha1_RequeueBackoff_To_config_RequeueBackoff(in *RequeueBackoff, out *config.RequeueBackoff, s conversion.Scope) error {
+	return autoConvert_v1alpha1_RequeueBackoff_To_config_RequeueBackoff(in, out, s)
+}
+
+func autoConvert_config_RequeueBackoff_To_v1alpha1_RequeueBackoff(in *config.RequeueBackoff, out *RequeueBackoff, s conversion.Scope) error {
+	out.InitialInterval = (*v1.Duration)(unsafe.Pointer(in.InitialInterval))
+	out.MaxInterval = (*v1.Duration)(unsafe.Pointer(in.MaxInterval))
+	out.Multiplier = (*float64)(unsafe.Pointer(in.Multiplier))
+	out.JitterPeriod = (*v1.Duration)(unsafe.Pointer(in.JitterPeriod))
+	return nil
+}
+
+// Convert_config_RequeueBackoff_To_v1alpha1_RequeueBackoff is an autogenerated conversion function.
+func Convert_config_RequeueBackoff_To_v1alpha1_RequeueBackoff(in *config.RequeueBackoff, out *RequeueBackoff, s conversion.Scope) error {
+	return autoConvert_config_RequeueBackoff_To_v1alpha1_RequeueBackoff(in, out, s)
+}
+
 func autoConvert_v1alpha1_Server_To_config_Server(in *Server, out *config.Server, s conversion.Scope) error {
 	out.BindAddress = in.BindAddress
 	out.Port = in.Port
@@ -314,6 +435,7 @@ func Convert_config_Server_To_v1alpha1_Server(in *config.Server, out *Server, s
 func autoConvert_v1alpha1_ServerConfiguration_To_config_ServerConfiguration(in *ServerConfiguration, out *config.ServerConfiguration, s conversion.Scope) error {
 	out.HealthProbes = (*config.Server)(unsafe.Pointer(in.HealthProbes))
 	out.Metrics = (*config.Server)(unsafe.Pointer(in.Metrics))
+	out.MetricsClientCAFile = (*string)(unsafe.Pointer(in.MetricsClientCAFile))
 	return nil
 }
 
@@ -325,6 +447,7 @@ func Convert_v1alpha1_ServerConfiguration_To_config_ServerConfiguration(in *Serv
 func autoConvert_config_ServerConfiguration_To_v1alpha1_ServerConfiguration(in *config.ServerConfiguration, out *ServerConfiguration, s conversion.Scope) error {
 	out.HealthProbes = (*Server)(unsafe.Pointer(in.HealthProbes))
 	out.Metrics = (*Server)(unsafe.Pointer(in.Metrics))
+	out.MetricsClientCAFile = (*string)(unsafe.Pointer(in.MetricsClientCAFile))
 	return nil
 }
 
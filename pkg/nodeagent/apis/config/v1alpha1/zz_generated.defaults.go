@@ -38,4 +38,10 @@ func SetObjectDefaults_NodeAgentConfiguration(in *NodeAgentConfiguration) {
 	SetDefaults_ClientConnectionConfiguration(&in.ClientConnection)
 	SetDefaults_ServerConfiguration(&in.Server)
 	SetDefaults_OperatingSystemConfigControllerConfig(&in.Controllers.OperatingSystemConfig)
+	if in.Controllers.OperatingSystemConfig.RequeueBackoff != nil {
+		SetDefaults_RequeueBackoff(in.Controllers.OperatingSystemConfig.RequeueBackoff)
+	}
+	if in.Controllers.OperatingSystemConfig.Reboot != nil {
+		SetDefaults_Reboot(in.Controllers.OperatingSystemConfig.Reboot)
+	}
 }
@@ -15,6 +15,7 @@
 package validation
 
 import (
+	"path/filepath"
 	"time"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -40,6 +41,8 @@ func ValidateNodeAgentConfiguration(conf *config.NodeAgentConfiguration) field.E
 
 	allErrs = append(allErrs, validateBootstrapConfiguration(conf.Bootstrap, field.NewPath("bootstrap"))...)
 	allErrs = append(allErrs, validateControllerConfiguration(conf.Controllers, field.NewPath("controllers"))...)
+	allErrs = append(allErrs, validateDiagnosticsConfiguration(conf.Diagnostics, field.NewPath("diagnostics"))...)
+	allErrs = append(allErrs, validateServerConfiguration(conf.Server, field.NewPath("server"))...)
 
 	return allErrs
 }
@@ -50,6 +53,30 @@ func validateBootstrapConfiguration(_ *config.BootstrapConfiguration, _ *field.P
 	return allErrs
 }
 
+func validateDiagnosticsConfiguration(conf *config.DiagnosticsConfiguration, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if conf == nil {
+		return allErrs
+	}
+
+	if conf.SocketPath != nil && !filepath.IsAbs(*conf.SocketPath) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("socketPath"), *conf.SocketPath, "must be an absolute path"))
+	}
+
+	return allErrs
+}
+
+func validateServerConfiguration(conf config.ServerConfiguration, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if conf.MetricsClientCAFile != nil && !filepath.IsAbs(*conf.MetricsClientCAFile) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("metricsClientCAFile"), *conf.MetricsClientCAFile, "must be an absolute path"))
+	}
+
+	return allErrs
+}
+
 func validateControllerConfiguration(conf config.ControllerConfiguration, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 
@@ -74,6 +101,62 @@ func validateOperatingSystemConfigControllerConfiguration(conf config.OperatingS
 		allErrs = append(allErrs, field.Invalid(fldPath.Child("kubernetesVersion"), conf.KubernetesVersion, err.Error()))
 	}
 
+	if conf.DiskUsageQuota != nil && conf.DiskUsageQuota.Sign() < 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("diskUsageQuota"), conf.DiskUsageQuota.String(), "must not be negative"))
+	}
+
+	if conf.ConcurrentFileWrites != nil && *conf.ConcurrentFileWrites < 1 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("concurrentFileWrites"), *conf.ConcurrentFileWrites, "must be at least 1"))
+	}
+
+	if conf.UnitHealthVerificationTimeout != nil && conf.UnitHealthVerificationTimeout.Duration <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("unitHealthVerificationTimeout"), conf.UnitHealthVerificationTimeout.Duration.String(), "must be positive"))
+	}
+
+	if conf.RequeueBackoff != nil {
+		allErrs = append(allErrs, validateRequeueBackoff(*conf.RequeueBackoff, fldPath.Child("requeueBackoff"))...)
+	}
+
+	if conf.Reboot != nil {
+		allErrs = append(allErrs, validateReboot(*conf.Reboot, fldPath.Child("reboot"))...)
+	}
+
+	return allErrs
+}
+
+func validateRequeueBackoff(conf config.RequeueBackoff, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if conf.InitialInterval != nil && conf.InitialInterval.Duration <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("initialInterval"), conf.InitialInterval.Duration.String(), "must be positive"))
+	}
+
+	if conf.MaxInterval != nil && conf.InitialInterval != nil && conf.MaxInterval.Duration < conf.InitialInterval.Duration {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("maxInterval"), conf.MaxInterval.Duration.String(), "must not be less than the initial interval"))
+	}
+
+	if conf.Multiplier != nil && *conf.Multiplier < 1 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("multiplier"), *conf.Multiplier, "must be at least 1"))
+	}
+
+	if conf.JitterPeriod != nil && conf.JitterPeriod.Duration < 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("jitterPeriod"), conf.JitterPeriod.Duration.String(), "must not be negative"))
+	}
+
+	return allErrs
+}
+
+func validateReboot(conf config.Reboot, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if conf.MaxConcurrentReboots != nil && *conf.MaxConcurrentReboots < 1 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("maxConcurrentReboots"), *conf.MaxConcurrentReboots, "must be at least 1"))
+	}
+
+	if conf.DrainTimeout != nil && conf.DrainTimeout.Duration <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("drainTimeout"), conf.DrainTimeout.Duration.String(), "must be positive"))
+	}
+
 	return allErrs
 }
 
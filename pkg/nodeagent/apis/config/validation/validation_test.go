@@ -21,8 +21,10 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	. "github.com/onsi/gomega/gstruct"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/pointer"
 
 	. "github.com/gardener/gardener/pkg/nodeagent/apis/config"
 	. "github.com/gardener/gardener/pkg/nodeagent/apis/config/validation"
@@ -72,6 +74,82 @@ var _ = Describe("#ValidateNodeAgentConfiguration", func() {
 				})),
 			))
 		})
+
+		It("should fail because the disk usage quota is negative", func() {
+			config.Controllers.OperatingSystemConfig.DiskUsageQuota = resource.NewQuantity(-1, resource.BinarySI)
+
+			Expect(ValidateNodeAgentConfiguration(config)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("controllers.operatingSystemConfig.diskUsageQuota"),
+				})),
+			))
+		})
+
+		It("should fail because the number of concurrent file writes is not positive", func() {
+			config.Controllers.OperatingSystemConfig.ConcurrentFileWrites = pointer.Int(0)
+
+			Expect(ValidateNodeAgentConfiguration(config)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("controllers.operatingSystemConfig.concurrentFileWrites"),
+				})),
+			))
+		})
+
+		It("should fail because the unit health verification timeout is not positive", func() {
+			config.Controllers.OperatingSystemConfig.UnitHealthVerificationTimeout = &metav1.Duration{}
+
+			Expect(ValidateNodeAgentConfiguration(config)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("controllers.operatingSystemConfig.unitHealthVerificationTimeout"),
+				})),
+			))
+		})
+
+		It("should fail because the requeue backoff is invalid", func() {
+			config.Controllers.OperatingSystemConfig.RequeueBackoff = &RequeueBackoff{
+				InitialInterval: &metav1.Duration{Duration: time.Minute},
+				MaxInterval:     &metav1.Duration{Duration: time.Second},
+				Multiplier:      pointer.Float64(0.5),
+				JitterPeriod:    &metav1.Duration{Duration: -time.Second},
+			}
+
+			Expect(ValidateNodeAgentConfiguration(config)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("controllers.operatingSystemConfig.requeueBackoff.maxInterval"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("controllers.operatingSystemConfig.requeueBackoff.multiplier"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("controllers.operatingSystemConfig.requeueBackoff.jitterPeriod"),
+				})),
+			))
+		})
+
+		It("should fail because the reboot configuration is invalid", func() {
+			config.Controllers.OperatingSystemConfig.Reboot = &Reboot{
+				Enabled:              pointer.Bool(true),
+				MaxConcurrentReboots: pointer.Int32(0),
+				DrainTimeout:         &metav1.Duration{},
+			}
+
+			Expect(ValidateNodeAgentConfiguration(config)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("controllers.operatingSystemConfig.reboot.maxConcurrentReboots"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("controllers.operatingSystemConfig.reboot.drainTimeout"),
+				})),
+			))
+		})
 	})
 
 	Context("Token Controller", func() {
@@ -86,4 +164,42 @@ var _ = Describe("#ValidateNodeAgentConfiguration", func() {
 			))
 		})
 	})
+
+	Context("Diagnostics", func() {
+		It("should pass for a unix socket", func() {
+			config.Diagnostics = &DiagnosticsConfiguration{SocketPath: pointer.String("/var/lib/gardener-node-agent/diagnostics.sock"), PersistCrashDumps: true}
+
+			Expect(ValidateNodeAgentConfiguration(config)).To(BeEmpty())
+		})
+
+		It("should fail because the socket path is relative", func() {
+			config.Diagnostics = &DiagnosticsConfiguration{SocketPath: pointer.String("diagnostics.sock")}
+
+			Expect(ValidateNodeAgentConfiguration(config)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("diagnostics.socketPath"),
+				})),
+			))
+		})
+	})
+
+	Context("Server", func() {
+		It("should pass for an absolute path of the metrics client CA file", func() {
+			config.Server.MetricsClientCAFile = pointer.String("/var/lib/kubelet/ca.crt")
+
+			Expect(ValidateNodeAgentConfiguration(config)).To(BeEmpty())
+		})
+
+		It("should fail because the path of the metrics client CA file is relative", func() {
+			config.Server.MetricsClientCAFile = pointer.String("ca.crt")
+
+			Expect(ValidateNodeAgentConfiguration(config)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("server.metricsClientCAFile"),
+				})),
+			))
+		})
+	})
 })
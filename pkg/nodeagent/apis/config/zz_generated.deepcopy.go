@@ -88,6 +88,27 @@ func (in *ControllerConfiguration) DeepCopy() *ControllerConfiguration {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DiagnosticsConfiguration) DeepCopyInto(out *DiagnosticsConfiguration) {
+	*out = *in
+	if in.SocketPath != nil {
+		in, out := &in.SocketPath, &out.SocketPath
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DiagnosticsConfiguration.
+func (in *DiagnosticsConfiguration) DeepCopy() *DiagnosticsConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(DiagnosticsConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NodeAgentConfiguration) DeepCopyInto(out *NodeAgentConfiguration) {
 	*out = *in
@@ -113,6 +134,11 @@ func (in *NodeAgentConfiguration) DeepCopyInto(out *NodeAgentConfiguration) {
 		(*in).DeepCopyInto(*out)
 	}
 	in.Controllers.DeepCopyInto(&out.Controllers)
+	if in.Diagnostics != nil {
+		in, out := &in.Diagnostics, &out.Diagnostics
+		*out = new(DiagnosticsConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -152,6 +178,36 @@ func (in *OperatingSystemConfigControllerConfig) DeepCopyInto(out *OperatingSyst
 		*out = new(v3.Version)
 		**out = **in
 	}
+	if in.DiskUsageQuota != nil {
+		in, out := &in.DiskUsageQuota, &out.DiskUsageQuota
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.DriftDetectionEnabled != nil {
+		in, out := &in.DriftDetectionEnabled, &out.DriftDetectionEnabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ConcurrentFileWrites != nil {
+		in, out := &in.ConcurrentFileWrites, &out.ConcurrentFileWrites
+		*out = new(int)
+		**out = **in
+	}
+	if in.UnitHealthVerificationTimeout != nil {
+		in, out := &in.UnitHealthVerificationTimeout, &out.UnitHealthVerificationTimeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.RequeueBackoff != nil {
+		in, out := &in.RequeueBackoff, &out.RequeueBackoff
+		*out = new(RequeueBackoff)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Reboot != nil {
+		in, out := &in.Reboot, &out.Reboot
+		*out = new(Reboot)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -165,6 +221,73 @@ func (in *OperatingSystemConfigControllerConfig) DeepCopy() *OperatingSystemConf
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Reboot) DeepCopyInto(out *Reboot) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.MaxConcurrentReboots != nil {
+		in, out := &in.MaxConcurrentReboots, &out.MaxConcurrentReboots
+		*out = new(int32)
+		**out = **in
+	}
+	if in.DrainTimeout != nil {
+		in, out := &in.DrainTimeout, &out.DrainTimeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Reboot.
+func (in *Reboot) DeepCopy() *Reboot {
+	if in == nil {
+		return nil
+	}
+	out := new(Reboot)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RequeueBackoff) DeepCopyInto(out *RequeueBackoff) {
+	*out = *in
+	if in.InitialInterval != nil {
+		in, out := &in.InitialInterval, &out.InitialInterval
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.MaxInterval != nil {
+		in, out := &in.MaxInterval, &out.MaxInterval
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.Multiplier != nil {
+		in, out := &in.Multiplier, &out.Multiplier
+		*out = new(float64)
+		**out = **in
+	}
+	if in.JitterPeriod != nil {
+		in, out := &in.JitterPeriod, &out.JitterPeriod
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RequeueBackoff.
+func (in *RequeueBackoff) DeepCopy() *RequeueBackoff {
+	if in == nil {
+		return nil
+	}
+	out := new(RequeueBackoff)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Server) DeepCopyInto(out *Server) {
 	*out = *in
@@ -194,6 +317,11 @@ func (in *ServerConfiguration) DeepCopyInto(out *ServerConfiguration) {
 		*out = new(Server)
 		**out = **in
 	}
+	if in.MetricsClientCAFile != nil {
+		in, out := &in.MetricsClientCAFile, &out.MetricsClientCAFile
+		*out = new(string)
+		**out = **in
+	}
 	return
 }
 
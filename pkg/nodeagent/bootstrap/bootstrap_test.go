@@ -50,6 +50,7 @@ LimitMEMLOCK=infinity
 ExecStart=/opt/bin/gardener-node-agent --config=/var/lib/gardener-node-agent/config.yaml
 Restart=always
 RestartSec=5
+WatchdogSec=2min
 
 [Install]
 WantedBy=multi-user.target`
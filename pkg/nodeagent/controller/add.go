@@ -24,19 +24,31 @@ import (
 	"github.com/gardener/gardener/pkg/nodeagent/controller/node"
 	"github.com/gardener/gardener/pkg/nodeagent/controller/operatingsystemconfig"
 	"github.com/gardener/gardener/pkg/nodeagent/controller/token"
+	"github.com/gardener/gardener/pkg/nodeagent/diagnostics"
+	"github.com/gardener/gardener/pkg/nodeagent/watchdog"
 )
 
-// AddToManager adds all controllers to the given manager.
-func AddToManager(cancel context.CancelFunc, mgr manager.Manager, cfg *config.NodeAgentConfiguration, hostName string) error {
+// AddToManager adds all controllers to the given manager. The given reconcile state is optional and records the active
+// reconciliations for the diagnostics server. The given watchdog is optional and is notified about the progress of the
+// reconciliations of the operating system config.
+func AddToManager(cancel context.CancelFunc, mgr manager.Manager, cfg *config.NodeAgentConfiguration, hostName string, reconcileState *diagnostics.ReconcileState, watchdog *watchdog.Watchdog) error {
 	if err := (&node.Reconciler{}).AddToManager(mgr); err != nil {
 		return fmt.Errorf("failed adding node controller: %w", err)
 	}
 
-	if err := (&operatingsystemconfig.Reconciler{
-		Config:        cfg.Controllers.OperatingSystemConfig,
-		HostName:      hostName,
-		CancelContext: cancel,
-	}).AddToManager(mgr); err != nil {
+	osc := &operatingsystemconfig.Reconciler{
+		Config:         cfg.Controllers.OperatingSystemConfig,
+		HostName:       hostName,
+		CancelContext:  cancel,
+		ReconcileState: reconcileState,
+		Watchdog:       watchdog,
+	}
+	if cfg.Diagnostics != nil {
+		osc.PersistFailureReports = cfg.Diagnostics.PersistFailureReports
+		osc.RecordFailureReportEvents = cfg.Diagnostics.RecordFailureReportEvents
+	}
+
+	if err := osc.AddToManager(mgr); err != nil {
 		return fmt.Errorf("failed adding operating system config controller: %w", err)
 	}
 
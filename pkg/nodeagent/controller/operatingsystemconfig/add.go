@@ -24,6 +24,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/util/workqueue"
+	"k8s.io/utils/clock"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
@@ -48,6 +49,9 @@ func (r *Reconciler) AddToManager(mgr manager.Manager) error {
 	if r.Client == nil {
 		r.Client = mgr.GetClient()
 	}
+	if r.APIReader == nil {
+		r.APIReader = mgr.GetAPIReader()
+	}
 	if r.Recorder == nil {
 		r.Recorder = mgr.GetEventRecorderFor(ControllerName)
 	}
@@ -58,7 +62,13 @@ func (r *Reconciler) AddToManager(mgr manager.Manager) error {
 		r.FS = afero.Afero{Fs: afero.NewOsFs()}
 	}
 	if r.Extractor == nil {
-		r.Extractor = registry.NewExtractor()
+		r.Extractor = registry.NewCachingExtractor(r.FS, extractionCacheDirectory, registry.NewExtractor())
+	}
+	if r.Clock == nil {
+		r.Clock = clock.RealClock{}
+	}
+	if r.ExecProbe == nil {
+		r.ExecProbe = execProbeCommand
 	}
 
 	return builder.
@@ -112,14 +122,15 @@ func reconcileRequest(obj client.Object) reconcile.Request {
 var RandomDurationWithMetaDuration = utils.RandomDurationWithMetaDuration
 
 // EnqueueWithJitterDelay returns handler.Funcs which enqueues the object with a random jitter duration for 'update'
-// events. 'Create' events are enqueued immediately.
+// events. 'Create' events, e.g. when gardener-node-agent is restarted on all nodes, are enqueued after a random duration
+// up to the jitter period of the requeue backoff.
 func (r *Reconciler) EnqueueWithJitterDelay(log logr.Logger) handler.EventHandler {
 	return &handler.Funcs{
 		CreateFunc: func(_ context.Context, evt event.CreateEvent, q workqueue.RateLimitingInterface) {
 			if evt.Object == nil {
 				return
 			}
-			q.Add(reconcileRequest(evt.Object))
+			q.AddAfter(reconcileRequest(evt.Object), r.requeueJitter())
 		},
 
 		UpdateFunc: func(_ context.Context, evt event.UpdateEvent, q workqueue.RateLimitingInterface) {
@@ -111,16 +111,34 @@ var _ = Describe("Add", func() {
 			obj = &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "osc-secret", Namespace: "namespace"}}
 			req = reconcile.Request{NamespacedName: types.NamespacedName{Name: obj.Name, Namespace: obj.Namespace}}
 
-			DeferCleanup(func() {
-				test.WithVar(&RandomDurationWithMetaDuration, func(_ *metav1.Duration) time.Duration { return randomDuration })
-			})
+			DeferCleanup(test.WithVar(&RandomDurationWithMetaDuration, func(_ *metav1.Duration) time.Duration { return randomDuration }))
 		})
 
 		Context("Create events", func() {
-			It("should enqueue the object without delay", func() {
-				queue.EXPECT().Add(req)
+			It("should enqueue the object after the requeue jitter", func() {
+				var jitterPeriod *metav1.Duration
+				DeferCleanup(test.WithVar(&RandomDurationWithMetaDuration, func(max *metav1.Duration) time.Duration {
+					jitterPeriod = max
+					return randomDuration
+				}))
+				queue.EXPECT().AddAfter(req, randomDuration)
+
+				hdlr.Create(ctx, event.CreateEvent{Object: obj}, queue)
+				Expect(jitterPeriod).To(Equal(&metav1.Duration{Duration: 5 * time.Second}))
+			})
+
+			It("should enqueue the object after the configured requeue jitter", func() {
+				var jitterPeriod *metav1.Duration
+				DeferCleanup(test.WithVar(&RandomDurationWithMetaDuration, func(max *metav1.Duration) time.Duration {
+					jitterPeriod = max
+					return randomDuration
+				}))
+				cfg.RequeueBackoff = &config.RequeueBackoff{JitterPeriod: &metav1.Duration{Duration: time.Second}}
+				hdlr = (&Reconciler{Config: cfg}).EnqueueWithJitterDelay(log)
+				queue.EXPECT().AddAfter(req, randomDuration)
 
 				hdlr.Create(ctx, event.CreateEvent{Object: obj}, queue)
+				Expect(jitterPeriod).To(Equal(&metav1.Duration{Duration: time.Second}))
 			})
 		})
 
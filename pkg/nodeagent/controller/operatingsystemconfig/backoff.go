@@ -0,0 +1,117 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package operatingsystemconfig
+
+import (
+	"math"
+	"sync"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/gardener/gardener/pkg/nodeagent/apis/config"
+)
+
+const (
+	defaultRequeueInitialInterval = 5 * time.Second
+	defaultRequeueMaxInterval     = 5 * time.Minute
+	defaultRequeueMultiplier      = 2
+	defaultRequeueJitterPeriod    = 5 * time.Second
+)
+
+// RequeueBackoffDuration returns the duration after which the operating system config is requeued after the given
+// number of consecutive requeues. The jitter is not included. Unset fields of the backoff fall back to their defaults.
+func RequeueBackoffDuration(backoff *config.RequeueBackoff, requeues int) time.Duration {
+	var (
+		initialInterval = defaultRequeueInitialInterval
+		maxInterval     = defaultRequeueMaxInterval
+		multiplier      = float64(defaultRequeueMultiplier)
+	)
+
+	if backoff != nil {
+		if backoff.InitialInterval != nil {
+			initialInterval = backoff.InitialInterval.Duration
+		}
+		if backoff.MaxInterval != nil {
+			maxInterval = backoff.MaxInterval.Duration
+		}
+		multiplier = pointer.Float64Deref(backoff.Multiplier, multiplier)
+	}
+
+	duration := float64(initialInterval) * math.Pow(multiplier, float64(requeues))
+	if duration > float64(maxInterval) {
+		return maxInterval
+	}
+	return time.Duration(duration)
+}
+
+// requeueBackoff counts the consecutive requeues of requests while the reconciliation waits for the node.
+type requeueBackoff struct {
+	lock     sync.Mutex
+	requeues map[reconcile.Request]int
+}
+
+// next returns the number of consecutive requeues of the given request and increments it.
+func (b *requeueBackoff) next(request reconcile.Request) int {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	if b.requeues == nil {
+		b.requeues = make(map[reconcile.Request]int)
+	}
+
+	requeues := b.requeues[request]
+	b.requeues[request]++
+	return requeues
+}
+
+// get returns the number of consecutive requeues of the given request.
+func (b *requeueBackoff) get(request reconcile.Request) int {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	return b.requeues[request]
+}
+
+// reset forgets the requeues of the given request.
+func (b *requeueBackoff) reset(request reconcile.Request) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	delete(b.requeues, request)
+}
+
+// requeueWithBackoff returns a result which requeues the given request after the backoff duration for its consecutive
+// requeues plus a random jitter, so that the nodes of a cluster do not hit the kube-apiserver at the same time.
+func (r *Reconciler) requeueWithBackoff(request reconcile.Request) reconcile.Result {
+	return reconcile.Result{RequeueAfter: RequeueBackoffDuration(r.Config.RequeueBackoff, r.requeueBackoff.next(request)) + r.requeueJitter()}
+}
+
+// requeueAfterSyncPeriod returns a result which requeues the request after the sync period plus a random jitter.
+func (r *Reconciler) requeueAfterSyncPeriod() reconcile.Result {
+	return reconcile.Result{RequeueAfter: r.Config.SyncPeriod.Duration + r.requeueJitter()}
+}
+
+// requeueJitter returns a random duration up to the jitter period of the requeue backoff. If no jitter period is
+// configured, it falls back to its default.
+func (r *Reconciler) requeueJitter() time.Duration {
+	jitterPeriod := &metav1.Duration{Duration: defaultRequeueJitterPeriod}
+	if r.Config.RequeueBackoff != nil && r.Config.RequeueBackoff.JitterPeriod != nil {
+		jitterPeriod = r.Config.RequeueBackoff.JitterPeriod
+	}
+	return RandomDurationWithMetaDuration(jitterPeriod)
+}
@@ -0,0 +1,58 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package operatingsystemconfig_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
+
+	"github.com/gardener/gardener/pkg/nodeagent/apis/config"
+	. "github.com/gardener/gardener/pkg/nodeagent/controller/operatingsystemconfig"
+)
+
+var _ = Describe("Backoff", func() {
+	Describe("#RequeueBackoffDuration", func() {
+		It("should use the defaults if no backoff is configured", func() {
+			Expect(RequeueBackoffDuration(nil, 0)).To(Equal(5 * time.Second))
+			Expect(RequeueBackoffDuration(nil, 1)).To(Equal(10 * time.Second))
+			Expect(RequeueBackoffDuration(nil, 10)).To(Equal(5 * time.Minute))
+		})
+
+		It("should grow the duration by the multiplier up to the maximum", func() {
+			backoff := &config.RequeueBackoff{
+				InitialInterval: &metav1.Duration{Duration: 2 * time.Second},
+				MaxInterval:     &metav1.Duration{Duration: 30 * time.Second},
+				Multiplier:      pointer.Float64(3),
+			}
+
+			Expect(RequeueBackoffDuration(backoff, 0)).To(Equal(2 * time.Second))
+			Expect(RequeueBackoffDuration(backoff, 1)).To(Equal(6 * time.Second))
+			Expect(RequeueBackoffDuration(backoff, 2)).To(Equal(18 * time.Second))
+			Expect(RequeueBackoffDuration(backoff, 3)).To(Equal(30 * time.Second))
+			Expect(RequeueBackoffDuration(backoff, 100)).To(Equal(30 * time.Second))
+		})
+
+		It("should keep the duration constant for a multiplier of 1", func() {
+			backoff := &config.RequeueBackoff{Multiplier: pointer.Float64(1)}
+
+			Expect(RequeueBackoffDuration(backoff, 0)).To(Equal(5 * time.Second))
+			Expect(RequeueBackoffDuration(backoff, 5)).To(Equal(5 * time.Second))
+		})
+	})
+})
@@ -26,6 +26,7 @@ import (
 	"k8s.io/apimachinery/pkg/runtime/serializer"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/utils/pointer"
 
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	"github.com/gardener/gardener/pkg/utils"
@@ -58,6 +59,23 @@ type operatingSystemConfigChanges struct {
 	files files
 }
 
+// rebootRequired returns true if any of the changed units or files requires a reboot of the node.
+func (o *operatingSystemConfigChanges) rebootRequired() bool {
+	for _, unit := range o.units.changed {
+		if pointer.BoolDeref(unit.RebootRequired, false) {
+			return true
+		}
+	}
+
+	for _, file := range o.files.changed {
+		if pointer.BoolDeref(file.RebootRequired, false) {
+			return true
+		}
+	}
+
+	return false
+}
+
 type units struct {
 	changed []changedUnit
 	deleted []extensionsv1alpha1.Unit
@@ -236,6 +254,9 @@ func mergeUnits(specUnits, statusUnits []extensionsv1alpha1.Unit) []extensionsv1
 		if unit.Content != nil {
 			out[unitIndex].Content = unit.Content
 		}
+		if unit.RebootRequired != nil {
+			out[unitIndex].RebootRequired = unit.RebootRequired
+		}
 		out[unitIndex].DropIns = append(out[unitIndex].DropIns, unit.DropIns...)
 	}
 
@@ -0,0 +1,182 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package operatingsystemconfig
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/go-logr/logr"
+	"github.com/spf13/afero"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	extensionsv1alpha1helper "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1/helper"
+	nodeagentv1alpha1 "github.com/gardener/gardener/pkg/nodeagent/apis/config/v1alpha1"
+)
+
+// chunkCacheDirectory is the directory containing the content-addressed chunks of chunked inline files. Each chunk is
+// stored in a file named after its digest.
+const chunkCacheDirectory = nodeagentv1alpha1.BaseDir + "/cache/chunks"
+
+// assembleChunkedFile returns the data of a chunked inline file. Chunks which are present in the chunk cache are read
+// from there, all others are fetched from their secrets in the given namespace and added to the cache. The integrity of
+// every chunk is verified before it is used.
+func (r *Reconciler) assembleChunkedFile(ctx context.Context, log logr.Logger, namespace string, chunks []extensionsv1alpha1.FileContentChunk) ([]byte, error) {
+	var (
+		data          bytes.Buffer
+		fetchedChunks int
+	)
+
+	for _, chunk := range chunks {
+		chunkData, err := r.readCachedChunk(chunk)
+		if err != nil {
+			return nil, err
+		}
+
+		if chunkData == nil {
+			chunkData, err = r.fetchChunk(ctx, namespace, chunk)
+			if err != nil {
+				return nil, err
+			}
+			fetchedChunks++
+		}
+
+		data.Write(chunkData)
+	}
+
+	log.V(1).Info("Assembled chunked file", "chunks", len(chunks), "fetchedChunks", fetchedChunks)
+	return data.Bytes(), nil
+}
+
+// readCachedChunk returns the data of the given chunk from the chunk cache. It returns nil if the chunk is not cached
+// or if the cached data is corrupted.
+func (r *Reconciler) readCachedChunk(chunk extensionsv1alpha1.FileContentChunk) ([]byte, error) {
+	data, err := r.FS.ReadFile(filepath.Join(chunkCacheDirectory, chunk.Digest))
+	if err != nil {
+		if errors.Is(err, afero.ErrFileNotFound) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("unable to read cached chunk %s: %w", chunk.Digest, err)
+	}
+
+	if extensionsv1alpha1helper.VerifyFileContentChunk(chunk, data) != nil {
+		return nil, nil
+	}
+	return data, nil
+}
+
+// fetchChunk reads the data of the given chunk from its secret, verifies it and adds it to the chunk cache.
+func (r *Reconciler) fetchChunk(ctx context.Context, namespace string, chunk extensionsv1alpha1.FileContentChunk) ([]byte, error) {
+	secret := &corev1.Secret{}
+	if err := r.APIReader.Get(ctx, client.ObjectKey{Namespace: namespace, Name: extensionsv1alpha1helper.FileContentChunkSecretName(chunk.Digest)}, secret); err != nil {
+		return nil, fmt.Errorf("unable to fetch secret of chunk %s: %w", chunk.Digest, err)
+	}
+
+	data := secret.Data[extensionsv1alpha1helper.DataKeyFileContentChunk]
+	if err := extensionsv1alpha1helper.VerifyFileContentChunk(chunk, data); err != nil {
+		return nil, fmt.Errorf("failed verifying integrity of chunk: %w", err)
+	}
+
+	if err := r.FS.MkdirAll(chunkCacheDirectory, os.ModeDir|0700); err != nil {
+		return nil, fmt.Errorf("unable to create chunk cache directory %q: %w", chunkCacheDirectory, err)
+	}
+
+	// The chunk is written via a temporary file so that a crash does not leave a partial chunk behind. Corrupted chunks
+	// would be detected anyways, but they would have to be fetched again. The name of the temporary file is unique since
+	// files sharing a chunk might be written concurrently.
+	tmpFile, err := r.FS.TempFile(chunkCacheDirectory, chunk.Digest+"-*.tmp")
+	if err != nil {
+		return nil, fmt.Errorf("unable to create temporary file of chunk %s: %w", chunk.Digest, err)
+	}
+	_, err = tmpFile.Write(data)
+	if closeErr := tmpFile.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return nil, fmt.Errorf("unable to write chunk %s to cache: %w", chunk.Digest, err)
+	}
+	if err := r.FS.Rename(tmpFile.Name(), filepath.Join(chunkCacheDirectory, chunk.Digest)); err != nil {
+		return nil, fmt.Errorf("unable to rename temporary file of chunk %s: %w", chunk.Digest, err)
+	}
+
+	return data, nil
+}
+
+// fileMatchesChunks returns true if the file at the given path exists and its content matches the given chunks. In
+// this case, the file does not need to be written again.
+func (r *Reconciler) fileMatchesChunks(path string, chunks []extensionsv1alpha1.FileContentChunk) (bool, error) {
+	data, err := r.FS.ReadFile(path)
+	if err != nil {
+		if errors.Is(err, afero.ErrFileNotFound) {
+			return false, nil
+		}
+		return false, fmt.Errorf("unable to read file %q: %w", path, err)
+	}
+
+	var offset int64
+	for _, chunk := range chunks {
+		if offset+chunk.Size > int64(len(data)) {
+			return false, nil
+		}
+		if extensionsv1alpha1helper.VerifyFileContentChunk(chunk, data[offset:offset+chunk.Size]) != nil {
+			return false, nil
+		}
+		offset += chunk.Size
+	}
+
+	return offset == int64(len(data)), nil
+}
+
+// removeUnreferencedChunks removes all chunks from the chunk cache which are not referenced by the files of the given
+// operating system config.
+func (r *Reconciler) removeUnreferencedChunks(log logr.Logger, osc *extensionsv1alpha1.OperatingSystemConfig) error {
+	referencedChunks := sets.New[string]()
+	for _, file := range collectAllFiles(osc) {
+		if file.Content.Inline == nil {
+			continue
+		}
+		for _, chunk := range file.Content.Inline.Chunks {
+			referencedChunks.Insert(chunk.Digest)
+		}
+	}
+
+	entries, err := r.FS.ReadDir(chunkCacheDirectory)
+	if err != nil {
+		if errors.Is(err, afero.ErrFileNotFound) {
+			return nil
+		}
+		return fmt.Errorf("unable to read chunk cache directory %q: %w", chunkCacheDirectory, err)
+	}
+
+	for _, entry := range entries {
+		if referencedChunks.Has(entry.Name()) {
+			continue
+		}
+
+		if err := r.FS.Remove(filepath.Join(chunkCacheDirectory, entry.Name())); err != nil && !errors.Is(err, afero.ErrFileNotFound) {
+			return fmt.Errorf("unable to remove no longer needed chunk %q: %w", entry.Name(), err)
+		}
+		log.V(1).Info("Removed no longer needed chunk from cache", "digest", entry.Name())
+	}
+
+	return nil
+}
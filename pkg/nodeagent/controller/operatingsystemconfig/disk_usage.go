@@ -0,0 +1,115 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package operatingsystemconfig
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/go-logr/logr"
+	"github.com/spf13/afero"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	nodeagentv1alpha1 "github.com/gardener/gardener/pkg/nodeagent/apis/config/v1alpha1"
+	"github.com/gardener/gardener/pkg/nodeagent/diskusage"
+	"github.com/gardener/gardener/pkg/nodeagent/metrics"
+)
+
+// extractionCacheDirectory is the directory containing the files copied from container images.
+const extractionCacheDirectory = nodeagentv1alpha1.BaseDir + "/cache/extraction"
+
+// temporaryDirectoryPatterns are the patterns of the temporary directories created by gardener-node-agent when
+// applying files. Usually, they are removed right away, but they might be left behind, e.g., when the process crashes.
+var temporaryDirectoryPatterns = []string{
+	"gardener-node-agent-*",
+	"copy-image-*",
+}
+
+// manageDiskUsage measures the disk space consumed by the artifacts managed by gardener-node-agent and exposes it via
+// metrics. If a quota is configured and exceeded by the caches and temporary directories, the least recently used
+// entries of the extraction cache are removed. The managed files cannot be evicted, hence they do not count against
+// the quota.
+func (r *Reconciler) manageDiskUsage(log logr.Logger, node *metav1.PartialObjectMetadata, osc *extensionsv1alpha1.OperatingSystemConfig) error {
+	var filePaths []string
+	for _, files := range [][]extensionsv1alpha1.File{osc.Spec.Files, osc.Status.ExtensionFiles} {
+		for _, file := range files {
+			filePaths = append(filePaths, file.Path)
+		}
+	}
+
+	managedFilesSize, err := diskusage.FilesSize(r.FS, filePaths)
+	if err != nil {
+		return fmt.Errorf("failed measuring size of managed files: %w", err)
+	}
+
+	var temporaryDirectoriesSize int64
+	for _, pattern := range temporaryDirectoryPatterns {
+		directories, err := afero.Glob(r.FS, filepath.Join(os.TempDir(), pattern))
+		if err != nil {
+			return fmt.Errorf("failed listing temporary directories matching %q: %w", pattern, err)
+		}
+
+		for _, directory := range directories {
+			size, err := diskusage.DirectorySize(r.FS, directory)
+			if err != nil {
+				return fmt.Errorf("failed measuring size of temporary directory %q: %w", directory, err)
+			}
+			temporaryDirectoriesSize += size
+		}
+	}
+
+	extractionCacheSize, err := diskusage.DirectorySize(r.FS, extractionCacheDirectory)
+	if err != nil {
+		return fmt.Errorf("failed measuring size of extraction cache: %w", err)
+	}
+
+	chunkCacheSize, err := diskusage.DirectorySize(r.FS, chunkCacheDirectory)
+	if err != nil {
+		return fmt.Errorf("failed measuring size of chunk cache: %w", err)
+	}
+
+	if quota := r.Config.DiskUsageQuota; quota != nil {
+		metrics.DiskUsageQuota.Set(float64(quota.Value()))
+
+		if excess := temporaryDirectoriesSize + extractionCacheSize + chunkCacheSize - quota.Value(); excess > 0 {
+			removedEntries, freedBytes, err := diskusage.EvictLeastRecentlyUsed(r.FS, extractionCacheDirectory, excess)
+			metrics.ExtractionCacheEvictions.Add(float64(removedEntries))
+			extractionCacheSize -= freedBytes
+			if err != nil {
+				return fmt.Errorf("failed evicting entries from extraction cache: %w", err)
+			}
+
+			log.Info("Evicted least recently used entries from extraction cache to satisfy disk usage quota", "removedEntries", removedEntries, "freedBytes", freedBytes)
+
+			if freedBytes < excess {
+				message := fmt.Sprintf("Disk usage of caches and temporary directories of gardener-node-agent exceeds the quota of %s by %d bytes", quota.String(), excess-freedBytes)
+				log.Info(message)
+				if node != nil {
+					r.Recorder.Event(node, corev1.EventTypeWarning, "DiskUsageQuotaExceeded", message)
+				}
+			}
+		}
+	}
+
+	metrics.DiskUsage.WithLabelValues(metrics.ArtifactManagedFiles).Set(float64(managedFilesSize))
+	metrics.DiskUsage.WithLabelValues(metrics.ArtifactTemporaryDirectories).Set(float64(temporaryDirectoriesSize))
+	metrics.DiskUsage.WithLabelValues(metrics.ArtifactExtractionCache).Set(float64(extractionCacheSize))
+	metrics.DiskUsage.WithLabelValues(metrics.ArtifactChunkCache).Set(float64(chunkCacheSize))
+
+	return nil
+}
@@ -0,0 +1,148 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package operatingsystemconfig
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"path"
+	"slices"
+
+	"github.com/spf13/afero"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	extensionsv1alpha1helper "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1/helper"
+	"github.com/gardener/gardener/pkg/nodeagent/metrics"
+)
+
+// detectDrift compares the files and units of the given operating system config with their content, owner, or link
+// target on the disk. It returns the changes which must be applied to correct the drift and the paths of the drifted
+// files and the names of the drifted units. Units are also restarted if one of their files drifted.
+// Drifts are corrected without rebooting the node, i.e., the affected units are restarted instead, since draining the
+// node because of a manually edited file would be too disruptive.
+func (r *Reconciler) detectDrift(osc *extensionsv1alpha1.OperatingSystemConfig) (*operatingSystemConfigChanges, []string, error) {
+	var (
+		changes = &operatingSystemConfigChanges{}
+		drifted []string
+	)
+
+	for _, file := range collectAllFiles(osc) {
+		fileDrifted, err := r.fileDrifted(file)
+		if err != nil {
+			return nil, nil, err
+		}
+		if !fileDrifted {
+			continue
+		}
+
+		metrics.OperatingSystemConfigDrifts.WithLabelValues(metrics.KindFile).Inc()
+		drifted = append(drifted, file.Path)
+
+		file.RebootRequired = nil
+		changes.files.changed = append(changes.files.changed, file)
+	}
+
+	allUnits := mergeUnits(osc.Spec.Units, osc.Status.ExtensionUnits)
+	changes.units = computeUnitDiffs(allUnits, allUnits, changes.files)
+
+	for _, unit := range allUnits {
+		unitDrifted, err := r.unitDrifted(unit)
+		if err != nil {
+			return nil, nil, err
+		}
+		if !unitDrifted {
+			continue
+		}
+
+		metrics.OperatingSystemConfigDrifts.WithLabelValues(metrics.KindUnit).Inc()
+		drifted = append(drifted, unit.Name)
+
+		changed := changedUnit{Unit: unit, dropIns: dropIns{changed: unit.DropIns}}
+		if i := slices.IndexFunc(changes.units.changed, func(u changedUnit) bool { return u.Name == unit.Name }); i != -1 {
+			changes.units.changed[i] = changed
+		} else {
+			changes.units.changed = append(changes.units.changed, changed)
+		}
+	}
+
+	for i := range changes.units.changed {
+		changes.units.changed[i].RebootRequired = nil
+	}
+
+	return changes, drifted, nil
+}
+
+// fileDrifted returns true if the content or the owner of the given file on the disk, or the target of the given
+// symbolic link, does not match the operating system config.
+func (r *Reconciler) fileDrifted(file extensionsv1alpha1.File) (bool, error) {
+	if file.Content.Symlink != nil {
+		upToDate, err := r.symlinkUpToDate(file)
+		return !upToDate, err
+	}
+
+	if drifted, err := r.fileOwnerDrifted(file); err != nil || drifted {
+		return drifted, err
+	}
+
+	if file.Content.Inline == nil {
+		// Files from container images are not verified since this would require to pull the image again.
+		return false, nil
+	}
+
+	if chunks := file.Content.Inline.Chunks; len(chunks) > 0 {
+		upToDate, err := r.fileMatchesChunks(file.Path, chunks)
+		return !upToDate, err
+	}
+
+	data, err := extensionsv1alpha1helper.Decode(file.Content.Inline.Encoding, []byte(file.Content.Inline.Data))
+	if err != nil {
+		return false, fmt.Errorf("unable to decode data of file %q: %w", file.Path, err)
+	}
+
+	return r.contentDrifted(file.Path, data)
+}
+
+// unitDrifted returns true if the content of the unit file or one of its drop-in files on the disk does not match the
+// operating system config.
+func (r *Reconciler) unitDrifted(unit extensionsv1alpha1.Unit) (bool, error) {
+	unitFilePath := path.Join(etcSystemdSystem, unit.Name)
+
+	if unit.Content != nil {
+		if drifted, err := r.contentDrifted(unitFilePath, []byte(*unit.Content)); err != nil || drifted {
+			return drifted, err
+		}
+	}
+
+	for _, dropIn := range unit.DropIns {
+		if drifted, err := r.contentDrifted(path.Join(unitFilePath+".d", dropIn.Name), []byte(dropIn.Content)); err != nil || drifted {
+			return drifted, err
+		}
+	}
+
+	return false, nil
+}
+
+func (r *Reconciler) contentDrifted(filePath string, content []byte) (bool, error) {
+	data, err := r.FS.ReadFile(filePath)
+	if err != nil {
+		if errors.Is(err, afero.ErrFileNotFound) {
+			return true, nil
+		}
+		return false, fmt.Errorf("unable to read file %q: %w", filePath, err)
+	}
+
+	return !bytes.Equal(data, content), nil
+}
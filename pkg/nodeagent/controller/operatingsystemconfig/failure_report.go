@@ -0,0 +1,103 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package operatingsystemconfig
+
+import (
+	"context"
+	"fmt"
+	"os/exec"
+	"strconv"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/gardener/gardener/pkg/nodeagent/diagnostics"
+)
+
+const (
+	// journalLinesPerUnit is the number of the most recent journal lines of each affected unit which are added to a
+	// failure report.
+	journalLinesPerUnit = 100
+	// maxEventMessageLength is the maximum length of the message of the event recorded for a failure report.
+	maxEventMessageLength = 1024
+)
+
+// FetchUnitJournal returns the most recent journal lines of the given unit. Exposed for tests.
+var FetchUnitJournal = func(ctx context.Context, unitName string) ([]byte, error) {
+	return exec.CommandContext(ctx, "journalctl", "--unit", unitName, "--lines", strconv.Itoa(journalLinesPerUnit), "--no-pager", "--output", "short-iso").CombinedOutput()
+}
+
+// failureDetails collects the information about a reconciliation which is added to the failure report in case the
+// reconciliation fails.
+type failureDetails struct {
+	phase       string
+	node        *metav1.PartialObjectMetadata
+	oscChecksum string
+	units       []string
+}
+
+// reportFailure creates a failure report for the given reconciliation error. Depending on the configuration, it is
+// persisted to the diagnostics directory and/or recorded as event for the node. Errors are only logged since the
+// reconciliation error is returned anyway.
+func (r *Reconciler) reportFailure(ctx context.Context, log logr.Logger, request reconcile.Request, details *failureDetails, reconcileErr error) {
+	if !r.PersistFailureReports && !r.RecordFailureReportEvents {
+		return
+	}
+
+	report := &diagnostics.FailureReport{
+		Time:                          r.Clock.Now().UTC(),
+		Controller:                    ControllerName,
+		Object:                        request.String(),
+		Phase:                         details.phase,
+		Errors:                        diagnostics.ErrorChain(reconcileErr),
+		OperatingSystemConfigChecksum: details.oscChecksum,
+	}
+
+	for _, unitName := range details.units {
+		output, err := FetchUnitJournal(ctx, unitName)
+		if err != nil {
+			log.Error(err, "Failed fetching journal of unit for failure report", "unitName", unitName)
+			continue
+		}
+
+		if report.UnitJournals == nil {
+			report.UnitJournals = make(map[string]string, len(details.units))
+		}
+		report.UnitJournals[unitName] = string(output)
+	}
+
+	var path string
+	if r.PersistFailureReports {
+		var err error
+		if path, err = diagnostics.PersistFailureReport(r.FS, report); err != nil {
+			log.Error(err, "Failed persisting failure report")
+		} else {
+			log.Info("Persisted failure report", "path", path)
+		}
+	}
+
+	if r.RecordFailureReportEvents && details.node != nil {
+		message := fmt.Sprintf("Applying operating system config failed in phase %q: %v", report.Phase, reconcileErr)
+		if path != "" {
+			message = fmt.Sprintf("Failure report persisted to %s. %s", path, message)
+		}
+		if len(message) > maxEventMessageLength {
+			message = message[:maxEventMessageLength-3] + "..."
+		}
+		r.Recorder.Event(details.node, corev1.EventTypeWarning, "OSCApplyFailed", message)
+	}
+}
@@ -0,0 +1,195 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package operatingsystemconfig
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os/user"
+	"path/filepath"
+	"strconv"
+	"syscall"
+
+	"github.com/go-logr/logr"
+	"github.com/spf13/afero"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+)
+
+// LookupUserID returns the numeric ID of the user with the given name. Exposed for tests.
+var LookupUserID = func(name string) (string, error) {
+	u, err := user.Lookup(name)
+	if err != nil {
+		return "", err
+	}
+	return u.Uid, nil
+}
+
+// LookupGroupID returns the numeric ID of the group with the given name. Exposed for tests.
+var LookupGroupID = func(name string) (string, error) {
+	g, err := user.LookupGroup(name)
+	if err != nil {
+		return "", err
+	}
+	return g.Gid, nil
+}
+
+// FileOwnerIDs returns the numeric IDs of the user and group owning the file with the given info. It returns false if
+// the file system does not provide them. Exposed for tests.
+var FileOwnerIDs = func(info fs.FileInfo) (int, int, bool) {
+	stat, ok := info.Sys().(*syscall.Stat_t)
+	if !ok {
+		return 0, 0, false
+	}
+	return int(stat.Uid), int(stat.Gid), true
+}
+
+// fileOwnerIDs returns the numeric IDs of the user and group which should own the given file. Names are resolved on
+// the node since the IDs of system users and groups (e.g. systemd-network) differ between operating systems.
+func fileOwnerIDs(file extensionsv1alpha1.File) (int, int, error) {
+	var userName, groupName string
+	if file.Owner != nil {
+		userName, groupName = file.Owner.User, file.Owner.Group
+	}
+
+	uid, err := resolveID(userName, LookupUserID)
+	if err != nil {
+		return 0, 0, fmt.Errorf("unable to look up user %q owning file %q: %w", userName, file.Path, err)
+	}
+
+	gid, err := resolveID(groupName, LookupGroupID)
+	if err != nil {
+		return 0, 0, fmt.Errorf("unable to look up group %q owning file %q: %w", groupName, file.Path, err)
+	}
+
+	return uid, gid, nil
+}
+
+func resolveID(nameOrID string, lookup func(string) (string, error)) (int, error) {
+	if nameOrID == "" {
+		return 0, nil
+	}
+	if id, err := strconv.Atoi(nameOrID); err == nil {
+		return id, nil
+	}
+
+	id, err := lookup(nameOrID)
+	if err != nil {
+		return 0, err
+	}
+	return strconv.Atoi(id)
+}
+
+// applyFileOwner changes the owner of the given file. It is also applied for files without an owner, so that the
+// ownership is restored to root:root when the owner is removed from the operating system config.
+func (r *Reconciler) applyFileOwner(file extensionsv1alpha1.File) error {
+	uid, gid, err := fileOwnerIDs(file)
+	if err != nil {
+		return err
+	}
+
+	if err := r.FS.Chown(file.Path, uid, gid); err != nil {
+		return fmt.Errorf("unable to change owner of file %q: %w", file.Path, err)
+	}
+	return nil
+}
+
+// fileOwnerDrifted returns true if the given file on the disk is not owned by the user and group declared in the
+// operating system config. Missing files are not reported since their content is verified separately.
+func (r *Reconciler) fileOwnerDrifted(file extensionsv1alpha1.File) (bool, error) {
+	info, err := r.FS.Stat(file.Path)
+	if err != nil {
+		if errors.Is(err, afero.ErrFileNotFound) {
+			return false, nil
+		}
+		return false, fmt.Errorf("unable to stat file %q: %w", file.Path, err)
+	}
+
+	uid, gid, ok := FileOwnerIDs(info)
+	if !ok {
+		return false, nil
+	}
+
+	expectedUID, expectedGID, err := fileOwnerIDs(file)
+	if err != nil {
+		return false, err
+	}
+
+	return uid != expectedUID || gid != expectedGID, nil
+}
+
+// applySymlink creates the symbolic link described by the given file. An existing file at the path of the link is
+// replaced.
+func (r *Reconciler) applySymlink(log logr.Logger, file extensionsv1alpha1.File) error {
+	linker, ok := r.FS.Fs.(afero.Linker)
+	if !ok {
+		return fmt.Errorf("unable to create symbolic link %q: file system does not support symbolic links", file.Path)
+	}
+
+	upToDate, err := r.symlinkUpToDate(file)
+	if err != nil {
+		return err
+	}
+	if upToDate {
+		log.Info("Symbolic link is up to date, skipping", "path", file.Path)
+		return nil
+	}
+
+	if err := r.FS.MkdirAll(filepath.Dir(file.Path), fs.ModeDir); err != nil {
+		return fmt.Errorf("unable to create directory %q: %w", file.Path, err)
+	}
+
+	if err := r.FS.Remove(file.Path); err != nil && !errors.Is(err, afero.ErrFileNotFound) {
+		return fmt.Errorf("unable to remove existing file %q before creating symbolic link: %w", file.Path, err)
+	}
+
+	if err := linker.SymlinkIfPossible(file.Content.Symlink.Target, file.Path); err != nil {
+		return fmt.Errorf("unable to create symbolic link %q to %q: %w", file.Path, file.Content.Symlink.Target, err)
+	}
+
+	log.Info("Successfully applied new or changed symbolic link", "path", file.Path, "target", file.Content.Symlink.Target)
+	return nil
+}
+
+// symlinkUpToDate returns true if a symbolic link pointing to the target of the given file exists at its path.
+func (r *Reconciler) symlinkUpToDate(file extensionsv1alpha1.File) (bool, error) {
+	lstater, ok := r.FS.Fs.(afero.Lstater)
+	if !ok {
+		return false, nil
+	}
+	reader, ok := r.FS.Fs.(afero.LinkReader)
+	if !ok {
+		return false, nil
+	}
+
+	info, _, err := lstater.LstatIfPossible(file.Path)
+	if err != nil {
+		if errors.Is(err, afero.ErrFileNotFound) {
+			return false, nil
+		}
+		return false, fmt.Errorf("unable to stat file %q: %w", file.Path, err)
+	}
+	if info.Mode()&fs.ModeSymlink == 0 {
+		return false, nil
+	}
+
+	target, err := reader.ReadlinkIfPossible(file.Path)
+	if err != nil {
+		return false, fmt.Errorf("unable to read symbolic link %q: %w", file.Path, err)
+	}
+
+	return target == file.Content.Symlink.Target, nil
+}
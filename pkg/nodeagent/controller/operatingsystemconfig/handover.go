@@ -0,0 +1,196 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package operatingsystemconfig
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path"
+
+	"github.com/go-logr/logr"
+	"github.com/spf13/afero"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/yaml"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/component/extensions/operatingsystemconfig/downloader"
+	nodeagentv1alpha1 "github.com/gardener/gardener/pkg/nodeagent/apis/config/v1alpha1"
+	"github.com/gardener/gardener/pkg/nodeagent/journal"
+)
+
+// legacyHandoverMarkerFilePath is the path of the file which records that gardener-node-agent took over the node from
+// the legacy cloud-config-downloader.
+const legacyHandoverMarkerFilePath = nodeagentv1alpha1.BaseDir + "/legacy-handover.yaml"
+
+// legacyHandover is the content of the marker file written after the node was taken over from the legacy
+// cloud-config-downloader.
+type legacyHandover struct {
+	// CompletedAt is the time at which the handover was completed.
+	CompletedAt metav1.Time `json:"completedAt"`
+	// ImportedFiles are the paths of the files which were already written by the legacy cloud-config-downloader with
+	// the content of the operating system config, hence they were imported instead of being written again.
+	ImportedFiles []string `json:"importedFiles,omitempty"`
+	// ImportedUnits are the names of the units which were already written by the legacy cloud-config-downloader with
+	// the content of the operating system config, hence they were imported instead of being restarted.
+	ImportedUnits []string `json:"importedUnits,omitempty"`
+	// DisabledUnits are the names of the legacy units which were disabled and removed.
+	DisabledUnits []string `json:"disabledUnits,omitempty"`
+}
+
+// legacyHandoverRequired returns true if the node is still (or was until recently) managed by the legacy
+// cloud-config-downloader and gardener-node-agent did not take it over yet.
+func (r *Reconciler) legacyHandoverRequired() (bool, error) {
+	if exists, err := r.FS.Exists(legacyHandoverMarkerFilePath); err != nil || exists {
+		return false, err
+	}
+
+	for _, filePath := range []string{path.Join(etcSystemdSystem, downloader.UnitName), downloader.PathCCDScript} {
+		if exists, err := r.FS.Exists(filePath); err != nil || exists {
+			return exists, err
+		}
+	}
+
+	return false, nil
+}
+
+// handOverFromLegacyCloudConfigDownloader takes over the node from the legacy cloud-config-downloader without
+// replacing it. The downloader is stopped first so that it does not execute the legacy cloud-config concurrently.
+// Then, the files and units which the downloader already wrote with the content of the given operating system config
+// are imported as last applied configuration, hence they are neither written nor restarted again. Finally, the legacy
+// unit is disabled and removed, and the handover is recorded in a marker file so that it is not repeated. The files of
+// the downloader below /var/lib/cloud-config-downloader are kept since they do not interfere with gardener-node-agent.
+func (r *Reconciler) handOverFromLegacyCloudConfigDownloader(ctx context.Context, log logr.Logger, node *metav1.PartialObjectMetadata, osc *extensionsv1alpha1.OperatingSystemConfig) error {
+	handover := &legacyHandover{}
+
+	unitFilePath := path.Join(etcSystemdSystem, downloader.UnitName)
+	legacyUnitExists, err := r.FS.Exists(unitFilePath)
+	if err != nil {
+		return fmt.Errorf("unable to check whether unit file %q exists: %w", unitFilePath, err)
+	}
+
+	if legacyUnitExists {
+		if err := r.DBus.Stop(ctx, r.Recorder, node, downloader.UnitName); err != nil {
+			return fmt.Errorf("unable to stop legacy unit %q: %w", downloader.UnitName, err)
+		}
+		log.Info("Successfully stopped legacy unit", "unitName", downloader.UnitName)
+	}
+
+	// The inventory is only imported if gardener-node-agent did not apply an operating system config yet. Otherwise,
+	// the last applied configuration is more accurate than the content found on the disk.
+	lastAppliedExists, err := r.FS.Exists(lastAppliedOperatingSystemConfigFilePath)
+	if err != nil {
+		return fmt.Errorf("unable to check whether last applied OSC file %q exists: %w", lastAppliedOperatingSystemConfigFilePath, err)
+	}
+
+	if !lastAppliedExists {
+		if err := r.importLegacyInventory(log, osc, handover); err != nil {
+			return fmt.Errorf("failed importing files and units written by legacy cloud-config-downloader: %w", err)
+		}
+	}
+
+	if legacyUnitExists {
+		if err := r.DBus.Disable(ctx, downloader.UnitName); err != nil {
+			return fmt.Errorf("unable to disable legacy unit %q: %w", downloader.UnitName, err)
+		}
+
+		if err := r.FS.Remove(unitFilePath); err != nil && !errors.Is(err, afero.ErrFileNotFound) {
+			return fmt.Errorf("unable to delete unit file of legacy unit %q: %w", downloader.UnitName, err)
+		}
+
+		if err := r.FS.RemoveAll(unitFilePath + ".d"); err != nil && !errors.Is(err, afero.ErrFileNotFound) {
+			return fmt.Errorf("unable to delete systemd drop-in folder of legacy unit %q: %w", downloader.UnitName, err)
+		}
+
+		if err := r.DBus.DaemonReload(ctx); err != nil {
+			return fmt.Errorf("failed reloading systemd daemon: %w", err)
+		}
+
+		handover.DisabledUnits = append(handover.DisabledUnits, downloader.UnitName)
+		log.Info("Successfully disabled and removed legacy unit", "unitName", downloader.UnitName)
+	}
+
+	handover.CompletedAt = metav1.NewTime(r.Clock.Now().UTC())
+	data, err := yaml.Marshal(handover)
+	if err != nil {
+		return fmt.Errorf("unable to marshal legacy handover marker: %w", err)
+	}
+
+	if err := journal.WriteFile(r.FS, legacyHandoverMarkerFilePath, data, 0644); err != nil {
+		return fmt.Errorf("unable to write legacy handover marker to file path %q: %w", legacyHandoverMarkerFilePath, err)
+	}
+
+	log.Info("Successfully took over node from legacy cloud-config-downloader", "importedFiles", len(handover.ImportedFiles), "importedUnits", len(handover.ImportedUnits))
+	if node != nil {
+		r.Recorder.Eventf(node, corev1.EventTypeNormal, "LegacyHandoverCompleted", "Took over node from legacy cloud-config-downloader, imported %d files and %d units", len(handover.ImportedFiles), len(handover.ImportedUnits))
+	}
+
+	return nil
+}
+
+// importLegacyInventory persists the files and units of the given operating system config whose content on the disk
+// already matches as last applied configuration. Files from container images cannot be verified without pulling the
+// image, hence they are not imported. Units referencing a file which was not imported are restarted as usual.
+func (r *Reconciler) importLegacyInventory(log logr.Logger, osc *extensionsv1alpha1.OperatingSystemConfig, handover *legacyHandover) error {
+	inventory := &extensionsv1alpha1.OperatingSystemConfig{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: extensionsv1alpha1.SchemeGroupVersion.String(),
+			Kind:       extensionsv1alpha1.OperatingSystemConfigResource,
+		},
+	}
+
+	for _, file := range collectAllFiles(osc) {
+		if file.Content.Inline == nil {
+			continue
+		}
+
+		drifted, err := r.fileDrifted(file)
+		if err != nil {
+			return err
+		}
+		if drifted {
+			continue
+		}
+
+		inventory.Spec.Files = append(inventory.Spec.Files, file)
+		handover.ImportedFiles = append(handover.ImportedFiles, file.Path)
+	}
+
+	for _, unit := range mergeUnits(osc.Spec.Units, osc.Status.ExtensionUnits) {
+		drifted, err := r.unitDrifted(unit)
+		if err != nil {
+			return err
+		}
+		if drifted {
+			continue
+		}
+
+		inventory.Spec.Units = append(inventory.Spec.Units, unit)
+		handover.ImportedUnits = append(handover.ImportedUnits, unit.Name)
+	}
+
+	data, err := yaml.Marshal(inventory)
+	if err != nil {
+		return fmt.Errorf("unable to marshal imported OSC: %w", err)
+	}
+
+	log.Info("Persisting files and units written by legacy cloud-config-downloader as 'last-applied' file to the disk", "path", lastAppliedOperatingSystemConfigFilePath, "importedFiles", len(handover.ImportedFiles), "importedUnits", len(handover.ImportedUnits))
+	if err := journal.WriteFile(r.FS, lastAppliedOperatingSystemConfigFilePath, data, 0644); err != nil {
+		return fmt.Errorf("unable to write imported OSC to file path %q: %w", lastAppliedOperatingSystemConfigFilePath, err)
+	}
+
+	return nil
+}
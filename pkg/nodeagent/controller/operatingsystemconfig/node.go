@@ -0,0 +1,129 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package operatingsystemconfig
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+)
+
+const (
+	// AnnotationKeyManagedNodeLabels is the key of an annotation on a shoot Node object whose value is the
+	// comma-separated list of label keys which are managed by gardener-node-agent.
+	AnnotationKeyManagedNodeLabels = "worker.gardener.cloud/managed-node-labels"
+	// AnnotationKeyManagedNodeTaints is the key of an annotation on a shoot Node object whose value is the
+	// comma-separated list of taints (in the format '<key>:<effect>') which are managed by gardener-node-agent.
+	AnnotationKeyManagedNodeTaints = "worker.gardener.cloud/managed-node-taints"
+)
+
+// reconcileNodeLabelsAndTaints ensures that the labels and taints declared in the OperatingSystemConfig are present on
+// the Node. Labels and taints which were managed previously but are no longer declared are removed. The keys which are
+// owned by gardener-node-agent are remembered in annotations on the Node, hence labels and taints added by other
+// parties are never touched.
+func (r *Reconciler) reconcileNodeLabelsAndTaints(ctx context.Context, log logr.Logger, nodeName string, osc *extensionsv1alpha1.OperatingSystemConfig) error {
+	node := &corev1.Node{}
+	if err := r.Client.Get(ctx, client.ObjectKey{Name: nodeName}, node); err != nil {
+		return fmt.Errorf("unable to fetch node %q: %w", nodeName, err)
+	}
+
+	original := node.DeepCopy()
+
+	desiredLabelKeys := sets.New[string]()
+	for key, value := range osc.Spec.NodeLabels {
+		metav1.SetMetaDataLabel(&node.ObjectMeta, key, value)
+		desiredLabelKeys.Insert(key)
+	}
+	for key := range managedKeys(node, AnnotationKeyManagedNodeLabels).Difference(desiredLabelKeys) {
+		delete(node.Labels, key)
+	}
+	setManagedKeys(node, AnnotationKeyManagedNodeLabels, desiredLabelKeys)
+
+	var (
+		previousTaintKeys = managedKeys(node, AnnotationKeyManagedNodeTaints)
+		desiredTaintKeys  = sets.New[string]()
+		taints            []corev1.Taint
+	)
+
+	for _, taint := range osc.Spec.NodeTaints {
+		desiredTaintKeys.Insert(taintKey(taint))
+	}
+
+	for _, taint := range node.Spec.Taints {
+		if key := taintKey(taint); desiredTaintKeys.Has(key) || previousTaintKeys.Has(key) {
+			continue
+		}
+		taints = append(taints, taint)
+	}
+
+	for _, taint := range osc.Spec.NodeTaints {
+		if existing := findTaint(node.Spec.Taints, taint); existing != nil && existing.Value == taint.Value {
+			// Keep the existing taint to not reset its 'timeAdded' field.
+			taint = *existing
+		}
+		taints = append(taints, taint)
+	}
+
+	node.Spec.Taints = taints
+	setManagedKeys(node, AnnotationKeyManagedNodeTaints, desiredTaintKeys)
+
+	if apiequality.Semantic.DeepEqual(original, node) {
+		return nil
+	}
+
+	// Taints are a list which is replaced as a whole by merge patches, hence we need optimistic locking to not
+	// overwrite taints which were concurrently added by other parties (e.g., the node lifecycle controller).
+	log.Info("Updating managed labels and taints of node", "nodeName", node.Name)
+	return r.Client.Patch(ctx, node, client.MergeFromWithOptions(original, client.MergeFromWithOptimisticLock{}))
+}
+
+func taintKey(taint corev1.Taint) string {
+	return taint.Key + ":" + string(taint.Effect)
+}
+
+func findTaint(taints []corev1.Taint, taint corev1.Taint) *corev1.Taint {
+	for i := range taints {
+		if taints[i].MatchTaint(&taint) {
+			return &taints[i]
+		}
+	}
+	return nil
+}
+
+func managedKeys(node *corev1.Node, annotationKey string) sets.Set[string] {
+	keys := sets.New[string]()
+	if value := node.Annotations[annotationKey]; value != "" {
+		keys.Insert(strings.Split(value, ",")...)
+	}
+	return keys
+}
+
+func setManagedKeys(node *corev1.Node, annotationKey string, keys sets.Set[string]) {
+	if keys.Len() == 0 {
+		delete(node.Annotations, annotationKey)
+		return
+	}
+
+	metav1.SetMetaDataAnnotation(&node.ObjectMeta, annotationKey, strings.Join(sets.List(keys), ","))
+}
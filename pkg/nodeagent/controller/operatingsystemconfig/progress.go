@@ -0,0 +1,105 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package operatingsystemconfig
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/go-logr/logr"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// AnnotationKeyApplyProgress is the key of an annotation on a shoot Node object which reflects the progress of
+	// applying the files and units of a changed OperatingSystemConfig, e.g. `files=120/300,units=0/12`. It is removed once
+	// the configuration has been applied successfully, hence a remaining annotation indicates a partial application.
+	AnnotationKeyApplyProgress = "worker.gardener.cloud/osc-apply-progress"
+
+	// applyProgressReportInterval is the number of applied files or units after which the progress is reported on the
+	// node. Reporting each single item would cause hundreds of requests for large configurations.
+	applyProgressReportInterval = 25
+)
+
+// applyProgress counts the applied files and units and reports them in the AnnotationKeyApplyProgress annotation on the
+// node. It is safe for concurrent use. Failures to report the progress are only logged since they must not fail the
+// reconciliation.
+type applyProgress struct {
+	client client.Client
+	log    logr.Logger
+	node   *metav1.PartialObjectMetadata
+
+	lock                     sync.Mutex
+	filesApplied, filesTotal int
+	unitsApplied, unitsTotal int
+}
+
+// newApplyProgress returns a new applyProgress and reports the initial progress on the given node. Nothing is reported
+// if the node is not registered yet or if there is nothing to apply.
+func (r *Reconciler) newApplyProgress(ctx context.Context, log logr.Logger, node *metav1.PartialObjectMetadata, filesTotal, unitsTotal int) *applyProgress {
+	p := &applyProgress{
+		client:     r.Client,
+		log:        log,
+		node:       node,
+		filesTotal: filesTotal,
+		unitsTotal: unitsTotal,
+	}
+
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	p.report(ctx)
+
+	return p
+}
+
+func (p *applyProgress) fileApplied(ctx context.Context) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	p.filesApplied++
+	if p.filesApplied%applyProgressReportInterval == 0 || p.filesApplied == p.filesTotal {
+		p.report(ctx)
+	}
+}
+
+func (p *applyProgress) unitApplied(ctx context.Context) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	p.unitsApplied++
+	if p.unitsApplied%applyProgressReportInterval == 0 || p.unitsApplied == p.unitsTotal {
+		p.report(ctx)
+	}
+}
+
+func (p *applyProgress) String() string {
+	return fmt.Sprintf("files=%d/%d,units=%d/%d", p.filesApplied, p.filesTotal, p.unitsApplied, p.unitsTotal)
+}
+
+// report must be called while holding the lock.
+func (p *applyProgress) report(ctx context.Context) {
+	if p.node == nil || p.filesTotal+p.unitsTotal == 0 {
+		return
+	}
+
+	// The node object is patched in place, so that the final patch of the reconciler removes the annotation again.
+	patch := client.MergeFrom(p.node.DeepCopy())
+	metav1.SetMetaDataAnnotation(&p.node.ObjectMeta, AnnotationKeyApplyProgress, p.String())
+	if err := p.client.Patch(ctx, p.node, patch); err != nil {
+		p.log.Error(err, "Failed reporting progress of applying the operating system config on the node", "progress", p.String())
+	}
+}
@@ -0,0 +1,363 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package operatingsystemconfig
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	coordinationv1 "k8s.io/api/coordination/v1"
+	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	nodeagentv1alpha1 "github.com/gardener/gardener/pkg/nodeagent/apis/config/v1alpha1"
+	"github.com/gardener/gardener/pkg/nodeagent/journal"
+)
+
+const (
+	// AnnotationKeyCordonedForReboot is the key of an annotation on a shoot Node object which is set when
+	// gardener-node-agent cordoned the node because an applied change requires a reboot. Its value is the boot ID of the
+	// node at the time it was cordoned. The node is uncordoned once the operating system config has been applied after
+	// the reboot.
+	AnnotationKeyCordonedForReboot = "worker.gardener.cloud/cordoned-for-reboot"
+	// RebootLeaseNamePrefix is the prefix of the names of the Leases in the kube-system namespace which limit the number
+	// of nodes being cordoned, drained and rebooted at the same time. Each Lease is one slot held by one node.
+	RebootLeaseNamePrefix = "gardener-node-agent-reboot-"
+
+	bootIDFilePath        = "/proc/sys/kernel/random/boot_id"
+	rebootPendingFilePath = nodeagentv1alpha1.BaseDir + "/reboot-pending"
+
+	// rebootLeaseDuration is the duration after which a reboot slot which was not renewed can be taken over by another
+	// node, e.g. if the node holding it did not come back after the reboot.
+	rebootLeaseDuration         = 15 * time.Minute
+	defaultMaxConcurrentReboots = 1
+	defaultDrainTimeout         = 30 * time.Minute
+)
+
+func (r *Reconciler) rebootEnabled() bool {
+	return r.Config.Reboot != nil && pointer.BoolDeref(r.Config.Reboot.Enabled, false)
+}
+
+func (r *Reconciler) maxConcurrentReboots() int {
+	if r.Config.Reboot == nil {
+		return defaultMaxConcurrentReboots
+	}
+	return int(pointer.Int32Deref(r.Config.Reboot.MaxConcurrentReboots, defaultMaxConcurrentReboots))
+}
+
+func (r *Reconciler) drainTimeout() time.Duration {
+	if r.Config.Reboot == nil || r.Config.Reboot.DrainTimeout == nil {
+		return defaultDrainTimeout
+	}
+	return r.Config.Reboot.DrainTimeout.Duration
+}
+
+func (r *Reconciler) currentBootID() (string, error) {
+	bootID, err := r.FS.ReadFile(bootIDFilePath)
+	if err != nil {
+		return "", fmt.Errorf("unable to read boot ID from file path %q: %w", bootIDFilePath, err)
+	}
+	return strings.TrimSpace(string(bootID)), nil
+}
+
+// persistPendingReboot writes the checksum of the operating system config which requires the reboot together with the
+// current boot ID to the disk. This way, the reboot is not repeated and the units are started and verified once the
+// node has come back.
+func (r *Reconciler) persistPendingReboot(oscChecksum string) error {
+	bootID, err := r.currentBootID()
+	if err != nil {
+		return err
+	}
+
+	if err := journal.WriteFile(r.FS, rebootPendingFilePath, []byte(oscChecksum+" "+bootID+"\n"), 0644); err != nil {
+		return fmt.Errorf("unable to write pending reboot to file path %q: %w", rebootPendingFilePath, err)
+	}
+	return nil
+}
+
+// rebootedForOperatingSystemConfig returns true if the node has been rebooted since a reboot was required for the
+// operating system config with the given checksum.
+func (r *Reconciler) rebootedForOperatingSystemConfig(oscChecksum string) (bool, error) {
+	content, err := r.FS.ReadFile(rebootPendingFilePath)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return false, nil
+		}
+		return false, fmt.Errorf("unable to read pending reboot from file path %q: %w", rebootPendingFilePath, err)
+	}
+
+	fields := strings.Fields(string(content))
+	if len(fields) != 2 || fields[0] != oscChecksum {
+		return false, nil
+	}
+
+	bootID, err := r.currentBootID()
+	if err != nil {
+		return false, err
+	}
+	return fields[1] != bootID, nil
+}
+
+// acquireRebootSlot acquires one of the Leases which limit the number of nodes of the cluster being cordoned, drained
+// and rebooted at the same time. A Lease already held by the node is renewed. Leases which were not renewed within
+// their duration are taken over. It returns nil if all slots are held by other nodes.
+func (r *Reconciler) acquireRebootSlot(ctx context.Context, log logr.Logger, nodeName string) (*coordinationv1.Lease, error) {
+	leases, err := r.getRebootLeases(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	now := metav1.NewMicroTime(r.Clock.Now())
+
+	for _, lease := range leases {
+		if lease.ResourceVersion != "" && pointer.StringDeref(lease.Spec.HolderIdentity, "") == nodeName {
+			lease.Spec.RenewTime = &now
+			if err := r.Client.Update(ctx, lease); err != nil {
+				return nil, fmt.Errorf("unable to renew reboot lease %q: %w", client.ObjectKeyFromObject(lease), err)
+			}
+			return lease, nil
+		}
+	}
+
+	for _, lease := range leases {
+		if lease.ResourceVersion != "" && !rebootLeaseExpired(lease, now) {
+			continue
+		}
+
+		lease.Spec = coordinationv1.LeaseSpec{
+			HolderIdentity:       &nodeName,
+			LeaseDurationSeconds: pointer.Int32(int32(rebootLeaseDuration / time.Second)),
+			AcquireTime:          &now,
+			RenewTime:            &now,
+		}
+
+		if lease.ResourceVersion == "" {
+			err = r.Client.Create(ctx, lease)
+		} else {
+			err = r.Client.Update(ctx, lease)
+		}
+		if err != nil {
+			// Another node was faster, try the next slot.
+			if apierrors.IsAlreadyExists(err) || apierrors.IsConflict(err) {
+				continue
+			}
+			return nil, fmt.Errorf("unable to acquire reboot lease %q: %w", client.ObjectKeyFromObject(lease), err)
+		}
+
+		log.Info("Acquired reboot lease", "lease", client.ObjectKeyFromObject(lease))
+		return lease, nil
+	}
+
+	return nil, nil
+}
+
+// releaseRebootSlot releases the Leases held by the node so that other nodes can be rebooted.
+func (r *Reconciler) releaseRebootSlot(ctx context.Context, log logr.Logger, nodeName string) error {
+	leases, err := r.getRebootLeases(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, lease := range leases {
+		if lease.ResourceVersion == "" || pointer.StringDeref(lease.Spec.HolderIdentity, "") != nodeName {
+			continue
+		}
+
+		log.Info("Releasing reboot lease", "lease", client.ObjectKeyFromObject(lease))
+		lease.Spec.HolderIdentity = nil
+		if err := r.Client.Update(ctx, lease); err != nil {
+			return fmt.Errorf("unable to release reboot lease %q: %w", client.ObjectKeyFromObject(lease), err)
+		}
+	}
+
+	return nil
+}
+
+// getRebootLeases reads the reboot Leases directly from the API server, i.e., without starting an informer for Leases.
+// Leases which do not exist yet are returned without resource version.
+func (r *Reconciler) getRebootLeases(ctx context.Context) ([]*coordinationv1.Lease, error) {
+	var leases []*coordinationv1.Lease
+
+	for i := 0; i < r.maxConcurrentReboots(); i++ {
+		lease := &coordinationv1.Lease{ObjectMeta: metav1.ObjectMeta{Name: fmt.Sprintf("%s%d", RebootLeaseNamePrefix, i), Namespace: metav1.NamespaceSystem}}
+		if err := r.APIReader.Get(ctx, client.ObjectKeyFromObject(lease), lease); err != nil {
+			if !apierrors.IsNotFound(err) {
+				return nil, fmt.Errorf("unable to fetch reboot lease %q: %w", client.ObjectKeyFromObject(lease), err)
+			}
+			lease = &coordinationv1.Lease{ObjectMeta: metav1.ObjectMeta{Name: lease.Name, Namespace: lease.Namespace}}
+		}
+		leases = append(leases, lease)
+	}
+
+	return leases, nil
+}
+
+func rebootLeaseExpired(lease *coordinationv1.Lease, now metav1.MicroTime) bool {
+	if pointer.StringDeref(lease.Spec.HolderIdentity, "") == "" || lease.Spec.RenewTime == nil {
+		return true
+	}
+	duration := time.Duration(pointer.Int32Deref(lease.Spec.LeaseDurationSeconds, 0)) * time.Second
+	return lease.Spec.RenewTime.Add(duration).Before(now.Time)
+}
+
+// drainTimeoutExceeded returns true if the node holds the given reboot lease for longer than the drain timeout.
+func (r *Reconciler) drainTimeoutExceeded(lease *coordinationv1.Lease) bool {
+	return lease.Spec.AcquireTime != nil && r.Clock.Now().Sub(lease.Spec.AcquireTime.Time) > r.drainTimeout()
+}
+
+// cordonAndDrainNode marks the node as unschedulable and evicts all pods running on it. Pods managed by DaemonSets and
+// static pods are not evicted. Evictions respect PodDisruptionBudgets, i.e., pods whose eviction is blocked are tried
+// again in the next reconciliation. It returns true once no pods to evict are left on the node.
+func (r *Reconciler) cordonAndDrainNode(ctx context.Context, log logr.Logger, nodeName string) (bool, error) {
+	node := &corev1.Node{}
+	if err := r.Client.Get(ctx, client.ObjectKey{Name: nodeName}, node); err != nil {
+		return false, fmt.Errorf("unable to fetch node %q: %w", nodeName, err)
+	}
+
+	// Nodes which were already cordoned by somebody else are not annotated, hence they stay cordoned after the reboot.
+	if !node.Spec.Unschedulable {
+		bootID, err := r.currentBootID()
+		if err != nil {
+			return false, err
+		}
+
+		log.Info("Cordoning node before reboot", "nodeName", node.Name)
+		patch := client.MergeFrom(node.DeepCopy())
+		node.Spec.Unschedulable = true
+		metav1.SetMetaDataAnnotation(&node.ObjectMeta, AnnotationKeyCordonedForReboot, bootID)
+		if err := r.Client.Patch(ctx, node, patch); err != nil {
+			return false, fmt.Errorf("unable to cordon node %q: %w", node.Name, err)
+		}
+	}
+
+	podList := &corev1.PodList{}
+	if err := r.APIReader.List(ctx, podList, client.MatchingFields{"spec.nodeName": node.Name}); err != nil {
+		return false, fmt.Errorf("unable to list pods on node %q: %w", node.Name, err)
+	}
+
+	var remainingPods int
+	for i := range podList.Items {
+		pod := &podList.Items[i]
+		if !mustEvictPod(pod) {
+			continue
+		}
+
+		remainingPods++
+		if pod.DeletionTimestamp != nil {
+			continue
+		}
+
+		eviction := &policyv1.Eviction{ObjectMeta: metav1.ObjectMeta{Name: pod.Name, Namespace: pod.Namespace}}
+		if err := r.Client.SubResource("eviction").Create(ctx, pod, eviction); err != nil {
+			if apierrors.IsNotFound(err) {
+				continue
+			}
+			if apierrors.IsTooManyRequests(err) {
+				log.Info("Eviction of pod is blocked by a PodDisruptionBudget, trying again later", "pod", client.ObjectKeyFromObject(pod))
+				continue
+			}
+			return false, fmt.Errorf("unable to evict pod %q: %w", client.ObjectKeyFromObject(pod), err)
+		}
+
+		log.Info("Evicted pod", "pod", client.ObjectKeyFromObject(pod))
+	}
+
+	return remainingPods == 0, nil
+}
+
+func mustEvictPod(pod *corev1.Pod) bool {
+	if pod.Status.Phase == corev1.PodSucceeded || pod.Status.Phase == corev1.PodFailed {
+		return false
+	}
+	if _, ok := pod.Annotations[corev1.MirrorPodAnnotationKey]; ok {
+		return false
+	}
+	if controllerRef := metav1.GetControllerOf(pod); controllerRef != nil && controllerRef.Kind == "DaemonSet" {
+		return false
+	}
+	return true
+}
+
+// rebootNode reboots the node. The operating system config is not marked as applied before, this happens after the
+// units were started and verified once the node has come back.
+func (r *Reconciler) rebootNode(ctx context.Context, log logr.Logger, node *metav1.PartialObjectMetadata, oscChecksum string) error {
+	if err := r.persistPendingReboot(oscChecksum); err != nil {
+		return err
+	}
+
+	if node != nil {
+		r.Recorder.Event(node, corev1.EventTypeNormal, "RebootingNode", "Operating system config has been written, rebooting node since a change requires it")
+	}
+
+	log.Info("Rebooting node")
+	if err := r.DBus.Reboot(ctx); err != nil {
+		return fmt.Errorf("unable to reboot node: %w", err)
+	}
+
+	return nil
+}
+
+// completeReboot finishes a reboot of the node after the operating system config has been applied: It uncordons the
+// node if it was cordoned by gardener-node-agent, releases the reboot lease, and removes the pending reboot from the
+// disk. It does nothing if no reboot was pending.
+func (r *Reconciler) completeReboot(ctx context.Context, log logr.Logger, nodeName string) error {
+	pending, err := r.FS.Exists(rebootPendingFilePath)
+	if err != nil {
+		return fmt.Errorf("unable to check whether file path %q exists: %w", rebootPendingFilePath, err)
+	}
+	if !pending {
+		return nil
+	}
+
+	if err := r.uncordonNode(ctx, log, nodeName); err != nil {
+		return fmt.Errorf("failed uncordoning node: %w", err)
+	}
+
+	if err := r.releaseRebootSlot(ctx, log, nodeName); err != nil {
+		return err
+	}
+
+	if err := r.FS.Remove(rebootPendingFilePath); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return fmt.Errorf("unable to remove pending reboot file %q: %w", rebootPendingFilePath, err)
+	}
+	return nil
+}
+
+// uncordonNode marks the node as schedulable again if it was cordoned by gardener-node-agent for a reboot.
+func (r *Reconciler) uncordonNode(ctx context.Context, log logr.Logger, nodeName string) error {
+	node := &corev1.Node{}
+	if err := r.Client.Get(ctx, client.ObjectKey{Name: nodeName}, node); err != nil {
+		return fmt.Errorf("unable to fetch node %q: %w", nodeName, err)
+	}
+
+	if _, ok := node.Annotations[AnnotationKeyCordonedForReboot]; !ok {
+		return nil
+	}
+
+	log.Info("Uncordoning node after reboot", "nodeName", node.Name)
+	patch := client.MergeFrom(node.DeepCopy())
+	node.Spec.Unschedulable = false
+	delete(node.Annotations, AnnotationKeyCordonedForReboot)
+	return r.Client.Patch(ctx, node, patch)
+}
@@ -21,8 +21,12 @@ import (
 	"fmt"
 	"io/fs"
 	"os"
+	"os/exec"
 	"path"
 	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/go-logr/logr"
@@ -32,6 +36,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/clock"
 	"k8s.io/utils/pointer"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
@@ -45,8 +50,13 @@ import (
 	"github.com/gardener/gardener/pkg/nodeagent/apis/config"
 	nodeagentv1alpha1 "github.com/gardener/gardener/pkg/nodeagent/apis/config/v1alpha1"
 	"github.com/gardener/gardener/pkg/nodeagent/dbus"
+	"github.com/gardener/gardener/pkg/nodeagent/diagnostics"
+	"github.com/gardener/gardener/pkg/nodeagent/journal"
+	"github.com/gardener/gardener/pkg/nodeagent/metrics"
 	"github.com/gardener/gardener/pkg/nodeagent/registry"
+	"github.com/gardener/gardener/pkg/nodeagent/watchdog"
 	"github.com/gardener/gardener/pkg/utils/flow"
+	"github.com/gardener/gardener/pkg/utils/retry"
 )
 
 const (
@@ -57,7 +67,9 @@ const (
 // Reconciler decodes the OperatingSystemConfig resources from secrets and applies the systemd units and files to the
 // node.
 type Reconciler struct {
-	Client        client.Client
+	Client client.Client
+	// APIReader is used to list the pods on the node when it is drained before a reboot.
+	APIReader     client.Reader
 	Config        config.OperatingSystemConfigControllerConfig
 	Recorder      record.EventRecorder
 	DBus          dbus.DBus
@@ -65,7 +77,22 @@ type Reconciler struct {
 	Extractor     registry.Extractor
 	CancelContext context.CancelFunc
 	HostName      string
-	nodeName      string
+	// ReconcileState records the active reconciliation and its step for the diagnostics server. It is optional.
+	ReconcileState *diagnostics.ReconcileState
+	// Watchdog is notified about the progress of the reconciliation, so that systemd restarts gardener-node-agent if
+	// the reconciliation hangs. It is optional.
+	Watchdog *watchdog.Watchdog
+	// PersistFailureReports specifies whether a failure report is written to the diagnostics directory when the
+	// reconciliation fails.
+	PersistFailureReports bool
+	// RecordFailureReportEvents specifies whether a summary of the failure report is recorded as event for the node
+	// when the reconciliation fails.
+	RecordFailureReportEvents bool
+	// ExecProbe executes the post-start probe command of a unit on the node and returns its combined output.
+	ExecProbe      func(ctx context.Context, command []string) ([]byte, error)
+	Clock          clock.PassiveClock
+	nodeName       string
+	requeueBackoff requeueBackoff
 }
 
 // Reconcile decodes the OperatingSystemConfig resources from secrets and applies the systemd units and files to the
@@ -73,9 +100,47 @@ type Reconciler struct {
 func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
 	log := logf.FromContext(ctx)
 
+	details := &failureDetails{}
+	requeues := r.requeueBackoff.get(request)
+	result, err := r.reconcile(ctx, log, request, details)
+	// The backoff only grows while the reconciliation keeps waiting for the node, any other outcome resets it.
+	if r.requeueBackoff.get(request) == requeues {
+		r.requeueBackoff.reset(request)
+	}
+	if err != nil {
+		r.reportFailure(ctx, log, request, details, err)
+	}
+	r.recordReconciliationResult(err)
+	return result, err
+}
+
+// recordReconciliationResult increments the OperatingSystemConfigReconciliations metric and updates the
+// OperatingSystemConfigLastReconciliationTimestamp metric for the result of the reconciliation.
+func (r *Reconciler) recordReconciliationResult(err error) {
+	result := metrics.ResultSucceeded
+	if err != nil {
+		result = metrics.ResultFailed
+	}
+
+	metrics.OperatingSystemConfigReconciliations.WithLabelValues(result).Inc()
+	metrics.OperatingSystemConfigLastReconciliationTimestamp.WithLabelValues(result).Set(float64(r.Clock.Now().Unix()))
+}
+
+func (r *Reconciler) reconcile(ctx context.Context, log logr.Logger, request reconcile.Request, details *failureDetails) (reconcile.Result, error) {
 	ctx, cancel := controllerutils.GetMainReconciliationContext(ctx, controllerutils.DefaultReconciliationTimeout)
 	defer cancel()
 
+	defer r.ReconcileState.Start(ControllerName, request.String())()
+	defer r.Watchdog.Begin()()
+	step := func(msg string) {
+		log.Info(msg)
+		r.ReconcileState.SetStep(ControllerName, request.String(), msg)
+		r.Watchdog.Progress()
+		details.phase = msg
+	}
+
+	details.phase = "Reading operating system config"
+
 	secret := &corev1.Secret{}
 	if err := r.Client.Get(ctx, request.NamespacedName, secret); err != nil {
 		if apierrors.IsNotFound(err) {
@@ -90,51 +155,173 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 		return reconcile.Result{}, fmt.Errorf("failed getting node: %w", err)
 	}
 
+	details.node = node
+
 	osc, oscRaw, oscChecksum, err := extractOSCFromSecret(secret)
 	if err != nil {
 		return reconcile.Result{}, fmt.Errorf("failed extracting OSC from secret: %w", err)
 	}
+	details.oscChecksum = oscChecksum
+
+	legacyHandoverRequired, err := r.legacyHandoverRequired()
+	if err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed checking whether node must be taken over from legacy cloud-config-downloader: %w", err)
+	}
+	if legacyHandoverRequired {
+		step("Taking over node from legacy cloud-config-downloader")
+		if err := r.handOverFromLegacyCloudConfigDownloader(ctx, log, node, osc); err != nil {
+			return reconcile.Result{}, fmt.Errorf("failed taking over node from legacy cloud-config-downloader: %w", err)
+		}
+	}
 
 	oscChanges, err := computeOperatingSystemConfigChanges(r.FS, osc)
 	if err != nil {
 		return reconcile.Result{}, fmt.Errorf("failed calculating the OSC changes: %w", err)
 	}
+	for _, unit := range oscChanges.units.changed {
+		details.units = append(details.units, unit.Name)
+	}
+
+	if node != nil {
+		step("Reconciling managed labels and taints of node")
+		if err := r.reconcileNodeLabelsAndTaints(ctx, log, node.Name, osc); err != nil {
+			return reconcile.Result{}, fmt.Errorf("failed reconciling managed labels and taints of node: %w", err)
+		}
+	}
 
 	if node != nil && node.Annotations[executor.AnnotationKeyChecksum] == oscChecksum {
-		log.Info("Configuration on this node is up to date, nothing to be done")
-		return reconcile.Result{}, nil
+		var drifted []string
+		if pointer.BoolDeref(r.Config.DriftDetectionEnabled, false) {
+			step("Detecting drift of files and units")
+			oscChanges, drifted, err = r.detectDrift(osc)
+			if err != nil {
+				return reconcile.Result{}, fmt.Errorf("failed detecting drift of files and units: %w", err)
+			}
+		}
+
+		if len(drifted) == 0 {
+			if err := r.completeReboot(ctx, log, node.Name); err != nil {
+				return reconcile.Result{}, fmt.Errorf("failed completing reboot of node: %w", err)
+			}
+
+			// Requeue regularly so that manual changes to the managed labels and taints of the node are corrected.
+			log.Info("Configuration on this node is up to date, nothing to be done")
+			return r.requeueAfterSyncPeriod(), nil
+		}
+
+		log.Info("Detected drift of files and units on the disk, applying them again", "drifted", drifted)
+		r.Recorder.Eventf(node, corev1.EventTypeWarning, "OSCDriftDetected", "Detected drift of files and units on the disk, applying them again: %s", strings.Join(drifted, ", "))
+
+		details.units = nil
+		for _, unit := range oscChanges.units.changed {
+			details.units = append(details.units, unit.Name)
+		}
+	}
+
+	rebootRequired := oscChanges.rebootRequired()
+	if rebootRequired {
+		rebooted, err := r.rebootedForOperatingSystemConfig(oscChecksum)
+		if err != nil {
+			return reconcile.Result{}, fmt.Errorf("failed checking whether node has been rebooted: %w", err)
+		}
+
+		if rebooted {
+			log.Info("Node has been rebooted for the operating system config, applying it without rebooting again")
+			rebootRequired = false
+		} else if !r.rebootEnabled() {
+			log.Info("Changes of the operating system config require a reboot but reboots are disabled, applying them without reboot")
+			if node != nil {
+				r.Recorder.Event(node, corev1.EventTypeWarning, "RebootDisabled", "Changes of the operating system config require a reboot but reboots are disabled, applying them without reboot")
+			}
+			rebootRequired = false
+		}
+	}
+
+	if rebootRequired && node != nil {
+		step("Acquiring reboot lease")
+		lease, err := r.acquireRebootSlot(ctx, log, node.Name)
+		if err != nil {
+			return reconcile.Result{}, fmt.Errorf("failed acquiring reboot lease: %w", err)
+		}
+		if lease == nil {
+			result := r.requeueWithBackoff(request)
+			log.Info("Waiting for other nodes to finish their reboot, requeuing", "requeueAfter", result.RequeueAfter)
+			return result, nil
+		}
+
+		if err := r.persistPendingReboot(oscChecksum); err != nil {
+			return reconcile.Result{}, err
+		}
+
+		step("Cordoning and draining node before reboot")
+		drained, err := r.cordonAndDrainNode(ctx, log, node.Name)
+		if err != nil {
+			return reconcile.Result{}, fmt.Errorf("failed cordoning and draining node: %w", err)
+		}
+		if !drained {
+			if !r.drainTimeoutExceeded(lease) {
+				result := r.requeueWithBackoff(request)
+				log.Info("Waiting for pods to be evicted from node before reboot, requeuing", "requeueAfter", result.RequeueAfter)
+				return result, nil
+			}
+
+			log.Info("Drain timeout exceeded, rebooting node although pods could not be evicted", "drainTimeout", r.drainTimeout())
+			r.Recorder.Eventf(node, corev1.EventTypeWarning, "DrainTimeoutExceeded", "Pods could not be evicted within %s, rebooting node anyways", r.drainTimeout())
+		}
 	}
 
-	log.Info("Applying new or changed files")
-	if err := r.applyChangedFiles(ctx, log, oscChanges.files.changed); err != nil {
+	progress := r.newApplyProgress(ctx, log, node, len(oscChanges.files.changed), len(oscChanges.units.changed))
+
+	step("Applying new or changed files")
+	if err := r.applyChangedFiles(ctx, log, secret.Namespace, oscChanges.files.changed, progress); err != nil {
 		return reconcile.Result{}, fmt.Errorf("failed applying changed files: %w", err)
 	}
 
-	log.Info("Applying new or changed units")
-	if err := r.applyChangedUnits(ctx, log, oscChanges.units.changed); err != nil {
+	step("Applying new or changed units")
+	if err := r.applyChangedUnits(ctx, log, oscChanges.units.changed, progress); err != nil {
 		return reconcile.Result{}, fmt.Errorf("failed applying changed units: %w", err)
 	}
 
-	log.Info("Removing no longer needed units")
+	step("Removing no longer needed units")
 	if err := r.removeDeletedUnits(ctx, log, node, oscChanges.units.deleted); err != nil {
 		return reconcile.Result{}, fmt.Errorf("failed removing deleted units: %w", err)
 	}
 
-	log.Info("Reloading systemd daemon")
+	step("Reloading systemd daemon")
 	if err := r.DBus.DaemonReload(ctx); err != nil {
 		return reconcile.Result{}, fmt.Errorf("failed reloading systemd daemon: %w", err)
 	}
 
-	log.Info("Executing unit commands (start/stop)")
-	mustRestartGardenerNodeAgent, err := r.executeUnitCommands(ctx, log, node, oscChanges.units.changed)
-	if err != nil {
-		return reconcile.Result{}, fmt.Errorf("failed executing unit commands: %w", err)
+	var mustRestartGardenerNodeAgent bool
+	// Units are (re)started by systemd anyways when the node comes back after the reboot.
+	if !rebootRequired {
+		step("Executing unit commands (start/stop)")
+		mustRestartGardenerNodeAgent, err = r.executeUnitCommands(ctx, log, node, oscChanges.units.changed)
+		if err != nil {
+			return reconcile.Result{}, fmt.Errorf("failed executing unit commands: %w", err)
+		}
+
+		step("Verifying health of restarted units")
+		if err := r.verifyRestartedUnitsHealthy(ctx, log, node, oscChanges.units.changed); err != nil {
+			return reconcile.Result{}, fmt.Errorf("failed verifying health of restarted units: %w", err)
+		}
 	}
 
-	log.Info("Removing no longer needed files")
+	step("Removing no longer needed files")
 	if err := r.removeDeletedFiles(log, oscChanges.files.deleted); err != nil {
 		return reconcile.Result{}, fmt.Errorf("failed removing deleted files: %w", err)
 	}
+	if err := r.removeUnreferencedChunks(log, osc); err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed removing no longer needed chunks: %w", err)
+	}
+
+	step("Managing disk usage of managed artifacts")
+	if err := r.manageDiskUsage(log, node, osc); err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed managing disk usage: %w", err)
+	}
+
+	metrics.OperatingSystemConfigApplied.WithLabelValues(metrics.KindFile).Add(float64(len(oscChanges.files.changed)))
+	metrics.OperatingSystemConfigApplied.WithLabelValues(metrics.KindUnit).Add(float64(len(oscChanges.units.changed)))
 
 	log.Info("Successfully applied operating system config",
 		"changedFiles", len(oscChanges.files.changed),
@@ -143,8 +330,21 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 		"deletedUnits", len(oscChanges.units.deleted),
 	)
 
+	// The 'last-applied' file is only written after the reboot, i.e., once the units were started and verified.
+	if rebootRequired {
+		step("Rebooting node")
+		if err := r.rebootNode(ctx, log, node, oscChecksum); err != nil {
+			return reconcile.Result{}, err
+		}
+
+		// Requeue in case the reboot is delayed, e.g. by inhibitors, so that it is tried again.
+		result := r.requeueWithBackoff(request)
+		log.Info("Waiting for node to be rebooted, requeuing", "requeueAfter", result.RequeueAfter)
+		return result, nil
+	}
+
 	log.Info("Persisting current operating system config as 'last-applied' file to the disk", "path", lastAppliedOperatingSystemConfigFilePath)
-	if err := r.FS.WriteFile(lastAppliedOperatingSystemConfigFilePath, oscRaw, 0644); err != nil {
+	if err := journal.WriteFile(r.FS, lastAppliedOperatingSystemConfigFilePath, oscRaw, 0644); err != nil {
 		return reconcile.Result{}, fmt.Errorf("unable to write current OSC to file path %q: %w", lastAppliedOperatingSystemConfigFilePath, err)
 	}
 
@@ -155,8 +355,9 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 	}
 
 	if node == nil {
-		log.Info("Waiting for Node to get registered by kubelet, requeuing")
-		return reconcile.Result{RequeueAfter: 5 * time.Second}, nil
+		result := r.requeueWithBackoff(request)
+		log.Info("Waiting for Node to get registered by kubelet, requeuing", "requeueAfter", result.RequeueAfter)
+		return result, nil
 	}
 
 	r.Recorder.Event(node, corev1.EventTypeNormal, "OSCApplied", "Operating system config has been applied successfully")
@@ -164,7 +365,16 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 	patch := client.MergeFrom(node.DeepCopy())
 	metav1.SetMetaDataAnnotation(&node.ObjectMeta, v1beta1constants.LabelWorkerKubernetesVersion, r.Config.KubernetesVersion.String())
 	metav1.SetMetaDataAnnotation(&node.ObjectMeta, executor.AnnotationKeyChecksum, oscChecksum)
-	return reconcile.Result{RequeueAfter: r.Config.SyncPeriod.Duration}, r.Client.Patch(ctx, node, patch)
+	delete(node.Annotations, AnnotationKeyApplyProgress)
+	if err := r.Client.Patch(ctx, node, patch); err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed patching node: %w", err)
+	}
+
+	if err := r.completeReboot(ctx, log, node.Name); err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed completing reboot of node: %w", err)
+	}
+
+	return r.requeueAfterSyncPeriod(), nil
 }
 
 func (r *Reconciler) getNode(ctx context.Context) (*metav1.PartialObjectMetadata, error) {
@@ -200,53 +410,140 @@ var (
 	defaultFilePermissions os.FileMode = 0600
 )
 
-func (r *Reconciler) applyChangedFiles(ctx context.Context, log logr.Logger, files []extensionsv1alpha1.File) error {
+func (r *Reconciler) applyChangedFiles(ctx context.Context, log logr.Logger, namespace string, files []extensionsv1alpha1.File, progress *applyProgress) error {
 	tmpDir, err := r.FS.TempDir("", "gardener-node-agent-*")
 	if err != nil {
 		return fmt.Errorf("unable to create temporary directory: %w", err)
 	}
 	defer func() { utilruntime.HandleError(r.FS.RemoveAll(tmpDir)) }()
 
-	for _, file := range files {
-		permissions := defaultFilePermissions
-		if file.Permissions != nil {
-			permissions = fs.FileMode(*file.Permissions)
-		}
+	var (
+		inlineFiles = make(chan int)
+		imageFiles  []extensionsv1alpha1.File
+		symlinks    []extensionsv1alpha1.File
+		errs        = make([]error, len(files))
+		wg          sync.WaitGroup
+	)
 
+	// Inline files are written concurrently since large configurations (e.g. for registry mirrors) contain hundreds of
+	// them. Each worker writes to its own temporary file, so that files with the same base name do not conflict.
+	for i := 0; i < pointer.IntDeref(r.Config.ConcurrentFileWrites, 1); i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for index := range inlineFiles {
+				if errs[index] = r.applyInlineFile(ctx, log, namespace, tmpDir, index, files[index]); errs[index] == nil {
+					progress.fileApplied(ctx)
+				}
+			}
+		}()
+	}
+
+	for index, file := range files {
 		switch {
 		case file.Content.Inline != nil:
-			if err := r.FS.MkdirAll(filepath.Dir(file.Path), fs.ModeDir); err != nil {
-				return fmt.Errorf("unable to create directory %q: %w", file.Path, err)
-			}
+			inlineFiles <- index
+		case file.Content.ImageRef != nil:
+			imageFiles = append(imageFiles, file)
+		case file.Content.Symlink != nil:
+			symlinks = append(symlinks, file)
+		}
+	}
+	close(inlineFiles)
+	wg.Wait()
 
-			data, err := extensionsv1alpha1helper.Decode(file.Content.Inline.Encoding, []byte(file.Content.Inline.Data))
-			if err != nil {
-				return fmt.Errorf("unable to decode data of file %q: %w", file.Path, err)
-			}
+	if err := errors.Join(errs...); err != nil {
+		return err
+	}
 
-			tmpFilePath := filepath.Join(tmpDir, filepath.Base(file.Path))
-			if err := r.FS.WriteFile(tmpFilePath, data, permissions); err != nil {
-				return fmt.Errorf("unable to create temporary file %q: %w", tmpFilePath, err)
-			}
+	// Files from images are applied one after another as the extraction cache is not safe for concurrent use.
+	for _, file := range imageFiles {
+		// Pulling and extracting the image might take longer than the watchdog timeout, but not longer than the
+		// reconciliation itself.
+		r.Watchdog.Extend(controllerutils.DefaultReconciliationTimeout)
+		if err := r.Extractor.CopyFromImage(ctx, file.Content.ImageRef.Image, file.Content.ImageRef.FilePathInImage, file.Path, filePermissions(file)); err != nil {
+			return fmt.Errorf("unable to copy file %q from image %q to %q: %w", file.Content.ImageRef.FilePathInImage, file.Content.ImageRef.Image, file.Path, err)
+		}
+		if err := r.applyFileOwner(file); err != nil {
+			return err
+		}
 
-			if err := r.FS.Rename(tmpFilePath, file.Path); err != nil {
-				return fmt.Errorf("unable to rename temporary file %q to %q: %w", tmpFilePath, file.Path, err)
-			}
+		log.Info("Successfully applied new or changed file from image", "path", file.Path, "image", file.Content.ImageRef.Image)
+		progress.fileApplied(ctx)
+	}
 
-			log.Info("Successfully applied new or changed file", "path", file.Path)
+	for _, file := range symlinks {
+		if err := r.applySymlink(log, file); err != nil {
+			return err
+		}
+		progress.fileApplied(ctx)
+	}
 
-		case file.Content.ImageRef != nil:
-			if err := r.Extractor.CopyFromImage(ctx, file.Content.ImageRef.Image, file.Content.ImageRef.FilePathInImage, file.Path, permissions); err != nil {
-				return fmt.Errorf("unable to copy file %q from image %q to %q: %w", file.Content.ImageRef.FilePathInImage, file.Content.ImageRef.Image, file.Path, err)
+	return nil
+}
+
+func (r *Reconciler) applyInlineFile(ctx context.Context, log logr.Logger, namespace, tmpDir string, index int, file extensionsv1alpha1.File) error {
+	permissions := filePermissions(file)
+
+	if err := r.FS.MkdirAll(filepath.Dir(file.Path), fs.ModeDir); err != nil {
+		return fmt.Errorf("unable to create directory %q: %w", file.Path, err)
+	}
+
+	var data []byte
+	if chunks := file.Content.Inline.Chunks; len(chunks) > 0 {
+		// Rewriting large files is avoided if their content did not change, e.g., when only the permissions, the owner,
+		// or the assignment to units changed.
+		upToDate, err := r.fileMatchesChunks(file.Path, chunks)
+		if err != nil {
+			return err
+		}
+		if upToDate {
+			if err := r.FS.Chmod(file.Path, permissions); err != nil {
+				return fmt.Errorf("unable to change permissions of file %q: %w", file.Path, err)
 			}
+			if err := r.applyFileOwner(file); err != nil {
+				return err
+			}
+			log.Info("Content of chunked file is up to date, skipping write", "path", file.Path)
+			return nil
+		}
 
-			log.Info("Successfully applied new or changed file from image", "path", file.Path, "image", file.Content.ImageRef.Image)
+		data, err = r.assembleChunkedFile(ctx, log, namespace, chunks)
+		if err != nil {
+			return fmt.Errorf("unable to assemble data of file %q from chunks: %w", file.Path, err)
 		}
+	} else {
+		var err error
+		data, err = extensionsv1alpha1helper.Decode(file.Content.Inline.Encoding, []byte(file.Content.Inline.Data))
+		if err != nil {
+			return fmt.Errorf("unable to decode data of file %q: %w", file.Path, err)
+		}
+	}
+
+	tmpFilePath := filepath.Join(tmpDir, fmt.Sprintf("%d-%s", index, filepath.Base(file.Path)))
+	if err := r.FS.WriteFile(tmpFilePath, data, permissions); err != nil {
+		return fmt.Errorf("unable to create temporary file %q: %w", tmpFilePath, err)
+	}
+
+	if err := r.FS.Rename(tmpFilePath, file.Path); err != nil {
+		return fmt.Errorf("unable to rename temporary file %q to %q: %w", tmpFilePath, file.Path, err)
+	}
+
+	if err := r.applyFileOwner(file); err != nil {
+		return err
 	}
 
+	log.Info("Successfully applied new or changed file", "path", file.Path)
 	return nil
 }
 
+func filePermissions(file extensionsv1alpha1.File) fs.FileMode {
+	if file.Permissions != nil {
+		return fs.FileMode(*file.Permissions)
+	}
+	return defaultFilePermissions
+}
+
 func (r *Reconciler) removeDeletedFiles(log logr.Logger, files []extensionsv1alpha1.File) error {
 	for _, file := range files {
 		if err := r.FS.Remove(file.Path); err != nil && !errors.Is(err, afero.ErrFileNotFound) {
@@ -259,7 +556,7 @@ func (r *Reconciler) removeDeletedFiles(log logr.Logger, files []extensionsv1alp
 	return nil
 }
 
-func (r *Reconciler) applyChangedUnits(ctx context.Context, log logr.Logger, units []changedUnit) error {
+func (r *Reconciler) applyChangedUnits(ctx context.Context, log logr.Logger, units []changedUnit, progress *applyProgress) error {
 	for _, unit := range units {
 		unitFilePath := path.Join(etcSystemdSystem, unit.Name)
 
@@ -336,6 +633,8 @@ func (r *Reconciler) applyChangedUnits(ctx context.Context, log logr.Logger, uni
 			}
 			log.Info("Successfully disabled unit", "unitName", unit.Name)
 		}
+
+		progress.unitApplied(ctx)
 	}
 
 	return nil
@@ -398,3 +697,103 @@ func (r *Reconciler) executeUnitCommands(ctx context.Context, log logr.Logger, n
 
 	return mustRestartGardenerNodeAgent, flow.Parallel(fns...)(ctx)
 }
+
+// defaultUnitHealthVerificationTimeout is used if the unit health verification timeout is not configured.
+const defaultUnitHealthVerificationTimeout = time.Minute
+
+// execProbeCommand executes the given post-start probe command on the node.
+func execProbeCommand(ctx context.Context, command []string) ([]byte, error) {
+	return exec.CommandContext(ctx, command[0], command[1:]...).CombinedOutput()
+}
+
+func (r *Reconciler) verifyRestartedUnitsHealthy(ctx context.Context, log logr.Logger, node *metav1.PartialObjectMetadata, units []changedUnit) error {
+	timeout := defaultUnitHealthVerificationTimeout
+	if r.Config.UnitHealthVerificationTimeout != nil {
+		timeout = r.Config.UnitHealthVerificationTimeout.Duration
+	}
+
+	// The units are verified in parallel with an overall deadline, so that the verification of many units which do not
+	// become healthy does not take the timeout multiplied by their number.
+	timeoutCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	var (
+		lock           sync.Mutex
+		unhealthyUnits []string
+		fns            []flow.TaskFn
+	)
+
+	for _, u := range units {
+		unit := u
+		if unit.Name == nodeagentv1alpha1.UnitName || !pointer.BoolDeref(unit.Enable, true) || (unit.Command != nil && *unit.Command == extensionsv1alpha1.CommandStop) {
+			continue
+		}
+
+		fns = append(fns, func(ctx context.Context) error {
+			if err := r.verifyUnitHealthy(ctx, unit.Unit); err != nil {
+				log.Error(err, "Unit did not become healthy after restart", "unitName", unit.Name)
+				lock.Lock()
+				unhealthyUnits = append(unhealthyUnits, fmt.Sprintf("%s (%v)", unit.Name, err))
+				lock.Unlock()
+				return nil
+			}
+
+			log.Info("Unit is healthy after restart", "unitName", unit.Name)
+			return nil
+		})
+	}
+
+	r.Watchdog.Progress()
+	if err := flow.Parallel(fns...)(timeoutCtx); err != nil {
+		return err
+	}
+
+	if len(unhealthyUnits) == 0 {
+		return nil
+	}
+	slices.Sort(unhealthyUnits)
+
+	message := fmt.Sprintf("Units did not become healthy after restart: %s", strings.Join(unhealthyUnits, ", "))
+	if node != nil {
+		r.Recorder.Event(node, corev1.EventTypeWarning, "UnitsUnhealthy", message)
+	}
+
+	return errors.New(message)
+}
+
+func (r *Reconciler) verifyUnitHealthy(ctx context.Context, unit extensionsv1alpha1.Unit) error {
+	return retry.Until(ctx, 2*time.Second, func(ctx context.Context) (done bool, err error) {
+		activeState, err := r.DBus.ActiveState(ctx, unit.Name)
+		if err != nil {
+			return retry.MinorError(err)
+		}
+
+		switch activeState {
+		case "active":
+		case "inactive":
+			// Units which ran to completion, e.g. oneshot services without 'RemainAfterExit=yes', or whose start
+			// conditions were not met are inactive after a successful (re)start.
+			result, err := r.DBus.Result(ctx, unit.Name)
+			if err != nil {
+				return retry.MinorError(err)
+			}
+			if result != "success" {
+				return retry.MinorError(fmt.Errorf("unit is in state %q with result %q", activeState, result))
+			}
+		case "failed":
+			return retry.SevereError(fmt.Errorf("unit is in state %q", activeState))
+		default:
+			return retry.MinorError(fmt.Errorf("unit is in state %q", activeState))
+		}
+
+		if unit.PostStartProbe == nil || len(unit.PostStartProbe.Command) == 0 {
+			return retry.Ok()
+		}
+
+		if output, err := r.ExecProbe(ctx, unit.PostStartProbe.Command); err != nil {
+			return retry.MinorError(fmt.Errorf("post-start probe failed: %w (output: %s)", err, strings.TrimSpace(string(output))))
+		}
+
+		return retry.Ok()
+	})
+}
@@ -31,6 +31,7 @@ import (
 	"github.com/gardener/gardener/pkg/controllerutils"
 	"github.com/gardener/gardener/pkg/nodeagent/apis/config"
 	nodeagentv1alpha1 "github.com/gardener/gardener/pkg/nodeagent/apis/config/v1alpha1"
+	"github.com/gardener/gardener/pkg/nodeagent/journal"
 )
 
 // Reconciler fetches the shoot access token for gardener-node-agent and writes it to disk.
@@ -68,7 +69,7 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 
 	if !bytes.Equal(currentToken, token) {
 		log.Info("Access token differs from the one currently stored on the disk, updating it", "path", nodeagentv1alpha1.TokenFilePath)
-		if err := r.FS.WriteFile(nodeagentv1alpha1.TokenFilePath, token, 0600); err != nil {
+		if err := journal.WriteFile(r.FS, nodeagentv1alpha1.TokenFilePath, token, 0600); err != nil {
 			return reconcile.Result{}, fmt.Errorf("unable to write access token to %s: %w", nodeagentv1alpha1.TokenFilePath, err)
 		}
 		log.Info("Updated token written to disk")
@@ -17,7 +17,9 @@ package dbus
 import (
 	"context"
 	"fmt"
+	"path"
 	"reflect"
+	"strings"
 
 	"github.com/coreos/go-systemd/v22/dbus"
 	corev1 "k8s.io/api/core/v1"
@@ -41,6 +43,12 @@ type DBus interface {
 	Stop(ctx context.Context, recorder record.EventRecorder, node runtime.Object, unitName string) error
 	// Restart the given unit and record an event to the node object, same as executing "systemctl restart unit".
 	Restart(ctx context.Context, recorder record.EventRecorder, node runtime.Object, unitName string) error
+	// ActiveState returns the active state of the given unit, same as executing "systemctl show -P ActiveState unit".
+	ActiveState(ctx context.Context, unitName string) (string, error)
+	// Result returns the result of the last run of the given unit, same as executing "systemctl show -P Result unit".
+	Result(ctx context.Context, unitName string) (string, error)
+	// Reboot the node, same as executing "systemctl reboot".
+	Reboot(ctx context.Context) error
 }
 
 type db struct{}
@@ -151,6 +159,66 @@ func (_ *db) DaemonReload(ctx context.Context) error {
 	return nil
 }
 
+func (_ *db) ActiveState(ctx context.Context, unitName string) (string, error) {
+	dbc, err := dbus.NewWithContext(ctx)
+	if err != nil {
+		return "", fmt.Errorf("unable to connect to dbus: %w", err)
+	}
+	defer dbc.Close()
+
+	property, err := dbc.GetUnitPropertyContext(ctx, unitName, "ActiveState")
+	if err != nil {
+		return "", fmt.Errorf("unable to get active state of unit %s: %w", unitName, err)
+	}
+
+	activeState, ok := property.Value.Value().(string)
+	if !ok {
+		return "", fmt.Errorf("unexpected type %T of active state of unit %s", property.Value.Value(), unitName)
+	}
+
+	return activeState, nil
+}
+
+func (_ *db) Result(ctx context.Context, unitName string) (string, error) {
+	dbc, err := dbus.NewWithContext(ctx)
+	if err != nil {
+		return "", fmt.Errorf("unable to connect to dbus: %w", err)
+	}
+	defer dbc.Close()
+
+	// The result is a property of the unit type specific interface, e.g. 'org.freedesktop.systemd1.Service'.
+	unitType := strings.TrimPrefix(path.Ext(unitName), ".")
+	if len(unitType) == 0 {
+		unitType = "service"
+	}
+
+	property, err := dbc.GetUnitTypePropertyContext(ctx, unitName, strings.ToUpper(unitType[:1])+unitType[1:], "Result")
+	if err != nil {
+		return "", fmt.Errorf("unable to get result of unit %s: %w", unitName, err)
+	}
+
+	result, ok := property.Value.Value().(string)
+	if !ok {
+		return "", fmt.Errorf("unexpected type %T of result of unit %s", property.Value.Value(), unitName)
+	}
+
+	return result, nil
+}
+
+func (_ *db) Reboot(ctx context.Context) error {
+	dbc, err := dbus.NewWithContext(ctx)
+	if err != nil {
+		return fmt.Errorf("unable to connect to dbus: %w", err)
+	}
+	defer dbc.Close()
+
+	if _, err := dbc.StartUnitContext(ctx, "reboot.target", "replace-irreversibly", nil); err != nil {
+		return fmt.Errorf("unable to start reboot.target: %w", err)
+	}
+
+	return nil
+}
+
 func recordEvent(recorder record.EventRecorder, node runtime.Object, err error, unitName, reason, operation string) {
 	if recorder != nil && node != nil && !reflect.ValueOf(node).IsNil() { // nil is not nil :(
 		var (
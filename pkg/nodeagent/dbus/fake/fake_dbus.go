@@ -40,6 +40,8 @@ const (
 	ActionStart
 	// ActionStop is constant for the 'Stop' action.
 	ActionStop
+	// ActionReboot is constant for the 'Reboot' action.
+	ActionReboot
 )
 
 // SystemdAction is used for the implementation of the fake dbus.
@@ -52,6 +54,9 @@ type SystemdAction struct {
 type DBus struct {
 	Actions []SystemdAction
 
+	activeStates map[string]string
+	results      map[string]string
+
 	mutex sync.Mutex
 }
 
@@ -133,3 +138,60 @@ func (d *DBus) Stop(_ context.Context, _ record.EventRecorder, _ runtime.Object,
 	})
 	return nil
 }
+
+// ActiveState implements dbus.DBus.
+func (d *DBus) ActiveState(_ context.Context, unitName string) (string, error) {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	if activeState, ok := d.activeStates[unitName]; ok {
+		return activeState, nil
+	}
+	return "active", nil
+}
+
+// Result implements dbus.DBus.
+func (d *DBus) Result(_ context.Context, unitName string) (string, error) {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	if result, ok := d.results[unitName]; ok {
+		return result, nil
+	}
+	return "success", nil
+}
+
+// Reboot implements dbus.DBus.
+func (d *DBus) Reboot(_ context.Context) error {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	d.Actions = append(d.Actions, SystemdAction{
+		Action: ActionReboot,
+	})
+	return nil
+}
+
+// SetActiveState sets the active state which is returned by ActiveState for the given unit. By default, all units are
+// reported as "active".
+func (d *DBus) SetActiveState(unitName, activeState string) {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	if d.activeStates == nil {
+		d.activeStates = make(map[string]string)
+	}
+	d.activeStates[unitName] = activeState
+}
+
+// SetResult sets the result which is returned by Result for the given unit. By default, all units are reported with
+// result "success".
+func (d *DBus) SetResult(unitName, result string) {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	if d.results == nil {
+		d.results = make(map[string]string)
+	}
+	d.results[unitName] = result
+}
@@ -0,0 +1,119 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package diagnostics
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"sort"
+	"strings"
+
+	"github.com/go-logr/logr"
+	"github.com/spf13/afero"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/utils/clock"
+)
+
+const (
+	crashDumpPrefix = "crash-"
+	// maxCrashDumps is the number of crash dumps which are kept in the diagnostics directory. Older ones are removed
+	// when a new crash dump is persisted.
+	maxCrashDumps = 5
+)
+
+// PersistCrashDump writes the given panic value and the stack traces of all goroutines to a file in the diagnostics
+// directory and returns its path. Only the most recent crash dumps are kept.
+func PersistCrashDump(fs afero.Afero, clock clock.PassiveClock, reason interface{}) (string, error) {
+	if err := fs.MkdirAll(Dir, os.ModeDir|0700); err != nil {
+		return "", fmt.Errorf("unable to create diagnostics directory %q: %w", Dir, err)
+	}
+
+	var (
+		path    = filepath.Join(Dir, crashDumpPrefix+clock.Now().UTC().Format(timestampFormat)+".log")
+		content = fmt.Sprintf("panic: %v\n\n%s", reason, allGoroutineStacks())
+	)
+
+	if err := fs.WriteFile(path, []byte(content), 0600); err != nil {
+		return "", fmt.Errorf("unable to write crash dump %q: %w", path, err)
+	}
+
+	return path, pruneFiles(fs, crashDumpPrefix, maxCrashDumps)
+}
+
+// AddCrashDumpPanicHandler registers a panic handler which persists a crash dump for panics which are handled by
+// utilruntime.HandleCrash, e.g., panics of reconcilers which are recovered by controller-runtime.
+func AddCrashDumpPanicHandler(log logr.Logger, fs afero.Afero, clock clock.PassiveClock) {
+	utilruntime.PanicHandlers = append(utilruntime.PanicHandlers, func(reason interface{}) {
+		path, err := PersistCrashDump(fs, clock, reason)
+		if err != nil {
+			log.Error(err, "Failed persisting crash dump")
+			return
+		}
+		log.Info("Persisted crash dump", "path", path)
+	})
+}
+
+func allGoroutineStacks() []byte {
+	buf := make([]byte, 1<<16)
+	for {
+		n := runtime.Stack(buf, true)
+		if n < len(buf) {
+			return buf[:n]
+		}
+		buf = make([]byte, 2*len(buf))
+	}
+}
+
+// pruneFiles removes the oldest files with the given prefix from the diagnostics directory so that at most maxFiles of
+// them are kept.
+func pruneFiles(fs afero.Afero, prefix string, maxFiles int) error {
+	return prune(fs, prefix, maxFiles, false)
+}
+
+// pruneDirectories removes the oldest directories with the given prefix (including their contents) from the
+// diagnostics directory so that at most maxDirectories of them are kept.
+func pruneDirectories(fs afero.Afero, prefix string, maxDirectories int) error {
+	return prune(fs, prefix, maxDirectories, true)
+}
+
+func prune(fs afero.Afero, prefix string, maxEntries int, directories bool) error {
+	entries, err := fs.ReadDir(Dir)
+	if err != nil {
+		return fmt.Errorf("unable to read diagnostics directory %q: %w", Dir, err)
+	}
+
+	var names []string
+	for _, entry := range entries {
+		if entry.IsDir() == directories && (directories || entry.Mode().IsRegular()) && strings.HasPrefix(entry.Name(), prefix) {
+			names = append(names, entry.Name())
+		}
+	}
+
+	if len(names) <= maxEntries {
+		return nil
+	}
+
+	// The names contain sortable timestamps, hence the oldest entries come first.
+	sort.Strings(names)
+	for _, name := range names[:len(names)-maxEntries] {
+		if err := fs.RemoveAll(filepath.Join(Dir, name)); err != nil {
+			return fmt.Errorf("unable to remove old entry %q: %w", name, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,79 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package diagnostics_test
+
+import (
+	"path/filepath"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/afero"
+	testclock "k8s.io/utils/clock/testing"
+
+	. "github.com/gardener/gardener/pkg/nodeagent/diagnostics"
+)
+
+var _ = Describe("CrashDump", func() {
+	var (
+		fs        afero.Afero
+		fakeClock *testclock.FakeClock
+	)
+
+	BeforeEach(func() {
+		fs = afero.Afero{Fs: afero.NewMemMapFs()}
+		fakeClock = testclock.NewFakeClock(time.Date(2023, 11, 1, 10, 0, 0, 0, time.UTC))
+	})
+
+	Describe("#PersistCrashDump", func() {
+		It("should write the panic reason and the goroutine stacks", func() {
+			path, err := PersistCrashDump(fs, fakeClock, "boom")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(path).To(Equal(filepath.Join(Dir, "crash-20231101T100000.000000000Z.log")))
+
+			content, err := fs.ReadFile(path)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(content)).To(HavePrefix("panic: boom\n\n"))
+			Expect(string(content)).To(ContainSubstring("goroutine"))
+		})
+
+		It("should only keep the most recent crash dumps", func() {
+			Expect(fs.MkdirAll(Dir, 0700)).To(Succeed())
+			Expect(fs.WriteFile(filepath.Join(Dir, "capture-foo"), []byte("foo"), 0600)).To(Succeed())
+
+			var paths []string
+			for i := 0; i < 7; i++ {
+				path, err := PersistCrashDump(fs, fakeClock, "boom")
+				Expect(err).NotTo(HaveOccurred())
+				paths = append(paths, path)
+				fakeClock.Step(time.Second)
+			}
+
+			entries, err := fs.ReadDir(Dir)
+			Expect(err).NotTo(HaveOccurred())
+
+			var names []string
+			for _, entry := range entries {
+				names = append(names, entry.Name())
+			}
+
+			expected := []string{"capture-foo"}
+			for _, path := range paths[2:] {
+				expected = append(expected, filepath.Base(path))
+			}
+			Expect(names).To(ConsistOf(expected))
+		})
+	})
+})
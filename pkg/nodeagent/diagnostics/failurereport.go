@@ -0,0 +1,139 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package diagnostics
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/spf13/afero"
+)
+
+const (
+	failureReportPrefix = "failure-"
+	// maxFailureReports is the number of failure reports which are kept in the diagnostics directory. Older ones are
+	// removed when a new failure report is persisted.
+	maxFailureReports = 5
+
+	// MaxFailureReportSize is the maximum size in bytes of a marshalled failure report. Journal excerpts are dropped
+	// (starting with the largest one) until the report fits.
+	MaxFailureReportSize = 64 * 1024
+	// MaxJournalExcerptSize is the maximum size in bytes of the journal excerpt of a single unit. Only the most recent
+	// lines are kept.
+	MaxJournalExcerptSize = 8 * 1024
+	// maxErrorMessageSize is the maximum size in bytes of a single message of the error chain.
+	maxErrorMessageSize = 4 * 1024
+)
+
+// FailureReport is a structured report about a failed reconciliation which is meant to speed up the analysis of broken
+// nodes.
+type FailureReport struct {
+	// Time is the time the reconciliation failed.
+	Time time.Time `json:"time"`
+	// Controller is the name of the controller whose reconciliation failed.
+	Controller string `json:"controller"`
+	// Object is the key of the reconciled object.
+	Object string `json:"object"`
+	// Phase is the step the reconciliation was in when it failed.
+	Phase string `json:"phase,omitempty"`
+	// Errors is the chain of errors, starting with the outermost one.
+	Errors []string `json:"errors"`
+	// OperatingSystemConfigChecksum is the checksum of the operating system config which was applied.
+	OperatingSystemConfigChecksum string `json:"operatingSystemConfigChecksum,omitempty"`
+	// UnitJournals contains the most recent journal lines of the units affected by the reconciliation.
+	UnitJournals map[string]string `json:"unitJournals,omitempty"`
+	// Truncated is true if parts of the report were dropped to comply with the size limit.
+	Truncated bool `json:"truncated,omitempty"`
+}
+
+// ErrorChain returns the messages of the given error and of all errors it wraps, starting with the outermost one.
+func ErrorChain(err error) []string {
+	var chain []string
+	for ; err != nil; err = errors.Unwrap(err) {
+		chain = append(chain, err.Error())
+	}
+	return chain
+}
+
+// Marshal marshals the report to JSON. Overly long error messages and journal excerpts are truncated, and journal
+// excerpts are dropped if the report would exceed MaxFailureReportSize otherwise.
+func (r *FailureReport) Marshal() ([]byte, error) {
+	report := *r
+
+	report.Errors = make([]string, 0, len(r.Errors))
+	for _, message := range r.Errors {
+		if len(message) > maxErrorMessageSize {
+			message = message[:maxErrorMessageSize]
+			report.Truncated = true
+		}
+		report.Errors = append(report.Errors, message)
+	}
+
+	if r.UnitJournals != nil {
+		report.UnitJournals = make(map[string]string, len(r.UnitJournals))
+		for unit, excerpt := range r.UnitJournals {
+			if len(excerpt) > MaxJournalExcerptSize {
+				// Keep the tail of the journal since the most recent lines are the interesting ones.
+				excerpt = excerpt[len(excerpt)-MaxJournalExcerptSize:]
+				report.Truncated = true
+			}
+			report.UnitJournals[unit] = excerpt
+		}
+	}
+
+	for {
+		data, err := json.MarshalIndent(report, "", "  ")
+		if err != nil {
+			return nil, err
+		}
+
+		if len(data) <= MaxFailureReportSize || len(report.UnitJournals) == 0 {
+			return data, nil
+		}
+
+		var largestUnit string
+		for unit, excerpt := range report.UnitJournals {
+			if largestUnit == "" || len(excerpt) > len(report.UnitJournals[largestUnit]) || (len(excerpt) == len(report.UnitJournals[largestUnit]) && unit < largestUnit) {
+				largestUnit = unit
+			}
+		}
+		delete(report.UnitJournals, largestUnit)
+		report.Truncated = true
+	}
+}
+
+// PersistFailureReport writes the given failure report to a file in the diagnostics directory and returns its path.
+// Only the most recent failure reports are kept.
+func PersistFailureReport(fs afero.Afero, report *FailureReport) (string, error) {
+	data, err := report.Marshal()
+	if err != nil {
+		return "", fmt.Errorf("unable to marshal failure report: %w", err)
+	}
+
+	if err := fs.MkdirAll(Dir, os.ModeDir|0700); err != nil {
+		return "", fmt.Errorf("unable to create diagnostics directory %q: %w", Dir, err)
+	}
+
+	path := filepath.Join(Dir, failureReportPrefix+report.Time.UTC().Format(timestampFormat)+".json")
+	if err := fs.WriteFile(path, data, 0600); err != nil {
+		return "", fmt.Errorf("unable to write failure report %q: %w", path, err)
+	}
+
+	return path, pruneFiles(fs, failureReportPrefix, maxFailureReports)
+}
@@ -0,0 +1,146 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package diagnostics_test
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"strings"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/afero"
+
+	. "github.com/gardener/gardener/pkg/nodeagent/diagnostics"
+)
+
+var _ = Describe("FailureReport", func() {
+	var (
+		fs     afero.Afero
+		now    time.Time
+		report *FailureReport
+	)
+
+	BeforeEach(func() {
+		fs = afero.Afero{Fs: afero.NewMemMapFs()}
+		now = time.Date(2023, 11, 1, 10, 0, 0, 0, time.UTC)
+
+		report = &FailureReport{
+			Time:                          now,
+			Controller:                    "operatingsystemconfig",
+			Object:                        "kube-system/osc-secret",
+			Phase:                         "Executing unit commands (start/stop)",
+			Errors:                        []string{"failed executing unit commands: unable to restart unit", "unable to restart unit"},
+			OperatingSystemConfigChecksum: "abc123",
+			UnitJournals:                  map[string]string{"kubelet.service": "kubelet failed"},
+		}
+	})
+
+	Describe("#ErrorChain", func() {
+		It("should return the messages of all wrapped errors", func() {
+			err := fmt.Errorf("outer: %w", fmt.Errorf("middle: %w", errors.New("inner")))
+
+			Expect(ErrorChain(err)).To(Equal([]string{"outer: middle: inner", "middle: inner", "inner"}))
+		})
+
+		It("should return nil for a nil error", func() {
+			Expect(ErrorChain(nil)).To(BeNil())
+		})
+	})
+
+	Describe("#Marshal", func() {
+		It("should marshal the report without truncation", func() {
+			data, err := report.Marshal()
+			Expect(err).NotTo(HaveOccurred())
+
+			actual := &FailureReport{}
+			Expect(json.Unmarshal(data, actual)).To(Succeed())
+			Expect(actual).To(Equal(report))
+		})
+
+		It("should keep the tail of overly long journal excerpts", func() {
+			report.UnitJournals["kubelet.service"] = strings.Repeat("a", MaxJournalExcerptSize) + "last line"
+
+			data, err := report.Marshal()
+			Expect(err).NotTo(HaveOccurred())
+
+			actual := &FailureReport{}
+			Expect(json.Unmarshal(data, actual)).To(Succeed())
+			Expect(actual.Truncated).To(BeTrue())
+			Expect(actual.UnitJournals["kubelet.service"]).To(HaveLen(MaxJournalExcerptSize))
+			Expect(actual.UnitJournals["kubelet.service"]).To(HaveSuffix("last line"))
+			Expect(report.Truncated).To(BeFalse())
+		})
+
+		It("should drop the largest journal excerpts if the report exceeds the size limit", func() {
+			report.UnitJournals = map[string]string{"small.service": "small"}
+			for i := 0; i < 10; i++ {
+				report.UnitJournals[fmt.Sprintf("unit-%d.service", i)] = strings.Repeat("a", MaxJournalExcerptSize)
+			}
+
+			data, err := report.Marshal()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(len(data)).To(BeNumerically("<=", MaxFailureReportSize))
+
+			actual := &FailureReport{}
+			Expect(json.Unmarshal(data, actual)).To(Succeed())
+			Expect(actual.Truncated).To(BeTrue())
+			Expect(actual.UnitJournals).To(HaveKeyWithValue("small.service", "small"))
+			Expect(len(actual.UnitJournals)).To(BeNumerically("<", 11))
+		})
+	})
+
+	Describe("#PersistFailureReport", func() {
+		It("should write the report to the diagnostics directory", func() {
+			path, err := PersistFailureReport(fs, report)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(path).To(Equal(filepath.Join(Dir, "failure-20231101T100000.000000000Z.json")))
+
+			content, err := fs.ReadFile(path)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(content)).To(ContainSubstring(`"phase": "Executing unit commands (start/stop)"`))
+		})
+
+		It("should only keep the most recent failure reports", func() {
+			Expect(fs.MkdirAll(Dir, 0700)).To(Succeed())
+			Expect(fs.WriteFile(filepath.Join(Dir, "crash-foo.log"), []byte("foo"), 0600)).To(Succeed())
+
+			var paths []string
+			for i := 0; i < 7; i++ {
+				report.Time = now.Add(time.Duration(i) * time.Second)
+				path, err := PersistFailureReport(fs, report)
+				Expect(err).NotTo(HaveOccurred())
+				paths = append(paths, path)
+			}
+
+			entries, err := fs.ReadDir(Dir)
+			Expect(err).NotTo(HaveOccurred())
+
+			var names []string
+			for _, entry := range entries {
+				names = append(names, entry.Name())
+			}
+
+			expected := []string{"crash-foo.log"}
+			for _, path := range paths[2:] {
+				expected = append(expected, filepath.Base(path))
+			}
+			Expect(names).To(ConsistOf(expected))
+		})
+	})
+})
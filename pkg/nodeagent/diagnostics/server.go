@@ -0,0 +1,208 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package diagnostics
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"runtime/pprof"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/spf13/afero"
+	"k8s.io/utils/clock"
+
+	"github.com/gardener/gardener/pkg/controllerutils/routes"
+	nodeagentv1alpha1 "github.com/gardener/gardener/pkg/nodeagent/apis/config/v1alpha1"
+)
+
+const (
+	// Dir is the directory in the state directory of gardener-node-agent which contains the captured diagnostics and
+	// the crash dumps.
+	Dir = nodeagentv1alpha1.BaseDir + "/diagnostics"
+
+	// PathReconcileState is the path of the endpoint serving the currently active reconciliations.
+	PathReconcileState = "/debug/reconcile-state"
+	// PathCapture is the path of the endpoint capturing the diagnostics to the diagnostics directory.
+	PathCapture = "/debug/capture"
+
+	capturePrefix = "capture-"
+	// maxCaptures is the number of captures which are kept in the diagnostics directory. Older ones are removed when a
+	// new capture is written.
+	maxCaptures = 5
+
+	fileNameGoroutines     = "goroutines.txt"
+	fileNameHeap           = "heap.pprof"
+	fileNameReconcileState = "reconcile-state.json"
+
+	timestampFormat = "20060102T150405.000000000Z"
+)
+
+// Server is a local diagnostics server. It listens on a unix socket which is only accessible by its owner and serves
+// the pprof endpoints, the currently active reconciliations, and an endpoint for capturing the goroutine and heap
+// profiles together with the active reconciliations to the diagnostics directory on demand.
+type Server struct {
+	// Log is the logger.
+	Log logr.Logger
+	// FS is the file system used for writing captures.
+	FS afero.Afero
+	// Clock is the clock.
+	Clock clock.PassiveClock
+	// State is the state of the active reconciliations.
+	State *ReconcileState
+	// SocketPath is the path of the unix socket the server listens on.
+	SocketPath string
+}
+
+// Start starts the server and blocks until the given context is cancelled. It implements manager.Runnable.
+func (s *Server) Start(ctx context.Context) error {
+	listener, err := s.listen()
+	if err != nil {
+		return err
+	}
+
+	server := &http.Server{
+		Handler:           s.Handler(),
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	go func() {
+		<-ctx.Done()
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		if err := server.Shutdown(shutdownCtx); err != nil {
+			s.Log.Error(err, "Failed shutting down diagnostics server")
+		}
+	}()
+
+	s.Log.Info("Starting diagnostics server", "address", listener.Addr().String())
+	if err := server.Serve(listener); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		return fmt.Errorf("failed serving diagnostics server: %w", err)
+	}
+	return nil
+}
+
+func (s *Server) listen() (net.Listener, error) {
+	if s.SocketPath == "" {
+		return nil, fmt.Errorf("socket path of the diagnostics server must not be empty")
+	}
+
+	// A stale socket file of a previous run prevents listening on the socket.
+	if err := os.Remove(s.SocketPath); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return nil, fmt.Errorf("failed removing stale socket %q: %w", s.SocketPath, err)
+	}
+	if err := os.MkdirAll(filepath.Dir(s.SocketPath), os.ModeDir|0700); err != nil {
+		return nil, fmt.Errorf("failed creating directory for socket %q: %w", s.SocketPath, err)
+	}
+
+	listener, err := net.Listen("unix", s.SocketPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed listening on socket %q: %w", s.SocketPath, err)
+	}
+
+	if err := os.Chmod(s.SocketPath, 0600); err != nil {
+		return nil, errors.Join(fmt.Errorf("failed restricting permissions of socket %q: %w", s.SocketPath, err), listener.Close())
+	}
+
+	return listener, nil
+}
+
+// Handler returns the HTTP handler serving the diagnostics endpoints.
+func (s *Server) Handler() http.Handler {
+	mux := http.NewServeMux()
+
+	for path, handler := range routes.ProfilingHandlers {
+		mux.Handle(path, handler)
+	}
+
+	mux.HandleFunc(PathReconcileState, func(w http.ResponseWriter, _ *http.Request) {
+		writeJSON(w, http.StatusOK, s.State.Active())
+	})
+
+	mux.HandleFunc(PathCapture, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			w.Header().Set("Allow", http.MethodPost)
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+
+		directory, err := s.Capture()
+		if err != nil {
+			s.Log.Error(err, "Failed capturing diagnostics")
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		s.Log.Info("Captured diagnostics", "directory", directory)
+		writeJSON(w, http.StatusOK, map[string]string{"directory": directory})
+	})
+
+	return mux
+}
+
+// Capture writes the stack traces of all goroutines, the heap profile, and the currently active reconciliations into
+// a new directory below the diagnostics directory and returns its path. Only the most recent captures are kept.
+func (s *Server) Capture() (string, error) {
+	directory := filepath.Join(Dir, capturePrefix+s.Clock.Now().UTC().Format(timestampFormat))
+	if err := s.FS.MkdirAll(directory, os.ModeDir|0700); err != nil {
+		return "", fmt.Errorf("unable to create capture directory %q: %w", directory, err)
+	}
+
+	var goroutines bytes.Buffer
+	if err := pprof.Lookup("goroutine").WriteTo(&goroutines, 2); err != nil {
+		return "", fmt.Errorf("unable to capture goroutines: %w", err)
+	}
+
+	var heap bytes.Buffer
+	if err := pprof.Lookup("heap").WriteTo(&heap, 0); err != nil {
+		return "", fmt.Errorf("unable to capture heap profile: %w", err)
+	}
+
+	reconcileState, err := json.MarshalIndent(s.State.Active(), "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("unable to marshal reconcile state: %w", err)
+	}
+
+	for name, data := range map[string][]byte{
+		fileNameGoroutines:     goroutines.Bytes(),
+		fileNameHeap:           heap.Bytes(),
+		fileNameReconcileState: reconcileState,
+	} {
+		if err := s.FS.WriteFile(filepath.Join(directory, name), data, 0600); err != nil {
+			return "", fmt.Errorf("unable to write %q: %w", name, err)
+		}
+	}
+
+	return directory, pruneDirectories(s.FS, capturePrefix, maxCaptures)
+}
+
+func writeJSON(w http.ResponseWriter, statusCode int, obj interface{}) {
+	data, err := json.Marshal(obj)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+	_, _ = w.Write(data)
+}
@@ -0,0 +1,132 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package diagnostics_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"time"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/afero"
+	testclock "k8s.io/utils/clock/testing"
+
+	. "github.com/gardener/gardener/pkg/nodeagent/diagnostics"
+)
+
+var _ = Describe("Server", func() {
+	var (
+		fs        afero.Afero
+		fakeClock *testclock.FakeClock
+		state     *ReconcileState
+		server    *Server
+		handler   http.Handler
+		now       = time.Date(2023, 11, 1, 10, 0, 0, 0, time.UTC)
+	)
+
+	BeforeEach(func() {
+		fs = afero.Afero{Fs: afero.NewMemMapFs()}
+		fakeClock = testclock.NewFakeClock(now)
+		state = NewReconcileState(fakeClock)
+		server = &Server{
+			Log:   logr.Discard(),
+			FS:    fs,
+			Clock: fakeClock,
+			State: state,
+		}
+		handler = server.Handler()
+
+		DeferCleanup(state.Start("operatingsystemconfig", "kube-system/osc"))
+	})
+
+	It("should serve the active reconciliations", func() {
+		recorder := httptest.NewRecorder()
+		handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, PathReconcileState, nil))
+
+		Expect(recorder.Code).To(Equal(http.StatusOK))
+		var reconciliations []Reconciliation
+		Expect(json.Unmarshal(recorder.Body.Bytes(), &reconciliations)).To(Succeed())
+		Expect(reconciliations).To(ConsistOf(Reconciliation{Controller: "operatingsystemconfig", Object: "kube-system/osc", StartTime: now}))
+	})
+
+	It("should serve the pprof index", func() {
+		recorder := httptest.NewRecorder()
+		handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/debug/pprof/", nil))
+
+		Expect(recorder.Code).To(Equal(http.StatusOK))
+		Expect(recorder.Body.String()).To(ContainSubstring("goroutine"))
+	})
+
+	It("should reject captures with other methods than POST", func() {
+		recorder := httptest.NewRecorder()
+		handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, PathCapture, nil))
+
+		Expect(recorder.Code).To(Equal(http.StatusMethodNotAllowed))
+	})
+
+	It("should capture the diagnostics to the diagnostics directory", func() {
+		recorder := httptest.NewRecorder()
+		handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodPost, PathCapture, nil))
+
+		Expect(recorder.Code).To(Equal(http.StatusOK))
+		var response map[string]string
+		Expect(json.Unmarshal(recorder.Body.Bytes(), &response)).To(Succeed())
+
+		directory := response["directory"]
+		Expect(directory).To(Equal(filepath.Join(Dir, "capture-20231101T100000.000000000Z")))
+
+		goroutines, err := fs.ReadFile(filepath.Join(directory, "goroutines.txt"))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(goroutines)).To(ContainSubstring("goroutine"))
+
+		heap, err := fs.ReadFile(filepath.Join(directory, "heap.pprof"))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(heap).NotTo(BeEmpty())
+
+		reconcileState, err := fs.ReadFile(filepath.Join(directory, "reconcile-state.json"))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(reconcileState)).To(ContainSubstring(`"object": "kube-system/osc"`))
+	})
+
+	It("should only keep the most recent captures", func() {
+		Expect(fs.WriteFile(filepath.Join(Dir, "crash-20231101T090000.000000000Z.log"), []byte("crash"), 0600)).To(Succeed())
+
+		var directories []string
+		for i := 0; i < 7; i++ {
+			directory, err := server.Capture()
+			Expect(err).NotTo(HaveOccurred())
+			directories = append(directories, directory)
+			fakeClock.Step(time.Minute)
+		}
+
+		for _, directory := range directories[:2] {
+			Expect(fs.DirExists(directory)).To(BeFalse())
+			Expect(fs.Exists(filepath.Join(directory, "goroutines.txt"))).To(BeFalse())
+		}
+		for _, directory := range directories[2:] {
+			Expect(fs.DirExists(directory)).To(BeTrue())
+		}
+		Expect(fs.Exists(filepath.Join(Dir, "crash-20231101T090000.000000000Z.log"))).To(BeTrue())
+	})
+
+	It("should fail to start without socket path", func() {
+		Expect(server.Start(context.Background())).To(MatchError(ContainSubstring("socket path of the diagnostics server must not be empty")))
+	})
+})
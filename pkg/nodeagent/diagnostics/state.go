@@ -0,0 +1,128 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package diagnostics
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"k8s.io/utils/clock"
+)
+
+// Reconciliation describes a reconciliation which is currently active.
+type Reconciliation struct {
+	// Controller is the name of the controller performing the reconciliation.
+	Controller string `json:"controller"`
+	// Object is the key of the reconciled object.
+	Object string `json:"object"`
+	// Step is the step the reconciliation is currently in.
+	Step string `json:"step,omitempty"`
+	// StartTime is the time the reconciliation was started.
+	StartTime time.Time `json:"startTime"`
+	// StepStartTime is the time the current step was started.
+	StepStartTime *time.Time `json:"stepStartTime,omitempty"`
+}
+
+// ReconcileState records the reconciliations which are currently active and the steps they are in. It is safe for
+// concurrent use. All methods can be called on a nil *ReconcileState, in which case nothing is recorded.
+type ReconcileState struct {
+	clock clock.PassiveClock
+
+	lock   sync.RWMutex
+	active map[string]*Reconciliation
+}
+
+// NewReconcileState creates a new ReconcileState.
+func NewReconcileState(clock clock.PassiveClock) *ReconcileState {
+	return &ReconcileState{
+		clock:  clock,
+		active: make(map[string]*Reconciliation),
+	}
+}
+
+// Start records that the given controller started reconciling the given object. The returned function must be called
+// when the reconciliation is finished.
+func (s *ReconcileState) Start(controller, object string) func() {
+	if s == nil {
+		return func() {}
+	}
+
+	key := stateKey(controller, object)
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.active[key] = &Reconciliation{
+		Controller: controller,
+		Object:     object,
+		StartTime:  s.clock.Now().UTC(),
+	}
+
+	return func() {
+		s.lock.Lock()
+		defer s.lock.Unlock()
+
+		delete(s.active, key)
+	}
+}
+
+// SetStep records that the reconciliation of the given object by the given controller entered the given step. It is a
+// no-op if the reconciliation was not started.
+func (s *ReconcileState) SetStep(controller, object, step string) {
+	if s == nil {
+		return
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	reconciliation, ok := s.active[stateKey(controller, object)]
+	if !ok {
+		return
+	}
+
+	now := s.clock.Now().UTC()
+	reconciliation.Step = step
+	reconciliation.StepStartTime = &now
+}
+
+// Active returns the currently active reconciliations sorted by controller and object.
+func (s *ReconcileState) Active() []Reconciliation {
+	result := []Reconciliation{}
+	if s == nil {
+		return result
+	}
+
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	for _, reconciliation := range s.active {
+		result = append(result, *reconciliation)
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Controller != result[j].Controller {
+			return result[i].Controller < result[j].Controller
+		}
+		return result[i].Object < result[j].Object
+	})
+
+	return result
+}
+
+func stateKey(controller, object string) string {
+	return controller + "/" + object
+}
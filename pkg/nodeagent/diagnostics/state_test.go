@@ -0,0 +1,76 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package diagnostics_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	testclock "k8s.io/utils/clock/testing"
+
+	. "github.com/gardener/gardener/pkg/nodeagent/diagnostics"
+)
+
+var _ = Describe("ReconcileState", func() {
+	var (
+		fakeClock *testclock.FakeClock
+		state     *ReconcileState
+		now       = time.Date(2023, 11, 1, 10, 0, 0, 0, time.UTC)
+	)
+
+	BeforeEach(func() {
+		fakeClock = testclock.NewFakeClock(now)
+		state = NewReconcileState(fakeClock)
+	})
+
+	It("should record the active reconciliations and their steps", func() {
+		doneOSC := state.Start("operatingsystemconfig", "kube-system/osc")
+		doneToken := state.Start("token", "kube-system/token")
+
+		fakeClock.Step(time.Minute)
+		state.SetStep("operatingsystemconfig", "kube-system/osc", "Applying new or changed files")
+
+		stepStartTime := now.Add(time.Minute)
+		Expect(state.Active()).To(Equal([]Reconciliation{
+			{Controller: "operatingsystemconfig", Object: "kube-system/osc", Step: "Applying new or changed files", StartTime: now, StepStartTime: &stepStartTime},
+			{Controller: "token", Object: "kube-system/token", StartTime: now},
+		}))
+
+		doneOSC()
+		Expect(state.Active()).To(Equal([]Reconciliation{
+			{Controller: "token", Object: "kube-system/token", StartTime: now},
+		}))
+
+		doneToken()
+		Expect(state.Active()).To(BeEmpty())
+	})
+
+	It("should ignore steps of reconciliations which were not started", func() {
+		state.SetStep("operatingsystemconfig", "kube-system/osc", "Applying new or changed files")
+
+		Expect(state.Active()).To(BeEmpty())
+	})
+
+	It("should do nothing for a nil state", func() {
+		var nilState *ReconcileState
+
+		done := nilState.Start("operatingsystemconfig", "kube-system/osc")
+		nilState.SetStep("operatingsystemconfig", "kube-system/osc", "Applying new or changed files")
+		done()
+
+		Expect(nilState.Active()).To(BeEmpty())
+	})
+})
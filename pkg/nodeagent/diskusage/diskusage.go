@@ -0,0 +1,123 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package diskusage
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+
+	"github.com/spf13/afero"
+)
+
+// DirectorySize returns the total size of all regular files in the given directory and its subdirectories. If the
+// directory does not exist, 0 is returned.
+func DirectorySize(fs afero.Afero, directory string) (int64, error) {
+	files, err := regularFiles(fs, directory)
+	if err != nil {
+		return 0, err
+	}
+
+	var size int64
+	for _, file := range files {
+		size += file.Size()
+	}
+
+	return size, nil
+}
+
+// FilesSize returns the total size of the given files. Files which do not exist are skipped.
+func FilesSize(fs afero.Afero, filePaths []string) (int64, error) {
+	var size int64
+
+	for _, filePath := range filePaths {
+		fileInfo, err := fs.Stat(filePath)
+		if err != nil {
+			if errors.Is(err, afero.ErrFileNotFound) {
+				continue
+			}
+			return 0, fmt.Errorf("failed reading file info of %q: %w", filePath, err)
+		}
+
+		if fileInfo.Mode().IsRegular() {
+			size += fileInfo.Size()
+		}
+	}
+
+	return size, nil
+}
+
+// EvictLeastRecentlyUsed removes the regular files in the given directory and its subdirectories in the order of their
+// modification time (oldest first) until at least the given number of bytes has been freed or no file is left. It
+// returns the number of removed files and the number of freed bytes.
+func EvictLeastRecentlyUsed(fs afero.Afero, directory string, bytes int64) (int, int64, error) {
+	files, err := regularFiles(fs, directory)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	sort.SliceStable(files, func(i, j int) bool {
+		return files[i].ModTime().Before(files[j].ModTime())
+	})
+
+	var (
+		removedFiles int
+		freedBytes   int64
+	)
+
+	for _, file := range files {
+		if freedBytes >= bytes {
+			break
+		}
+
+		if err := fs.Remove(file.path); err != nil && !errors.Is(err, afero.ErrFileNotFound) {
+			return removedFiles, freedBytes, fmt.Errorf("failed removing file %q: %w", file.path, err)
+		}
+
+		removedFiles++
+		freedBytes += file.Size()
+	}
+
+	return removedFiles, freedBytes, nil
+}
+
+type fileInfoWithPath struct {
+	os.FileInfo
+	path string
+}
+
+func regularFiles(fs afero.Afero, directory string) ([]fileInfoWithPath, error) {
+	var files []fileInfoWithPath
+
+	if err := fs.Walk(directory, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			if errors.Is(err, afero.ErrFileNotFound) {
+				return nil
+			}
+			return err
+		}
+
+		if info.Mode().IsRegular() {
+			files = append(files, fileInfoWithPath{FileInfo: info, path: path})
+		}
+
+		return nil
+	}); err != nil {
+		return nil, fmt.Errorf("failed walking directory %q: %w", directory, err)
+	}
+
+	return files, nil
+}
@@ -0,0 +1,100 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package diskusage_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/afero"
+
+	. "github.com/gardener/gardener/pkg/nodeagent/diskusage"
+)
+
+var _ = Describe("DiskUsage", func() {
+	var (
+		fakeFS    afero.Afero
+		directory = "/var/lib/cache"
+	)
+
+	BeforeEach(func() {
+		fakeFS = afero.Afero{Fs: afero.NewMemMapFs()}
+	})
+
+	writeFile := func(path string, size int, modTime time.Time) {
+		ExpectWithOffset(1, fakeFS.WriteFile(path, make([]byte, size), 0600)).To(Succeed())
+		ExpectWithOffset(1, fakeFS.Chtimes(path, modTime, modTime)).To(Succeed())
+	}
+
+	Describe("#DirectorySize", func() {
+		It("should return 0 if the directory does not exist", func() {
+			Expect(DirectorySize(fakeFS, directory)).To(BeZero())
+		})
+
+		It("should sum up the sizes of all files in the directory and its subdirectories", func() {
+			writeFile(directory+"/foo", 10, time.Now())
+			writeFile(directory+"/bar/baz", 20, time.Now())
+
+			Expect(DirectorySize(fakeFS, directory)).To(Equal(int64(30)))
+		})
+	})
+
+	Describe("#FilesSize", func() {
+		It("should sum up the sizes of the existing files", func() {
+			writeFile("/etc/foo", 5, time.Now())
+			writeFile("/etc/bar", 7, time.Now())
+
+			Expect(FilesSize(fakeFS, []string{"/etc/foo", "/etc/bar", "/etc/does-not-exist"})).To(Equal(int64(12)))
+		})
+	})
+
+	Describe("#EvictLeastRecentlyUsed", func() {
+		var now = time.Date(2023, 10, 1, 12, 0, 0, 0, time.UTC)
+
+		BeforeEach(func() {
+			writeFile(directory+"/recent", 10, now)
+			writeFile(directory+"/old", 10, now.Add(-2*time.Hour))
+			writeFile(directory+"/older", 10, now.Add(-3*time.Hour))
+		})
+
+		It("should not remove anything if no bytes must be freed", func() {
+			removedFiles, freedBytes, err := EvictLeastRecentlyUsed(fakeFS, directory, 0)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(removedFiles).To(BeZero())
+			Expect(freedBytes).To(BeZero())
+			Expect(DirectorySize(fakeFS, directory)).To(Equal(int64(30)))
+		})
+
+		It("should remove the least recently used files until enough bytes are freed", func() {
+			removedFiles, freedBytes, err := EvictLeastRecentlyUsed(fakeFS, directory, 15)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(removedFiles).To(Equal(2))
+			Expect(freedBytes).To(Equal(int64(20)))
+
+			Expect(fakeFS.Exists(directory + "/older")).To(BeFalse())
+			Expect(fakeFS.Exists(directory + "/old")).To(BeFalse())
+			Expect(fakeFS.Exists(directory + "/recent")).To(BeTrue())
+		})
+
+		It("should remove all files if more bytes must be freed than available", func() {
+			removedFiles, freedBytes, err := EvictLeastRecentlyUsed(fakeFS, directory, 100)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(removedFiles).To(Equal(3))
+			Expect(freedBytes).To(Equal(int64(30)))
+			Expect(DirectorySize(fakeFS, directory)).To(BeZero())
+		})
+	})
+})
@@ -0,0 +1,196 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package journal
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/afero"
+
+	nodeagentv1alpha1 "github.com/gardener/gardener/pkg/nodeagent/apis/config/v1alpha1"
+)
+
+const (
+	// Dir is the directory containing the intent records of the journal.
+	Dir = nodeagentv1alpha1.BaseDir + "/journal"
+
+	suffixIntent    = ".intent"
+	suffixTemporary = ".tmp"
+)
+
+// intent is a write-ahead record describing that the content of TemporaryPath shall replace the file at Path.
+type intent struct {
+	Path          string `json:"path"`
+	TemporaryPath string `json:"temporaryPath"`
+	Checksum      string `json:"checksum"`
+}
+
+// WriteFile writes the given data crash-consistently to the given path, i.e., readers either observe the old or the
+// new content of the file but never a partially written one, even after a power loss. The data is written to a
+// temporary file first, then an intent record is persisted in the journal directory, and finally the temporary file is
+// atomically renamed to the target path. Interrupted writes are completed or rolled back by Recover.
+func WriteFile(fs afero.Afero, path string, data []byte, perm os.FileMode) error {
+	var (
+		temporaryPath = path + suffixTemporary
+		record        = intent{Path: path, TemporaryPath: temporaryPath, Checksum: checksum(data)}
+		intentPath    = intentPathFor(path)
+	)
+
+	if err := fs.MkdirAll(filepath.Dir(path), os.ModeDir|0755); err != nil {
+		return fmt.Errorf("unable to create directory for %q: %w", path, err)
+	}
+	if err := fs.MkdirAll(Dir, os.ModeDir|0700); err != nil {
+		return fmt.Errorf("unable to create journal directory %q: %w", Dir, err)
+	}
+
+	if err := writeAndSync(fs, temporaryPath, data, perm); err != nil {
+		return fmt.Errorf("unable to write temporary file %q: %w", temporaryPath, err)
+	}
+
+	recordRaw, err := json.Marshal(record)
+	if err != nil {
+		return fmt.Errorf("unable to marshal intent record for %q: %w", path, err)
+	}
+
+	// The intent record itself is written via a temporary file and renamed so that it is either complete or absent.
+	if err := writeAndSync(fs, intentPath+suffixTemporary, recordRaw, 0600); err != nil {
+		return fmt.Errorf("unable to write intent record for %q: %w", path, err)
+	}
+	if err := fs.Rename(intentPath+suffixTemporary, intentPath); err != nil {
+		return fmt.Errorf("unable to commit intent record for %q: %w", path, err)
+	}
+	syncDir(fs, Dir)
+
+	if err := fs.Rename(temporaryPath, path); err != nil {
+		return fmt.Errorf("unable to rename temporary file %q to %q: %w", temporaryPath, path, err)
+	}
+	syncDir(fs, filepath.Dir(path))
+
+	if err := fs.Remove(intentPath); err != nil && !errors.Is(err, afero.ErrFileNotFound) {
+		return fmt.Errorf("unable to remove intent record for %q: %w", path, err)
+	}
+
+	return nil
+}
+
+// Recover completes or rolls back all writes which were interrupted, e.g. by a power loss. It must be called at
+// startup before any of the journaled files are read.
+func Recover(fs afero.Afero) error {
+	entries, err := fs.ReadDir(Dir)
+	if err != nil {
+		if errors.Is(err, afero.ErrFileNotFound) {
+			return nil
+		}
+		return fmt.Errorf("unable to read journal directory %q: %w", Dir, err)
+	}
+
+	for _, entry := range entries {
+		entryPath := filepath.Join(Dir, entry.Name())
+
+		switch {
+		case strings.HasSuffix(entry.Name(), suffixIntent):
+			if err := recoverIntent(fs, entryPath); err != nil {
+				return err
+			}
+
+		case strings.HasSuffix(entry.Name(), suffixIntent+suffixTemporary):
+			// The intent record was never committed, hence the target file was not touched yet.
+			if err := fs.Remove(entryPath); err != nil && !errors.Is(err, afero.ErrFileNotFound) {
+				return fmt.Errorf("unable to remove uncommitted intent record %q: %w", entryPath, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func recoverIntent(fs afero.Afero, intentPath string) error {
+	recordRaw, err := fs.ReadFile(intentPath)
+	if err != nil {
+		return fmt.Errorf("unable to read intent record %q: %w", intentPath, err)
+	}
+
+	record := intent{}
+	if err := json.Unmarshal(recordRaw, &record); err == nil && record.TemporaryPath != "" {
+		data, err := fs.ReadFile(record.TemporaryPath)
+		switch {
+		case errors.Is(err, afero.ErrFileNotFound):
+			// The temporary file was already renamed, i.e., the write was completed.
+		case err != nil:
+			return fmt.Errorf("unable to read temporary file %q: %w", record.TemporaryPath, err)
+		case checksum(data) == record.Checksum:
+			if err := fs.Rename(record.TemporaryPath, record.Path); err != nil {
+				return fmt.Errorf("unable to complete interrupted write of %q: %w", record.Path, err)
+			}
+			syncDir(fs, filepath.Dir(record.Path))
+		default:
+			if err := fs.Remove(record.TemporaryPath); err != nil && !errors.Is(err, afero.ErrFileNotFound) {
+				return fmt.Errorf("unable to roll back interrupted write of %q: %w", record.Path, err)
+			}
+		}
+	}
+
+	if err := fs.Remove(intentPath); err != nil && !errors.Is(err, afero.ErrFileNotFound) {
+		return fmt.Errorf("unable to remove intent record %q: %w", intentPath, err)
+	}
+
+	return nil
+}
+
+func writeAndSync(fs afero.Afero, path string, data []byte, perm os.FileMode) error {
+	file, err := fs.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, perm)
+	if err != nil {
+		return err
+	}
+
+	if _, err := file.Write(data); err != nil {
+		_ = file.Close()
+		return err
+	}
+
+	if err := file.Sync(); err != nil {
+		_ = file.Close()
+		return err
+	}
+
+	return file.Close()
+}
+
+// syncDir persists the directory entries of the given directory on a best-effort basis. Not all file systems support
+// syncing directories, hence errors are ignored.
+func syncDir(fs afero.Afero, dir string) {
+	d, err := fs.Open(dir)
+	if err != nil {
+		return
+	}
+	_ = d.Sync()
+	_ = d.Close()
+}
+
+func intentPathFor(path string) string {
+	return filepath.Join(Dir, checksum([]byte(path))[:16]+suffixIntent)
+}
+
+func checksum(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
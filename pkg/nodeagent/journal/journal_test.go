@@ -0,0 +1,119 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package journal_test
+
+import (
+	"errors"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/afero"
+
+	. "github.com/gardener/gardener/pkg/nodeagent/journal"
+)
+
+var _ = Describe("Journal", func() {
+	var (
+		fs   afero.Afero
+		path = "/var/lib/gardener-node-agent/credentials/token"
+	)
+
+	BeforeEach(func() {
+		fs = afero.Afero{Fs: afero.NewMemMapFs()}
+	})
+
+	interruptWrite := func(data []byte) {
+		crashingFS := afero.Afero{Fs: &failingRenameFs{Fs: fs.Fs, path: path}}
+		Expect(WriteFile(crashingFS, path, data, 0600)).To(MatchError(ContainSubstring("unable to rename temporary file")))
+	}
+
+	Describe("#WriteFile", func() {
+		It("should write the file and leave no journal entries behind", func() {
+			Expect(WriteFile(fs, path, []byte("foo"), 0600)).To(Succeed())
+			Expect(fs.ReadFile(path)).To(Equal([]byte("foo")))
+
+			entries, err := fs.ReadDir(Dir)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(entries).To(BeEmpty())
+			Expect(fs.Exists(path + ".tmp")).To(BeFalse())
+		})
+
+		It("should not touch the existing file if the write is interrupted", func() {
+			Expect(WriteFile(fs, path, []byte("old"), 0600)).To(Succeed())
+
+			interruptWrite([]byte("new"))
+
+			Expect(fs.ReadFile(path)).To(Equal([]byte("old")))
+		})
+	})
+
+	Describe("#Recover", func() {
+		It("should do nothing if the journal directory does not exist", func() {
+			Expect(Recover(fs)).To(Succeed())
+		})
+
+		It("should complete an interrupted write", func() {
+			Expect(WriteFile(fs, path, []byte("old"), 0600)).To(Succeed())
+			interruptWrite([]byte("new"))
+
+			Expect(Recover(fs)).To(Succeed())
+
+			Expect(fs.ReadFile(path)).To(Equal([]byte("new")))
+			Expect(fs.Exists(path + ".tmp")).To(BeFalse())
+			entries, err := fs.ReadDir(Dir)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(entries).To(BeEmpty())
+		})
+
+		It("should roll back an interrupted write whose temporary file is corrupt", func() {
+			Expect(WriteFile(fs, path, []byte("old"), 0600)).To(Succeed())
+			interruptWrite([]byte("new"))
+			Expect(fs.WriteFile(path+".tmp", []byte("ne"), 0600)).To(Succeed())
+
+			Expect(Recover(fs)).To(Succeed())
+
+			Expect(fs.ReadFile(path)).To(Equal([]byte("old")))
+			Expect(fs.Exists(path + ".tmp")).To(BeFalse())
+			entries, err := fs.ReadDir(Dir)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(entries).To(BeEmpty())
+		})
+
+		It("should remove uncommitted intent records", func() {
+			Expect(fs.MkdirAll(Dir, 0700)).To(Succeed())
+			Expect(fs.WriteFile(filepath.Join(Dir, "foo.intent.tmp"), []byte("{"), 0600)).To(Succeed())
+
+			Expect(Recover(fs)).To(Succeed())
+
+			entries, err := fs.ReadDir(Dir)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(entries).To(BeEmpty())
+		})
+	})
+})
+
+// failingRenameFs simulates a crash right before the temporary file is renamed to the given path.
+type failingRenameFs struct {
+	afero.Fs
+	path string
+}
+
+func (f *failingRenameFs) Rename(oldName, newName string) error {
+	if newName == f.path {
+		return errors.New("simulated crash")
+	}
+	return f.Fs.Rename(oldName, newName)
+}
@@ -0,0 +1,72 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metrics
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+
+	"github.com/spf13/afero"
+)
+
+// ClientCertificateTLSOption returns an option for the TLS configuration of the metrics server which requires clients
+// to present a certificate signed by one of the certificate authorities in the given file. The file is read for every
+// handshake since it is only written when the operating system config has been applied for the first time and the
+// certificate authorities might be rotated.
+func ClientCertificateTLSOption(fs afero.Afero, caFile string) func(*tls.Config) {
+	return func(c *tls.Config) {
+		c.ClientAuth = tls.RequireAnyClientCert
+		c.VerifyPeerCertificate = func(rawCerts [][]byte, _ [][]*x509.Certificate) error {
+			return verifyClientCertificate(fs, caFile, rawCerts)
+		}
+	}
+}
+
+func verifyClientCertificate(fs afero.Afero, caFile string, rawCerts [][]byte) error {
+	caBundle, err := fs.ReadFile(caFile)
+	if err != nil {
+		return fmt.Errorf("failed reading client CA file %q: %w", caFile, err)
+	}
+
+	roots := x509.NewCertPool()
+	if !roots.AppendCertsFromPEM(caBundle) {
+		return fmt.Errorf("no certificates found in client CA file %q", caFile)
+	}
+
+	certs := make([]*x509.Certificate, 0, len(rawCerts))
+	for _, rawCert := range rawCerts {
+		cert, err := x509.ParseCertificate(rawCert)
+		if err != nil {
+			return fmt.Errorf("failed parsing client certificate: %w", err)
+		}
+		certs = append(certs, cert)
+	}
+	if len(certs) == 0 {
+		return fmt.Errorf("no client certificate presented")
+	}
+
+	intermediates := x509.NewCertPool()
+	for _, cert := range certs[1:] {
+		intermediates.AddCert(cert)
+	}
+
+	_, err = certs[0].Verify(x509.VerifyOptions{
+		Roots:         roots,
+		Intermediates: intermediates,
+		KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	})
+	return err
+}
@@ -0,0 +1,95 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metrics_test
+
+import (
+	"crypto/tls"
+	"encoding/pem"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/afero"
+
+	. "github.com/gardener/gardener/pkg/nodeagent/metrics"
+	secretsutils "github.com/gardener/gardener/pkg/utils/secrets"
+)
+
+var _ = Describe("ClientAuth", func() {
+	Describe("#ClientCertificateTLSOption", func() {
+		var (
+			fs        afero.Afero
+			caFile    = "/var/lib/kubelet/ca.crt"
+			tlsConfig *tls.Config
+
+			ca         *secretsutils.Certificate
+			clientCert *secretsutils.Certificate
+		)
+
+		BeforeEach(func() {
+			fs = afero.Afero{Fs: afero.NewMemMapFs()}
+			tlsConfig = &tls.Config{}
+
+			var err error
+			ca, err = (&secretsutils.CertificateSecretConfig{Name: "ca", CommonName: "ca", CertType: secretsutils.CACert}).GenerateCertificate()
+			Expect(err).NotTo(HaveOccurred())
+			clientCert, err = (&secretsutils.CertificateSecretConfig{Name: "client", CommonName: "kube-apiserver-kubelet", CertType: secretsutils.ClientCert, SigningCA: ca}).GenerateCertificate()
+			Expect(err).NotTo(HaveOccurred())
+
+			ClientCertificateTLSOption(fs, caFile)(tlsConfig)
+		})
+
+		It("should require a client certificate", func() {
+			Expect(tlsConfig.ClientAuth).To(Equal(tls.RequireAnyClientCert))
+		})
+
+		It("should accept a client certificate signed by the CA", func() {
+			Expect(fs.WriteFile(caFile, ca.CertificatePEM, 0644)).To(Succeed())
+
+			Expect(tlsConfig.VerifyPeerCertificate([][]byte{rawCertificate(clientCert)}, nil)).To(Succeed())
+		})
+
+		It("should reject a client certificate signed by another CA", func() {
+			otherCA, err := (&secretsutils.CertificateSecretConfig{Name: "other-ca", CommonName: "other-ca", CertType: secretsutils.CACert}).GenerateCertificate()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(fs.WriteFile(caFile, otherCA.CertificatePEM, 0644)).To(Succeed())
+
+			Expect(tlsConfig.VerifyPeerCertificate([][]byte{rawCertificate(clientCert)}, nil)).To(MatchError(ContainSubstring("certificate signed by unknown authority")))
+		})
+
+		It("should reject a server certificate", func() {
+			serverCert, err := (&secretsutils.CertificateSecretConfig{Name: "server", CommonName: "server", CertType: secretsutils.ServerCert, SigningCA: ca}).GenerateCertificate()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(fs.WriteFile(caFile, ca.CertificatePEM, 0644)).To(Succeed())
+
+			Expect(tlsConfig.VerifyPeerCertificate([][]byte{rawCertificate(serverCert)}, nil)).To(MatchError(ContainSubstring("incompatible key usage")))
+		})
+
+		It("should fail if the CA file does not exist yet", func() {
+			Expect(tlsConfig.VerifyPeerCertificate([][]byte{rawCertificate(clientCert)}, nil)).To(MatchError(ContainSubstring("failed reading client CA file")))
+		})
+
+		It("should fail if no client certificate is presented", func() {
+			Expect(fs.WriteFile(caFile, ca.CertificatePEM, 0644)).To(Succeed())
+
+			Expect(tlsConfig.VerifyPeerCertificate(nil, nil)).To(MatchError("no client certificate presented"))
+		})
+	})
+})
+
+func rawCertificate(cert *secretsutils.Certificate) []byte {
+	block, _ := pem.Decode(cert.CertificatePEM)
+	ExpectWithOffset(1, block).NotTo(BeNil())
+	return block.Bytes
+}
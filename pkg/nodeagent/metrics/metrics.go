@@ -0,0 +1,130 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	runtimemetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+// Namespace is the metric namespace for the gardener-node-agent.
+const Namespace = "gardener_node_agent"
+
+const (
+	// ArtifactManagedFiles is the value of the 'artifact' label for the files of the operating system config.
+	ArtifactManagedFiles = "managed_files"
+	// ArtifactExtractionCache is the value of the 'artifact' label for the files extracted from container images.
+	ArtifactExtractionCache = "extraction_cache"
+	// ArtifactChunkCache is the value of the 'artifact' label for the content-addressed chunks of chunked inline files.
+	ArtifactChunkCache = "chunk_cache"
+	// ArtifactTemporaryDirectories is the value of the 'artifact' label for the temporary directories.
+	ArtifactTemporaryDirectories = "temporary_directories"
+
+	// KindFile is the value of the 'kind' label for the files of the operating system config.
+	KindFile = "file"
+	// KindUnit is the value of the 'kind' label for the systemd units (including their drop-ins) of the operating system
+	// config.
+	KindUnit = "unit"
+
+	// ResultSucceeded is the value of the 'result' label for successful reconciliations of the operating system config.
+	ResultSucceeded = "succeeded"
+	// ResultFailed is the value of the 'result' label for failed reconciliations of the operating system config.
+	ResultFailed = "failed"
+)
+
+var (
+	// Factory is used for registering metrics in the controller-runtime metrics registry.
+	Factory = promauto.With(runtimemetrics.Registry)
+
+	// DiskUsage defines the gauge disk_usage_bytes.
+	DiskUsage = Factory.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: Namespace,
+			Name:      "disk_usage_bytes",
+			Help:      "Disk space consumed by the artifacts managed by gardener-node-agent.",
+		},
+		[]string{
+			"artifact",
+		},
+	)
+
+	// DiskUsageQuota defines the gauge disk_usage_quota_bytes.
+	DiskUsageQuota = Factory.NewGauge(
+		prometheus.GaugeOpts{
+			Namespace: Namespace,
+			Name:      "disk_usage_quota_bytes",
+			Help:      "Maximum disk space which may be consumed by the artifacts managed by gardener-node-agent.",
+		},
+	)
+
+	// ExtractionCacheEvictions defines the counter extraction_cache_evictions_total.
+	ExtractionCacheEvictions = Factory.NewCounter(
+		prometheus.CounterOpts{
+			Namespace: Namespace,
+			Name:      "extraction_cache_evictions_total",
+			Help:      "Total number of entries evicted from the extraction cache to satisfy the disk usage quota.",
+		},
+	)
+
+	// OperatingSystemConfigDrifts defines the counter operating_system_config_drifts_total.
+	OperatingSystemConfigDrifts = Factory.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: Namespace,
+			Name:      "operating_system_config_drifts_total",
+			Help:      "Total number of files and units whose state on the disk drifted from the applied operating system config.",
+		},
+		[]string{
+			"kind",
+		},
+	)
+
+	// OperatingSystemConfigApplied defines the counter operating_system_config_applied_total.
+	OperatingSystemConfigApplied = Factory.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: Namespace,
+			Name:      "operating_system_config_applied_total",
+			Help:      "Total number of new or changed files and units of the operating system config which were applied to the node.",
+		},
+		[]string{
+			"kind",
+		},
+	)
+
+	// OperatingSystemConfigReconciliations defines the counter operating_system_config_reconciliations_total.
+	OperatingSystemConfigReconciliations = Factory.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: Namespace,
+			Name:      "operating_system_config_reconciliations_total",
+			Help:      "Total number of reconciliations of the operating system config by result.",
+		},
+		[]string{
+			"result",
+		},
+	)
+
+	// OperatingSystemConfigLastReconciliationTimestamp defines the gauge
+	// operating_system_config_last_reconciliation_timestamp_seconds.
+	OperatingSystemConfigLastReconciliationTimestamp = Factory.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: Namespace,
+			Name:      "operating_system_config_last_reconciliation_timestamp_seconds",
+			Help:      "Unix timestamp of the last reconciliation of the operating system config by result.",
+		},
+		[]string{
+			"result",
+		},
+	)
+)
@@ -0,0 +1,84 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package registry
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	dockerreference "github.com/containerd/containerd/reference/docker"
+	"github.com/spf13/afero"
+
+	"github.com/gardener/gardener/pkg/utils"
+)
+
+type cachingExtractor struct {
+	fs             afero.Afero
+	cacheDirectory string
+	delegate       Extractor
+}
+
+// NewCachingExtractor returns an Extractor which keeps the files copied from container images by the given delegate in
+// the cache directory. Subsequent requests for the same image digest and file path are served from the cache without
+// pulling the image again. Image references without a digest are mutable, hence files from such images are always
+// copied by the delegate and never cached. The modification time of a cache entry is updated whenever it is used, so
+// that the least recently used entries can be evicted first.
+func NewCachingExtractor(fs afero.Afero, cacheDirectory string, delegate Extractor) Extractor {
+	return &cachingExtractor{
+		fs:             fs,
+		cacheDirectory: cacheDirectory,
+		delegate:       delegate,
+	}
+}
+
+// CopyFromImage copies a file from a given image reference to the destination file.
+func (e *cachingExtractor) CopyFromImage(ctx context.Context, imageRef string, filePathInImage string, destination string, permissions os.FileMode) error {
+	ref, err := dockerreference.ParseDockerRef(imageRef)
+	if err != nil {
+		return fmt.Errorf("failed parsing image reference %q: %w", imageRef, err)
+	}
+
+	digested, ok := ref.(dockerreference.Digested)
+	if !ok {
+		return e.delegate.CopyFromImage(ctx, imageRef, filePathInImage, destination, permissions)
+	}
+
+	cacheEntry := filepath.Join(e.cacheDirectory, utils.ComputeSHA256Hex([]byte(digested.Digest().String()+"\x00"+filePathInImage)))
+
+	if _, err := e.fs.Stat(cacheEntry); err != nil {
+		if !errors.Is(err, afero.ErrFileNotFound) {
+			return fmt.Errorf("failed reading cache entry %q: %w", cacheEntry, err)
+		}
+
+		if err := e.fs.MkdirAll(e.cacheDirectory, 0700); err != nil {
+			return fmt.Errorf("failed creating cache directory %q: %w", e.cacheDirectory, err)
+		}
+
+		if err := e.delegate.CopyFromImage(ctx, imageRef, filePathInImage, cacheEntry, 0600); err != nil {
+			return err
+		}
+	} else {
+		now := time.Now()
+		if err := e.fs.Chtimes(cacheEntry, now, now); err != nil {
+			return fmt.Errorf("failed updating modification time of cache entry %q: %w", cacheEntry, err)
+		}
+	}
+
+	return CopyFile(e.fs, cacheEntry, destination, permissions)
+}
@@ -0,0 +1,125 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package registry_test
+
+import (
+	"context"
+	"os"
+	"path"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/afero"
+
+	. "github.com/gardener/gardener/pkg/nodeagent/registry"
+	fakeregistry "github.com/gardener/gardener/pkg/nodeagent/registry/fake"
+)
+
+type countingExtractor struct {
+	Extractor
+	calls int
+}
+
+func (e *countingExtractor) CopyFromImage(ctx context.Context, imageRef string, filePathInImage string, destination string, permissions os.FileMode) error {
+	e.calls++
+	return e.Extractor.CopyFromImage(ctx, imageRef, filePathInImage, destination, permissions)
+}
+
+var _ = Describe("CachingExtractor", func() {
+	var (
+		ctx = context.TODO()
+
+		fakeFS          afero.Afero
+		delegate        *countingExtractor
+		extractor       Extractor
+		sourceDirectory = "/image"
+		cacheDirectory  = "/var/lib/cache"
+
+		imageRef = "foo-image@sha256:2c26b46b68ffc68ff99b453c1d30413413422d706483bfa0f98a5e886266e7ae"
+	)
+
+	BeforeEach(func() {
+		fakeFS = afero.Afero{Fs: afero.NewMemMapFs()}
+		delegate = &countingExtractor{Extractor: fakeregistry.NewExtractor(fakeFS, sourceDirectory)}
+		extractor = NewCachingExtractor(fakeFS, cacheDirectory, delegate)
+
+		Expect(fakeFS.WriteFile(path.Join(sourceDirectory, "foo"), []byte("foo content"), 0755)).To(Succeed())
+	})
+
+	It("should copy the file from the image and keep it in the cache", func() {
+		Expect(extractor.CopyFromImage(ctx, imageRef, "/foo", "/opt/bin/foo", 0750)).To(Succeed())
+
+		Expect(delegate.calls).To(Equal(1))
+		Expect(fakeFS.ReadFile("/opt/bin/foo")).To(BeEquivalentTo("foo content"))
+		fileInfo, err := fakeFS.Stat("/opt/bin/foo")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(fileInfo.Mode().Perm()).To(Equal(os.FileMode(0750)))
+
+		entries, err := fakeFS.ReadDir(cacheDirectory)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(entries).To(HaveLen(1))
+	})
+
+	It("should serve subsequent requests for the same file from the cache", func() {
+		Expect(extractor.CopyFromImage(ctx, imageRef, "/foo", "/opt/bin/foo", 0750)).To(Succeed())
+
+		entries, err := fakeFS.ReadDir(cacheDirectory)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(entries).To(HaveLen(1))
+		cacheEntry := path.Join(cacheDirectory, entries[0].Name())
+		past := time.Now().Add(-time.Hour)
+		Expect(fakeFS.Chtimes(cacheEntry, past, past)).To(Succeed())
+
+		Expect(extractor.CopyFromImage(ctx, imageRef, "/foo", "/usr/bin/foo", 0700)).To(Succeed())
+
+		Expect(delegate.calls).To(Equal(1))
+		Expect(fakeFS.ReadFile("/usr/bin/foo")).To(BeEquivalentTo("foo content"))
+		fileInfo, err := fakeFS.Stat(cacheEntry)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(fileInfo.ModTime()).To(BeTemporally(">", past))
+	})
+
+	It("should use separate cache entries for different image digests", func() {
+		Expect(extractor.CopyFromImage(ctx, imageRef, "/foo", "/opt/bin/foo", 0750)).To(Succeed())
+		Expect(extractor.CopyFromImage(ctx, "foo-image@sha256:fcde2b2edba56bf408601fb721fe9b5c338d10ee429ea04fae5511b68fbf8fb9", "/foo", "/opt/bin/foo", 0750)).To(Succeed())
+
+		Expect(delegate.calls).To(Equal(2))
+		entries, err := fakeFS.ReadDir(cacheDirectory)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(entries).To(HaveLen(2))
+	})
+
+	It("should share the cache entry for the same digest regardless of the tag", func() {
+		Expect(extractor.CopyFromImage(ctx, imageRef, "/foo", "/opt/bin/foo", 0750)).To(Succeed())
+		Expect(extractor.CopyFromImage(ctx, "foo-image:v1@sha256:2c26b46b68ffc68ff99b453c1d30413413422d706483bfa0f98a5e886266e7ae", "/foo", "/opt/bin/foo", 0750)).To(Succeed())
+
+		Expect(delegate.calls).To(Equal(1))
+	})
+
+	It("should not cache files from image references without a digest", func() {
+		Expect(extractor.CopyFromImage(ctx, "foo-image:v1", "/foo", "/opt/bin/foo", 0750)).To(Succeed())
+		Expect(extractor.CopyFromImage(ctx, "foo-image:v1", "/foo", "/opt/bin/foo", 0750)).To(Succeed())
+
+		Expect(delegate.calls).To(Equal(2))
+		Expect(fakeFS.ReadFile("/opt/bin/foo")).To(BeEquivalentTo("foo content"))
+		Expect(fakeFS.DirExists(cacheDirectory)).To(BeFalse())
+	})
+
+	It("should fail for an invalid image reference", func() {
+		Expect(extractor.CopyFromImage(ctx, "Foo-Image", "/foo", "/opt/bin/foo", 0750)).To(MatchError(ContainSubstring(`failed parsing image reference "Foo-Image"`)))
+		Expect(delegate.calls).To(BeZero())
+	})
+})
@@ -0,0 +1,173 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package watchdog
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/coreos/go-systemd/v22/daemon"
+	"github.com/go-logr/logr"
+	"k8s.io/utils/clock"
+)
+
+// NotifyFunc sends the given state to the service manager, see sd_notify(3).
+type NotifyFunc func(state string) error
+
+// SdNotify is a NotifyFunc which sends the state to systemd via the socket in the NOTIFY_SOCKET environment variable.
+func SdNotify(state string) error {
+	_, err := daemon.SdNotify(false, state)
+	return err
+}
+
+// Watchdog implements the systemd watchdog protocol for gardener-node-agent. It periodically sends keep-alive
+// notifications to systemd as long as all active operations report progress within the watchdog timeout. Hence,
+// systemd restarts the agent if an operation hangs, while long-running operations can extend their deadline. All
+// methods can be called on a nil *Watchdog, in which case nothing is tracked.
+type Watchdog struct {
+	log     logr.Logger
+	clock   clock.WithTicker
+	notify  NotifyFunc
+	timeout time.Duration
+
+	lock     sync.Mutex
+	active   int
+	deadline time.Time
+}
+
+// New returns a new Watchdog if the systemd watchdog is enabled for the current process, i.e. if `WatchdogSec=` is
+// configured for the unit. Otherwise, it returns nil.
+func New(log logr.Logger, clock clock.WithTicker, notify NotifyFunc) (*Watchdog, error) {
+	timeout, err := daemon.SdWatchdogEnabled(false)
+	if err != nil {
+		return nil, fmt.Errorf("failed checking whether systemd watchdog is enabled: %w", err)
+	}
+	if timeout == 0 {
+		return nil, nil
+	}
+
+	return NewWithTimeout(log, clock, notify, timeout), nil
+}
+
+// NewWithTimeout returns a new Watchdog for the given watchdog timeout.
+func NewWithTimeout(log logr.Logger, clock clock.WithTicker, notify NotifyFunc, timeout time.Duration) *Watchdog {
+	return &Watchdog{
+		log:     log,
+		clock:   clock,
+		notify:  notify,
+		timeout: timeout,
+	}
+}
+
+// Begin records that an operation was started which must report progress (see Progress) within the watchdog timeout.
+// The returned function must be called when the operation is finished.
+func (w *Watchdog) Begin() func() {
+	if w == nil {
+		return func() {}
+	}
+
+	w.lock.Lock()
+	defer w.lock.Unlock()
+
+	w.active++
+	w.extendDeadline(w.timeout)
+
+	return func() {
+		w.lock.Lock()
+		defer w.lock.Unlock()
+
+		w.active--
+	}
+}
+
+// Progress records that the active operations made progress. They must report progress again within the watchdog
+// timeout.
+func (w *Watchdog) Progress() {
+	if w == nil {
+		return
+	}
+
+	w.lock.Lock()
+	defer w.lock.Unlock()
+
+	w.extendDeadline(w.timeout)
+}
+
+// Extend allows the active operations to not report progress for the given duration, e.g. before a long-running
+// phase like the extraction of an image. Additionally, systemd is asked to extend the timeout of the unit (see
+// `EXTEND_TIMEOUT_USEC=` in sd_notify(3)), so that a long-running phase does not exceed the start or stop timeout.
+func (w *Watchdog) Extend(duration time.Duration) {
+	if w == nil {
+		return
+	}
+
+	w.lock.Lock()
+	w.extendDeadline(duration)
+	w.lock.Unlock()
+
+	if err := w.notify(fmt.Sprintf("EXTEND_TIMEOUT_USEC=%d", duration.Microseconds())); err != nil {
+		w.log.Error(err, "Failed asking systemd to extend the timeout", "duration", duration)
+	}
+}
+
+func (w *Watchdog) extendDeadline(duration time.Duration) {
+	if deadline := w.clock.Now().Add(duration); deadline.After(w.deadline) {
+		w.deadline = deadline
+	}
+}
+
+// Healthy returns whether no active operation exceeded its deadline.
+func (w *Watchdog) Healthy() bool {
+	if w == nil {
+		return true
+	}
+
+	w.lock.Lock()
+	defer w.lock.Unlock()
+
+	return w.active == 0 || w.clock.Now().Before(w.deadline)
+}
+
+// Start sends keep-alive notifications to systemd every half of the watchdog timeout as long as the watchdog is
+// healthy. It blocks until the given context is canceled.
+func (w *Watchdog) Start(ctx context.Context) error {
+	w.log.Info("Sending keep-alive notifications to systemd watchdog", "timeout", w.timeout)
+
+	ticker := w.clock.NewTicker(w.timeout / 2)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C():
+			if !w.Healthy() {
+				w.log.Info("Active operation did not report progress within the watchdog timeout, not sending keep-alive notification")
+				continue
+			}
+
+			if err := w.notify(daemon.SdNotifyWatchdog); err != nil {
+				w.log.Error(err, "Failed sending keep-alive notification to systemd watchdog")
+			}
+		}
+	}
+}
+
+// NeedLeaderElection implements LeaderElectionRunnable.
+func (w *Watchdog) NeedLeaderElection() bool {
+	return false
+}
@@ -0,0 +1,156 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package watchdog_test
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	testclock "k8s.io/utils/clock/testing"
+
+	. "github.com/gardener/gardener/pkg/nodeagent/watchdog"
+)
+
+var _ = Describe("Watchdog", func() {
+	var (
+		fakeClock *testclock.FakeClock
+		timeout   = time.Minute
+
+		lock          sync.Mutex
+		notifications []string
+		notify        NotifyFunc
+
+		watchdog *Watchdog
+	)
+
+	BeforeEach(func() {
+		fakeClock = testclock.NewFakeClock(time.Date(2023, 11, 1, 10, 0, 0, 0, time.UTC))
+
+		notifications = nil
+		notify = func(state string) error {
+			lock.Lock()
+			defer lock.Unlock()
+
+			notifications = append(notifications, state)
+			return nil
+		}
+
+		watchdog = NewWithTimeout(logr.Discard(), fakeClock, notify, timeout)
+	})
+
+	sentNotifications := func() []string {
+		lock.Lock()
+		defer lock.Unlock()
+
+		return append([]string{}, notifications...)
+	}
+
+	Describe("#Healthy", func() {
+		It("should be healthy if no operation is active", func() {
+			fakeClock.Step(time.Hour)
+			Expect(watchdog.Healthy()).To(BeTrue())
+		})
+
+		It("should be unhealthy if an active operation does not report progress within the timeout", func() {
+			done := watchdog.Begin()
+
+			fakeClock.Step(timeout - time.Second)
+			Expect(watchdog.Healthy()).To(BeTrue())
+
+			fakeClock.Step(time.Second)
+			Expect(watchdog.Healthy()).To(BeFalse())
+
+			done()
+			Expect(watchdog.Healthy()).To(BeTrue())
+		})
+
+		It("should stay healthy if the active operation reports progress", func() {
+			defer watchdog.Begin()()
+
+			for i := 0; i < 5; i++ {
+				fakeClock.Step(timeout - time.Second)
+				watchdog.Progress()
+				Expect(watchdog.Healthy()).To(BeTrue())
+			}
+		})
+
+		It("should stay healthy if the active operation extended its deadline", func() {
+			defer watchdog.Begin()()
+
+			watchdog.Extend(10 * time.Minute)
+			Expect(sentNotifications()).To(ConsistOf("EXTEND_TIMEOUT_USEC=600000000"))
+
+			fakeClock.Step(5 * time.Minute)
+			watchdog.Progress()
+			Expect(watchdog.Healthy()).To(BeTrue())
+
+			fakeClock.Step(5 * time.Minute)
+			Expect(watchdog.Healthy()).To(BeFalse())
+		})
+
+		It("should handle a nil watchdog", func() {
+			var watchdog *Watchdog
+
+			defer watchdog.Begin()()
+			watchdog.Progress()
+			watchdog.Extend(time.Minute)
+			Expect(watchdog.Healthy()).To(BeTrue())
+		})
+	})
+
+	Describe("#Start", func() {
+		var (
+			ctx    context.Context
+			cancel context.CancelFunc
+		)
+
+		BeforeEach(func() {
+			ctx, cancel = context.WithCancel(context.Background())
+			DeferCleanup(cancel)
+
+			go func() {
+				defer GinkgoRecover()
+				Expect(watchdog.Start(ctx)).To(Succeed())
+			}()
+			Eventually(fakeClock.HasWaiters).Should(BeTrue())
+		})
+
+		It("should send keep-alive notifications while healthy", func() {
+			fakeClock.Step(timeout / 2)
+			Eventually(sentNotifications).Should(Equal([]string{"WATCHDOG=1"}))
+
+			fakeClock.Step(timeout / 2)
+			Eventually(sentNotifications).Should(Equal([]string{"WATCHDOG=1", "WATCHDOG=1"}))
+		})
+
+		It("should not send keep-alive notifications while an operation hangs", func() {
+			done := watchdog.Begin()
+
+			fakeClock.Step(timeout / 2)
+			Eventually(sentNotifications).Should(HaveLen(1))
+
+			fakeClock.Step(timeout / 2)
+			Consistently(sentNotifications).Should(HaveLen(1))
+
+			done()
+			fakeClock.Step(timeout / 2)
+			Eventually(sentNotifications).Should(HaveLen(2))
+		})
+	})
+})
@@ -60,6 +60,7 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.CloudProfileList":                           schema_pkg_apis_core_v1beta1_CloudProfileList(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.CloudProfileSpec":                           schema_pkg_apis_core_v1beta1_CloudProfileSpec(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ClusterAutoscaler":                          schema_pkg_apis_core_v1beta1_ClusterAutoscaler(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ClusterAutoscalerOptions":                   schema_pkg_apis_core_v1beta1_ClusterAutoscalerOptions(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.Condition":                                  schema_pkg_apis_core_v1beta1_Condition(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ContainerRuntime":                           schema_pkg_apis_core_v1beta1_ContainerRuntime(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ControlPlane":                               schema_pkg_apis_core_v1beta1_ControlPlane(ref),
@@ -132,9 +133,11 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.Networking":                                 schema_pkg_apis_core_v1beta1_Networking(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.NginxIngress":                               schema_pkg_apis_core_v1beta1_NginxIngress(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.NodeLocalDNS":                               schema_pkg_apis_core_v1beta1_NodeLocalDNS(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.NodeLocalDNSForward":                        schema_pkg_apis_core_v1beta1_NodeLocalDNSForward(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.OIDCConfig":                                 schema_pkg_apis_core_v1beta1_OIDCConfig(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ObservabilityRotation":                      schema_pkg_apis_core_v1beta1_ObservabilityRotation(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.OpenIDConnectClientAuthentication":          schema_pkg_apis_core_v1beta1_OpenIDConnectClientAuthentication(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.PriorityClassScaleUpDelay":                  schema_pkg_apis_core_v1beta1_PriorityClassScaleUpDelay(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.Project":                                    schema_pkg_apis_core_v1beta1_Project(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ProjectList":                                schema_pkg_apis_core_v1beta1_ProjectList(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ProjectMember":                              schema_pkg_apis_core_v1beta1_ProjectMember(ref),
@@ -1739,6 +1742,134 @@ func schema_pkg_apis_core_v1beta1_ClusterAutoscaler(ref common.ReferenceCallback
 							Format:      "int32",
 						},
 					},
+					"skipNodesWithCustomControllerPods": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SkipNodesWithCustomControllerPods specifies whether CA should never delete nodes with pods owned by custom controllers, i.e., controllers other than ReplicaSets, Jobs, StatefulSets, and ReplicationControllers (default: true). Setting it to false allows scaling down nodes whose pods are only blocked by such custom controllers. This field is only available for Kubernetes versions >= 1.27.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"maxPodEvictionTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxPodEvictionTime defines how long CA tries to evict a pod when draining a node before it gives up and marks the scale-down as failed (default: 2m).",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"priorityClassScaleUpDelays": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PriorityClassScaleUpDelays specifies how long CA should ignore newly created pods of the given priority classes before they have to be considered for scale-up. It overrides NewPodScaleUpDelay for pods with a matching priority class name.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.PriorityClassScaleUpDelay"),
+									},
+								},
+							},
+						},
+					},
+					"cordonNodeBeforeTerminating": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CordonNodeBeforeTerminating specifies whether CA should cordon nodes before it starts draining them during scale-down, i.e., whether no new pods are scheduled to nodes which are about to be removed (default: false).",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"scaleDownCandidatesPoolRatio": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ScaleDownCandidatesPoolRatio defines the ratio of nodes that are considered as additional non-empty candidates for scale-down when some candidates from the previous iteration are no longer valid (default: 0.1). Lower values reduce the CPU consumption and the loop latency of CA in large clusters but slow down the scale-down.",
+							Type:        []string{"number"},
+							Format:      "double",
+						},
+					},
+					"scaleDownCandidatesPoolMinCount": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ScaleDownCandidatesPoolMinCount defines the minimum number of nodes that are considered as additional non-empty candidates for scale-down when some candidates from the previous iteration are no longer valid (default: 50). The effective pool size is the maximum of this value and the number of nodes multiplied by ScaleDownCandidatesPoolRatio.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.PriorityClassScaleUpDelay", "k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_ClusterAutoscalerOptions(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ClusterAutoscalerOptions contains the cluster autoscaler configurations for a worker pool.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"scaleDownUtilizationThreshold": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ScaleDownUtilizationThreshold defines the threshold in fraction (0.0 - 1.0) under which a node is being removed.",
+							Type:        []string{"number"},
+							Format:      "double",
+						},
+					},
+					"scaleDownUnneededTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ScaleDownUnneededTime defines how long a node should be unneeded before it is eligible for scale down.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"maxNodeProvisionTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxNodeProvisionTime defines how long CA waits for node to be provisioned.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"maxGracefulTerminationSeconds": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxGracefulTerminationSeconds is the number of seconds CA waits for pod termination when trying to scale down a node of this worker pool.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"zoneSplitStrategy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ZoneSplitStrategy is the strategy used by Gardener for splitting the minimum and maximum of this worker pool over the machine deployments of its zones. If not set, the split is left to the provider extension.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"zoneWeights": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ZoneWeights are the weights of the zones of this worker pool which are considered by the `Weighted` zone split strategy. Zones without weight have a weight of 1.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: 0,
+										Type:    []string{"integer"},
+										Format:  "int32",
+									},
+								},
+							},
+						},
+					},
+					"zoneCaps": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ZoneCaps are the maximum numbers of nodes in the zones of this worker pool which are considered by the `Capped` zone split strategy.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: 0,
+										Type:    []string{"integer"},
+										Format:  "int32",
+									},
+								},
+							},
+						},
+					},
 				},
 			},
 		},
@@ -5106,10 +5237,123 @@ func schema_pkg_apis_core_v1beta1_NodeLocalDNS(ref common.ReferenceCallback) com
 							Format:      "",
 						},
 					},
+					"resources": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Resources are the resource requirements of the node-cache container of node local DNS. Only CPU and memory resources are supported. Defaults to requests of 25m CPU and 25Mi memory, and a memory limit of 100Mi.",
+							Ref:         ref("k8s.io/api/core/v1.ResourceRequirements"),
+						},
+					},
+					"priorityClassName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PriorityClassName is the name of the priority class of the node local DNS pods. The priority class must exist in the shoot cluster. Defaults to `system-node-critical`.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"tolerations": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Tolerations are the tolerations of the node local DNS pods. If set, they replace the default tolerations which tolerate all taints with the `NoSchedule` and `NoExecute` effects. This allows keeping node local DNS off specialized worker pools (e.g., Windows or confidential nodes) by not tolerating their taints.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("k8s.io/api/core/v1.Toleration"),
+									},
+								},
+							},
+						},
+					},
+					"enableDaemonSetEviction": {
+						SchemaProps: spec.SchemaProps{
+							Description: "EnableDaemonSetEviction specifies whether cluster-autoscaler evicts the node local DNS pods when scaling down a node. If set, the pods are annotated with `cluster-autoscaler.kubernetes.io/enable-ds-eviction` accordingly. Otherwise, the default behaviour of cluster-autoscaler applies.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"upstreamServers": {
+						SchemaProps: spec.SchemaProps{
+							Description: "UpstreamServers are the DNS servers (IP addresses, optionally with port) to which node local DNS forwards queries for non-cluster domains. If set, they replace the resolvers of the node. This is not allowed if forwarding to upstream DNS is disabled.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"customZones": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CustomZones are additional server blocks which are appended to the Corefile of node local DNS, e.g., to forward queries for corporate domains to dedicated DNS servers.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"clusterDNSForward": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ClusterDNSForward configures how node local DNS forwards queries for the cluster domain and the reverse zones to the cluster DNS.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.NodeLocalDNSForward"),
+						},
+					},
+					"upstreamDNSForward": {
+						SchemaProps: spec.SchemaProps{
+							Description: "UpstreamDNSForward configures how node local DNS forwards queries for non-cluster domains to the upstream DNS.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.NodeLocalDNSForward"),
+						},
+					},
 				},
 				Required: []string{"enabled"},
 			},
 		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.NodeLocalDNSForward", "k8s.io/api/core/v1.ResourceRequirements", "k8s.io/api/core/v1.Toleration"},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_NodeLocalDNSForward(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "NodeLocalDNSForward contains the settings of the forward plugin of a zone of node local DNS.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"healthCheckInterval": {
+						SchemaProps: spec.SchemaProps{
+							Description: "HealthCheckInterval is the interval in which the health of the DNS servers the queries are forwarded to is checked. Unhealthy servers are not used until they are healthy again, hence a shorter interval speeds up the failover for flaky servers. Defaults to `0.5s`.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"maxConcurrent": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxConcurrent is the maximum number of concurrent queries which are forwarded. Queries exceeding it are answered with `REFUSED`. Defaults to no limit.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"policy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Policy is the policy for selecting the DNS server a query is forwarded to. Supported values are `random`, `round_robin`, and `sequential`. Defaults to `random`.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
 	}
 }
 
@@ -5277,6 +5521,37 @@ func schema_pkg_apis_core_v1beta1_OpenIDConnectClientAuthentication(ref common.R
 	}
 }
 
+func schema_pkg_apis_core_v1beta1_PriorityClassScaleUpDelay(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "PriorityClassScaleUpDelay contains the new pod scale-up delay for pods of a certain priority class.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"priorityClassName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PriorityClassName is the name of the priority class.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"newPodScaleUpDelay": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NewPodScaleUpDelay specifies how long CA should ignore newly created pods of the priority class before they have to be considered for scale-up.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+				},
+				Required: []string{"priorityClassName", "newPodScaleUpDelay"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
+	}
+}
+
 func schema_pkg_apis_core_v1beta1_Project(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -8608,12 +8883,18 @@ func schema_pkg_apis_core_v1beta1_Worker(ref common.ReferenceCallback) common.Op
 							},
 						},
 					},
+					"clusterAutoscaler": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ClusterAutoscaler contains the cluster autoscaler configurations for the worker pool. They override the global settings in `.spec.kubernetes.clusterAutoscaler` for this worker pool.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.ClusterAutoscalerOptions"),
+						},
+					},
 				},
 				Required: []string{"name", "machine", "maximum", "minimum"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/core/v1beta1.CRI", "github.com/gardener/gardener/pkg/apis/core/v1beta1.DataVolume", "github.com/gardener/gardener/pkg/apis/core/v1beta1.Machine", "github.com/gardener/gardener/pkg/apis/core/v1beta1.MachineControllerManagerSettings", "github.com/gardener/gardener/pkg/apis/core/v1beta1.Volume", "github.com/gardener/gardener/pkg/apis/core/v1beta1.WorkerKubernetes", "github.com/gardener/gardener/pkg/apis/core/v1beta1.WorkerSystemComponents", "k8s.io/api/core/v1.Taint", "k8s.io/apimachinery/pkg/runtime.RawExtension", "k8s.io/apimachinery/pkg/util/intstr.IntOrString"},
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.CRI", "github.com/gardener/gardener/pkg/apis/core/v1beta1.ClusterAutoscalerOptions", "github.com/gardener/gardener/pkg/apis/core/v1beta1.DataVolume", "github.com/gardener/gardener/pkg/apis/core/v1beta1.Machine", "github.com/gardener/gardener/pkg/apis/core/v1beta1.MachineControllerManagerSettings", "github.com/gardener/gardener/pkg/apis/core/v1beta1.Volume", "github.com/gardener/gardener/pkg/apis/core/v1beta1.WorkerKubernetes", "github.com/gardener/gardener/pkg/apis/core/v1beta1.WorkerSystemComponents", "k8s.io/api/core/v1.Taint", "k8s.io/apimachinery/pkg/runtime.RawExtension", "k8s.io/apimachinery/pkg/util/intstr.IntOrString"},
 	}
 }
 
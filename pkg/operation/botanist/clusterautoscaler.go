@@ -16,11 +16,15 @@ package botanist
 
 import (
 	"context"
+	"strings"
+	"time"
 
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/gardener/gardener/imagevector"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	"github.com/gardener/gardener/pkg/client/kubernetes"
 	"github.com/gardener/gardener/pkg/component/clusterautoscaler"
 	imagevectorutils "github.com/gardener/gardener/pkg/utils/imagevector"
@@ -34,6 +38,11 @@ func (b *Botanist) DefaultClusterAutoscaler() (clusterautoscaler.Interface, erro
 		return nil, err
 	}
 
+	imageAlpine, err := imagevector.ImageVector().FindImage(imagevector.ImageNameAlpine)
+	if err != nil {
+		return nil, err
+	}
+
 	return clusterautoscaler.New(
 		b.SeedClientSet.Client(),
 		b.Shoot.SeedNamespace,
@@ -41,6 +50,11 @@ func (b *Botanist) DefaultClusterAutoscaler() (clusterautoscaler.Interface, erro
 		image.String(),
 		b.Shoot.GetReplicas(1),
 		b.Shoot.GetInfo().Spec.Kubernetes.ClusterAutoscaler,
+		clusterautoscaler.Values{
+			RBACNamespace:                        b.Shoot.GetInfo().Annotations[v1beta1constants.AnnotationClusterAutoscalerRBACNamespace],
+			WaitForMachineControllerManagerImage: imageAlpine.String(),
+			Recorder:                             b.SeedRecorder,
+		},
 	), nil
 }
 
@@ -48,7 +62,10 @@ func (b *Botanist) DefaultClusterAutoscaler() (clusterautoscaler.Interface, erro
 func (b *Botanist) DeployClusterAutoscaler(ctx context.Context) error {
 	if b.Shoot.WantsClusterAutoscaler {
 		b.Shoot.Components.ControlPlane.ClusterAutoscaler.SetNamespaceUID(b.SeedNamespaceObject.UID)
-		b.Shoot.Components.ControlPlane.ClusterAutoscaler.SetMachineDeployments(b.Shoot.Components.Extensions.Worker.MachineDeployments())
+		machineDeployments := b.Shoot.Components.Extensions.Worker.MachineDeployments()
+		b.Shoot.Components.ControlPlane.ClusterAutoscaler.SetMachineDeployments(machineDeployments)
+		b.Shoot.Components.ControlPlane.ClusterAutoscaler.SetWorkerPools(clusterAutoscalerWorkerPools(b.Shoot.SeedNamespace, b.Shoot.GetInfo().Spec.Provider.Workers, machineDeployments))
+		b.Shoot.Components.ControlPlane.ClusterAutoscaler.SetCredentialsRotationMarker(clusterAutoscalerCredentialsRotationMarker(b.Shoot.GetInfo().Status.Credentials))
 
 		return b.Shoot.Components.ControlPlane.ClusterAutoscaler.Deploy(ctx)
 	}
@@ -56,6 +73,55 @@ func (b *Botanist) DeployClusterAutoscaler(ctx context.Context) error {
 	return b.Shoot.Components.ControlPlane.ClusterAutoscaler.Destroy(ctx)
 }
 
+// clusterAutoscalerWorkerPools returns the worker pools whose minimum and maximum shall be split over the machine
+// deployments of their zones by the cluster-autoscaler component. Only worker pools which are scaled by the
+// cluster-autoscaler, which configure a zone split strategy, and for which the Worker reported machine deployments are
+// considered, for all others the bounds of the machine deployments computed by the provider extension are used.
+func clusterAutoscalerWorkerPools(namespace string, workers []gardencorev1beta1.Worker, machineDeployments []extensionsv1alpha1.MachineDeployment) []clusterautoscaler.WorkerPool {
+	var workerPools []clusterautoscaler.WorkerPool
+
+	for _, worker := range workers {
+		if worker.ClusterAutoscaler == nil || worker.ClusterAutoscaler.ZoneSplitStrategy == nil ||
+			worker.Maximum <= worker.Minimum || len(worker.Zones) == 0 {
+			continue
+		}
+		if pool := clusterautoscaler.NewWorkerPool(namespace, worker, machineDeployments); len(pool.Zones) > 0 {
+			workerPools = append(workerPools, pool)
+		}
+	}
+
+	return workerPools
+}
+
+// clusterAutoscalerCredentialsRotationMarker returns a marker for the rotations of the credentials used by the
+// cluster-autoscaler. It changes when the preparation of a certificate authority or service account key rotation has
+// finished, i.e., after the access tokens have been renewed.
+func clusterAutoscalerCredentialsRotationMarker(credentials *gardencorev1beta1.ShootCredentials) string {
+	if credentials == nil || credentials.Rotation == nil {
+		return ""
+	}
+
+	var marker []string
+	if rotation := credentials.Rotation.CertificateAuthorities; rotation != nil && rotation.LastInitiationFinishedTime != nil {
+		marker = append(marker, "ca:"+rotation.LastInitiationFinishedTime.UTC().Format(time.RFC3339))
+	}
+	if rotation := credentials.Rotation.ServiceAccountKey; rotation != nil && rotation.LastInitiationFinishedTime != nil {
+		marker = append(marker, "sa:"+rotation.LastInitiationFinishedTime.UTC().Format(time.RFC3339))
+	}
+
+	return strings.Join(marker, ",")
+}
+
+// WaitForClusterAutoscaler waits until the machine-controller-manager and the cluster-autoscaler are ready. If the
+// cluster-autoscaler is not wanted, it does nothing.
+func (b *Botanist) WaitForClusterAutoscaler(ctx context.Context) error {
+	if !b.Shoot.WantsClusterAutoscaler {
+		return nil
+	}
+
+	return b.Shoot.Components.ControlPlane.ClusterAutoscaler.Wait(ctx)
+}
+
 // ScaleClusterAutoscalerToZero scales cluster-autoscaler replicas to zero.
 func (b *Botanist) ScaleClusterAutoscalerToZero(ctx context.Context) error {
 	return client.IgnoreNotFound(kubernetes.ScaleDeployment(ctx, b.SeedClientSet.Client(), kubernetesutils.Key(b.Shoot.SeedNamespace, v1beta1constants.DeploymentNameClusterAutoscaler), 0))
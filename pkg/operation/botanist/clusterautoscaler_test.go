@@ -17,6 +17,7 @@ package botanist_test
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/Masterminds/semver/v3"
 	. "github.com/onsi/ginkgo/v2"
@@ -31,6 +32,7 @@ import (
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	kubernetesmock "github.com/gardener/gardener/pkg/client/kubernetes/mock"
+	"github.com/gardener/gardener/pkg/component/clusterautoscaler"
 	mockclusterautoscaler "github.com/gardener/gardener/pkg/component/clusterautoscaler/mock"
 	mockworker "github.com/gardener/gardener/pkg/component/extensions/worker/mock"
 	mockclient "github.com/gardener/gardener/pkg/mock/controller-runtime/client"
@@ -85,7 +87,11 @@ var _ = Describe("ClusterAutoscaler", func() {
 			worker            *mockworker.MockInterface
 
 			namespaceUID       = types.UID("5678")
-			machineDeployments = []extensionsv1alpha1.MachineDeployment{{}}
+			machineDeployments = []extensionsv1alpha1.MachineDeployment{
+				{Name: "shoot--foo--bar-split-z1", Minimum: 1, Maximum: 3},
+				{Name: "shoot--foo--bar-split-z2", Minimum: 0, Maximum: 2},
+				{Name: "shoot--foo--bar-no-strategy-z1", Minimum: 1, Maximum: 5},
+			}
 		)
 
 		BeforeEach(func() {
@@ -107,6 +113,7 @@ var _ = Describe("ClusterAutoscaler", func() {
 					},
 				},
 			}
+			botanist.Shoot.SetInfo(&gardencorev1beta1.Shoot{})
 		})
 
 		Context("CA wanted", func() {
@@ -119,11 +126,89 @@ var _ = Describe("ClusterAutoscaler", func() {
 			})
 
 			It("should set the secrets, namespace uid, machine deployments, and deploy", func() {
+				clusterAutoscaler.EXPECT().SetWorkerPools(nil)
+				clusterAutoscaler.EXPECT().SetCredentialsRotationMarker("")
+				clusterAutoscaler.EXPECT().Deploy(ctx)
+				Expect(botanist.DeployClusterAutoscaler(ctx)).To(Succeed())
+			})
+
+			It("should set the worker pools which configure a zone split strategy", func() {
+				strategy := gardencorev1beta1.ZoneSplitStrategyWeighted
+				botanist.Shoot.SeedNamespace = "shoot--foo--bar"
+				botanist.Shoot.SetInfo(&gardencorev1beta1.Shoot{
+					Spec: gardencorev1beta1.ShootSpec{
+						Provider: gardencorev1beta1.Provider{
+							Workers: []gardencorev1beta1.Worker{
+								{
+									Name:    "split",
+									Minimum: 1,
+									Maximum: 5,
+									Zones:   []string{"zone-a", "zone-b"},
+									ClusterAutoscaler: &gardencorev1beta1.ClusterAutoscalerOptions{
+										ZoneSplitStrategy: &strategy,
+										ZoneWeights:       map[string]int32{"zone-a": 2},
+									},
+								},
+								{
+									Name:    "no-strategy",
+									Minimum: 1,
+									Maximum: 5,
+									Zones:   []string{"zone-a"},
+								},
+								{
+									Name:    "not-scaled",
+									Minimum: 2,
+									Maximum: 2,
+									Zones:   []string{"zone-a"},
+									ClusterAutoscaler: &gardencorev1beta1.ClusterAutoscalerOptions{
+										ZoneSplitStrategy: &strategy,
+									},
+								},
+							},
+						},
+					},
+				})
+
+				clusterAutoscaler.EXPECT().SetWorkerPools([]clusterautoscaler.WorkerPool{{
+					Name:     "split",
+					Minimum:  1,
+					Maximum:  5,
+					Strategy: clusterautoscaler.ZoneSplitStrategyWeighted,
+					Zones: []clusterautoscaler.WorkerPoolZone{
+						{Name: "zone-a", MachineDeploymentName: "shoot--foo--bar-split-z1", Weight: 2},
+						{Name: "zone-b", MachineDeploymentName: "shoot--foo--bar-split-z2"},
+					},
+				}})
+				clusterAutoscaler.EXPECT().SetCredentialsRotationMarker("")
+				clusterAutoscaler.EXPECT().Deploy(ctx)
+				Expect(botanist.DeployClusterAutoscaler(ctx)).To(Succeed())
+			})
+
+			It("should set the credentials rotation marker if credentials were rotated", func() {
+				botanist.Shoot.SetInfo(&gardencorev1beta1.Shoot{
+					Status: gardencorev1beta1.ShootStatus{
+						Credentials: &gardencorev1beta1.ShootCredentials{
+							Rotation: &gardencorev1beta1.ShootCredentialsRotation{
+								CertificateAuthorities: &gardencorev1beta1.CARotation{
+									LastInitiationFinishedTime: &metav1.Time{Time: time.Date(2023, 10, 1, 10, 0, 0, 0, time.UTC)},
+								},
+								ServiceAccountKey: &gardencorev1beta1.ServiceAccountKeyRotation{
+									LastInitiationTime: &metav1.Time{Time: time.Date(2023, 10, 2, 10, 0, 0, 0, time.UTC)},
+								},
+							},
+						},
+					},
+				})
+
+				clusterAutoscaler.EXPECT().SetWorkerPools(nil)
+				clusterAutoscaler.EXPECT().SetCredentialsRotationMarker("ca:2023-10-01T10:00:00Z")
 				clusterAutoscaler.EXPECT().Deploy(ctx)
 				Expect(botanist.DeployClusterAutoscaler(ctx)).To(Succeed())
 			})
 
 			It("should fail when the deploy function fails", func() {
+				clusterAutoscaler.EXPECT().SetWorkerPools(nil)
+				clusterAutoscaler.EXPECT().SetCredentialsRotationMarker("")
 				clusterAutoscaler.EXPECT().Deploy(ctx).Return(fakeErr)
 				Expect(botanist.DeployClusterAutoscaler(ctx)).To(Equal(fakeErr))
 			})
@@ -146,6 +231,33 @@ var _ = Describe("ClusterAutoscaler", func() {
 		})
 	})
 
+	Describe("#WaitForClusterAutoscaler", func() {
+		var clusterAutoscaler *mockclusterautoscaler.MockInterface
+
+		BeforeEach(func() {
+			clusterAutoscaler = mockclusterautoscaler.NewMockInterface(ctrl)
+
+			botanist.Shoot = &shootpkg.Shoot{
+				Components: &shootpkg.Components{
+					ControlPlane: &shootpkg.ControlPlane{
+						ClusterAutoscaler: clusterAutoscaler,
+					},
+				},
+			}
+		})
+
+		It("should wait if CA is wanted", func() {
+			botanist.Shoot.WantsClusterAutoscaler = true
+
+			clusterAutoscaler.EXPECT().Wait(ctx).Return(fakeErr)
+			Expect(botanist.WaitForClusterAutoscaler(ctx)).To(Equal(fakeErr))
+		})
+
+		It("should do nothing if CA is unwanted", func() {
+			Expect(botanist.WaitForClusterAutoscaler(ctx)).To(Succeed())
+		})
+	})
+
 	Describe("#ScaleClusterAutoscalerToZero", func() {
 		var (
 			c         *mockclient.MockClient
@@ -46,11 +46,14 @@ func (b *Botanist) DefaultCoreDNS() (coredns.Interface, error) {
 		ClusterDomain:                   gardencorev1beta1.DefaultDomain,
 		ClusterIP:                       b.Shoot.Networks.CoreDNS.String(),
 		Image:                           image.String(),
-		PodNetworkCIDR:                  b.Shoot.Networks.Pods.String(),
+		PodNetworkCIDR:                  b.Shoot.Networks.PrimaryPods().String(),
 		NodeNetworkCIDR:                 b.Shoot.GetInfo().Spec.Networking.Nodes,
 		AutoscalingMode:                 gardencorev1beta1.CoreDNSAutoscalingModeHorizontal,
 		SearchPathRewritesEnabled:       v1beta1helper.IsCoreDNSRewritingEnabled(features.DefaultFeatureGate.Enabled(features.CoreDNSQueryRewriting), b.Shoot.GetInfo().GetAnnotations()),
 		SearchPathRewriteCommonSuffixes: getCommonSuffixesForRewriting(b.Shoot.GetInfo().Spec.SystemComponents),
+		// If node-local-dns also serves pods in the host network, the kubelet hands out node-local-dns as resolver, hence
+		// CoreDNS must forward to the original resolvers of the host to prevent forwarding loops.
+		ForwardToHostResolvConf: v1beta1helper.IsNodeLocalDNSHostNetworkEnabled(b.Shoot.GetInfo().Spec.SystemComponents, b.Shoot.GetInfo().GetAnnotations()),
 	}
 
 	if b.ShootUsesDNS() {
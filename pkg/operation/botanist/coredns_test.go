@@ -73,7 +73,7 @@ var _ = Describe("CoreDNS", func() {
 			botanist.SeedClientSet = kubernetesClient
 			botanist.Shoot.Networks = &shootpkg.Networks{
 				CoreDNS: net.ParseIP("18.19.20.21"),
-				Pods:    &net.IPNet{IP: net.ParseIP("22.23.24.25")},
+				Pods:    []net.IPNet{{IP: net.ParseIP("22.23.24.25")}},
 			}
 			botanist.Garden = &garden.Garden{}
 		})
@@ -33,6 +33,7 @@ import (
 	"github.com/gardener/gardener/pkg/gardenlet/apis/config"
 	"github.com/gardener/gardener/pkg/operation/shoot"
 	"github.com/gardener/gardener/pkg/utils/flow"
+	"github.com/gardener/gardener/pkg/utils/gardener/secretsrotation"
 	kubernetesutils "github.com/gardener/gardener/pkg/utils/kubernetes"
 	"github.com/gardener/gardener/pkg/utils/timewindow"
 )
@@ -175,6 +176,17 @@ func (b *Botanist) SnapshotEtcd(ctx context.Context) error {
 	return shared.SnapshotEtcd(ctx, b.SecretsManager, b.Shoot.Components.ControlPlane.EtcdMain)
 }
 
+// VerifyEncryptedDataAtRest samples the secrets stored in ETCD main and verifies that they are encrypted with the
+// current ETCD encryption key.
+func (b *Botanist) VerifyEncryptedDataAtRest(ctx context.Context) error {
+	reader, err := shared.NewEtcdMainReader(b.SecretsManager, b.Shoot.SeedNamespace)
+	if err != nil {
+		return err
+	}
+
+	return secretsrotation.VerifyEncryptedDataAtRest(ctx, b.Logger, reader, b.SecretsManager, secretsrotation.VerifyOptions{}, corev1.Resource("secrets"))
+}
+
 // ScaleETCDToZero scales ETCD main and events replicas to zero.
 func (b *Botanist) ScaleETCDToZero(ctx context.Context) error {
 	return b.scaleETCD(ctx, 0)
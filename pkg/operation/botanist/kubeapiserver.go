@@ -48,11 +48,11 @@ func (b *Botanist) DefaultKubeAPIServer(ctx context.Context) (kubeapiserver.Inte
 	)
 
 	if b.Shoot.Networks != nil {
-		if b.Shoot.Networks.Pods != nil {
-			pods = b.Shoot.Networks.Pods.String()
+		if podNetwork := b.Shoot.Networks.PrimaryPods(); podNetwork != nil {
+			pods = podNetwork.String()
 		}
-		if b.Shoot.Networks.Services != nil {
-			services = b.Shoot.Networks.Services.String()
+		if serviceNetwork := b.Shoot.Networks.PrimaryServices(); serviceNetwork != nil {
+			services = serviceNetwork.String()
 		}
 	}
 
@@ -122,8 +122,8 @@ var _ = Describe("KubeAPIServer", func() {
 					ExternalClusterDomain: &externalClusterDomain,
 					Networks: &shootpkg.Networks{
 						APIServer: apiServerNetwork,
-						Pods:      podNetwork,
-						Services:  serviceNetwork,
+						Pods:      []net.IPNet{*podNetwork},
+						Services:  []net.IPNet{*serviceNetwork},
 					},
 					PSPDisabled:       false,
 					KubernetesVersion: semver.MustParse("1.26.1"),
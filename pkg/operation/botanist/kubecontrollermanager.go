@@ -41,10 +41,9 @@ func (b *Botanist) DefaultKubeControllerManager() (kubecontrollermanager.Interfa
 		scaleDownUpdateMode = hvpav1alpha1.UpdateModeOff
 	}
 
-	var services, pods *net.IPNet
+	var services, pods []net.IPNet
 	if b.Shoot.Networks != nil {
-		services = b.Shoot.Networks.Services
-		pods = b.Shoot.Networks.Pods
+		services, pods = b.Shoot.Networks.Services, b.Shoot.Networks.Pods
 	}
 
 	return shared.NewKubeControllerManager(
@@ -67,6 +66,7 @@ func (b *Botanist) DefaultKubeControllerManager() (kubecontrollermanager.Interfa
 		nil,
 		kubecontrollermanager.ControllerWorkers{},
 		kubecontrollermanager.ControllerSyncPeriods{},
+		b.SeedRecorder,
 	)
 }
 
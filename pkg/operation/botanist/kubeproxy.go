@@ -53,7 +53,7 @@ func (b *Botanist) DefaultKubeProxy() (kubeproxy.Interface, error) {
 			IPVSEnabled:    b.Shoot.IPVSEnabled(),
 			FeatureGates:   featureGates,
 			ImageAlpine:    imageAlpine.String(),
-			PodNetworkCIDR: pointer.String(b.Shoot.Networks.Pods.String()),
+			PodNetworkCIDR: pointer.String(b.Shoot.Networks.PrimaryPods().String()),
 			VPAEnabled:     b.Shoot.WantsVerticalPodAutoscaler,
 			PSPDisabled:    b.Shoot.PSPDisabled,
 		},
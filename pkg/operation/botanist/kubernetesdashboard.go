@@ -20,6 +20,7 @@ import (
 	"k8s.io/utils/pointer"
 
 	"github.com/gardener/gardener/imagevector"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
 	"github.com/gardener/gardener/pkg/component/kubernetesdashboard"
 	imagevectorutils "github.com/gardener/gardener/pkg/utils/imagevector"
@@ -51,7 +52,40 @@ func (b *Botanist) DefaultKubernetesDashboard() (kubernetesdashboard.Interface,
 		values.AuthenticationMode = *b.Shoot.GetInfo().Spec.Addons.KubernetesDashboard.AuthenticationMode
 	}
 
-	return kubernetesdashboard.New(b.SeedClientSet.Client(), b.Shoot.SeedNamespace, values), nil
+	if values.AuthenticationMode == gardencorev1beta1.KubernetesDashboardAuthModeOIDC {
+		oidcProxy, err := b.kubernetesDashboardOIDCProxy()
+		if err != nil {
+			return nil, err
+		}
+
+		// The OIDC auth proxy forwards the ID token of the authenticated user, hence the dashboard itself uses the token
+		// authentication mode.
+		values.AuthenticationMode = gardencorev1beta1.KubernetesDashboardAuthModeToken
+		values.OIDCProxy = oidcProxy
+	}
+
+	return kubernetesdashboard.New(b.SeedClientSet.Client(), b.Shoot.SeedNamespace, b.SecretsManager, values), nil
+}
+
+func (b *Botanist) kubernetesDashboardOIDCProxy() (*kubernetesdashboard.OIDCProxy, error) {
+	image, err := imagevector.ImageVector().FindImage(imagevector.ImageNameOauth2Proxy, imagevectorutils.RuntimeVersion(b.ShootVersion()), imagevectorutils.TargetVersion(b.ShootVersion()))
+	if err != nil {
+		return nil, err
+	}
+
+	oidcProxy := &kubernetesdashboard.OIDCProxy{Image: image.String()}
+
+	if kubeAPIServer := b.Shoot.GetInfo().Spec.Kubernetes.KubeAPIServer; kubeAPIServer != nil && kubeAPIServer.OIDCConfig != nil {
+		oidcConfig := kubeAPIServer.OIDCConfig
+		oidcProxy.IssuerURL = pointer.StringDeref(oidcConfig.IssuerURL, "")
+		oidcProxy.ClientID = pointer.StringDeref(oidcConfig.ClientID, "")
+		oidcProxy.CABundle = pointer.StringDeref(oidcConfig.CABundle, "")
+		if oidcConfig.ClientAuthentication != nil {
+			oidcProxy.ClientSecret = pointer.StringDeref(oidcConfig.ClientAuthentication.Secret, "")
+		}
+	}
+
+	return oidcProxy, nil
 }
 
 // DeployKubernetesDashboard deploys the Kubernetes Dashboard component.
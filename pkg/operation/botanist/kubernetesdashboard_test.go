@@ -21,6 +21,7 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	"go.uber.org/mock/gomock"
+	"k8s.io/utils/pointer"
 
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	kubernetesmock "github.com/gardener/gardener/pkg/client/kubernetes/mock"
@@ -78,6 +79,21 @@ var _ = Describe("Kubernetes Dashboard", func() {
 			Expect(kubernetesDashboard).NotTo(BeNil())
 			Expect(err).NotTo(HaveOccurred())
 		})
+
+		It("should successfully create a Kubernetes Dashboard interface with the OIDC authentication mode", func() {
+			shoot.Spec.Addons.KubernetesDashboard.AuthenticationMode = pointer.String("oidc")
+			shoot.Spec.Kubernetes.KubeAPIServer = &gardencorev1beta1.KubeAPIServerConfig{
+				OIDCConfig: &gardencorev1beta1.OIDCConfig{
+					IssuerURL: pointer.String("https://issuer.example.com"),
+					ClientID:  pointer.String("dashboard"),
+				},
+			}
+			botanist.Shoot.SetInfo(shoot)
+
+			kubernetesDashboard, err := botanist.DefaultKubernetesDashboard()
+			Expect(kubernetesDashboard).NotTo(BeNil())
+			Expect(err).NotTo(HaveOccurred())
+		})
 	})
 
 	Describe("#DeployKubernetesDashboard", func() {
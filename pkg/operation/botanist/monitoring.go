@@ -24,6 +24,7 @@ import (
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 	"github.com/gardener/gardener/pkg/component"
 	"github.com/gardener/gardener/pkg/component/monitoring"
+	"github.com/gardener/gardener/pkg/features"
 	gardenlethelper "github.com/gardener/gardener/pkg/gardenlet/apis/config/helper"
 	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
 )
@@ -69,6 +70,7 @@ func (b *Botanist) DefaultMonitoring() (monitoring.Interface, error) {
 		IsWorkerless:                 b.Shoot.IsWorkerless,
 		KubernetesVersion:            b.Shoot.GetInfo().Spec.Kubernetes.Version,
 		MonitoringConfig:             b.Shoot.GetInfo().Spec.Monitoring,
+		NodeAgentEnabled:             features.DefaultFeatureGate.Enabled(features.UseGardenerNodeAgent),
 		NodeLocalDNSEnabled:          b.Shoot.NodeLocalDNSEnabled,
 		ProjectName:                  b.Garden.Project.Name,
 		Replicas:                     b.Shoot.GetReplicas(1),
@@ -81,10 +83,10 @@ func (b *Botanist) DefaultMonitoring() (monitoring.Interface, error) {
 	}
 
 	if b.Shoot.Networks != nil {
-		if services := b.Shoot.Networks.Services; services != nil {
+		if services := b.Shoot.Networks.PrimaryServices(); services != nil {
 			values.ServiceNetworkCIDR = pointer.String(services.String())
 		}
-		if pods := b.Shoot.Networks.Pods; pods != nil {
+		if pods := b.Shoot.Networks.PrimaryPods(); pods != nil {
 			values.PodNetworkCIDR = pointer.String(pods.String())
 		}
 		if apiServer := b.Shoot.Networks.APIServer; apiServer != nil {
@@ -38,8 +38,8 @@ func (b *Botanist) DefaultNetwork() component.DeployMigrateWaiter {
 			Type:           *b.Shoot.GetInfo().Spec.Networking.Type,
 			IPFamilies:     ipFamilies,
 			ProviderConfig: b.Shoot.GetInfo().Spec.Networking.ProviderConfig,
-			PodCIDR:        b.Shoot.Networks.Pods,
-			ServiceCIDR:    b.Shoot.Networks.Services,
+			PodCIDR:        b.Shoot.Networks.PrimaryPods(),
+			ServiceCIDR:    b.Shoot.Networks.PrimaryServices(),
 		},
 		network.DefaultInterval,
 		network.DefaultSevereThreshold,
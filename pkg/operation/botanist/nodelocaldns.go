@@ -22,8 +22,10 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/gardener/gardener/imagevector"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	corednsconstants "github.com/gardener/gardener/pkg/component/coredns/constants"
 	"github.com/gardener/gardener/pkg/component/nodelocaldns"
 	imagevectorutils "github.com/gardener/gardener/pkg/utils/imagevector"
 	kubernetesutils "github.com/gardener/gardener/pkg/utils/kubernetes"
@@ -31,34 +33,72 @@ import (
 
 // DefaultNodeLocalDNS returns a deployer for the node-local-dns.
 func (b *Botanist) DefaultNodeLocalDNS() (nodelocaldns.Interface, error) {
-	image, err := imagevector.ImageVector().FindImage(imagevector.ImageNameNodeLocalDns, imagevectorutils.RuntimeVersion(b.ShootVersion()), imagevectorutils.TargetVersion(b.ShootVersion()))
+	values, err := b.nodeLocalDNSValues()
 	if err != nil {
 		return nil, err
 	}
 
+	return nodelocaldns.New(
+		b.SeedClientSet.Client(),
+		b.Shoot.SeedNamespace,
+		values,
+	), nil
+}
+
+// nodeLocalDNSValues computes the values for node-local-dns. They are used for both the deployer and the static pod in
+// the OperatingSystemConfig.
+func (b *Botanist) nodeLocalDNSValues() (nodelocaldns.Values, error) {
+	image, err := imagevector.ImageVector().FindImage(imagevector.ImageNameNodeLocalDns, imagevectorutils.RuntimeVersion(b.ShootVersion()), imagevectorutils.TargetVersion(b.ShootVersion()))
+	if err != nil {
+		return nodelocaldns.Values{}, err
+	}
+
+	staticPodEnabled := b.Shoot.GetInfo().Annotations[v1beta1constants.AnnotationNodeLocalDNSStaticPod] == "true"
+
 	// The node-local-dns interface cannot bind the kube-dns cluster IP since the interface
-	// used for IPVS load-balancing already uses this address.
+	// used for IPVS load-balancing already uses this address. The static pod does not bind it either, since its
+	// Corefile cannot be completed with the ClusterIP of the kube-dns-upstream Service, which is only known at runtime.
 	clusterDNS := "__PILLAR__CLUSTER__DNS__"
 	dnsServer := ""
-	if b.Shoot.IPVSEnabled() {
+	if b.Shoot.IPVSEnabled() || staticPodEnabled {
 		clusterDNS = b.Shoot.Networks.CoreDNS.String()
 	} else {
 		dnsServer = b.Shoot.Networks.CoreDNS.String()
 	}
 
-	return nodelocaldns.New(
-		b.SeedClientSet.Client(),
-		b.Shoot.SeedNamespace,
-		nodelocaldns.Values{
-			Image:             image.String(),
-			VPAEnabled:        b.Shoot.WantsVerticalPodAutoscaler,
-			Config:            v1beta1helper.GetNodeLocalDNS(b.Shoot.GetInfo().Spec.SystemComponents),
-			ClusterDNS:        clusterDNS,
-			DNSServer:         dnsServer,
-			PSPDisabled:       b.Shoot.PSPDisabled,
-			KubernetesVersion: b.Shoot.KubernetesVersion,
-		},
-	), nil
+	config := v1beta1helper.GetNodeLocalDNS(b.Shoot.GetInfo().Spec.SystemComponents)
+
+	var upstreamServers, customZones []string
+	if config != nil {
+		upstreamServers = config.UpstreamServers
+		customZones = config.CustomZones
+	}
+
+	var ipFamilies []gardencorev1beta1.IPFamily
+	if networking := b.Shoot.GetInfo().Spec.Networking; networking != nil {
+		ipFamilies = networking.IPFamilies
+	}
+
+	return nodelocaldns.Values{
+		Image:             image.String(),
+		VPAEnabled:        b.Shoot.WantsVerticalPodAutoscaler,
+		Config:            config,
+		ClusterDNS:        clusterDNS,
+		DNSServer:         dnsServer,
+		PSPDisabled:       b.Shoot.PSPDisabled,
+		KubernetesVersion: b.Shoot.KubernetesVersion,
+		ClusterDomain:     gardencorev1beta1.DefaultDomain,
+
+		HostNetworkEnabled: v1beta1helper.IsNodeLocalDNSHostNetworkEnabled(b.Shoot.GetInfo().Spec.SystemComponents, b.Shoot.GetInfo().GetAnnotations()),
+		StaticPodEnabled:   staticPodEnabled,
+
+		ForwardToNodeResolvers: v1beta1helper.IsNodeLocalDNSForwardToNodeResolversEnabled(b.Shoot.GetInfo().Spec.SystemComponents, b.Shoot.GetInfo().GetAnnotations()),
+		UpstreamServers:        upstreamServers,
+		CustomZones:            customZones,
+		IPFamilies:             ipFamilies,
+
+		CoreDNSReplicas: corednsconstants.MinReplicas,
+	}, nil
 }
 
 // ReconcileNodeLocalDNS deploys or destroys the node-local-dns component depending on whether it is enabled for the Shoot.
@@ -33,7 +33,7 @@ import (
 	"github.com/gardener/gardener/pkg/component/extensions/operatingsystemconfig"
 	"github.com/gardener/gardener/pkg/component/extensions/operatingsystemconfig/downloader"
 	"github.com/gardener/gardener/pkg/component/extensions/operatingsystemconfig/executor"
-	nodelocaldnsconstants "github.com/gardener/gardener/pkg/component/nodelocaldns/constants"
+	"github.com/gardener/gardener/pkg/component/nodelocaldns"
 	"github.com/gardener/gardener/pkg/utils/flow"
 	imagevectorutils "github.com/gardener/gardener/pkg/utils/imagevector"
 	kubernetesutils "github.com/gardener/gardener/pkg/utils/kubernetes"
@@ -53,11 +53,17 @@ func (b *Botanist) DefaultOperatingSystemConfig() (operatingsystemconfig.Interfa
 	}
 
 	clusterDNSAddress := b.Shoot.Networks.CoreDNS.String()
-	if b.Shoot.NodeLocalDNSEnabled && b.Shoot.IPVSEnabled() {
+	if b.Shoot.NodeLocalDNSEnabled && (b.Shoot.IPVSEnabled() || b.Shoot.GetInfo().Annotations[v1beta1constants.AnnotationNodeLocalDNSStaticPod] == "true") {
 		// If IPVS is enabled then instruct the kubelet to create pods resolving DNS to the `nodelocaldns` network
 		// interface link-local ip address. For more information checkout the usage documentation under
-		// https://kubernetes.io/docs/tasks/administer-cluster/nodelocaldns/.
-		clusterDNSAddress = nodelocaldnsconstants.IPVSAddress
+		// https://kubernetes.io/docs/tasks/administer-cluster/nodelocaldns/. The same applies if node-local-dns runs as
+		// static pod, since it does not bind the kube-dns cluster IP then. For dual-stack shoots, the address of the
+		// primary IP family is used.
+		var ipFamilies []gardencorev1beta1.IPFamily
+		if networking := b.Shoot.GetInfo().Spec.Networking; networking != nil {
+			ipFamilies = networking.IPFamilies
+		}
+		clusterDNSAddress = nodelocaldns.IPVSAddresses(ipFamilies)[0]
 	}
 
 	valitailEnabled, valiIngressHost := false, ""
@@ -65,6 +71,17 @@ func (b *Botanist) DefaultOperatingSystemConfig() (operatingsystemconfig.Interfa
 		valitailEnabled, valiIngressHost = true, b.ComputeValiHost()
 	}
 
+	var nodeLocalDNSStaticPodValues *nodelocaldns.Values
+	if b.Shoot.NodeLocalDNSEnabled {
+		values, err := b.nodeLocalDNSValues()
+		if err != nil {
+			return nil, err
+		}
+		if values.StaticPodEnabled {
+			nodeLocalDNSStaticPodValues = &values
+		}
+	}
+
 	return operatingsystemconfig.New(
 		b.Logger,
 		b.SeedClientSet.Client(),
@@ -83,6 +100,11 @@ func (b *Botanist) DefaultOperatingSystemConfig() (operatingsystemconfig.Interfa
 				ValitailEnabled:     valitailEnabled,
 				ValiIngressHostName: valiIngressHost,
 				NodeLocalDNSEnabled: v1beta1helper.IsNodeLocalDNSEnabled(b.Shoot.GetInfo().Spec.SystemComponents),
+
+				NodeLocalDNSHostNetworkEnabled: v1beta1helper.IsNodeLocalDNSHostNetworkEnabled(b.Shoot.GetInfo().Spec.SystemComponents, b.Shoot.GetInfo().GetAnnotations()),
+				NodeLocalDNSStaticPodValues:    nodeLocalDNSStaticPodValues,
+
+				NodeLocalDNSForwardToNodeResolvers: v1beta1helper.IsNodeLocalDNSForwardToNodeResolversEnabled(b.Shoot.GetInfo().Spec.SystemComponents, b.Shoot.GetInfo().GetAnnotations()),
 			},
 		},
 		operatingsystemconfig.DefaultInterval,
@@ -57,6 +57,7 @@ func (b *Botanist) DefaultResourceManager() (resourcemanager.Interface, error) {
 		"",
 		true,
 		v1beta1constants.PriorityClassNameShootControlPlane400,
+		v1beta1helper.ShootPriorityClassScaleUpDelays(b.Shoot.GetInfo()),
 		v1beta1helper.ShootSchedulingProfile(b.Shoot.GetInfo()),
 		v1beta1constants.SecretNameCACluster,
 		gardenerutils.ExtractSystemComponentsTolerations(b.Shoot.GetInfo().Spec.Provider.Workers),
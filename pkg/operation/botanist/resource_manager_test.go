@@ -103,6 +103,25 @@ var _ = Describe("ResourceManager", func() {
 			Expect(resourceManager.GetValues().DefaultNotReadyToleration).To(Equal(notReadyTolerationSeconds))
 			Expect(resourceManager.GetValues().DefaultUnreachableToleration).To(Equal(unreachableTolerationSeconds))
 		})
+
+		It("should consider the priority class scale-up delays of the cluster-autoscaler", func() {
+			botanist.Shoot.SetInfo(&gardencorev1beta1.Shoot{
+				Spec: gardencorev1beta1.ShootSpec{
+					Kubernetes: gardencorev1beta1.Kubernetes{
+						ClusterAutoscaler: &gardencorev1beta1.ClusterAutoscaler{
+							PriorityClassScaleUpDelays: []gardencorev1beta1.PriorityClassScaleUpDelay{
+								{PriorityClassName: "batch", NewPodScaleUpDelay: metav1.Duration{Duration: 5 * time.Minute}},
+							},
+						},
+					},
+				},
+			})
+
+			resourceManager, err := botanist.DefaultResourceManager()
+			Expect(resourceManager).NotTo(BeNil())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(resourceManager.GetValues().PriorityClassScaleUpDelays).To(Equal(map[string]metav1.Duration{"batch": {Duration: 5 * time.Minute}}))
+		})
 	})
 
 	Describe("#DeployGardenerResourceManager", func() {
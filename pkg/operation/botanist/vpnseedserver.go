@@ -76,8 +76,8 @@ func (b *Botanist) DefaultVPNSeedServer() (vpnseedserver.Interface, error) {
 		ImageAPIServerProxy: imageAPIServerProxy.String(),
 		ImageVPNSeedServer:  imageVPNSeedServer.String(),
 		Network: vpnseedserver.NetworkValues{
-			PodCIDR:     b.Shoot.Networks.Pods.String(),
-			ServiceCIDR: b.Shoot.Networks.Services.String(),
+			PodCIDR:     b.Shoot.Networks.PrimaryPods().String(),
+			ServiceCIDR: b.Shoot.Networks.PrimaryServices().String(),
 			NodeCIDR:    pointer.StringDeref(b.Shoot.GetInfo().Spec.Networking.Nodes, ""),
 			IPFamilies:  b.Shoot.GetInfo().Spec.Networking.IPFamilies,
 		},
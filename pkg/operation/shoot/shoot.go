@@ -500,10 +500,14 @@ func ToNetworks(s *gardencorev1beta1.Shoot, workerless bool) (*Networks, error)
 		return nil, fmt.Errorf("cannot calculate CoreDNS ClusterIP: %w", err)
 	}
 
-	return &Networks{
+	networks := &Networks{
 		CoreDNS:   coreDNS,
-		Pods:      pods,
-		Services:  svc,
+		Services:  []net.IPNet{*svc},
 		APIServer: apiserver,
-	}, nil
+	}
+	if pods != nil {
+		networks.Pods = []net.IPNet{*pods}
+	}
+
+	return networks, nil
 }
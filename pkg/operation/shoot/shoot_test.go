@@ -55,14 +55,14 @@ var _ = Describe("shoot", func() {
 
 				Expect(err).ToNot(HaveOccurred())
 				Expect(result).To(PointTo(Equal(Networks{
-					Pods: &net.IPNet{
+					Pods: []net.IPNet{{
 						IP:   []byte{10, 0, 0, 0},
 						Mask: []byte{255, 255, 255, 0},
-					},
-					Services: &net.IPNet{
+					}},
+					Services: []net.IPNet{{
 						IP:   []byte{20, 0, 0, 0},
 						Mask: []byte{255, 255, 255, 0},
-					},
+					}},
 					APIServer: []byte{20, 0, 0, 1},
 					CoreDNS:   []byte{20, 0, 0, 10},
 				})))
@@ -75,10 +75,10 @@ var _ = Describe("shoot", func() {
 				Expect(err).ToNot(HaveOccurred())
 				Expect(result).To(PointTo(Equal(Networks{
 					Pods: nil,
-					Services: &net.IPNet{
+					Services: []net.IPNet{{
 						IP:   []byte{20, 0, 0, 0},
 						Mask: []byte{255, 255, 255, 0},
-					},
+					}},
 					APIServer: []byte{20, 0, 0, 1},
 					CoreDNS:   []byte{20, 0, 0, 10},
 				})))
@@ -116,6 +116,27 @@ var _ = Describe("shoot", func() {
 			)
 		})
 
+		Describe("#PrimaryPods and #PrimaryServices", func() {
+			var (
+				ipv4 = net.IPNet{IP: net.IP{10, 0, 0, 0}, Mask: net.CIDRMask(16, 32)}
+				ipv6 = net.IPNet{IP: net.ParseIP("2001:db8::"), Mask: net.CIDRMask(64, 128)}
+			)
+
+			It("should return the subnets of the primary IP family", func() {
+				networks := &Networks{Pods: []net.IPNet{ipv6, ipv4}, Services: []net.IPNet{ipv4, ipv6}}
+
+				Expect(networks.PrimaryPods()).To(PointTo(Equal(ipv6)))
+				Expect(networks.PrimaryServices()).To(PointTo(Equal(ipv4)))
+			})
+
+			It("should return nil if there are no subnets", func() {
+				networks := &Networks{}
+
+				Expect(networks.PrimaryPods()).To(BeNil())
+				Expect(networks.PrimaryServices()).To(BeNil())
+			})
+		})
+
 		Describe("#IPVSEnabled", func() {
 			It("should return false when KubeProxy is null", func() {
 				shoot.GetInfo().Spec.Kubernetes.KubeProxy = nil
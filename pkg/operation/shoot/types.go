@@ -104,6 +104,9 @@ type Shoot struct {
 	Networks                                *Networks
 	BackupEntryName                         string
 	CloudConfigExecutionMaxDelaySeconds     int
+	// EncryptedDataRewritePaused is set during the reconciliation if the rewrite of encrypted data for the ETCD
+	// encryption key rotation is paused, i.e., the rotation must not advance to the next phase.
+	EncryptedDataRewritePaused bool
 
 	Components *Components
 }
@@ -192,12 +195,30 @@ type Addons struct {
 
 // Networks contains pre-calculated subnets and IP address for various components.
 type Networks struct {
-	// Pods subnet
-	Pods *net.IPNet
-	// Services subnet
-	Services *net.IPNet
+	// Pods are the pod subnets, one per IP family of the shoot. The first subnet belongs to the primary IP family.
+	Pods []net.IPNet
+	// Services are the service subnets, one per IP family of the shoot. The first subnet belongs to the primary IP
+	// family.
+	Services []net.IPNet
 	// APIServer is the ClusterIP of default/kubernetes Service
 	APIServer net.IP
 	// CoreDNS is the ClusterIP of kube-system/coredns Service
 	CoreDNS net.IP
 }
+
+// PrimaryPods returns the pod subnet of the primary IP family, or nil if the shoot has no pod network.
+func (n *Networks) PrimaryPods() *net.IPNet {
+	return primaryNetwork(n.Pods)
+}
+
+// PrimaryServices returns the service subnet of the primary IP family, or nil if the shoot has no service network.
+func (n *Networks) PrimaryServices() *net.IPNet {
+	return primaryNetwork(n.Services)
+}
+
+func primaryNetwork(networks []net.IPNet) *net.IPNet {
+	if len(networks) == 0 {
+		return nil
+	}
+	return &networks[0]
+}
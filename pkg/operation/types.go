@@ -20,6 +20,7 @@ import (
 
 	"github.com/go-logr/logr"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/tools/record"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
@@ -63,6 +64,7 @@ type Operation struct {
 	ManagedSeedAPIServer  *v1beta1helper.ManagedSeedAPIServer
 	GardenClient          client.Client
 	SeedClientSet         kubernetes.Interface
+	SeedRecorder          record.EventRecorder
 	ShootClientMap        clientmap.ClientMap
 	ShootClientSet        kubernetes.Interface
 	APIServerAddress      string
@@ -33,6 +33,7 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/util/intstr"
+	vpaautoscalingv1 "k8s.io/autoscaler/vertical-pod-autoscaler/pkg/apis/autoscaling.k8s.io/v1"
 	clientcmdlatest "k8s.io/client-go/tools/clientcmd/api/latest"
 	clientcmdv1 "k8s.io/client-go/tools/clientcmd/api/v1"
 	"k8s.io/component-base/version"
@@ -74,6 +75,7 @@ import (
 	controllermanagerv1alpha1 "github.com/gardener/gardener/pkg/controllermanager/apis/config/v1alpha1"
 	"github.com/gardener/gardener/pkg/features"
 	"github.com/gardener/gardener/pkg/logger"
+	schedulerv1alpha1 "github.com/gardener/gardener/pkg/scheduler/apis/config/v1alpha1"
 	"github.com/gardener/gardener/pkg/utils"
 	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
 	kubernetesutils "github.com/gardener/gardener/pkg/utils/kubernetes"
@@ -110,7 +112,7 @@ type components struct {
 	gardenerAPIServer           gardenerapiserver.Interface
 	gardenerAdmissionController component.DeployWaiter
 	gardenerControllerManager   component.DeployWaiter
-	gardenerScheduler           component.DeployWaiter
+	gardenerScheduler           gardenerscheduler.Interface
 
 	gardenerMetricsExporter       component.DeployWaiter
 	kubeStateMetrics              component.DeployWaiter
@@ -320,6 +322,7 @@ func (r *Reconciler) newVirtualGardenGardenerResourceManager(secretsManager secr
 		false,
 		v1beta1constants.PriorityClassNameGardenSystem400,
 		nil,
+		nil,
 		operatorv1alpha1.SecretNameCARuntime,
 		nil,
 		false,
@@ -562,7 +565,7 @@ func (r *Reconciler) newKubeAPIServer(
 		secretsManager,
 		namePrefix,
 		apiServerConfig,
-		defaultAPIServerAutoscalingConfig(garden),
+		kubeAPIServerAutoscalingConfig(garden),
 		garden.Spec.VirtualCluster.Networking.Services,
 		kubeapiserver.VPNConfig{Enabled: false},
 		v1beta1constants.PriorityClassNameGardenSystem500,
@@ -597,6 +600,59 @@ func defaultAPIServerAutoscalingConfig(garden *operatorv1alpha1.Garden) apiserve
 	}
 }
 
+// kubeAPIServerAutoscalingConfig returns the default autoscaling configuration of the API servers overwritten with the
+// autoscaling settings of the kube-apiserver of the virtual garden cluster.
+func kubeAPIServerAutoscalingConfig(garden *operatorv1alpha1.Garden) apiserver.AutoscalingConfig {
+	autoscalingConfig := defaultAPIServerAutoscalingConfig(garden)
+
+	if garden.Spec.VirtualCluster.Kubernetes.KubeAPIServer == nil || garden.Spec.VirtualCluster.Kubernetes.KubeAPIServer.Autoscaling == nil {
+		return autoscalingConfig
+	}
+	autoscaling := garden.Spec.VirtualCluster.Kubernetes.KubeAPIServer.Autoscaling
+
+	if autoscaling.MinReplicas != nil {
+		autoscalingConfig.MinReplicas = *autoscaling.MinReplicas
+	}
+	if autoscaling.MaxReplicas != nil {
+		autoscalingConfig.MaxReplicas = *autoscaling.MaxReplicas
+	}
+	// The default maximum must not undercut a configured minimum.
+	if autoscalingConfig.MaxReplicas < autoscalingConfig.MinReplicas {
+		autoscalingConfig.MaxReplicas = autoscalingConfig.MinReplicas
+	}
+
+	if autoscaling.ScalingClass != nil {
+		autoscalingConfig.APIServerResources = kubeAPIServerResourcesForScalingClass(*autoscaling.ScalingClass)
+	}
+
+	if autoscaling.VPAUpdateMode != nil {
+		updateMode := vpaautoscalingv1.UpdateMode(*autoscaling.VPAUpdateMode)
+		autoscalingConfig.VPAUpdateMode = &updateMode
+	}
+
+	return autoscalingConfig
+}
+
+// kubeAPIServerResourcesForScalingClass returns the initial resource requirements of the kube-apiserver for the given
+// scaling class. They are the starting point for the vertical autoscaling of the kube-apiserver.
+func kubeAPIServerResourcesForScalingClass(scalingClass operatorv1alpha1.ScalingClass) corev1.ResourceRequirements {
+	cpu, memory := "600m", "512Mi"
+
+	switch scalingClass {
+	case operatorv1alpha1.ScalingClassMedium:
+		cpu, memory = "1500m", "2Gi"
+	case operatorv1alpha1.ScalingClassLarge:
+		cpu, memory = "3000m", "6Gi"
+	}
+
+	return corev1.ResourceRequirements{
+		Requests: corev1.ResourceList{
+			corev1.ResourceCPU:    resource.MustParse(cpu),
+			corev1.ResourceMemory: resource.MustParse(memory),
+		},
+	}
+}
+
 func (r *Reconciler) computeAPIServerAuditWebhookConfig(ctx context.Context, config *operatorv1alpha1.AuditWebhook) (*apiserver.AuditWebhook, error) {
 	if config == nil {
 		return nil, nil
@@ -675,7 +731,7 @@ func (r *Reconciler) newKubeControllerManager(
 		true,
 		&kubecontrollermanager.HVPAConfig{Enabled: hvpaEnabled()},
 		nil,
-		services,
+		[]net.IPNet{*services},
 		certificateSigningDuration,
 		kubecontrollermanager.ControllerWorkers{
 			GarbageCollector:    pointer.Int(250),
@@ -686,6 +742,7 @@ func (r *Reconciler) newKubeControllerManager(
 		kubecontrollermanager.ControllerSyncPeriods{
 			ResourceQuota: pointer.Duration(time.Minute),
 		},
+		r.Recorder,
 	)
 }
 
@@ -944,7 +1001,7 @@ func (r *Reconciler) newGardenerControllerManager(garden *operatorv1alpha1.Garde
 	return gardenercontrollermanager.New(r.RuntimeClientSet.Client(), r.GardenNamespace, secretsManager, values), nil
 }
 
-func (r *Reconciler) newGardenerScheduler(garden *operatorv1alpha1.Garden, secretsManager secretsmanager.Interface) (component.DeployWaiter, error) {
+func (r *Reconciler) newGardenerScheduler(garden *operatorv1alpha1.Garden, secretsManager secretsmanager.Interface) (gardenerscheduler.Interface, error) {
 	image, err := imagevector.ImageVector().FindImage(imagevector.ImageNameGardenerScheduler)
 	if err != nil {
 		return nil, err
@@ -952,8 +1009,9 @@ func (r *Reconciler) newGardenerScheduler(garden *operatorv1alpha1.Garden, secre
 	image.WithOptionalTag(version.Get().GitVersion)
 
 	values := gardenerscheduler.Values{
-		Image:    image.String(),
-		LogLevel: logger.InfoLevel,
+		Image:                   image.String(),
+		LogLevel:                logger.InfoLevel,
+		HardenedSecurityContext: true,
 	}
 
 	if config := garden.Spec.VirtualCluster.Gardener.Scheduler; config != nil {
@@ -961,6 +1019,26 @@ func (r *Reconciler) newGardenerScheduler(garden *operatorv1alpha1.Garden, secre
 		if config.LogLevel != nil {
 			values.LogLevel = *config.LogLevel
 		}
+		if config.ShootSpreadStrategy != nil {
+			values.ShootSpreadStrategy = schedulerv1alpha1.SpreadStrategy(*config.ShootSpreadStrategy)
+		}
+		if config.ShootStrategy != nil {
+			values.ShootStrategy = schedulerv1alpha1.CandidateDeterminationStrategy(*config.ShootStrategy)
+		}
+		if weights := config.ShootCandidateWeights; weights != nil {
+			values.ShootCandidateWeights = &schedulerv1alpha1.CandidateWeights{
+				RegionAffinity: weights.RegionAffinity,
+				SeedCapacity:   pointer.BoolDeref(weights.SeedCapacity, false),
+			}
+		}
+		values.ShootRetryInterval = config.ShootRetryInterval
+		values.ShootMaxRetryBackoff = config.ShootMaxRetryBackoff
+		values.HardenedSecurityContext = pointer.BoolDeref(config.HardenedSecurityContext, true)
+		if config.Profiling != nil {
+			values.Profiling = &gardenerscheduler.Profiling{
+				ContentionProfilingEnabled: pointer.BoolDeref(config.Profiling.ContentionProfiling, false),
+			}
+		}
 	}
 
 	return gardenerscheduler.New(r.RuntimeClientSet.Client(), r.GardenNamespace, secretsManager, values), nil
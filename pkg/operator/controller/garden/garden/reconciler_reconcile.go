@@ -26,6 +26,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	podsecurityadmissionapi "k8s.io/pod-security-admission/api"
+	"k8s.io/utils/pointer"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
@@ -292,11 +293,21 @@ func (r *Reconciler) reconcile(
 			Fn:           component.OpWait(c.gardenerControllerManager).Deploy,
 			Dependencies: flow.NewTaskIDs(waitUntilGardenerAPIServerReady),
 		})
-		deployGardenerScheduler = g.Add(flow.Task{
-			Name:         "Deploying Gardener Scheduler",
-			Fn:           component.OpWait(c.gardenerScheduler).Deploy,
+		deployGardenerSchedulerRuntime = g.Add(flow.Task{
+			Name:         "Deploying Gardener Scheduler runtime resources",
+			Fn:           c.gardenerScheduler.DeployRuntime,
+			Dependencies: flow.NewTaskIDs(syncPointSystemComponents),
+		})
+		deployGardenerSchedulerVirtual = g.Add(flow.Task{
+			Name:         "Deploying Gardener Scheduler virtual resources",
+			Fn:           c.gardenerScheduler.DeployVirtual,
 			Dependencies: flow.NewTaskIDs(waitUntilGardenerAPIServerReady),
 		})
+		waitUntilGardenerSchedulerReady = g.Add(flow.Task{
+			Name:         "Waiting until Gardener Scheduler rolled out",
+			Fn:           c.gardenerScheduler.Wait,
+			Dependencies: flow.NewTaskIDs(deployGardenerSchedulerRuntime, deployGardenerSchedulerVirtual),
+		})
 
 		_ = g.Add(flow.Task{
 			Name:         "Deploying virtual system resources",
@@ -319,7 +330,7 @@ func (r *Reconciler) reconcile(
 				)
 			}).RetryUntilTimeout(5*time.Second, 30*time.Second),
 			SkipIf:       helper.GetServiceAccountKeyRotationPhase(garden.Status.Credentials) != gardencorev1beta1.RotationPreparing,
-			Dependencies: flow.NewTaskIDs(deployKubeControllerManager, deployVirtualGardenGardenerAccess, deployGardenerAPIServer, deployGardenerAdmissionController, deployGardenerControllerManager, deployGardenerScheduler),
+			Dependencies: flow.NewTaskIDs(deployKubeControllerManager, deployVirtualGardenGardenerAccess, deployGardenerAPIServer, deployGardenerAdmissionController, deployGardenerControllerManager, waitUntilGardenerSchedulerReady),
 		})
 		initializeVirtualClusterClient = g.Add(flow.Task{
 			Name: "Initializing connection to virtual garden cluster",
@@ -370,7 +381,15 @@ func (r *Reconciler) reconcile(
 		rewriteSecretsAddLabel = g.Add(flow.Task{
 			Name: "Labeling encrypted resources to re-encrypt them with new ETCD encryption key",
 			Fn: flow.TaskFn(func(ctx context.Context) error {
-				return secretsrotation.RewriteEncryptedDataAddLabel(ctx, log, virtualClusterClient, secretsManager, encryptedGVKs...)
+				gvks, err := secretsrotation.GetResourcesForEncryption(ctx, log, virtualClusterClient, encryptedGVKs, gardencorev1beta1.GroupName)
+				if err != nil {
+					return err
+				}
+				stateMachine, err := secretsrotation.NewETCDEncryptionKeyStateMachine(ctx, r.RuntimeClientSet.Client(), r.GardenNamespace, namePrefix+v1beta1constants.DeploymentNameKubeAPIServer, gardencorev1beta1.RotationPreparing)
+				if err != nil {
+					return err
+				}
+				return secretsrotation.RewriteEncryptedDataAddLabel(ctx, log, virtualClusterClient, secretsManager, secretsrotation.RewriteOptions{StepRunner: stateMachine}, gvks...)
 			}).RetryUntilTimeout(30*time.Second, 10*time.Minute),
 			SkipIf:       helper.GetETCDEncryptionKeyRotationPhase(garden.Status.Credentials) != gardencorev1beta1.RotationPreparing,
 			Dependencies: flow.NewTaskIDs(initializeVirtualClusterClient, waitUntilGardenerAPIServerReady),
@@ -386,7 +405,35 @@ func (r *Reconciler) reconcile(
 		_ = g.Add(flow.Task{
 			Name: "Removing label from re-encrypted resources after rotation of ETCD encryption key",
 			Fn: flow.TaskFn(func(ctx context.Context) error {
-				return secretsrotation.RewriteEncryptedDataRemoveLabel(ctx, log, r.RuntimeClientSet.Client(), virtualClusterClient, r.GardenNamespace, namePrefix+v1beta1constants.DeploymentNameKubeAPIServer, encryptedGVKs...)
+				stateMachine, err := secretsrotation.NewETCDEncryptionKeyStateMachine(ctx, r.RuntimeClientSet.Client(), r.GardenNamespace, namePrefix+v1beta1constants.DeploymentNameKubeAPIServer, gardencorev1beta1.RotationCompleting)
+				if err != nil {
+					return err
+				}
+
+				opts := secretsrotation.RewriteOptions{StepRunner: stateMachine}
+
+				discoveredGVKs, err := secretsrotation.DiscoverEncryptedResources(ctx, log, virtualClusterClient, shared.KubeAPIServerEncryptedResources, encryptedGVKs)
+				if err != nil {
+					return err
+				}
+				discoveredGVKs, err = secretsrotation.GetResourcesForEncryption(ctx, log, virtualClusterClient, discoveredGVKs, gardencorev1beta1.GroupName)
+				if err != nil {
+					return err
+				}
+				if len(discoveredGVKs) > 0 {
+					// Objects of resources registered after the start of the rotation might still be encrypted with the old
+					// key, hence they must be rewritten before the old key is removed. The rewrite is a step of the same
+					// state machine, i.e., it is not repeated once the label was removed from all objects.
+					if err := secretsrotation.RewriteDiscoveredEncryptedDataAddLabel(ctx, log, virtualClusterClient, secretsManager, opts, discoveredGVKs...); err != nil {
+						return err
+					}
+				}
+
+				gvks, err := secretsrotation.GetResourcesForEncryption(ctx, log, virtualClusterClient, encryptedGVKs, gardencorev1beta1.GroupName)
+				if err != nil {
+					return err
+				}
+				return secretsrotation.RewriteEncryptedDataRemoveLabel(ctx, log, r.RuntimeClientSet.Client(), virtualClusterClient, r.GardenNamespace, namePrefix+v1beta1constants.DeploymentNameKubeAPIServer, opts, append(gvks, discoveredGVKs...)...)
 			}).RetryUntilTimeout(30*time.Second, 10*time.Minute),
 			SkipIf:       helper.GetETCDEncryptionKeyRotationPhase(garden.Status.Credentials) != gardencorev1beta1.RotationCompleting,
 			Dependencies: flow.NewTaskIDs(initializeVirtualClusterClient, waitUntilGardenerAPIServerReady),
@@ -496,6 +543,13 @@ func (r *Reconciler) deployKubeAPIServerFunc(garden *operatorv1alpha1.Garden, ku
 			}
 		}
 
+		for _, domainTLS := range garden.Spec.VirtualCluster.DNS.DomainTLS {
+			sniConfig.TLS = append(sniConfig.TLS, kubeapiserver.TLSSNIConfig{
+				SecretName:     pointer.String(domainTLS.SecretName),
+				DomainPatterns: getAPIServerDomains([]string{domainTLS.Domain}),
+			})
+		}
+
 		return shared.DeployKubeAPIServer(
 			ctx,
 			r.RuntimeClientSet.Client(),
@@ -54,6 +54,7 @@ func Predicate(oldObj, newObj client.Object) bool {
 		etcdBackupSecretChanged(oldGarden.Spec.VirtualCluster.ETCD, newGarden.Spec.VirtualCluster.ETCD) ||
 		authenticationWebhookSecretChanged(oldGarden.Spec.VirtualCluster.Kubernetes.KubeAPIServer, newGarden.Spec.VirtualCluster.Kubernetes.KubeAPIServer) ||
 		sniSecretChanged(oldGarden.Spec.VirtualCluster.Kubernetes.KubeAPIServer, newGarden.Spec.VirtualCluster.Kubernetes.KubeAPIServer) ||
+		domainTLSSecretChanged(oldGarden.Spec.VirtualCluster.DNS, newGarden.Spec.VirtualCluster.DNS) ||
 		kubeAPIServerAuditWebhookSecretChanged(oldGarden.Spec.VirtualCluster.Kubernetes.KubeAPIServer, newGarden.Spec.VirtualCluster.Kubernetes.KubeAPIServer) ||
 		gardenerAPIServerAuditWebhookSecretChanged(oldGarden.Spec.VirtualCluster.Gardener.APIServer, newGarden.Spec.VirtualCluster.Gardener.APIServer) ||
 		kubeAPIServerAdmissionPluginSecretChanged(oldGarden.Spec.VirtualCluster.Kubernetes.KubeAPIServer, newGarden.Spec.VirtualCluster.Kubernetes.KubeAPIServer) ||
@@ -128,6 +129,19 @@ func sniSecretChanged(oldKubeAPIServer, newKubeAPIServer *operatorv1alpha1.KubeA
 	return oldSecret != newSecret
 }
 
+func domainTLSSecretChanged(oldDNS, newDNS operatorv1alpha1.DNS) bool {
+	oldSecrets, newSecrets := sets.Set[string]{}, sets.Set[string]{}
+
+	for _, domainTLS := range oldDNS.DomainTLS {
+		oldSecrets.Insert(domainTLS.SecretName)
+	}
+	for _, domainTLS := range newDNS.DomainTLS {
+		newSecrets.Insert(domainTLS.SecretName)
+	}
+
+	return !oldSecrets.Equal(newSecrets)
+}
+
 func kubeAPIServerAuditWebhookSecretChanged(oldKubeAPIServer, newKubeAPIServer *operatorv1alpha1.KubeAPIServerConfig) bool {
 	var oldSecret, newSecret string
 
@@ -235,6 +249,10 @@ func getReferencedSecretNames(obj client.Object) []string {
 		out = append(out, virtualCluster.Kubernetes.KubeAPIServer.SNI.SecretName)
 	}
 
+	for _, domainTLS := range virtualCluster.DNS.DomainTLS {
+		out = append(out, domainTLS.SecretName)
+	}
+
 	if virtualCluster.Kubernetes.KubeAPIServer != nil && virtualCluster.Kubernetes.KubeAPIServer.AuditWebhook != nil {
 		out = append(out, virtualCluster.Kubernetes.KubeAPIServer.AuditWebhook.KubeconfigSecretName)
 	}
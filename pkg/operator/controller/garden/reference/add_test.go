@@ -94,6 +94,12 @@ var _ = Describe("Add", func() {
 			Expect(Predicate(oldShoot, garden)).To(BeTrue())
 		})
 
+		It("should return true because the domain TLS secret fields changed", func() {
+			oldShoot := garden.DeepCopy()
+			garden.Spec.VirtualCluster.DNS.DomainTLS = []operatorv1alpha1.DomainTLS{{Domain: "example.com", SecretName: "secret-tls"}}
+			Expect(Predicate(oldShoot, garden)).To(BeTrue())
+		})
+
 		It("should return true because the authentication webhook secret field changed", func() {
 			oldShoot := garden.DeepCopy()
 			garden.Spec.VirtualCluster.Kubernetes.KubeAPIServer.Authentication = &operatorv1alpha1.Authentication{Webhook: &operatorv1alpha1.AuthenticationWebhook{KubeconfigSecretName: "auth-secret"}}
@@ -129,17 +129,18 @@ func (w *workerDelegate) generateMachineConfig() error {
 		})
 
 		machineDeployments = append(machineDeployments, worker.MachineDeployment{
-			Name:                 deploymentName,
-			ClassName:            className,
-			SecretName:           className,
-			Minimum:              pool.Minimum,
-			Maximum:              pool.Maximum,
-			MaxSurge:             pool.MaxSurge,
-			MaxUnavailable:       pool.MaxUnavailable,
-			Labels:               pool.Labels,
-			Annotations:          pool.Annotations,
-			Taints:               pool.Taints,
-			MachineConfiguration: genericworkeractuator.ReadMachineConfiguration(pool),
+			Name:                         deploymentName,
+			ClassName:                    className,
+			SecretName:                   className,
+			Minimum:                      pool.Minimum,
+			Maximum:                      pool.Maximum,
+			MaxSurge:                     pool.MaxSurge,
+			MaxUnavailable:               pool.MaxUnavailable,
+			Labels:                       pool.Labels,
+			Annotations:                  pool.Annotations,
+			Taints:                       pool.Taints,
+			MachineConfiguration:         genericworkeractuator.ReadMachineConfiguration(pool),
+			ClusterAutoscalerAnnotations: genericworkeractuator.ReadClusterAutoscalerAnnotations(pool),
 		})
 	}
 
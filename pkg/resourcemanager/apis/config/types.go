@@ -224,6 +224,8 @@ type ResourceManagerWebhookConfiguration struct {
 	HighAvailabilityConfig HighAvailabilityConfigWebhookConfig
 	// KubernetesServiceHost is the configuration for the kubernetes-service-host webhook.
 	KubernetesServiceHost KubernetesServiceHostWebhookConfig
+	// PodScaleUpDelay is the configuration for the pod-scale-up-delay webhook.
+	PodScaleUpDelay PodScaleUpDelayWebhookConfig
 	// PodSchedulerName is the configuration for the pod-scheduler-name webhook.
 	PodSchedulerName PodSchedulerNameWebhookConfig
 	// PodTopologySpreadConstraints is the configuration for the pod-topology-spread-constraints webhook.
@@ -288,6 +290,15 @@ type SystemComponentsConfigWebhookConfig struct {
 	PodTolerations []corev1.Toleration
 }
 
+// PodScaleUpDelayWebhookConfig is the configuration for the pod-scale-up-delay webhook.
+type PodScaleUpDelayWebhookConfig struct {
+	// Enabled defines whether this webhook is enabled.
+	Enabled bool
+	// PriorityClassScaleUpDelays maps names of priority classes to the delay after which the cluster-autoscaler should
+	// consider newly created pods of this priority class for scale-up.
+	PriorityClassScaleUpDelays map[string]metav1.Duration
+}
+
 // PodSchedulerNameWebhookConfig is the configuration for the pod-scheduler-name webhook.
 type PodSchedulerNameWebhookConfig struct {
 	// Enabled defines whether this webhook is enabled.
@@ -251,6 +251,8 @@ type ResourceManagerWebhookConfiguration struct {
 	KubernetesServiceHost KubernetesServiceHostWebhookConfig `json:"kubernetesServiceHost"`
 	// SystemComponentsConfig is the configuration for the system-components-config webhook.
 	SystemComponentsConfig SystemComponentsConfigWebhookConfig `json:"systemComponentsConfig"`
+	// PodScaleUpDelay is the configuration for the pod-scale-up-delay webhook.
+	PodScaleUpDelay PodScaleUpDelayWebhookConfig `json:"podScaleUpDelay"`
 	// PodSchedulerName is the configuration for the pod-scheduler-name webhook.
 	PodSchedulerName PodSchedulerNameWebhookConfig `json:"podSchedulerName"`
 	// PodTopologySpreadConstraints is the configuration for the pod-topology-spread-constraints webhook.
@@ -318,6 +320,16 @@ type SystemComponentsConfigWebhookConfig struct {
 	PodTolerations []corev1.Toleration `json:"podTolerations,omitempty"`
 }
 
+// PodScaleUpDelayWebhookConfig is the configuration for the pod-scale-up-delay webhook.
+type PodScaleUpDelayWebhookConfig struct {
+	// Enabled defines whether this webhook is enabled.
+	Enabled bool `json:"enabled"`
+	// PriorityClassScaleUpDelays maps names of priority classes to the delay after which the cluster-autoscaler should
+	// consider newly created pods of this priority class for scale-up.
+	// +optional
+	PriorityClassScaleUpDelays map[string]metav1.Duration `json:"priorityClassScaleUpDelays,omitempty"`
+}
+
 // PodSchedulerNameWebhookConfig is the configuration for the pod-scheduler-name webhook.
 type PodSchedulerNameWebhookConfig struct {
 	// Enabled defines whether this webhook is enabled.
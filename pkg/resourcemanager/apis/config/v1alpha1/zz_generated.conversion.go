@@ -180,6 +180,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*PodScaleUpDelayWebhookConfig)(nil), (*config.PodScaleUpDelayWebhookConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_PodScaleUpDelayWebhookConfig_To_config_PodScaleUpDelayWebhookConfig(a.(*PodScaleUpDelayWebhookConfig), b.(*config.PodScaleUpDelayWebhookConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.PodScaleUpDelayWebhookConfig)(nil), (*PodScaleUpDelayWebhookConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_PodScaleUpDelayWebhookConfig_To_v1alpha1_PodScaleUpDelayWebhookConfig(a.(*config.PodScaleUpDelayWebhookConfig), b.(*PodScaleUpDelayWebhookConfig), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*PodSchedulerNameWebhookConfig)(nil), (*config.PodSchedulerNameWebhookConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_PodSchedulerNameWebhookConfig_To_config_PodSchedulerNameWebhookConfig(a.(*PodSchedulerNameWebhookConfig), b.(*config.PodSchedulerNameWebhookConfig), scope)
 	}); err != nil {
@@ -663,6 +673,28 @@ func Convert_config_NodeControllerConfig_To_v1alpha1_NodeControllerConfig(in *co
 	return autoConvert_config_NodeControllerConfig_To_v1alpha1_NodeControllerConfig(in, out, s)
 }
 
+func autoConvert_v1alpha1_PodScaleUpDelayWebhookConfig_To_config_PodScaleUpDelayWebhookConfig(in *PodScaleUpDelayWebhookConfig, out *config.PodScaleUpDelayWebhookConfig, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	out.PriorityClassScaleUpDelays = *(*map[string]v1.Duration)(unsafe.Pointer(&in.PriorityClassScaleUpDelays))
+	return nil
+}
+
+// Convert_v1alpha1_PodScaleUpDelayWebhookConfig_To_config_PodScaleUpDelayWebhookConfig is an autogenerated conversion function.
+func Convert_v1alpha1_PodScaleUpDelayWebhookConfig_To_config_PodScaleUpDelayWebhookConfig(in *PodScaleUpDelayWebhookConfig, out *config.PodScaleUpDelayWebhookConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_PodScaleUpDelayWebhookConfig_To_config_PodScaleUpDelayWebhookConfig(in, out, s)
+}
+
+func autoConvert_config_PodScaleUpDelayWebhookConfig_To_v1alpha1_PodScaleUpDelayWebhookConfig(in *config.PodScaleUpDelayWebhookConfig, out *PodScaleUpDelayWebhookConfig, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	out.PriorityClassScaleUpDelays = *(*map[string]v1.Duration)(unsafe.Pointer(&in.PriorityClassScaleUpDelays))
+	return nil
+}
+
+// Convert_config_PodScaleUpDelayWebhookConfig_To_v1alpha1_PodScaleUpDelayWebhookConfig is an autogenerated conversion function.
+func Convert_config_PodScaleUpDelayWebhookConfig_To_v1alpha1_PodScaleUpDelayWebhookConfig(in *config.PodScaleUpDelayWebhookConfig, out *PodScaleUpDelayWebhookConfig, s conversion.Scope) error {
+	return autoConvert_config_PodScaleUpDelayWebhookConfig_To_v1alpha1_PodScaleUpDelayWebhookConfig(in, out, s)
+}
+
 func autoConvert_v1alpha1_PodSchedulerNameWebhookConfig_To_config_PodSchedulerNameWebhookConfig(in *PodSchedulerNameWebhookConfig, out *config.PodSchedulerNameWebhookConfig, s conversion.Scope) error {
 	out.Enabled = in.Enabled
 	out.SchedulerName = (*string)(unsafe.Pointer(in.SchedulerName))
@@ -910,6 +942,9 @@ func autoConvert_v1alpha1_ResourceManagerWebhookConfiguration_To_config_Resource
 	if err := Convert_v1alpha1_SystemComponentsConfigWebhookConfig_To_config_SystemComponentsConfigWebhookConfig(&in.SystemComponentsConfig, &out.SystemComponentsConfig, s); err != nil {
 		return err
 	}
+	if err := Convert_v1alpha1_PodScaleUpDelayWebhookConfig_To_config_PodScaleUpDelayWebhookConfig(&in.PodScaleUpDelay, &out.PodScaleUpDelay, s); err != nil {
+		return err
+	}
 	if err := Convert_v1alpha1_PodSchedulerNameWebhookConfig_To_config_PodSchedulerNameWebhookConfig(&in.PodSchedulerName, &out.PodSchedulerName, s); err != nil {
 		return err
 	}
@@ -949,6 +984,9 @@ func autoConvert_config_ResourceManagerWebhookConfiguration_To_v1alpha1_Resource
 	if err := Convert_config_KubernetesServiceHostWebhookConfig_To_v1alpha1_KubernetesServiceHostWebhookConfig(&in.KubernetesServiceHost, &out.KubernetesServiceHost, s); err != nil {
 		return err
 	}
+	if err := Convert_config_PodScaleUpDelayWebhookConfig_To_v1alpha1_PodScaleUpDelayWebhookConfig(&in.PodScaleUpDelay, &out.PodScaleUpDelay, s); err != nil {
+		return err
+	}
 	if err := Convert_config_PodSchedulerNameWebhookConfig_To_v1alpha1_PodSchedulerNameWebhookConfig(&in.PodSchedulerName, &out.PodSchedulerName, s); err != nil {
 		return err
 	}
@@ -134,6 +134,7 @@ func validateManagedResourceControllerConfiguration(conf config.ManagedResourceC
 func validateResourceManagerWebhookConfiguration(conf config.ResourceManagerWebhookConfiguration, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 
+	allErrs = append(allErrs, validatePodScaleUpDelayWebhookConfiguration(conf.PodScaleUpDelay, fldPath.Child("podScaleUpDelay"))...)
 	allErrs = append(allErrs, validatePodSchedulerNameWebhookConfiguration(conf.PodSchedulerName, fldPath.Child("podSchedulerName"))...)
 	allErrs = append(allErrs, validateProjectedTokenMountWebhookConfiguration(conf.ProjectedTokenMount, fldPath.Child("projectedTokenMount"))...)
 	allErrs = append(allErrs, validateHighAvailabilityConfigWebhookConfiguration(conf.HighAvailabilityConfig, fldPath.Child("highAvailabilityConfig"))...)
@@ -142,6 +143,18 @@ func validateResourceManagerWebhookConfiguration(conf config.ResourceManagerWebh
 	return allErrs
 }
 
+func validatePodScaleUpDelayWebhookConfiguration(conf config.PodScaleUpDelayWebhookConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	for priorityClassName, delay := range conf.PriorityClassScaleUpDelays {
+		if delay.Duration < 0 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("priorityClassScaleUpDelays").Key(priorityClassName), delay.Duration.String(), "must not be negative"))
+		}
+	}
+
+	return allErrs
+}
+
 func validatePodSchedulerNameWebhookConfiguration(conf config.PodSchedulerNameWebhookConfig, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 
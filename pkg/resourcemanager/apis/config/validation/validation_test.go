@@ -324,6 +324,23 @@ var _ = Describe("Validation", func() {
 		})
 
 		Context("webhook configuration", func() {
+			Context("pod scale-up delay", func() {
+				It("should return errors when a scale-up delay is negative", func() {
+					conf.Webhooks.PodScaleUpDelay.Enabled = true
+					conf.Webhooks.PodScaleUpDelay.PriorityClassScaleUpDelays = map[string]metav1.Duration{
+						"batch":    {Duration: -time.Minute},
+						"critical": {},
+					}
+
+					Expect(ValidateResourceManagerConfiguration(conf)).To(ConsistOf(
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeInvalid),
+							"Field": Equal("webhooks.podScaleUpDelay.priorityClassScaleUpDelays[batch]"),
+						})),
+					))
+				})
+			})
+
 			Context("pod scheduler name", func() {
 				It("should return errors when scheduler name is nil", func() {
 					conf.Webhooks.PodSchedulerName.Enabled = true
@@ -343,6 +343,29 @@ func (in *NodeControllerConfig) DeepCopy() *NodeControllerConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PodScaleUpDelayWebhookConfig) DeepCopyInto(out *PodScaleUpDelayWebhookConfig) {
+	*out = *in
+	if in.PriorityClassScaleUpDelays != nil {
+		in, out := &in.PriorityClassScaleUpDelays, &out.PriorityClassScaleUpDelays
+		*out = make(map[string]v1.Duration, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodScaleUpDelayWebhookConfig.
+func (in *PodScaleUpDelayWebhookConfig) DeepCopy() *PodScaleUpDelayWebhookConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(PodScaleUpDelayWebhookConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PodSchedulerNameWebhookConfig) DeepCopyInto(out *PodSchedulerNameWebhookConfig) {
 	*out = *in
@@ -484,6 +507,7 @@ func (in *ResourceManagerWebhookConfiguration) DeepCopyInto(out *ResourceManager
 	out.ExtensionValidation = in.ExtensionValidation
 	in.HighAvailabilityConfig.DeepCopyInto(&out.HighAvailabilityConfig)
 	out.KubernetesServiceHost = in.KubernetesServiceHost
+	in.PodScaleUpDelay.DeepCopyInto(&out.PodScaleUpDelay)
 	in.PodSchedulerName.DeepCopyInto(&out.PodSchedulerName)
 	out.PodTopologySpreadConstraints = in.PodTopologySpreadConstraints
 	in.ProjectedTokenMount.DeepCopyInto(&out.ProjectedTokenMount)
@@ -27,6 +27,7 @@ import (
 	"github.com/gardener/gardener/pkg/resourcemanager/webhook/extensionvalidation"
 	"github.com/gardener/gardener/pkg/resourcemanager/webhook/highavailabilityconfig"
 	"github.com/gardener/gardener/pkg/resourcemanager/webhook/kubernetesservicehost"
+	"github.com/gardener/gardener/pkg/resourcemanager/webhook/podscaleupdelay"
 	"github.com/gardener/gardener/pkg/resourcemanager/webhook/podschedulername"
 	"github.com/gardener/gardener/pkg/resourcemanager/webhook/podtopologyspreadconstraints"
 	"github.com/gardener/gardener/pkg/resourcemanager/webhook/projectedtokenmount"
@@ -93,6 +94,15 @@ func AddToManager(mgr manager.Manager, sourceCluster, targetCluster cluster.Clus
 		}
 	}
 
+	if cfg.Webhooks.PodScaleUpDelay.Enabled {
+		if err := (&podscaleupdelay.Handler{
+			Logger:                     mgr.GetLogger().WithName("webhook").WithName(podscaleupdelay.HandlerName),
+			PriorityClassScaleUpDelays: cfg.Webhooks.PodScaleUpDelay.PriorityClassScaleUpDelays,
+		}).AddToManager(mgr); err != nil {
+			return fmt.Errorf("failed adding %s webhook handler: %w", podscaleupdelay.HandlerName, err)
+		}
+	}
+
 	if cfg.Webhooks.PodSchedulerName.Enabled {
 		if err := (&podschedulername.Handler{
 			SchedulerName: *cfg.Webhooks.PodSchedulerName.SchedulerName,
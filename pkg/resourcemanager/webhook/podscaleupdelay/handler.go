@@ -0,0 +1,70 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package podscaleupdelay
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	kubernetesutils "github.com/gardener/gardener/pkg/utils/kubernetes"
+)
+
+// AnnotationPodScaleUpDelay is the annotation the cluster-autoscaler reads to determine how long newly created pods
+// should be ignored before they are considered for scale-up. It takes precedence over the global
+// --new-pod-scale-up-delay flag of the cluster-autoscaler.
+const AnnotationPodScaleUpDelay = "cluster-autoscaler.kubernetes.io/pod-scale-up-delay"
+
+// Handler handles admission requests and sets the cluster-autoscaler.kubernetes.io/pod-scale-up-delay annotation on
+// Pod resources depending on their priority class.
+type Handler struct {
+	Logger logr.Logger
+	// PriorityClassScaleUpDelays maps names of priority classes to the scale-up delay for their pods.
+	PriorityClassScaleUpDelays map[string]metav1.Duration
+}
+
+// Default defaults the scale-up delay annotation of the provided pod.
+func (h *Handler) Default(ctx context.Context, obj runtime.Object) error {
+	pod, ok := obj.(*corev1.Pod)
+	if !ok {
+		return fmt.Errorf("expected *corev1.Pod but got %T", obj)
+	}
+
+	delay, ok := h.PriorityClassScaleUpDelays[pod.Spec.PriorityClassName]
+	if !ok {
+		return nil
+	}
+
+	// Do not overwrite the scale-up delay if it is already specified
+	if _, ok := pod.Annotations[AnnotationPodScaleUpDelay]; ok {
+		return nil
+	}
+
+	req, err := admission.RequestFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	log := h.Logger.WithValues("pod", kubernetesutils.ObjectKeyForCreateWebhooks(pod, req))
+	log.Info("Mutating pod with scale-up delay of its priority class", "priorityClassName", pod.Spec.PriorityClassName, "delay", delay.Duration)
+
+	metav1.SetMetaDataAnnotation(&pod.ObjectMeta, AnnotationPodScaleUpDelay, delay.Duration.String())
+	return nil
+}
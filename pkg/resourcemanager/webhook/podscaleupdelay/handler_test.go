@@ -0,0 +1,91 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package podscaleupdelay_test
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	logzap "sigs.k8s.io/controller-runtime/pkg/log/zap"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	"github.com/gardener/gardener/pkg/logger"
+	. "github.com/gardener/gardener/pkg/resourcemanager/webhook/podscaleupdelay"
+)
+
+var _ = Describe("Handler", func() {
+	var (
+		ctx     = context.TODO()
+		log     logr.Logger
+		handler *Handler
+
+		pod *corev1.Pod
+	)
+
+	BeforeEach(func() {
+		ctx = admission.NewContextWithRequest(ctx, admission.Request{})
+		log = logger.MustNewZapLogger(logger.InfoLevel, logger.FormatJSON, logzap.WriteTo(GinkgoWriter))
+		handler = &Handler{
+			Logger: log,
+			PriorityClassScaleUpDelays: map[string]metav1.Duration{
+				"batch":    {Duration: 5 * time.Minute},
+				"critical": {},
+			},
+		}
+
+		pod = &corev1.Pod{}
+	})
+
+	Describe("#Default", func() {
+		It("should not annotate the pod when it has no priority class", func() {
+			Expect(handler.Default(ctx, pod)).To(Succeed())
+			Expect(pod.Annotations).To(BeEmpty())
+		})
+
+		It("should not annotate the pod when its priority class has no scale-up delay", func() {
+			pod.Spec.PriorityClassName = "other"
+
+			Expect(handler.Default(ctx, pod)).To(Succeed())
+			Expect(pod.Annotations).To(BeEmpty())
+		})
+
+		It("should annotate the pod with the scale-up delay of its priority class", func() {
+			pod.Spec.PriorityClassName = "batch"
+
+			Expect(handler.Default(ctx, pod)).To(Succeed())
+			Expect(pod.Annotations).To(HaveKeyWithValue("cluster-autoscaler.kubernetes.io/pod-scale-up-delay", "5m0s"))
+		})
+
+		It("should annotate the pod with a zero scale-up delay", func() {
+			pod.Spec.PriorityClassName = "critical"
+
+			Expect(handler.Default(ctx, pod)).To(Succeed())
+			Expect(pod.Annotations).To(HaveKeyWithValue("cluster-autoscaler.kubernetes.io/pod-scale-up-delay", "0s"))
+		})
+
+		It("should not overwrite an already specified scale-up delay", func() {
+			pod.Spec.PriorityClassName = "batch"
+			pod.Annotations = map[string]string{"cluster-autoscaler.kubernetes.io/pod-scale-up-delay": "1m"}
+
+			Expect(handler.Default(ctx, pod)).To(Succeed())
+			Expect(pod.Annotations).To(HaveKeyWithValue("cluster-autoscaler.kubernetes.io/pod-scale-up-delay", "1m"))
+		})
+	})
+})
@@ -28,6 +28,13 @@ const (
 	MinimalDistance CandidateDeterminationStrategy = "MinimalDistance"
 	// Default Strategy is the default strategy to use when there is no configuration provided
 	Default CandidateDeterminationStrategy = SameRegion
+	// LeastShoots SpreadStrategy chooses the seed candidate which hosts the least number of shoots.
+	LeastShoots SpreadStrategy = "LeastShoots"
+	// ProjectAntiAffinity SpreadStrategy chooses the seed candidate which hosts the least number of shoots of the same
+	// project as the shoot. Ties are broken by choosing the seed candidate which hosts the least number of shoots.
+	ProjectAntiAffinity SpreadStrategy = "ProjectAntiAffinity"
+	// DefaultSpreadStrategy is the default spread strategy to use when there is no configuration provided
+	DefaultSpreadStrategy = LeastShoots
 	// SchedulerDefaultLockObjectNamespace is the default lock namespace for leader election.
 	SchedulerDefaultLockObjectNamespace = "garden"
 	// SchedulerDefaultLockObjectName is the default lock name for leader election.
@@ -46,6 +53,13 @@ var Strategies = []CandidateDeterminationStrategy{SameRegion, MinimalDistance}
 // CandidateDeterminationStrategy defines how seeds for shoots, that do not specify a seed explicitly, are being determined
 type CandidateDeterminationStrategy string
 
+// SpreadStrategies defines all currently implemented SpreadStrategies
+var SpreadStrategies = []SpreadStrategy{LeastShoots, ProjectAntiAffinity}
+
+// SpreadStrategy defines how shoots are spread over the seed candidates which were determined by the
+// CandidateDeterminationStrategy
+type SpreadStrategy string
+
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
 // SchedulerConfiguration provides the configuration for the Gardener scheduler
@@ -113,6 +127,27 @@ type ShootSchedulerConfiguration struct {
 	ConcurrentSyncs int
 	// Strategy defines how seeds for shoots, that do not specify a seed explicitly, are being determined
 	Strategy CandidateDeterminationStrategy
+	// SpreadStrategy defines how shoots are spread over the seed candidates which were determined by the Strategy
+	SpreadStrategy SpreadStrategy
+	// CandidateWeights configures how the seed candidates which were determined by the Strategy are weighted before
+	// the SpreadStrategy chooses the winner.
+	CandidateWeights *CandidateWeights
+	// RetryInterval is the initial duration after which the scheduling of a shoot which could not be scheduled is
+	// retried. The duration is doubled after each failed attempt up to MaxRetryBackoff. Defaults to 5ms.
+	RetryInterval *metav1.Duration
+	// MaxRetryBackoff is the maximum duration after which the scheduling of a shoot which could not be scheduled is
+	// retried. Defaults to 1000s.
+	MaxRetryBackoff *metav1.Duration
+}
+
+// CandidateWeights configures how the seed candidates are weighted before the SpreadStrategy chooses the winner.
+type CandidateWeights struct {
+	// RegionAffinity maps regions to weights. Only the seed candidates in the region(s) with the highest weight are
+	// passed to the SpreadStrategy. Regions which are not listed have a weight of 0.
+	RegionAffinity map[string]int32
+	// SeedCapacity makes the SpreadStrategy compare the number of shoots relative to the allocatable shoots of the seed
+	// candidates instead of the absolute number of shoots.
+	SeedCapacity bool
 }
 
 // ServerConfiguration contains details for the HTTP(S) servers.
@@ -121,6 +156,9 @@ type ServerConfiguration struct {
 	HealthProbes *Server
 	// Metrics is the configuration for serving the metrics endpoint.
 	Metrics *Server
+	// Profiling is the configuration for serving the profiling endpoints on a dedicated port. It is only considered if
+	// profiling is enabled. If nil, the profiling endpoints are served by the metrics server.
+	Profiling *Server
 }
 
 // Server contains information for HTTP(S) server configuration.
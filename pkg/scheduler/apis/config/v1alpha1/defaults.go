@@ -15,6 +15,9 @@
 package v1alpha1
 
 import (
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	componentbaseconfigv1alpha1 "k8s.io/component-base/config/v1alpha1"
 )
@@ -48,10 +51,19 @@ func SetDefaults_SchedulerConfiguration(obj *SchedulerConfiguration) {
 	if len(obj.Schedulers.Shoot.Strategy) == 0 {
 		obj.Schedulers.Shoot.Strategy = Default
 	}
+	if len(obj.Schedulers.Shoot.SpreadStrategy) == 0 {
+		obj.Schedulers.Shoot.SpreadStrategy = DefaultSpreadStrategy
+	}
 
 	if obj.Schedulers.Shoot.ConcurrentSyncs == 0 {
 		obj.Schedulers.Shoot.ConcurrentSyncs = 5
 	}
+	if obj.Schedulers.Shoot.RetryInterval == nil {
+		obj.Schedulers.Shoot.RetryInterval = &metav1.Duration{Duration: 5 * time.Millisecond}
+	}
+	if obj.Schedulers.Shoot.MaxRetryBackoff == nil {
+		obj.Schedulers.Shoot.MaxRetryBackoff = &metav1.Duration{Duration: 1000 * time.Second}
+	}
 
 	if obj.LeaderElection == nil {
 		obj.LeaderElection = &componentbaseconfigv1alpha1.LeaderElectionConfiguration{}
@@ -49,6 +49,9 @@ var _ = Describe("Defaults", func() {
 					Shoot: &schedulerv1alpha1.ShootSchedulerConfiguration{
 						ConcurrentSyncs: 5,
 						Strategy:        schedulerv1alpha1.Default,
+						SpreadStrategy:  schedulerv1alpha1.DefaultSpreadStrategy,
+						RetryInterval:   &metav1.Duration{Duration: 5 * time.Millisecond},
+						MaxRetryBackoff: &metav1.Duration{Duration: 1000 * time.Second},
 					},
 				}))
 			})
@@ -28,6 +28,13 @@ const (
 	MinimalDistance CandidateDeterminationStrategy = "MinimalDistance"
 	// Default Strategy is the default strategy to use when there is no configuration provided
 	Default = SameRegion
+	// LeastShoots SpreadStrategy chooses the seed candidate which hosts the least number of shoots.
+	LeastShoots SpreadStrategy = "LeastShoots"
+	// ProjectAntiAffinity SpreadStrategy chooses the seed candidate which hosts the least number of shoots of the same
+	// project as the shoot. Ties are broken by choosing the seed candidate which hosts the least number of shoots.
+	ProjectAntiAffinity SpreadStrategy = "ProjectAntiAffinity"
+	// DefaultSpreadStrategy is the default spread strategy to use when there is no configuration provided
+	DefaultSpreadStrategy = LeastShoots
 	// SchedulerDefaultLockObjectNamespace is the default lock namespace for leader election.
 	SchedulerDefaultLockObjectNamespace = "garden"
 	// SchedulerDefaultLockObjectName is the default lock name for leader election.
@@ -58,6 +65,13 @@ var Strategies = []CandidateDeterminationStrategy{SameRegion, MinimalDistance}
 // CandidateDeterminationStrategy defines how seeds for shoots, that do not specify a seed explicitly, are being determined
 type CandidateDeterminationStrategy string
 
+// SpreadStrategies defines all currently implemented SpreadStrategies
+var SpreadStrategies = []SpreadStrategy{LeastShoots, ProjectAntiAffinity}
+
+// SpreadStrategy defines how shoots are spread over the seed candidates which were determined by the
+// CandidateDeterminationStrategy
+type SpreadStrategy string
+
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
 // SchedulerConfiguration provides the configuration for the SeedManager admission plugin.
@@ -115,6 +129,34 @@ type ShootSchedulerConfiguration struct {
 	ConcurrentSyncs int `json:"concurrentSyncs"`
 	// Strategy defines how seeds for shoots, that do not specify a seed explicitly, are being determined
 	Strategy CandidateDeterminationStrategy `json:"candidateDeterminationStrategy"`
+	// SpreadStrategy defines how shoots are spread over the seed candidates which were determined by the Strategy.
+	// Defaults to LeastShoots.
+	// +optional
+	SpreadStrategy SpreadStrategy `json:"spreadStrategy,omitempty"`
+	// CandidateWeights configures how the seed candidates which were determined by the Strategy are weighted before
+	// the SpreadStrategy chooses the winner.
+	// +optional
+	CandidateWeights *CandidateWeights `json:"candidateWeights,omitempty"`
+	// RetryInterval is the initial duration after which the scheduling of a shoot which could not be scheduled is
+	// retried. The duration is doubled after each failed attempt up to MaxRetryBackoff. Defaults to 5ms.
+	// +optional
+	RetryInterval *metav1.Duration `json:"retryInterval,omitempty"`
+	// MaxRetryBackoff is the maximum duration after which the scheduling of a shoot which could not be scheduled is
+	// retried. Defaults to 1000s.
+	// +optional
+	MaxRetryBackoff *metav1.Duration `json:"maxRetryBackoff,omitempty"`
+}
+
+// CandidateWeights configures how the seed candidates are weighted before the SpreadStrategy chooses the winner.
+type CandidateWeights struct {
+	// RegionAffinity maps regions to weights. Only the seed candidates in the region(s) with the highest weight are
+	// passed to the SpreadStrategy. Regions which are not listed have a weight of 0.
+	// +optional
+	RegionAffinity map[string]int32 `json:"regionAffinity,omitempty"`
+	// SeedCapacity makes the SpreadStrategy compare the number of shoots relative to the allocatable shoots of the seed
+	// candidates instead of the absolute number of shoots.
+	// +optional
+	SeedCapacity bool `json:"seedCapacity,omitempty"`
 }
 
 // ServerConfiguration contains details for the HTTP(S) servers.
@@ -125,6 +167,11 @@ type ServerConfiguration struct {
 	// Metrics is the configuration for serving the metrics endpoint.
 	// +optional
 	Metrics *Server `json:"metrics,omitempty"`
+	// Profiling is the configuration for serving the profiling endpoints on a dedicated port. It is only considered if
+	// profiling is enabled (see .debugging.enableProfiling). If not set, the profiling endpoints are served by the
+	// metrics server.
+	// +optional
+	Profiling *Server `json:"profiling,omitempty"`
 }
 
 // Server contains information for HTTP(S) server configuration.
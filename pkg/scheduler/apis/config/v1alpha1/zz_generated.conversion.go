@@ -25,6 +25,7 @@ import (
 	unsafe "unsafe"
 
 	config "github.com/gardener/gardener/pkg/scheduler/apis/config"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	conversion "k8s.io/apimachinery/pkg/conversion"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 	componentbaseconfig "k8s.io/component-base/config"
@@ -48,6 +49,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*CandidateWeights)(nil), (*config.CandidateWeights)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_CandidateWeights_To_config_CandidateWeights(a.(*CandidateWeights), b.(*config.CandidateWeights), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.CandidateWeights)(nil), (*CandidateWeights)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_CandidateWeights_To_v1alpha1_CandidateWeights(a.(*config.CandidateWeights), b.(*CandidateWeights), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*SchedulerConfiguration)(nil), (*config.SchedulerConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_SchedulerConfiguration_To_config_SchedulerConfiguration(a.(*SchedulerConfiguration), b.(*config.SchedulerConfiguration), scope)
 	}); err != nil {
@@ -121,6 +132,28 @@ func Convert_config_BackupBucketSchedulerConfiguration_To_v1alpha1_BackupBucketS
 	return autoConvert_config_BackupBucketSchedulerConfiguration_To_v1alpha1_BackupBucketSchedulerConfiguration(in, out, s)
 }
 
+func autoConvert_v1alpha1_CandidateWeights_To_config_CandidateWeights(in *CandidateWeights, out *config.CandidateWeights, s conversion.Scope) error {
+	out.RegionAffinity = *(*map[string]int32)(unsafe.Pointer(&in.RegionAffinity))
+	out.SeedCapacity = in.SeedCapacity
+	return nil
+}
+
+// Convert_v1alpha1_CandidateWeights_To_config_CandidateWeights is an autogenerated conversion function.
+func Convert_v1alpha1_CandidateWeights_To_config_CandidateWeights(in *CandidateWeights, out *config.CandidateWeights, s conversion.Scope) error {
+	return autoConvert_v1alpha1_CandidateWeights_To_config_CandidateWeights(in, out, s)
+}
+
+func autoConvert_config_CandidateWeights_To_v1alpha1_CandidateWeights(in *config.CandidateWeights, out *CandidateWeights, s conversion.Scope) error {
+	out.RegionAffinity = *(*map[string]int32)(unsafe.Pointer(&in.RegionAffinity))
+	out.SeedCapacity = in.SeedCapacity
+	return nil
+}
+
+// Convert_config_CandidateWeights_To_v1alpha1_CandidateWeights is an autogenerated conversion function.
+func Convert_config_CandidateWeights_To_v1alpha1_CandidateWeights(in *config.CandidateWeights, out *CandidateWeights, s conversion.Scope) error {
+	return autoConvert_config_CandidateWeights_To_v1alpha1_CandidateWeights(in, out, s)
+}
+
 func autoConvert_v1alpha1_SchedulerConfiguration_To_config_SchedulerConfiguration(in *SchedulerConfiguration, out *config.SchedulerConfiguration, s conversion.Scope) error {
 	if err := configv1alpha1.Convert_v1alpha1_ClientConnectionConfiguration_To_config_ClientConnectionConfiguration(&in.ClientConnection, &out.ClientConnection, s); err != nil {
 		return err
@@ -246,6 +279,7 @@ func Convert_config_Server_To_v1alpha1_Server(in *config.Server, out *Server, s
 func autoConvert_v1alpha1_ServerConfiguration_To_config_ServerConfiguration(in *ServerConfiguration, out *config.ServerConfiguration, s conversion.Scope) error {
 	out.HealthProbes = (*config.Server)(unsafe.Pointer(in.HealthProbes))
 	out.Metrics = (*config.Server)(unsafe.Pointer(in.Metrics))
+	out.Profiling = (*config.Server)(unsafe.Pointer(in.Profiling))
 	return nil
 }
 
@@ -257,6 +291,7 @@ func Convert_v1alpha1_ServerConfiguration_To_config_ServerConfiguration(in *Serv
 func autoConvert_config_ServerConfiguration_To_v1alpha1_ServerConfiguration(in *config.ServerConfiguration, out *ServerConfiguration, s conversion.Scope) error {
 	out.HealthProbes = (*Server)(unsafe.Pointer(in.HealthProbes))
 	out.Metrics = (*Server)(unsafe.Pointer(in.Metrics))
+	out.Profiling = (*Server)(unsafe.Pointer(in.Profiling))
 	return nil
 }
 
@@ -268,6 +303,10 @@ func Convert_config_ServerConfiguration_To_v1alpha1_ServerConfiguration(in *conf
 func autoConvert_v1alpha1_ShootSchedulerConfiguration_To_config_ShootSchedulerConfiguration(in *ShootSchedulerConfiguration, out *config.ShootSchedulerConfiguration, s conversion.Scope) error {
 	out.ConcurrentSyncs = in.ConcurrentSyncs
 	out.Strategy = config.CandidateDeterminationStrategy(in.Strategy)
+	out.SpreadStrategy = config.SpreadStrategy(in.SpreadStrategy)
+	out.CandidateWeights = (*config.CandidateWeights)(unsafe.Pointer(in.CandidateWeights))
+	out.RetryInterval = (*v1.Duration)(unsafe.Pointer(in.RetryInterval))
+	out.MaxRetryBackoff = (*v1.Duration)(unsafe.Pointer(in.MaxRetryBackoff))
 	return nil
 }
 
@@ -279,6 +318,10 @@ func Convert_v1alpha1_ShootSchedulerConfiguration_To_config_ShootSchedulerConfig
 func autoConvert_config_ShootSchedulerConfiguration_To_v1alpha1_ShootSchedulerConfiguration(in *config.ShootSchedulerConfiguration, out *ShootSchedulerConfiguration, s conversion.Scope) error {
 	out.ConcurrentSyncs = in.ConcurrentSyncs
 	out.Strategy = CandidateDeterminationStrategy(in.Strategy)
+	out.SpreadStrategy = SpreadStrategy(in.SpreadStrategy)
+	out.CandidateWeights = (*CandidateWeights)(unsafe.Pointer(in.CandidateWeights))
+	out.RetryInterval = (*v1.Duration)(unsafe.Pointer(in.RetryInterval))
+	out.MaxRetryBackoff = (*v1.Duration)(unsafe.Pointer(in.MaxRetryBackoff))
 	return nil
 }
 
@@ -22,6 +22,7 @@ limitations under the License.
 package v1alpha1
 
 import (
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 	configv1alpha1 "k8s.io/component-base/config/v1alpha1"
 )
@@ -42,6 +43,29 @@ func (in *BackupBucketSchedulerConfiguration) DeepCopy() *BackupBucketSchedulerC
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CandidateWeights) DeepCopyInto(out *CandidateWeights) {
+	*out = *in
+	if in.RegionAffinity != nil {
+		in, out := &in.RegionAffinity, &out.RegionAffinity
+		*out = make(map[string]int32, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CandidateWeights.
+func (in *CandidateWeights) DeepCopy() *CandidateWeights {
+	if in == nil {
+		return nil
+	}
+	out := new(CandidateWeights)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SchedulerConfiguration) DeepCopyInto(out *SchedulerConfiguration) {
 	*out = *in
@@ -98,7 +122,7 @@ func (in *SchedulerControllerConfiguration) DeepCopyInto(out *SchedulerControlle
 	if in.Shoot != nil {
 		in, out := &in.Shoot, &out.Shoot
 		*out = new(ShootSchedulerConfiguration)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	return
 }
@@ -142,6 +166,11 @@ func (in *ServerConfiguration) DeepCopyInto(out *ServerConfiguration) {
 		*out = new(Server)
 		**out = **in
 	}
+	if in.Profiling != nil {
+		in, out := &in.Profiling, &out.Profiling
+		*out = new(Server)
+		**out = **in
+	}
 	return
 }
 
@@ -158,6 +187,21 @@ func (in *ServerConfiguration) DeepCopy() *ServerConfiguration {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ShootSchedulerConfiguration) DeepCopyInto(out *ShootSchedulerConfiguration) {
 	*out = *in
+	if in.CandidateWeights != nil {
+		in, out := &in.CandidateWeights, &out.CandidateWeights
+		*out = new(CandidateWeights)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RetryInterval != nil {
+		in, out := &in.RetryInterval, &out.RetryInterval
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.MaxRetryBackoff != nil {
+		in, out := &in.MaxRetryBackoff, &out.MaxRetryBackoff
+		*out = new(v1.Duration)
+		**out = **in
+	}
 	return
 }
 
@@ -17,6 +17,7 @@ package validation
 import (
 	apivalidation "k8s.io/apimachinery/pkg/api/validation"
 	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 
 	"github.com/gardener/gardener/pkg/logger"
@@ -28,6 +29,7 @@ func ValidateConfiguration(config *schedulerconfig.SchedulerConfiguration) field
 	allErrs := field.ErrorList{}
 
 	allErrs = append(allErrs, validateSchedulerControllerConfiguration(config.Schedulers, field.NewPath("schedulers"))...)
+	allErrs = append(allErrs, validateServerConfiguration(config.Server, field.NewPath("server"))...)
 
 	if config.LogLevel != "" {
 		if !sets.New(logger.AllLogLevels...).Has(config.LogLevel) {
@@ -44,6 +46,28 @@ func ValidateConfiguration(config *schedulerconfig.SchedulerConfiguration) field
 	return allErrs
 }
 
+// validateServerConfiguration validates the server configuration.
+func validateServerConfiguration(server schedulerconfig.ServerConfiguration, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if server.Profiling == nil {
+		return allErrs
+	}
+
+	profilingPath := fldPath.Child("profiling", "port")
+	for _, msg := range validation.IsValidPortNum(server.Profiling.Port) {
+		allErrs = append(allErrs, field.Invalid(profilingPath, server.Profiling.Port, msg))
+	}
+
+	for name, other := range map[string]*schedulerconfig.Server{"healthProbes": server.HealthProbes, "metrics": server.Metrics} {
+		if other != nil && other.Port == server.Profiling.Port {
+			allErrs = append(allErrs, field.Invalid(profilingPath, server.Profiling.Port, "must not be equal to the port of the "+name+" server"))
+		}
+	}
+
+	return allErrs
+}
+
 // validateSchedulerControllerConfiguration validates the scheduler controller configuration.
 func validateSchedulerControllerConfiguration(schedulers schedulerconfig.SchedulerControllerConfiguration, fldPath *field.Path) field.ErrorList {
 	var (
@@ -57,6 +81,44 @@ func validateSchedulerControllerConfiguration(schedulers schedulerconfig.Schedul
 	if schedulers.Shoot != nil {
 		allErrs = append(allErrs, apivalidation.ValidateNonnegativeField(int64(schedulers.Shoot.ConcurrentSyncs), fldPath.Child("shoot", "concurrentSyncs"))...)
 		allErrs = append(allErrs, validateStrategy(schedulers.Shoot.Strategy, fldPath.Child("shoot", "strategy"))...)
+		allErrs = append(allErrs, validateSpreadStrategy(schedulers.Shoot.SpreadStrategy, fldPath.Child("shoot", "spreadStrategy"))...)
+		allErrs = append(allErrs, validateCandidateWeights(schedulers.Shoot.CandidateWeights, fldPath.Child("shoot", "candidateWeights"))...)
+		allErrs = append(allErrs, validateRetryBackoff(schedulers.Shoot, fldPath.Child("shoot"))...)
+	}
+
+	return allErrs
+}
+
+func validateCandidateWeights(weights *schedulerconfig.CandidateWeights, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if weights == nil {
+		return allErrs
+	}
+
+	for region, weight := range weights.RegionAffinity {
+		if region == "" {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("regionAffinity"), region, "region must not be empty"))
+		}
+		allErrs = append(allErrs, apivalidation.ValidateNonnegativeField(int64(weight), fldPath.Child("regionAffinity").Key(region))...)
+	}
+
+	return allErrs
+}
+
+func validateRetryBackoff(config *schedulerconfig.ShootSchedulerConfiguration, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if config.RetryInterval != nil && config.RetryInterval.Duration <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("retryInterval"), config.RetryInterval.Duration.String(), "must be positive"))
+	}
+
+	if config.MaxRetryBackoff != nil && config.MaxRetryBackoff.Duration <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("maxRetryBackoff"), config.MaxRetryBackoff.Duration.String(), "must be positive"))
+	}
+
+	if config.RetryInterval != nil && config.MaxRetryBackoff != nil && config.RetryInterval.Duration > config.MaxRetryBackoff.Duration {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("retryInterval"), config.RetryInterval.Duration.String(), "must not be greater than maxRetryBackoff"))
 	}
 
 	return allErrs
@@ -79,3 +141,21 @@ func validateStrategy(strategy schedulerconfig.CandidateDeterminationStrategy, f
 
 	return allErrs
 }
+
+func validateSpreadStrategy(strategy schedulerconfig.SpreadStrategy, fldPath *field.Path) field.ErrorList {
+	var (
+		allErrs             = field.ErrorList{}
+		supportedStrategies []string
+	)
+
+	for _, s := range schedulerconfig.SpreadStrategies {
+		supportedStrategies = append(supportedStrategies, string(s))
+		if s == strategy {
+			return allErrs
+		}
+	}
+
+	allErrs = append(allErrs, field.NotSupported(fldPath, strategy, supportedStrategies))
+
+	return allErrs
+}
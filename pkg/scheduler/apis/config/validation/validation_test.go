@@ -15,6 +15,8 @@
 package validation
 
 import (
+	"time"
+
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	. "github.com/onsi/gomega/gstruct"
@@ -41,6 +43,7 @@ var _ = Describe("gardener-scheduler", func() {
 					Shoot: &schedulerconfig.ShootSchedulerConfiguration{
 						ConcurrentSyncs: 2,
 						Strategy:        schedulerconfig.SameRegion,
+						SpreadStrategy:  schedulerconfig.LeastShoots,
 					},
 				},
 			}
@@ -79,6 +82,53 @@ var _ = Describe("gardener-scheduler", func() {
 				}))))
 			})
 
+			It("should pass because the Gardener Scheduler Configuration with the 'Project Anti Affinity' SpreadStrategy is a valid configuration", func() {
+				projectAntiAffinityConfiguration := defaultAdmissionConfiguration
+				projectAntiAffinityConfiguration.Schedulers.Shoot.SpreadStrategy = schedulerconfig.ProjectAntiAffinity
+				err := ValidateConfiguration(&projectAntiAffinityConfiguration)
+
+				Expect(err).To(BeEmpty())
+			})
+
+			It("should fail because the Gardener Scheduler Configuration contains an invalid spread strategy", func() {
+				invalidConfiguration := defaultAdmissionConfiguration
+				invalidConfiguration.Schedulers.Shoot.SpreadStrategy = "invalidSpreadStrategy"
+				err := ValidateConfiguration(&invalidConfiguration)
+
+				Expect(err).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("schedulers.shoot.spreadStrategy"),
+				}))))
+			})
+
+			It("should pass because the candidate weights are valid", func() {
+				validConfiguration := defaultAdmissionConfiguration
+				validConfiguration.Schedulers.Shoot.CandidateWeights = &schedulerconfig.CandidateWeights{
+					RegionAffinity: map[string]int32{"eu-west-1": 10, "eu-central-1": 0},
+					SeedCapacity:   true,
+				}
+
+				Expect(ValidateConfiguration(&validConfiguration)).To(BeEmpty())
+			})
+
+			It("should fail because the candidate weights are invalid", func() {
+				invalidConfiguration := defaultAdmissionConfiguration
+				invalidConfiguration.Schedulers.Shoot.CandidateWeights = &schedulerconfig.CandidateWeights{
+					RegionAffinity: map[string]int32{"": 1, "eu-west-1": -1},
+				}
+
+				Expect(ValidateConfiguration(&invalidConfiguration)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("schedulers.shoot.candidateWeights.regionAffinity"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("schedulers.shoot.candidateWeights.regionAffinity[eu-west-1]"),
+					})),
+				))
+			})
+
 			It("should fail because backupBucket concurrentSyncs are negative", func() {
 				invalidConfiguration := defaultAdmissionConfiguration
 				invalidConfiguration.Schedulers.BackupBucket.ConcurrentSyncs = -1
@@ -102,6 +152,74 @@ var _ = Describe("gardener-scheduler", func() {
 					"Field": Equal("schedulers.shoot.concurrentSyncs"),
 				}))))
 			})
+
+			It("should pass because the shoot retry backoff is valid", func() {
+				validConfiguration := defaultAdmissionConfiguration
+				validConfiguration.Schedulers.Shoot.RetryInterval = &metav1.Duration{Duration: time.Second}
+				validConfiguration.Schedulers.Shoot.MaxRetryBackoff = &metav1.Duration{Duration: time.Minute}
+
+				Expect(ValidateConfiguration(&validConfiguration)).To(BeEmpty())
+			})
+
+			It("should fail because the shoot retry backoff durations are not positive", func() {
+				invalidConfiguration := defaultAdmissionConfiguration
+				invalidConfiguration.Schedulers.Shoot.RetryInterval = &metav1.Duration{Duration: -time.Second}
+				invalidConfiguration.Schedulers.Shoot.MaxRetryBackoff = &metav1.Duration{}
+
+				err := ValidateConfiguration(&invalidConfiguration)
+
+				Expect(err).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("schedulers.shoot.retryInterval"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("schedulers.shoot.maxRetryBackoff"),
+					})),
+				))
+			})
+
+			It("should fail because the shoot retry interval is greater than the max retry backoff", func() {
+				invalidConfiguration := defaultAdmissionConfiguration
+				invalidConfiguration.Schedulers.Shoot.RetryInterval = &metav1.Duration{Duration: time.Hour}
+				invalidConfiguration.Schedulers.Shoot.MaxRetryBackoff = &metav1.Duration{Duration: time.Minute}
+
+				err := ValidateConfiguration(&invalidConfiguration)
+
+				Expect(err).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":   Equal(field.ErrorTypeInvalid),
+					"Field":  Equal("schedulers.shoot.retryInterval"),
+					"Detail": Equal("must not be greater than maxRetryBackoff"),
+				}))))
+			})
+
+			It("should pass because the profiling server uses a dedicated port", func() {
+				validConfiguration := defaultAdmissionConfiguration
+				validConfiguration.Server.Metrics = &schedulerconfig.Server{Port: 19251}
+				validConfiguration.Server.Profiling = &schedulerconfig.Server{Port: 19253}
+
+				Expect(ValidateConfiguration(&validConfiguration)).To(BeEmpty())
+			})
+
+			It("should fail because the profiling server port is invalid or already used", func() {
+				invalidConfiguration := defaultAdmissionConfiguration
+				invalidConfiguration.Server.Metrics = &schedulerconfig.Server{Port: 19251}
+				invalidConfiguration.Server.Profiling = &schedulerconfig.Server{Port: 19251}
+
+				Expect(ValidateConfiguration(&invalidConfiguration)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":   Equal(field.ErrorTypeInvalid),
+					"Field":  Equal("server.profiling.port"),
+					"Detail": Equal("must not be equal to the port of the metrics server"),
+				}))))
+
+				invalidConfiguration.Server.Profiling.Port = 0
+
+				Expect(ValidateConfiguration(&invalidConfiguration)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("server.profiling.port"),
+				}))))
+			})
 		})
 	})
 })
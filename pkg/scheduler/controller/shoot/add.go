@@ -15,6 +15,8 @@
 package shoot
 
 import (
+	"golang.org/x/time/rate"
+	"k8s.io/client-go/util/workqueue"
 	"k8s.io/utils/pointer"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -51,10 +53,22 @@ func (r *Reconciler) AddToManager(mgr manager.Manager) error {
 		)).
 		WithOptions(controller.Options{
 			MaxConcurrentReconciles: r.Config.ConcurrentSyncs,
+			RateLimiter:             r.rateLimiter(),
 		}).
 		Complete(r)
 }
 
+// rateLimiter returns the rate limiter for retrying shoots which could not be scheduled. It behaves like the default
+// controller rate limiter, but uses the configured retry interval and maximum backoff for the per-item exponential
+// backoff.
+func (r *Reconciler) rateLimiter() workqueue.RateLimiter {
+	return workqueue.NewMaxOfRateLimiter(
+		workqueue.NewItemExponentialFailureRateLimiter(r.Config.RetryInterval.Duration, r.Config.MaxRetryBackoff.Duration),
+		// 10 qps, 100 bucket size, same as in workqueue.DefaultControllerRateLimiter
+		&workqueue.BucketRateLimiter{Limiter: rate.NewLimiter(rate.Limit(10), 100)},
+	)
+}
+
 // ShootPredicate is a predicate that returns true if a shoot is not assigned to a seed
 // and the default scheduler is configured.
 func (r *Reconciler) ShootPredicate() predicate.Predicate {
@@ -16,9 +16,11 @@ package shoot
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"math"
 	"strings"
+	"time"
 
 	"github.com/go-logr/logr"
 	corev1 "k8s.io/api/core/v1"
@@ -37,10 +39,24 @@ import (
 	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
 	"github.com/gardener/gardener/pkg/controllerutils"
 	"github.com/gardener/gardener/pkg/scheduler/apis/config"
+	"github.com/gardener/gardener/pkg/scheduler/metrics"
 	kubernetesutils "github.com/gardener/gardener/pkg/utils/kubernetes"
 	cidrvalidation "github.com/gardener/gardener/pkg/utils/validation/cidr"
 )
 
+// The failure reasons are used for the reason label of the scheduling_failures_total metric.
+const (
+	failureReasonAPIError          = "APIError"
+	failureReasonNoUsableSeeds     = "NoUsableSeeds"
+	failureReasonSeedSelector      = "SeedSelector"
+	failureReasonProvider          = "Provider"
+	failureReasonZonalControlPlane = "ZonalControlPlane"
+	failureReasonCandidates        = "Candidates"
+	failureReasonStrategy          = "Strategy"
+	failureReasonSpreadStrategy    = "SpreadStrategy"
+	failureReasonBinding           = "Binding"
+)
+
 // Reconciler schedules shoots to seeds.
 type Reconciler struct {
 	Client          client.Client
@@ -75,18 +91,23 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 		return reconcile.Result{}, nil
 	}
 
+	start := time.Now()
+
 	// If no Seed is referenced, we try to determine an adequate one.
 	seed, err := r.determineSeed(ctx, log, shoot)
 	if err != nil {
+		observeSchedulingDuration(start, metrics.ResultError)
 		r.reportFailedScheduling(ctx, log, shoot, err)
 		return reconcile.Result{}, fmt.Errorf("failed to determine seed for shoot: %w", err)
 	}
 
 	shoot.Spec.SeedName = &seed.Name
 	if err = r.Client.SubResource("binding").Update(ctx, shoot); err != nil {
-		r.reportFailedScheduling(ctx, log, shoot, err)
+		observeSchedulingDuration(start, metrics.ResultError)
+		r.reportFailedScheduling(ctx, log, shoot, &schedulingError{reason: failureReasonBinding, err: err})
 		return reconcile.Result{}, fmt.Errorf("failed to bind shoot to seed: %w", err)
 	}
+	observeSchedulingDuration(start, metrics.ResultSuccess)
 
 	log.Info(
 		"Shoot successfully scheduled to seed",
@@ -94,13 +115,22 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 		"region", shoot.Spec.Region,
 		"seed", seed.Name,
 		"strategy", r.Config.Strategy,
+		"spreadStrategy", r.Config.SpreadStrategy,
 	)
 
 	r.reportEvent(shoot, corev1.EventTypeNormal, gardencorev1beta1.ShootEventSchedulingSuccessful, "Scheduled to seed '%s'", seed.Name)
 	return reconcile.Result{}, nil
 }
 
+// observeSchedulingDuration records the duration of a scheduling attempt (measured from the given start time) with the
+// given result.
+func observeSchedulingDuration(start time.Time, result string) {
+	metrics.SchedulingDuration.WithLabelValues(result).Observe(time.Since(start).Seconds())
+}
+
 func (r *Reconciler) reportFailedScheduling(ctx context.Context, log logr.Logger, shoot *gardencorev1beta1.Shoot, err error) {
+	metrics.SchedulingFailures.WithLabelValues(schedulingFailureReason(err)).Inc()
+
 	description := fmt.Sprintf("Failed to schedule Shoot: %s", err.Error())
 	r.reportEvent(shoot, corev1.EventTypeWarning, gardencorev1beta1.ShootEventSchedulingFailed, description)
 
@@ -121,6 +151,30 @@ func (r *Reconciler) reportEvent(shoot *gardencorev1beta1.Shoot, eventType strin
 	r.Recorder.Eventf(shoot, eventType, eventReason, messageFmt, args...)
 }
 
+// schedulingError is returned by determineSeed and carries the reason why no seed could be determined.
+type schedulingError struct {
+	reason string
+	err    error
+}
+
+func (e *schedulingError) Error() string {
+	return e.err.Error()
+}
+
+func (e *schedulingError) Unwrap() error {
+	return e.err
+}
+
+// schedulingFailureReason returns the reason of the given scheduling error. Errors without a reason are caused by
+// failed requests to the API server.
+func schedulingFailureReason(err error) string {
+	var schedulingErr *schedulingError
+	if errors.As(err, &schedulingErr) {
+		return schedulingErr.reason
+	}
+	return failureReasonAPIError
+}
+
 // determineSeed returns an appropriate Seed cluster (or nil).
 func (r *Reconciler) determineSeed(
 	ctx context.Context,
@@ -149,33 +203,38 @@ func (r *Reconciler) determineSeed(
 
 	filteredSeeds, err := filterUsableSeeds(seedList.Items)
 	if err != nil {
-		return nil, err
+		return nil, &schedulingError{reason: failureReasonNoUsableSeeds, err: err}
 	}
 	filteredSeeds, err = filterSeedsMatchingLabelSelector(filteredSeeds, cloudProfile.Spec.SeedSelector, "CloudProfile")
 	if err != nil {
-		return nil, err
+		return nil, &schedulingError{reason: failureReasonSeedSelector, err: err}
 	}
 	filteredSeeds, err = filterSeedsMatchingLabelSelector(filteredSeeds, shoot.Spec.SeedSelector, "Shoot")
 	if err != nil {
-		return nil, err
+		return nil, &schedulingError{reason: failureReasonSeedSelector, err: err}
 	}
 	filteredSeeds, err = filterSeedsMatchingProviders(cloudProfile, shoot, filteredSeeds)
 	if err != nil {
-		return nil, err
+		return nil, &schedulingError{reason: failureReasonProvider, err: err}
 	}
 	filteredSeeds, err = filterSeedsForZonalShootControlPlanes(filteredSeeds, shoot)
 	if err != nil {
-		return nil, err
+		return nil, &schedulingError{reason: failureReasonZonalControlPlane, err: err}
 	}
 	filteredSeeds, err = filterCandidates(shoot, shootList.Items, filteredSeeds)
 	if err != nil {
-		return nil, err
+		return nil, &schedulingError{reason: failureReasonCandidates, err: err}
 	}
 	filteredSeeds, err = applyStrategy(log, shoot, filteredSeeds, r.Config.Strategy, regionConfig)
 	if err != nil {
-		return nil, err
+		return nil, &schedulingError{reason: failureReasonStrategy, err: err}
 	}
-	return getSeedWithLeastShootsDeployed(filteredSeeds, shootList.Items)
+	filteredSeeds = applyCandidateWeights(filteredSeeds, r.Config.CandidateWeights)
+	seed, err := applySpreadStrategy(shoot, filteredSeeds, shootList.Items, r.Config.SpreadStrategy, r.Config.CandidateWeights != nil && r.Config.CandidateWeights.SeedCapacity)
+	if err != nil {
+		return nil, &schedulingError{reason: failureReasonSpreadStrategy, err: err}
+	}
+	return seed, nil
 }
 
 func (r *Reconciler) getRegionConfigMap(ctx context.Context, log logr.Logger, cloudProfile *gardencorev1beta1.CloudProfile) (*corev1.ConfigMap, error) {
@@ -342,24 +401,109 @@ func filterCandidates(shoot *gardencorev1beta1.Shoot, shootList []gardencorev1be
 	return candidates, nil
 }
 
+// applyCandidateWeights returns the seed candidates in the region(s) with the highest region affinity weight. Regions
+// which are not configured have a weight of 0.
+func applyCandidateWeights(seedList []gardencorev1beta1.Seed, weights *config.CandidateWeights) []gardencorev1beta1.Seed {
+	if weights == nil || len(weights.RegionAffinity) == 0 {
+		return seedList
+	}
+
+	var (
+		candidates []gardencorev1beta1.Seed
+		max        *int32
+	)
+
+	for _, seed := range seedList {
+		weight := weights.RegionAffinity[seed.Spec.Provider.Region]
+		switch {
+		case max == nil || weight > *max:
+			candidates = []gardencorev1beta1.Seed{seed}
+			max = &weight
+		case weight == *max:
+			candidates = append(candidates, seed)
+		}
+	}
+
+	return candidates
+}
+
+func applySpreadStrategy(shoot *gardencorev1beta1.Shoot, seedList []gardencorev1beta1.Seed, shootList []gardencorev1beta1.Shoot, spreadStrategy config.SpreadStrategy, considerSeedCapacity bool) (*gardencorev1beta1.Seed, error) {
+	switch spreadStrategy {
+	case config.ProjectAntiAffinity:
+		return getSeedWithLeastShootsOfSameProjectDeployed(shoot, seedList, shootList, considerSeedCapacity)
+	case config.LeastShoots, "":
+		return getSeedWithLeastShootsDeployed(seedList, shootList, considerSeedCapacity)
+	default:
+		return nil, fmt.Errorf("failed to determine seed, spread strategy: '%s', valid spread strategies are: %v", spreadStrategy, config.SpreadStrategies)
+	}
+}
+
+// getSeedWithLeastShootsOfSameProjectDeployed finds the best candidate (i.e. the one managing the smallest number of
+// shoots of the same project as the given shoot right now). This reduces the blast radius of a seed outage for a
+// project. If multiple candidates manage the same number of shoots of the project, the one managing the smallest number
+// of shoots overall is chosen.
+func getSeedWithLeastShootsOfSameProjectDeployed(shoot *gardencorev1beta1.Shoot, seedList []gardencorev1beta1.Seed, shootList []gardencorev1beta1.Shoot, considerSeedCapacity bool) (*gardencorev1beta1.Seed, error) {
+	var projectShootList []gardencorev1beta1.Shoot
+	for _, s := range shootList {
+		if s.Namespace == shoot.Namespace {
+			projectShootList = append(projectShootList, s)
+		}
+	}
+
+	var (
+		candidates       []gardencorev1beta1.Seed
+		min              *int
+		projectSeedUsage = v1beta1helper.CalculateSeedUsage(projectShootList)
+	)
+
+	for _, seed := range seedList {
+		numberOfManagedProjectShoots := projectSeedUsage[seed.Name]
+		switch {
+		case min == nil || numberOfManagedProjectShoots < *min:
+			candidates = []gardencorev1beta1.Seed{seed}
+			min = &numberOfManagedProjectShoots
+		case numberOfManagedProjectShoots == *min:
+			candidates = append(candidates, seed)
+		}
+	}
+
+	return getSeedWithLeastShootsDeployed(candidates, shootList, considerSeedCapacity)
+}
+
 // getSeedWithLeastShootsDeployed finds the best candidate (i.e. the one managing the smallest number of shoots right now).
-func getSeedWithLeastShootsDeployed(seedList []gardencorev1beta1.Seed, shootList []gardencorev1beta1.Shoot) (*gardencorev1beta1.Seed, error) {
+// If considerSeedCapacity is true, the number of shoots is compared relative to the allocatable shoots of the candidates.
+func getSeedWithLeastShootsDeployed(seedList []gardencorev1beta1.Seed, shootList []gardencorev1beta1.Shoot, considerSeedCapacity bool) (*gardencorev1beta1.Seed, error) {
 	var (
 		bestCandidate gardencorev1beta1.Seed
-		min           *int
+		min           *float64
 		seedUsage     = v1beta1helper.CalculateSeedUsage(shootList)
 	)
 
 	for _, seed := range seedList {
-		if numberOfManagedShoots := seedUsage[seed.Name]; min == nil || numberOfManagedShoots < *min {
+		if load := seedLoad(&seed, seedUsage[seed.Name], considerSeedCapacity); min == nil || load < *min {
 			bestCandidate = seed
-			min = &numberOfManagedShoots
+			min = &load
 		}
 	}
 
 	return &bestCandidate, nil
 }
 
+// seedLoad returns the number of shoots managed by the given seed. If considerSeedCapacity is true, the number is
+// returned relative to the allocatable shoots of the seed. As candidates without available capacity were already
+// filtered, this ratio is below 1, hence seeds which do not report allocatable shoots are ranked after all seeds which
+// do.
+func seedLoad(seed *gardencorev1beta1.Seed, numberOfManagedShoots int, considerSeedCapacity bool) float64 {
+	if !considerSeedCapacity {
+		return float64(numberOfManagedShoots)
+	}
+
+	if allocatableShoots, ok := seed.Status.Allocatable[gardencorev1beta1.ResourceShoots]; ok && allocatableShoots.Value() > 0 {
+		return float64(numberOfManagedShoots) / float64(allocatableShoots.Value())
+	}
+	return 1 + float64(numberOfManagedShoots)
+}
+
 func matchProvider(seedProviderType, shootProviderType string, enabledProviderTypes []string) bool {
 	if len(enabledProviderTypes) == 0 {
 		return seedProviderType == shootProviderType
@@ -21,17 +21,22 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	gomegatypes "github.com/onsi/gomega/types"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
 	"go.uber.org/mock/gomock"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
 	"k8s.io/utils/pointer"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	"github.com/gardener/gardener/pkg/client/kubernetes"
 	"github.com/gardener/gardener/pkg/scheduler/apis/config"
+	"github.com/gardener/gardener/pkg/scheduler/metrics"
 )
 
 var _ = Describe("Scheduler_Control", func() {
@@ -212,6 +217,26 @@ var _ = Describe("Scheduler_Control", func() {
 			bestSeed, err := reconciler.determineSeed(ctx, log, shoot)
 			Expect(err).To(HaveOccurred())
 			Expect(bestSeed).To(BeNil())
+			Expect(schedulingFailureReason(err)).To(Equal(failureReasonStrategy))
+		})
+
+		It("should record the scheduling duration and the failure reason when the shoot cannot be scheduled", func() {
+			shoot.Spec.Region = "another-region"
+
+			Expect(fakeGardenClient.Create(ctx, cloudProfile)).To(Succeed())
+			Expect(fakeGardenClient.Create(ctx, seed)).To(Succeed())
+			Expect(fakeGardenClient.Create(ctx, shoot)).To(Succeed())
+
+			metrics.SchedulingDuration.Reset()
+			metrics.SchedulingFailures.Reset()
+			reconciler.Recorder = record.NewFakeRecorder(1)
+
+			_, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(shoot)})
+			Expect(err).To(MatchError(ContainSubstring("failed to determine seed for shoot")))
+
+			Expect(testutil.CollectAndCount(metrics.SchedulingDuration)).To(Equal(1))
+			Expect(testutil.CollectAndCount(metrics.SchedulingDuration.WithLabelValues(metrics.ResultError).(prometheus.Collector))).To(Equal(1))
+			Expect(testutil.ToFloat64(metrics.SchedulingFailures.WithLabelValues(failureReasonStrategy))).To(Equal(float64(1)))
 		})
 
 		It("should fail because it cannot find a seed cluster (due to no zones) for a shoot with failure tolerance type 'zone'", func() {
@@ -230,6 +255,7 @@ var _ = Describe("Scheduler_Control", func() {
 			bestSeed, err := reconciler.determineSeed(ctx, log, shoot)
 			Expect(err).To(MatchError("none of the 1 seeds has at least 3 zones for hosting a shoot control plane with failure tolerance type 'zone'"))
 			Expect(bestSeed).To(BeNil())
+			Expect(schedulingFailureReason(err)).To(Equal(failureReasonZonalControlPlane))
 		})
 
 		It("should fail when the only available seed has < 3 zones for a shoot with failure tolerance type 'zone'", func() {
@@ -680,6 +706,7 @@ var _ = Describe("Scheduler_Control", func() {
 			bestSeed, err := reconciler.determineSeed(ctx, log, shoot)
 			Expect(err).To(HaveOccurred())
 			Expect(bestSeed).To(BeNil())
+			Expect(schedulingFailureReason(err)).To(Equal(failureReasonCandidates))
 		})
 
 		It("should fail because it cannot find a seed cluster due to non-tolerated taints", func() {
@@ -748,6 +775,7 @@ var _ = Describe("Scheduler_Control", func() {
 			bestSeed, err := reconciler.determineSeed(ctx, log, shoot)
 			Expect(err).To(HaveOccurred())
 			Expect(bestSeed).To(BeNil())
+			Expect(schedulingFailureReason(err)).To(Equal(failureReasonSeedSelector))
 		})
 
 		It("should fail because the shoot doesn't select any seed candidate", func() {
@@ -820,6 +848,163 @@ var _ = Describe("Scheduler_Control", func() {
 		})
 	})
 
+	Context("SEED DETERMINATION - Shoot does not reference a Seed - spread shoots using 'ProjectAntiAffinity' spread strategy", func() {
+		var secondSeed *gardencorev1beta1.Seed
+
+		BeforeEach(func() {
+			cloudProfile = cloudProfileBase.DeepCopy()
+			seed = seedBase.DeepCopy()
+			shoot = shootBase.DeepCopy()
+			schedulerConfiguration = *schedulerConfigurationBase.DeepCopy()
+			// no seed referenced
+			shoot.Spec.SeedName = nil
+			schedulerConfiguration.Schedulers.Shoot.SpreadStrategy = config.ProjectAntiAffinity
+
+			secondSeed = seedBase.DeepCopy()
+			secondSeed.Name = "seed-2"
+
+			Expect(fakeGardenClient.Create(ctx, cloudProfile)).To(Succeed())
+			Expect(fakeGardenClient.Create(ctx, seed)).To(Succeed())
+			Expect(fakeGardenClient.Create(ctx, secondSeed)).To(Succeed())
+		})
+
+		createShoot := func(name, namespace, seedName string) {
+			s := shootBase.DeepCopy()
+			s.Name = name
+			s.Namespace = namespace
+			s.Spec.SeedName = &seedName
+			ExpectWithOffset(1, fakeGardenClient.Create(ctx, s)).To(Succeed())
+		}
+
+		It("should pick the candidate with the least shoots of the same project deployed", func() {
+			// seed-1 hosts one shoot of the project, seed-2 hosts more shoots overall but none of the project
+			createShoot("shoot-1", shoot.Namespace, seed.Name)
+			createShoot("shoot-2", "other-namespace", secondSeed.Name)
+			createShoot("shoot-3", "other-namespace", secondSeed.Name)
+
+			bestSeed, err := reconciler.determineSeed(ctx, log, shoot)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(bestSeed.Name).To(Equal(secondSeed.Name))
+		})
+
+		It("should pick the candidate with the least shoots deployed if the shoots of the project are spread evenly", func() {
+			createShoot("shoot-1", shoot.Namespace, seed.Name)
+			createShoot("shoot-2", shoot.Namespace, secondSeed.Name)
+			createShoot("shoot-3", "other-namespace", seed.Name)
+
+			bestSeed, err := reconciler.determineSeed(ctx, log, shoot)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(bestSeed.Name).To(Equal(secondSeed.Name))
+		})
+
+		It("should pick the candidate with the least shoots deployed when using the 'LeastShoots' spread strategy", func() {
+			schedulerConfiguration.Schedulers.Shoot.SpreadStrategy = config.LeastShoots
+
+			createShoot("shoot-1", shoot.Namespace, seed.Name)
+			createShoot("shoot-2", "other-namespace", secondSeed.Name)
+			createShoot("shoot-3", "other-namespace", secondSeed.Name)
+
+			bestSeed, err := reconciler.determineSeed(ctx, log, shoot)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(bestSeed.Name).To(Equal(seed.Name))
+		})
+
+		It("should fail for an unknown spread strategy", func() {
+			schedulerConfiguration.Schedulers.Shoot.SpreadStrategy = "foo"
+
+			bestSeed, err := reconciler.determineSeed(ctx, log, shoot)
+			Expect(err).To(MatchError(ContainSubstring("valid spread strategies are")))
+			Expect(bestSeed).To(BeNil())
+		})
+	})
+
+	Context("SEED DETERMINATION - Shoot does not reference a Seed - weight the seed candidates", func() {
+		var secondSeed *gardencorev1beta1.Seed
+
+		BeforeEach(func() {
+			cloudProfile = cloudProfileBase.DeepCopy()
+			seed = seedBase.DeepCopy()
+			shoot = shootBase.DeepCopy()
+			schedulerConfiguration = *schedulerConfigurationBase.DeepCopy()
+			// no seed referenced
+			shoot.Spec.SeedName = nil
+
+			secondSeed = seedBase.DeepCopy()
+			secondSeed.Name = "seed-2"
+		})
+
+		createSeeds := func() {
+			ExpectWithOffset(1, fakeGardenClient.Create(ctx, cloudProfile)).To(Succeed())
+			ExpectWithOffset(1, fakeGardenClient.Create(ctx, seed)).To(Succeed())
+			ExpectWithOffset(1, fakeGardenClient.Create(ctx, secondSeed)).To(Succeed())
+		}
+
+		createShoot := func(name, seedName string) {
+			s := shootBase.DeepCopy()
+			s.Name = name
+			s.Spec.SeedName = &seedName
+			ExpectWithOffset(1, fakeGardenClient.Create(ctx, s)).To(Succeed())
+		}
+
+		It("should pick the candidate in the region with the highest weight", func() {
+			purpose := gardencorev1beta1.ShootPurposeTesting
+			shoot.Spec.Purpose = &purpose
+			secondSeed.Spec.Provider.Region = "asia"
+			schedulerConfiguration.Schedulers.Shoot.CandidateWeights = &config.CandidateWeights{
+				RegionAffinity: map[string]int32{"asia": 10, region: 5},
+			}
+
+			createSeeds()
+			createShoot("shoot-1", secondSeed.Name)
+			createShoot("shoot-2", secondSeed.Name)
+
+			bestSeed, err := reconciler.determineSeed(ctx, log, shoot)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(bestSeed.Name).To(Equal(secondSeed.Name))
+		})
+
+		It("should consider regions without weight if no candidate is in a weighted region", func() {
+			schedulerConfiguration.Schedulers.Shoot.CandidateWeights = &config.CandidateWeights{
+				RegionAffinity: map[string]int32{"asia": 10},
+			}
+
+			createSeeds()
+			createShoot("shoot-1", seed.Name)
+
+			bestSeed, err := reconciler.determineSeed(ctx, log, shoot)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(bestSeed.Name).To(Equal(secondSeed.Name))
+		})
+
+		It("should pick the candidate with the least shoots relative to its capacity", func() {
+			seed.Status.Allocatable = corev1.ResourceList{gardencorev1beta1.ResourceShoots: resource.MustParse("10")}
+			secondSeed.Status.Allocatable = corev1.ResourceList{gardencorev1beta1.ResourceShoots: resource.MustParse("100")}
+			schedulerConfiguration.Schedulers.Shoot.CandidateWeights = &config.CandidateWeights{SeedCapacity: true}
+
+			createSeeds()
+			createShoot("shoot-1", seed.Name)
+			createShoot("shoot-2", secondSeed.Name)
+			createShoot("shoot-3", secondSeed.Name)
+
+			bestSeed, err := reconciler.determineSeed(ctx, log, shoot)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(bestSeed.Name).To(Equal(secondSeed.Name))
+		})
+
+		It("should rank candidates without allocatable shoots last when considering the capacity", func() {
+			secondSeed.Status.Allocatable = corev1.ResourceList{gardencorev1beta1.ResourceShoots: resource.MustParse("3")}
+			schedulerConfiguration.Schedulers.Shoot.CandidateWeights = &config.CandidateWeights{SeedCapacity: true}
+
+			createSeeds()
+			createShoot("shoot-1", secondSeed.Name)
+			createShoot("shoot-2", secondSeed.Name)
+
+			bestSeed, err := reconciler.determineSeed(ctx, log, shoot)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(bestSeed.Name).To(Equal(secondSeed.Name))
+		})
+	})
+
 	Context("#DetermineBestSeedCandidate", func() {
 		BeforeEach(func() {
 			seed = seedBase.DeepCopy()
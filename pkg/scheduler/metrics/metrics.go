@@ -0,0 +1,62 @@
+// Copyright 2021 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	runtimemetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+const (
+	// Namespace is the metric namespace for the gardener-scheduler.
+	Namespace = "gardener_scheduler"
+
+	// ResultSuccess is the value of the 'result' label for shoots which were scheduled successfully.
+	ResultSuccess = "success"
+	// ResultError is the value of the 'result' label for shoots which could not be scheduled.
+	ResultError = "error"
+)
+
+var (
+	// Factory is used for registering metrics in the controller-runtime metrics registry.
+	Factory = promauto.With(runtimemetrics.Registry)
+
+	// SchedulingFailures defines the counter scheduling_failures_total.
+	SchedulingFailures = Factory.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: Namespace,
+			Name:      "scheduling_failures_total",
+			Help:      "Total number of failed attempts to schedule a shoot by failure reason.",
+		},
+		[]string{
+			"reason",
+		},
+	)
+
+	// SchedulingDuration defines the histogram scheduling_duration_seconds.
+	SchedulingDuration = Factory.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: Namespace,
+			Name:      "scheduling_duration_seconds",
+			Help:      "Histogram of duration of attempts to schedule a shoot, i.e., determining a seed and binding the shoot to it.",
+			// Start with 5ms with the last bucket being [~10s, Inf)
+			Buckets: prometheus.ExponentialBuckets(0.005, 2, 12),
+		},
+		[]string{
+			"result",
+		},
+	)
+)
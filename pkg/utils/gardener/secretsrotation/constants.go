@@ -21,6 +21,42 @@ const (
 	// AnnotationKeyEtcdSnapshotted is an annotation indicating that ETCD snapshot was completed
 	AnnotationKeyEtcdSnapshotted = "credentials.gardener.cloud/etcd-snapshotted"
 
-	labelKeyRotationKeyName = "credentials.gardener.cloud/key-name"
-	rotationQPS             = 100
+	// AnnotationKeyRewritePaused is an annotation on the rewrite progress ConfigMap which pauses the
+	// namespace-by-namespace rewrite of encrypted data if set to "true".
+	AnnotationKeyRewritePaused = "credentials.gardener.cloud/rewrite-paused"
+	// AnnotationKeyServiceAccountKeyBundleCurrentVersion is an annotation on an externally managed service account key
+	// bundle secret which contains the version (i.e., the data key) of the key currently used for signing tokens.
+	AnnotationKeyServiceAccountKeyBundleCurrentVersion = "credentials.gardener.cloud/current-key-version"
+	// ConfigMapNamePrefixRewriteProgress is the name prefix of the ConfigMaps in the kube-system namespace of the target
+	// cluster which record the namespaces for which the namespace-by-namespace rewrite of encrypted data has completed.
+	// The name of the respective step is appended to the prefix.
+	ConfigMapNamePrefixRewriteProgress = "gardener-rewrite-progress-"
+
+	// RotationETCDEncryptionKey is the name of the ETCD encryption key rotation used by the StateMachine.
+	RotationETCDEncryptionKey = "etcd-encryption-key"
+	// StepRewriteAddLabel is the name of the step which rewrites all encrypted data and adds the key name label.
+	StepRewriteAddLabel = "rewrite-add-label"
+	// StepRewriteDiscoveredAddLabel is the name of the step which rewrites the encrypted data of resources registered
+	// after the start of the ETCD encryption key rotation and adds the key name label.
+	StepRewriteDiscoveredAddLabel = "rewrite-discovered-add-label"
+	// StepRewriteRemoveLabel is the name of the step which rewrites all encrypted data and removes the key name label.
+	StepRewriteRemoveLabel = "rewrite-remove-label"
+	// StepRewriteKMSKeyVersion is the name of the step which rewrites all encrypted data after the key of the KMS
+	// provider has been rotated. The hash of the key version is appended to the name.
+	StepRewriteKMSKeyVersion = "rewrite-kms-key-version"
+	// StepETCDSnapshotted is the name of the step which triggers a full snapshot of ETCD.
+	StepETCDSnapshotted = "snapshot-triggered"
+
+	annotationKeyPrefixRotation = "rotation.credentials.gardener.cloud/"
+	labelKeyRotationKeyName     = "credentials.gardener.cloud/key-name"
+	labelKeyKMSKeyVersion       = "credentials.gardener.cloud/kms-key-version"
+	rotationQPS                 = 100
+
+	// annotationKeyRewriteContinueList and annotationKeyRewriteContinue record the list and the continue token of the
+	// page which is rewritten next by the paginated rewrite of encrypted data.
+	annotationKeyRewriteContinueList = "credentials.gardener.cloud/rewrite-continue-list"
+	annotationKeyRewriteContinue     = "credentials.gardener.cloud/rewrite-continue"
+	// annotationKeyRewriteObjectsRewritten records the number of objects rewritten by the paginated rewrite of
+	// encrypted data.
+	annotationKeyRewriteObjectsRewritten = "credentials.gardener.cloud/rewrite-objects-rewritten"
 )
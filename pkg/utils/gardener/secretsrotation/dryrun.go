@@ -0,0 +1,95 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package secretsrotation
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/selection"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	"github.com/gardener/gardener/pkg/utils"
+	secretsmanager "github.com/gardener/gardener/pkg/utils/secrets/manager"
+)
+
+// RewriteDryRunResult is the result of a dry run of the rewrite of encrypted data.
+type RewriteDryRunResult struct {
+	// Objects maps the GVKs to the number of their objects which would be rewritten.
+	Objects map[schema.GroupVersionKind]int
+}
+
+// Total returns the number of objects of all GVKs which would be rewritten.
+func (r *RewriteDryRunResult) Total() int {
+	var total int
+	for _, count := range r.Objects {
+		total += count
+	}
+	return total
+}
+
+// EstimatedDuration returns the minimum duration of the rewrite of all objects with the rate limit of the given
+// options. The actual duration is longer since the latency of the requests is not considered.
+func (r *RewriteDryRunResult) EstimatedDuration(opts RewriteOptions) time.Duration {
+	qps := opts.QPS
+	if qps <= 0 {
+		qps = rotationQPS
+	}
+	return time.Duration(float64(r.Total()) / float64(qps) * float64(time.Second))
+}
+
+// DryRunRewriteEncryptedDataAddLabel counts the objects per GVK in the target cluster which would be rewritten by
+// RewriteEncryptedDataAddLabel, i.e., whose key name label does not match the name of the current ETCD encryption key
+// secret. Nothing is patched. This is useful for estimating the duration of the rotation and for validating changes of
+// the encryption configuration before the rotation is triggered. Only the PageSize and the MemoryBudget of the given
+// options are considered.
+func DryRunRewriteEncryptedDataAddLabel(
+	ctx context.Context,
+	log logr.Logger,
+	c client.Client,
+	secretsManager secretsmanager.Interface,
+	opts RewriteOptions,
+	gvks ...schema.GroupVersionKind,
+) (*RewriteDryRunResult, error) {
+	etcdEncryptionKeySecret, found := secretsManager.Get(v1beta1constants.SecretNameETCDEncryptionKey, secretsmanager.Current)
+	if !found {
+		return nil, fmt.Errorf("secret %q not found", v1beta1constants.SecretNameETCDEncryptionKey)
+	}
+
+	var (
+		r        = &rewriter{client: c, pageSize: rewritePageSize(opts)}
+		listOpts = []client.ListOption{client.MatchingLabelsSelector{Selector: labels.NewSelector().Add(
+			utils.MustNewRequirement(labelKeyRotationKeyName, selection.NotEquals, etcdEncryptionKeySecret.Name),
+		)}}
+		result = &RewriteDryRunResult{Objects: make(map[schema.GroupVersionKind]int, len(gvks))}
+	)
+
+	for _, gvk := range gvks {
+		count, err := r.countObjects(ctx, gvk, listOpts)
+		if err != nil {
+			return nil, err
+		}
+
+		log.Info("Objects which would be rewritten after ETCD encryption key rotation", "gvk", gvk, "number", count)
+		result.Objects[gvk] = count
+	}
+
+	return result, nil
+}
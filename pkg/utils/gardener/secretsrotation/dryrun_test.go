@@ -0,0 +1,100 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package secretsrotation_test
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	. "github.com/gardener/gardener/pkg/utils/gardener/secretsrotation"
+	secretsmanager "github.com/gardener/gardener/pkg/utils/secrets/manager"
+	fakesecretsmanager "github.com/gardener/gardener/pkg/utils/secrets/manager/fake"
+)
+
+var _ = Describe("Dry run", func() {
+	var (
+		ctx = context.TODO()
+
+		kubeAPIServerNamespace = "shoot--foo--bar"
+
+		runtimeClient      client.Client
+		targetClient       client.Client
+		fakeSecretsManager secretsmanager.Interface
+
+		secret1, secret2 *corev1.Secret
+
+		secretGVK     = corev1.SchemeGroupVersion.WithKind("SecretList")
+		deploymentGVK = appsv1.SchemeGroupVersion.WithKind("DeploymentList")
+	)
+
+	BeforeEach(func() {
+		runtimeClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).Build()
+		targetClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.ShootScheme).Build()
+		fakeSecretsManager = fakesecretsmanager.New(runtimeClient, kubeAPIServerNamespace)
+
+		secret1 = &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "secret1", Namespace: "ns1"}}
+		secret2 = &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "secret2", Namespace: "ns2", Labels: map[string]string{"credentials.gardener.cloud/key-name": "kube-apiserver-etcd-encryption-key-old"}}}
+
+		Expect(targetClient.Create(ctx, secret1)).To(Succeed())
+		Expect(targetClient.Create(ctx, secret2)).To(Succeed())
+		Expect(targetClient.Create(ctx, &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "secret3", Namespace: "ns2", Labels: map[string]string{"credentials.gardener.cloud/key-name": "kube-apiserver-etcd-encryption-key-current"}}})).To(Succeed())
+		Expect(targetClient.Create(ctx, &appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: "deployment1", Namespace: "ns1"}})).To(Succeed())
+	})
+
+	Describe("#DryRunRewriteEncryptedDataAddLabel", func() {
+		It("should count the objects which would be rewritten without patching them", func() {
+			Expect(runtimeClient.Create(ctx, &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "kube-apiserver-etcd-encryption-key-current", Namespace: kubeAPIServerNamespace}})).To(Succeed())
+
+			Expect(targetClient.Get(ctx, client.ObjectKeyFromObject(secret1), secret1)).To(Succeed())
+			secret1ResourceVersion := secret1.ResourceVersion
+
+			result, err := DryRunRewriteEncryptedDataAddLabel(ctx, logr.Discard(), targetClient, fakeSecretsManager, RewriteOptions{}, secretGVK, deploymentGVK)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.Objects).To(Equal(map[schema.GroupVersionKind]int{secretGVK: 2, deploymentGVK: 1}))
+			Expect(result.Total()).To(Equal(3))
+
+			Expect(targetClient.Get(ctx, client.ObjectKeyFromObject(secret1), secret1)).To(Succeed())
+			Expect(secret1.ResourceVersion).To(Equal(secret1ResourceVersion))
+			Expect(secret1.Labels).NotTo(HaveKey("credentials.gardener.cloud/key-name"))
+		})
+
+		It("should fail if the current encryption key secret does not exist", func() {
+			_, err := DryRunRewriteEncryptedDataAddLabel(ctx, logr.Discard(), targetClient, fakeSecretsManager, RewriteOptions{}, secretGVK)
+			Expect(err).To(MatchError(`secret "kube-apiserver-etcd-encryption-key" not found`))
+		})
+	})
+
+	Describe("RewriteDryRunResult", func() {
+		Describe("#EstimatedDuration", func() {
+			It("should estimate the duration with the configured rate limit", func() {
+				result := &RewriteDryRunResult{Objects: map[schema.GroupVersionKind]int{secretGVK: 1000, deploymentGVK: 500}}
+
+				Expect(result.EstimatedDuration(RewriteOptions{})).To(Equal(15 * time.Second))
+				Expect(result.EstimatedDuration(RewriteOptions{QPS: 50})).To(Equal(30 * time.Second))
+			})
+		})
+	})
+})
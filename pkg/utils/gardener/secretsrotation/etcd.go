@@ -16,15 +16,27 @@ package secretsrotation
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"time"
 
 	"github.com/go-logr/logr"
 	"golang.org/x/time/rate"
 	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/selection"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	apiregistrationv1 "k8s.io/kube-aggregator/pkg/apis/apiregistration/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
@@ -44,29 +56,61 @@ func RewriteEncryptedDataAddLabel(
 	log logr.Logger,
 	c client.Client,
 	secretsManager secretsmanager.Interface,
+	opts RewriteOptions,
 	gvks ...schema.GroupVersionKind,
+) error {
+	return rewriteEncryptedDataAddLabel(ctx, log, c, secretsManager, opts, StepRewriteAddLabel, gvks)
+}
+
+// RewriteDiscoveredEncryptedDataAddLabel works like RewriteEncryptedDataAddLabel for the resources registered after the
+// start of the ETCD encryption key rotation (see DiscoverEncryptedResources). It is recorded as a separate step, hence
+// it can be executed by the same StepRunner as RewriteEncryptedDataRemoveLabel in the 'Completing' phase.
+func RewriteDiscoveredEncryptedDataAddLabel(
+	ctx context.Context,
+	log logr.Logger,
+	c client.Client,
+	secretsManager secretsmanager.Interface,
+	opts RewriteOptions,
+	gvks ...schema.GroupVersionKind,
+) error {
+	return rewriteEncryptedDataAddLabel(ctx, log, c, secretsManager, opts, StepRewriteDiscoveredAddLabel, gvks)
+}
+
+func rewriteEncryptedDataAddLabel(
+	ctx context.Context,
+	log logr.Logger,
+	c client.Client,
+	secretsManager secretsmanager.Interface,
+	opts RewriteOptions,
+	step string,
+	gvks []schema.GroupVersionKind,
 ) error {
 	etcdEncryptionKeySecret, found := secretsManager.Get(v1beta1constants.SecretNameETCDEncryptionKey, secretsmanager.Current)
 	if !found {
 		return fmt.Errorf("secret %q not found", v1beta1constants.SecretNameETCDEncryptionKey)
 	}
 
-	return rewriteEncryptedData(
+	if err := rewrite(
 		ctx,
 		log,
 		c,
+		opts,
+		step,
 		utils.MustNewRequirement(labelKeyRotationKeyName, selection.NotEquals, etcdEncryptionKeySecret.Name),
 		func(objectMeta *metav1.ObjectMeta) {
 			metav1.SetMetaDataLabel(objectMeta, labelKeyRotationKeyName, etcdEncryptionKeySecret.Name)
 		},
-		gvks...,
-	)
+		gvks,
+	); err != nil && !errors.Is(err, errRewritePaused) {
+		return err
+	}
+	return nil
 }
 
 // RewriteEncryptedDataRemoveLabel patches all encrypted data in all namespaces in the target clusters and removes the
 // label whose value is the name of the current ETCD encryption key secret. This function is useful for the ETCD
 // encryption key secret rotation which requires all encrypted data to be rewritten to ETCD so that they become
-// encrypted with the new key.
+// encrypted with the new key. If the rewrite is paused (see RewriteOptions), the deployment is not patched.
 func RewriteEncryptedDataRemoveLabel(
 	ctx context.Context,
 	log logr.Logger,
@@ -74,18 +118,24 @@ func RewriteEncryptedDataRemoveLabel(
 	targetClient client.Client,
 	namespace string,
 	name string,
+	opts RewriteOptions,
 	gvks ...schema.GroupVersionKind,
 ) error {
-	if err := rewriteEncryptedData(
+	if err := rewrite(
 		ctx,
 		log,
 		targetClient,
+		opts,
+		StepRewriteRemoveLabel,
 		utils.MustNewRequirement(labelKeyRotationKeyName, selection.Exists),
 		func(objectMeta *metav1.ObjectMeta) {
 			delete(objectMeta.Labels, labelKeyRotationKeyName)
 		},
-		gvks...,
+		gvks,
 	); err != nil {
+		if errors.Is(err, errRewritePaused) {
+			return nil
+		}
 		return err
 	}
 
@@ -94,48 +144,550 @@ func RewriteEncryptedDataRemoveLabel(
 	})
 }
 
-func rewriteEncryptedData(
+// errRewritePaused is returned by rewrite if the rewrite was paused. It prevents that the StepRunner marks the step as
+// completed, but it is not returned to the callers since a paused rewrite is not a failure. The pause is reported to
+// the ProgressSink instead.
+var errRewritePaused = errors.New("rewrite of encrypted data is paused")
+
+// RewriteOptions contains options for rewriting the encrypted data in the target cluster.
+type RewriteOptions struct {
+	// NamespaceByNamespace specifies whether the encrypted data shall be rewritten one namespace at a time instead of
+	// all namespaces at once. This is useful for clusters with extremely large namespaces. The completed namespaces are
+	// recorded in a marker ConfigMap in the kube-system namespace of the target cluster, hence an interrupted rewrite
+	// resumes with the next namespace. The rewrite can be paused by annotating the marker ConfigMap with
+	// credentials.gardener.cloud/rewrite-paused=true. A paused rewrite returns without an error and reports a Progress
+	// with Paused=true to the ProgressSink, but the step is not completed.
+	NamespaceByNamespace bool
+	// ProgressSink receives the progress of the namespace-by-namespace and the paginated rewrite. Use
+	// NewMultiProgressSink to report the progress to multiple sinks. Defaults to a sink logging the progress.
+	ProgressSink ProgressSink
+	// QPS is the maximum number of patch requests per second sent to the API server of the target cluster. Defaults to
+	// 100.
+	QPS float32
+	// Burst is the maximum burst of patch requests sent to the API server of the target cluster. Defaults to the QPS.
+	Burst int
+	// PageSize is the maximum number of objects listed at once. If set, the objects are rewritten page by page and the
+	// continue token of the list is recorded in the marker ConfigMap after each page, hence an interrupted rewrite
+	// resumes with the next page instead of listing all remaining objects again. The number of rewritten objects is
+	// reported to the ProgressSink after each page. If not set, the objects are still listed and rewritten page by page
+	// (see MemoryBudget), but no checkpoint is recorded.
+	PageSize int64
+	// MemoryBudget is the approximate number of bytes which may be used for holding the metadata of listed objects at
+	// once. Each page is patched right after it has been listed and dropped afterwards, hence the memory usage does not
+	// grow with the number of objects in the target cluster. The size of the pages is derived from the budget assuming
+	// 4 KiB per object and capped by PageSize. Defaults to 64 MiB.
+	MemoryBudget int64
+	// StepRunner executes the rewrite as a step of the rotation, e.g. a StateMachine which persists that the rewrite
+	// was completed in the current phase. If set, a completed rewrite is not repeated in subsequent reconciliations.
+	StepRunner Runner
+}
+
+const (
+	defaultRewriteMemoryBudget  int64 = 64 << 20
+	estimatedObjectMetadataSize int64 = 4 << 10
+)
+
+// rewritePageSize returns the maximum number of objects listed at once for the given options.
+func rewritePageSize(opts RewriteOptions) int64 {
+	memoryBudget := opts.MemoryBudget
+	if memoryBudget <= 0 {
+		memoryBudget = defaultRewriteMemoryBudget
+	}
+
+	pageSize := memoryBudget / estimatedObjectMetadataSize
+	if pageSize < 1 {
+		pageSize = 1
+	}
+	if opts.PageSize > 0 && opts.PageSize < pageSize {
+		pageSize = opts.PageSize
+	}
+	return pageSize
+}
+
+func rewrite(
 	ctx context.Context,
 	log logr.Logger,
 	c client.Client,
+	opts RewriteOptions,
+	step string,
 	requirement labels.Requirement,
 	mutateObjectMeta func(*metav1.ObjectMeta),
-	gvks ...schema.GroupVersionKind,
+	gvks []schema.GroupVersionKind,
 ) error {
-	var (
-		limiter = rate.NewLimiter(rate.Limit(rotationQPS), rotationQPS)
-		taskFns []flow.TaskFn
-	)
+	if opts.StepRunner != nil {
+		runner := opts.StepRunner
+		opts.StepRunner = nil
+		return runner.RunStep(ctx, step, func(ctx context.Context) error {
+			return rewrite(ctx, log, c, opts, step, requirement, mutateObjectMeta, gvks)
+		})
+	}
 
-	for _, gvk := range gvks {
-		objList := &metav1.PartialObjectMetadataList{}
-		objList.SetGroupVersionKind(gvk)
-		if err := c.List(ctx, objList, client.MatchingLabelsSelector{Selector: labels.NewSelector().Add(requirement)}); err != nil {
+	r := &rewriter{
+		client:           c,
+		limiter:          newRewriteLimiter(opts.QPS, opts.Burst),
+		progressSink:     opts.ProgressSink,
+		pageSize:         rewritePageSize(opts),
+		checkpointPages:  opts.PageSize > 0,
+		markerKey:        client.ObjectKey{Name: ConfigMapNamePrefixRewriteProgress + step, Namespace: metav1.NamespaceSystem},
+		requirement:      requirement,
+		mutateObjectMeta: mutateObjectMeta,
+		gvks:             gvks,
+		progress:         Progress{Step: step},
+	}
+	if r.progressSink == nil {
+		r.progressSink = NewLogProgressSink(log)
+	}
+
+	if !opts.NamespaceByNamespace && opts.PageSize <= 0 {
+		return r.rewriteEncryptedData(ctx, log)
+	}
+
+	if err := r.readOrCreateMarker(ctx); err != nil {
+		return err
+	}
+
+	if opts.NamespaceByNamespace {
+		if err := r.rewriteEncryptedDataNamespaceByNamespace(ctx, log); err != nil {
+			return err
+		}
+	} else if err := r.rewriteEncryptedData(ctx, log); err != nil {
+		return err
+	}
+
+	if err := kubernetesutils.DeleteObject(ctx, c, &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: r.markerKey.Name, Namespace: r.markerKey.Namespace}}); err != nil {
+		return err
+	}
+
+	r.progress.Current = ""
+	r.progress.Done = true
+	return r.report(ctx)
+}
+
+// newRewriteLimiter returns the limiter for the patch requests sent by the rewrite of encrypted data.
+func newRewriteLimiter(qps float32, burst int) *rate.Limiter {
+	if qps <= 0 {
+		qps = rotationQPS
+	}
+	if burst <= 0 {
+		burst = int(math.Ceil(float64(qps)))
+	}
+	return rate.NewLimiter(rate.Limit(qps), burst)
+}
+
+// rewriter rewrites the encrypted data of the given kinds in the target cluster. The marker ConfigMap is only used in
+// the namespace-by-namespace and the paginated mode.
+type rewriter struct {
+	client           client.Client
+	limiter          *rate.Limiter
+	progressSink     ProgressSink
+	pageSize         int64
+	checkpointPages  bool
+	markerKey        client.ObjectKey
+	requirement      labels.Requirement
+	mutateObjectMeta func(*metav1.ObjectMeta)
+	gvks             []schema.GroupVersionKind
+
+	progress Progress
+	// continueList and continueToken are the checkpoint of the paginated rewrite read from the marker ConfigMap.
+	continueList, continueToken string
+}
+
+func (r *rewriter) report(ctx context.Context) error {
+	return r.progressSink.Report(ctx, r.progress)
+}
+
+// readOrCreateMarker reads the marker ConfigMap and restores the checkpoint of an interrupted paginated rewrite. If the
+// ConfigMap does not exist yet, it is created.
+func (r *rewriter) readOrCreateMarker(ctx context.Context) error {
+	marker := &corev1.ConfigMap{}
+	if err := r.client.Get(ctx, r.markerKey, marker); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return fmt.Errorf("failed reading rewrite progress ConfigMap %s: %w", r.markerKey, err)
+		}
+		if err := r.client.Create(ctx, &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: r.markerKey.Name, Namespace: r.markerKey.Namespace}}); err != nil {
+			return fmt.Errorf("failed creating rewrite progress ConfigMap %s: %w", r.markerKey, err)
+		}
+		return nil
+	}
+
+	r.continueList = marker.Annotations[annotationKeyRewriteContinueList]
+	r.continueToken = marker.Annotations[annotationKeyRewriteContinue]
+	if objectsRewritten, err := strconv.Atoi(marker.Annotations[annotationKeyRewriteObjectsRewritten]); err == nil {
+		// The objects rewritten before the interruption do not match the requirement anymore, hence they are not
+		// counted again.
+		r.progress.ObjectsRewritten = objectsRewritten
+		r.progress.ObjectsFound = objectsRewritten
+	}
+	return nil
+}
+
+// checkpoint records the given continue token of the given list and the number of rewritten objects in the marker
+// ConfigMap. An empty continue token removes the checkpoint of the list.
+func (r *rewriter) checkpoint(ctx context.Context, list, continueToken string) error {
+	annotations := map[string]any{
+		annotationKeyRewriteContinueList:     nil,
+		annotationKeyRewriteContinue:         nil,
+		annotationKeyRewriteObjectsRewritten: strconv.Itoa(r.progress.ObjectsRewritten),
+	}
+	if continueToken != "" {
+		annotations[annotationKeyRewriteContinueList] = list
+		annotations[annotationKeyRewriteContinue] = continueToken
+	}
+
+	patch, err := json.Marshal(map[string]any{"metadata": map[string]any{"annotations": annotations}})
+	if err != nil {
+		return err
+	}
+
+	marker := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: r.markerKey.Name, Namespace: r.markerKey.Namespace}}
+	if err := r.client.Patch(ctx, marker, client.RawPatch(types.MergePatchType, patch)); err != nil {
+		return fmt.Errorf("failed recording checkpoint in rewrite progress ConfigMap %s: %w", r.markerKey, err)
+	}
+	return nil
+}
+
+func (r *rewriter) rewriteEncryptedDataNamespaceByNamespace(ctx context.Context, log logr.Logger) error {
+	namespaceList := &metav1.PartialObjectMetadataList{}
+	namespaceList.SetGroupVersionKind(corev1.SchemeGroupVersion.WithKind("NamespaceList"))
+	if err := r.client.List(ctx, namespaceList); err != nil {
+		return err
+	}
+
+	namespaces := make([]string, 0, len(namespaceList.Items))
+	for _, namespace := range namespaceList.Items {
+		namespaces = append(namespaces, namespace.Name)
+	}
+	sort.Strings(namespaces)
+
+	r.progress.Total = len(namespaces)
+	if err := r.report(ctx); err != nil {
+		return err
+	}
+
+	for _, namespace := range namespaces {
+		// Read the marker ConfigMap again in every iteration to respect a pause requested in the meantime.
+		marker := &corev1.ConfigMap{}
+		if err := r.client.Get(ctx, r.markerKey, marker); err != nil {
+			return fmt.Errorf("failed reading rewrite progress ConfigMap %s: %w", r.markerKey, err)
+		}
+		if _, completed := marker.Data[namespace]; completed {
+			continue
+		}
+
+		if marker.Annotations[AnnotationKeyRewritePaused] == "true" {
+			log.Info("Rewrite of encrypted data is paused, remove the annotation from the progress ConfigMap to resume", "annotation", AnnotationKeyRewritePaused, "configMap", r.markerKey)
+			r.progress.Completed = len(marker.Data)
+			r.progress.Current = ""
+			r.progress.Paused = true
+			if err := r.report(ctx); err != nil {
+				return err
+			}
+			return errRewritePaused
+		}
+
+		r.progress.Completed = len(marker.Data)
+		r.progress.Current = namespace
+		if err := r.report(ctx); err != nil {
+			return err
+		}
+
+		if err := r.rewriteEncryptedData(ctx, log.WithValues("namespace", namespace), client.InNamespace(namespace)); err != nil {
 			return err
 		}
 
-		log.Info("Objects requiring to be rewritten after ETCD encryption key rotation", "gvk", gvk, "number", len(objList.Items))
+		patch := client.MergeFrom(marker.DeepCopy())
+		if marker.Data == nil {
+			marker.Data = make(map[string]string, 1)
+		}
+		marker.Data[namespace] = time.Now().UTC().Format(time.RFC3339)
+		if err := r.client.Patch(ctx, marker, patch); err != nil {
+			return fmt.Errorf("failed recording completion of namespace %q in rewrite progress ConfigMap %s: %w", namespace, r.markerKey, err)
+		}
+	}
 
-		for _, o := range objList.Items {
-			obj := o
+	r.progress.Completed = len(namespaces)
+	r.progress.Current = ""
+	if err := r.report(ctx); err != nil {
+		return err
+	}
+
+	// Cluster-scoped objects and objects in namespaces created after the iteration has started are not covered yet.
+	// Since all other objects already match the desired state, this final pass only rewrites the remaining ones.
+	return r.rewriteEncryptedData(ctx, log)
+}
 
-			taskFns = append(taskFns, func(ctx context.Context) error {
-				patch := client.StrategicMergeFrom(obj.DeepCopy())
-				mutateObjectMeta(&obj.ObjectMeta)
+func (r *rewriter) rewriteEncryptedData(ctx context.Context, log logr.Logger, listOpts ...client.ListOption) error {
+	listOpts = append([]client.ListOption{client.MatchingLabelsSelector{Selector: labels.NewSelector().Add(r.requirement)}}, listOpts...)
 
-				// Wait until we are allowed by the limiter to not overload the API server with too many requests.
-				if err := limiter.Wait(ctx); err != nil {
-					return err
+	if r.checkpointPages {
+		return r.rewriteEncryptedDataPaginated(ctx, log, listOpts)
+	}
+
+	// Each page is patched right away instead of accumulating all objects, which keeps the memory usage flat for
+	// clusters with a huge number of objects. The rewritten objects do not match the requirement anymore, but the list
+	// continues after the key of the last returned object, hence no object is skipped.
+	for _, gvk := range r.gvks {
+		var count int
+		if err := r.forEachPage(ctx, gvk, listOpts, func(objList *metav1.PartialObjectMetadataList) error {
+			r.progress.ObjectsFound += len(objList.Items)
+			if err := r.patch(ctx, objList.Items); err != nil {
+				return err
+			}
+			r.progress.ObjectsRewritten += len(objList.Items)
+			count += len(objList.Items)
+			return nil
+		}); err != nil {
+			return err
+		}
+
+		log.Info("Objects rewritten after ETCD encryption key rotation", "gvk", gvk, "number", count)
+	}
+
+	return nil
+}
+
+// rewriteEncryptedDataPaginated rewrites the objects page by page and records a checkpoint after each page. If the
+// continue token of the checkpoint has expired, e.g. because of a compaction of ETCD, the list is restarted. This is
+// safe since the list only contains the objects which have not been rewritten yet.
+func (r *rewriter) rewriteEncryptedDataPaginated(ctx context.Context, log logr.Logger, listOpts []client.ListOption) error {
+	listOptions := (&client.ListOptions{}).ApplyOptions(listOpts)
+
+	// The API server does not return the number of remaining items for lists with label selectors, hence the progress
+	// only reports the objects found so far instead of counting all objects upfront.
+	for _, gvk := range r.gvks {
+		list := listOptions.Namespace + "/" + gvk.String()
+
+		var continueToken string
+		if r.continueList == list {
+			continueToken = r.continueToken
+			log.Info("Resuming rewrite of encrypted data from checkpoint", "gvk", gvk)
+		}
+
+		var count int
+		for {
+			objList := &metav1.PartialObjectMetadataList{}
+			objList.SetGroupVersionKind(gvk)
+			if err := r.client.List(ctx, objList, append(listOpts, client.Limit(r.pageSize), client.Continue(continueToken))...); err != nil {
+				if apierrors.IsResourceExpired(err) && continueToken != "" {
+					log.Info("Continue token of checkpoint expired, restarting list", "gvk", gvk)
+					continueToken = ""
+					continue
 				}
+				return err
+			}
+
+			r.progress.ObjectsFound += len(objList.Items)
+			if err := r.patch(ctx, objList.Items); err != nil {
+				return err
+			}
+			r.progress.ObjectsRewritten += len(objList.Items)
+			count += len(objList.Items)
+			continueToken = objList.Continue
+
+			if err := r.checkpoint(ctx, list, continueToken); err != nil {
+				return err
+			}
+			if err := r.report(ctx); err != nil {
+				return err
+			}
 
-				return c.Patch(ctx, &obj, patch)
-			})
+			if continueToken == "" {
+				break
+			}
 		}
+
+		log.Info("Objects rewritten after ETCD encryption key rotation", "gvk", gvk, "number", count)
+	}
+
+	return nil
+}
+
+func (r *rewriter) countObjects(ctx context.Context, gvk schema.GroupVersionKind, listOpts []client.ListOption) (int, error) {
+	var count int
+	if err := r.forEachPage(ctx, gvk, listOpts, func(objList *metav1.PartialObjectMetadataList) error {
+		count += len(objList.Items)
+		return nil
+	}); err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// forEachPage lists the metadata of the objects of the given kind page by page and calls fn for each page. Only one
+// page is held in memory at a time.
+func (r *rewriter) forEachPage(ctx context.Context, gvk schema.GroupVersionKind, listOpts []client.ListOption, fn func(*metav1.PartialObjectMetadataList) error) error {
+	var continueToken string
+
+	for {
+		objList := &metav1.PartialObjectMetadataList{}
+		objList.SetGroupVersionKind(gvk)
+		if err := r.client.List(ctx, objList, append(listOpts, client.Limit(r.pageSize), client.Continue(continueToken))...); err != nil {
+			return err
+		}
+
+		if err := fn(objList); err != nil {
+			return err
+		}
+
+		if continueToken = objList.Continue; continueToken == "" {
+			return nil
+		}
+	}
+}
+
+func (r *rewriter) patch(ctx context.Context, objects []metav1.PartialObjectMetadata) error {
+	var taskFns []flow.TaskFn
+
+	for _, o := range objects {
+		obj := o
+
+		taskFns = append(taskFns, func(ctx context.Context) error {
+			patch := client.StrategicMergeFrom(obj.DeepCopy())
+			r.mutateObjectMeta(&obj.ObjectMeta)
+
+			// Wait until we are allowed by the limiter to not overload the API server with too many requests.
+			if err := r.limiter.Wait(ctx); err != nil {
+				return err
+			}
+
+			return r.client.Patch(ctx, &obj, patch)
+		})
 	}
 
 	return flow.Parallel(taskFns...)(ctx)
 }
 
+// GetResourcesForEncryption returns the given GVKs which can be rewritten as part of the ETCD encryption key rotation.
+// Resources served by aggregated API servers are not stored in the ETCD of the target cluster, hence rewriting them
+// would always fail. Such resources are skipped with a warning, unless their API group is contained in
+// sameStorageGroups, i.e., the aggregated API server persists them in the same ETCD (like the gardener-apiserver does
+// in the virtual garden cluster). The discovery information is read from the APIService objects in the target cluster.
+func GetResourcesForEncryption(
+	ctx context.Context,
+	log logr.Logger,
+	c client.Client,
+	gvks []schema.GroupVersionKind,
+	sameStorageGroups ...string,
+) ([]schema.GroupVersionKind, error) {
+	var (
+		result              []schema.GroupVersionKind
+		sameStorageGroupSet = sets.New(sameStorageGroups...)
+	)
+
+	for _, gvk := range gvks {
+		aggregated, err := isServedByAggregatedAPIServer(ctx, c, gvk.GroupVersion())
+		if err != nil {
+			return nil, err
+		}
+
+		if aggregated && !sameStorageGroupSet.Has(gvk.Group) {
+			log.Info("Skipping rewrite of resources served by aggregated API server without storage in ETCD of target cluster", "gvk", gvk)
+			continue
+		}
+
+		result = append(result, gvk)
+	}
+
+	return result, nil
+}
+
+func isServedByAggregatedAPIServer(ctx context.Context, c client.Client, groupVersion schema.GroupVersion) (bool, error) {
+	apiService := &apiregistrationv1.APIService{}
+	if err := c.Get(ctx, client.ObjectKey{Name: groupVersion.Version + "." + groupVersion.Group}, apiService); err != nil {
+		if apierrors.IsNotFound(err) {
+			// Without an APIService, the group version is served by the API server itself.
+			return false, nil
+		}
+		return false, fmt.Errorf("failed reading APIService for %s: %w", groupVersion, err)
+	}
+
+	// Local APIServices do not reference a service, i.e., their resources are served by the API server itself.
+	return apiService.Spec.Service != nil, nil
+}
+
+// DiscoverEncryptedResources returns the GVKs of the custom resources in the target cluster which are encrypted according
+// to the given resources of the ETCD encryption configuration (e.g., `foos.example.com` or `*.example.com`) but whose
+// group kind is not contained in knownGVKs. Such resources might have been registered after the ETCD encryption key
+// rotation was started, hence their objects were not rewritten yet and must be rewritten before the rotation is
+// completed.
+func DiscoverEncryptedResources(
+	ctx context.Context,
+	log logr.Logger,
+	c client.Client,
+	resources []string,
+	knownGVKs []schema.GroupVersionKind,
+) ([]schema.GroupVersionKind, error) {
+	crdList := &apiextensionsv1.CustomResourceDefinitionList{}
+	if err := c.List(ctx, crdList); err != nil {
+		return nil, fmt.Errorf("failed listing CustomResourceDefinitions: %w", err)
+	}
+
+	knownGroupKinds := sets.New[schema.GroupKind]()
+	for _, gvk := range knownGVKs {
+		knownGroupKinds.Insert(gvk.GroupKind())
+	}
+
+	var result []schema.GroupVersionKind
+	for _, crd := range crdList.Items {
+		groupResource := schema.GroupResource{Group: crd.Spec.Group, Resource: crd.Spec.Names.Plural}
+		if !isEncryptedResource(groupResource, resources) {
+			continue
+		}
+
+		listKind := crd.Spec.Names.ListKind
+		if listKind == "" {
+			listKind = crd.Spec.Names.Kind + "List"
+		}
+
+		groupKind := schema.GroupKind{Group: crd.Spec.Group, Kind: listKind}
+		if knownGroupKinds.Has(groupKind) {
+			continue
+		}
+
+		version := servedVersion(crd)
+		if version == "" {
+			log.Info("Skipping encrypted resource without served version", "groupResource", groupResource)
+			continue
+		}
+
+		gvk := groupKind.WithVersion(version)
+		log.Info("Discovered encrypted resource which was not rewritten yet", "gvk", gvk)
+		result = append(result, gvk)
+	}
+
+	return result, nil
+}
+
+// isEncryptedResource checks whether the given group resource is matched by the resources of the ETCD encryption
+// configuration. Like the kube-apiserver, it supports the `*.<group>` and `*.*` wildcards.
+func isEncryptedResource(groupResource schema.GroupResource, resources []string) bool {
+	for _, resource := range resources {
+		switch resource {
+		case groupResource.String(), "*." + groupResource.Group, "*.*":
+			return true
+		}
+	}
+	return false
+}
+
+// servedVersion returns the storage version of the given CustomResourceDefinition if it is served, otherwise the first
+// served version. Listing any served version returns all objects of the resource.
+func servedVersion(crd apiextensionsv1.CustomResourceDefinition) string {
+	var version string
+	for _, v := range crd.Spec.Versions {
+		if !v.Served {
+			continue
+		}
+		if v.Storage {
+			return v.Name
+		}
+		if version == "" {
+			version = v.Name
+		}
+	}
+	return version
+}
+
 // SnapshotETCDAfterRewritingEncryptedData performs a full snapshot on ETCD after the encrypted data (like secrets) have
 // been rewritten as part of the ETCD encryption secret rotation. It adds an annotation to the API server deployment
 // after it's done so that it does not take another snapshot again after it succeeded once.
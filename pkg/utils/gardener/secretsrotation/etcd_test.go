@@ -16,22 +16,33 @@ package secretsrotation_test
 
 import (
 	"context"
+	"errors"
+	"fmt"
 
 	"github.com/go-logr/logr"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus/testutil"
 	"go.uber.org/mock/gomock"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	apiregistrationv1 "k8s.io/kube-aggregator/pkg/apis/apiregistration/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
 
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	"github.com/gardener/gardener/pkg/client/kubernetes"
 	mocketcd "github.com/gardener/gardener/pkg/component/etcd/mock"
 	. "github.com/gardener/gardener/pkg/utils/gardener/secretsrotation"
+	fakesecretsrotation "github.com/gardener/gardener/pkg/utils/gardener/secretsrotation/fake"
 	secretsmanager "github.com/gardener/gardener/pkg/utils/secrets/manager"
 	fakesecretsmanager "github.com/gardener/gardener/pkg/utils/secrets/manager/fake"
+	. "github.com/gardener/gardener/pkg/utils/test/matchers"
 )
 
 var _ = Describe("ETCD", func() {
@@ -103,7 +114,7 @@ var _ = Describe("ETCD", func() {
 				secret2ResourceVersion := secret2.ResourceVersion
 				secret3ResourceVersion := secret3.ResourceVersion
 
-				Expect(RewriteEncryptedDataAddLabel(ctx, logger, targetClient, fakeSecretsManager, corev1.SchemeGroupVersion.WithKind("SecretList"))).To(Succeed())
+				Expect(RewriteEncryptedDataAddLabel(ctx, logger, targetClient, fakeSecretsManager, RewriteOptions{}, corev1.SchemeGroupVersion.WithKind("SecretList"))).To(Succeed())
 
 				Expect(targetClient.Get(ctx, client.ObjectKeyFromObject(secret1), secret1)).To(Succeed())
 				Expect(targetClient.Get(ctx, client.ObjectKeyFromObject(secret2), secret2)).To(Succeed())
@@ -117,6 +128,268 @@ var _ = Describe("ETCD", func() {
 				Expect(secret2.ResourceVersion).NotTo(Equal(secret2ResourceVersion))
 				Expect(secret3.ResourceVersion).To(Equal(secret3ResourceVersion))
 			})
+
+			It("should run the rewrite as a step of the rotation and skip it once it is done", func() {
+				Expect(runtimeClient.Create(ctx, &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "kube-apiserver-etcd-encryption-key-current", Namespace: kubeAPIServerNamespace}})).To(Succeed())
+				stateMachine := fakesecretsrotation.New(gardencorev1beta1.RotationPreparing).ScriptStep(StepRewriteAddLabel, fakesecretsrotation.StepBehavior{Err: errors.New("fake"), Times: 1})
+				opts := RewriteOptions{StepRunner: stateMachine}
+
+				Expect(RewriteEncryptedDataAddLabel(ctx, logger, targetClient, fakeSecretsManager, opts, corev1.SchemeGroupVersion.WithKind("SecretList"))).To(MatchError("fake"))
+				Expect(targetClient.Get(ctx, client.ObjectKeyFromObject(secret1), secret1)).To(Succeed())
+				Expect(secret1.Labels).NotTo(HaveKey("credentials.gardener.cloud/key-name"))
+
+				Expect(RewriteEncryptedDataAddLabel(ctx, logger, targetClient, fakeSecretsManager, opts, corev1.SchemeGroupVersion.WithKind("SecretList"))).To(Succeed())
+				Expect(targetClient.Get(ctx, client.ObjectKeyFromObject(secret1), secret1)).To(Succeed())
+				Expect(secret1.Labels).To(HaveKeyWithValue("credentials.gardener.cloud/key-name", "kube-apiserver-etcd-encryption-key-current"))
+				Expect(stateMachine.IsStepDone(StepRewriteAddLabel)).To(BeTrue())
+
+				// Secrets created after the step was completed are not rewritten again in the same phase.
+				secret4 := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "secret4", Namespace: namespace1.Name}}
+				Expect(targetClient.Create(ctx, secret4)).To(Succeed())
+				Expect(RewriteEncryptedDataAddLabel(ctx, logger, targetClient, fakeSecretsManager, opts, corev1.SchemeGroupVersion.WithKind("SecretList"))).To(Succeed())
+				Expect(targetClient.Get(ctx, client.ObjectKeyFromObject(secret4), secret4)).To(Succeed())
+				Expect(secret4.Labels).NotTo(HaveKey("credentials.gardener.cloud/key-name"))
+				Expect(stateMachine.Executions).To(Equal([]string{StepRewriteAddLabel, StepRewriteAddLabel}))
+			})
+
+			Context("namespace by namespace", func() {
+				var (
+					opts   RewriteOptions
+					marker *corev1.ConfigMap
+				)
+
+				BeforeEach(func() {
+					opts = RewriteOptions{NamespaceByNamespace: true, ProgressSink: NewMetricsProgressSink(kubeAPIServerNamespace)}
+					marker = &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "gardener-rewrite-progress-rewrite-add-label", Namespace: "kube-system"}}
+
+					Expect(runtimeClient.Create(ctx, &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "kube-apiserver-etcd-encryption-key-current", Namespace: kubeAPIServerNamespace}})).To(Succeed())
+				})
+
+				It("should patch all secrets, add the label and remove the progress ConfigMap", func() {
+					Expect(RewriteEncryptedDataAddLabel(ctx, logger, targetClient, fakeSecretsManager, opts, corev1.SchemeGroupVersion.WithKind("SecretList"))).To(Succeed())
+
+					Expect(targetClient.Get(ctx, client.ObjectKeyFromObject(secret1), secret1)).To(Succeed())
+					Expect(targetClient.Get(ctx, client.ObjectKeyFromObject(secret2), secret2)).To(Succeed())
+
+					Expect(secret1.Labels).To(HaveKeyWithValue("credentials.gardener.cloud/key-name", "kube-apiserver-etcd-encryption-key-current"))
+					Expect(secret2.Labels).To(HaveKeyWithValue("credentials.gardener.cloud/key-name", "kube-apiserver-etcd-encryption-key-current"))
+
+					Expect(targetClient.Get(ctx, client.ObjectKeyFromObject(marker), marker)).To(BeNotFoundError())
+				})
+
+				It("should not rewrite anything while paused and resume afterwards", func() {
+					marker.Annotations = map[string]string{"credentials.gardener.cloud/rewrite-paused": "true"}
+					marker.Data = map[string]string{namespace1.Name: "2023-10-16T00:00:00Z"}
+					Expect(targetClient.Create(ctx, marker)).To(Succeed())
+
+					progressSink := &fakesecretsrotation.ProgressSink{}
+					stateMachine := fakesecretsrotation.New(gardencorev1beta1.RotationPreparing)
+					opts.ProgressSink = NewMultiProgressSink(opts.ProgressSink, progressSink)
+					opts.StepRunner = stateMachine
+
+					Expect(RewriteEncryptedDataAddLabel(ctx, logger, targetClient, fakeSecretsManager, opts, corev1.SchemeGroupVersion.WithKind("SecretList"))).To(Succeed())
+					Expect(stateMachine.IsStepDone(StepRewriteAddLabel)).To(BeFalse())
+					Expect(progressSink.Reports).To(HaveExactElements(
+						Progress{Step: StepRewriteAddLabel, Total: 2},
+						Progress{Step: StepRewriteAddLabel, Total: 2, Completed: 1, Paused: true},
+					))
+					Expect(testutil.ToFloat64(RewritePaused.WithLabelValues(kubeAPIServerNamespace, StepRewriteAddLabel))).To(Equal(float64(1)))
+
+					Expect(targetClient.Get(ctx, client.ObjectKeyFromObject(secret1), secret1)).To(Succeed())
+					Expect(targetClient.Get(ctx, client.ObjectKeyFromObject(secret2), secret2)).To(Succeed())
+					Expect(secret1.Labels).NotTo(HaveKey("credentials.gardener.cloud/key-name"))
+					Expect(secret2.Labels).NotTo(HaveKey("credentials.gardener.cloud/key-name"))
+
+					Expect(targetClient.Get(ctx, client.ObjectKeyFromObject(marker), marker)).To(Succeed())
+					Expect(marker.Data).To(Equal(map[string]string{namespace1.Name: "2023-10-16T00:00:00Z"}))
+
+					delete(marker.Annotations, "credentials.gardener.cloud/rewrite-paused")
+					Expect(targetClient.Update(ctx, marker)).To(Succeed())
+
+					Expect(RewriteEncryptedDataAddLabel(ctx, logger, targetClient, fakeSecretsManager, opts, corev1.SchemeGroupVersion.WithKind("SecretList"))).To(Succeed())
+
+					Expect(targetClient.Get(ctx, client.ObjectKeyFromObject(secret1), secret1)).To(Succeed())
+					Expect(targetClient.Get(ctx, client.ObjectKeyFromObject(secret2), secret2)).To(Succeed())
+					Expect(secret1.Labels).To(HaveKeyWithValue("credentials.gardener.cloud/key-name", "kube-apiserver-etcd-encryption-key-current"))
+					Expect(secret2.Labels).To(HaveKeyWithValue("credentials.gardener.cloud/key-name", "kube-apiserver-etcd-encryption-key-current"))
+
+					Expect(stateMachine.IsStepDone(StepRewriteAddLabel)).To(BeTrue())
+					Expect(targetClient.Get(ctx, client.ObjectKeyFromObject(marker), marker)).To(BeNotFoundError())
+				})
+			})
+
+			Context("paginated", func() {
+				var (
+					opts           RewriteOptions
+					marker         *corev1.ConfigMap
+					reported       []Progress
+					continueTokens []string
+					pagedClient    client.Client
+					interceptFuncs interceptor.Funcs
+				)
+
+				BeforeEach(func() {
+					reported = nil
+					continueTokens = nil
+
+					opts = RewriteOptions{
+						PageSize: 1,
+						QPS:      1000,
+						ProgressSink: progressSinkFunc(func(_ context.Context, p Progress) error {
+							reported = append(reported, p)
+							return nil
+						}),
+					}
+					marker = &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "gardener-rewrite-progress-rewrite-add-label", Namespace: "kube-system"}}
+
+					// The fake client does not support pagination, hence it is emulated by returning the objects sorted by
+					// their keys and using the key of the last returned object as continue token.
+					interceptFuncs = interceptor.Funcs{
+						List: func(ctx context.Context, c client.WithWatch, list client.ObjectList, opts ...client.ListOption) error {
+							listOptions := (&client.ListOptions{}).ApplyOptions(opts)
+							if listOptions.Limit == 0 {
+								return c.List(ctx, list, opts...)
+							}
+							if listOptions.Continue == "expired" {
+								return apierrors.NewResourceExpired("continue token expired")
+							}
+							if _, ok := list.(*metav1.PartialObjectMetadataList); ok {
+								continueTokens = append(continueTokens, listOptions.Continue)
+							}
+
+							listOptions.Limit, listOptions.Continue = 0, ""
+							if err := c.List(ctx, list, listOptions); err != nil {
+								return err
+							}
+
+							objList := list.(*metav1.PartialObjectMetadataList)
+							var items []metav1.PartialObjectMetadata
+							for _, item := range objList.Items {
+								if item.Namespace+"/"+item.Name > continueTokens[len(continueTokens)-1] {
+									items = append(items, item)
+								}
+							}
+							objList.Items, objList.Continue = items, ""
+							if len(items) > 1 {
+								objList.Items = items[:1]
+								objList.Continue = items[0].Namespace + "/" + items[0].Name
+							}
+							return nil
+						},
+					}
+
+					Expect(runtimeClient.Create(ctx, &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "kube-apiserver-etcd-encryption-key-current", Namespace: kubeAPIServerNamespace}})).To(Succeed())
+				})
+
+				JustBeforeEach(func() {
+					pagedClient = interceptor.NewClient(targetClient.(client.WithWatch), interceptFuncs)
+				})
+
+				It("should rewrite the secrets page by page and report the progress", func() {
+					Expect(RewriteEncryptedDataAddLabel(ctx, logger, pagedClient, fakeSecretsManager, opts, corev1.SchemeGroupVersion.WithKind("SecretList"))).To(Succeed())
+
+					Expect(targetClient.Get(ctx, client.ObjectKeyFromObject(secret1), secret1)).To(Succeed())
+					Expect(targetClient.Get(ctx, client.ObjectKeyFromObject(secret2), secret2)).To(Succeed())
+					Expect(secret1.Labels).To(HaveKeyWithValue("credentials.gardener.cloud/key-name", "kube-apiserver-etcd-encryption-key-current"))
+					Expect(secret2.Labels).To(HaveKeyWithValue("credentials.gardener.cloud/key-name", "kube-apiserver-etcd-encryption-key-current"))
+
+					Expect(targetClient.Get(ctx, client.ObjectKeyFromObject(marker), marker)).To(BeNotFoundError())
+					Expect(reported).To(Equal([]Progress{
+						{Step: "rewrite-add-label", ObjectsFound: 1, ObjectsRewritten: 1},
+						{Step: "rewrite-add-label", ObjectsFound: 2, ObjectsRewritten: 2},
+						{Step: "rewrite-add-label", ObjectsFound: 2, ObjectsRewritten: 2, Done: true},
+					}))
+				})
+
+				It("should resume the rewrite from the checkpoint", func() {
+					interceptFuncs.Patch = func(ctx context.Context, c client.WithWatch, obj client.Object, patch client.Patch, opts ...client.PatchOption) error {
+						if obj.GetName() == secret2.Name {
+							return fmt.Errorf("fake")
+						}
+						return c.Patch(ctx, obj, patch, opts...)
+					}
+					failingClient := interceptor.NewClient(targetClient.(client.WithWatch), interceptFuncs)
+
+					Expect(RewriteEncryptedDataAddLabel(ctx, logger, failingClient, fakeSecretsManager, opts, corev1.SchemeGroupVersion.WithKind("SecretList"))).To(MatchError(ContainSubstring("fake")))
+
+					Expect(targetClient.Get(ctx, client.ObjectKeyFromObject(marker), marker)).To(Succeed())
+					Expect(marker.Annotations).To(Equal(map[string]string{
+						"credentials.gardener.cloud/rewrite-continue-list":     "//v1, Kind=SecretList",
+						"credentials.gardener.cloud/rewrite-continue":          "ns1/secret1",
+						"credentials.gardener.cloud/rewrite-objects-rewritten": "1",
+					}))
+
+					reported, continueTokens = nil, nil
+					Expect(RewriteEncryptedDataAddLabel(ctx, logger, pagedClient, fakeSecretsManager, opts, corev1.SchemeGroupVersion.WithKind("SecretList"))).To(Succeed())
+
+					Expect(targetClient.Get(ctx, client.ObjectKeyFromObject(secret2), secret2)).To(Succeed())
+					Expect(secret2.Labels).To(HaveKeyWithValue("credentials.gardener.cloud/key-name", "kube-apiserver-etcd-encryption-key-current"))
+
+					// The objects are not counted upfront, hence the only list resumes from the checkpoint.
+					Expect(continueTokens).To(Equal([]string{"ns1/secret1"}))
+					Expect(reported).To(ContainElement(Progress{Step: "rewrite-add-label", ObjectsFound: 2, ObjectsRewritten: 2, Done: true}))
+					Expect(targetClient.Get(ctx, client.ObjectKeyFromObject(marker), marker)).To(BeNotFoundError())
+				})
+
+				It("should stream the pages without checkpoints according to the memory budget", func() {
+					var limits []int64
+					list := interceptFuncs.List
+					interceptFuncs.List = func(ctx context.Context, c client.WithWatch, obj client.ObjectList, opts ...client.ListOption) error {
+						if _, ok := obj.(*metav1.PartialObjectMetadataList); ok {
+							limits = append(limits, (&client.ListOptions{}).ApplyOptions(opts).Limit)
+						}
+						return list(ctx, c, obj, opts...)
+					}
+					streamingClient := interceptor.NewClient(targetClient.(client.WithWatch), interceptFuncs)
+
+					opts.PageSize = 0
+					opts.MemoryBudget = 8 << 10
+					Expect(RewriteEncryptedDataAddLabel(ctx, logger, streamingClient, fakeSecretsManager, opts, corev1.SchemeGroupVersion.WithKind("SecretList"))).To(Succeed())
+
+					Expect(targetClient.Get(ctx, client.ObjectKeyFromObject(secret1), secret1)).To(Succeed())
+					Expect(targetClient.Get(ctx, client.ObjectKeyFromObject(secret2), secret2)).To(Succeed())
+					Expect(secret1.Labels).To(HaveKeyWithValue("credentials.gardener.cloud/key-name", "kube-apiserver-etcd-encryption-key-current"))
+					Expect(secret2.Labels).To(HaveKeyWithValue("credentials.gardener.cloud/key-name", "kube-apiserver-etcd-encryption-key-current"))
+
+					Expect(limits).To(Equal([]int64{2, 2}))
+					Expect(continueTokens).To(Equal([]string{"", "ns1/secret1"}))
+					Expect(reported).To(BeEmpty())
+					Expect(targetClient.Get(ctx, client.ObjectKeyFromObject(marker), marker)).To(BeNotFoundError())
+				})
+
+				It("should cap the page size by the memory budget", func() {
+					var limits []int64
+					list := interceptFuncs.List
+					interceptFuncs.List = func(ctx context.Context, c client.WithWatch, obj client.ObjectList, opts ...client.ListOption) error {
+						if _, ok := obj.(*metav1.PartialObjectMetadataList); ok {
+							limits = append(limits, (&client.ListOptions{}).ApplyOptions(opts).Limit)
+						}
+						return list(ctx, c, obj, opts...)
+					}
+					streamingClient := interceptor.NewClient(targetClient.(client.WithWatch), interceptFuncs)
+
+					opts.PageSize = 500
+					opts.MemoryBudget = 1
+					Expect(RewriteEncryptedDataAddLabel(ctx, logger, streamingClient, fakeSecretsManager, opts, corev1.SchemeGroupVersion.WithKind("SecretList"))).To(Succeed())
+
+					Expect(limits).To(HaveEach(Equal(int64(1))))
+				})
+
+				It("should restart the list if the continue token of the checkpoint expired", func() {
+					marker.Annotations = map[string]string{
+						"credentials.gardener.cloud/rewrite-continue-list": "//v1, Kind=SecretList",
+						"credentials.gardener.cloud/rewrite-continue":      "expired",
+					}
+					Expect(targetClient.Create(ctx, marker)).To(Succeed())
+
+					Expect(RewriteEncryptedDataAddLabel(ctx, logger, pagedClient, fakeSecretsManager, opts, corev1.SchemeGroupVersion.WithKind("SecretList"))).To(Succeed())
+
+					Expect(targetClient.Get(ctx, client.ObjectKeyFromObject(secret1), secret1)).To(Succeed())
+					Expect(targetClient.Get(ctx, client.ObjectKeyFromObject(secret2), secret2)).To(Succeed())
+					Expect(secret1.Labels).To(HaveKeyWithValue("credentials.gardener.cloud/key-name", "kube-apiserver-etcd-encryption-key-current"))
+					Expect(secret2.Labels).To(HaveKeyWithValue("credentials.gardener.cloud/key-name", "kube-apiserver-etcd-encryption-key-current"))
+				})
+			})
 		})
 
 		Describe("#SnapshotETCDAfterRewritingEncryptedData", func() {
@@ -157,7 +430,7 @@ var _ = Describe("ETCD", func() {
 				secret2ResourceVersion := secret2.ResourceVersion
 				secret3ResourceVersion := secret3.ResourceVersion
 
-				Expect(RewriteEncryptedDataRemoveLabel(ctx, logger, runtimeClient, targetClient, kubeAPIServerNamespace, kubeAPIServerDeploymentName, corev1.SchemeGroupVersion.WithKind("SecretList"))).To(Succeed())
+				Expect(RewriteEncryptedDataRemoveLabel(ctx, logger, runtimeClient, targetClient, kubeAPIServerNamespace, kubeAPIServerDeploymentName, RewriteOptions{}, corev1.SchemeGroupVersion.WithKind("SecretList"))).To(Succeed())
 
 				Expect(targetClient.Get(ctx, client.ObjectKeyFromObject(secret1), secret1)).To(Succeed())
 				Expect(targetClient.Get(ctx, client.ObjectKeyFromObject(secret2), secret2)).To(Succeed())
@@ -175,5 +448,118 @@ var _ = Describe("ETCD", func() {
 				Expect(kubeAPIServerDeployment.Annotations).NotTo(HaveKey("credentials.gardener.cloud/etcd-snapshotted"))
 			})
 		})
+
+		Describe("#RewriteDiscoveredEncryptedDataAddLabel", func() {
+			It("should not add the label again once it was removed in the same phase", func() {
+				Expect(runtimeClient.Create(ctx, &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "kube-apiserver-etcd-encryption-key-current", Namespace: kubeAPIServerNamespace}})).To(Succeed())
+				stateMachine := fakesecretsrotation.New(gardencorev1beta1.RotationCompleting)
+				opts := RewriteOptions{StepRunner: stateMachine}
+
+				Expect(RewriteDiscoveredEncryptedDataAddLabel(ctx, logger, targetClient, fakeSecretsManager, opts, corev1.SchemeGroupVersion.WithKind("SecretList"))).To(Succeed())
+				Expect(targetClient.Get(ctx, client.ObjectKeyFromObject(secret1), secret1)).To(Succeed())
+				Expect(secret1.Labels).To(HaveKeyWithValue("credentials.gardener.cloud/key-name", "kube-apiserver-etcd-encryption-key-current"))
+
+				Expect(RewriteEncryptedDataRemoveLabel(ctx, logger, runtimeClient, targetClient, kubeAPIServerNamespace, kubeAPIServerDeploymentName, opts, corev1.SchemeGroupVersion.WithKind("SecretList"))).To(Succeed())
+				Expect(targetClient.Get(ctx, client.ObjectKeyFromObject(secret1), secret1)).To(Succeed())
+				Expect(secret1.Labels).NotTo(HaveKey("credentials.gardener.cloud/key-name"))
+
+				By("Retry both steps, e.g. after a later step of the flow failed")
+				Expect(RewriteDiscoveredEncryptedDataAddLabel(ctx, logger, targetClient, fakeSecretsManager, opts, corev1.SchemeGroupVersion.WithKind("SecretList"))).To(Succeed())
+				Expect(RewriteEncryptedDataRemoveLabel(ctx, logger, runtimeClient, targetClient, kubeAPIServerNamespace, kubeAPIServerDeploymentName, opts, corev1.SchemeGroupVersion.WithKind("SecretList"))).To(Succeed())
+				Expect(targetClient.Get(ctx, client.ObjectKeyFromObject(secret1), secret1)).To(Succeed())
+				Expect(secret1.Labels).NotTo(HaveKey("credentials.gardener.cloud/key-name"))
+
+				Expect(stateMachine.Executions).To(Equal([]string{StepRewriteDiscoveredAddLabel, StepRewriteRemoveLabel}))
+			})
+		})
+	})
+
+	Describe("#GetResourcesForEncryption", func() {
+		var (
+			secretListGVK     = corev1.SchemeGroupVersion.WithKind("SecretList")
+			metricsListGVK    = schema.GroupVersionKind{Group: "metrics.k8s.io", Version: "v1beta1", Kind: "PodMetricsList"}
+			gardenerListGVK   = schema.GroupVersionKind{Group: "core.gardener.cloud", Version: "v1beta1", Kind: "ShootStateList"}
+			aggregatedService = &apiregistrationv1.ServiceReference{Namespace: "kube-system", Name: "aggregated"}
+		)
+
+		BeforeEach(func() {
+			Expect(targetClient.Create(ctx, &apiregistrationv1.APIService{
+				ObjectMeta: metav1.ObjectMeta{Name: "v1."},
+				Spec:       apiregistrationv1.APIServiceSpec{Version: "v1"},
+			})).To(Succeed())
+			Expect(targetClient.Create(ctx, &apiregistrationv1.APIService{
+				ObjectMeta: metav1.ObjectMeta{Name: "v1beta1.metrics.k8s.io"},
+				Spec:       apiregistrationv1.APIServiceSpec{Group: "metrics.k8s.io", Version: "v1beta1", Service: aggregatedService},
+			})).To(Succeed())
+			Expect(targetClient.Create(ctx, &apiregistrationv1.APIService{
+				ObjectMeta: metav1.ObjectMeta{Name: "v1beta1.core.gardener.cloud"},
+				Spec:       apiregistrationv1.APIServiceSpec{Group: "core.gardener.cloud", Version: "v1beta1", Service: aggregatedService},
+			})).To(Succeed())
+		})
+
+		It("should skip resources served by aggregated API servers", func() {
+			Expect(GetResourcesForEncryption(ctx, logger, targetClient, []schema.GroupVersionKind{secretListGVK, metricsListGVK, gardenerListGVK})).To(ConsistOf(secretListGVK))
+		})
+
+		It("should keep resources of aggregated API servers sharing the same storage", func() {
+			Expect(GetResourcesForEncryption(ctx, logger, targetClient, []schema.GroupVersionKind{secretListGVK, metricsListGVK, gardenerListGVK}, "core.gardener.cloud")).To(ConsistOf(secretListGVK, gardenerListGVK))
+		})
+
+		It("should keep resources without APIService", func() {
+			gvk := schema.GroupVersionKind{Group: "foo.bar", Version: "v1", Kind: "BazList"}
+			Expect(GetResourcesForEncryption(ctx, logger, targetClient, []schema.GroupVersionKind{gvk})).To(ConsistOf(gvk))
+		})
+	})
+
+	Describe("#DiscoverEncryptedResources", func() {
+		var (
+			secretListGVK = corev1.SchemeGroupVersion.WithKind("SecretList")
+			fooListGVK    = schema.GroupVersionKind{Group: "example.com", Version: "v1", Kind: "FooList"}
+		)
+
+		newCRD := func(group, plural, kind string, versions ...apiextensionsv1.CustomResourceDefinitionVersion) *apiextensionsv1.CustomResourceDefinition {
+			return &apiextensionsv1.CustomResourceDefinition{
+				ObjectMeta: metav1.ObjectMeta{Name: plural + "." + group},
+				Spec: apiextensionsv1.CustomResourceDefinitionSpec{
+					Group:    group,
+					Names:    apiextensionsv1.CustomResourceDefinitionNames{Plural: plural, Kind: kind},
+					Versions: versions,
+				},
+			}
+		}
+
+		BeforeEach(func() {
+			Expect(targetClient.Create(ctx, newCRD("example.com", "foos", "Foo",
+				apiextensionsv1.CustomResourceDefinitionVersion{Name: "v1alpha1", Served: true},
+				apiextensionsv1.CustomResourceDefinitionVersion{Name: "v1", Served: true, Storage: true},
+			))).To(Succeed())
+			Expect(targetClient.Create(ctx, newCRD("example.com", "bars", "Bar",
+				apiextensionsv1.CustomResourceDefinitionVersion{Name: "v1alpha1", Served: false, Storage: true},
+				apiextensionsv1.CustomResourceDefinitionVersion{Name: "v1beta1", Served: true},
+			))).To(Succeed())
+			Expect(targetClient.Create(ctx, newCRD("other.com", "bazs", "Baz",
+				apiextensionsv1.CustomResourceDefinitionVersion{Name: "v1", Served: true, Storage: true},
+			))).To(Succeed())
+		})
+
+		It("should not return anything if no custom resource is encrypted", func() {
+			Expect(DiscoverEncryptedResources(ctx, logger, targetClient, []string{"secrets"}, []schema.GroupVersionKind{secretListGVK})).To(BeEmpty())
+		})
+
+		It("should return the custom resources matching the encrypted resources", func() {
+			Expect(DiscoverEncryptedResources(ctx, logger, targetClient, []string{"secrets", "foos.example.com"}, []schema.GroupVersionKind{secretListGVK})).To(ConsistOf(fooListGVK))
+		})
+
+		It("should support wildcards and prefer served storage versions", func() {
+			Expect(DiscoverEncryptedResources(ctx, logger, targetClient, []string{"*.example.com"}, nil)).To(ConsistOf(
+				fooListGVK,
+				schema.GroupVersionKind{Group: "example.com", Version: "v1beta1", Kind: "BarList"},
+			))
+			Expect(DiscoverEncryptedResources(ctx, logger, targetClient, []string{"*.*"}, nil)).To(HaveLen(3))
+		})
+
+		It("should skip already known resources regardless of their version", func() {
+			Expect(DiscoverEncryptedResources(ctx, logger, targetClient, []string{"foos.example.com"}, []schema.GroupVersionKind{fooListGVK.GroupKind().WithVersion("v1alpha1")})).To(BeEmpty())
+		})
 	})
 })
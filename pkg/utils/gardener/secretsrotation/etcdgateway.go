@@ -0,0 +1,119 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package secretsrotation
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"k8s.io/client-go/rest"
+)
+
+type etcdGatewayReader struct {
+	httpClient rest.HTTPClient
+	endpoint   string
+}
+
+// NewEtcdGatewayReader returns an EtcdReader which reads from ETCD via the JSON gRPC gateway served on its client
+// endpoint, e.g. https://etcd-main-client.shoot--foo--bar:2379. The given HTTP client must be configured with a
+// client certificate accepted by ETCD.
+func NewEtcdGatewayReader(httpClient rest.HTTPClient, endpoint string) EtcdReader {
+	return &etcdGatewayReader{httpClient: httpClient, endpoint: endpoint}
+}
+
+type etcdRangeRequest struct {
+	Key      []byte `json:"key"`
+	RangeEnd []byte `json:"range_end,omitempty"`
+	KeysOnly bool   `json:"keys_only,omitempty"`
+}
+
+type etcdRangeResponse struct {
+	KVs []struct {
+		Key   []byte `json:"key"`
+		Value []byte `json:"value"`
+	} `json:"kvs"`
+}
+
+func (r *etcdGatewayReader) Keys(ctx context.Context, prefix string) ([]string, error) {
+	response, err := r.rangeRequest(ctx, etcdRangeRequest{Key: []byte(prefix), RangeEnd: prefixRangeEnd([]byte(prefix)), KeysOnly: true})
+	if err != nil {
+		return nil, err
+	}
+
+	keys := make([]string, 0, len(response.KVs))
+	for _, kv := range response.KVs {
+		keys = append(keys, string(kv.Key))
+	}
+	return keys, nil
+}
+
+func (r *etcdGatewayReader) Value(ctx context.Context, key string) ([]byte, error) {
+	response, err := r.rangeRequest(ctx, etcdRangeRequest{Key: []byte(key)})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(response.KVs) == 0 {
+		return nil, fmt.Errorf("key %q not found", key)
+	}
+	return response.KVs[0].Value, nil
+}
+
+func (r *etcdGatewayReader) rangeRequest(ctx context.Context, rangeRequest etcdRangeRequest) (*etcdRangeResponse, error) {
+	body, err := json.Marshal(rangeRequest)
+	if err != nil {
+		return nil, err
+	}
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, r.endpoint+"/v3/kv/range", bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	request.Header.Set("Content-Type", "application/json")
+
+	resp, err := r.httpClient.Do(request)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected response from ETCD: %s", resp.Status)
+	}
+
+	response := &etcdRangeResponse{}
+	if err := json.NewDecoder(resp.Body).Decode(response); err != nil {
+		return nil, fmt.Errorf("failed decoding response from ETCD: %w", err)
+	}
+	return response, nil
+}
+
+// prefixRangeEnd returns the end of the range of all keys with the given prefix, i.e. the prefix whose last byte is
+// incremented (see clientv3.GetPrefixRangeEnd).
+func prefixRangeEnd(prefix []byte) []byte {
+	end := make([]byte, len(prefix))
+	copy(end, prefix)
+	for i := len(end) - 1; i >= 0; i-- {
+		if end[i] < 0xff {
+			end[i]++
+			return end[:i+1]
+		}
+	}
+	// All bytes are 0xff, hence the range has no upper bound.
+	return []byte{0}
+}
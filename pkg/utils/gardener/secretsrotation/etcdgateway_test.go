@@ -0,0 +1,93 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package secretsrotation_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	. "github.com/gardener/gardener/pkg/utils/gardener/secretsrotation"
+)
+
+var _ = Describe("EtcdGatewayReader", func() {
+	var (
+		ctx = context.TODO()
+
+		server   *httptest.Server
+		requests []map[string]interface{}
+		reader   EtcdReader
+	)
+
+	BeforeEach(func() {
+		requests = nil
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			defer GinkgoRecover()
+
+			Expect(r.Method).To(Equal(http.MethodPost))
+			Expect(r.URL.Path).To(Equal("/v3/kv/range"))
+
+			request := map[string]interface{}{}
+			Expect(json.NewDecoder(r.Body).Decode(&request)).To(Succeed())
+			requests = append(requests, request)
+
+			switch request["key"] {
+			case "L3JlZ2lzdHJ5L3NlY3JldHMv": // "/registry/secrets/"
+				_, _ = w.Write([]byte(`{"kvs":[{"key":"L3JlZ2lzdHJ5L3NlY3JldHMvbnMxL3Mx"},{"key":"L3JlZ2lzdHJ5L3NlY3JldHMvbnMyL3My"}],"count":"2"}`))
+			case "L3JlZ2lzdHJ5L3NlY3JldHMvbnMxL3Mx": // "/registry/secrets/ns1/s1"
+				_, _ = w.Write([]byte(`{"kvs":[{"key":"L3JlZ2lzdHJ5L3NlY3JldHMvbnMxL3Mx","value":"azhzOmVuYzphZXNjYmM6djE6a2V5OmZvbw=="}],"count":"1"}`))
+			case "L3JlZ2lzdHJ5L2ZhaWwv": // "/registry/fail/"
+				w.WriteHeader(http.StatusServiceUnavailable)
+			default:
+				_, _ = w.Write([]byte(`{}`))
+			}
+		}))
+		DeferCleanup(server.Close)
+
+		reader = NewEtcdGatewayReader(server.Client(), server.URL)
+	})
+
+	Describe("#Keys", func() {
+		It("should return all keys with the given prefix", func() {
+			Expect(reader.Keys(ctx, "/registry/secrets/")).To(Equal([]string{"/registry/secrets/ns1/s1", "/registry/secrets/ns2/s2"}))
+			Expect(requests).To(ConsistOf(map[string]interface{}{
+				"key":       "L3JlZ2lzdHJ5L3NlY3JldHMv",
+				"range_end": "L3JlZ2lzdHJ5L3NlY3JldHMw", // "/registry/secrets0"
+				"keys_only": true,
+			}))
+		})
+
+		It("should fail if ETCD responds with an error", func() {
+			_, err := reader.Keys(ctx, "/registry/fail/")
+			Expect(err).To(MatchError("unexpected response from ETCD: 503 Service Unavailable"))
+		})
+	})
+
+	Describe("#Value", func() {
+		It("should return the raw value of the given key", func() {
+			Expect(reader.Value(ctx, "/registry/secrets/ns1/s1")).To(Equal([]byte("k8s:enc:aescbc:v1:key:foo")))
+			Expect(requests).To(ConsistOf(map[string]interface{}{"key": "L3JlZ2lzdHJ5L3NlY3JldHMvbnMxL3Mx"}))
+		})
+
+		It("should fail if the key does not exist", func() {
+			_, err := reader.Value(ctx, "/registry/secrets/ns3/s3")
+			Expect(err).To(MatchError(`key "/registry/secrets/ns3/s3" not found`))
+		})
+	})
+})
@@ -0,0 +1,93 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fake
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/gardener/gardener/pkg/utils/gardener/secretsrotation"
+)
+
+// ProgressSink is a fake implementation of secretsrotation.ProgressSink which records the reported progress.
+type ProgressSink struct {
+	// Reports contains the reported progress in this order.
+	Reports []secretsrotation.Progress
+	// Err is returned by Report if set. The progress is recorded nevertheless.
+	Err error
+
+	mutex sync.Mutex
+}
+
+var _ secretsrotation.ProgressSink = &ProgressSink{}
+
+// Report implements secretsrotation.ProgressSink.
+func (p *ProgressSink) Report(_ context.Context, progress secretsrotation.Progress) error {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	p.Reports = append(p.Reports, progress)
+	return p.Err
+}
+
+// EtcdReader is a fake implementation of secretsrotation.EtcdReader which serves the keys and values from memory.
+type EtcdReader struct {
+	// Data maps the keys to their raw values.
+	Data map[string][]byte
+	// Err is returned by all functions if set, which simulates an unavailable ETCD.
+	Err error
+	// Reads contains the keys whose values were read in this order.
+	Reads []string
+
+	mutex sync.Mutex
+}
+
+var _ secretsrotation.EtcdReader = &EtcdReader{}
+
+// Keys implements secretsrotation.EtcdReader. The keys are returned in lexical order like ETCD does.
+func (e *EtcdReader) Keys(_ context.Context, prefix string) ([]string, error) {
+	if e.Err != nil {
+		return nil, e.Err
+	}
+
+	var keys []string
+	for key := range e.Data {
+		if strings.HasPrefix(key, prefix) {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	return keys, nil
+}
+
+// Value implements secretsrotation.EtcdReader.
+func (e *EtcdReader) Value(_ context.Context, key string) ([]byte, error) {
+	if e.Err != nil {
+		return nil, e.Err
+	}
+
+	e.mutex.Lock()
+	e.Reads = append(e.Reads, key)
+	e.mutex.Unlock()
+
+	value, ok := e.Data[key]
+	if !ok {
+		return nil, fmt.Errorf("key %q not found", key)
+	}
+	return value, nil
+}
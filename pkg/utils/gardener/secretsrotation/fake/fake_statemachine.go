@@ -0,0 +1,201 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fake
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/utils/gardener/secretsrotation"
+)
+
+// StepBehavior scripts the behavior of a step executed by the fake StateMachine.
+type StepBehavior struct {
+	// Err is returned instead of executing the step.
+	Err error
+	// Times is the number of executions of the step for which Err is returned. Afterwards, the step is executed
+	// normally, which simulates the resumption of a rotation after a failure. If 0, Err is returned for all executions.
+	Times int
+	// Wait blocks the execution of the step until the channel is closed or the context is cancelled, which simulates a
+	// long-running step. If the context is cancelled, its error is returned and the step is not marked as done.
+	Wait <-chan struct{}
+}
+
+// StateMachine is a fake implementation of secretsrotation.StateMachineInterface which keeps the state of the rotation
+// in memory. It enforces the same transitions as the real implementation. The state survives failed steps, hence
+// calling the same StateMachine again simulates the resumption of an interrupted rotation.
+type StateMachine struct {
+	// Executions contains the names of the steps whose execution was attempted in this order, including the attempts
+	// which failed. Steps which were already done are not recorded.
+	Executions []string
+	// Transitions contains the phases to which the rotation transitioned in this order.
+	Transitions []gardencorev1beta1.CredentialsRotationPhase
+
+	phase            gardencorev1beta1.CredentialsRotationPhase
+	steps            sets.Set[string]
+	behaviors        map[string]*StepBehavior
+	transitionErrors map[gardencorev1beta1.CredentialsRotationPhase]error
+
+	mutex sync.Mutex
+}
+
+var _ secretsrotation.StateMachineInterface = &StateMachine{}
+
+// New returns a fake StateMachine for a rotation in the given phase. The given steps are already done in this phase.
+func New(phase gardencorev1beta1.CredentialsRotationPhase, doneSteps ...string) *StateMachine {
+	return &StateMachine{
+		phase:            phase,
+		steps:            sets.New(doneSteps...),
+		behaviors:        make(map[string]*StepBehavior),
+		transitionErrors: make(map[gardencorev1beta1.CredentialsRotationPhase]error),
+	}
+}
+
+// ScriptStep scripts the behavior of the given step.
+func (s *StateMachine) ScriptStep(step string, behavior StepBehavior) *StateMachine {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.behaviors[step] = &behavior
+	return s
+}
+
+// FailTransition makes all transitions to the given phase fail with the given error.
+func (s *StateMachine) FailTransition(phase gardencorev1beta1.CredentialsRotationPhase, err error) *StateMachine {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.transitionErrors[phase] = err
+	return s
+}
+
+// Phase implements secretsrotation.StateMachineInterface.
+func (s *StateMachine) Phase() gardencorev1beta1.CredentialsRotationPhase {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	return s.phase
+}
+
+// Transition implements secretsrotation.StateMachineInterface.
+func (s *StateMachine) Transition(_ context.Context, phase gardencorev1beta1.CredentialsRotationPhase) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if s.phase == phase {
+		return nil
+	}
+
+	if err := s.transitionErrors[phase]; err != nil {
+		return err
+	}
+
+	if !secretsrotation.IsTransitionAllowed(s.phase, phase) {
+		return fmt.Errorf("transition from phase %q to phase %q is not allowed", s.phase, phase)
+	}
+
+	s.phase = phase
+	s.steps = sets.New[string]()
+	s.Transitions = append(s.Transitions, phase)
+	return nil
+}
+
+// AdvanceTo implements secretsrotation.StateMachineInterface.
+func (s *StateMachine) AdvanceTo(ctx context.Context, phase gardencorev1beta1.CredentialsRotationPhase) error {
+	for _, next := range secretsrotation.PhasesUntil(s.Phase(), phase) {
+		if err := s.Transition(ctx, next); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// IsStepDone implements secretsrotation.StateMachineInterface.
+func (s *StateMachine) IsStepDone(step string) bool {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	return s.steps.Has(step)
+}
+
+// MarkStepDone implements secretsrotation.StateMachineInterface.
+func (s *StateMachine) MarkStepDone(_ context.Context, step string) error {
+	if err := secretsrotation.ValidateStepName(step); err != nil {
+		return err
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.steps.Insert(step)
+	return nil
+}
+
+// RunStep implements secretsrotation.Runner. The scripted behavior of the step is applied before the given function is
+// executed.
+func (s *StateMachine) RunStep(ctx context.Context, step string, fn func(context.Context) error) error {
+	if s.IsStepDone(step) {
+		return nil
+	}
+
+	wait, err := s.startStep(step)
+	if err != nil {
+		return err
+	}
+
+	if wait != nil {
+		select {
+		case <-wait:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+
+	if err := fn(ctx); err != nil {
+		return err
+	}
+
+	return s.MarkStepDone(ctx, step)
+}
+
+// startStep records the execution of the given step and returns the channel to wait for or the scripted error.
+func (s *StateMachine) startStep(step string) (<-chan struct{}, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.Executions = append(s.Executions, step)
+
+	behavior, ok := s.behaviors[step]
+	if !ok {
+		return nil, nil
+	}
+
+	if err := behavior.Err; err != nil {
+		if behavior.Times > 0 {
+			behavior.Times--
+			if behavior.Times == 0 {
+				// Subsequent executions are not failed anymore.
+				behavior.Err = nil
+			}
+		}
+		return nil, err
+	}
+
+	return behavior.Wait, nil
+}
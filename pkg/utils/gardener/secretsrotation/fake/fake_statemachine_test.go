@@ -0,0 +1,202 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fake_test
+
+import (
+	"context"
+	"errors"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/utils/gardener/secretsrotation"
+	. "github.com/gardener/gardener/pkg/utils/gardener/secretsrotation/fake"
+)
+
+var _ = Describe("StateMachine", func() {
+	var (
+		ctx = context.TODO()
+
+		stateMachine *StateMachine
+		executed     []string
+	)
+
+	BeforeEach(func() {
+		stateMachine = New(gardencorev1beta1.RotationPreparing)
+		executed = nil
+	})
+
+	step := func(name string) func(context.Context) error {
+		return func(context.Context) error {
+			executed = append(executed, name)
+			return nil
+		}
+	}
+
+	Describe("#Transition", func() {
+		It("should record the transitions and reset the steps", func() {
+			stateMachine = New(gardencorev1beta1.RotationPreparing, secretsrotation.StepRewriteAddLabel)
+			Expect(stateMachine.IsStepDone(secretsrotation.StepRewriteAddLabel)).To(BeTrue())
+
+			Expect(stateMachine.Transition(ctx, gardencorev1beta1.RotationPreparing)).To(Succeed())
+			Expect(stateMachine.Transition(ctx, gardencorev1beta1.RotationPrepared)).To(Succeed())
+
+			Expect(stateMachine.Phase()).To(Equal(gardencorev1beta1.RotationPrepared))
+			Expect(stateMachine.Transitions).To(Equal([]gardencorev1beta1.CredentialsRotationPhase{gardencorev1beta1.RotationPrepared}))
+			Expect(stateMachine.IsStepDone(secretsrotation.StepRewriteAddLabel)).To(BeFalse())
+		})
+
+		It("should reject transitions which are not allowed", func() {
+			Expect(stateMachine.Transition(ctx, gardencorev1beta1.RotationCompleted)).To(MatchError(ContainSubstring("is not allowed")))
+			Expect(stateMachine.Phase()).To(Equal(gardencorev1beta1.RotationPreparing))
+		})
+
+		It("should fail scripted transitions", func() {
+			stateMachine.FailTransition(gardencorev1beta1.RotationPrepared, errors.New("fake"))
+
+			Expect(stateMachine.Transition(ctx, gardencorev1beta1.RotationPrepared)).To(MatchError("fake"))
+			Expect(stateMachine.Phase()).To(Equal(gardencorev1beta1.RotationPreparing))
+		})
+	})
+
+	Describe("#AdvanceTo", func() {
+		It("should transition through the phases which were not observed", func() {
+			stateMachine = New(gardencorev1beta1.RotationPreparing, secretsrotation.StepRewriteAddLabel)
+
+			Expect(stateMachine.AdvanceTo(ctx, gardencorev1beta1.RotationCompleting)).To(Succeed())
+			Expect(stateMachine.Transitions).To(Equal([]gardencorev1beta1.CredentialsRotationPhase{gardencorev1beta1.RotationPrepared, gardencorev1beta1.RotationCompleting}))
+			Expect(stateMachine.IsStepDone(secretsrotation.StepRewriteAddLabel)).To(BeFalse())
+		})
+
+		It("should stop at scripted transition failures", func() {
+			stateMachine.FailTransition(gardencorev1beta1.RotationPrepared, errors.New("fake"))
+
+			Expect(stateMachine.AdvanceTo(ctx, gardencorev1beta1.RotationCompleting)).To(MatchError("fake"))
+			Expect(stateMachine.Phase()).To(Equal(gardencorev1beta1.RotationPreparing))
+		})
+	})
+
+	Describe("#RunStep", func() {
+		It("should execute the step only once", func() {
+			Expect(stateMachine.RunStep(ctx, "foo", step("foo"))).To(Succeed())
+			Expect(stateMachine.RunStep(ctx, "foo", step("foo"))).To(Succeed())
+
+			Expect(executed).To(Equal([]string{"foo"}))
+			Expect(stateMachine.Executions).To(Equal([]string{"foo"}))
+			Expect(stateMachine.IsStepDone("foo")).To(BeTrue())
+		})
+
+		It("should fail the step the scripted number of times and resume afterwards", func() {
+			stateMachine.ScriptStep("bar", StepBehavior{Err: errors.New("fake"), Times: 2})
+
+			Expect(stateMachine.RunStep(ctx, "foo", step("foo"))).To(Succeed())
+			Expect(stateMachine.RunStep(ctx, "bar", step("bar"))).To(MatchError("fake"))
+			Expect(stateMachine.RunStep(ctx, "bar", step("bar"))).To(MatchError("fake"))
+
+			Expect(stateMachine.RunStep(ctx, "foo", step("foo"))).To(Succeed())
+			Expect(stateMachine.RunStep(ctx, "bar", step("bar"))).To(Succeed())
+
+			Expect(executed).To(Equal([]string{"foo", "bar"}))
+			Expect(stateMachine.Executions).To(Equal([]string{"foo", "bar", "bar", "bar"}))
+		})
+
+		It("should always fail the step if no number of times is scripted", func() {
+			stateMachine.ScriptStep("foo", StepBehavior{Err: errors.New("fake")})
+
+			for i := 0; i < 3; i++ {
+				Expect(stateMachine.RunStep(ctx, "foo", step("foo"))).To(MatchError("fake"))
+			}
+			Expect(executed).To(BeEmpty())
+		})
+
+		It("should block long-running steps until they are released", func() {
+			wait := make(chan struct{})
+			stateMachine.ScriptStep("foo", StepBehavior{Wait: wait})
+
+			done := make(chan error)
+			go func() {
+				done <- stateMachine.RunStep(ctx, "foo", func(context.Context) error { return nil })
+			}()
+
+			Consistently(done).ShouldNot(Receive())
+			Expect(stateMachine.IsStepDone("foo")).To(BeFalse())
+
+			close(wait)
+			Eventually(done).Should(Receive(BeNil()))
+			Expect(stateMachine.IsStepDone("foo")).To(BeTrue())
+		})
+
+		It("should abort long-running steps if the context is cancelled", func() {
+			stateMachine.ScriptStep("foo", StepBehavior{Wait: make(chan struct{})})
+
+			cancelledCtx, cancel := context.WithCancel(ctx)
+			cancel()
+
+			Expect(stateMachine.RunStep(cancelledCtx, "foo", step("foo"))).To(MatchError(context.Canceled))
+			Expect(executed).To(BeEmpty())
+			Expect(stateMachine.IsStepDone("foo")).To(BeFalse())
+		})
+	})
+
+	Describe("#MarkStepDone", func() {
+		It("should reject invalid step names", func() {
+			Expect(stateMachine.MarkStepDone(ctx, "foo,bar")).To(MatchError(ContainSubstring("invalid step name")))
+		})
+	})
+})
+
+var _ = Describe("ProgressSink", func() {
+	It("should record the reported progress", func() {
+		sink := &ProgressSink{Err: errors.New("fake")}
+
+		Expect(sink.Report(context.TODO(), secretsrotation.Progress{Step: "foo", Total: 2})).To(MatchError("fake"))
+		Expect(sink.Reports).To(Equal([]secretsrotation.Progress{{Step: "foo", Total: 2}}))
+	})
+})
+
+var _ = Describe("EtcdReader", func() {
+	var (
+		ctx    = context.TODO()
+		reader *EtcdReader
+	)
+
+	BeforeEach(func() {
+		reader = &EtcdReader{Data: map[string][]byte{
+			"/registry/secrets/ns/b": []byte("b"),
+			"/registry/secrets/ns/a": []byte("a"),
+			"/registry/configmaps/c": []byte("c"),
+		}}
+	})
+
+	It("should return the keys with the prefix in lexical order", func() {
+		Expect(reader.Keys(ctx, "/registry/secrets/")).To(Equal([]string{"/registry/secrets/ns/a", "/registry/secrets/ns/b"}))
+	})
+
+	It("should return the values and record the reads", func() {
+		Expect(reader.Value(ctx, "/registry/configmaps/c")).To(Equal([]byte("c")))
+
+		_, err := reader.Value(ctx, "/registry/configmaps/d")
+		Expect(err).To(MatchError(ContainSubstring("not found")))
+		Expect(reader.Reads).To(Equal([]string{"/registry/configmaps/c", "/registry/configmaps/d"}))
+	})
+
+	It("should fail if ETCD is unavailable", func() {
+		reader.Err = errors.New("fake")
+
+		_, err := reader.Keys(ctx, "/")
+		Expect(err).To(MatchError("fake"))
+	})
+})
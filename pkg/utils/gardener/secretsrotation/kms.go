@@ -0,0 +1,120 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package secretsrotation
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/go-logr/logr"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/selection"
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/gardener/gardener/pkg/utils"
+)
+
+// KMSHealthCheckFunc checks whether the KMS provider used by kube-apiserver is healthy. It must return an error if the
+// provider cannot be used for encrypting data.
+type KMSHealthCheckFunc func(ctx context.Context) error
+
+// NewKubeAPIServerKMSHealthCheck returns a KMSHealthCheckFunc which queries the readiness check of the KMS providers
+// served by kube-apiserver on the given endpoint, e.g. https://kube-apiserver.shoot--foo--bar. The given HTTP client
+// must be configured with credentials which are allowed to read the readiness endpoints.
+func NewKubeAPIServerKMSHealthCheck(httpClient rest.HTTPClient, endpoint string) KMSHealthCheckFunc {
+	return func(ctx context.Context) error {
+		request, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint+"/readyz/kms-providers", nil)
+		if err != nil {
+			return err
+		}
+
+		resp, err := httpClient.Do(request)
+		if err != nil {
+			return err
+		}
+		defer resp.Body.Close()
+
+		if resp.StatusCode != http.StatusOK {
+			body, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+			return fmt.Errorf("KMS providers of kube-apiserver are not healthy: %s: %s", resp.Status, body)
+		}
+		return nil
+	}
+}
+
+// KMSRewriteOptions contains options for rewriting the encrypted data in the target cluster after the key of the KMS
+// provider has been rotated.
+type KMSRewriteOptions struct {
+	RewriteOptions
+	// HealthCheck is called before any data is rewritten. If it fails, nothing is rewritten since all writes would fail
+	// or, even worse, could be encrypted by a provider which is about to be replaced. Use NewKubeAPIServerKMSHealthCheck
+	// for checking the KMS providers of kube-apiserver.
+	HealthCheck KMSHealthCheckFunc
+}
+
+// RewriteEncryptedDataForKMSKeyVersion patches all encrypted data in all namespaces in the target cluster which were
+// not yet rewritten for the given version of the key of the KMS provider. Unlike the static aescbc key model, the key
+// encryption key of a KMS provider is rotated externally. kube-apiserver generates new data encryption keys (DEKs)
+// wrapped with the new key version, but already stored data keep their DEKs wrapped with the old version until they
+// are written again. The key version is injected by the caller, e.g. as reported by the KMS plugin, and recorded in a
+// label on each rewritten object, hence the rewrite is skipped for objects which were already rewritten for the
+// current version.
+func RewriteEncryptedDataForKMSKeyVersion(
+	ctx context.Context,
+	log logr.Logger,
+	c client.Client,
+	keyVersion string,
+	opts KMSRewriteOptions,
+	gvks ...schema.GroupVersionKind,
+) error {
+	if keyVersion == "" {
+		return fmt.Errorf("KMS key version must not be empty")
+	}
+
+	if opts.HealthCheck != nil {
+		if err := opts.HealthCheck(ctx); err != nil {
+			return fmt.Errorf("KMS provider is not healthy, not rewriting encrypted data: %w", err)
+		}
+	}
+
+	// Key versions of KMS providers are arbitrary strings (e.g. key URLs or ARNs) which are not necessarily valid label
+	// values, hence their hash is used instead.
+	keyVersionHash := KMSKeyVersionHash(keyVersion)
+
+	return rewrite(
+		ctx,
+		log.WithValues("kmsKeyVersion", keyVersion),
+		c,
+		opts.RewriteOptions,
+		// The step name contains the hash so that the progress recorded for an interrupted rewrite for a previous key
+		// version is not taken over.
+		StepRewriteKMSKeyVersion+"-"+keyVersionHash,
+		utils.MustNewRequirement(labelKeyKMSKeyVersion, selection.NotEquals, keyVersionHash),
+		func(objectMeta *metav1.ObjectMeta) {
+			metav1.SetMetaDataLabel(objectMeta, labelKeyKMSKeyVersion, keyVersionHash)
+		},
+		gvks,
+	)
+}
+
+// KMSKeyVersionHash returns the value of the label which is added to the objects rewritten for the given version of
+// the key of the KMS provider.
+func KMSKeyVersionHash(keyVersion string) string {
+	return utils.ComputeSHA256Hex([]byte(keyVersion))[:16]
+}
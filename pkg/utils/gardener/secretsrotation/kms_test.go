@@ -0,0 +1,143 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package secretsrotation_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	. "github.com/gardener/gardener/pkg/utils/gardener/secretsrotation"
+	. "github.com/gardener/gardener/pkg/utils/test/matchers"
+)
+
+var _ = Describe("KMS", func() {
+	var (
+		ctx    = context.TODO()
+		logger = logr.Discard()
+
+		keyVersion = "arn:aws:kms:eu-west-1:123456789012:key/foo"
+		keyHash    = KMSKeyVersionHash(keyVersion)
+	)
+
+	Describe("#RewriteEncryptedDataForKMSKeyVersion", func() {
+		var (
+			targetClient     client.Client
+			secret1, secret2 *corev1.Secret
+		)
+
+		BeforeEach(func() {
+			targetClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.ShootScheme).Build()
+
+			Expect(targetClient.Create(ctx, &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "ns1"}})).To(Succeed())
+
+			secret1 = &corev1.Secret{TypeMeta: metav1.TypeMeta{APIVersion: "v1", Kind: "Secret"}, ObjectMeta: metav1.ObjectMeta{Name: "secret1", Namespace: "ns1", Labels: map[string]string{"credentials.gardener.cloud/kms-key-version": "outdated"}}}
+			secret2 = &corev1.Secret{TypeMeta: metav1.TypeMeta{APIVersion: "v1", Kind: "Secret"}, ObjectMeta: metav1.ObjectMeta{Name: "secret2", Namespace: "ns1", Labels: map[string]string{"credentials.gardener.cloud/kms-key-version": keyHash}}}
+
+			Expect(targetClient.Create(ctx, secret1)).To(Succeed())
+			Expect(targetClient.Create(ctx, secret2)).To(Succeed())
+		})
+
+		It("should patch all secrets not yet rewritten for the key version", func() {
+			secret2ResourceVersion := secret2.ResourceVersion
+
+			Expect(RewriteEncryptedDataForKMSKeyVersion(ctx, logger, targetClient, keyVersion, KMSRewriteOptions{}, corev1.SchemeGroupVersion.WithKind("SecretList"))).To(Succeed())
+
+			Expect(targetClient.Get(ctx, client.ObjectKeyFromObject(secret1), secret1)).To(Succeed())
+			Expect(targetClient.Get(ctx, client.ObjectKeyFromObject(secret2), secret2)).To(Succeed())
+
+			Expect(secret1.Labels).To(HaveKeyWithValue("credentials.gardener.cloud/kms-key-version", keyHash))
+			Expect(secret2.Labels).To(HaveKeyWithValue("credentials.gardener.cloud/kms-key-version", keyHash))
+			Expect(secret2.ResourceVersion).To(Equal(secret2ResourceVersion))
+		})
+
+		It("should use a separate progress ConfigMap per key version when rewriting namespace by namespace", func() {
+			marker := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "gardener-rewrite-progress-rewrite-kms-key-version-" + KMSKeyVersionHash("previous"), Namespace: "kube-system"}, Data: map[string]string{"ns1": "2023-10-16T00:00:00Z"}}
+			Expect(targetClient.Create(ctx, marker)).To(Succeed())
+
+			Expect(RewriteEncryptedDataForKMSKeyVersion(ctx, logger, targetClient, keyVersion, KMSRewriteOptions{RewriteOptions: RewriteOptions{NamespaceByNamespace: true}}, corev1.SchemeGroupVersion.WithKind("SecretList"))).To(Succeed())
+
+			Expect(targetClient.Get(ctx, client.ObjectKeyFromObject(secret1), secret1)).To(Succeed())
+			Expect(secret1.Labels).To(HaveKeyWithValue("credentials.gardener.cloud/kms-key-version", keyHash))
+
+			Expect(targetClient.Get(ctx, client.ObjectKey{Name: "gardener-rewrite-progress-rewrite-kms-key-version-" + keyHash, Namespace: "kube-system"}, &corev1.ConfigMap{})).To(BeNotFoundError())
+		})
+
+		It("should not rewrite anything if the health check fails", func() {
+			healthCheck := func(context.Context) error { return fmt.Errorf("fake") }
+
+			Expect(RewriteEncryptedDataForKMSKeyVersion(ctx, logger, targetClient, keyVersion, KMSRewriteOptions{HealthCheck: healthCheck}, corev1.SchemeGroupVersion.WithKind("SecretList"))).To(MatchError(ContainSubstring("KMS provider is not healthy")))
+
+			Expect(targetClient.Get(ctx, client.ObjectKeyFromObject(secret1), secret1)).To(Succeed())
+			Expect(secret1.Labels).To(HaveKeyWithValue("credentials.gardener.cloud/kms-key-version", "outdated"))
+		})
+
+		It("should fail if the key version is empty", func() {
+			Expect(RewriteEncryptedDataForKMSKeyVersion(ctx, logger, targetClient, "", KMSRewriteOptions{}, corev1.SchemeGroupVersion.WithKind("SecretList"))).To(MatchError("KMS key version must not be empty"))
+		})
+	})
+
+	Describe("#KMSKeyVersionHash", func() {
+		It("should return a stable hash which can be used as label value", func() {
+			Expect(KMSKeyVersionHash(keyVersion)).To(HaveLen(16))
+			Expect(KMSKeyVersionHash(keyVersion)).To(Equal(keyHash))
+			Expect(KMSKeyVersionHash("other")).NotTo(Equal(keyHash))
+		})
+	})
+
+	Describe("#NewKubeAPIServerKMSHealthCheck", func() {
+		var (
+			server     *httptest.Server
+			statusCode int
+		)
+
+		BeforeEach(func() {
+			statusCode = http.StatusOK
+			server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				defer GinkgoRecover()
+
+				Expect(r.Method).To(Equal(http.MethodGet))
+				Expect(r.URL.Path).To(Equal("/readyz/kms-providers"))
+
+				w.WriteHeader(statusCode)
+				_, _ = w.Write([]byte("[-]kms-providers failed"))
+			}))
+			DeferCleanup(server.Close)
+		})
+
+		It("should succeed if the KMS providers are healthy", func() {
+			Expect(NewKubeAPIServerKMSHealthCheck(server.Client(), server.URL)(ctx)).To(Succeed())
+		})
+
+		It("should fail if the KMS providers are not healthy", func() {
+			statusCode = http.StatusInternalServerError
+
+			Expect(NewKubeAPIServerKMSHealthCheck(server.Client(), server.URL)(ctx)).To(MatchError(And(
+				ContainSubstring("KMS providers of kube-apiserver are not healthy"),
+				ContainSubstring("[-]kms-providers failed"),
+			)))
+		})
+	})
+})
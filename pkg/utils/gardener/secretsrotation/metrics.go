@@ -0,0 +1,113 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package secretsrotation
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	runtimemetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+// metricsNamespace is the metric namespace for the secrets rotation.
+const metricsNamespace = "gardener_secrets_rotation"
+
+var (
+	factory = promauto.With(runtimemetrics.Registry)
+
+	// RewriteNamespaces defines the gauge rewrite_namespaces which exposes the number of namespaces processed by the
+	// namespace-by-namespace rewrite of encrypted data.
+	RewriteNamespaces = factory.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: metricsNamespace,
+			Name:      "rewrite_namespaces",
+			Help:      "Number of namespaces processed by the namespace-by-namespace rewrite of encrypted data.",
+		},
+		[]string{
+			"cluster",
+			"step",
+		},
+	)
+
+	// RewriteNamespacesCompleted defines the gauge rewrite_namespaces_completed which exposes the number of namespaces
+	// for which the namespace-by-namespace rewrite of encrypted data has completed.
+	RewriteNamespacesCompleted = factory.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: metricsNamespace,
+			Name:      "rewrite_namespaces_completed",
+			Help:      "Number of namespaces for which the namespace-by-namespace rewrite of encrypted data has completed.",
+		},
+		[]string{
+			"cluster",
+			"step",
+		},
+	)
+
+	// RewriteCurrentNamespace defines the gauge rewrite_current_namespace which is set to 1 for the namespace currently
+	// processed by the namespace-by-namespace rewrite of encrypted data.
+	RewriteCurrentNamespace = factory.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: metricsNamespace,
+			Name:      "rewrite_current_namespace",
+			Help:      "Namespace currently processed by the namespace-by-namespace rewrite of encrypted data.",
+		},
+		[]string{
+			"cluster",
+			"step",
+			"namespace",
+		},
+	)
+
+	// RewritePaused defines the gauge rewrite_paused which is set to 1 while the namespace-by-namespace rewrite of
+	// encrypted data is paused.
+	RewritePaused = factory.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: metricsNamespace,
+			Name:      "rewrite_paused",
+			Help:      "Whether the namespace-by-namespace rewrite of encrypted data is paused.",
+		},
+		[]string{
+			"cluster",
+			"step",
+		},
+	)
+
+	// RewriteObjectsFound defines the gauge rewrite_objects_found which exposes the number of objects which were found
+	// to require a rewrite of encrypted data so far.
+	RewriteObjectsFound = factory.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: metricsNamespace,
+			Name:      "rewrite_objects_found",
+			Help:      "Number of objects which were found to require a rewrite of encrypted data so far.",
+		},
+		[]string{
+			"cluster",
+			"step",
+		},
+	)
+
+	// RewriteObjectsRewritten defines the gauge rewrite_objects_rewritten which exposes the number of objects whose
+	// encrypted data has been rewritten.
+	RewriteObjectsRewritten = factory.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: metricsNamespace,
+			Name:      "rewrite_objects_rewritten",
+			Help:      "Number of objects whose encrypted data has been rewritten.",
+		},
+		[]string{
+			"cluster",
+			"step",
+		},
+	)
+)
@@ -0,0 +1,198 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package secretsrotation
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// EventReasonProgress is the reason of events emitted by the ProgressSink returned by NewEventProgressSink.
+const EventReasonProgress = "SecretsRotationProgress"
+
+// Progress describes the progress of a step of the secrets rotation which processes multiple units one after another,
+// e.g., the namespace-by-namespace or the paginated rewrite of encrypted data.
+type Progress struct {
+	// Step is the name of the step, e.g., StepRewriteAddLabel.
+	Step string
+	// Total is the number of units processed by the step.
+	Total int
+	// Completed is the number of units which have been processed already.
+	Completed int
+	// Current is the unit which is processed at the moment. It is empty if no unit is being processed.
+	Current string
+	// ObjectsFound is the number of objects which were found to require a rewrite so far. The objects are not counted
+	// upfront, i.e., the number grows while the objects are listed page by page.
+	ObjectsFound int
+	// ObjectsRewritten is the number of objects which have been rewritten already.
+	ObjectsRewritten int
+	// Done specifies whether the step has been completed.
+	Done bool
+	// Paused specifies whether the step has been paused. A paused step returns without an error but is not completed,
+	// i.e., it resumes in a subsequent execution once the pause has been lifted.
+	Paused bool
+}
+
+// ProgressSink receives the progress reported by the secrets rotation helpers. Implementations are called
+// synchronously, hence they should return quickly.
+type ProgressSink interface {
+	// Report reports the given progress.
+	Report(ctx context.Context, progress Progress) error
+}
+
+// ProgressSinkFunc is a function which implements ProgressSink.
+type ProgressSinkFunc func(ctx context.Context, progress Progress) error
+
+// Report calls f(ctx, progress).
+func (f ProgressSinkFunc) Report(ctx context.Context, progress Progress) error {
+	return f(ctx, progress)
+}
+
+// NewMultiProgressSink returns a ProgressSink which reports the progress to all given sinks.
+func NewMultiProgressSink(sinks ...ProgressSink) ProgressSink {
+	return multiProgressSink(sinks)
+}
+
+type multiProgressSink []ProgressSink
+
+func (m multiProgressSink) Report(ctx context.Context, progress Progress) error {
+	var errs []error
+	for _, sink := range m {
+		if err := sink.Report(ctx, progress); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// NewLogProgressSink returns a ProgressSink which logs the progress.
+func NewLogProgressSink(log logr.Logger) ProgressSink {
+	return &logProgressSink{log: log}
+}
+
+type logProgressSink struct {
+	log logr.Logger
+}
+
+func (l *logProgressSink) Report(_ context.Context, progress Progress) error {
+	log := l.log.WithValues("step", progress.Step, "completed", progress.Completed, "total", progress.Total, "objectsRewritten", progress.ObjectsRewritten, "objectsFound", progress.ObjectsFound)
+
+	switch {
+	case progress.Done:
+		log.Info("Step of secrets rotation completed")
+	case progress.Paused:
+		log.Info("Step of secrets rotation is paused")
+	case progress.Current != "":
+		log.Info("Processing next unit of secrets rotation step", "current", progress.Current)
+	default:
+		log.Info("Progress of secrets rotation step")
+	}
+	return nil
+}
+
+// NewEventProgressSink returns a ProgressSink which records the progress as events for the given object.
+func NewEventProgressSink(recorder record.EventRecorder, obj runtime.Object) ProgressSink {
+	return &eventProgressSink{recorder: recorder, obj: obj}
+}
+
+type eventProgressSink struct {
+	recorder record.EventRecorder
+	obj      runtime.Object
+}
+
+func (e *eventProgressSink) Report(_ context.Context, progress Progress) error {
+	switch {
+	case progress.Done:
+		e.recorder.Eventf(e.obj, corev1.EventTypeNormal, EventReasonProgress, "Step %q completed", progress.Step)
+	case progress.Paused:
+		e.recorder.Eventf(e.obj, corev1.EventTypeNormal, EventReasonProgress, "Step %q is paused (%d/%d completed)", progress.Step, progress.Completed, progress.Total)
+	case progress.Current != "":
+		e.recorder.Eventf(e.obj, corev1.EventTypeNormal, EventReasonProgress, "Step %q: processing %q (%d/%d completed)", progress.Step, progress.Current, progress.Completed, progress.Total)
+	case progress.Total == 0:
+		e.recorder.Eventf(e.obj, corev1.EventTypeNormal, EventReasonProgress, "Step %q: %d objects rewritten", progress.Step, progress.ObjectsRewritten)
+	default:
+		e.recorder.Eventf(e.obj, corev1.EventTypeNormal, EventReasonProgress, "Step %q: %d/%d completed", progress.Step, progress.Completed, progress.Total)
+	}
+	return nil
+}
+
+// NewMetricsProgressSink returns a ProgressSink which exposes the progress via the RewriteNamespaces,
+// RewriteNamespacesCompleted, RewriteCurrentNamespace, RewritePaused, RewriteObjectsFound, and RewriteObjectsRewritten
+// metrics. The given cluster is used as value for the `cluster` label of the metrics.
+func NewMetricsProgressSink(cluster string) ProgressSink {
+	return &metricsProgressSink{cluster: cluster}
+}
+
+type metricsProgressSink struct {
+	cluster string
+}
+
+func (m *metricsProgressSink) Report(_ context.Context, progress Progress) error {
+	RewriteCurrentNamespace.DeletePartialMatch(map[string]string{"cluster": m.cluster, "step": progress.Step})
+
+	if progress.Done {
+		RewriteNamespaces.DeleteLabelValues(m.cluster, progress.Step)
+		RewriteNamespacesCompleted.DeleteLabelValues(m.cluster, progress.Step)
+		RewritePaused.DeleteLabelValues(m.cluster, progress.Step)
+		RewriteObjectsFound.DeleteLabelValues(m.cluster, progress.Step)
+		RewriteObjectsRewritten.DeleteLabelValues(m.cluster, progress.Step)
+		return nil
+	}
+
+	RewriteNamespaces.WithLabelValues(m.cluster, progress.Step).Set(float64(progress.Total))
+	RewriteNamespacesCompleted.WithLabelValues(m.cluster, progress.Step).Set(float64(progress.Completed))
+	RewritePaused.WithLabelValues(m.cluster, progress.Step).Set(boolToFloat64(progress.Paused))
+	RewriteObjectsFound.WithLabelValues(m.cluster, progress.Step).Set(float64(progress.ObjectsFound))
+	RewriteObjectsRewritten.WithLabelValues(m.cluster, progress.Step).Set(float64(progress.ObjectsRewritten))
+	if progress.Current != "" {
+		RewriteCurrentNamespace.WithLabelValues(m.cluster, progress.Step, progress.Current).Set(1)
+	}
+	return nil
+}
+
+func boolToFloat64(b bool) float64 {
+	if b {
+		return 1
+	}
+	return 0
+}
+
+// NewStatusProgressSink returns a ProgressSink which patches the status of the given object. The mutate function is
+// expected to reflect the progress in the status of the object, e.g., by setting a condition.
+func NewStatusProgressSink(c client.Client, obj client.Object, mutate func(Progress)) ProgressSink {
+	return &statusProgressSink{client: c, obj: obj, mutate: mutate}
+}
+
+type statusProgressSink struct {
+	client client.Client
+	obj    client.Object
+	mutate func(Progress)
+}
+
+func (s *statusProgressSink) Report(ctx context.Context, progress Progress) error {
+	patch := client.MergeFrom(s.obj.DeepCopyObject().(client.Object))
+	s.mutate(progress)
+	if err := s.client.Status().Patch(ctx, s.obj, patch); err != nil {
+		return fmt.Errorf("failed patching status of %T %s with progress of step %q: %w", s.obj, client.ObjectKeyFromObject(s.obj), progress.Step, err)
+	}
+	return nil
+}
@@ -0,0 +1,117 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package secretsrotation_test
+
+import (
+	"context"
+	"errors"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	. "github.com/gardener/gardener/pkg/utils/gardener/secretsrotation"
+)
+
+var _ = Describe("ProgressSink", func() {
+	var (
+		ctx = context.TODO()
+
+		progress = Progress{Step: StepRewriteAddLabel, Total: 3, Completed: 1, Current: "bar"}
+	)
+
+	Describe("#NewMultiProgressSink", func() {
+		It("should report to all sinks and return their errors", func() {
+			var reported []Progress
+			recording := progressSinkFunc(func(_ context.Context, p Progress) error {
+				reported = append(reported, p)
+				return nil
+			})
+			failing := progressSinkFunc(func(context.Context, Progress) error { return errors.New("fake") })
+
+			Expect(NewMultiProgressSink(recording, failing, recording).Report(ctx, progress)).To(MatchError("fake"))
+			Expect(reported).To(Equal([]Progress{progress, progress}))
+		})
+	})
+
+	Describe("#NewEventProgressSink", func() {
+		It("should record events for the given object", func() {
+			recorder := record.NewFakeRecorder(4)
+			sink := NewEventProgressSink(recorder, &appsv1.Deployment{})
+
+			Expect(sink.Report(ctx, progress)).To(Succeed())
+			Expect(sink.Report(ctx, Progress{Step: StepRewriteAddLabel, ObjectsFound: 500, ObjectsRewritten: 100})).To(Succeed())
+			Expect(sink.Report(ctx, Progress{Step: StepRewriteAddLabel, Total: 3, Completed: 2, Paused: true})).To(Succeed())
+			Expect(sink.Report(ctx, Progress{Step: StepRewriteAddLabel, Done: true})).To(Succeed())
+
+			Expect(recorder.Events).To(Receive(Equal(`Normal SecretsRotationProgress Step "rewrite-add-label": processing "bar" (1/3 completed)`)))
+			Expect(recorder.Events).To(Receive(Equal(`Normal SecretsRotationProgress Step "rewrite-add-label": 100 objects rewritten`)))
+			Expect(recorder.Events).To(Receive(Equal(`Normal SecretsRotationProgress Step "rewrite-add-label" is paused (2/3 completed)`)))
+			Expect(recorder.Events).To(Receive(Equal(`Normal SecretsRotationProgress Step "rewrite-add-label" completed`)))
+		})
+	})
+
+	Describe("#NewMetricsProgressSink", func() {
+		It("should expose the progress and clean up once done", func() {
+			sink := NewMetricsProgressSink("progress-test")
+
+			Expect(sink.Report(ctx, Progress{Step: StepRewriteAddLabel, Total: 3, Completed: 1, Current: "bar", ObjectsFound: 500, ObjectsRewritten: 100})).To(Succeed())
+			Expect(testutil.ToFloat64(RewriteNamespaces.WithLabelValues("progress-test", StepRewriteAddLabel))).To(Equal(float64(3)))
+			Expect(testutil.ToFloat64(RewriteNamespacesCompleted.WithLabelValues("progress-test", StepRewriteAddLabel))).To(Equal(float64(1)))
+			Expect(testutil.ToFloat64(RewriteCurrentNamespace.WithLabelValues("progress-test", StepRewriteAddLabel, "bar"))).To(Equal(float64(1)))
+			Expect(testutil.ToFloat64(RewriteObjectsFound.WithLabelValues("progress-test", StepRewriteAddLabel))).To(Equal(float64(500)))
+			Expect(testutil.ToFloat64(RewriteObjectsRewritten.WithLabelValues("progress-test", StepRewriteAddLabel))).To(Equal(float64(100)))
+			Expect(testutil.ToFloat64(RewritePaused.WithLabelValues("progress-test", StepRewriteAddLabel))).To(Equal(float64(0)))
+
+			Expect(sink.Report(ctx, Progress{Step: StepRewriteAddLabel, Total: 3, Completed: 2, Paused: true})).To(Succeed())
+			Expect(testutil.ToFloat64(RewritePaused.WithLabelValues("progress-test", StepRewriteAddLabel))).To(Equal(float64(1)))
+
+			Expect(sink.Report(ctx, Progress{Step: StepRewriteAddLabel, Total: 3, Completed: 3, Done: true})).To(Succeed())
+			Expect(RewriteNamespaces.DeleteLabelValues("progress-test", StepRewriteAddLabel)).To(BeFalse())
+			Expect(RewriteNamespacesCompleted.DeleteLabelValues("progress-test", StepRewriteAddLabel)).To(BeFalse())
+			Expect(RewritePaused.DeleteLabelValues("progress-test", StepRewriteAddLabel)).To(BeFalse())
+			Expect(RewriteCurrentNamespace.DeleteLabelValues("progress-test", StepRewriteAddLabel, "bar")).To(BeFalse())
+			Expect(RewriteObjectsFound.DeleteLabelValues("progress-test", StepRewriteAddLabel)).To(BeFalse())
+			Expect(RewriteObjectsRewritten.DeleteLabelValues("progress-test", StepRewriteAddLabel)).To(BeFalse())
+		})
+	})
+
+	Describe("#NewStatusProgressSink", func() {
+		It("should patch the status of the given object", func() {
+			deployment := &appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: "kube-apiserver", Namespace: "shoot--foo--bar"}}
+			fakeClient := fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).WithStatusSubresource(deployment).WithObjects(deployment).Build()
+
+			sink := NewStatusProgressSink(fakeClient, deployment, func(p Progress) {
+				deployment.Status.Conditions = []appsv1.DeploymentCondition{{Type: "Rewrite", Status: "Progressing", Message: p.Current}}
+			})
+			Expect(sink.Report(ctx, progress)).To(Succeed())
+
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(deployment), deployment)).To(Succeed())
+			Expect(deployment.Status.Conditions).To(ConsistOf(HaveField("Message", "bar")))
+		})
+	})
+})
+
+type progressSinkFunc func(context.Context, Progress) error
+
+func (f progressSinkFunc) Report(ctx context.Context, progress Progress) error {
+	return f(ctx, progress)
+}
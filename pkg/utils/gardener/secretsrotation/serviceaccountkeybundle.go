@@ -0,0 +1,107 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package secretsrotation
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/gardener/gardener/pkg/utils"
+)
+
+// ServiceAccountKeyBundle is a bundle of versioned service account signing keys which is managed outside of Gardener,
+// e.g. by an external key management process. The bundle is stored in a secret whose data keys are the versions of the
+// keys and whose values are the PEM-encoded RSA private keys. The version of the key currently used for signing tokens
+// is stored in the AnnotationKeyServiceAccountKeyBundleCurrentVersion annotation. All other keys are old keys which
+// must still be accepted for verifying tokens until the external rotation removes them from the bundle.
+type ServiceAccountKeyBundle struct {
+	// SecretName is the name of the secret containing the bundle.
+	SecretName string
+	// CurrentVersion is the version of the key currently used for signing tokens.
+	CurrentVersion string
+	// Keys maps the versions of the keys to the PEM-encoded private keys.
+	Keys map[string][]byte
+}
+
+// ServiceAccountKeyBundleFromSecret parses the given secret into a ServiceAccountKeyBundle. It fails if the current
+// version is not annotated or not contained in the secret, or if any of the keys cannot be decoded.
+func ServiceAccountKeyBundleFromSecret(secret *corev1.Secret) (*ServiceAccountKeyBundle, error) {
+	currentVersion := secret.Annotations[AnnotationKeyServiceAccountKeyBundleCurrentVersion]
+	if currentVersion == "" {
+		return nil, fmt.Errorf("service account key bundle secret %q is missing the %s annotation", secret.Name, AnnotationKeyServiceAccountKeyBundleCurrentVersion)
+	}
+
+	if _, ok := secret.Data[currentVersion]; !ok {
+		return nil, fmt.Errorf("service account key bundle secret %q does not contain the current key version %q", secret.Name, currentVersion)
+	}
+
+	for version, key := range secret.Data {
+		if _, err := utils.DecodePrivateKey(key); err != nil {
+			return nil, fmt.Errorf("failed decoding key version %q of service account key bundle secret %q: %w", version, secret.Name, err)
+		}
+	}
+
+	return &ServiceAccountKeyBundle{
+		SecretName:     secret.Name,
+		CurrentVersion: currentVersion,
+		Keys:           secret.Data,
+	}, nil
+}
+
+// KeyName returns the name of the current key of the bundle. It is used as value of the key name label on the service
+// accounts whose token secrets were created for the current key, see CreateNewServiceAccountSecretsForKeyBundle.
+func (b *ServiceAccountKeyBundle) KeyName() string {
+	return utils.ComputeSHA256Hex([]byte(b.SecretName + "/" + b.CurrentVersion))[:16]
+}
+
+// CurrentKey returns the PEM-encoded private key which is currently used for signing tokens.
+func (b *ServiceAccountKeyBundle) CurrentKey() []byte {
+	return b.Keys[b.CurrentVersion]
+}
+
+// PrivateKeyBundle returns the concatenation of all keys of the bundle, starting with the current key followed by the
+// old keys sorted by their versions. It can be passed to kube-apiserver for verifying tokens signed with any of the
+// keys during the rotation window.
+func (b *ServiceAccountKeyBundle) PrivateKeyBundle() []byte {
+	versions := make([]string, 0, len(b.Keys))
+	for version := range b.Keys {
+		if version != b.CurrentVersion {
+			versions = append(versions, version)
+		}
+	}
+	sort.Strings(versions)
+
+	var buf bytes.Buffer
+	for _, version := range append([]string{b.CurrentVersion}, versions...) {
+		buf.Write(bytes.TrimSpace(b.Keys[version]))
+		buf.WriteString("\n")
+	}
+	return buf.Bytes()
+}
+
+// CreateNewServiceAccountSecretsForKeyBundle creates new secrets for all service accounts in the target cluster which
+// were not yet handled for the current key of the given externally managed bundle. It is the counterpart of
+// CreateNewServiceAccountSecrets for shoots whose service account signing key is not managed by Gardener and should be
+// executed whenever the current version of the bundle changes. The secrets for the old keys remain valid as long as
+// their keys are contained in the bundle.
+func CreateNewServiceAccountSecretsForKeyBundle(ctx context.Context, log logr.Logger, c client.Client, bundle *ServiceAccountKeyBundle) error {
+	return createNewServiceAccountSecrets(ctx, log.WithValues("keyVersion", bundle.CurrentVersion), c, bundle.KeyName(), utils.ComputeSHA256Hex(bundle.CurrentKey())[:6])
+}
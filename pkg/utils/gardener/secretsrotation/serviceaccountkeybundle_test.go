@@ -0,0 +1,152 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package secretsrotation_test
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/utils"
+	. "github.com/gardener/gardener/pkg/utils/gardener/secretsrotation"
+)
+
+var _ = Describe("Service account key bundle", func() {
+	var (
+		currentKey, oldKey []byte
+		secret             *corev1.Secret
+	)
+
+	BeforeEach(func() {
+		currentKey = generatePrivateKey()
+		oldKey = generatePrivateKey()
+
+		secret = &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        "sa-key-bundle",
+				Namespace:   "shoot--foo--bar",
+				Annotations: map[string]string{"credentials.gardener.cloud/current-key-version": "v2"},
+			},
+			Data: map[string][]byte{
+				"v1": oldKey,
+				"v2": currentKey,
+			},
+		}
+	})
+
+	Describe("#ServiceAccountKeyBundleFromSecret", func() {
+		It("should parse the bundle", func() {
+			bundle, err := ServiceAccountKeyBundleFromSecret(secret)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(bundle.SecretName).To(Equal("sa-key-bundle"))
+			Expect(bundle.CurrentVersion).To(Equal("v2"))
+			Expect(bundle.CurrentKey()).To(Equal(currentKey))
+		})
+
+		It("should fail if the current version is not annotated", func() {
+			delete(secret.Annotations, "credentials.gardener.cloud/current-key-version")
+
+			_, err := ServiceAccountKeyBundleFromSecret(secret)
+			Expect(err).To(MatchError(ContainSubstring("is missing the credentials.gardener.cloud/current-key-version annotation")))
+		})
+
+		It("should fail if the current version is not contained", func() {
+			secret.Annotations["credentials.gardener.cloud/current-key-version"] = "v3"
+
+			_, err := ServiceAccountKeyBundleFromSecret(secret)
+			Expect(err).To(MatchError(ContainSubstring(`does not contain the current key version "v3"`)))
+		})
+
+		It("should fail if a key cannot be decoded", func() {
+			secret.Data["v1"] = []byte("invalid")
+
+			_, err := ServiceAccountKeyBundleFromSecret(secret)
+			Expect(err).To(MatchError(ContainSubstring(`failed decoding key version "v1"`)))
+		})
+	})
+
+	Describe("#KeyName", func() {
+		It("should change with the current version", func() {
+			bundle, err := ServiceAccountKeyBundleFromSecret(secret)
+			Expect(err).NotTo(HaveOccurred())
+			keyName := bundle.KeyName()
+			Expect(keyName).To(HaveLen(16))
+
+			bundle.CurrentVersion = "v1"
+			Expect(bundle.KeyName()).NotTo(Equal(keyName))
+		})
+	})
+
+	Describe("#PrivateKeyBundle", func() {
+		It("should start with the current key followed by the old keys", func() {
+			bundle, err := ServiceAccountKeyBundleFromSecret(secret)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(string(bundle.PrivateKeyBundle())).To(Equal(string(currentKey) + string(oldKey)))
+		})
+	})
+
+	Describe("#CreateNewServiceAccountSecretsForKeyBundle", func() {
+		var (
+			ctx          = context.TODO()
+			targetClient client.Client
+			sa1, sa2     *corev1.ServiceAccount
+		)
+
+		BeforeEach(func() {
+			targetClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.ShootScheme).Build()
+
+			sa1 = &corev1.ServiceAccount{ObjectMeta: metav1.ObjectMeta{Name: "sa1", Namespace: "ns1"}, Secrets: []corev1.ObjectReference{{Name: "sa1secret1"}}}
+			sa2 = &corev1.ServiceAccount{ObjectMeta: metav1.ObjectMeta{Name: "sa2", Namespace: "ns1"}}
+
+			Expect(targetClient.Create(ctx, sa1)).To(Succeed())
+			Expect(targetClient.Create(ctx, sa2)).To(Succeed())
+		})
+
+		It("should create new service account secrets for the current key", func() {
+			bundle, err := ServiceAccountKeyBundleFromSecret(secret)
+			Expect(err).NotTo(HaveOccurred())
+			secretName := "sa1-token-" + utils.ComputeSHA256Hex(currentKey)[:6]
+
+			Expect(CreateNewServiceAccountSecretsForKeyBundle(ctx, logr.Discard(), targetClient, bundle)).To(Succeed())
+
+			Expect(targetClient.Get(ctx, client.ObjectKeyFromObject(sa1), sa1)).To(Succeed())
+			Expect(targetClient.Get(ctx, client.ObjectKeyFromObject(sa2), sa2)).To(Succeed())
+
+			Expect(sa1.Labels).To(HaveKeyWithValue("credentials.gardener.cloud/key-name", bundle.KeyName()))
+			Expect(sa1.Secrets).To(Equal([]corev1.ObjectReference{{Name: secretName}, {Name: "sa1secret1"}}))
+			Expect(sa2.Secrets).To(BeEmpty())
+
+			sa1Secret := &corev1.Secret{}
+			Expect(targetClient.Get(ctx, client.ObjectKey{Name: secretName, Namespace: "ns1"}, sa1Secret)).To(Succeed())
+			verifyCreatedSATokenSecret(sa1Secret, sa1.Name)
+		})
+	})
+})
+
+func generatePrivateKey() []byte {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	ExpectWithOffset(1, err).NotTo(HaveOccurred())
+	return utils.EncodePrivateKey(key)
+}
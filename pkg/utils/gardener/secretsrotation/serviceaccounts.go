@@ -43,12 +43,17 @@ func CreateNewServiceAccountSecrets(ctx context.Context, log logr.Logger, c clie
 	if !found {
 		return fmt.Errorf("secret %q not found", v1beta1constants.SecretNameServiceAccountKey)
 	}
-	secretNameSuffix := utils.ComputeSecretChecksum(serviceAccountKeySecret.Data)[:6]
 
+	return createNewServiceAccountSecrets(ctx, log, c, serviceAccountKeySecret.Name, utils.ComputeSecretChecksum(serviceAccountKeySecret.Data)[:6])
+}
+
+// createNewServiceAccountSecrets creates new secrets for all service accounts in the target cluster which are not yet
+// labeled with the given key name. The names of the new secrets are suffixed with the given suffix.
+func createNewServiceAccountSecrets(ctx context.Context, log logr.Logger, c client.Client, keyName, secretNameSuffix string) error {
 	serviceAccountList := &corev1.ServiceAccountList{}
 	if err := c.List(ctx, serviceAccountList, client.MatchingLabelsSelector{
 		Selector: labels.NewSelector().Add(
-			utils.MustNewRequirement(labelKeyRotationKeyName, selection.NotEquals, serviceAccountKeySecret.Name),
+			utils.MustNewRequirement(labelKeyRotationKeyName, selection.NotEquals, keyName),
 		)},
 	); err != nil {
 		return err
@@ -107,7 +112,7 @@ func CreateNewServiceAccountSecrets(ctx context.Context, log logr.Logger, c clie
 				}
 
 				patch := client.MergeFromWithOptions(serviceAccount.DeepCopy(), client.MergeFromWithOptimisticLock{})
-				metav1.SetMetaDataLabel(&serviceAccount.ObjectMeta, labelKeyRotationKeyName, serviceAccountKeySecret.Name)
+				metav1.SetMetaDataLabel(&serviceAccount.ObjectMeta, labelKeyRotationKeyName, keyName)
 				serviceAccount.Secrets = append([]corev1.ObjectReference{{Name: secret.Name}}, serviceAccount.Secrets...)
 
 				if err := c.Patch(ctx, &serviceAccount, patch); err != nil {
@@ -0,0 +1,237 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package secretsrotation
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	kubernetesutils "github.com/gardener/gardener/pkg/utils/kubernetes"
+)
+
+// Runner executes the steps of a phase of a credentials rotation.
+type Runner interface {
+	// RunStep executes the given function unless the step was already completed in the current phase. After the
+	// function succeeded, the step is marked as done so that it is not executed again.
+	RunStep(ctx context.Context, step string, fn func(context.Context) error) error
+}
+
+// StateMachineInterface tracks the phase of a credentials rotation and the steps which were already completed within
+// the current phase.
+type StateMachineInterface interface {
+	Runner
+	// Phase returns the current phase of the rotation. It is empty if the rotation was never started.
+	Phase() gardencorev1beta1.CredentialsRotationPhase
+	// Transition moves the rotation to the given phase and resets all step markers of the previous phase.
+	Transition(ctx context.Context, phase gardencorev1beta1.CredentialsRotationPhase) error
+	// AdvanceTo transitions the rotation along the allowed transitions until it reaches the given phase.
+	AdvanceTo(ctx context.Context, phase gardencorev1beta1.CredentialsRotationPhase) error
+	// IsStepDone returns whether the given step was already completed in the current phase.
+	IsStepDone(step string) bool
+	// MarkStepDone records that the given step was completed in the current phase.
+	MarkStepDone(ctx context.Context, step string) error
+}
+
+var _ StateMachineInterface = &StateMachine{}
+
+// StateMachine models the phases of a credentials rotation and persists markers for the steps which were already
+// completed within the current phase in the annotations of the owning object. This way, interrupted rotations resume
+// exactly where they stopped, e.g. after a restart of gardenlet.
+type StateMachine struct {
+	client   client.Client
+	obj      client.Object
+	rotation string
+}
+
+// NewStateMachine returns a new state machine for the rotation with the given name. The state is persisted in the
+// annotations of the given object.
+func NewStateMachine(c client.Client, obj client.Object, rotation string) *StateMachine {
+	return &StateMachine{
+		client:   c,
+		obj:      obj,
+		rotation: rotation,
+	}
+}
+
+// Phase returns the current phase of the rotation. It is empty if the rotation was never started.
+func (s *StateMachine) Phase() gardencorev1beta1.CredentialsRotationPhase {
+	return gardencorev1beta1.CredentialsRotationPhase(s.obj.GetAnnotations()[s.annotationKeyPhase()])
+}
+
+// Transition moves the rotation to the given phase and resets all step markers of the previous phase. Transitioning
+// to the current phase is a no-op. An error is returned if the transition is not allowed.
+func (s *StateMachine) Transition(ctx context.Context, phase gardencorev1beta1.CredentialsRotationPhase) error {
+	current := s.Phase()
+	if current == phase {
+		return nil
+	}
+
+	if !IsTransitionAllowed(current, phase) {
+		return fmt.Errorf("transition of rotation %q from phase %q to phase %q is not allowed", s.rotation, current, phase)
+	}
+
+	return s.patch(ctx, func(obj client.Object) {
+		annotations := obj.GetAnnotations()
+		if annotations == nil {
+			annotations = map[string]string{}
+		}
+		annotations[s.annotationKeyPhase()] = string(phase)
+		delete(annotations, s.annotationKeySteps())
+		obj.SetAnnotations(annotations)
+	})
+}
+
+// AdvanceTo transitions the rotation along the allowed transitions until it reaches the given phase. This way, the
+// state machine catches up with the phase of the rotation if it did not observe all phases, e.g. because no steps are
+// executed in the Prepared and Completed phases.
+func (s *StateMachine) AdvanceTo(ctx context.Context, phase gardencorev1beta1.CredentialsRotationPhase) error {
+	for _, next := range PhasesUntil(s.Phase(), phase) {
+		if err := s.Transition(ctx, next); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// PhasesUntil returns the phases through which a rotation in the current phase has to transition for reaching the given
+// phase, including the given phase. It is empty if the rotation is already in the given phase.
+func PhasesUntil(current, phase gardencorev1beta1.CredentialsRotationPhase) []gardencorev1beta1.CredentialsRotationPhase {
+	var phases []gardencorev1beta1.CredentialsRotationPhase
+	for current != phase && len(phases) < len(allowedTransitions) {
+		current = allowedTransitions[current]
+		phases = append(phases, current)
+	}
+	return phases
+}
+
+// NewETCDEncryptionKeyStateMachine returns a state machine for the ETCD encryption key rotation which persists its
+// state in the annotations of the given kube-apiserver deployment. The state machine is advanced to the given phase,
+// hence the step markers of a previous phase are reset.
+func NewETCDEncryptionKeyStateMachine(
+	ctx context.Context,
+	c client.Client,
+	namespace string,
+	name string,
+	phase gardencorev1beta1.CredentialsRotationPhase,
+) (
+	*StateMachine,
+	error,
+) {
+	meta := &metav1.PartialObjectMetadata{}
+	meta.SetGroupVersionKind(appsv1.SchemeGroupVersion.WithKind("Deployment"))
+	if err := c.Get(ctx, kubernetesutils.Key(namespace, name), meta); err != nil {
+		return nil, err
+	}
+
+	stateMachine := NewStateMachine(c, meta, RotationETCDEncryptionKey)
+	if err := stateMachine.AdvanceTo(ctx, phase); err != nil {
+		return nil, err
+	}
+	return stateMachine, nil
+}
+
+// IsTransitionAllowed returns whether a rotation may transition from the current to the given phase. Rotations walk
+// through the phases Preparing, Prepared, Completing and Completed in this order and may be started again once they
+// are completed.
+func IsTransitionAllowed(current, phase gardencorev1beta1.CredentialsRotationPhase) bool {
+	allowed, ok := allowedTransitions[current]
+	return ok && allowed == phase
+}
+
+// IsStepDone returns whether the given step was already completed in the current phase.
+func (s *StateMachine) IsStepDone(step string) bool {
+	return s.completedSteps().Has(step)
+}
+
+// MarkStepDone persists that the given step was completed in the current phase.
+func (s *StateMachine) MarkStepDone(ctx context.Context, step string) error {
+	if err := ValidateStepName(step); err != nil {
+		return err
+	}
+
+	if s.IsStepDone(step) {
+		return nil
+	}
+
+	steps := s.completedSteps().Insert(step)
+
+	return s.patch(ctx, func(obj client.Object) {
+		annotations := obj.GetAnnotations()
+		if annotations == nil {
+			annotations = map[string]string{}
+		}
+		annotations[s.annotationKeySteps()] = strings.Join(sets.List(steps), ",")
+		obj.SetAnnotations(annotations)
+	})
+}
+
+// ValidateStepName returns an error if the given step name cannot be recorded by the StateMachine.
+func ValidateStepName(step string) error {
+	if step == "" || strings.Contains(step, ",") {
+		return fmt.Errorf("invalid step name %q", step)
+	}
+	return nil
+}
+
+// RunStep executes the given function unless the step was already completed in the current phase. After the function
+// succeeded, the step is marked as done so that it is not executed again in a future reconciliation.
+func (s *StateMachine) RunStep(ctx context.Context, step string, fn func(context.Context) error) error {
+	if s.IsStepDone(step) {
+		return nil
+	}
+
+	if err := fn(ctx); err != nil {
+		return err
+	}
+
+	return s.MarkStepDone(ctx, step)
+}
+
+var allowedTransitions = map[gardencorev1beta1.CredentialsRotationPhase]gardencorev1beta1.CredentialsRotationPhase{
+	"":                                   gardencorev1beta1.RotationPreparing,
+	gardencorev1beta1.RotationPreparing:  gardencorev1beta1.RotationPrepared,
+	gardencorev1beta1.RotationPrepared:   gardencorev1beta1.RotationCompleting,
+	gardencorev1beta1.RotationCompleting: gardencorev1beta1.RotationCompleted,
+	gardencorev1beta1.RotationCompleted:  gardencorev1beta1.RotationPreparing,
+}
+
+func (s *StateMachine) completedSteps() sets.Set[string] {
+	value := s.obj.GetAnnotations()[s.annotationKeySteps()]
+	if value == "" {
+		return sets.New[string]()
+	}
+	return sets.New(strings.Split(value, ",")...)
+}
+
+func (s *StateMachine) patch(ctx context.Context, mutate func(client.Object)) error {
+	patch := client.MergeFrom(s.obj.DeepCopyObject().(client.Object))
+	mutate(s.obj)
+	return s.client.Patch(ctx, s.obj, patch)
+}
+
+func (s *StateMachine) annotationKeyPhase() string {
+	return annotationKeyPrefixRotation + s.rotation + "-phase"
+}
+
+func (s *StateMachine) annotationKeySteps() string {
+	return annotationKeyPrefixRotation + s.rotation + "-steps"
+}
@@ -0,0 +1,198 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package secretsrotation_test
+
+import (
+	"context"
+	"errors"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	. "github.com/gardener/gardener/pkg/utils/gardener/secretsrotation"
+	. "github.com/gardener/gardener/pkg/utils/test/matchers"
+)
+
+var _ = Describe("StateMachine", func() {
+	var (
+		ctx = context.TODO()
+
+		fakeClient   client.Client
+		deployment   *appsv1.Deployment
+		stateMachine *StateMachine
+	)
+
+	BeforeEach(func() {
+		fakeClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).Build()
+		deployment = &appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: "kube-apiserver", Namespace: "shoot--foo--bar"}}
+		Expect(fakeClient.Create(ctx, deployment)).To(Succeed())
+
+		stateMachine = NewStateMachine(fakeClient, deployment, RotationETCDEncryptionKey)
+	})
+
+	reload := func() *StateMachine {
+		obj := &appsv1.Deployment{}
+		Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(deployment), obj)).To(Succeed())
+		return NewStateMachine(fakeClient, obj, RotationETCDEncryptionKey)
+	}
+
+	Describe("#Transition", func() {
+		It("should walk through all phases and persist them", func() {
+			Expect(stateMachine.Phase()).To(BeEmpty())
+
+			for _, phase := range []gardencorev1beta1.CredentialsRotationPhase{
+				gardencorev1beta1.RotationPreparing,
+				gardencorev1beta1.RotationPrepared,
+				gardencorev1beta1.RotationCompleting,
+				gardencorev1beta1.RotationCompleted,
+				gardencorev1beta1.RotationPreparing,
+			} {
+				Expect(stateMachine.Transition(ctx, phase)).To(Succeed())
+				Expect(reload().Phase()).To(Equal(phase))
+			}
+		})
+
+		It("should do nothing when transitioning to the current phase", func() {
+			Expect(stateMachine.Transition(ctx, gardencorev1beta1.RotationPreparing)).To(Succeed())
+			Expect(stateMachine.MarkStepDone(ctx, StepRewriteAddLabel)).To(Succeed())
+
+			Expect(stateMachine.Transition(ctx, gardencorev1beta1.RotationPreparing)).To(Succeed())
+			Expect(reload().IsStepDone(StepRewriteAddLabel)).To(BeTrue())
+		})
+
+		It("should reject transitions skipping a phase", func() {
+			Expect(stateMachine.Transition(ctx, gardencorev1beta1.RotationCompleting)).To(MatchError(ContainSubstring("is not allowed")))
+			Expect(reload().Phase()).To(BeEmpty())
+		})
+
+		It("should reset the step markers of the previous phase", func() {
+			Expect(stateMachine.Transition(ctx, gardencorev1beta1.RotationPreparing)).To(Succeed())
+			Expect(stateMachine.MarkStepDone(ctx, StepRewriteAddLabel)).To(Succeed())
+
+			Expect(stateMachine.Transition(ctx, gardencorev1beta1.RotationPrepared)).To(Succeed())
+			Expect(reload().IsStepDone(StepRewriteAddLabel)).To(BeFalse())
+		})
+	})
+
+	Describe("#AdvanceTo", func() {
+		It("should transition through the phases which were not observed", func() {
+			Expect(stateMachine.Transition(ctx, gardencorev1beta1.RotationPreparing)).To(Succeed())
+			Expect(stateMachine.MarkStepDone(ctx, StepRewriteAddLabel)).To(Succeed())
+
+			Expect(stateMachine.AdvanceTo(ctx, gardencorev1beta1.RotationCompleting)).To(Succeed())
+			restored := reload()
+			Expect(restored.Phase()).To(Equal(gardencorev1beta1.RotationCompleting))
+			Expect(restored.IsStepDone(StepRewriteAddLabel)).To(BeFalse())
+		})
+
+		It("should start the next rotation after a completed one", func() {
+			Expect(stateMachine.AdvanceTo(ctx, gardencorev1beta1.RotationCompleting)).To(Succeed())
+			Expect(stateMachine.MarkStepDone(ctx, StepRewriteRemoveLabel)).To(Succeed())
+
+			Expect(stateMachine.AdvanceTo(ctx, gardencorev1beta1.RotationPreparing)).To(Succeed())
+			restored := reload()
+			Expect(restored.Phase()).To(Equal(gardencorev1beta1.RotationPreparing))
+			Expect(restored.IsStepDone(StepRewriteRemoveLabel)).To(BeFalse())
+		})
+
+		It("should keep the step markers if the rotation is already in the phase", func() {
+			Expect(stateMachine.AdvanceTo(ctx, gardencorev1beta1.RotationPreparing)).To(Succeed())
+			Expect(stateMachine.MarkStepDone(ctx, StepRewriteAddLabel)).To(Succeed())
+
+			Expect(reload().AdvanceTo(ctx, gardencorev1beta1.RotationPreparing)).To(Succeed())
+			Expect(reload().IsStepDone(StepRewriteAddLabel)).To(BeTrue())
+		})
+	})
+
+	Describe("#PhasesUntil", func() {
+		It("should return the phases to walk through", func() {
+			Expect(PhasesUntil(gardencorev1beta1.RotationPrepared, gardencorev1beta1.RotationPrepared)).To(BeEmpty())
+			Expect(PhasesUntil("", gardencorev1beta1.RotationPrepared)).To(Equal([]gardencorev1beta1.CredentialsRotationPhase{
+				gardencorev1beta1.RotationPreparing,
+				gardencorev1beta1.RotationPrepared,
+			}))
+			Expect(PhasesUntil(gardencorev1beta1.RotationCompleting, gardencorev1beta1.RotationPreparing)).To(Equal([]gardencorev1beta1.CredentialsRotationPhase{
+				gardencorev1beta1.RotationCompleted,
+				gardencorev1beta1.RotationPreparing,
+			}))
+		})
+	})
+
+	Describe("#NewETCDEncryptionKeyStateMachine", func() {
+		It("should read the state from the deployment and advance it to the given phase", func() {
+			Expect(stateMachine.AdvanceTo(ctx, gardencorev1beta1.RotationPreparing)).To(Succeed())
+			Expect(stateMachine.MarkStepDone(ctx, StepRewriteAddLabel)).To(Succeed())
+
+			restored, err := NewETCDEncryptionKeyStateMachine(ctx, fakeClient, deployment.Namespace, deployment.Name, gardencorev1beta1.RotationPreparing)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(restored.IsStepDone(StepRewriteAddLabel)).To(BeTrue())
+
+			restored, err = NewETCDEncryptionKeyStateMachine(ctx, fakeClient, deployment.Namespace, deployment.Name, gardencorev1beta1.RotationCompleting)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(restored.Phase()).To(Equal(gardencorev1beta1.RotationCompleting))
+			Expect(reload().Phase()).To(Equal(gardencorev1beta1.RotationCompleting))
+			Expect(reload().IsStepDone(StepRewriteAddLabel)).To(BeFalse())
+		})
+
+		It("should fail if the deployment does not exist", func() {
+			_, err := NewETCDEncryptionKeyStateMachine(ctx, fakeClient, deployment.Namespace, "foo", gardencorev1beta1.RotationPreparing)
+			Expect(err).To(BeNotFoundError())
+		})
+	})
+
+	Describe("#MarkStepDone", func() {
+		It("should persist the step markers", func() {
+			Expect(stateMachine.MarkStepDone(ctx, StepRewriteAddLabel)).To(Succeed())
+			Expect(stateMachine.MarkStepDone(ctx, StepETCDSnapshotted)).To(Succeed())
+
+			restored := reload()
+			Expect(restored.IsStepDone(StepRewriteAddLabel)).To(BeTrue())
+			Expect(restored.IsStepDone(StepETCDSnapshotted)).To(BeTrue())
+			Expect(restored.IsStepDone(StepRewriteRemoveLabel)).To(BeFalse())
+		})
+
+		It("should reject invalid step names", func() {
+			Expect(stateMachine.MarkStepDone(ctx, "")).To(MatchError(ContainSubstring("invalid step name")))
+			Expect(stateMachine.MarkStepDone(ctx, "foo,bar")).To(MatchError(ContainSubstring("invalid step name")))
+		})
+	})
+
+	Describe("#RunStep", func() {
+		It("should run the step only once", func() {
+			var calls int
+			fn := func(context.Context) error {
+				calls++
+				return nil
+			}
+
+			Expect(stateMachine.RunStep(ctx, StepETCDSnapshotted, fn)).To(Succeed())
+			Expect(reload().RunStep(ctx, StepETCDSnapshotted, fn)).To(Succeed())
+			Expect(calls).To(Equal(1))
+		})
+
+		It("should not mark the step as done if it failed", func() {
+			Expect(stateMachine.RunStep(ctx, StepETCDSnapshotted, func(context.Context) error {
+				return errors.New("fake")
+			})).To(MatchError("fake"))
+			Expect(reload().IsStepDone(StepETCDSnapshotted)).To(BeFalse())
+		})
+	})
+})
@@ -0,0 +1,149 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package secretsrotation
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"math/rand"
+	"strings"
+
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	secretsutils "github.com/gardener/gardener/pkg/utils/secrets"
+	secretsmanager "github.com/gardener/gardener/pkg/utils/secrets/manager"
+)
+
+const (
+	// DefaultVerificationSampleSize is the default number of keys sampled per resource when verifying the encryption of
+	// the data stored in ETCD.
+	DefaultVerificationSampleSize = 10
+	// DefaultEtcdPrefix is the default prefix of the keys stored in ETCD by kube-apiserver.
+	DefaultEtcdPrefix = "/registry"
+
+	ciphertextPrefixAESCBC = "k8s:enc:aescbc:v1:"
+)
+
+// EtcdReader reads the raw keys and values stored in ETCD.
+type EtcdReader interface {
+	// Keys returns all keys with the given prefix.
+	Keys(ctx context.Context, prefix string) ([]string, error)
+	// Value returns the raw value stored for the given key.
+	Value(ctx context.Context, key string) ([]byte, error)
+}
+
+// VerifyOptions contains options for verifying the encryption of the data stored in ETCD.
+type VerifyOptions struct {
+	// SampleSize is the number of keys which are sampled per resource. Defaults to DefaultVerificationSampleSize.
+	SampleSize int
+	// EtcdPrefix is the prefix of the keys stored in ETCD. Defaults to DefaultEtcdPrefix.
+	EtcdPrefix string
+}
+
+// VerifyEncryptedDataAtRest samples the keys of the given resources stored in ETCD and verifies that their values are
+// encrypted with the current ETCD encryption key. In contrast to inferring the re-encryption from the rewrites of the
+// objects, this reads the ciphertext from ETCD and hence proves that the data is encrypted with the expected provider
+// and key. It is meant to be called after the encrypted data has been rewritten as part of the ETCD encryption key
+// rotation.
+func VerifyEncryptedDataAtRest(
+	ctx context.Context,
+	log logr.Logger,
+	reader EtcdReader,
+	secretsManager secretsmanager.Interface,
+	opts VerifyOptions,
+	resources ...schema.GroupResource,
+) error {
+	etcdEncryptionKeySecret, found := secretsManager.Get(v1beta1constants.SecretNameETCDEncryptionKey, secretsmanager.Current)
+	if !found {
+		return fmt.Errorf("secret %q not found", v1beta1constants.SecretNameETCDEncryptionKey)
+	}
+
+	if opts.SampleSize <= 0 {
+		opts.SampleSize = DefaultVerificationSampleSize
+	}
+	if opts.EtcdPrefix == "" {
+		opts.EtcdPrefix = DefaultEtcdPrefix
+	}
+
+	var (
+		expectedPrefix = ciphertextPrefixAESCBC + string(etcdEncryptionKeySecret.Data[secretsutils.DataKeyEncryptionKeyName]) + ":"
+		mismatches     []string
+	)
+
+	for _, resource := range resources {
+		keys, err := reader.Keys(ctx, EtcdKeyPrefix(opts.EtcdPrefix, resource))
+		if err != nil {
+			return fmt.Errorf("failed listing keys of %s in ETCD: %w", resource, err)
+		}
+
+		sample := sampleKeys(keys, opts.SampleSize)
+		log.Info("Verifying encryption of data stored in ETCD", "resource", resource, "keys", len(keys), "sampled", len(sample))
+
+		for _, key := range sample {
+			value, err := reader.Value(ctx, key)
+			if err != nil {
+				return fmt.Errorf("failed reading key %q from ETCD: %w", key, err)
+			}
+
+			if !bytes.HasPrefix(value, []byte(expectedPrefix)) {
+				mismatches = append(mismatches, fmt.Sprintf("%s (%s)", key, describeCiphertext(value)))
+			}
+		}
+	}
+
+	if len(mismatches) > 0 {
+		return fmt.Errorf("data stored in ETCD is not encrypted with the current ETCD encryption key (expected prefix %q): %s", expectedPrefix, strings.Join(mismatches, ", "))
+	}
+
+	return nil
+}
+
+// EtcdKeyPrefix returns the prefix of the keys stored in ETCD for the given resource, assuming the default storage
+// prefixes of kube-apiserver: Resources of the core group and of the built-in API groups are stored below
+// <etcdPrefix>/<resource>/, all others (e.g., custom resources) below <etcdPrefix>/<group>/<resource>/.
+func EtcdKeyPrefix(etcdPrefix string, resource schema.GroupResource) string {
+	if resource.Group == "" || !strings.Contains(resource.Group, ".") || strings.HasSuffix(resource.Group, ".k8s.io") {
+		return fmt.Sprintf("%s/%s/", etcdPrefix, resource.Resource)
+	}
+	return fmt.Sprintf("%s/%s/%s/", etcdPrefix, resource.Group, resource.Resource)
+}
+
+func sampleKeys(keys []string, size int) []string {
+	if len(keys) <= size {
+		return keys
+	}
+
+	sample := make([]string, 0, size)
+	for _, i := range rand.Perm(len(keys))[:size] {
+		sample = append(sample, keys[i])
+	}
+	return sample
+}
+
+// describeCiphertext returns the provider and key name of the given value stored in ETCD, e.g., "aescbc/key1".
+func describeCiphertext(value []byte) string {
+	if !bytes.HasPrefix(value, []byte("k8s:enc:")) {
+		return "unencrypted"
+	}
+
+	parts := strings.SplitN(string(value), ":", 6)
+	if len(parts) < 6 {
+		return "malformed"
+	}
+	return parts[2] + "/" + parts[4]
+}
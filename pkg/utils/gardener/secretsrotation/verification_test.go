@@ -0,0 +1,121 @@
+// Copyright 2023 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package secretsrotation_test
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	. "github.com/gardener/gardener/pkg/utils/gardener/secretsrotation"
+	fakesecretsrotation "github.com/gardener/gardener/pkg/utils/gardener/secretsrotation/fake"
+	secretsmanager "github.com/gardener/gardener/pkg/utils/secrets/manager"
+	fakesecretsmanager "github.com/gardener/gardener/pkg/utils/secrets/manager/fake"
+)
+
+var _ = Describe("Verification", func() {
+	var (
+		ctx = context.TODO()
+		log = logr.Discard()
+
+		namespace = "shoot--foo--bar"
+
+		runtimeClient      client.Client
+		fakeSecretsManager secretsmanager.Interface
+		reader             *fakesecretsrotation.EtcdReader
+	)
+
+	BeforeEach(func() {
+		runtimeClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).Build()
+		fakeSecretsManager = fakesecretsmanager.New(runtimeClient, namespace)
+		reader = &fakesecretsrotation.EtcdReader{Data: map[string][]byte{
+			"/registry/secrets/ns1/secret1":  []byte("k8s:enc:aescbc:v1:key-new:ciphertext1"),
+			"/registry/secrets/ns2/secret2":  []byte("k8s:enc:aescbc:v1:key-new:ciphertext2"),
+			"/registry/configmaps/ns1/cm1":   []byte("plaintext"),
+			"/registry/example.com/foos/foo": []byte("k8s:enc:aescbc:v1:key-new:ciphertext3"),
+		}}
+	})
+
+	Describe("#VerifyEncryptedDataAtRest", func() {
+		BeforeEach(func() {
+			Expect(runtimeClient.Create(ctx, &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "kube-apiserver-etcd-encryption-key-current", Namespace: namespace},
+				Data:       map[string][]byte{"key": []byte("key-new")},
+			})).To(Succeed())
+		})
+
+		It("should succeed if all sampled values are encrypted with the current key", func() {
+			Expect(VerifyEncryptedDataAtRest(ctx, log, reader, fakeSecretsManager, VerifyOptions{},
+				schema.GroupResource{Resource: "secrets"},
+				schema.GroupResource{Group: "example.com", Resource: "foos"},
+			)).To(Succeed())
+			Expect(reader.Reads).To(ConsistOf("/registry/secrets/ns1/secret1", "/registry/secrets/ns2/secret2", "/registry/example.com/foos/foo"))
+		})
+
+		It("should only read the configured number of keys per resource", func() {
+			Expect(VerifyEncryptedDataAtRest(ctx, log, reader, fakeSecretsManager, VerifyOptions{SampleSize: 1}, schema.GroupResource{Resource: "secrets"})).To(Succeed())
+			Expect(reader.Reads).To(HaveLen(1))
+		})
+
+		It("should fail if values are encrypted with another key", func() {
+			reader.Data["/registry/secrets/ns2/secret2"] = []byte("k8s:enc:aescbc:v1:key-old:ciphertext2")
+
+			Expect(VerifyEncryptedDataAtRest(ctx, log, reader, fakeSecretsManager, VerifyOptions{}, schema.GroupResource{Resource: "secrets"})).To(MatchError(
+				`data stored in ETCD is not encrypted with the current ETCD encryption key (expected prefix "k8s:enc:aescbc:v1:key-new:"): /registry/secrets/ns2/secret2 (aescbc/key-old)`,
+			))
+		})
+
+		It("should fail if values are not encrypted", func() {
+			Expect(VerifyEncryptedDataAtRest(ctx, log, reader, fakeSecretsManager, VerifyOptions{}, schema.GroupResource{Resource: "configmaps"})).To(MatchError(
+				ContainSubstring("/registry/configmaps/ns1/cm1 (unencrypted)"),
+			))
+		})
+
+		It("should respect the configured ETCD prefix", func() {
+			reader.Data = map[string][]byte{"/custom/secrets/ns1/secret1": []byte("k8s:enc:aescbc:v1:key-old:ciphertext1")}
+
+			Expect(VerifyEncryptedDataAtRest(ctx, log, reader, fakeSecretsManager, VerifyOptions{EtcdPrefix: "/custom"}, schema.GroupResource{Resource: "secrets"})).To(MatchError(
+				ContainSubstring("/custom/secrets/ns1/secret1 (aescbc/key-old)"),
+			))
+		})
+
+		It("should fail if the current ETCD encryption key secret does not exist", func() {
+			Expect(runtimeClient.Delete(ctx, &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "kube-apiserver-etcd-encryption-key-current", Namespace: namespace}})).To(Succeed())
+
+			Expect(VerifyEncryptedDataAtRest(ctx, log, reader, fakeSecretsManager, VerifyOptions{}, schema.GroupResource{Resource: "secrets"})).To(MatchError(
+				`secret "kube-apiserver-etcd-encryption-key" not found`,
+			))
+		})
+	})
+
+	DescribeTable("#EtcdKeyPrefix",
+		func(resource schema.GroupResource, expected string) {
+			Expect(EtcdKeyPrefix("/registry", resource)).To(Equal(expected))
+		},
+
+		Entry("core group", schema.GroupResource{Resource: "secrets"}, "/registry/secrets/"),
+		Entry("built-in group", schema.GroupResource{Group: "apps", Resource: "deployments"}, "/registry/deployments/"),
+		Entry("built-in k8s.io group", schema.GroupResource{Group: "networking.k8s.io", Resource: "networkpolicies"}, "/registry/networkpolicies/"),
+		Entry("custom group", schema.GroupResource{Group: "core.gardener.cloud", Resource: "shoots"}, "/registry/core.gardener.cloud/shoots/"),
+	)
+})
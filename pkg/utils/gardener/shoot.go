@@ -283,6 +283,7 @@ type AccessSecret struct {
 	ServiceAccountName string
 	Class              string
 
+	serviceAccountNamespace string
 	tokenExpirationDuration string
 	kubeconfig              *clientcmdv1.Config
 	targetSecretName        string
@@ -327,6 +328,13 @@ func (s *AccessSecret) WithServiceAccountName(name string) *AccessSecret {
 	return s
 }
 
+// WithServiceAccountNamespace sets the namespace of the service account in the shoot for which the token is requested.
+// If not set, the service account is expected in the kube-system namespace.
+func (s *AccessSecret) WithServiceAccountNamespace(namespace string) *AccessSecret {
+	s.serviceAccountNamespace = namespace
+	return s
+}
+
 // WithTokenExpirationDuration sets the tokenExpirationDuration field of the AccessSecret.
 func (s *AccessSecret) WithTokenExpirationDuration(duration string) *AccessSecret {
 	s.tokenExpirationDuration = duration
@@ -356,7 +364,11 @@ func (s *AccessSecret) Reconcile(ctx context.Context, c client.Client) error {
 		metav1.SetMetaDataAnnotation(&s.Secret.ObjectMeta, resourcesv1alpha1.ServiceAccountName, s.ServiceAccountName)
 
 		if s.Class == resourcesv1alpha1.ResourceManagerClassShoot {
-			metav1.SetMetaDataAnnotation(&s.Secret.ObjectMeta, resourcesv1alpha1.ServiceAccountNamespace, metav1.NamespaceSystem)
+			serviceAccountNamespace := metav1.NamespaceSystem
+			if s.serviceAccountNamespace != "" {
+				serviceAccountNamespace = s.serviceAccountNamespace
+			}
+			metav1.SetMetaDataAnnotation(&s.Secret.ObjectMeta, resourcesv1alpha1.ServiceAccountNamespace, serviceAccountNamespace)
 		}
 
 		if s.tokenExpirationDuration != "" {
@@ -409,6 +421,8 @@ func (s *AccessSecret) Reconcile(ctx context.Context, c client.Client) error {
 // object. The access secret name must be the name of a secret containing a JWT token which should be used by the
 // kubeconfig. If the object has multiple containers then the default is to inject it into all of them. If it should
 // only be done for a selection of containers then their respective names must be provided.
+// The token is read from the projected file rather than via an exec credential plugin, see the TokenRequestor section
+// in docs/concepts/resource-manager.md.
 func InjectGenericKubeconfig(obj runtime.Object, genericKubeconfigName, accessSecretName string, containerNames ...string) error {
 	return injectGenericKubeconfig(obj, genericKubeconfigName, accessSecretName, "kubeconfig", VolumeMountPathGenericKubeconfig, containerNames...)
 }
@@ -482,6 +482,13 @@ var _ = Describe("Shoot", func() {
 					Expect(accessSecret.Secret.Annotations).To(HaveKeyWithValue("serviceaccount.resources.gardener.cloud/namespace", "kube-system"))
 				})
 
+				It("should set the configured ServiceAccount namespace for shoot class", func() {
+					accessSecret.Class = "shoot"
+					accessSecret.WithServiceAccountNamespace("custom")
+					validate()
+					Expect(accessSecret.Secret.Annotations).To(HaveKeyWithValue("serviceaccount.resources.gardener.cloud/namespace", "custom"))
+				})
+
 				It("should work w/ token expiration duration", func() {
 					accessSecret.WithTokenExpirationDuration(tokenExpirationDuration)
 					validate()
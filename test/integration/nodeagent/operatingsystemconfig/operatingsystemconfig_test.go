@@ -16,36 +16,49 @@ package operatingsystemconfig_test
 
 import (
 	"context"
+	"fmt"
 	"io/fs"
+	"os"
 	"path"
+	"sync"
+	"syscall"
 	"time"
 
 	"github.com/Masterminds/semver/v3"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	"github.com/spf13/afero"
+	coordinationv1 "k8s.io/api/coordination/v1"
 	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/clock"
 	"k8s.io/utils/pointer"
 	"sigs.k8s.io/controller-runtime/pkg/cache"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 	metricsserver "sigs.k8s.io/controller-runtime/pkg/metrics/server"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	extensionsv1alpha1helper "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1/helper"
 	"github.com/gardener/gardener/pkg/nodeagent/apis/config"
 	"github.com/gardener/gardener/pkg/nodeagent/controller/operatingsystemconfig"
 	fakedbus "github.com/gardener/gardener/pkg/nodeagent/dbus/fake"
 	fakeregistry "github.com/gardener/gardener/pkg/nodeagent/registry/fake"
 	"github.com/gardener/gardener/pkg/utils"
+	"github.com/gardener/gardener/pkg/utils/test"
+	. "github.com/gardener/gardener/pkg/utils/test/matchers"
 )
 
 var _ = Describe("OperatingSystemConfig controller tests", func() {
 	var (
-		fakeDBus *fakedbus.DBus
-		fakeFS   afero.Afero
+		fakeDBus         *fakedbus.DBus
+		fakeFS           afero.Afero
+		fakeAttributesFS *attributesFS
 
 		oscSecretName     = testRunID
 		kubernetesVersion = semver.MustParse("1.2.3")
@@ -68,7 +81,8 @@ var _ = Describe("OperatingSystemConfig controller tests", func() {
 		var err error
 
 		fakeDBus = fakedbus.New()
-		fakeFS = afero.Afero{Fs: afero.NewMemMapFs()}
+		fakeAttributesFS = newAttributesFS(afero.NewMemMapFs())
+		fakeFS = afero.Afero{Fs: fakeAttributesFS}
 
 		imageMountDirectory, err = fakeFS.TempDir("", "fake-node-agent-")
 		Expect(err).NotTo(HaveOccurred())
@@ -105,9 +119,16 @@ var _ = Describe("OperatingSystemConfig controller tests", func() {
 		By("Register controller")
 		Expect((&operatingsystemconfig.Reconciler{
 			Config: config.OperatingSystemConfigControllerConfig{
-				SyncPeriod:        &metav1.Duration{Duration: time.Hour},
-				SecretName:        oscSecretName,
-				KubernetesVersion: kubernetesVersion,
+				SyncPeriod:           &metav1.Duration{Duration: time.Hour},
+				SecretName:           oscSecretName,
+				KubernetesVersion:    kubernetesVersion,
+				ConcurrentFileWrites: pointer.Int(3),
+				RequeueBackoff: &config.RequeueBackoff{
+					InitialInterval: &metav1.Duration{Duration: 100 * time.Millisecond},
+					MaxInterval:     &metav1.Duration{Duration: time.Second},
+					JitterPeriod:    &metav1.Duration{},
+				},
+				Reboot: &config.Reboot{Enabled: pointer.Bool(true)},
 			},
 			DBus:          fakeDBus,
 			FS:            fakeFS,
@@ -272,6 +293,7 @@ var _ = Describe("OperatingSystemConfig controller tests", func() {
 		}).Should(And(
 			HaveKeyWithValue("checksum/cloud-config-data", utils.ComputeSHA256Hex(oscRaw)),
 			HaveKeyWithValue("worker.gardener.cloud/kubernetes-version", kubernetesVersion.String()),
+			Not(HaveKey("worker.gardener.cloud/osc-apply-progress")),
 		))
 
 		By("Assert that files and units have been created")
@@ -411,6 +433,593 @@ var _ = Describe("OperatingSystemConfig controller tests", func() {
 		Expect(cancelFunc.called).To(BeFalse())
 	})
 
+	It("should manage the node labels and taints declared in the OSC", func() {
+		By("Wait for node annotations to be updated")
+		Eventually(func(g Gomega) map[string]string {
+			updatedNode := &corev1.Node{}
+			g.Expect(testClient.Get(ctx, client.ObjectKeyFromObject(node), updatedNode)).To(Succeed())
+			return updatedNode.Annotations
+		}).Should(HaveKeyWithValue("checksum/cloud-config-data", utils.ComputeSHA256Hex(oscRaw)))
+
+		poolTaint := corev1.Taint{Key: "pool-taint", Value: "foo", Effect: corev1.TaintEffectNoSchedule}
+		userTaint := corev1.Taint{Key: "user-taint", Effect: corev1.TaintEffectPreferNoSchedule}
+
+		By("Update Operating System Config with node labels and taints")
+		operatingSystemConfig.Spec.NodeLabels = map[string]string{"pool-label": "foo", "stale-label": "true"}
+		operatingSystemConfig.Spec.NodeTaints = []corev1.Taint{poolTaint}
+
+		var err error
+		oscRaw, err = runtime.Encode(codec, operatingSystemConfig)
+		Expect(err).NotTo(HaveOccurred())
+
+		patch := client.MergeFrom(oscSecret.DeepCopy())
+		oscSecret.Data["osc.yaml"] = oscRaw
+		Expect(testClient.Patch(ctx, oscSecret, patch)).To(Succeed())
+
+		By("Wait for node labels and taints to be updated")
+		Eventually(func(g Gomega) {
+			g.Expect(testClient.Get(ctx, client.ObjectKeyFromObject(node), node)).To(Succeed())
+			g.Expect(node.Labels).To(And(
+				HaveKeyWithValue("pool-label", "foo"),
+				HaveKeyWithValue("stale-label", "true"),
+			))
+			g.Expect(node.Spec.Taints).To(ConsistOf(poolTaint))
+			g.Expect(node.Annotations).To(And(
+				HaveKeyWithValue("worker.gardener.cloud/managed-node-labels", "pool-label,stale-label"),
+				HaveKeyWithValue("worker.gardener.cloud/managed-node-taints", "pool-taint:NoSchedule"),
+			))
+		}).Should(Succeed())
+
+		By("Manually change the node labels and taints")
+		patch = client.MergeFrom(node.DeepCopy())
+		node.Labels["pool-label"] = "changed"
+		node.Labels["user-label"] = "bar"
+		node.Spec.Taints = []corev1.Taint{userTaint}
+		Expect(testClient.Patch(ctx, node, patch)).To(Succeed())
+
+		By("Update Operating System Config and remove a node label")
+		delete(operatingSystemConfig.Spec.NodeLabels, "stale-label")
+
+		oscRaw, err = runtime.Encode(codec, operatingSystemConfig)
+		Expect(err).NotTo(HaveOccurred())
+
+		patch = client.MergeFrom(oscSecret.DeepCopy())
+		oscSecret.Data["osc.yaml"] = oscRaw
+		Expect(testClient.Patch(ctx, oscSecret, patch)).To(Succeed())
+
+		By("Wait for managed node labels and taints to be restored")
+		Eventually(func(g Gomega) {
+			g.Expect(testClient.Get(ctx, client.ObjectKeyFromObject(node), node)).To(Succeed())
+			g.Expect(node.Labels).To(And(
+				HaveKeyWithValue("pool-label", "foo"),
+				HaveKeyWithValue("user-label", "bar"),
+				Not(HaveKey("stale-label")),
+			))
+			g.Expect(node.Spec.Taints).To(ConsistOf(userTaint, poolTaint))
+			g.Expect(node.Annotations).To(HaveKeyWithValue("worker.gardener.cloud/managed-node-labels", "pool-label"))
+		}).Should(Succeed())
+	})
+
+	It("should assemble chunked inline files and only fetch missing chunks", func() {
+		By("Wait for node annotations to be updated")
+		Eventually(func(g Gomega) map[string]string {
+			updatedNode := &corev1.Node{}
+			g.Expect(testClient.Get(ctx, client.ObjectKeyFromObject(node), updatedNode)).To(Succeed())
+			return updatedNode.Annotations
+		}).Should(HaveKeyWithValue("checksum/cloud-config-data", utils.ComputeSHA256Hex(oscRaw)))
+
+		createChunkSecrets := func(chunkData map[string][]byte) {
+			for digest, data := range chunkData {
+				chunkSecret := &corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      extensionsv1alpha1helper.FileContentChunkSecretName(digest),
+						Namespace: metav1.NamespaceSystem,
+						Labels:    map[string]string{testID: testRunID},
+					},
+					Data: map[string][]byte{extensionsv1alpha1helper.DataKeyFileContentChunk: data},
+				}
+				Expect(testClient.Create(ctx, chunkSecret)).To(Succeed())
+				DeferCleanup(func() {
+					Expect(client.IgnoreNotFound(testClient.Delete(ctx, chunkSecret))).To(Succeed())
+				})
+			}
+		}
+
+		updateOSC := func() {
+			var err error
+			oscRaw, err = runtime.Encode(codec, operatingSystemConfig)
+			Expect(err).NotTo(HaveOccurred())
+
+			patch := client.MergeFrom(oscSecret.DeepCopy())
+			oscSecret.Data["osc.yaml"] = oscRaw
+			Expect(testClient.Patch(ctx, oscSecret, patch)).To(Succeed())
+
+			Eventually(func(g Gomega) map[string]string {
+				updatedNode := &corev1.Node{}
+				g.Expect(testClient.Get(ctx, client.ObjectKeyFromObject(node), updatedNode)).To(Succeed())
+				return updatedNode.Annotations
+			}).Should(HaveKeyWithValue("checksum/cloud-config-data", utils.ComputeSHA256Hex(oscRaw)))
+		}
+
+		By("Update Operating System Config with a chunked file")
+		chunks, chunkData, err := extensionsv1alpha1helper.SplitIntoFileContentChunks([]byte("aaaabbbbcc"), 4)
+		Expect(err).NotTo(HaveOccurred())
+		createChunkSecrets(chunkData)
+
+		chunkedFile := extensionsv1alpha1.File{
+			Path:        "/example/chunked-file",
+			Permissions: pointer.Int32(0644),
+			Content:     extensionsv1alpha1.FileContent{Inline: &extensionsv1alpha1.FileContentInline{Chunks: chunks}},
+		}
+		operatingSystemConfig.Spec.Files = append(operatingSystemConfig.Spec.Files, chunkedFile)
+		updateOSC()
+
+		By("Assert that the chunked file has been assembled")
+		assertFileOnDisk(fakeFS, chunkedFile.Path, "aaaabbbbcc", 0644)
+		for digest := range chunkData {
+			assertFileOnDisk(fakeFS, "/var/lib/gardener-node-agent/cache/chunks/"+digest, string(chunkData[digest]), 0600)
+		}
+
+		By("Delete the secrets of the cached chunks")
+		for digest := range chunkData {
+			Expect(testClient.Delete(ctx, &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: extensionsv1alpha1helper.FileContentChunkSecretName(digest), Namespace: metav1.NamespaceSystem}})).To(Succeed())
+		}
+
+		By("Update the chunked file and only provide the secret of the changed chunk")
+		newChunks, newChunkData, err := extensionsv1alpha1helper.SplitIntoFileContentChunks([]byte("aaaabbbbdd"), 4)
+		Expect(err).NotTo(HaveOccurred())
+		createChunkSecrets(map[string][]byte{newChunks[2].Digest: newChunkData[newChunks[2].Digest]})
+
+		operatingSystemConfig.Spec.Files[len(operatingSystemConfig.Spec.Files)-1].Content.Inline.Chunks = newChunks
+		updateOSC()
+
+		By("Assert that the chunked file has been updated and no longer needed chunks have been removed")
+		assertFileOnDisk(fakeFS, chunkedFile.Path, "aaaabbbbdd", 0644)
+		assertNoFileOnDisk(fakeFS, "/var/lib/gardener-node-agent/cache/chunks/"+chunks[2].Digest)
+	})
+
+	It("should apply the owner of files and symbolic links", func() {
+		DeferCleanup(test.WithVar(&operatingsystemconfig.LookupGroupID, func(name string) (string, error) {
+			if name != "systemd-network" {
+				return "", fmt.Errorf("unknown group %q", name)
+			}
+			return "101", nil
+		}))
+
+		By("Wait for node annotations to be updated")
+		Eventually(func(g Gomega) map[string]string {
+			updatedNode := &corev1.Node{}
+			g.Expect(testClient.Get(ctx, client.ObjectKeyFromObject(node), updatedNode)).To(Succeed())
+			return updatedNode.Annotations
+		}).Should(HaveKeyWithValue("checksum/cloud-config-data", utils.ComputeSHA256Hex(oscRaw)))
+
+		updateOSC := func() {
+			var err error
+			oscRaw, err = runtime.Encode(codec, operatingSystemConfig)
+			Expect(err).NotTo(HaveOccurred())
+
+			patch := client.MergeFrom(oscSecret.DeepCopy())
+			oscSecret.Data["osc.yaml"] = oscRaw
+			Expect(testClient.Patch(ctx, oscSecret, patch)).To(Succeed())
+
+			Eventually(func(g Gomega) map[string]string {
+				updatedNode := &corev1.Node{}
+				g.Expect(testClient.Get(ctx, client.ObjectKeyFromObject(node), updatedNode)).To(Succeed())
+				return updatedNode.Annotations
+			}).Should(HaveKeyWithValue("checksum/cloud-config-data", utils.ComputeSHA256Hex(oscRaw)))
+		}
+
+		By("Update Operating System Config with an owned file and a symbolic link")
+		networkFile := extensionsv1alpha1.File{
+			Path:        "/etc/systemd/network/10-eth0.network",
+			Permissions: pointer.Int32(0640),
+			Owner:       &extensionsv1alpha1.FileOwner{Group: "systemd-network"},
+			Content:     extensionsv1alpha1.FileContent{Inline: &extensionsv1alpha1.FileContentInline{Data: "[Match]\nName=eth0\n"}},
+		}
+		networkLink := extensionsv1alpha1.File{
+			Path:    "/etc/systemd/network/eth0.network",
+			Content: extensionsv1alpha1.FileContent{Symlink: &extensionsv1alpha1.FileContentSymlink{Target: "10-eth0.network"}},
+		}
+		operatingSystemConfig.Spec.Files = append(operatingSystemConfig.Spec.Files, networkFile, networkLink)
+		updateOSC()
+
+		By("Assert that the owner and the symbolic link have been applied")
+		assertFileOnDisk(fakeFS, networkFile.Path, "[Match]\nName=eth0\n", 0640)
+		Expect(fakeAttributesFS.owner(networkFile.Path)).To(Equal("0:101"))
+		Expect(fakeAttributesFS.owner(file1.Path)).To(Equal("0:0"))
+		Expect(fakeAttributesFS.ReadlinkIfPossible(networkLink.Path)).To(Equal("10-eth0.network"))
+
+		By("Remove the owner and change the target of the symbolic link")
+		operatingSystemConfig.Spec.Files[len(operatingSystemConfig.Spec.Files)-2].Owner = nil
+		operatingSystemConfig.Spec.Files[len(operatingSystemConfig.Spec.Files)-1].Content.Symlink.Target = "/run/systemd/network/eth0.network"
+		updateOSC()
+
+		By("Assert that the owner has been restored and the symbolic link has been replaced")
+		assertFileOnDisk(fakeFS, networkFile.Path, "[Match]\nName=eth0\n", 0640)
+		Expect(fakeAttributesFS.owner(networkFile.Path)).To(Equal("0:0"))
+		Expect(fakeAttributesFS.ReadlinkIfPossible(networkLink.Path)).To(Equal("/run/systemd/network/eth0.network"))
+
+		By("Delete the symbolic link")
+		operatingSystemConfig.Spec.Files = operatingSystemConfig.Spec.Files[:len(operatingSystemConfig.Spec.Files)-1]
+		updateOSC()
+
+		_, err := fakeAttributesFS.ReadlinkIfPossible(networkLink.Path)
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should not mark the configuration as applied when a restarted unit does not become healthy", func() {
+		By("Wait for node annotations to be updated")
+		Eventually(func(g Gomega) map[string]string {
+			updatedNode := &corev1.Node{}
+			g.Expect(testClient.Get(ctx, client.ObjectKeyFromObject(node), updatedNode)).To(Succeed())
+			return updatedNode.Annotations
+		}).Should(HaveKeyWithValue("checksum/cloud-config-data", utils.ComputeSHA256Hex(oscRaw)))
+
+		fakeDBus.SetActiveState(unit7.Name, "failed")
+
+		By("Update Operating System Config")
+		// the content of file5 (belonging to unit7) is changed, so unit7 is restarting
+		operatingSystemConfig.Spec.Files[2].Content.Inline.Data = "changeme"
+
+		newOSCRaw, err := runtime.Encode(codec, operatingSystemConfig)
+		Expect(err).NotTo(HaveOccurred())
+
+		By("Update Secret containing the operating system config")
+		patch := client.MergeFrom(oscSecret.DeepCopy())
+		oscSecret.Data["osc.yaml"] = newOSCRaw
+		Expect(testClient.Patch(ctx, oscSecret, patch)).To(Succeed())
+
+		By("Wait for unit7 to be restarted")
+		Eventually(func() []fakedbus.SystemdAction {
+			return fakeDBus.Actions
+		}).Should(ContainElement(fakedbus.SystemdAction{Action: fakedbus.ActionRestart, UnitNames: []string{unit7.Name}}))
+
+		By("Ensure node annotations are not updated")
+		Consistently(func(g Gomega) map[string]string {
+			updatedNode := &corev1.Node{}
+			g.Expect(testClient.Get(ctx, client.ObjectKeyFromObject(node), updatedNode)).To(Succeed())
+			return updatedNode.Annotations
+		}).Should(HaveKeyWithValue("checksum/cloud-config-data", utils.ComputeSHA256Hex(oscRaw)))
+	})
+
+	It("should mark the configuration as applied when a restarted unit ran to completion successfully", func() {
+		By("Wait for node annotations to be updated")
+		Eventually(func(g Gomega) map[string]string {
+			updatedNode := &corev1.Node{}
+			g.Expect(testClient.Get(ctx, client.ObjectKeyFromObject(node), updatedNode)).To(Succeed())
+			return updatedNode.Annotations
+		}).Should(HaveKeyWithValue("checksum/cloud-config-data", utils.ComputeSHA256Hex(oscRaw)))
+
+		// e.g., a oneshot service without 'RemainAfterExit=yes'
+		fakeDBus.SetActiveState(unit7.Name, "inactive")
+
+		By("Update Operating System Config")
+		// the content of file5 (belonging to unit7) is changed, so unit7 is restarting
+		operatingSystemConfig.Spec.Files[2].Content.Inline.Data = "changeme"
+
+		var err error
+		oscRaw, err = runtime.Encode(codec, operatingSystemConfig)
+		Expect(err).NotTo(HaveOccurred())
+
+		By("Update Secret containing the operating system config")
+		patch := client.MergeFrom(oscSecret.DeepCopy())
+		oscSecret.Data["osc.yaml"] = oscRaw
+		Expect(testClient.Patch(ctx, oscSecret, patch)).To(Succeed())
+
+		By("Wait for node annotations to be updated")
+		Eventually(func(g Gomega) map[string]string {
+			updatedNode := &corev1.Node{}
+			g.Expect(testClient.Get(ctx, client.ObjectKeyFromObject(node), updatedNode)).To(Succeed())
+			return updatedNode.Annotations
+		}).Should(HaveKeyWithValue("checksum/cloud-config-data", utils.ComputeSHA256Hex(oscRaw)))
+	})
+
+	Context("reboot", func() {
+		var (
+			pod   *corev1.Pod
+			lease *coordinationv1.Lease
+		)
+
+		BeforeEach(func() {
+			By("Wait for node annotations to be updated")
+			Eventually(func(g Gomega) map[string]string {
+				updatedNode := &corev1.Node{}
+				g.Expect(testClient.Get(ctx, client.ObjectKeyFromObject(node), updatedNode)).To(Succeed())
+				return updatedNode.Annotations
+			}).Should(HaveKeyWithValue("checksum/cloud-config-data", utils.ComputeSHA256Hex(oscRaw)))
+
+			Expect(fakeFS.WriteFile("/proc/sys/kernel/random/boot_id", []byte("boot-1\n"), 0444)).To(Succeed())
+
+			By("Create Pod running on the node")
+			pod = &corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      testRunID,
+					Namespace: metav1.NamespaceDefault,
+					Labels:    map[string]string{testID: testRunID},
+				},
+				Spec: corev1.PodSpec{
+					NodeName:                      node.Name,
+					TerminationGracePeriodSeconds: pointer.Int64(0),
+					Containers:                    []corev1.Container{{Name: "app", Image: "app"}},
+				},
+			}
+			Expect(testClient.Create(ctx, pod)).To(Succeed())
+			DeferCleanup(func() {
+				Expect(client.IgnoreNotFound(testClient.Delete(ctx, pod))).To(Succeed())
+			})
+
+			lease = &coordinationv1.Lease{ObjectMeta: metav1.ObjectMeta{Name: "gardener-node-agent-reboot-0", Namespace: metav1.NamespaceSystem}}
+			DeferCleanup(func() {
+				Expect(client.IgnoreNotFound(testClient.Delete(ctx, lease))).To(Succeed())
+			})
+
+			fakeDBus.Actions = nil // reset actions on dbus to not repeat assertions from above for update scenario
+		})
+
+		updateOperatingSystemConfig := func() {
+			By("Update Operating System Config")
+			// unit7 requires a reboot now
+			operatingSystemConfig.Spec.Units[5].RebootRequired = pointer.Bool(true)
+
+			var err error
+			oscRaw, err = runtime.Encode(codec, operatingSystemConfig)
+			Expect(err).NotTo(HaveOccurred())
+
+			By("Update Secret containing the operating system config")
+			patch := client.MergeFrom(oscSecret.DeepCopy())
+			oscSecret.Data["osc.yaml"] = oscRaw
+			Expect(testClient.Patch(ctx, oscSecret, patch)).To(Succeed())
+		}
+
+		It("should drain and reboot the node when a changed unit requires a reboot", func() {
+			oldOSCChecksum := utils.ComputeSHA256Hex(oscRaw)
+			updateOperatingSystemConfig()
+
+			By("Wait for the node to be rebooted")
+			Eventually(func() []fakedbus.SystemdAction {
+				return fakeDBus.Actions
+			}).Should(ContainElement(fakedbus.SystemdAction{Action: fakedbus.ActionReboot}))
+			Expect(fakeDBus.Actions).NotTo(ContainElement(fakedbus.SystemdAction{Action: fakedbus.ActionRestart, UnitNames: []string{unit7.Name}}))
+
+			By("Assert that the pod was evicted, the node was cordoned and the reboot lease was acquired")
+			Expect(testClient.Get(ctx, client.ObjectKeyFromObject(pod), pod)).To(BeNotFoundError())
+			Expect(testClient.Get(ctx, client.ObjectKeyFromObject(node), node)).To(Succeed())
+			Expect(node.Spec.Unschedulable).To(BeTrue())
+			Expect(node.Annotations).To(And(
+				HaveKeyWithValue("worker.gardener.cloud/cordoned-for-reboot", "boot-1"),
+				HaveKeyWithValue("checksum/cloud-config-data", oldOSCChecksum),
+			))
+			Expect(testClient.Get(ctx, client.ObjectKeyFromObject(lease), lease)).To(Succeed())
+			Expect(lease.Spec.HolderIdentity).To(Equal(pointer.String(node.Name)))
+
+			By("Simulate reboot")
+			Expect(fakeFS.WriteFile("/proc/sys/kernel/random/boot_id", []byte("boot-2\n"), 0444)).To(Succeed())
+
+			By("Wait for unit7 to be restarted and the node to be uncordoned")
+			Eventually(func() []fakedbus.SystemdAction {
+				return fakeDBus.Actions
+			}).Should(ContainElement(fakedbus.SystemdAction{Action: fakedbus.ActionRestart, UnitNames: []string{unit7.Name}}))
+
+			Eventually(func(g Gomega) {
+				g.Expect(testClient.Get(ctx, client.ObjectKeyFromObject(node), node)).To(Succeed())
+				g.Expect(node.Spec.Unschedulable).To(BeFalse())
+				g.Expect(node.Annotations).NotTo(HaveKey("worker.gardener.cloud/cordoned-for-reboot"))
+				g.Expect(node.Annotations).To(HaveKeyWithValue("checksum/cloud-config-data", utils.ComputeSHA256Hex(oscRaw)))
+			}).Should(Succeed())
+
+			By("Assert that the reboot lease was released")
+			Expect(testClient.Get(ctx, client.ObjectKeyFromObject(lease), lease)).To(Succeed())
+			Expect(lease.Spec.HolderIdentity).To(BeNil())
+		})
+
+		It("should not cordon the node while another node holds the reboot lease", func() {
+			now := metav1.NowMicro()
+			lease.Spec = coordinationv1.LeaseSpec{
+				HolderIdentity:       pointer.String("other-node"),
+				LeaseDurationSeconds: pointer.Int32(900),
+				AcquireTime:          &now,
+				RenewTime:            &now,
+			}
+			Expect(testClient.Create(ctx, lease)).To(Succeed())
+
+			updateOperatingSystemConfig()
+
+			By("Ensure the node is not cordoned")
+			Consistently(func(g Gomega) {
+				g.Expect(testClient.Get(ctx, client.ObjectKeyFromObject(node), node)).To(Succeed())
+				g.Expect(node.Spec.Unschedulable).To(BeFalse())
+				g.Expect(fakeDBus.Actions).NotTo(ContainElement(fakedbus.SystemdAction{Action: fakedbus.ActionReboot}))
+			}).Should(Succeed())
+
+			By("Release the reboot lease of the other node")
+			patch := client.MergeFrom(lease.DeepCopy())
+			lease.Spec.HolderIdentity = nil
+			Expect(testClient.Patch(ctx, lease, patch)).To(Succeed())
+
+			By("Wait for the node to be rebooted")
+			Eventually(func() []fakedbus.SystemdAction {
+				return fakeDBus.Actions
+			}).Should(ContainElement(fakedbus.SystemdAction{Action: fakedbus.ActionReboot}))
+		})
+
+		It("should reboot the node when the drain timeout is exceeded", func() {
+			By("Create PodDisruptionBudget blocking the eviction")
+			pdb := &policyv1.PodDisruptionBudget{
+				ObjectMeta: metav1.ObjectMeta{Name: testRunID, Namespace: metav1.NamespaceDefault},
+				Spec: policyv1.PodDisruptionBudgetSpec{
+					MinAvailable: utils.IntStrPtrFromInt32(1),
+					Selector:     &metav1.LabelSelector{MatchLabels: map[string]string{testID: testRunID}},
+				},
+			}
+			Expect(testClient.Create(ctx, pdb)).To(Succeed())
+			DeferCleanup(func() {
+				Expect(testClient.Delete(ctx, pdb)).To(Succeed())
+			})
+
+			By("Create reboot lease acquired by the node a long time ago")
+			acquireTime, now := metav1.NewMicroTime(time.Now().Add(-time.Hour)), metav1.NowMicro()
+			lease.Spec = coordinationv1.LeaseSpec{
+				HolderIdentity:       pointer.String(node.Name),
+				LeaseDurationSeconds: pointer.Int32(900),
+				AcquireTime:          &acquireTime,
+				RenewTime:            &now,
+			}
+			Expect(testClient.Create(ctx, lease)).To(Succeed())
+
+			updateOperatingSystemConfig()
+
+			By("Wait for the node to be rebooted")
+			Eventually(func() []fakedbus.SystemdAction {
+				return fakeDBus.Actions
+			}).Should(ContainElement(fakedbus.SystemdAction{Action: fakedbus.ActionReboot}))
+			Expect(testClient.Get(ctx, client.ObjectKeyFromObject(pod), pod)).To(Succeed())
+		})
+	})
+
+	It("should apply drifted files and units again when drift detection is enabled", func() {
+		By("Wait for node annotations to be updated")
+		Eventually(func(g Gomega) map[string]string {
+			updatedNode := &corev1.Node{}
+			g.Expect(testClient.Get(ctx, client.ObjectKeyFromObject(node), updatedNode)).To(Succeed())
+			return updatedNode.Annotations
+		}).Should(HaveKeyWithValue("checksum/cloud-config-data", utils.ComputeSHA256Hex(oscRaw)))
+
+		fakeDBus.Actions = nil // reset actions on dbus to not repeat assertions from above for drift scenario
+
+		// The reconciler registered with the manager only requeues after the sync period, hence a second reconciler with
+		// enabled drift detection is invoked directly.
+		fakeRecorder := record.NewFakeRecorder(5)
+		reconciler := &operatingsystemconfig.Reconciler{
+			Client:    testClient,
+			APIReader: testClient,
+			Config: config.OperatingSystemConfigControllerConfig{
+				SyncPeriod:            &metav1.Duration{Duration: time.Hour},
+				SecretName:            oscSecretName,
+				KubernetesVersion:     kubernetesVersion,
+				DriftDetectionEnabled: pointer.Bool(true),
+				RequeueBackoff:        &config.RequeueBackoff{JitterPeriod: &metav1.Duration{}},
+			},
+			Recorder:      fakeRecorder,
+			DBus:          fakeDBus,
+			FS:            fakeFS,
+			HostName:      hostName,
+			Extractor:     fakeregistry.NewExtractor(fakeFS, imageMountDirectory),
+			CancelContext: cancelFunc.cancel,
+			Clock:         clock.RealClock{},
+		}
+		request := reconcile.Request{NamespacedName: client.ObjectKeyFromObject(oscSecret)}
+
+		By("Reconcile without any drift")
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: time.Hour}))
+		Expect(fakeDBus.Actions).To(BeEmpty())
+		Expect(fakeRecorder.Events).To(BeEmpty())
+
+		By("Manually change files and units")
+		Expect(fakeFS.WriteFile(file1.Path, []byte("manually edited"), 0777)).To(Succeed())
+		Expect(fakeFS.WriteFile(file5.Path, []byte("manually edited"), 0750)).To(Succeed())
+		Expect(fakeFS.Remove("/etc/systemd/system/" + unit1.Name + ".d/" + unit1.DropIns[0].Name)).To(Succeed())
+
+		By("Reconcile with drift")
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: time.Hour}))
+		Expect(fakeRecorder.Events).To(Receive(Equal("Warning OSCDriftDetected Detected drift of files and units on the disk, applying them again: /example/file, /changed/file, unit1")))
+		Expect(fakeRecorder.Events).To(Receive(HavePrefix("Normal OSCApplied")))
+
+		By("Assert that files and units have been applied again")
+		assertFileOnDisk(fakeFS, file1.Path, "file1", 0777)
+		assertFileOnDisk(fakeFS, file5.Path, "file5", 0750)
+		assertFileOnDisk(fakeFS, "/etc/systemd/system/"+unit1.Name+".d/"+unit1.DropIns[0].Name, "#unit1drop", 0600)
+
+		By("Assert that the affected units have been restarted")
+		Expect(fakeDBus.Actions).To(ConsistOf(
+			fakedbus.SystemdAction{Action: fakedbus.ActionEnable, UnitNames: []string{unit1.Name}},
+			fakedbus.SystemdAction{Action: fakedbus.ActionEnable, UnitNames: []string{unit7.Name}},
+			fakedbus.SystemdAction{Action: fakedbus.ActionDaemonReload},
+			fakedbus.SystemdAction{Action: fakedbus.ActionRestart, UnitNames: []string{unit1.Name}},
+			fakedbus.SystemdAction{Action: fakedbus.ActionRestart, UnitNames: []string{unit7.Name}},
+		))
+	})
+
+	It("should take over a node from the legacy cloud-config-downloader", func() {
+		By("Wait for node annotations to be updated")
+		Eventually(func(g Gomega) map[string]string {
+			updatedNode := &corev1.Node{}
+			g.Expect(testClient.Get(ctx, client.ObjectKeyFromObject(node), updatedNode)).To(Succeed())
+			return updatedNode.Annotations
+		}).Should(HaveKeyWithValue("checksum/cloud-config-data", utils.ComputeSHA256Hex(oscRaw)))
+
+		fakeDBus.Actions = nil // reset actions on dbus to not repeat assertions from above for handover scenario
+
+		By("Simulate node managed by legacy cloud-config-downloader")
+		// The files and units were written by the legacy cloud-config-downloader, only the content of file1 differs.
+		Expect(fakeFS.Remove("/var/lib/gardener-node-agent/last-applied-osc.yaml")).To(Succeed())
+		Expect(fakeFS.WriteFile("/etc/systemd/system/cloud-config-downloader.service", []byte("#ccd"), 0600)).To(Succeed())
+		Expect(fakeFS.WriteFile("/var/lib/cloud-config-downloader/download-cloud-config.sh", []byte("#!/bin/bash"), 0744)).To(Succeed())
+		Expect(fakeFS.WriteFile(file1.Path, []byte("legacy"), 0777)).To(Succeed())
+
+		patch := client.MergeFrom(node.DeepCopy())
+		metav1.SetMetaDataAnnotation(&node.ObjectMeta, "checksum/cloud-config-data", "legacy")
+		Expect(testClient.Patch(ctx, node, patch)).To(Succeed())
+
+		// The reconciler registered with the manager only requeues after the sync period, hence a second reconciler is
+		// invoked directly.
+		fakeRecorder := record.NewFakeRecorder(5)
+		reconciler := &operatingsystemconfig.Reconciler{
+			Client:    testClient,
+			APIReader: testClient,
+			Config: config.OperatingSystemConfigControllerConfig{
+				SyncPeriod:        &metav1.Duration{Duration: time.Hour},
+				SecretName:        oscSecretName,
+				KubernetesVersion: kubernetesVersion,
+				RequeueBackoff:    &config.RequeueBackoff{JitterPeriod: &metav1.Duration{}},
+			},
+			Recorder:      fakeRecorder,
+			DBus:          fakeDBus,
+			FS:            fakeFS,
+			HostName:      hostName,
+			Extractor:     fakeregistry.NewExtractor(fakeFS, imageMountDirectory),
+			CancelContext: cancelFunc.cancel,
+			Clock:         clock.RealClock{},
+		}
+		request := reconcile.Request{NamespacedName: client.ObjectKeyFromObject(oscSecret)}
+
+		By("Reconcile with legacy cloud-config-downloader")
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: time.Hour}))
+		Expect(fakeRecorder.Events).To(Receive(Equal("Normal LegacyHandoverCompleted Took over node from legacy cloud-config-downloader, imported 3 files and 7 units")))
+		Expect(fakeRecorder.Events).To(Receive(HavePrefix("Normal OSCApplied")))
+
+		By("Assert that the legacy unit has been stopped and disabled before applying the changes")
+		// Only the files which were not imported are applied, i.e., file1 with a different content and file3 from an
+		// image, hence only unit6 referencing file3 is restarted.
+		Expect(fakeDBus.Actions).To(Equal([]fakedbus.SystemdAction{
+			{Action: fakedbus.ActionStop, UnitNames: []string{"cloud-config-downloader.service"}},
+			{Action: fakedbus.ActionDisable, UnitNames: []string{"cloud-config-downloader.service"}},
+			{Action: fakedbus.ActionDaemonReload},
+			{Action: fakedbus.ActionEnable, UnitNames: []string{unit6.Name}},
+			{Action: fakedbus.ActionDaemonReload},
+			{Action: fakedbus.ActionRestart, UnitNames: []string{unit6.Name}},
+		}))
+
+		By("Assert that the legacy unit has been removed and the handover has been recorded")
+		assertNoFileOnDisk(fakeFS, "/etc/systemd/system/cloud-config-downloader.service")
+		assertFileOnDisk(fakeFS, "/var/lib/cloud-config-downloader/download-cloud-config.sh", "#!/bin/bash", 0744)
+		assertFileOnDisk(fakeFS, file1.Path, "file1", 0777)
+
+		marker, err := fakeFS.ReadFile("/var/lib/gardener-node-agent/legacy-handover.yaml")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(marker)).To(And(
+			ContainSubstring("disabledUnits:\n- cloud-config-downloader.service\n"),
+			ContainSubstring("importedFiles:\n- /changed/file\n- /another/file\n- /unchanged/file\n"),
+			Not(ContainSubstring(file1.Path)),
+		))
+
+		fakeDBus.Actions = nil
+
+		By("Reconcile again")
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: time.Hour}))
+		Expect(fakeDBus.Actions).To(BeEmpty())
+		Expect(fakeRecorder.Events).To(BeEmpty())
+	})
+
 	It("should call the cancel function when gardener-node-agent must be restarted itself", func() {
 		var lastAppliedOSC []byte
 		By("Wait last-applied OSC file to be persisted")
@@ -478,6 +1087,88 @@ func assertNoDirectoryOnDisk(fakeFS afero.Afero, path string) {
 	ExpectWithOffset(1, exists).To(BeFalse(), "directory path "+path)
 }
 
+// attributesFS records the owners of files and supports symbolic links, which the in-memory file system does not.
+type attributesFS struct {
+	afero.Fs
+
+	lock   sync.Mutex
+	owners map[string]string
+	links  map[string]string
+}
+
+func newAttributesFS(fs afero.Fs) *attributesFS {
+	return &attributesFS{Fs: fs, owners: make(map[string]string), links: make(map[string]string)}
+}
+
+func (f *attributesFS) Chown(name string, uid, gid int) error {
+	if err := f.Fs.Chown(name, uid, gid); err != nil {
+		return err
+	}
+
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	f.owners[name] = fmt.Sprintf("%d:%d", uid, gid)
+	return nil
+}
+
+func (f *attributesFS) owner(name string) string {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	return f.owners[name]
+}
+
+func (f *attributesFS) Remove(name string) error {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	if _, ok := f.links[name]; ok {
+		delete(f.links, name)
+		return nil
+	}
+	delete(f.owners, name)
+	return f.Fs.Remove(name)
+}
+
+func (f *attributesFS) SymlinkIfPossible(oldname, newname string) error {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	if _, ok := f.links[newname]; ok {
+		return &os.LinkError{Op: "symlink", Old: oldname, New: newname, Err: os.ErrExist}
+	}
+	f.links[newname] = oldname
+	return nil
+}
+
+func (f *attributesFS) ReadlinkIfPossible(name string) (string, error) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	target, ok := f.links[name]
+	if !ok {
+		return "", &os.PathError{Op: "readlink", Path: name, Err: syscall.EINVAL}
+	}
+	return target, nil
+}
+
+func (f *attributesFS) LstatIfPossible(name string) (os.FileInfo, bool, error) {
+	f.lock.Lock()
+	_, ok := f.links[name]
+	f.lock.Unlock()
+	if ok {
+		return symlinkInfo{name: path.Base(name)}, true, nil
+	}
+
+	info, err := f.Fs.Stat(name)
+	return info, false, err
+}
+
+type symlinkInfo struct {
+	os.FileInfo
+	name string
+}
+
+func (s symlinkInfo) Name() string      { return s.name }
+func (s symlinkInfo) Mode() fs.FileMode { return fs.ModeSymlink | 0777 }
+func (s symlinkInfo) IsDir() bool       { return false }
+
 type cancelFuncEnsurer struct {
 	called bool
 }
@@ -17,6 +17,7 @@ package shoot_test
 import (
 	"context"
 	"fmt"
+	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -536,6 +537,10 @@ us-central-3: 220`,
 })
 
 func createAndStartManager(config *config.ShootSchedulerConfiguration) {
+	// The retry settings are defaulted when the component configuration is decoded, which is skipped in this test.
+	config.RetryInterval = &metav1.Duration{Duration: 5 * time.Millisecond}
+	config.MaxRetryBackoff = &metav1.Duration{Duration: 1000 * time.Second}
+
 	By("Setup manager")
 	mgr, err := manager.New(restConfig, manager.Options{
 		Scheme:  kubernetes.GardenScheme,